@@ -19,12 +19,26 @@ func (u *Files) RegisterRoutes(v1 *gin.RouterGroup) {
 	fmt.Println("registering file related endpoints to admin server")
 	files := v1.Group("/file")
 	files.GET("/list", u.getFileList())
+
+	tags := v1.Group("/tags")
+	tags.PUT("/:name", u.renameTag())
+	tags.POST("/:name/merge", u.mergeTags())
 }
 func (u *Files) getFileList() gin.HandlerFunc {
 	return func(ctx *gin.Context) {
 		u.service.GetFileList(ctx, true)
 	}
 }
+func (u *Files) renameTag() gin.HandlerFunc {
+	return func(ctx *gin.Context) {
+		u.service.RenameTag(ctx, true)
+	}
+}
+func (u *Files) mergeTags() gin.HandlerFunc {
+	return func(ctx *gin.Context) {
+		u.service.MergeTags(ctx, true)
+	}
+}
 func NewFileModule(fileService *fileService.FileService, fileRepo *fileRepository.FileRepository, authEnabled bool) (*Files, error) {
 	if fileService == nil {
 		return nil, ErrNilFileService
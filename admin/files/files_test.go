@@ -1,12 +1,17 @@
 package files
 
 import (
+	"bytes"
+	"encoding/json"
 	"net/http"
 	"net/http/httptest"
 	"testing"
+	"time"
 
 	"github.com/gin-gonic/gin"
 	"github.com/golang/mock/gomock"
+	"github.com/lebleuciel/maani/models"
+	"github.com/lebleuciel/maani/pkg/database"
 	mock_database "github.com/lebleuciel/maani/pkg/database/mocks"
 	"github.com/lebleuciel/maani/pkg/repository/file"
 	fileservice "github.com/lebleuciel/maani/pkg/services/file"
@@ -19,6 +24,7 @@ func initFilesModuleWithMockDB(t *testing.T, authEnabled bool) (*Files, *mock_da
 	ctrl := gomock.NewController(t)
 	defer ctrl.Finish()
 	var st settings.Settings
+	st.BackendServer.EncryptKey = "0123456789abcdef"
 	db := mock_database.NewMockDatabase(ctrl)
 	fileRepo, err := file.NewFileRepository(st, db)
 	assert.Nil(t, err)
@@ -40,6 +46,7 @@ func TestNewFilesModule(t *testing.T) {
 	})
 	t.Run("nil_file_repo", func(t *testing.T) {
 		var st settings.Settings
+		st.BackendServer.EncryptKey = "0123456789abcdef"
 		db := mock_database.NewMockDatabase(ctrl)
 		fileRepo, err := file.NewFileRepository(st, db)
 		assert.Nil(t, err)
@@ -51,6 +58,7 @@ func TestNewFilesModule(t *testing.T) {
 	})
 	t.Run("valid", func(t *testing.T) {
 		var st settings.Settings
+		st.BackendServer.EncryptKey = "0123456789abcdef"
 		db := mock_database.NewMockDatabase(ctrl)
 		fileRepo, err := file.NewFileRepository(st, db)
 		assert.Nil(t, err)
@@ -67,7 +75,7 @@ func TestFiles_RegisterRoutes(t *testing.T) {
 	fileMod, db := initFilesModuleWithMockDB(t, true)
 	baseRecorder := httptest.NewRecorder()
 	_, engine := gin.CreateTestContext(baseRecorder)
-	db.EXPECT().GetFileList().Return(nil, nil).AnyTimes()
+	db.EXPECT().GetFileList(database.AllScope(), database.ListOptions{Limit: database.DefaultListLimit, Sort: database.SortCreatedAt}).Return(nil, "", nil).AnyTimes()
 	v1 := engine.Group("/api")
 	fileMod.RegisterRoutes(v1)
 
@@ -78,5 +86,115 @@ func TestFiles_RegisterRoutes(t *testing.T) {
 		engine.ServeHTTP(recorder, req)
 		assert.NotEqual(t, http.StatusNotFound, recorder.Code)
 		assert.Equal(t, http.StatusOK, recorder.Code)
+		assert.JSONEq(t, `{"files": [], "next_cursor": ""}`, recorder.Body.String())
+	})
+}
+
+// TestFiles_GetFileList tests file list is paged by cursors, sorted and filtered by query parameters
+func TestFiles_GetFileList(t *testing.T) {
+	fileMod, db := initFilesModuleWithMockDB(t, true)
+	_, engine := gin.CreateTestContext(httptest.NewRecorder())
+	fileMod.RegisterRoutes(engine.Group("/api"))
+
+	sizeOptions := database.ListOptions{Limit: 2, Sort: database.SortSize, Desc: true}
+	cursor := sizeOptions.NextCursor(7, "2048")
+
+	t.Run("first_page", func(t *testing.T) {
+		after := time.Date(2026, 1, 2, 0, 0, 0, 0, time.UTC)
+		db.EXPECT().GetFileList(database.AllScope(), database.ListOptions{
+			Limit:        2,
+			Sort:         database.SortSize,
+			Desc:         true,
+			OwnerId:      3,
+			Type:         "image",
+			Tag:          "cats",
+			CreatedAfter: &after,
+		}).Return([]models.File{{UUID: "a", Size: 2048}, {UUID: "b", Size: 1024}}, cursor, nil)
+
+		recorder := httptest.NewRecorder()
+		engine.ServeHTTP(recorder, httptest.NewRequest("GET", "https://store.foo/api/file/list?limit=2&sort=-size&owner=3&type=image&tag=Cats&after=2026-01-02", nil))
+		assert.Equal(t, http.StatusOK, recorder.Code)
+		var list models.FileList
+		assert.Nil(t, json.Unmarshal(recorder.Body.Bytes(), &list))
+		assert.Len(t, list.Files, 2)
+		assert.Equal(t, cursor, list.NextCursor)
+	})
+	t.Run("next_page", func(t *testing.T) {
+		options := sizeOptions
+		options.Cursor = &database.Cursor{Sort: "-size", Value: "2048", Id: 7}
+		db.EXPECT().GetFileList(database.AllScope(), options).Return([]models.File{{UUID: "c", Size: 512}}, "", nil)
+
+		recorder := httptest.NewRecorder()
+		engine.ServeHTTP(recorder, httptest.NewRequest("GET", "https://store.foo/api/file/list?limit=2&sort=-size&cursor="+cursor, nil))
+		assert.Equal(t, http.StatusOK, recorder.Code)
+		assert.Contains(t, recorder.Body.String(), `"next_cursor":""`)
+	})
+	t.Run("invalid_parameters", func(t *testing.T) {
+		for _, query := range []string{
+			"sort=type",
+			"limit=0",
+			"limit=101",
+			"owner=me",
+			"tag=a",
+			"after=yesterday",
+			"cursor=not-a-cursor",
+			// Cursors can't be used with another sort
+			"sort=name&cursor=" + cursor,
+		} {
+			recorder := httptest.NewRecorder()
+			engine.ServeHTTP(recorder, httptest.NewRequest("GET", "https://store.foo/api/file/list?"+query, nil))
+			assert.Equal(t, http.StatusBadRequest, recorder.Code, query)
+		}
+	})
+}
+
+// TestFiles_Tags tests admins rename and merge tags, which are normalized first
+func TestFiles_Tags(t *testing.T) {
+	fileMod, db := initFilesModuleWithMockDB(t, true)
+	_, engine := gin.CreateTestContext(httptest.NewRecorder())
+	fileMod.RegisterRoutes(engine.Group("/api"))
+
+	tagRequest := func(method string, target string, body string) *http.Request {
+		req := httptest.NewRequest(method, target, bytes.NewBufferString(body))
+		req.Header.Set("Content-Type", "application/json")
+		return req
+	}
+
+	t.Run("rename_tag", func(t *testing.T) {
+		db.EXPECT().RenameTag("cats", "kittens").Return(nil)
+
+		recorder := httptest.NewRecorder()
+		engine.ServeHTTP(recorder, tagRequest("PUT", "https://store.foo/api/tags/Cats", `{"name": " Kittens"}`))
+		assert.Equal(t, http.StatusNoContent, recorder.Code)
+	})
+	t.Run("rename_to_existing_tag", func(t *testing.T) {
+		db.EXPECT().RenameTag("cats", "pets").Return(database.ErrTagExists)
+
+		recorder := httptest.NewRecorder()
+		engine.ServeHTTP(recorder, tagRequest("PUT", "https://store.foo/api/tags/cats", `{"name": "pets"}`))
+		assert.Equal(t, http.StatusConflict, recorder.Code)
+	})
+	t.Run("rename_to_invalid_tag", func(t *testing.T) {
+		recorder := httptest.NewRecorder()
+		engine.ServeHTTP(recorder, tagRequest("PUT", "https://store.foo/api/tags/cats", `{"name": "two words"}`))
+		assert.Equal(t, http.StatusBadRequest, recorder.Code)
+
+		recorder = httptest.NewRecorder()
+		engine.ServeHTTP(recorder, tagRequest("PUT", "https://store.foo/api/tags/cats", `{"name": "CATS"}`))
+		assert.Equal(t, http.StatusBadRequest, recorder.Code)
+	})
+	t.Run("merge_tags", func(t *testing.T) {
+		db.EXPECT().MergeTags("kitten", "cats").Return(nil)
+
+		recorder := httptest.NewRecorder()
+		engine.ServeHTTP(recorder, tagRequest("POST", "https://store.foo/api/tags/kitten/merge", `{"into": "cats"}`))
+		assert.Equal(t, http.StatusNoContent, recorder.Code)
+	})
+	t.Run("merge_missing_tag", func(t *testing.T) {
+		db.EXPECT().MergeTags("kitten", "dogs").Return(database.ErrTagNotFound)
+
+		recorder := httptest.NewRecorder()
+		engine.ServeHTTP(recorder, tagRequest("POST", "https://store.foo/api/tags/kitten/merge", `{"into": "dogs"}`))
+		assert.Equal(t, http.StatusNotFound, recorder.Code)
 	})
 }
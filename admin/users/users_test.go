@@ -1,12 +1,15 @@
 package users
 
 import (
+	"encoding/json"
 	"net/http"
 	"net/http/httptest"
 	"testing"
 
 	"github.com/gin-gonic/gin"
 	"github.com/golang/mock/gomock"
+	"github.com/lebleuciel/maani/models"
+	"github.com/lebleuciel/maani/pkg/database"
 	mock_database "github.com/lebleuciel/maani/pkg/database/mocks"
 	"github.com/lebleuciel/maani/pkg/repository/user"
 	userservice "github.com/lebleuciel/maani/pkg/services/user"
@@ -67,7 +70,7 @@ func TestUsers_RegisterRoutes(t *testing.T) {
 	userMod, db := initUsersModuleWithMockDB(t, true)
 	baseRecorder := httptest.NewRecorder()
 	_, engine := gin.CreateTestContext(baseRecorder)
-	db.EXPECT().GetUserList().Return(nil, nil).AnyTimes()
+	db.EXPECT().GetUserList(database.ListOptions{Limit: database.DefaultListLimit, Sort: database.SortCreatedAt}).Return(nil, "", nil).AnyTimes()
 	v1 := engine.Group("/api")
 	userMod.RegisterRoutes(v1)
 
@@ -78,5 +81,34 @@ func TestUsers_RegisterRoutes(t *testing.T) {
 		engine.ServeHTTP(recorder, req)
 		assert.NotEqual(t, http.StatusNotFound, recorder.Code)
 		assert.Equal(t, http.StatusOK, recorder.Code)
+		assert.JSONEq(t, `{"users": [], "next_cursor": ""}`, recorder.Body.String())
+	})
+}
+
+// TestUsers_GetUserList tests user list is paged by cursors, sorted by name and filtered by access type
+func TestUsers_GetUserList(t *testing.T) {
+	userMod, db := initUsersModuleWithMockDB(t, true)
+	_, engine := gin.CreateTestContext(httptest.NewRecorder())
+	userMod.RegisterRoutes(engine.Group("/api"))
+
+	t.Run("filtered_page", func(t *testing.T) {
+		options := database.ListOptions{Limit: 1, Sort: database.SortName, Type: models.AdminType}
+		cursor := options.NextCursor(1, "Doe")
+		db.EXPECT().GetUserList(options).Return([]models.User{{Id: 1, LastName: "Doe", AccessType: models.AdminType}}, cursor, nil)
+
+		recorder := httptest.NewRecorder()
+		engine.ServeHTTP(recorder, httptest.NewRequest("GET", "https://store.foo/api/user/list?limit=1&sort=name&type=Admin", nil))
+		assert.Equal(t, http.StatusOK, recorder.Code)
+		var list models.UserList
+		assert.Nil(t, json.Unmarshal(recorder.Body.Bytes(), &list))
+		assert.Len(t, list.Users, 1)
+		assert.Equal(t, cursor, list.NextCursor)
+	})
+	t.Run("invalid_parameters", func(t *testing.T) {
+		for _, query := range []string{"sort=size", "type=Owner", "owner=1", "tag=cats", "cursor=abc"} {
+			recorder := httptest.NewRecorder()
+			engine.ServeHTTP(recorder, httptest.NewRequest("GET", "https://store.foo/api/user/list?"+query, nil))
+			assert.Equal(t, http.StatusBadRequest, recorder.Code, query)
+		}
 	})
 }
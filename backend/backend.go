@@ -1,6 +1,8 @@
 package backend
 
 import (
+	"context"
+
 	"github.com/pkg/errors"
 
 	"github.com/lebleuciel/maani/backend/files"
@@ -24,6 +26,15 @@ func NewBackendServer(setting settings.Settings, database database.Database) (*s
 		return nil, errors.Wrap(err, "Could not initialize new file service")
 	}
 
+	// Run search jobs in background of store process
+	err = fileService.StartSearchJobs(context.Background())
+	if err != nil {
+		return nil, errors.Wrap(err, "Could not start search jobs runner")
+	}
+
+	// Purge trashed files in background of store process
+	fileService.StartPurger(context.Background())
+
 	// Initialize API Modules
 	fileModule, err := files.NewFileModule(fileService, fileRepo, false)
 	if err != nil {
@@ -19,9 +19,31 @@ func (u *Files) RegisterRoutes(v1 *gin.RouterGroup) {
 	fmt.Println("registering file related endpoints to backend server")
 	files := v1.Group("/file")
 
-	files.GET("", u.getFile())
 	files.POST("", u.saveFiles())
+	files.GET("/search", u.searchFiles())
 	files.POST("/search", u.searchGoogle())
+	files.GET("/search/text", u.searchFilesText())
+	files.GET("/search/jobs", u.getSearchJobList())
+	files.GET("/search/jobs/:id", u.getSearchJob())
+	files.GET("/trash", u.getTrash())
+	files.GET("/:id", u.getFile())
+	files.HEAD("/:id", u.headFile())
+	files.DELETE("/:id", u.deleteFile())
+	files.POST("/:id/restore", u.restoreFile())
+	files.GET("/:id/meta", u.getFileMeta())
+	files.POST("/:id/shares", u.shareFile())
+	files.GET("/:id/shares", u.getShareList())
+	files.DELETE("/:id/shares/:shareId", u.deleteShare())
+	files.POST("/:id/links", u.createShareLink())
+	files.PUT("/:id/tags", u.addFileTags())
+	files.DELETE("/:id/tags", u.removeFileTags())
+
+	tags := v1.Group("/tags")
+	tags.GET("", u.getTagList())
+
+	// Public share links are verified by their signature instead of a logged in user
+	shares := v1.Group("/share")
+	shares.GET("/:token", u.getSharedFile())
 }
 
 func (u *Files) searchGoogle() gin.HandlerFunc {
@@ -30,12 +52,114 @@ func (u *Files) searchGoogle() gin.HandlerFunc {
 	}
 }
 
+func (u *Files) getSearchJobList() gin.HandlerFunc {
+	return func(ctx *gin.Context) {
+		u.service.GetSearchJobList(ctx, false)
+	}
+}
+
+func (u *Files) getSearchJob() gin.HandlerFunc {
+	return func(ctx *gin.Context) {
+		u.service.GetSearchJob(ctx, false)
+	}
+}
+
 func (u *Files) getFile() gin.HandlerFunc {
 	return func(ctx *gin.Context) {
 		u.service.GetFile(ctx, false)
 	}
 }
 
+func (u *Files) headFile() gin.HandlerFunc {
+	return func(ctx *gin.Context) {
+		u.service.HeadFile(ctx, false)
+	}
+}
+
+func (u *Files) deleteFile() gin.HandlerFunc {
+	return func(ctx *gin.Context) {
+		u.service.DeleteFile(ctx, false)
+	}
+}
+
+func (u *Files) getTrash() gin.HandlerFunc {
+	return func(ctx *gin.Context) {
+		u.service.GetTrash(ctx, false)
+	}
+}
+
+func (u *Files) restoreFile() gin.HandlerFunc {
+	return func(ctx *gin.Context) {
+		u.service.RestoreFile(ctx, false)
+	}
+}
+
+func (u *Files) searchFiles() gin.HandlerFunc {
+	return func(ctx *gin.Context) {
+		u.service.SearchFiles(ctx, false)
+	}
+}
+
+func (u *Files) searchFilesText() gin.HandlerFunc {
+	return func(ctx *gin.Context) {
+		u.service.SearchFilesText(ctx, false)
+	}
+}
+
+func (u *Files) getFileMeta() gin.HandlerFunc {
+	return func(ctx *gin.Context) {
+		u.service.GetFileMeta(ctx, false)
+	}
+}
+
+func (u *Files) shareFile() gin.HandlerFunc {
+	return func(ctx *gin.Context) {
+		u.service.ShareFile(ctx, false)
+	}
+}
+
+func (u *Files) getShareList() gin.HandlerFunc {
+	return func(ctx *gin.Context) {
+		u.service.GetShareList(ctx, false)
+	}
+}
+
+func (u *Files) deleteShare() gin.HandlerFunc {
+	return func(ctx *gin.Context) {
+		u.service.DeleteShare(ctx, false)
+	}
+}
+
+func (u *Files) createShareLink() gin.HandlerFunc {
+	return func(ctx *gin.Context) {
+		u.service.CreateShareLink(ctx, false)
+	}
+}
+
+func (u *Files) getSharedFile() gin.HandlerFunc {
+	return func(ctx *gin.Context) {
+		u.service.GetSharedFile(ctx, false)
+	}
+}
+
+func (u *Files) addFileTags() gin.HandlerFunc {
+	return func(ctx *gin.Context) {
+		u.service.AddFileTags(ctx, false)
+	}
+}
+
+func (u *Files) removeFileTags() gin.HandlerFunc {
+	return func(ctx *gin.Context) {
+		u.service.RemoveFileTags(ctx, false)
+	}
+}
+
+func (u *Files) getTagList() gin.HandlerFunc {
+	return func(ctx *gin.Context) {
+		u.service.GetTagList(ctx, false)
+	}
+}
+
 func (u *Files) saveFiles() gin.HandlerFunc {
 	return func(ctx *gin.Context) {
 		u.service.SaveFiles(ctx, false)
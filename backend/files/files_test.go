@@ -1,18 +1,39 @@
 package files
 
 import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"image"
+	"image/color"
+	"image/jpeg"
+	"image/png"
+	"mime/multipart"
 	"net/http"
 	"net/http/httptest"
+	"net/textproto"
+	"net/url"
+	"os"
+	"path/filepath"
+	"strconv"
+	"strings"
 	"testing"
+	"time"
 
 	"github.com/gin-gonic/gin"
 	"github.com/golang/mock/gomock"
 	"github.com/lebleuciel/maani/models"
+	"github.com/lebleuciel/maani/pkg/blobstore"
+	"github.com/lebleuciel/maani/pkg/database"
 	mock_database "github.com/lebleuciel/maani/pkg/database/mocks"
+	"github.com/lebleuciel/maani/pkg/encryption"
+	"github.com/lebleuciel/maani/pkg/filequery"
+	"github.com/lebleuciel/maani/pkg/helpers"
 	"github.com/lebleuciel/maani/pkg/repository/file"
 	fileservice "github.com/lebleuciel/maani/pkg/services/file"
 	"github.com/lebleuciel/maani/pkg/settings"
 	"github.com/stretchr/testify/assert"
+	"golang.org/x/image/webp"
 )
 
 // initFilesModuleWithMockDB function tests creating a new ForwarderModule and mockDatabase and returns instance of both
@@ -20,7 +41,13 @@ func initFilesModuleWithMockDB(t *testing.T, authEnabled bool) (*Files, *mock_da
 	ctrl := gomock.NewController(t)
 	defer ctrl.Finish()
 	var st settings.Settings
+	st.BackendServer.EncryptKey = "0123456789abcdef"
 	st.BackendServer.FilePath = "\tmp"
+	st.GatewayServer.UserIdHeaderKey = "X-MAANI-USER"
+	st.GatewayServer.UserAccessHeaderKey = "X-MAANI-ACCESS"
+	st.GatewayServer.ShareLinkSecretKey = "link-secret"
+	st.GatewayServer.ShareLinkTimeout = time.Hour
+	st.GatewayServer.ShareLinkMaxTimeout = 24 * time.Hour
 	db := mock_database.NewMockDatabase(ctrl)
 	fileRepo, err := file.NewFileRepository(st, db)
 	assert.Nil(t, err)
@@ -40,6 +67,13 @@ func initFileModuleWithMockTransactoin(t *testing.T) *mock_database.MockTransact
 	return tx
 }
 
+// userRequest returns a request gateway forwards for customer with userId
+func userRequest(method string, target string, userId int) *http.Request {
+	req := httptest.NewRequest(method, target, nil)
+	req.Header.Set("X-MAANI-USER", strconv.Itoa(userId))
+	return req
+}
+
 func TestNewFilesModule(t *testing.T) {
 	ctrl := gomock.NewController(t)
 	defer ctrl.Finish()
@@ -50,6 +84,7 @@ func TestNewFilesModule(t *testing.T) {
 	})
 	t.Run("nil_file_repo", func(t *testing.T) {
 		var st settings.Settings
+		st.BackendServer.EncryptKey = "0123456789abcdef"
 		db := mock_database.NewMockDatabase(ctrl)
 		fileRepo, err := file.NewFileRepository(st, db)
 		assert.Nil(t, err)
@@ -61,6 +96,7 @@ func TestNewFilesModule(t *testing.T) {
 	})
 	t.Run("valid", func(t *testing.T) {
 		var st settings.Settings
+		st.BackendServer.EncryptKey = "0123456789abcdef"
 		db := mock_database.NewMockDatabase(ctrl)
 		fileRepo, err := file.NewFileRepository(st, db)
 		assert.Nil(t, err)
@@ -80,19 +116,111 @@ func TestFiles_RegisterRoutes(t *testing.T) {
 
 	tx := initFileModuleWithMockTransactoin(t)
 	db.EXPECT().NewSerializableTransaction(gomock.Any()).Return(tx, nil).AnyTimes()
-	tx.EXPECT().GetFile(gomock.Any(), gomock.Any()).Return(models.File{}, nil).AnyTimes()
+	tx.EXPECT().GetFileByUUID(database.OwnerScope(7), "missing").Return(models.File{}, database.ErrFileNotFound).AnyTimes()
+	tx.EXPECT().Rollback().Return(nil).AnyTimes()
 	tx.EXPECT().Commit().Return(nil).AnyTimes()
+	db.EXPECT().CreateSearchJob(gomock.Any()).DoAndReturn(func(job models.SearchJob) (models.SearchJob, error) {
+		job.Id = 1
+		job.Status = models.SearchJobPending
+		return job, nil
+	}).AnyTimes()
+	db.EXPECT().GetSearchJob(1).Return(models.SearchJob{Id: 1, UserId: 7, Status: models.SearchJobRunning}, nil).AnyTimes()
+	db.EXPECT().GetSearchJob(2).Return(models.SearchJob{}, database.ErrSearchJobNotFound).AnyTimes()
+	db.EXPECT().GetSearchJobList(database.OwnerScope(7)).Return([]models.SearchJob{{Id: 1, UserId: 7}}, nil).AnyTimes()
+	db.EXPECT().GetSearchJobList(database.AllScope()).Return([]models.SearchJob{{Id: 1, UserId: 7}, {Id: 3, UserId: 8}}, nil).AnyTimes()
 
 	v1 := engine.Group("/api")
 	fileMod.RegisterRoutes(v1)
 
-	t.Run("get_file", func(t *testing.T) {
+	t.Run("get_missing_file", func(t *testing.T) {
+		req := userRequest("GET", "https://store.foo/api/file/missing", 7)
+		recorder := httptest.NewRecorder()
+
+		engine.ServeHTTP(recorder, req)
+		assert.Equal(t, http.StatusNotFound, recorder.Code)
+	})
+	t.Run("get_file_without_id", func(t *testing.T) {
 		req := httptest.NewRequest("GET", "https://store.foo/api/file", nil)
 		recorder := httptest.NewRecorder()
 
 		engine.ServeHTTP(recorder, req)
-		assert.NotEqual(t, http.StatusNotFound, recorder.Code)
-		assert.Equal(t, http.StatusInternalServerError, recorder.Code)
+		assert.Equal(t, http.StatusNotFound, recorder.Code)
+	})
+	t.Run("search_without_query", func(t *testing.T) {
+		req := httptest.NewRequest("POST", "https://store.foo/api/file/search?maxnum=2", nil)
+		req.Header.Set("X-MAANI-USER", "7")
+		recorder := httptest.NewRecorder()
+
+		engine.ServeHTTP(recorder, req)
+		assert.Equal(t, http.StatusBadRequest, recorder.Code)
+	})
+	t.Run("search_creates_job", func(t *testing.T) {
+		req := httptest.NewRequest("POST", "https://store.foo/api/file/search?q=cats&maxnum=2", nil)
+		req.Header.Set("X-MAANI-USER", "7")
+		recorder := httptest.NewRecorder()
+
+		engine.ServeHTTP(recorder, req)
+		assert.Equal(t, http.StatusAccepted, recorder.Code)
+		var job models.SearchJob
+		assert.Nil(t, json.Unmarshal(recorder.Body.Bytes(), &job))
+		assert.Equal(t, 1, job.Id)
+		assert.Equal(t, "cats", job.Query)
+		assert.Equal(t, 2, job.MaxResults)
+		assert.Equal(t, models.SearchJobPending, job.Status)
+	})
+	t.Run("get_search_job", func(t *testing.T) {
+		req := httptest.NewRequest("GET", "https://store.foo/api/file/search/jobs/1", nil)
+		req.Header.Set("X-MAANI-USER", "7")
+		recorder := httptest.NewRecorder()
+
+		engine.ServeHTTP(recorder, req)
+		assert.Equal(t, http.StatusOK, recorder.Code)
+	})
+	t.Run("get_search_job_of_other_user", func(t *testing.T) {
+		req := httptest.NewRequest("GET", "https://store.foo/api/file/search/jobs/1", nil)
+		req.Header.Set("X-MAANI-USER", "8")
+		recorder := httptest.NewRecorder()
+
+		engine.ServeHTTP(recorder, req)
+		assert.Equal(t, http.StatusNotFound, recorder.Code)
+	})
+	t.Run("get_missing_search_job", func(t *testing.T) {
+		req := httptest.NewRequest("GET", "https://store.foo/api/file/search/jobs/2", nil)
+		req.Header.Set("X-MAANI-USER", "7")
+		recorder := httptest.NewRecorder()
+
+		engine.ServeHTTP(recorder, req)
+		assert.Equal(t, http.StatusNotFound, recorder.Code)
+	})
+	t.Run("list_search_jobs", func(t *testing.T) {
+		req := httptest.NewRequest("GET", "https://store.foo/api/file/search/jobs", nil)
+		req.Header.Set("X-MAANI-USER", "7")
+		recorder := httptest.NewRecorder()
+
+		engine.ServeHTTP(recorder, req)
+		assert.Equal(t, http.StatusOK, recorder.Code)
+		var jobs []models.SearchJob
+		assert.Nil(t, json.Unmarshal(recorder.Body.Bytes(), &jobs))
+		assert.Len(t, jobs, 1)
+	})
+	t.Run("list_search_jobs_of_every_user", func(t *testing.T) {
+		req := httptest.NewRequest("GET", "https://store.foo/api/file/search/jobs", nil)
+		req.Header.Set("X-MAANI-USER", "1")
+		req.Header.Set("X-MAANI-ACCESS", models.AdminType)
+		recorder := httptest.NewRecorder()
+
+		engine.ServeHTTP(recorder, req)
+		assert.Equal(t, http.StatusOK, recorder.Code)
+		var jobs []models.SearchJob
+		assert.Nil(t, json.Unmarshal(recorder.Body.Bytes(), &jobs))
+		assert.Len(t, jobs, 2)
+	})
+	t.Run("list_search_jobs_without_user", func(t *testing.T) {
+		req := httptest.NewRequest("GET", "https://store.foo/api/file/search/jobs", nil)
+		recorder := httptest.NewRecorder()
+
+		engine.ServeHTTP(recorder, req)
+		assert.Equal(t, http.StatusBadRequest, recorder.Code)
 	})
 	t.Run("save_file", func(t *testing.T) {
 		req := httptest.NewRequest("POST", "https://store.foo/api/file", nil)
@@ -103,3 +231,828 @@ func TestFiles_RegisterRoutes(t *testing.T) {
 		assert.Equal(t, http.StatusInternalServerError, recorder.Code)
 	})
 }
+
+// TestFiles_GetFile tests downloads keep files unless they are burn after read
+func TestFiles_GetFile(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	var st settings.Settings
+	st.BackendServer.FilePath = t.TempDir()
+	st.BackendServer.EncryptKey = "0123456789abcdef"
+	st.GatewayServer.UserIdHeaderKey = "X-MAANI-USER"
+	db := mock_database.NewMockDatabase(ctrl)
+	db.EXPECT().LockChecksum(gomock.Any()).Return(func() {}, nil).AnyTimes()
+	tx := mock_database.NewMockTransaction(ctrl)
+	fileRepo, err := file.NewFileRepository(st, db)
+	assert.Nil(t, err)
+	fileService, err := fileservice.NewFileService(fileRepo, st, db)
+	assert.Nil(t, err)
+	fileMod, err := NewFileModule(fileService, fileRepo, false)
+	assert.Nil(t, err)
+
+	_, engine := gin.CreateTestContext(httptest.NewRecorder())
+	fileMod.RegisterRoutes(engine.Group("/api"))
+
+	keyring, err := encryption.KeyringFromSettings(st)
+	assert.Nil(t, err)
+	dek, err := encryption.GenerateDataKey()
+	assert.Nil(t, err)
+	wrapped, keyId, err := encryption.WrapDataKey(keyring, dek)
+	assert.Nil(t, err)
+	store, err := blobstore.NewLocalStore(st.BackendServer.FilePath)
+	assert.Nil(t, err)
+	checksum := helpers.Checksum([]byte("plain content"))
+	assert.Nil(t, helpers.SaveEncryptedFile(context.Background(), store, helpers.BlobKey(checksum), []byte("plain content"), dek))
+	blobPath := filepath.Join(st.BackendServer.FilePath, filepath.FromSlash(helpers.BlobKey(checksum)))
+	stored := models.File{Name: "note.txt", UUID: "note", TypeId: "text/plain", KeyId: keyId, WrappedKey: wrapped, SHA256: checksum}
+
+	db.EXPECT().NewSerializableTransaction(gomock.Any()).Return(tx, nil).AnyTimes()
+	tx.EXPECT().Commit().Return(nil).AnyTimes()
+
+	t.Run("repeatable_download", func(t *testing.T) {
+		tx.EXPECT().GetFileByUUID(database.OwnerScope(7), "note").Return(stored, nil).Times(2)
+		for i := 0; i < 2; i++ {
+			recorder := httptest.NewRecorder()
+			engine.ServeHTTP(recorder, userRequest("GET", "https://store.foo/api/file/note", 7))
+			assert.Equal(t, http.StatusOK, recorder.Code)
+			assert.Equal(t, "plain content", recorder.Body.String())
+			assert.Equal(t, `"`+checksum+`"`, recorder.Header().Get("ETag"))
+			assert.Equal(t, "sha-256=0PSnL1s7hQiCFllmhTV0P+aS4hUW3MJjlYiMmtQAMic=", recorder.Header().Get("Digest"))
+		}
+		_, err := os.Stat(blobPath)
+		assert.Nil(t, err)
+	})
+	t.Run("corrupted_download", func(t *testing.T) {
+		corrupted := stored
+		corrupted.SHA256 = helpers.Checksum([]byte("other content"))
+		assert.Nil(t, helpers.SaveEncryptedFile(context.Background(), store, helpers.BlobKey(corrupted.SHA256), []byte("plain content"), dek))
+		tx.EXPECT().GetFileByUUID(database.OwnerScope(7), "note").Return(corrupted, nil).Times(1)
+
+		recorder := httptest.NewRecorder()
+		engine.ServeHTTP(recorder, userRequest("GET", "https://store.foo/api/file/note", 7))
+		assert.Less(t, recorder.Body.Len(), len("plain content"))
+	})
+	t.Run("burn_after_read_shared_blob", func(t *testing.T) {
+		burning := stored
+		burning.BurnAfterRead = true
+		tx.EXPECT().GetFileByUUID(database.OwnerScope(7), "note").Return(burning, nil).Times(1)
+		tx.EXPECT().DeleteFile(database.AllScope(), "note").Return(nil).Times(1)
+		db.EXPECT().CountFilesByChecksum(checksum).Return(1, nil).Times(1)
+
+		recorder := httptest.NewRecorder()
+		engine.ServeHTTP(recorder, userRequest("GET", "https://store.foo/api/file/note", 7))
+		assert.Equal(t, http.StatusOK, recorder.Code)
+		_, err := os.Stat(blobPath)
+		assert.Nil(t, err)
+	})
+	t.Run("burn_after_read", func(t *testing.T) {
+		burning := stored
+		burning.BurnAfterRead = true
+		tx.EXPECT().GetFileByUUID(database.OwnerScope(7), "note").Return(burning, nil).Times(1)
+		tx.EXPECT().DeleteFile(database.AllScope(), "note").Return(nil).Times(1)
+		db.EXPECT().CountFilesByChecksum(checksum).Return(0, nil).Times(1)
+
+		recorder := httptest.NewRecorder()
+		engine.ServeHTTP(recorder, userRequest("GET", "https://store.foo/api/file/note", 7))
+		assert.Equal(t, http.StatusOK, recorder.Code)
+		assert.Equal(t, "plain content", recorder.Body.String())
+		_, err := os.Stat(blobPath)
+		assert.True(t, os.IsNotExist(err))
+	})
+}
+
+// TestFiles_GetFileRendition tests renditions are generated on first request and served from store afterwards
+func TestFiles_GetFileRendition(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	var st settings.Settings
+	st.BackendServer.FilePath = t.TempDir()
+	st.BackendServer.EncryptKey = "0123456789abcdef"
+	st.GatewayServer.UserIdHeaderKey = "X-MAANI-USER"
+	st.BackendServer.Renditions = map[string]settings.Rendition{
+		"thumb": {Width: 64, Height: 64, Mode: settings.RenditionFit},
+	}
+	db := mock_database.NewMockDatabase(ctrl)
+	db.EXPECT().LockChecksum(gomock.Any()).Return(func() {}, nil).AnyTimes()
+	tx := mock_database.NewMockTransaction(ctrl)
+	fileRepo, err := file.NewFileRepository(st, db)
+	assert.Nil(t, err)
+	fileService, err := fileservice.NewFileService(fileRepo, st, db)
+	assert.Nil(t, err)
+	fileMod, err := NewFileModule(fileService, fileRepo, false)
+	assert.Nil(t, err)
+
+	_, engine := gin.CreateTestContext(httptest.NewRecorder())
+	fileMod.RegisterRoutes(engine.Group("/api"))
+
+	var original bytes.Buffer
+	assert.Nil(t, jpeg.Encode(&original, image.NewRGBA(image.Rect(0, 0, 200, 100)), nil))
+	keyring, err := encryption.KeyringFromSettings(st)
+	assert.Nil(t, err)
+	dek, err := encryption.GenerateDataKey()
+	assert.Nil(t, err)
+	wrapped, keyId, err := encryption.WrapDataKey(keyring, dek)
+	assert.Nil(t, err)
+	store, err := blobstore.NewLocalStore(st.BackendServer.FilePath)
+	assert.Nil(t, err)
+	checksum := helpers.Checksum(original.Bytes())
+	assert.Nil(t, helpers.SaveEncryptedFile(context.Background(), store, helpers.BlobKey(checksum), original.Bytes(), dek))
+	stored := models.File{Name: "photo.jpg", UUID: "photo", TypeId: "image/jpeg", KeyId: keyId, WrappedKey: wrapped, SHA256: checksum}
+
+	db.EXPECT().NewSerializableTransaction(gomock.Any()).Return(tx, nil).AnyTimes()
+	tx.EXPECT().Commit().Return(nil).AnyTimes()
+
+	t.Run("generated_once", func(t *testing.T) {
+		tx.EXPECT().GetFileByUUID(database.OwnerScope(7), "photo").Return(stored, nil).Times(2)
+		for i := 0; i < 2; i++ {
+			recorder := httptest.NewRecorder()
+			engine.ServeHTTP(recorder, userRequest("GET", "https://store.foo/api/file/photo?rendition=thumb", 7))
+			assert.Equal(t, http.StatusOK, recorder.Code)
+			assert.Equal(t, "image/jpeg", recorder.Header().Get("Content-Type"))
+			thumb, err := jpeg.Decode(recorder.Body)
+			assert.Nil(t, err)
+			assert.Equal(t, image.Rect(0, 0, 64, 32), thumb.Bounds())
+
+			renditions, err := store.List(context.Background(), "renditions/")
+			assert.Nil(t, err)
+			assert.Len(t, renditions, 1)
+		}
+	})
+	t.Run("original", func(t *testing.T) {
+		tx.EXPECT().GetFileByUUID(database.OwnerScope(7), "photo").Return(stored, nil).Times(1)
+		recorder := httptest.NewRecorder()
+		engine.ServeHTTP(recorder, userRequest("GET", "https://store.foo/api/file/photo", 7))
+		assert.Equal(t, http.StatusOK, recorder.Code)
+		assert.Equal(t, original.Bytes(), recorder.Body.Bytes())
+	})
+	t.Run("unknown_rendition", func(t *testing.T) {
+		recorder := httptest.NewRecorder()
+		engine.ServeHTTP(recorder, userRequest("GET", "https://store.foo/api/file/photo?rendition=huge", 7))
+		assert.Equal(t, http.StatusBadRequest, recorder.Code)
+	})
+	t.Run("missing_file", func(t *testing.T) {
+		tx.EXPECT().GetFileByUUID(database.OwnerScope(7), "missing").Return(models.File{}, database.ErrFileNotFound).Times(1)
+		tx.EXPECT().Rollback().Return(nil).Times(1)
+		recorder := httptest.NewRecorder()
+		engine.ServeHTTP(recorder, userRequest("GET", "https://store.foo/api/file/missing", 7))
+		assert.Equal(t, http.StatusNotFound, recorder.Code)
+	})
+}
+
+// TestFiles_NonImageFile tests files of any allowed type are stored verbatim and sent back with their content type
+func TestFiles_NonImageFile(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	var st settings.Settings
+	st.BackendServer.FilePath = t.TempDir()
+	st.BackendServer.EncryptKey = "0123456789abcdef"
+	st.BackendServer.MaxFilesSizeByte = 1 << 20
+	st.BackendServer.Renditions = map[string]settings.Rendition{
+		"thumb": {Width: 64, Height: 64, Mode: settings.RenditionFit, Eager: true},
+	}
+	st.GatewayServer.UserIdHeaderKey = "X-MAANI-USER"
+	db := mock_database.NewMockDatabase(ctrl)
+	db.EXPECT().LockChecksum(gomock.Any()).Return(func() {}, nil).AnyTimes()
+	tx := mock_database.NewMockTransaction(ctrl)
+	fileRepo, err := file.NewFileRepository(st, db)
+	assert.Nil(t, err)
+	fileService, err := fileservice.NewFileService(fileRepo, st, db)
+	assert.Nil(t, err)
+	fileMod, err := NewFileModule(fileService, fileRepo, false)
+	assert.Nil(t, err)
+
+	_, engine := gin.CreateTestContext(httptest.NewRecorder())
+	fileMod.RegisterRoutes(engine.Group("/api"))
+
+	content := []byte("%PDF-1.4 not an image")
+	var saved models.File
+	db.EXPECT().AddFileTypeIfNotExist("application/pdf").Return(nil)
+	db.EXPECT().GetFileTypes().Return([]models.FileType{{Name: "application/pdf", AllowedSize: 1 << 20}}, nil)
+	db.EXPECT().GetFilesSize().Return(0, nil)
+	db.EXPECT().GetFileByChecksum(helpers.Checksum(content)).Return(nil, nil)
+	db.EXPECT().SaveFile(gomock.Any()).DoAndReturn(func(file models.File) error {
+		saved = file
+		return nil
+	})
+
+	var body bytes.Buffer
+	form := multipart.NewWriter(&body)
+	header := make(textproto.MIMEHeader)
+	header.Set("Content-Disposition", `form-data; name="files"; filename="report 2024.pdf"`)
+	header.Set("Content-Type", "application/PDF; version=1.4")
+	part, err := form.CreatePart(header)
+	assert.Nil(t, err)
+	_, err = part.Write(content)
+	assert.Nil(t, err)
+	assert.Nil(t, form.Close())
+
+	req := httptest.NewRequest("POST", "https://store.foo/api/file", &body)
+	req.Header.Set("Content-Type", form.FormDataContentType())
+	req.Header.Set("X-MAANI-USER", "7")
+	recorder := httptest.NewRecorder()
+	engine.ServeHTTP(recorder, req)
+	assert.Equal(t, http.StatusOK, recorder.Code)
+	assert.Equal(t, "application/pdf", saved.TypeId)
+	assert.Nil(t, saved.PHash)
+
+	// Neither pipeline output nor renditions are stored for other files
+	store, err := blobstore.NewLocalStore(st.BackendServer.FilePath)
+	assert.Nil(t, err)
+	blobs, err := store.List(context.Background(), "")
+	assert.Nil(t, err)
+	assert.Len(t, blobs, 1)
+
+	db.EXPECT().NewSerializableTransaction(gomock.Any()).Return(tx, nil).AnyTimes()
+	tx.EXPECT().Commit().Return(nil).AnyTimes()
+	tx.EXPECT().GetFileByUUID(database.OwnerScope(7), saved.UUID).Return(saved, nil).Times(2)
+
+	recorder = httptest.NewRecorder()
+	engine.ServeHTTP(recorder, userRequest("GET", "https://store.foo/api/file/"+saved.UUID, 7))
+	assert.Equal(t, http.StatusOK, recorder.Code)
+	assert.Equal(t, content, recorder.Body.Bytes())
+	assert.Equal(t, "application/pdf", recorder.Header().Get("Content-Type"))
+	assert.Equal(t, `attachment; filename="report 2024.pdf"`, recorder.Header().Get("Content-Disposition"))
+
+	tx.EXPECT().Rollback().Return(nil).Times(1)
+	recorder = httptest.NewRecorder()
+	engine.ServeHTTP(recorder, userRequest("GET", "https://store.foo/api/file/"+saved.UUID+"?rendition=thumb", 7))
+	assert.Equal(t, http.StatusBadRequest, recorder.Code)
+}
+
+// TestFiles_ImageFormat tests images keep the format of their content and renditions are negotiated by Accept header
+func TestFiles_ImageFormat(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	var st settings.Settings
+	st.BackendServer.FilePath = t.TempDir()
+	st.BackendServer.EncryptKey = "0123456789abcdef"
+	st.BackendServer.MaxFilesSizeByte = 1 << 20
+	st.BackendServer.DedupDistance = -1
+	st.BackendServer.Renditions = map[string]settings.Rendition{
+		"thumb": {Width: 64, Height: 64, Mode: settings.RenditionFit, Eager: true},
+	}
+	st.GatewayServer.UserIdHeaderKey = "X-MAANI-USER"
+	db := mock_database.NewMockDatabase(ctrl)
+	db.EXPECT().LockChecksum(gomock.Any()).Return(func() {}, nil).AnyTimes()
+	tx := mock_database.NewMockTransaction(ctrl)
+	fileRepo, err := file.NewFileRepository(st, db)
+	assert.Nil(t, err)
+	fileService, err := fileservice.NewFileService(fileRepo, st, db)
+	assert.Nil(t, err)
+	fileMod, err := NewFileModule(fileService, fileRepo, false)
+	assert.Nil(t, err)
+
+	_, engine := gin.CreateTestContext(httptest.NewRecorder())
+	fileMod.RegisterRoutes(engine.Group("/api"))
+
+	// Transparent image which client claims to be a JPEG
+	img := image.NewNRGBA(image.Rect(0, 0, 200, 100))
+	img.Set(10, 10, color.NRGBA{R: 255, A: 128})
+	var content bytes.Buffer
+	assert.Nil(t, png.Encode(&content, img))
+
+	var saved models.File
+	db.EXPECT().AddFileTypeIfNotExist("image/png").Return(nil)
+	db.EXPECT().GetFileTypes().Return([]models.FileType{{Name: "image/png", AllowedSize: 1 << 20}}, nil)
+	db.EXPECT().GetFilesSize().Return(0, nil)
+	db.EXPECT().GetFileByChecksum(helpers.Checksum(content.Bytes())).Return(nil, nil)
+	db.EXPECT().SaveFile(gomock.Any()).DoAndReturn(func(file models.File) error {
+		saved = file
+		return nil
+	})
+
+	var body bytes.Buffer
+	form := multipart.NewWriter(&body)
+	header := make(textproto.MIMEHeader)
+	header.Set("Content-Disposition", `form-data; name="files"; filename="dot.jpg"`)
+	header.Set("Content-Type", "image/jpeg")
+	part, err := form.CreatePart(header)
+	assert.Nil(t, err)
+	_, err = part.Write(content.Bytes())
+	assert.Nil(t, err)
+	assert.Nil(t, form.Close())
+
+	req := httptest.NewRequest("POST", "https://store.foo/api/file", &body)
+	req.Header.Set("Content-Type", form.FormDataContentType())
+	req.Header.Set("X-MAANI-USER", "7")
+	recorder := httptest.NewRecorder()
+	engine.ServeHTTP(recorder, req)
+	assert.Equal(t, http.StatusOK, recorder.Code)
+	assert.Equal(t, "image/png", saved.TypeId)
+
+	db.EXPECT().NewSerializableTransaction(gomock.Any()).Return(tx, nil).AnyTimes()
+	tx.EXPECT().Commit().Return(nil).AnyTimes()
+	tx.EXPECT().Rollback().Return(nil).AnyTimes()
+	tx.EXPECT().GetFileByUUID(database.OwnerScope(7), saved.UUID).Return(saved, nil).AnyTimes()
+	getRendition := func(accept string) *httptest.ResponseRecorder {
+		req := userRequest("GET", "https://store.foo/api/file/"+saved.UUID+"?rendition=thumb", 7)
+		if accept != "" {
+			req.Header.Set("Accept", accept)
+		}
+		recorder := httptest.NewRecorder()
+		engine.ServeHTTP(recorder, req)
+		return recorder
+	}
+
+	t.Run("source_format", func(t *testing.T) {
+		recorder := getRendition("")
+		assert.Equal(t, http.StatusOK, recorder.Code)
+		assert.Equal(t, "image/png", recorder.Header().Get("Content-Type"))
+		assert.Equal(t, "Accept", recorder.Header().Get("Vary"))
+		thumb, err := png.Decode(recorder.Body)
+		assert.Nil(t, err)
+		_, _, _, alpha := thumb.At(3, 3).RGBA()
+		assert.Less(t, alpha, uint32(0xffff))
+	})
+	t.Run("accepted_format", func(t *testing.T) {
+		recorder := getRendition("image/avif, image/webp, image/*;q=0.8")
+		assert.Equal(t, http.StatusOK, recorder.Code)
+		assert.Equal(t, "image/webp", recorder.Header().Get("Content-Type"))
+		thumb, err := webp.Decode(recorder.Body)
+		assert.Nil(t, err)
+		assert.Equal(t, image.Rect(0, 0, 64, 32), thumb.Bounds())
+
+		// Each format is kept apart, next to the eager rendition
+		store, err := blobstore.NewLocalStore(st.BackendServer.FilePath)
+		assert.Nil(t, err)
+		renditions, err := store.List(context.Background(), "renditions/")
+		assert.Nil(t, err)
+		assert.Len(t, renditions, 2)
+	})
+	t.Run("not_acceptable", func(t *testing.T) {
+		recorder := getRendition("image/avif, image/png;q=0")
+		assert.Equal(t, http.StatusNotAcceptable, recorder.Code)
+	})
+	t.Run("original", func(t *testing.T) {
+		recorder := httptest.NewRecorder()
+		engine.ServeHTTP(recorder, userRequest("GET", "https://store.foo/api/file/"+saved.UUID, 7))
+		assert.Equal(t, http.StatusOK, recorder.Code)
+		assert.Equal(t, "image/png", recorder.Header().Get("Content-Type"))
+		assert.Equal(t, content.Bytes(), recorder.Body.Bytes())
+	})
+}
+
+// TestFiles_GetFileMeta tests metadata of a file is sent without its key material
+func TestFiles_GetFileMeta(t *testing.T) {
+	fileMod, db := initFilesModuleWithMockDB(t, true)
+	_, engine := gin.CreateTestContext(httptest.NewRecorder())
+	fileMod.RegisterRoutes(engine.Group("/api"))
+
+	createdAt := time.Date(2024, 3, 4, 5, 6, 7, 0, time.UTC)
+	db.EXPECT().GetFileByUUID(database.OwnerScope(7), "photo").Return(models.File{
+		Name:          "cat.png",
+		UUID:          "photo",
+		Size:          2048,
+		TypeId:        "image/png",
+		UserId:        7,
+		Tags:          []string{"cats"},
+		WrappedKey:    []byte("wrapped"),
+		Width:         640,
+		Height:        480,
+		DominantColor: "#2040c0",
+		SourceURL:     "https://images.foo/cat.png",
+		SearchQuery:   "cats",
+		CreatedAt:     &createdAt,
+	}, nil)
+	db.EXPECT().GetFileByUUID(database.OwnerScope(7), "missing").Return(models.File{}, database.ErrFileNotFound)
+
+	recorder := httptest.NewRecorder()
+	engine.ServeHTTP(recorder, userRequest("GET", "https://store.foo/api/file/photo/meta", 7))
+	assert.Equal(t, http.StatusOK, recorder.Code)
+	assert.JSONEq(t, `{
+		"id": "photo",
+		"name": "cat.png",
+		"size": 2048,
+		"type": "image/png",
+		"userId": 7,
+		"tags": ["cats"],
+		"burnAfterRead": false,
+		"width": 640,
+		"height": 480,
+		"dominantColor": "#2040c0",
+		"sourceUrl": "https://images.foo/cat.png",
+		"searchQuery": "cats",
+		"createdAt": "2024-03-04T05:06:07Z"
+	}`, recorder.Body.String())
+
+	recorder = httptest.NewRecorder()
+	engine.ServeHTTP(recorder, userRequest("GET", "https://store.foo/api/file/missing/meta", 7))
+	assert.Equal(t, http.StatusNotFound, recorder.Code)
+}
+
+// TestFiles_HeadFile tests headers of a file are sent without reading its content
+func TestFiles_HeadFile(t *testing.T) {
+	fileMod, db := initFilesModuleWithMockDB(t, true)
+	_, engine := gin.CreateTestContext(httptest.NewRecorder())
+	fileMod.RegisterRoutes(engine.Group("/api"))
+
+	checksum := helpers.Checksum([]byte("plain content"))
+	db.EXPECT().GetFileByUUID(database.OwnerScope(7), "note").Return(models.File{
+		Name:   "note.txt",
+		UUID:   "note",
+		Size:   13,
+		TypeId: "text/plain",
+		SHA256: checksum,
+	}, nil)
+	db.EXPECT().GetFileByUUID(database.OwnerScope(7), "missing").Return(models.File{}, database.ErrFileNotFound)
+
+	recorder := httptest.NewRecorder()
+	engine.ServeHTTP(recorder, userRequest("HEAD", "https://store.foo/api/file/note", 7))
+	assert.Equal(t, http.StatusOK, recorder.Code)
+	assert.Equal(t, "text/plain", recorder.Header().Get("Content-Type"))
+	assert.Equal(t, "13", recorder.Header().Get("Content-Length"))
+	assert.Equal(t, `"`+checksum+`"`, recorder.Header().Get("ETag"))
+	assert.Equal(t, helpers.ContentDisposition("note.txt"), recorder.Header().Get("Content-Disposition"))
+	assert.Zero(t, recorder.Body.Len())
+
+	recorder = httptest.NewRecorder()
+	engine.ServeHTTP(recorder, userRequest("HEAD", "https://store.foo/api/file/missing", 7))
+	assert.Equal(t, http.StatusNotFound, recorder.Code)
+}
+
+// TestFiles_DeleteFile tests files are moved to trash by id, keeping their blob until they are purged
+func TestFiles_DeleteFile(t *testing.T) {
+	var st settings.Settings
+	st.BackendServer.FilePath = t.TempDir()
+	st.BackendServer.EncryptKey = "0123456789abcdef"
+	st.GatewayServer.UserIdHeaderKey = "X-MAANI-USER"
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+	db := mock_database.NewMockDatabase(ctrl)
+	fileRepo, err := file.NewFileRepository(st, db)
+	assert.Nil(t, err)
+	fileService, err := fileservice.NewFileService(fileRepo, st, db)
+	assert.Nil(t, err)
+	fileMod, err := NewFileModule(fileService, fileRepo, false)
+	assert.Nil(t, err)
+	_, engine := gin.CreateTestContext(httptest.NewRecorder())
+	fileMod.RegisterRoutes(engine.Group("/api"))
+
+	store, err := blobstore.NewLocalStore(st.BackendServer.FilePath)
+	assert.Nil(t, err)
+	checksum := helpers.Checksum([]byte("plain content"))
+	assert.Nil(t, helpers.SaveEncryptedFile(context.Background(), store, helpers.BlobKey(checksum), []byte("plain content"), make([]byte, 32)))
+	blobPath := filepath.Join(st.BackendServer.FilePath, filepath.FromSlash(helpers.BlobKey(checksum)))
+
+	db.EXPECT().TrashFile(database.OwnerScope(7).ForWrite(), "note").Return(nil)
+	db.EXPECT().TrashFile(database.OwnerScope(7).ForWrite(), "missing").Return(database.ErrFileNotFound)
+
+	recorder := httptest.NewRecorder()
+	engine.ServeHTTP(recorder, userRequest("DELETE", "https://store.foo/api/file/note", 7))
+	assert.Equal(t, http.StatusNoContent, recorder.Code)
+	// Trashed files keep their blob until they are purged
+	_, err = os.Stat(blobPath)
+	assert.Nil(t, err)
+
+	recorder = httptest.NewRecorder()
+	engine.ServeHTTP(recorder, userRequest("DELETE", "https://store.foo/api/file/missing", 7))
+	assert.Equal(t, http.StatusNotFound, recorder.Code)
+}
+
+// TestFiles_Trash tests trashed files are listed and can be restored by their owner
+func TestFiles_Trash(t *testing.T) {
+	fileMod, db := initFilesModuleWithMockDB(t, true)
+	_, engine := gin.CreateTestContext(httptest.NewRecorder())
+	fileMod.RegisterRoutes(engine.Group("/api"))
+
+	deletedAt := time.Now().Add(-time.Hour)
+	db.EXPECT().GetTrashList(database.OwnerScope(7)).Return([]models.File{
+		{Name: "note.txt", UUID: "note", TypeId: "text/plain", DeletedAt: &deletedAt},
+	}, nil)
+	db.EXPECT().RestoreFile(database.OwnerScope(7).ForWrite(), "note").Return(nil)
+	db.EXPECT().RestoreFile(database.OwnerScope(7).ForWrite(), "missing").Return(database.ErrFileNotFound)
+
+	recorder := httptest.NewRecorder()
+	engine.ServeHTTP(recorder, userRequest("GET", "https://store.foo/api/file/trash", 7))
+	assert.Equal(t, http.StatusOK, recorder.Code)
+	var trashed []models.FileMetadata
+	assert.Nil(t, json.Unmarshal(recorder.Body.Bytes(), &trashed))
+	assert.Len(t, trashed, 1)
+	assert.Equal(t, "note", trashed[0].Id)
+	assert.NotNil(t, trashed[0].DeletedAt)
+
+	recorder = httptest.NewRecorder()
+	engine.ServeHTTP(recorder, userRequest("POST", "https://store.foo/api/file/note/restore", 7))
+	assert.Equal(t, http.StatusNoContent, recorder.Code)
+
+	recorder = httptest.NewRecorder()
+	engine.ServeHTTP(recorder, userRequest("POST", "https://store.foo/api/file/missing/restore", 7))
+	assert.Equal(t, http.StatusNotFound, recorder.Code)
+}
+
+// TestFiles_SearchFiles tests name and tag lookup returns all matching files and nothing else
+func TestFiles_SearchFiles(t *testing.T) {
+	fileMod, db := initFilesModuleWithMockDB(t, true)
+	_, engine := gin.CreateTestContext(httptest.NewRecorder())
+	fileMod.RegisterRoutes(engine.Group("/api"))
+
+	db.EXPECT().FindFiles(database.OwnerScope(7), []string{"cat.png"}, []string{"cats", "pets"}).Return([]models.File{
+		{Name: "cat.png", UUID: "first", TypeId: "image/png"},
+		{Name: "cat.png", UUID: "second", TypeId: "image/png"},
+	}, nil)
+	db.EXPECT().FindFiles(database.OwnerScope(7), []string{"dog.png"}, nil).Return(nil, nil)
+
+	recorder := httptest.NewRecorder()
+	engine.ServeHTTP(recorder, userRequest("GET", "https://store.foo/api/file/search?name=cat.png&tags=Cats,pets", 7))
+	assert.Equal(t, http.StatusOK, recorder.Code)
+	var found []models.FileMetadata
+	assert.Nil(t, json.Unmarshal(recorder.Body.Bytes(), &found))
+	assert.Len(t, found, 2)
+	assert.Equal(t, "first", found[0].Id)
+	assert.Equal(t, "second", found[1].Id)
+
+	recorder = httptest.NewRecorder()
+	engine.ServeHTTP(recorder, userRequest("GET", "https://store.foo/api/file/search?name=dog.png", 7))
+	assert.Equal(t, http.StatusOK, recorder.Code)
+	assert.Equal(t, "[]", recorder.Body.String())
+
+	recorder = httptest.NewRecorder()
+	engine.ServeHTTP(recorder, userRequest("GET", "https://store.foo/api/file/search", 7))
+	assert.Equal(t, http.StatusBadRequest, recorder.Code)
+}
+
+// TestFiles_Ownership tests customers only reach their own files while admins reach files of every user
+func TestFiles_Ownership(t *testing.T) {
+	fileMod, db := initFilesModuleWithMockDB(t, true)
+	_, engine := gin.CreateTestContext(httptest.NewRecorder())
+	fileMod.RegisterRoutes(engine.Group("/api"))
+
+	note := models.File{Name: "note.txt", UUID: "note", UserId: 7, TypeId: "text/plain"}
+	db.EXPECT().GetFileByUUID(database.OwnerScope(7), "note").Return(note, nil)
+	db.EXPECT().GetFileByUUID(database.OwnerScope(8), "note").Return(models.File{}, database.ErrFileNotFound)
+	db.EXPECT().GetFileByUUID(database.AllScope(), "note").Return(note, nil)
+
+	recorder := httptest.NewRecorder()
+	engine.ServeHTTP(recorder, userRequest("GET", "https://store.foo/api/file/note/meta", 7))
+	assert.Equal(t, http.StatusOK, recorder.Code)
+
+	recorder = httptest.NewRecorder()
+	engine.ServeHTTP(recorder, userRequest("GET", "https://store.foo/api/file/note/meta", 8))
+	assert.Equal(t, http.StatusNotFound, recorder.Code)
+
+	recorder = httptest.NewRecorder()
+	req := userRequest("GET", "https://store.foo/api/file/note/meta", 8)
+	req.Header.Set("X-MAANI-ACCESS", models.AdminType)
+	engine.ServeHTTP(recorder, req)
+	assert.Equal(t, http.StatusOK, recorder.Code)
+
+	recorder = httptest.NewRecorder()
+	engine.ServeHTTP(recorder, httptest.NewRequest("GET", "https://store.foo/api/file/note/meta", nil))
+	assert.Equal(t, http.StatusBadRequest, recorder.Code)
+}
+
+// TestFiles_Shares tests owners share files with users and public links while others can't
+func TestFiles_Shares(t *testing.T) {
+	fileMod, db := initFilesModuleWithMockDB(t, true)
+	_, engine := gin.CreateTestContext(httptest.NewRecorder())
+	fileMod.RegisterRoutes(engine.Group("/api"))
+
+	note := models.File{Name: "note.txt", UUID: "note", UserId: 7, TypeId: "text/plain"}
+	db.EXPECT().GetFileByUUID(database.OwnerScope(7), "note").Return(note, nil).AnyTimes()
+	db.EXPECT().GetFileByUUID(database.OwnerScope(8), "note").Return(note, nil).AnyTimes()
+
+	shareRequest := func(method string, target string, userId int, body string) *http.Request {
+		req := httptest.NewRequest(method, target, bytes.NewBufferString(body))
+		req.Header.Set("X-MAANI-USER", strconv.Itoa(userId))
+		req.Header.Set("Content-Type", "application/json")
+		return req
+	}
+
+	t.Run("share_with_user", func(t *testing.T) {
+		userId := 8
+		db.EXPECT().CreateShare(models.Share{FileId: "note", UserId: &userId, Permission: models.ShareWrite}).
+			Return(models.Share{Id: 1, FileId: "note", UserId: &userId, Permission: models.ShareWrite}, nil)
+
+		recorder := httptest.NewRecorder()
+		engine.ServeHTTP(recorder, shareRequest("POST", "https://store.foo/api/file/note/shares", 7, `{"userId": 8, "permission": "write"}`))
+		assert.Equal(t, http.StatusCreated, recorder.Code)
+	})
+	t.Run("invalid_share", func(t *testing.T) {
+		for _, body := range []string{`{"userId": 8, "permission": "admin"}`, `{"userId": 7}`, `{}`, `{"userId": 8, "expiresAt": "2001-01-01T00:00:00Z"}`} {
+			recorder := httptest.NewRecorder()
+			engine.ServeHTTP(recorder, shareRequest("POST", "https://store.foo/api/file/note/shares", 7, body))
+			assert.Equal(t, http.StatusBadRequest, recorder.Code, body)
+		}
+	})
+	t.Run("only_owner_shares", func(t *testing.T) {
+		recorder := httptest.NewRecorder()
+		engine.ServeHTTP(recorder, shareRequest("POST", "https://store.foo/api/file/note/shares", 8, `{"userId": 9}`))
+		assert.Equal(t, http.StatusForbidden, recorder.Code)
+
+		recorder = httptest.NewRecorder()
+		engine.ServeHTTP(recorder, shareRequest("POST", "https://store.foo/api/file/note/links", 8, `{}`))
+		assert.Equal(t, http.StatusForbidden, recorder.Code)
+	})
+	t.Run("delete_share", func(t *testing.T) {
+		db.EXPECT().DeleteShare("note", 1).Return(nil)
+		db.EXPECT().DeleteShare("note", 2).Return(database.ErrShareNotFound)
+
+		recorder := httptest.NewRecorder()
+		engine.ServeHTTP(recorder, shareRequest("DELETE", "https://store.foo/api/file/note/shares/1", 7, ""))
+		assert.Equal(t, http.StatusNoContent, recorder.Code)
+
+		recorder = httptest.NewRecorder()
+		engine.ServeHTTP(recorder, shareRequest("DELETE", "https://store.foo/api/file/note/shares/2", 7, ""))
+		assert.Equal(t, http.StatusNotFound, recorder.Code)
+	})
+	t.Run("share_link", func(t *testing.T) {
+		maxDownloads := 2
+		db.EXPECT().CreateShare(gomock.Any()).DoAndReturn(func(share models.Share) (models.Share, error) {
+			assert.Equal(t, "note", share.FileId)
+			assert.Nil(t, share.UserId)
+			assert.Equal(t, &maxDownloads, share.MaxDownloads)
+			assert.WithinDuration(t, time.Now().Add(2*time.Hour), *share.ExpiresAt, time.Minute)
+			share.Id = 3
+			return share, nil
+		})
+
+		recorder := httptest.NewRecorder()
+		engine.ServeHTTP(recorder, shareRequest("POST", "https://store.foo/api/file/note/links", 7, `{"expiresIn": "2h", "maxDownloads": 2}`))
+		assert.Equal(t, http.StatusCreated, recorder.Code)
+		var share models.Share
+		assert.Nil(t, json.Unmarshal(recorder.Body.Bytes(), &share))
+		assert.Contains(t, share.Link, "/api/share/")
+
+		tx := initFileModuleWithMockTransactoin(t)
+		db.EXPECT().UseShareLink(3).Return(models.Share{Id: 3, FileId: "note"}, nil)
+		db.EXPECT().NewSerializableTransaction(gomock.Any()).Return(tx, nil)
+		tx.EXPECT().GetFileByUUID(database.AllScope(), "note").Return(models.File{}, database.ErrFileNotFound)
+		tx.EXPECT().Rollback().Return(nil)
+		recorder = httptest.NewRecorder()
+		engine.ServeHTTP(recorder, httptest.NewRequest("GET", "https://store.foo"+share.Link, nil))
+		assert.Equal(t, http.StatusNotFound, recorder.Code)
+
+		db.EXPECT().UseShareLink(3).Return(models.Share{}, database.ErrShareUsedUp)
+		recorder = httptest.NewRecorder()
+		engine.ServeHTTP(recorder, httptest.NewRequest("GET", "https://store.foo"+share.Link, nil))
+		assert.Equal(t, http.StatusGone, recorder.Code)
+	})
+	t.Run("invalid_link", func(t *testing.T) {
+		for _, body := range []string{`{"expiresIn": "48h"}`, `{"expiresIn": "soon"}`, `{"maxDownloads": 0}`} {
+			recorder := httptest.NewRecorder()
+			engine.ServeHTTP(recorder, shareRequest("POST", "https://store.foo/api/file/note/links", 7, body))
+			assert.Equal(t, http.StatusBadRequest, recorder.Code, body)
+		}
+
+		recorder := httptest.NewRecorder()
+		engine.ServeHTTP(recorder, httptest.NewRequest("GET", "https://store.foo/api/share/3.1.forged", nil))
+		assert.Equal(t, http.StatusNotFound, recorder.Code)
+	})
+}
+
+// TestFiles_Tags tests tags of files are normalized when they are changed and listed with their counts
+func TestFiles_Tags(t *testing.T) {
+	fileMod, db := initFilesModuleWithMockDB(t, true)
+	_, engine := gin.CreateTestContext(httptest.NewRecorder())
+	fileMod.RegisterRoutes(engine.Group("/api"))
+
+	tagsRequest := func(method string, target string, body string) *http.Request {
+		req := httptest.NewRequest(method, target, bytes.NewBufferString(body))
+		req.Header.Set("X-MAANI-USER", "7")
+		req.Header.Set("Content-Type", "application/json")
+		return req
+	}
+
+	t.Run("add_tags", func(t *testing.T) {
+		db.EXPECT().AddFileTags(database.OwnerScope(7).ForWrite(), "note", []string{"cats", "pets"}).Return([]string{"cats", "notes", "pets"}, nil)
+
+		recorder := httptest.NewRecorder()
+		engine.ServeHTTP(recorder, tagsRequest("PUT", "https://store.foo/api/file/note/tags", `{"tags": [" Cats", "pets,CATS"]}`))
+		assert.Equal(t, http.StatusOK, recorder.Code)
+		assert.JSONEq(t, `["cats", "notes", "pets"]`, recorder.Body.String())
+	})
+	t.Run("add_invalid_tags", func(t *testing.T) {
+		recorder := httptest.NewRecorder()
+		engine.ServeHTTP(recorder, tagsRequest("PUT", "https://store.foo/api/file/note/tags", `{"tags": ["a"]}`))
+		assert.Equal(t, http.StatusBadRequest, recorder.Code)
+
+		recorder = httptest.NewRecorder()
+		engine.ServeHTTP(recorder, tagsRequest("PUT", "https://store.foo/api/file/note/tags", `{"tags": []}`))
+		assert.Equal(t, http.StatusBadRequest, recorder.Code)
+	})
+	t.Run("add_tags_to_missing_file", func(t *testing.T) {
+		db.EXPECT().AddFileTags(database.OwnerScope(7).ForWrite(), "missing", []string{"cats"}).Return(nil, database.ErrFileNotFound)
+
+		recorder := httptest.NewRecorder()
+		engine.ServeHTTP(recorder, tagsRequest("PUT", "https://store.foo/api/file/missing/tags", `{"tags": ["cats"]}`))
+		assert.Equal(t, http.StatusNotFound, recorder.Code)
+	})
+	t.Run("remove_tags", func(t *testing.T) {
+		db.EXPECT().RemoveFileTags(database.OwnerScope(7).ForWrite(), "note", []string{"cats"}).Return([]string{"notes", "pets"}, nil)
+
+		recorder := httptest.NewRecorder()
+		engine.ServeHTTP(recorder, userRequest("DELETE", "https://store.foo/api/file/note/tags?tags=CATS", 7))
+		assert.Equal(t, http.StatusOK, recorder.Code)
+		assert.JSONEq(t, `["notes", "pets"]`, recorder.Body.String())
+	})
+	t.Run("list_tags", func(t *testing.T) {
+		db.EXPECT().GetTagList(database.OwnerScope(7), "ca", 5).Return([]models.TagCount{{Name: "cats", Count: 3}, {Name: "cars", Count: 1}}, nil)
+
+		recorder := httptest.NewRecorder()
+		engine.ServeHTTP(recorder, userRequest("GET", "https://store.foo/api/tags?prefix=Ca&limit=5", 7))
+		assert.Equal(t, http.StatusOK, recorder.Code)
+		assert.JSONEq(t, `[{"name": "cats", "count": 3}, {"name": "cars", "count": 1}]`, recorder.Body.String())
+	})
+	t.Run("list_tags_with_invalid_limit", func(t *testing.T) {
+		recorder := httptest.NewRecorder()
+		engine.ServeHTTP(recorder, userRequest("GET", "https://store.foo/api/tags?limit=1000", 7))
+		assert.Equal(t, http.StatusBadRequest, recorder.Code)
+	})
+}
+
+// TestFiles_QueryFiles tests files are searched by parsed query a page at a time
+func TestFiles_QueryFiles(t *testing.T) {
+	fileMod, db := initFilesModuleWithMockDB(t, true)
+	_, engine := gin.CreateTestContext(httptest.NewRecorder())
+	fileMod.RegisterRoutes(engine.Group("/api"))
+
+	t.Run("query", func(t *testing.T) {
+		expr, err := filequery.Parse("cat AND (black OR white) AND NOT kitten")
+		assert.Nil(t, err)
+		db.EXPECT().QueryFiles(database.OwnerScope(7), expr, 2, 4).Return([]models.File{
+			{Name: "cat.png", UUID: "first", TypeId: "image/png", Tags: []string{"cat", "black"}},
+		}, 5, nil)
+
+		recorder := httptest.NewRecorder()
+		target := "https://store.foo/api/file/search?" + url.Values{"q": {"cat AND (black OR white) AND NOT kitten"}, "limit": {"2"}, "offset": {"4"}}.Encode()
+		engine.ServeHTTP(recorder, userRequest("GET", target, 7))
+		assert.Equal(t, http.StatusOK, recorder.Code)
+		var page models.FilePage
+		assert.Nil(t, json.Unmarshal(recorder.Body.Bytes(), &page))
+		assert.Equal(t, 5, page.Total)
+		assert.Equal(t, 2, page.Limit)
+		assert.Equal(t, 4, page.Offset)
+		assert.Len(t, page.Files, 1)
+		assert.Equal(t, "first", page.Files[0].Id)
+	})
+	t.Run("default_page", func(t *testing.T) {
+		db.EXPECT().QueryFiles(database.OwnerScope(7), filequery.Tag{Name: "cat"}, 20, 0).Return(nil, 0, nil)
+
+		recorder := httptest.NewRecorder()
+		engine.ServeHTTP(recorder, userRequest("GET", "https://store.foo/api/file/search?q=cat", 7))
+		assert.Equal(t, http.StatusOK, recorder.Code)
+		assert.JSONEq(t, `{"files": [], "total": 0, "limit": 20, "offset": 0}`, recorder.Body.String())
+	})
+	t.Run("invalid_query", func(t *testing.T) {
+		recorder := httptest.NewRecorder()
+		engine.ServeHTTP(recorder, userRequest("GET", "https://store.foo/api/file/search?q=%28cat", 7))
+		assert.Equal(t, http.StatusBadRequest, recorder.Code)
+
+		recorder = httptest.NewRecorder()
+		engine.ServeHTTP(recorder, userRequest("GET", "https://store.foo/api/file/search?q=", 7))
+		assert.Equal(t, http.StatusBadRequest, recorder.Code)
+
+		recorder = httptest.NewRecorder()
+		engine.ServeHTTP(recorder, userRequest("GET", "https://store.foo/api/file/search?q="+strings.Repeat("%28", filequery.MaxLength)+"cat", 7))
+		assert.Equal(t, http.StatusBadRequest, recorder.Code)
+		assert.Contains(t, recorder.Body.String(), "at most")
+	})
+	t.Run("invalid_page", func(t *testing.T) {
+		recorder := httptest.NewRecorder()
+		engine.ServeHTTP(recorder, userRequest("GET", "https://store.foo/api/file/search?q=cat&limit=0", 7))
+		assert.Equal(t, http.StatusBadRequest, recorder.Code)
+
+		recorder = httptest.NewRecorder()
+		engine.ServeHTTP(recorder, userRequest("GET", "https://store.foo/api/file/search?q=cat&offset=-1", 7))
+		assert.Equal(t, http.StatusBadRequest, recorder.Code)
+	})
+}
+
+// TestFiles_SearchFilesText tests full-text search returns ranked and highlighted files a page at a time
+func TestFiles_SearchFilesText(t *testing.T) {
+	fileMod, db := initFilesModuleWithMockDB(t, true)
+	_, engine := gin.CreateTestContext(httptest.NewRecorder())
+	fileMod.RegisterRoutes(engine.Group("/api"))
+
+	t.Run("search", func(t *testing.T) {
+		db.EXPECT().SearchFilesText(database.OwnerScope(7), "summer trip", 10, 0).Return([]models.TextMatch{
+			{File: models.File{Name: "summer_trip.jpg", UUID: "first", TypeId: "image/jpeg"}, Rank: 0.6, Highlight: "<mark>summer</mark>_trip.jpg"},
+		}, 1, nil)
+
+		recorder := httptest.NewRecorder()
+		engine.ServeHTTP(recorder, userRequest("GET", "https://store.foo/api/file/search/text?q=summer+trip&limit=10", 7))
+		assert.Equal(t, http.StatusOK, recorder.Code)
+		var page models.TextSearchPage
+		assert.Nil(t, json.Unmarshal(recorder.Body.Bytes(), &page))
+		assert.Equal(t, 1, page.Total)
+		assert.Equal(t, 10, page.Limit)
+		assert.Len(t, page.Matches, 1)
+		assert.Equal(t, "first", page.Matches[0].File.Id)
+		assert.Equal(t, 0.6, page.Matches[0].Rank)
+		assert.Equal(t, "<mark>summer</mark>_trip.jpg", page.Matches[0].Highlight)
+	})
+	t.Run("empty_text", func(t *testing.T) {
+		recorder := httptest.NewRecorder()
+		engine.ServeHTTP(recorder, userRequest("GET", "https://store.foo/api/file/search/text?q=+", 7))
+		assert.Equal(t, http.StatusBadRequest, recorder.Code)
+	})
+	t.Run("too_long_text", func(t *testing.T) {
+		recorder := httptest.NewRecorder()
+		engine.ServeHTTP(recorder, userRequest("GET", "https://store.foo/api/file/search/text?q="+strings.Repeat("a", 300), 7))
+		assert.Equal(t, http.StatusBadRequest, recorder.Code)
+	})
+}
@@ -33,12 +33,21 @@ func init() {
 }
 
 var settingsPath string
+var batchSize int
 
+// main runs store servers, or runs a maintenance command and exits:
+// "reencrypt" re-encrypts stored files with the active key, "migrate-blobs" moves blobs into content addressed layout
 func main() {
 	logger.Infoln("Store is running")
 	pflag.StringVar(&settingsPath, "settings", "/opt/maani/settings.yml", "Path to settings file")
+	pflag.IntVar(&batchSize, "batch-size", 100, "Number of files processed between progress updates of reencrypt and migrate-blobs commands")
 	pflag.Parse()
 
+	command := pflag.Arg(0)
+	if command != "" && command != "reencrypt" && command != "migrate-blobs" {
+		logger.Fatalw("Unknown command, supported commands: reencrypt, migrate-blobs", "command", command)
+	}
+
 	var st settings.Settings
 	var db database.Database
 
@@ -56,6 +65,15 @@ func main() {
 	// init database
 	db = initDatabase(st)
 
+	switch command {
+	case "reencrypt":
+		runReencrypt(st, db)
+		return
+	case "migrate-blobs":
+		runMigrateBlobs(st, db)
+		return
+	}
+
 	logger.Infoln("Setup router")
 
 	backendServer, adminServer := setupHttpServers(st, db)
@@ -0,0 +1,33 @@
+package main
+
+import (
+	"context"
+	"os/signal"
+	"syscall"
+
+	"github.com/lebleuciel/maani/pkg/database"
+	FileRepository "github.com/lebleuciel/maani/pkg/repository/file"
+	"github.com/lebleuciel/maani/pkg/settings"
+)
+
+// runMigrateBlobs moves blobs stored flat by file uuid into the content addressed layout and records their checksum.
+// It is safe to run while store servers are serving, migrated files are skipped so an interrupted run is resumed by running the command again.
+func runMigrateBlobs(settings settings.Settings, database database.Database) {
+	fileRepo, err := FileRepository.NewFileRepository(settings, database)
+	if err != nil {
+		logger.Fatalw("Could not initialize file repository", "error", err.Error())
+	}
+
+	ctx, stop := signal.NotifyContext(context.Background(), syscall.SIGHUP, syscall.SIGINT, syscall.SIGTERM)
+	defer stop()
+
+	logger.Infoln("Migrating file blobs")
+	migrated, failed, err := fileRepo.MigrateBlobs(ctx, batchSize)
+	if err != nil {
+		logger.Fatalw("Blob migration did not complete", "error", err.Error(), "migrated", migrated, "failed", failed)
+	}
+	if failed > 0 {
+		logger.Fatalw("Blobs of some files could not be migrated", "migrated", migrated, "failed", failed)
+	}
+	logger.Infow("Blob migration completed", "migrated", migrated)
+}
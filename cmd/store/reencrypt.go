@@ -0,0 +1,30 @@
+package main
+
+import (
+	"context"
+	"os/signal"
+	"syscall"
+
+	"github.com/lebleuciel/maani/pkg/database"
+	FileRepository "github.com/lebleuciel/maani/pkg/repository/file"
+	"github.com/lebleuciel/maani/pkg/settings"
+)
+
+// runReencrypt wraps data keys of stored files with the active key and re-encrypts files stored before envelope encryption.
+// It is safe to run while store servers are serving, progress is kept in database so an interrupted run is resumed by running the command again.
+func runReencrypt(settings settings.Settings, database database.Database) {
+	fileRepo, err := FileRepository.NewFileRepository(settings, database)
+	if err != nil {
+		logger.Fatalw("Could not initialize file repository", "error", err.Error())
+	}
+
+	ctx, stop := signal.NotifyContext(context.Background(), syscall.SIGHUP, syscall.SIGINT, syscall.SIGTERM)
+	defer stop()
+
+	logger.Infow("Rotating file keys", "keyId", fileRepo.ActiveKeyId())
+	rotation, err := fileRepo.RotateKey(ctx, batchSize)
+	if err != nil {
+		logger.Fatalw("Key rotation did not complete", "error", err.Error(), "done", rotation.Done, "failed", rotation.Failed, "total", rotation.Total)
+	}
+	logger.Infow("Key rotation completed", "done", rotation.Done, "total", rotation.Total)
+}
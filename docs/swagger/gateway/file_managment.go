@@ -1,9 +1,13 @@
 package gateway
 
-import "bytes"
+import (
+	"bytes"
+
+	"github.com/lebleuciel/maani/models"
+)
 
 // swagger:route POST /api/file File upload
-// Upload file.
+// Upload files of any allowed type, files are stored verbatim and only images get renditions.
 // Security:
 //    bearerAuth: []
 // responses:
@@ -15,49 +19,380 @@ type UploadFile struct {
 	// swagger:file
 	File *bytes.Buffer `json:"files"`
 	Tags []string      `json:"tags"`
+	// Delete file after its first download
+	// in:formData
+	BurnAfterRead bool `json:"burn_after_read"`
 }
 
-// swagger:route GET /api/file/searchgoogle File searchGoogle
-// File searchGoogle
+// swagger:route POST /api/file/search File searchGoogle
+// Queue a job which searches images and saves them.
+// Security:
+//    bearerAuth: []
 // responses:
-//   200: successResponse
+//   202: searchJob
 
 // swagger:parameters searchGoogle
 type searchGoogleParams struct {
 	// in:query
 	// type:string
 	// required: true
-	Query string `json:"query"`
+	Query string `json:"q"`
 
-	// in:formData
-	// type:file
+	// in:query
+	// type:integer
 	// required: true
-	File *bytes.Buffer `json:"file"`
+	MaxNum int `json:"maxnum"`
+}
 
-	// in:formData
-	// type:array
-	// items: string
-	Tags []string `json:"tags"`
+// swagger:route GET /api/file/search/jobs File searchJobList
+// List search jobs of current user, admins list jobs of every user.
+// Security:
+//    bearerAuth: []
+// responses:
+//   200: searchJobList
+
+// swagger:route GET /api/file/search/jobs/{id} File searchJob
+// Get progress of a search job.
+// Security:
+//    bearerAuth: []
+// responses:
+//   200: searchJob
+
+// swagger:parameters searchJob
+type searchJobParams struct {
+	// in:path
+	// required: true
+	Id int `json:"id"`
 }
 
-// swagger:route GET /api/file File download
-// Download file.
+// swagger:response searchJob
+type SearchJobResponse struct {
+	// in:body
+	Body models.SearchJob
+}
+
+// swagger:response searchJobList
+type SearchJobListResponse struct {
+	// in:body
+	Body []models.SearchJob
+}
+
+// Decrypted file content, its checksum is verified while it is sent and the response is cut short on mismatch
+// swagger:response downloadFile
+type DownloadFileResponse struct {
+	// Quoted hex SHA-256 of file content, not set for files stored before checksums
+	ETag string `json:"ETag"`
+	// SHA-256 of file content, such as sha-256=base64
+	Digest string `json:"Digest"`
+}
+
+// swagger:route GET /api/file/search File searchFiles
+// Find all files with any of names and any of tags, at least one of them is required.
+// Files are found by query q instead when it is given, such as cat AND (black OR white) AND NOT kitten type:image/png size>100kb before:2026-01-01,
+// which returns a page of matching files as filePage.
 // Security:
 //    bearerAuth: []
 // responses:
-//   200:
+//   200: fileList
+//   400:
 
-// swagger:parameters download
-type DownloadFile struct {
-	// in:formData
-	Name string   `json:"name"`
+// swagger:parameters searchFiles
+type SearchFilesParams struct {
+	// in:query
+	Name []string `json:"name"`
+	// in:query
 	Tags []string `json:"tags"`
+	// Query of tags, name, type, size, before and after terms joined by AND, OR and NOT
+	// in:query
+	Q string `json:"q"`
+	// Number of files in a page of query results, 20 by default and at most 100
+	// in:query
+	Limit int `json:"limit"`
+	// Number of query results skipped before page
+	// in:query
+	Offset int `json:"offset"`
+}
+
+// swagger:response filePage
+type FilePageResponse struct {
+	// in:body
+	Body models.FilePage
+}
+
+// swagger:route GET /api/file/search/text File searchFilesText
+// Find files whose name, tags or search query have words of text, or words starting with them, the best matches first.
+// Name and search query of files are highlighted as html, with matching words in mark tags.
+// Security:
+//    bearerAuth: []
+// responses:
+//   200: textSearchPage
+//   400:
+
+// swagger:parameters searchFilesText
+type SearchFilesTextParams struct {
+	// Text of at most 256 characters
+	// in:query
+	// required: true
+	Q string `json:"q"`
+	// Number of files in a page, 20 by default and at most 100
+	// in:query
+	Limit int `json:"limit"`
+	// Number of files skipped before page
+	// in:query
+	Offset int `json:"offset"`
+}
+
+// swagger:response textSearchPage
+type TextSearchPageResponse struct {
+	// in:body
+	Body models.TextSearchPage
+}
+
+// swagger:route GET /api/file/{id} File downloadById
+// Download file by id, or one of its renditions in a format negotiated by Accept header.
+// Security:
+//    bearerAuth: []
+// responses:
+//   200: downloadFile
+//   404:
+//   406:
+
+// swagger:parameters downloadById
+type DownloadFileById struct {
+	// in:path
+	// required: true
+	Id string `json:"id"`
+	// Name of a rendition defined in store settings, such as thumb, the original file is sent when it is empty
+	// in:query
+	Rendition string `json:"rendition"`
+	// Media types accepted for renditions, such as image/webp, format configured for the original is sent when it is empty
+	// in:header
+	Accept string `json:"Accept"`
+}
+
+// swagger:route HEAD /api/file/{id} File headFile
+// Get headers a download of file by id would have, without its content.
+// Security:
+//    bearerAuth: []
+// responses:
+//   200: headFile
+//   404:
+
+// swagger:parameters headFile deleteFile restoreFile
+type FileIdParams struct {
+	// in:path
+	// required: true
+	Id string `json:"id"`
+}
+
+// swagger:response headFile
+type HeadFileResponse struct {
+	// Media type of file
+	ContentType string `json:"Content-Type"`
+	// Size of file in bytes
+	ContentLength int `json:"Content-Length"`
+	// Quoted hex SHA-256 of file content, not set for files stored before checksums
+	ETag string `json:"ETag"`
+	// SHA-256 of file content, such as sha-256=base64
+	Digest string `json:"Digest"`
+}
+
+// swagger:route DELETE /api/file/{id} File deleteFile
+// Move file by id to trash, it can be restored until it is purged after retention period of trash.
+// Security:
+//    bearerAuth: []
+// responses:
+//   204:
+//   404:
+
+// swagger:route GET /api/file/trash File trash
+// Get metadata of trashed files, the most recently trashed first.
+// Security:
+//    bearerAuth: []
+// responses:
+//   200: fileList
+
+// swagger:route POST /api/file/{id}/restore File restoreFile
+// Restore trashed file by id.
+// Security:
+//    bearerAuth: []
+// responses:
+//   204:
+//   404:
+
+// swagger:route POST /api/file/{id}/shares File shareFile
+// Share file by id with another user, only owner of file can share it.
+// Sharing it with the same user again updates permission and expiry of the share.
+// Security:
+//    bearerAuth: []
+// responses:
+//   201: share
+//   400:
+//   403:
+//   404:
+
+// swagger:parameters shareFile
+type ShareFileParams struct {
+	// in:path
+	// required: true
+	Id string `json:"id"`
+	// Permission is read or write, read is default
+	// in:body
+	Body models.ShareParameters
+}
+
+// swagger:route GET /api/file/{id}/shares File shareList
+// List shares of file by id, public links are listed with their link.
+// Security:
+//    bearerAuth: []
+// responses:
+//   200: shareList
+//   403:
+//   404:
+
+// swagger:parameters shareList
+type ShareListParams struct {
+	// in:path
+	// required: true
+	Id string `json:"id"`
+}
+
+// swagger:route DELETE /api/file/{id}/shares/{shareId} File deleteShare
+// Revoke share of file by id, including public links.
+// Security:
+//    bearerAuth: []
+// responses:
+//   204:
+//   403:
+//   404:
+
+// swagger:parameters deleteShare
+type DeleteShareParams struct {
+	// in:path
+	// required: true
+	Id string `json:"id"`
+	// in:path
+	// required: true
+	ShareId int `json:"shareId"`
+}
+
+// swagger:route POST /api/file/{id}/links File createShareLink
+// Create a signed public link to file by id, which is downloaded without logging in until it expires or has no downloads left.
+// Security:
+//    bearerAuth: []
+// responses:
+//   201: share
+//   400:
+//   403:
+//   404:
+
+// swagger:parameters createShareLink
+type CreateShareLinkParams struct {
+	// in:path
+	// required: true
+	Id string `json:"id"`
+	// in:body
+	Body models.ShareLinkParameters
+}
+
+// swagger:response share
+type ShareResponse struct {
+	// in:body
+	Body models.Share
+}
+
+// swagger:response shareList
+type ShareListResponse struct {
+	// in:body
+	Body []models.Share
 }
 
-// swagger:route GET /api/file/list File list
-// Its only for admin user.
+// swagger:route GET /api/share/{token} File sharedFile
+// Download file of a public share link, each request counts as a download of the link.
+// responses:
+//   200: downloadFile
+//   404:
+//   410:
+
+// swagger:parameters sharedFile
+type SharedFileParams struct {
+	// in:path
+	// required: true
+	Token string `json:"token"`
+	// Name of a rendition defined in store settings, such as thumb, the original file is sent when it is empty
+	// in:query
+	Rendition string `json:"rendition"`
+}
+
+// swagger:route GET /api/file/{id}/meta File fileMeta
+// Get metadata of file by id.
+// Security:
+//    bearerAuth: []
+// responses:
+//   200: fileMeta
+//   404:
+
+// swagger:parameters fileMeta
+type FileMetaParams struct {
+	// in:path
+	// required: true
+	Id string `json:"id"`
+}
+
+// swagger:response fileMeta
+type FileMetaResponse struct {
+	// in:body
+	Body models.FileMetadata
+}
+
+// swagger:response fileList
+type FileListResponse struct {
+	// in:body
+	Body models.FileList
+}
+
+// swagger:route GET /api/file/list File fileList
+// List a page of files of every user, sorted and filtered by query parameters. next_cursor of response is passed as cursor to get the next page, it is empty on the last page.
 // Its only for admin user
 // Security:
 //    bearerAuth: []
 // responses:
-//   200:
+//   200: fileList
+//   400:
+
+// swagger:parameters fileList
+type FileListParams struct {
+	// Number of files in a page, from 1 to 100
+	// in:query
+	// default: 20
+	Limit int `json:"limit"`
+
+	// Opaque cursor of next page returned by previous page, it is only valid with the same sort
+	// in:query
+	Cursor string `json:"cursor"`
+
+	// created_at, size or name, with - in front for descending order
+	// in:query
+	// default: created_at
+	Sort string `json:"sort"`
+
+	// Id of user who owns files
+	// in:query
+	Owner int `json:"owner"`
+
+	// Media type of files such as image/png, image matches every image
+	// in:query
+	Type string `json:"type"`
+
+	// Tag of files
+	// in:query
+	Tag string `json:"tag"`
+
+	// Files stored on or after a date such as 2026-01-02, or a time such as 2026-01-02T15:04:05Z
+	// in:query
+	After string `json:"after"`
+
+	// Files stored before a date or a time
+	// in:query
+	Before string `json:"before"`
+}
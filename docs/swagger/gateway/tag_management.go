@@ -0,0 +1,108 @@
+package gateway
+
+import "github.com/lebleuciel/maani/models"
+
+// swagger:route PUT /api/file/{id}/tags Tag addFileTags
+// Add tags to file by id and get its tags.
+// Security:
+//    bearerAuth: []
+// responses:
+//   200: fileTags
+//   400:
+//   404:
+
+// swagger:parameters addFileTags
+type AddFileTagsParams struct {
+	// in:path
+	// required: true
+	Id string `json:"id"`
+	// Tags are lowercased and trimmed, each should be 2 to 64 characters long
+	// in:body
+	Body models.FileTagsParameters
+}
+
+// swagger:route DELETE /api/file/{id}/tags Tag removeFileTags
+// Remove tags from file by id and get its tags.
+// Security:
+//    bearerAuth: []
+// responses:
+//   200: fileTags
+//   400:
+//   404:
+
+// swagger:parameters removeFileTags
+type RemoveFileTagsParams struct {
+	// in:path
+	// required: true
+	Id string `json:"id"`
+	// in:query
+	// required: true
+	Tags []string `json:"tags"`
+}
+
+// swagger:response fileTags
+type FileTagsResponse struct {
+	// in:body
+	Body []string
+}
+
+// swagger:route GET /api/tags Tag tagList
+// Get tags of files user can access with number of files they are set on, the most used first.
+// Security:
+//    bearerAuth: []
+// responses:
+//   200: tagList
+//   400:
+
+// swagger:parameters tagList
+type TagListParams struct {
+	// Only tags starting with prefix are listed, for autocomplete
+	// in:query
+	Prefix string `json:"prefix"`
+	// Number of tags listed, 20 by default and at most 100
+	// in:query
+	Limit int `json:"limit"`
+}
+
+// swagger:response tagList
+type TagListResponse struct {
+	// in:body
+	Body []models.TagCount
+}
+
+// swagger:route PUT /api/tags/{name} Tag renameTag
+// Rename tag on every file, its only for admin user.
+// Security:
+//    bearerAuth: []
+// responses:
+//   204:
+//   400:
+//   404:
+//   409:
+
+// swagger:parameters renameTag
+type RenameTagParams struct {
+	// in:path
+	// required: true
+	Name string `json:"name"`
+	// in:body
+	Body models.RenameTagParameters
+}
+
+// swagger:route POST /api/tags/{name}/merge Tag mergeTags
+// Replace tag with another existing tag on every file and remove it, its only for admin user.
+// Security:
+//    bearerAuth: []
+// responses:
+//   204:
+//   400:
+//   404:
+
+// swagger:parameters mergeTags
+type MergeTagsParams struct {
+	// in:path
+	// required: true
+	Name string `json:"name"`
+	// in:body
+	Body models.MergeTagParameters
+}
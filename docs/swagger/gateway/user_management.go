@@ -61,10 +61,46 @@ type RegisterUserRequest struct {
 	Body models.UserRegisterParameters
 }
 
-// swagger:route GET /api/user/list File list
-// Its only for admin user.
+// swagger:route GET /api/user/list User userList
+// List a page of users, sorted and filtered by query parameters. next_cursor of response is passed as cursor to get the next page, it is empty on the last page.
 // Its only for admin user
 // Security:
 //    bearerAuth: []
 // responses:
-//   200:
+//   200: userList
+//   400:
+
+// swagger:parameters userList
+type UserListParams struct {
+	// Number of users in a page, from 1 to 100
+	// in:query
+	// default: 20
+	Limit int `json:"limit"`
+
+	// Opaque cursor of next page returned by previous page, it is only valid with the same sort
+	// in:query
+	Cursor string `json:"cursor"`
+
+	// created_at or name, which sorts by last name, with - in front for descending order
+	// in:query
+	// default: created_at
+	Sort string `json:"sort"`
+
+	// Access type of users, Admin or Customer
+	// in:query
+	Type string `json:"type"`
+
+	// Users registered on or after a date such as 2026-01-02, or a time such as 2026-01-02T15:04:05Z
+	// in:query
+	After string `json:"after"`
+
+	// Users registered before a date or a time
+	// in:query
+	Before string `json:"before"`
+}
+
+// swagger:response userList
+type UserListResponse struct {
+	// in:body
+	Body models.UserList
+}
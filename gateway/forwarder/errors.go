@@ -7,3 +7,6 @@ var ErrEmptyStoreHost = errors.New("Store host should not be empty")
 var ErrEmptyAdminPort = errors.New("Admin Port should not be empty")
 var ErrEmptyBackendPort = errors.New("Backend Port should not be empty")
 var ErrEmptyUserHeaderKey = errors.New("UserHeaderKey should not be empty")
+var ErrEmptyAccessHeaderKey = errors.New("AccessHeaderKey should not be empty")
+var ErrEmptyShareLinkSecret = errors.New("ShareLinkSecret should not be empty")
+var ErrInvalidStoreTimeout = errors.New("Store timeouts should be positive")
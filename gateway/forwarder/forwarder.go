@@ -1,14 +1,17 @@
 package forwarder
 
 import (
-	"bytes"
 	"fmt"
+	"io"
 	"log"
+	"net"
 	"net/http"
+	"time"
 
 	"github.com/gin-gonic/gin"
 	"github.com/lebleuciel/maani/models"
 	"github.com/lebleuciel/maani/pkg/services/auth"
+	"github.com/lebleuciel/maani/pkg/sharelink"
 	"github.com/pkg/errors"
 	"go.uber.org/zap"
 )
@@ -30,6 +33,12 @@ type Forwarder struct {
 	authMiddleware *auth.Auth
 	authEnabled    bool
 	userHeaderKey  string
+	// accessHeaderKey is header access type of user is passed in, store servers trust it to scope files
+	accessHeaderKey string
+	// shareLinkSecret is key public share links are signed with
+	shareLinkSecret string
+	// client forwards requests to store servers, it gives up on servers which don't respond in time
+	client *http.Client
 }
 
 func (u *Forwarder) RegisterRoutes(v1 *gin.RouterGroup) {
@@ -37,14 +46,34 @@ func (u *Forwarder) RegisterRoutes(v1 *gin.RouterGroup) {
 
 	file := v1.Group("/file")
 	user := v1.Group("/user")
+	tags := v1.Group("/tags")
 	if u.authEnabled {
 		file.Use(u.authMiddleware.Middleware())
 		user.Use(u.authMiddleware.Middleware())
+		tags.Use(u.authMiddleware.Middleware())
 	}
 	file.Any("", u.forward(u.backendUrl, false))
 	file.Any("/list", u.forward(u.adminUrl, true))
 	user.Any("/list", u.forward(u.adminUrl, true))
 	file.Any("/search", u.forward(u.backendUrl, false))
+	file.Any("/search/text", u.forward(u.backendUrl, false))
+	file.Any("/search/jobs", u.forward(u.backendUrl, false))
+	file.Any("/search/jobs/:id", u.forward(u.backendUrl, false))
+	file.Any("/trash", u.forward(u.backendUrl, false))
+	file.Any("/:id", u.forward(u.backendUrl, false))
+	file.Any("/:id/meta", u.forward(u.backendUrl, false))
+	file.Any("/:id/restore", u.forward(u.backendUrl, false))
+	file.Any("/:id/shares", u.forward(u.backendUrl, false))
+	file.Any("/:id/shares/:shareId", u.forward(u.backendUrl, false))
+	file.Any("/:id/links", u.forward(u.backendUrl, false))
+	file.Any("/:id/tags", u.forward(u.backendUrl, false))
+	tags.Any("", u.forward(u.backendUrl, false))
+	tags.Any("/:name", u.forward(u.adminUrl, true))
+	tags.Any("/:name/merge", u.forward(u.adminUrl, true))
+
+	// Public share links bypass authentication, they are checked by their signature instead
+	share := v1.Group("/share")
+	share.GET("/:token", u.forwardShareLink(u.backendUrl))
 }
 
 func (u *Forwarder) forward(url string, shouldBeAdmin bool) gin.HandlerFunc {
@@ -55,43 +84,83 @@ func (u *Forwarder) forward(url string, shouldBeAdmin bool) gin.HandlerFunc {
 				return
 			}
 
-			// Create a new GET request to the other code
-			req, err := http.NewRequest(ctx.Request.Method, url+ctx.FullPath()+"?"+ctx.Request.URL.RawQuery, ctx.Request.Body)
-			if err != nil {
-				logger.Errorw("can not create new request in gatewey", "error", err)
-				ctx.JSON(http.StatusInternalServerError, gin.H{"message": "Internal Server Error"})
-				return
-			}
+			// Identity headers are set by gateway only, values sent by clients are replaced
+			u.proxy(ctx, url, func(header http.Header) {
+				header.Set(u.userHeaderKey, fmt.Sprint(userData.Id))
+				header.Set(u.accessHeaderKey, userData.AccessType)
+			})
+			return
+		}
+		ctx.JSON(http.StatusForbidden, gin.H{})
+	}
+}
 
-			// Copy headers from the original request to the new request
-			for key, values := range ctx.Request.Header {
-				for _, value := range values {
-					req.Header.Add(key, value)
-				}
-			}
-			req.Header.Add(u.userHeaderKey, fmt.Sprint(userData.Id))
+// forwardShareLink forwards requests of public share links without a logged in user, only links with a valid signature are forwarded
+func (u *Forwarder) forwardShareLink(url string) gin.HandlerFunc {
+	return func(ctx *gin.Context) {
+		_, err := sharelink.Verify(u.shareLinkSecret, ctx.Param("token"), time.Now())
+		if errors.Is(err, sharelink.ErrExpiredLink) {
+			ctx.JSON(http.StatusGone, gin.H{"message": "Share link has expired"})
+			return
+		}
+		if err != nil {
+			ctx.JSON(http.StatusNotFound, gin.H{"message": "Share link not found"})
+			return
+		}
 
-			// Perform the HTTP request
-			resp, err := http.DefaultClient.Do(req)
-			if err != nil {
-				logger.Errorw("can not do request in gatewey", "error", err)
-				ctx.JSON(http.StatusInternalServerError, gin.H{"message": "Internal Server Error"})
-				return
-			}
-			defer resp.Body.Close()
-			for key, values := range resp.Header {
-				for _, value := range values {
-					ctx.Header(key, value)
-				}
-			}
+		// Requests of links are anonymous, identity headers sent by clients are dropped
+		u.proxy(ctx, url, func(header http.Header) {
+			header.Del(u.userHeaderKey)
+			header.Del(u.accessHeaderKey)
+		})
+	}
+}
 
-			// Copy the response from the other code to the current response
-			var buf bytes.Buffer
-			buf.ReadFrom(resp.Body)
-			ctx.String(resp.StatusCode, buf.String())
-			return
+// proxy sends request to same path of url and copies its response, setHeaders modifies headers of forwarded request
+func (u *Forwarder) proxy(ctx *gin.Context, url string, setHeaders func(http.Header)) {
+	// Create a new GET request to the other code
+	req, err := http.NewRequestWithContext(ctx.Request.Context(), ctx.Request.Method, url+ctx.Request.URL.Path+"?"+ctx.Request.URL.RawQuery, ctx.Request.Body)
+	if err != nil {
+		logger.Errorw("can not create new request in gatewey", "error", err)
+		ctx.JSON(http.StatusInternalServerError, gin.H{"message": "Internal Server Error"})
+		return
+	}
+
+	// Copy headers from the original request to the new request
+	for key, values := range ctx.Request.Header {
+		for _, value := range values {
+			req.Header.Add(key, value)
 		}
-		ctx.JSON(http.StatusForbidden, gin.H{})
+	}
+	setHeaders(req.Header)
+
+	// Perform the HTTP request
+	resp, err := u.client.Do(req)
+	var netErr net.Error
+	if errors.As(err, &netErr) && netErr.Timeout() {
+		logger.Errorw("store server timed out in gatewey", "error", err)
+		ctx.JSON(http.StatusGatewayTimeout, gin.H{"message": "Gateway Timeout"})
+		return
+	}
+	if err != nil {
+		logger.Errorw("can not do request in gatewey", "error", err)
+		ctx.JSON(http.StatusInternalServerError, gin.H{"message": "Internal Server Error"})
+		return
+	}
+	defer resp.Body.Close()
+	for key, values := range resp.Header {
+		for _, value := range values {
+			ctx.Header(key, value)
+		}
+	}
+
+	// Stream the response from the other code to the current response, downloads can take longer than the timeout
+	ctx.Status(resp.StatusCode)
+	_, err = io.Copy(ctx.Writer, resp.Body)
+	if err != nil {
+		// Status is already sent, so the response is aborted for client to see it is incomplete rather than a shorter file
+		logger.Errorw("can not copy response in gatewey", "error", err)
+		panic(http.ErrAbortHandler)
 	}
 }
 
@@ -113,7 +182,7 @@ func (u *Forwarder) checkAuthorizedRequest(c *gin.Context, shouldBeAdmin bool) (
 	return userData, nil
 }
 
-func NewForwarderModule(auth *auth.Auth, storeHost string, adminPort int, backendPort int, userHeaderKey string, authEnabled bool) (*Forwarder, error) {
+func NewForwarderModule(auth *auth.Auth, storeHost string, adminPort int, backendPort int, userHeaderKey string, accessHeaderKey string, shareLinkSecret string, storeTimeout time.Duration, storeConnectTimeout time.Duration, authEnabled bool) (*Forwarder, error) {
 	if storeHost == "" {
 		return nil, ErrEmptyStoreHost
 	}
@@ -126,11 +195,37 @@ func NewForwarderModule(auth *auth.Auth, storeHost string, adminPort int, backen
 	if userHeaderKey == "" {
 		return nil, ErrEmptyUserHeaderKey
 	}
+	if accessHeaderKey == "" {
+		return nil, ErrEmptyAccessHeaderKey
+	}
+	if shareLinkSecret == "" {
+		return nil, ErrEmptyShareLinkSecret
+	}
+	if storeTimeout <= 0 || storeConnectTimeout <= 0 {
+		return nil, ErrInvalidStoreTimeout
+	}
 	return &Forwarder{
-		adminUrl:       fmt.Sprintf("%s:%d", storeHost, adminPort),
-		backendUrl:     fmt.Sprintf("%s:%d", storeHost, backendPort),
-		authMiddleware: auth,
-		authEnabled:    authEnabled,
-		userHeaderKey:  userHeaderKey,
+		adminUrl:        fmt.Sprintf("%s:%d", storeHost, adminPort),
+		backendUrl:      fmt.Sprintf("%s:%d", storeHost, backendPort),
+		authMiddleware:  auth,
+		authEnabled:     authEnabled,
+		userHeaderKey:   userHeaderKey,
+		accessHeaderKey: accessHeaderKey,
+		shareLinkSecret: shareLinkSecret,
+		client:          newStoreClient(storeTimeout, storeConnectTimeout),
 	}, nil
 }
+
+// newStoreClient returns a client whose requests fail when store servers don't start responding within timeout, and which
+// gives up connecting after connectTimeout. Bodies of responses have no timeout, so large downloads aren't cut off
+func newStoreClient(timeout time.Duration, connectTimeout time.Duration) *http.Client {
+	return &http.Client{
+		Transport: &http.Transport{
+			DialContext:           (&net.Dialer{Timeout: connectTimeout}).DialContext,
+			TLSHandshakeTimeout:   connectTimeout,
+			ResponseHeaderTimeout: timeout,
+			MaxIdleConnsPerHost:   16,
+			IdleConnTimeout:       90 * time.Second,
+		},
+	}
+}
@@ -1,8 +1,12 @@
 package forwarder
 
 import (
+	"io"
+	"net"
 	"net/http"
 	"net/http/httptest"
+	"strconv"
+	"strings"
 	"testing"
 	"time"
 
@@ -11,6 +15,7 @@ import (
 	mock_database "github.com/lebleuciel/maani/pkg/database/mocks"
 	"github.com/lebleuciel/maani/pkg/repository/user"
 	"github.com/lebleuciel/maani/pkg/services/auth"
+	"github.com/lebleuciel/maani/pkg/sharelink"
 	"github.com/stretchr/testify/assert"
 )
 
@@ -23,7 +28,7 @@ func initForwarderModuleWithMockDB(t *testing.T, authEnabled bool) (*Forwarder,
 	assert.Nil(t, err)
 	authMod, err := auth.NewAuth(userRepo, "secret", "email", "panel", 50*time.Hour, 50*time.Hour)
 	assert.Nil(t, err)
-	userMod, err := NewForwarderModule(authMod, "http://store", 9000, 9001, "X-User", authEnabled)
+	userMod, err := NewForwarderModule(authMod, "http://store", 9000, 9001, "X-User", "X-Access", "secret", time.Minute, time.Second, authEnabled)
 	assert.Nil(t, err)
 	assert.NotNil(t, userMod)
 	return userMod, db
@@ -33,27 +38,41 @@ func TestNewUsersModule(t *testing.T) {
 	ctrl := gomock.NewController(t)
 	defer ctrl.Finish()
 	t.Run("empty_store_host", func(t *testing.T) {
-		_, err := NewForwarderModule(nil, "", 0, 0, "", false)
+		_, err := NewForwarderModule(nil, "", 0, 0, "", "", "", time.Minute, time.Second, false)
 		assert.NotNil(t, err)
 		assert.Equal(t, ErrEmptyStoreHost, err)
 	})
 	t.Run("empty_admin_port", func(t *testing.T) {
-		_, err := NewForwarderModule(nil, "http://store", 0, 0, "", false)
+		_, err := NewForwarderModule(nil, "http://store", 0, 0, "", "", "", time.Minute, time.Second, false)
 		assert.NotNil(t, err)
 		assert.Equal(t, ErrEmptyAdminPort, err)
 	})
 	t.Run("empty_backend_port", func(t *testing.T) {
-		_, err := NewForwarderModule(nil, "http://store", 9000, 0, "", false)
+		_, err := NewForwarderModule(nil, "http://store", 9000, 0, "", "", "", time.Minute, time.Second, false)
 		assert.NotNil(t, err)
 		assert.Equal(t, ErrEmptyBackendPort, err)
 	})
 	t.Run("empty_backend_port", func(t *testing.T) {
-		_, err := NewForwarderModule(nil, "http://store", 9000, 9000, "", false)
+		_, err := NewForwarderModule(nil, "http://store", 9000, 9000, "", "", "", time.Minute, time.Second, false)
 		assert.NotNil(t, err)
 		assert.Equal(t, ErrEmptyUserHeaderKey, err)
 	})
+	t.Run("empty_access_header_key", func(t *testing.T) {
+		_, err := NewForwarderModule(nil, "http://store", 9000, 9000, "X-UserKey", "", "", time.Minute, time.Second, false)
+		assert.NotNil(t, err)
+		assert.Equal(t, ErrEmptyAccessHeaderKey, err)
+	})
+	t.Run("empty_share_link_secret", func(t *testing.T) {
+		_, err := NewForwarderModule(nil, "http://store", 9000, 9000, "X-UserKey", "X-AccessKey", "", time.Minute, time.Second, false)
+		assert.NotNil(t, err)
+		assert.Equal(t, ErrEmptyShareLinkSecret, err)
+	})
+	t.Run("invalid_store_timeout", func(t *testing.T) {
+		_, err := NewForwarderModule(nil, "http://store", 9000, 9000, "X-UserKey", "X-AccessKey", "secret", 0, time.Second, false)
+		assert.Equal(t, ErrInvalidStoreTimeout, err)
+	})
 	t.Run("valid", func(t *testing.T) {
-		mod, err := NewForwarderModule(nil, "http://store", 9000, 9000, "X-UserKey", false)
+		mod, err := NewForwarderModule(nil, "http://store", 9000, 9000, "X-UserKey", "X-AccessKey", "secret", time.Minute, time.Second, false)
 		assert.Nil(t, err)
 		assert.NotNil(t, mod)
 	})
@@ -85,4 +104,163 @@ func TestForwarder_RegisterRoutes(t *testing.T) {
 		assert.NotEqual(t, http.StatusNotFound, recorder.Code)
 		assert.Equal(t, http.StatusUnauthorized, recorder.Code)
 	})
+
+	t.Run("list_tags", func(t *testing.T) {
+		req := httptest.NewRequest("GET", "https://store.foo/api/tags?prefix=ca", nil)
+		recorder := httptest.NewRecorder()
+
+		engine.ServeHTTP(recorder, req)
+		assert.Equal(t, http.StatusUnauthorized, recorder.Code)
+	})
+
+	t.Run("rename_tag", func(t *testing.T) {
+		req := httptest.NewRequest("PUT", "https://store.foo/api/tags/cats", nil)
+		recorder := httptest.NewRecorder()
+
+		engine.ServeHTTP(recorder, req)
+		assert.Equal(t, http.StatusUnauthorized, recorder.Code)
+	})
+}
+
+// TestForwarder_ShareLink tests only signed links are forwarded without authentication, and without identity headers
+func TestForwarder_ShareLink(t *testing.T) {
+	var forwarded *http.Request
+	backend := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		forwarded = r
+		w.Write([]byte("shared content"))
+	}))
+	defer backend.Close()
+	host, port, err := net.SplitHostPort(strings.TrimPrefix(backend.URL, "http://"))
+	assert.Nil(t, err)
+	backendPort, err := strconv.Atoi(port)
+	assert.Nil(t, err)
+
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+	userRepo, err := user.NewUserRepository(mock_database.NewMockDatabase(ctrl))
+	assert.Nil(t, err)
+	authMod, err := auth.NewAuth(userRepo, "secret", "email", "panel", 50*time.Hour, 50*time.Hour)
+	assert.Nil(t, err)
+	forwarderMod, err := NewForwarderModule(authMod, "http://"+host, 9000, backendPort, "X-User", "X-Access", "link-secret", time.Minute, time.Second, true)
+	assert.Nil(t, err)
+	_, engine := gin.CreateTestContext(httptest.NewRecorder())
+	forwarderMod.RegisterRoutes(engine.Group("/api"))
+
+	t.Run("valid_link", func(t *testing.T) {
+		token := sharelink.Sign("link-secret", 3, time.Now().Add(time.Hour))
+		req := httptest.NewRequest("GET", "https://store.foo/api/share/"+token, nil)
+		req.Header.Set("X-User", "1")
+		req.Header.Set("X-Access", "Admin")
+		recorder := httptest.NewRecorder()
+
+		engine.ServeHTTP(recorder, req)
+		assert.Equal(t, http.StatusOK, recorder.Code)
+		assert.Equal(t, "shared content", recorder.Body.String())
+		assert.Equal(t, "/api/share/"+token, forwarded.URL.Path)
+		assert.Empty(t, forwarded.Header.Get("X-User"))
+		assert.Empty(t, forwarded.Header.Get("X-Access"))
+	})
+	t.Run("forged_link", func(t *testing.T) {
+		forwarded = nil
+		token := sharelink.Sign("other-secret", 3, time.Now().Add(time.Hour))
+		recorder := httptest.NewRecorder()
+
+		engine.ServeHTTP(recorder, httptest.NewRequest("GET", "https://store.foo/api/share/"+token, nil))
+		assert.Equal(t, http.StatusNotFound, recorder.Code)
+		assert.Nil(t, forwarded)
+	})
+	t.Run("expired_link", func(t *testing.T) {
+		forwarded = nil
+		token := sharelink.Sign("link-secret", 3, time.Now().Add(-time.Hour))
+		recorder := httptest.NewRecorder()
+
+		engine.ServeHTTP(recorder, httptest.NewRequest("GET", "https://store.foo/api/share/"+token, nil))
+		assert.Equal(t, http.StatusGone, recorder.Code)
+		assert.Nil(t, forwarded)
+	})
+	t.Run("share_management_needs_login", func(t *testing.T) {
+		forwarded = nil
+		recorder := httptest.NewRecorder()
+
+		engine.ServeHTTP(recorder, httptest.NewRequest("POST", "https://store.foo/api/file/note/links", nil))
+		assert.Equal(t, http.StatusUnauthorized, recorder.Code)
+		assert.Nil(t, forwarded)
+	})
+}
+
+// TestForwarder_StoreTimeout tests requests to store servers which don't respond in time fail with gateway timeout
+func TestForwarder_StoreTimeout(t *testing.T) {
+	release := make(chan struct{})
+	backend := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		<-release
+	}))
+	defer backend.Close()
+	defer close(release)
+	host, port, err := net.SplitHostPort(strings.TrimPrefix(backend.URL, "http://"))
+	assert.Nil(t, err)
+	backendPort, err := strconv.Atoi(port)
+	assert.Nil(t, err)
+
+	forwarderMod, err := NewForwarderModule(nil, "http://"+host, 9000, backendPort, "X-User", "X-Access", "link-secret", 50*time.Millisecond, time.Second, false)
+	assert.Nil(t, err)
+	_, engine := gin.CreateTestContext(httptest.NewRecorder())
+	forwarderMod.RegisterRoutes(engine.Group("/api"))
+
+	token := sharelink.Sign("link-secret", 3, time.Now().Add(time.Hour))
+	recorder := httptest.NewRecorder()
+	engine.ServeHTTP(recorder, httptest.NewRequest("GET", "https://store.foo/api/share/"+token, nil))
+	assert.Equal(t, http.StatusGatewayTimeout, recorder.Code)
+}
+
+// TestForwarder_StreamDownload tests responses of store servers are streamed past the timeout, and are aborted rather than
+// truncated when store servers fail while sending them
+func TestForwarder_StreamDownload(t *testing.T) {
+	content := strings.Repeat("file content ", 100)
+	backend := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.Header().Set("Content-Length", strconv.Itoa(len(content)))
+		w.WriteHeader(http.StatusOK)
+		_, err := io.WriteString(w, content[:10])
+		assert.Nil(t, err)
+		w.(http.Flusher).Flush()
+		if r.URL.Query().Get("fail") != "" {
+			panic(http.ErrAbortHandler)
+		}
+		time.Sleep(150 * time.Millisecond)
+		_, err = io.WriteString(w, content[10:])
+		assert.Nil(t, err)
+	}))
+	defer backend.Close()
+	host, port, err := net.SplitHostPort(strings.TrimPrefix(backend.URL, "http://"))
+	assert.Nil(t, err)
+	backendPort, err := strconv.Atoi(port)
+	assert.Nil(t, err)
+
+	forwarderMod, err := NewForwarderModule(nil, "http://"+host, 9000, backendPort, "X-User", "X-Access", "link-secret", 50*time.Millisecond, time.Second, false)
+	assert.Nil(t, err)
+	_, engine := gin.CreateTestContext(httptest.NewRecorder())
+	forwarderMod.RegisterRoutes(engine.Group("/api"))
+	gateway := httptest.NewServer(engine)
+	defer gateway.Close()
+	token := sharelink.Sign("link-secret", 3, time.Now().Add(time.Hour))
+
+	t.Run("slow_download", func(t *testing.T) {
+		resp, err := http.Get(gateway.URL + "/api/share/" + token)
+		if !assert.Nil(t, err) {
+			return
+		}
+		defer resp.Body.Close()
+		body, err := io.ReadAll(resp.Body)
+		assert.Nil(t, err)
+		assert.Equal(t, http.StatusOK, resp.StatusCode)
+		assert.Equal(t, content, string(body))
+	})
+	t.Run("failed_download", func(t *testing.T) {
+		// Client fails either on headers or on body, depending on whether gateway sent any of them before aborting
+		resp, err := http.Get(gateway.URL + "/api/share/" + token + "?fail=1")
+		if err == nil {
+			defer resp.Body.Close()
+			_, err = io.ReadAll(resp.Body)
+		}
+		assert.NotNil(t, err)
+	})
 }
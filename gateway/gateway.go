@@ -33,6 +33,10 @@ func NewGatewayServer(settings settings.Settings, database database.Database, re
 		settings.Global.AdminPort,
 		settings.Global.BackendPort,
 		settings.GatewayServer.UserIdHeaderKey,
+		settings.GatewayServer.UserAccessHeaderKey,
+		settings.GatewayServer.ShareLinkSecretKey,
+		settings.GatewayServer.StoreTimeout,
+		settings.GatewayServer.StoreConnectTimeout,
 		true,
 	)
 	if err != nil {
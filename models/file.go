@@ -1,5 +1,7 @@
 package models
 
+import "time"
+
 // File general object contains file details
 type File struct {
 	Name    string
@@ -9,4 +11,92 @@ type File struct {
 	UserId  int
 	Content []byte
 	Tags    []string
+	// BurnAfterRead files are deleted after their first download
+	BurnAfterRead bool
+	// PHash is the perceptual hash of image files, nil for other files
+	PHash *uint64
+	// KeyId is the id of key-encryption key which wrapped WrappedKey, for files stored before envelope encryption
+	// it is the id of key content is encrypted with, empty for files stored before keyring
+	KeyId string
+	// WrappedKey is the data key of file content sealed by key-encryption key KeyId, nil for files stored before envelope encryption
+	WrappedKey []byte
+	// SHA256 is the hex SHA-256 of plaintext content which addresses its blob, empty for files stored before content addressing
+	SHA256 string
+	// Width and Height of images as displayed, zero for other files
+	Width  int
+	Height int
+	// DominantColor is the most common color of images as #rrggbb, empty for other files
+	DominantColor string
+	// CameraMake, CameraModel and TakenAt are read from exif metadata of photos
+	CameraMake  string
+	CameraModel string
+	TakenAt     *time.Time
+	// SourceURL and SearchQuery tell where files saved from search results came from, empty for uploaded files
+	SourceURL   string
+	SearchQuery string
+	CreatedAt   *time.Time
+	// DeletedAt is when file was moved to trash, nil for files which are not trashed
+	DeletedAt *time.Time
+	// DuplicateOf is the uuid of an already stored file this file was deduplicated against
+	DuplicateOf string
+}
+
+// FileMetadata object contains file details sent to clients, without content and key material
+type FileMetadata struct {
+	Id            string     `json:"id"`
+	Name          string     `json:"name"`
+	Size          int        `json:"size"`
+	Type          string     `json:"type"`
+	UserId        int        `json:"userId"`
+	Tags          []string   `json:"tags"`
+	BurnAfterRead bool       `json:"burnAfterRead"`
+	SHA256        string     `json:"sha256,omitempty"`
+	Width         int        `json:"width,omitempty"`
+	Height        int        `json:"height,omitempty"`
+	DominantColor string     `json:"dominantColor,omitempty"`
+	CameraMake    string     `json:"cameraMake,omitempty"`
+	CameraModel   string     `json:"cameraModel,omitempty"`
+	TakenAt       *time.Time `json:"takenAt,omitempty"`
+	SourceURL     string     `json:"sourceUrl,omitempty"`
+	SearchQuery   string     `json:"searchQuery,omitempty"`
+	CreatedAt     *time.Time `json:"createdAt"`
+	DeletedAt     *time.Time `json:"deletedAt,omitempty"`
+}
+
+// FilePage object contains a page of files matching a search, with number of all matching files
+type FilePage struct {
+	Files  []FileMetadata `json:"files"`
+	Total  int            `json:"total"`
+	Limit  int            `json:"limit"`
+	Offset int            `json:"offset"`
+}
+
+// FileList object contains a page of a file list, with cursor of next page which is empty on the last page
+type FileList struct {
+	Files      []FileMetadata `json:"files"`
+	NextCursor string         `json:"next_cursor"`
+}
+
+// TextMatch general object contains a file found by full-text search
+type TextMatch struct {
+	File File
+	// Rank is how well file matches, higher ranks match better
+	Rank float64
+	// Highlight is name and search query of file as html, with matching words in mark tags
+	Highlight string
+}
+
+// TextMatchMetadata object contains a file found by full-text search sent to clients
+type TextMatchMetadata struct {
+	File      FileMetadata `json:"file"`
+	Rank      float64      `json:"rank"`
+	Highlight string       `json:"highlight"`
+}
+
+// TextSearchPage object contains a page of files found by full-text search, the best matches first
+type TextSearchPage struct {
+	Matches []TextMatchMetadata `json:"matches"`
+	Total   int                 `json:"total"`
+	Limit   int                 `json:"limit"`
+	Offset  int                 `json:"offset"`
 }
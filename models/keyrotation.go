@@ -0,0 +1,29 @@
+package models
+
+import "time"
+
+const (
+	// KeyRotationRunning rotation is rewrapping data keys of files
+	KeyRotationRunning = "running"
+
+	// KeyRotationCompleted data key of every file is wrapped by target key
+	KeyRotationCompleted = "completed"
+
+	// KeyRotationFailed rotation stopped before rewrapping data key of every file
+	KeyRotationFailed = "failed"
+)
+
+// KeyRotation general object contains progress of moving data keys of files to a new key-encryption key
+type KeyRotation struct {
+	Id     int    `json:"id"`
+	KeyId  string `json:"keyId"`
+	Status string `json:"status"`
+	// Total is the number of files whose data key was not wrapped by KeyId when rotation started
+	Total      int        `json:"total"`
+	Done       int        `json:"done"`
+	Failed     int        `json:"failed"`
+	Error      string     `json:"error,omitempty"`
+	CreatedAt  time.Time  `json:"createdAt"`
+	UpdatedAt  time.Time  `json:"updatedAt"`
+	FinishedAt *time.Time `json:"finishedAt"`
+}
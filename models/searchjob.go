@@ -0,0 +1,47 @@
+package models
+
+import "time"
+
+const (
+	// SearchJobPending job is queued and waits for a runner
+	SearchJobPending = "pending"
+
+	// SearchJobRunning job is being processed by a runner
+	SearchJobRunning = "running"
+
+	// SearchJobCompleted job has finished searching and saving images
+	SearchJobCompleted = "completed"
+
+	// SearchJobFailed job could not be processed
+	SearchJobFailed = "failed"
+)
+
+// SearchJob general object contains search-and-save job details and progress
+type SearchJob struct {
+	Id         int      `json:"id"`
+	UserId     int      `json:"userId"`
+	Query      string   `json:"query"`
+	MaxResults int      `json:"maxResults"`
+	Status     string   `json:"status"`
+	Saved      int      `json:"saved"`
+	Failed     int      `json:"failed"`
+	FileNames  []string `json:"fileNames"`
+	// Deduplicated counts results skipped because user already had a near-duplicate image
+	Deduplicated int `json:"deduplicated"`
+	// DuplicateFileNames are names of deduplicated results, DuplicateOf has uuid of the already saved file each of them matched
+	DuplicateFileNames []string `json:"duplicateFileNames"`
+	DuplicateOf        []string `json:"duplicateOf"`
+	// CandidateURLs are urls of search results of the job, CandidateThumbnailURLs has thumbnail url of each of them.
+	// They are kept so a resumed job goes on with the same results, since results of another search may differ
+	CandidateURLs          []string `json:"-"`
+	CandidateThumbnailURLs []string `json:"-"`
+	// NextCandidate is index of the first search result which was not processed yet, results before it are skipped when job is resumed
+	NextCandidate int `json:"nextCandidate"`
+	// Runner is id of store process which claimed the job
+	Runner     string     `json:"-"`
+	Error      string     `json:"error,omitempty"`
+	CreatedAt  time.Time  `json:"createdAt"`
+	UpdatedAt  time.Time  `json:"updatedAt"`
+	StartedAt  *time.Time `json:"startedAt"`
+	FinishedAt *time.Time `json:"finishedAt"`
+}
@@ -0,0 +1,41 @@
+package models
+
+import "time"
+
+const (
+	// ShareRead permission lets user download a shared file and read its metadata
+	ShareRead = "read"
+
+	// ShareWrite permission also lets user modify and delete a shared file
+	ShareWrite = "write"
+)
+
+// Share general object contains access to a file given to another user, or to anyone with a link when UserId is nil
+type Share struct {
+	Id         int    `json:"id"`
+	FileId     string `json:"fileId"`
+	UserId     *int   `json:"userId"`
+	Permission string `json:"permission"`
+	// ExpiresAt is when share stops giving access, shares with users may never expire
+	ExpiresAt *time.Time `json:"expiresAt"`
+	// MaxDownloads limits downloads through a link, unlimited when it is nil
+	MaxDownloads *int       `json:"maxDownloads"`
+	Downloads    int        `json:"downloads"`
+	CreatedAt    *time.Time `json:"createdAt"`
+	// Link is path of public share link, only set when link is created
+	Link string `json:"link,omitempty"`
+}
+
+// ShareParameters input parameters for sharing a file with a user
+type ShareParameters struct {
+	UserId     int        `json:"userId"`
+	Permission string     `json:"permission"`
+	ExpiresAt  *time.Time `json:"expiresAt"`
+}
+
+// ShareLinkParameters input parameters for creating a public share link
+type ShareLinkParameters struct {
+	// ExpiresIn is how long link is valid, such as 24h, default expiry of links is used when it is empty
+	ExpiresIn    string `json:"expiresIn"`
+	MaxDownloads *int   `json:"maxDownloads"`
+}
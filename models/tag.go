@@ -0,0 +1,22 @@
+package models
+
+// TagCount object contains a tag and number of files it is set on
+type TagCount struct {
+	Name  string `json:"name"`
+	Count int    `json:"count"`
+}
+
+// FileTagsParameters input parameters for adding tags to a file
+type FileTagsParameters struct {
+	Tags []string `json:"tags"`
+}
+
+// RenameTagParameters input parameters for renaming a tag
+type RenameTagParameters struct {
+	Name string `json:"name"`
+}
+
+// MergeTagParameters input parameters for merging a tag into another tag
+type MergeTagParameters struct {
+	Into string `json:"into"`
+}
@@ -25,6 +25,12 @@ type User struct {
 	LastLoginAt *time.Time `json:"LastLoginAt"`
 }
 
+// UserList object contains a page of user list, with cursor of next page which is empty on the last page
+type UserList struct {
+	Users      []User `json:"users"`
+	NextCursor string `json:"next_cursor"`
+}
+
 // UserWithPassword private object to retrieve user's full details
 type UserWithPassword struct {
 	Password    string     `json:"password"`
@@ -0,0 +1,55 @@
+// Package blobstore stores encrypted file blobs on local disk or an s3 compatible storage.
+package blobstore
+
+import (
+	"context"
+	"io"
+	"time"
+
+	"github.com/lebleuciel/maani/pkg/settings"
+)
+
+const (
+	LocalDriver = "local"
+	S3Driver    = "s3"
+)
+
+// BlobInfo describes a stored blob
+type BlobInfo struct {
+	Key     string
+	Size    int64
+	ModTime time.Time
+}
+
+// BlobStore keeps blobs by slash separated keys, such as ab/cd/abcdef
+type BlobStore interface {
+	// Put stores content of r under key, replacing an existing blob atomically once r is fully read
+	Put(ctx context.Context, key string, r io.Reader) error
+	// Get streams content of blob, ErrNotFound is returned when there is no such blob
+	Get(ctx context.Context, key string) (io.ReadCloser, BlobInfo, error)
+	// Delete removes blob, removing a missing blob is not an error
+	Delete(ctx context.Context, key string) error
+	// Stat describes blob without reading it, ErrNotFound is returned when there is no such blob
+	Stat(ctx context.Context, key string) (BlobInfo, error)
+	// List describes every blob whose key starts with prefix, ordered by key
+	List(ctx context.Context, prefix string) ([]BlobInfo, error)
+}
+
+// NewBlobStore returns the driver chosen by blobStore.driver
+func NewBlobStore(st settings.Settings) (BlobStore, error) {
+	switch st.BlobStore.Driver {
+	case "", LocalDriver:
+		return NewLocalStore(st.BackendServer.FilePath)
+	case S3Driver:
+		return NewS3Store(S3Options{
+			Endpoint:  st.BlobStore.Endpoint,
+			Region:    st.BlobStore.Region,
+			Bucket:    st.BlobStore.Bucket,
+			Prefix:    st.BlobStore.Prefix,
+			AccessKey: st.BlobStore.AccessKey,
+			SecretKey: st.BlobStore.SecretKey,
+			UseSSL:    st.BlobStore.UseSSL,
+		})
+	}
+	return nil, ErrUnknownDriver
+}
@@ -0,0 +1,87 @@
+package blobstore
+
+import (
+	"bytes"
+	"context"
+	"errors"
+	"io"
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+// testBlobStore runs the same behavior checks against every driver
+func testBlobStore(t *testing.T, store BlobStore) {
+	ctx := context.Background()
+
+	t.Run("Put and get", func(t *testing.T) {
+		content := bytes.Repeat([]byte("blob content "), 1000)
+		assert.NoError(t, store.Put(ctx, "ab/cd/first", bytes.NewReader(content)))
+
+		r, info, err := store.Get(ctx, "ab/cd/first")
+		assert.NoError(t, err)
+		defer r.Close()
+		assert.Equal(t, "ab/cd/first", info.Key)
+		assert.Equal(t, int64(len(content)), info.Size)
+		got, err := io.ReadAll(r)
+		assert.NoError(t, err)
+		assert.Equal(t, content, got)
+	})
+
+	t.Run("Put replaces blob", func(t *testing.T) {
+		assert.NoError(t, store.Put(ctx, "replaced", strings.NewReader("old")))
+		assert.NoError(t, store.Put(ctx, "replaced", strings.NewReader("new content")))
+		info, err := store.Stat(ctx, "replaced")
+		assert.NoError(t, err)
+		assert.Equal(t, int64(len("new content")), info.Size)
+	})
+
+	t.Run("Failed put keeps no blob", func(t *testing.T) {
+		err := store.Put(ctx, "broken", io.MultiReader(strings.NewReader("partial"), errReader{}))
+		assert.Error(t, err)
+		_, err = store.Stat(ctx, "broken")
+		assert.ErrorIs(t, err, ErrNotFound)
+	})
+
+	t.Run("Missing blob", func(t *testing.T) {
+		_, _, err := store.Get(ctx, "missing")
+		assert.ErrorIs(t, err, ErrNotFound)
+		_, err = store.Stat(ctx, "missing")
+		assert.ErrorIs(t, err, ErrNotFound)
+		assert.NoError(t, store.Delete(ctx, "missing"))
+	})
+
+	t.Run("List and delete", func(t *testing.T) {
+		assert.NoError(t, store.Put(ctx, "ab/cd/second", strings.NewReader("second")))
+		assert.NoError(t, store.Put(ctx, "ef/gh/third", strings.NewReader("third")))
+
+		blobs, err := store.List(ctx, "ab/")
+		assert.NoError(t, err)
+		keys := make([]string, 0, len(blobs))
+		for _, blob := range blobs {
+			keys = append(keys, blob.Key)
+		}
+		assert.Equal(t, []string{"ab/cd/first", "ab/cd/second"}, keys)
+
+		assert.NoError(t, store.Delete(ctx, "ab/cd/first"))
+		_, err = store.Stat(ctx, "ab/cd/first")
+		assert.ErrorIs(t, err, ErrNotFound)
+		blobs, err = store.List(ctx, "")
+		assert.NoError(t, err)
+		assert.Len(t, blobs, 3)
+	})
+
+	t.Run("Invalid key", func(t *testing.T) {
+		assert.ErrorIs(t, store.Put(ctx, "/absolute", strings.NewReader("x")), ErrInvalidKey)
+		assert.ErrorIs(t, store.Put(ctx, "", strings.NewReader("x")), ErrInvalidKey)
+	})
+}
+
+var errBrokenReader = errors.New("broken reader")
+
+type errReader struct{}
+
+func (errReader) Read([]byte) (int, error) {
+	return 0, errBrokenReader
+}
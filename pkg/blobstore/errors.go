@@ -0,0 +1,8 @@
+package blobstore
+
+import "github.com/pkg/errors"
+
+var ErrNotFound = errors.New("blob not found")
+var ErrInvalidKey = errors.New("blob key must be a relative slash separated path")
+var ErrUnknownDriver = errors.New("blob store driver is not supported, supports: local, s3")
+var ErrMissingBucket = errors.New("bucket of s3 blob store is required")
@@ -0,0 +1,159 @@
+package blobstore
+
+import (
+	"context"
+	"io"
+	"io/fs"
+	"os"
+	"path"
+	"path/filepath"
+	"sort"
+	"strings"
+)
+
+// tempPrefix marks partially written blobs, which are never listed
+const tempPrefix = ".upload-"
+
+// LocalStore keeps blobs as files under a directory of local disk
+type LocalStore struct {
+	dir string
+}
+
+// NewLocalStore keeps blobs under dir, empty dir is working directory
+func NewLocalStore(dir string) (*LocalStore, error) {
+	return &LocalStore{dir: dir}, nil
+}
+
+// path returns file path of key, keys escaping store directory are rejected
+func (l *LocalStore) path(key string) (string, error) {
+	if key == "" || strings.HasPrefix(key, "/") || strings.Contains(key, "\\") || path.Clean(key) != key || strings.HasPrefix(key, "../") || key == ".." {
+		return "", ErrInvalidKey
+	}
+	for _, part := range strings.Split(key, "/") {
+		if strings.HasPrefix(part, tempPrefix) {
+			return "", ErrInvalidKey
+		}
+	}
+	return filepath.Join(l.dir, filepath.FromSlash(key)), nil
+}
+
+func (l *LocalStore) Put(ctx context.Context, key string, r io.Reader) (err error) {
+	dest, err := l.path(key)
+	if err != nil {
+		return err
+	}
+	err = os.MkdirAll(filepath.Dir(dest), os.ModePerm)
+	if err != nil {
+		return err
+	}
+
+	// Content is written to a temporary file which is renamed once complete, so partial blobs are never visible
+	tmp, err := os.CreateTemp(filepath.Dir(dest), tempPrefix+"*")
+	if err != nil {
+		return err
+	}
+	defer func() {
+		if err != nil {
+			tmp.Close()
+			os.Remove(tmp.Name())
+		}
+	}()
+	if _, err = io.Copy(tmp, r); err != nil {
+		return err
+	}
+	if err = ctx.Err(); err != nil {
+		return err
+	}
+	if err = tmp.Close(); err != nil {
+		return err
+	}
+	return os.Rename(tmp.Name(), dest)
+}
+
+func (l *LocalStore) Get(ctx context.Context, key string) (io.ReadCloser, BlobInfo, error) {
+	p, err := l.path(key)
+	if err != nil {
+		return nil, BlobInfo{}, err
+	}
+	f, err := os.Open(p)
+	if err != nil {
+		return nil, BlobInfo{}, notFound(err)
+	}
+	info, err := f.Stat()
+	if err != nil {
+		f.Close()
+		return nil, BlobInfo{}, err
+	}
+	return f, BlobInfo{Key: key, Size: info.Size(), ModTime: info.ModTime()}, nil
+}
+
+func (l *LocalStore) Delete(ctx context.Context, key string) error {
+	p, err := l.path(key)
+	if err != nil {
+		return err
+	}
+	err = os.Remove(p)
+	if os.IsNotExist(err) {
+		return nil
+	}
+	return err
+}
+
+func (l *LocalStore) Stat(ctx context.Context, key string) (BlobInfo, error) {
+	p, err := l.path(key)
+	if err != nil {
+		return BlobInfo{}, err
+	}
+	info, err := os.Stat(p)
+	if err != nil {
+		return BlobInfo{}, notFound(err)
+	}
+	if info.IsDir() {
+		return BlobInfo{}, ErrNotFound
+	}
+	return BlobInfo{Key: key, Size: info.Size(), ModTime: info.ModTime()}, nil
+}
+
+func (l *LocalStore) List(ctx context.Context, prefix string) ([]BlobInfo, error) {
+	var blobs []BlobInfo
+	err := filepath.WalkDir(l.dir, func(p string, d fs.DirEntry, err error) error {
+		if err != nil {
+			if os.IsNotExist(err) && p == l.dir {
+				return fs.SkipDir
+			}
+			return err
+		}
+		if err := ctx.Err(); err != nil {
+			return err
+		}
+		if d.IsDir() || strings.HasPrefix(d.Name(), tempPrefix) {
+			return nil
+		}
+		rel, err := filepath.Rel(l.dir, p)
+		if err != nil {
+			return err
+		}
+		key := filepath.ToSlash(rel)
+		if !strings.HasPrefix(key, prefix) {
+			return nil
+		}
+		info, err := d.Info()
+		if err != nil {
+			return err
+		}
+		blobs = append(blobs, BlobInfo{Key: key, Size: info.Size(), ModTime: info.ModTime()})
+		return nil
+	})
+	if err != nil {
+		return nil, err
+	}
+	sort.Slice(blobs, func(i, j int) bool { return blobs[i].Key < blobs[j].Key })
+	return blobs, nil
+}
+
+func notFound(err error) error {
+	if os.IsNotExist(err) {
+		return ErrNotFound
+	}
+	return err
+}
@@ -0,0 +1,37 @@
+package blobstore
+
+import (
+	"context"
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestLocalStore(t *testing.T) {
+	dir := t.TempDir()
+	store, err := NewLocalStore(dir)
+	assert.NoError(t, err)
+	testBlobStore(t, store)
+
+	// Partially written blobs are cleaned up
+	entries, err := os.ReadDir(dir)
+	assert.NoError(t, err)
+	for _, entry := range entries {
+		assert.False(t, strings.HasPrefix(entry.Name(), tempPrefix))
+	}
+}
+
+func TestLocalStore_EscapingKeys(t *testing.T) {
+	dir := t.TempDir()
+	store, err := NewLocalStore(filepath.Join(dir, "blobs"))
+	assert.NoError(t, err)
+
+	for _, key := range []string{"../outside", "a/../../outside", "a/./b", "a//b", `a\b`, tempPrefix + "x"} {
+		assert.ErrorIs(t, store.Put(context.Background(), key, strings.NewReader("x")), ErrInvalidKey, key)
+	}
+	_, err = os.Stat(filepath.Join(dir, "outside"))
+	assert.True(t, os.IsNotExist(err))
+}
@@ -0,0 +1,143 @@
+package blobstore
+
+import (
+	"context"
+	"io"
+	"net/http"
+	"strings"
+
+	"github.com/minio/minio-go/v7"
+	"github.com/minio/minio-go/v7/pkg/credentials"
+)
+
+// s3PartSize bounds memory used for uploading blobs of unknown size, blobs up to 10000 parts can be stored
+const s3PartSize = 16 << 20
+
+// S3Options configures connection to an s3 compatible storage, such as minio
+type S3Options struct {
+	Endpoint  string
+	Region    string
+	Bucket    string
+	Prefix    string
+	AccessKey string
+	SecretKey string
+	UseSSL    bool
+	// Transport overrides http transport of client, nil uses default transport
+	Transport http.RoundTripper
+}
+
+// S3Store keeps blobs as objects of a bucket, so every store replica sees the same blobs
+type S3Store struct {
+	client *minio.Client
+	bucket string
+	prefix string
+}
+
+func NewS3Store(opts S3Options) (*S3Store, error) {
+	if opts.Bucket == "" {
+		return nil, ErrMissingBucket
+	}
+	client, err := minio.New(opts.Endpoint, &minio.Options{
+		Creds:     credentials.NewStaticV4(opts.AccessKey, opts.SecretKey, ""),
+		Secure:    opts.UseSSL,
+		Region:    opts.Region,
+		Transport: opts.Transport,
+	})
+	if err != nil {
+		return nil, err
+	}
+	return &S3Store{
+		client: client,
+		bucket: opts.Bucket,
+		prefix: opts.Prefix,
+	}, nil
+}
+
+func (s *S3Store) object(key string) (string, error) {
+	if key == "" || strings.HasPrefix(key, "/") {
+		return "", ErrInvalidKey
+	}
+	return s.prefix + key, nil
+}
+
+func (s *S3Store) Put(ctx context.Context, key string, r io.Reader) error {
+	object, err := s.object(key)
+	if err != nil {
+		return err
+	}
+	// Objects only become visible once upload is complete, so replacing a blob is atomic
+	_, err = s.client.PutObject(ctx, s.bucket, object, r, -1, minio.PutObjectOptions{
+		ContentType: "application/octet-stream",
+		PartSize:    s3PartSize,
+	})
+	return err
+}
+
+func (s *S3Store) Get(ctx context.Context, key string) (io.ReadCloser, BlobInfo, error) {
+	object, err := s.object(key)
+	if err != nil {
+		return nil, BlobInfo{}, err
+	}
+	obj, err := s.client.GetObject(ctx, s.bucket, object, minio.GetObjectOptions{})
+	if err != nil {
+		return nil, BlobInfo{}, s3Error(err)
+	}
+	info, err := obj.Stat()
+	if err != nil {
+		obj.Close()
+		return nil, BlobInfo{}, s3Error(err)
+	}
+	return obj, BlobInfo{Key: key, Size: info.Size, ModTime: info.LastModified}, nil
+}
+
+func (s *S3Store) Delete(ctx context.Context, key string) error {
+	object, err := s.object(key)
+	if err != nil {
+		return err
+	}
+	return s3Error(s.client.RemoveObject(ctx, s.bucket, object, minio.RemoveObjectOptions{}))
+}
+
+func (s *S3Store) Stat(ctx context.Context, key string) (BlobInfo, error) {
+	object, err := s.object(key)
+	if err != nil {
+		return BlobInfo{}, err
+	}
+	info, err := s.client.StatObject(ctx, s.bucket, object, minio.StatObjectOptions{})
+	if err != nil {
+		return BlobInfo{}, s3Error(err)
+	}
+	return BlobInfo{Key: key, Size: info.Size, ModTime: info.LastModified}, nil
+}
+
+func (s *S3Store) List(ctx context.Context, prefix string) ([]BlobInfo, error) {
+	// Listing runs in background until its channel is drained or ctx is done, so it is cancelled when listing stops early
+	ctx, cancel := context.WithCancel(ctx)
+	defer cancel()
+
+	var blobs []BlobInfo
+	for info := range s.client.ListObjects(ctx, s.bucket, minio.ListObjectsOptions{
+		Prefix:    s.prefix + prefix,
+		Recursive: true,
+	}) {
+		if info.Err != nil {
+			return nil, s3Error(info.Err)
+		}
+		blobs = append(blobs, BlobInfo{
+			Key:     strings.TrimPrefix(info.Key, s.prefix),
+			Size:    info.Size,
+			ModTime: info.LastModified,
+		})
+	}
+	return blobs, nil
+}
+
+func s3Error(err error) error {
+	if err == nil {
+		return nil
+	}
+	if minio.ToErrorResponse(err).Code == "NoSuchKey" {
+		return ErrNotFound
+	}
+	return err
+}
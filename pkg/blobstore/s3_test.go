@@ -0,0 +1,221 @@
+package blobstore
+
+import (
+	"bufio"
+	"bytes"
+	"encoding/xml"
+	"fmt"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"net/url"
+	"sort"
+	"strconv"
+	"strings"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+)
+
+// s3StandIn is an in-memory MinIO-style server implementing the subset of s3 api used by S3Store
+type s3StandIn struct {
+	mu      sync.Mutex
+	bucket  string
+	objects map[string][]byte
+	uploads map[string]map[int][]byte
+	nextId  int
+}
+
+func newS3StandIn(bucket string) *httptest.Server {
+	s := &s3StandIn{
+		bucket:  bucket,
+		objects: make(map[string][]byte),
+		uploads: make(map[string]map[int][]byte),
+	}
+	return httptest.NewServer(s)
+}
+
+func (s *s3StandIn) ServeHTTP(w http.ResponseWriter, r *http.Request) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	bucket, key, _ := strings.Cut(strings.TrimPrefix(r.URL.Path, "/"), "/")
+	if bucket != s.bucket {
+		s.error(w, http.StatusNotFound, "NoSuchBucket")
+		return
+	}
+	query := r.URL.Query()
+	switch {
+	case key == "" && r.Method == http.MethodGet:
+		s.list(w, query.Get("prefix"))
+	case r.Method == http.MethodPost && query.Has("uploads"):
+		s.nextId++
+		uploadId := strconv.Itoa(s.nextId)
+		s.uploads[uploadId] = make(map[int][]byte)
+		s.xml(w, struct {
+			XMLName  xml.Name `xml:"InitiateMultipartUploadResult"`
+			Bucket   string
+			Key      string
+			UploadId string
+		}{Bucket: bucket, Key: key, UploadId: uploadId})
+	case r.Method == http.MethodPut && query.Has("uploadId"):
+		parts, ok := s.uploads[query.Get("uploadId")]
+		if !ok {
+			s.error(w, http.StatusNotFound, "NoSuchUpload")
+			return
+		}
+		body, err := readBody(r)
+		if err != nil {
+			s.error(w, http.StatusBadRequest, "IncompleteBody")
+			return
+		}
+		partNumber, _ := strconv.Atoi(query.Get("partNumber"))
+		parts[partNumber] = body
+		w.Header().Set("ETag", fmt.Sprintf(`"part-%d"`, partNumber))
+	case r.Method == http.MethodPost && query.Has("uploadId"):
+		parts, ok := s.uploads[query.Get("uploadId")]
+		if !ok {
+			s.error(w, http.StatusNotFound, "NoSuchUpload")
+			return
+		}
+		numbers := make([]int, 0, len(parts))
+		for n := range parts {
+			numbers = append(numbers, n)
+		}
+		sort.Ints(numbers)
+		var content []byte
+		for _, n := range numbers {
+			content = append(content, parts[n]...)
+		}
+		s.objects[key] = content
+		delete(s.uploads, query.Get("uploadId"))
+		s.xml(w, struct {
+			XMLName xml.Name `xml:"CompleteMultipartUploadResult"`
+			Bucket  string
+			Key     string
+			ETag    string
+		}{Bucket: bucket, Key: key, ETag: `"complete"`})
+	case r.Method == http.MethodDelete && query.Has("uploadId"):
+		delete(s.uploads, query.Get("uploadId"))
+		w.WriteHeader(http.StatusNoContent)
+	case r.Method == http.MethodPut:
+		body, err := readBody(r)
+		if err != nil {
+			s.error(w, http.StatusBadRequest, "IncompleteBody")
+			return
+		}
+		s.objects[key] = body
+		w.Header().Set("ETag", `"object"`)
+	case r.Method == http.MethodGet || r.Method == http.MethodHead:
+		content, ok := s.objects[key]
+		if !ok {
+			s.error(w, http.StatusNotFound, "NoSuchKey")
+			return
+		}
+		w.Header().Set("Content-Length", strconv.Itoa(len(content)))
+		w.Header().Set("Last-Modified", time.Now().UTC().Format(http.TimeFormat))
+		w.Header().Set("ETag", `"object"`)
+		if r.Method == http.MethodGet {
+			w.Write(content)
+		}
+	case r.Method == http.MethodDelete:
+		delete(s.objects, key)
+		w.WriteHeader(http.StatusNoContent)
+	default:
+		s.error(w, http.StatusNotImplemented, "NotImplemented")
+	}
+}
+
+func (s *s3StandIn) list(w http.ResponseWriter, prefix string) {
+	type object struct {
+		Key          string
+		Size         int
+		LastModified string
+		ETag         string
+	}
+	result := struct {
+		XMLName     xml.Name `xml:"ListBucketResult"`
+		Name        string
+		Prefix      string
+		KeyCount    int
+		MaxKeys     int
+		IsTruncated bool
+		Contents    []object
+	}{Name: s.bucket, Prefix: prefix, MaxKeys: 1000}
+	for key, content := range s.objects {
+		if strings.HasPrefix(key, prefix) {
+			result.Contents = append(result.Contents, object{Key: key, Size: len(content), LastModified: time.Now().UTC().Format(time.RFC3339), ETag: `"object"`})
+		}
+	}
+	sort.Slice(result.Contents, func(i, j int) bool { return result.Contents[i].Key < result.Contents[j].Key })
+	result.KeyCount = len(result.Contents)
+	s.xml(w, result)
+}
+
+func (s *s3StandIn) error(w http.ResponseWriter, status int, code string) {
+	w.Header().Set("Content-Type", "application/xml")
+	w.WriteHeader(status)
+	xml.NewEncoder(w).Encode(struct {
+		XMLName xml.Name `xml:"Error"`
+		Code    string
+	}{Code: code})
+}
+
+func (s *s3StandIn) xml(w http.ResponseWriter, v interface{}) {
+	w.Header().Set("Content-Type", "application/xml")
+	xml.NewEncoder(w).Encode(v)
+}
+
+// readBody reads request body, decoding aws-chunked bodies of streaming signatures
+func readBody(r *http.Request) ([]byte, error) {
+	if !strings.HasPrefix(r.Header.Get("X-Amz-Content-Sha256"), "STREAMING-") {
+		return io.ReadAll(r.Body)
+	}
+	var content bytes.Buffer
+	reader := bufio.NewReader(r.Body)
+	for {
+		line, err := reader.ReadString('\n')
+		if err != nil {
+			return nil, err
+		}
+		sizeHex, _, _ := strings.Cut(strings.TrimSpace(line), ";")
+		size, err := strconv.ParseInt(sizeHex, 16, 64)
+		if err != nil {
+			return nil, err
+		}
+		if size == 0 {
+			return content.Bytes(), nil
+		}
+		if _, err := io.CopyN(&content, reader, size); err != nil {
+			return nil, err
+		}
+		if _, err := reader.Discard(2); err != nil {
+			return nil, err
+		}
+	}
+}
+
+func TestS3Store(t *testing.T) {
+	server := newS3StandIn("maani")
+	defer server.Close()
+	endpoint, err := url.Parse(server.URL)
+	assert.NoError(t, err)
+
+	store, err := NewS3Store(S3Options{
+		Endpoint:  endpoint.Host,
+		Region:    "us-east-1",
+		Bucket:    "maani",
+		Prefix:    "files/",
+		AccessKey: "access",
+		SecretKey: "secret",
+	})
+	assert.NoError(t, err)
+	testBlobStore(t, store)
+}
+
+func TestNewS3Store_MissingBucket(t *testing.T) {
+	_, err := NewS3Store(S3Options{Endpoint: "localhost:9000"})
+	assert.ErrorIs(t, err, ErrMissingBucket)
+}
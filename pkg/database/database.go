@@ -3,8 +3,10 @@ package database
 import (
 	"context"
 	"database/sql"
+	"time"
 
 	"github.com/lebleuciel/maani/models"
+	"github.com/lebleuciel/maani/pkg/filequery"
 )
 
 const (
@@ -17,6 +19,10 @@ type Database interface {
 	TransactionMethods
 	UsersDatabaseMethods
 	FilesDatabaseMethods
+	SearchJobsDatabaseMethods
+	KeyRotationsDatabaseMethods
+	SharesDatabaseMethods
+	TagsDatabaseMethods
 }
 
 type (
@@ -30,7 +36,8 @@ type (
 		GetUserByEmail(email string) (*models.UserWithPassword, error)
 		CreateUser(spec models.UserCreationParameters) (models.User, error)
 		UpdateUserLastLogin(userId int) error
-		GetUserList() ([]models.User, error)
+		// GetUserList returns a page of users sorted and filtered by options, with cursor of next page which is empty on the last page
+		GetUserList(options ListOptions) ([]models.User, string, error)
 	}
 
 	// FilesDatabaseMethods to manage Files Repository Methods
@@ -39,8 +46,87 @@ type (
 		GetFileTypes() ([]models.FileType, error)
 		GetFilesSize() (int, error)
 		SaveFile(models.File) error
-		GetFile([]string, []string) (models.File, error)
-		GetFileList() ([]models.File, error)
+		// FindFiles returns files in scope with any of names and any of tags, an empty list matches every file
+		FindFiles(scope Scope, names []string, tags []string) ([]models.File, error)
+		// QueryFiles returns a page of files in scope matching expr, the most recently stored first, with number of all matching files
+		QueryFiles(scope Scope, expr filequery.Expr, limit int, offset int) ([]models.File, int, error)
+		// SearchFilesText returns a page of files in scope whose name, tags or search query have words of text, or words
+		// starting with them, the best matches first, with number of all matching files
+		SearchFilesText(scope Scope, text string, limit int, offset int) ([]models.TextMatch, int, error)
+		GetFileByUUID(scope Scope, uuid string) (models.File, error)
+		// DeleteFile removes file for good, TrashFile moves it to trash which can be restored
+		DeleteFile(scope Scope, uuid string) error
+		TrashFile(scope Scope, uuid string) error
+		RestoreFile(scope Scope, uuid string) error
+		// GetTrashList returns trashed files in scope, the most recently trashed first
+		GetTrashList(scope Scope) ([]models.File, error)
+		// GetFilesToPurge returns files trashed before trashedBefore
+		GetFilesToPurge(trashedBefore time.Time, limit int) ([]models.File, error)
+		// GetFileList returns a page of files in scope sorted and filtered by options, with cursor of next page which is empty on the last page
+		GetFileList(scope Scope, options ListOptions) ([]models.File, string, error)
+		// FindSimilarFile returns a file of user whose perceptual hash differs in at most maxDistance bits, burn after read
+		// files are left out since they are removed once read. Nil is returned when there is none
+		FindSimilarFile(userId int, phash uint64, maxDistance int) (*models.File, error)
+		UpdateFileKey(uuid string, keyId string, wrappedKey []byte) error
+		// GetFileByChecksum returns a file whose content has SHA-256 checksum, nil when there is none
+		GetFileByChecksum(sha256 string) (*models.File, error)
+		CountFilesByChecksum(sha256 string) (int, error)
+		// LockChecksum waits for and takes a lock on blob of content with sha256 checksum, which is held across store servers
+		// until the returned function is called. Blobs are written and removed under it, so files sharing a blob share its data key
+		LockChecksum(sha256 string) (func(), error)
+		UpdateFileBlob(uuid string, sha256 string, keyId string, wrappedKey []byte) error
+		UpdateFileType(uuid string, typeId string) error
+		// GetFilesToMigrate pages files stored before content addressing ordered by uuid, starting after afterUUID
+		GetFilesToMigrate(afterUUID string, limit int) ([]models.File, error)
+	}
+
+	// SearchJobsDatabaseMethods to manage search-and-save jobs
+	SearchJobsDatabaseMethods interface {
+		CreateSearchJob(models.SearchJob) (models.SearchJob, error)
+		// UpdateSearchJob saves progress of a job, ErrSearchJobClaimed is returned when its runner doesn't hold the job anymore
+		UpdateSearchJob(models.SearchJob) error
+		// ClaimSearchJob makes runner the only runner of a pending job, or of a running job whose runner hasn't reported
+		// since staleBefore, and returns the claimed job. ErrSearchJobClaimed is returned when job can't be claimed.
+		ClaimSearchJob(id int, runner string, staleBefore time.Time) (models.SearchJob, error)
+		// HeartbeatSearchJob reports runner is still running a job, ErrSearchJobClaimed is returned when it doesn't hold it anymore
+		HeartbeatSearchJob(id int, runner string) error
+		GetSearchJob(id int) (models.SearchJob, error)
+		GetSearchJobList(scope Scope) ([]models.SearchJob, error)
+		GetUnfinishedSearchJobs() ([]models.SearchJob, error)
+	}
+
+	// SharesDatabaseMethods to manage access to files given to other users and to anyone with a link
+	SharesDatabaseMethods interface {
+		// CreateShare shares file with a user, or with anyone with a link when UserId is nil.
+		// Sharing a file with a user it is already shared with updates the share.
+		CreateShare(models.Share) (models.Share, error)
+		GetShareList(fileUUID string) ([]models.Share, error)
+		DeleteShare(fileUUID string, id int) error
+		// UseShareLink counts a download through link of share and returns it, ErrShareUsedUp is returned when it expired or has no downloads left
+		UseShareLink(id int) (models.Share, error)
+	}
+
+	// TagsDatabaseMethods to manage tags of files, tags are normalized before they reach database
+	TagsDatabaseMethods interface {
+		// GetTagList returns tags starting with prefix and number of files in scope they are set on, the most used first
+		GetTagList(scope Scope, prefix string, limit int) ([]models.TagCount, error)
+		// AddFileTags and RemoveFileTags change tags of file in scope and return its tags
+		AddFileTags(scope Scope, uuid string, tags []string) ([]string, error)
+		RemoveFileTags(scope Scope, uuid string, tags []string) ([]string, error)
+		// RenameTag fails with ErrTagExists when newName is already a tag, MergeTags is used to join them instead
+		RenameTag(name string, newName string) error
+		// MergeTags sets tag into on files of tag name and removes tag name
+		MergeTags(name string, into string) error
+	}
+
+	// KeyRotationsDatabaseMethods to track moving data keys of files to a new key-encryption key
+	KeyRotationsDatabaseMethods interface {
+		CreateKeyRotation(models.KeyRotation) (models.KeyRotation, error)
+		UpdateKeyRotation(models.KeyRotation) error
+		GetRunningKeyRotation(keyId string) (*models.KeyRotation, error)
+		// GetFilesToRotate pages files without a data key wrapped by keyId ordered by uuid, starting after afterUUID
+		GetFilesToRotate(keyId string, afterUUID string, limit int) ([]models.File, error)
+		CountFilesToRotate(keyId string) (int, error)
 	}
 )
 
@@ -17,6 +17,9 @@ import (
 	"entgo.io/ent/dialect/sql/sqlgraph"
 	"github.com/lebleuciel/maani/pkg/database/ent/file"
 	"github.com/lebleuciel/maani/pkg/database/ent/filetype"
+	"github.com/lebleuciel/maani/pkg/database/ent/keyrotation"
+	"github.com/lebleuciel/maani/pkg/database/ent/searchjob"
+	"github.com/lebleuciel/maani/pkg/database/ent/share"
 	"github.com/lebleuciel/maani/pkg/database/ent/tag"
 	"github.com/lebleuciel/maani/pkg/database/ent/user"
 )
@@ -30,6 +33,12 @@ type Client struct {
 	File *FileClient
 	// Filetype is the client for interacting with the Filetype builders.
 	Filetype *FiletypeClient
+	// KeyRotation is the client for interacting with the KeyRotation builders.
+	KeyRotation *KeyRotationClient
+	// SearchJob is the client for interacting with the SearchJob builders.
+	SearchJob *SearchJobClient
+	// Share is the client for interacting with the Share builders.
+	Share *ShareClient
 	// Tag is the client for interacting with the Tag builders.
 	Tag *TagClient
 	// User is the client for interacting with the User builders.
@@ -47,6 +56,9 @@ func (c *Client) init() {
 	c.Schema = migrate.NewSchema(c.driver)
 	c.File = NewFileClient(c.config)
 	c.Filetype = NewFiletypeClient(c.config)
+	c.KeyRotation = NewKeyRotationClient(c.config)
+	c.SearchJob = NewSearchJobClient(c.config)
+	c.Share = NewShareClient(c.config)
 	c.Tag = NewTagClient(c.config)
 	c.User = NewUserClient(c.config)
 }
@@ -139,12 +151,15 @@ func (c *Client) Tx(ctx context.Context) (*Tx, error) {
 	cfg := c.config
 	cfg.driver = tx
 	return &Tx{
-		ctx:      ctx,
-		config:   cfg,
-		File:     NewFileClient(cfg),
-		Filetype: NewFiletypeClient(cfg),
-		Tag:      NewTagClient(cfg),
-		User:     NewUserClient(cfg),
+		ctx:         ctx,
+		config:      cfg,
+		File:        NewFileClient(cfg),
+		Filetype:    NewFiletypeClient(cfg),
+		KeyRotation: NewKeyRotationClient(cfg),
+		SearchJob:   NewSearchJobClient(cfg),
+		Share:       NewShareClient(cfg),
+		Tag:         NewTagClient(cfg),
+		User:        NewUserClient(cfg),
 	}, nil
 }
 
@@ -162,12 +177,15 @@ func (c *Client) BeginTx(ctx context.Context, opts *sql.TxOptions) (*Tx, error)
 	cfg := c.config
 	cfg.driver = &txDriver{tx: tx, drv: c.driver}
 	return &Tx{
-		ctx:      ctx,
-		config:   cfg,
-		File:     NewFileClient(cfg),
-		Filetype: NewFiletypeClient(cfg),
-		Tag:      NewTagClient(cfg),
-		User:     NewUserClient(cfg),
+		ctx:         ctx,
+		config:      cfg,
+		File:        NewFileClient(cfg),
+		Filetype:    NewFiletypeClient(cfg),
+		KeyRotation: NewKeyRotationClient(cfg),
+		SearchJob:   NewSearchJobClient(cfg),
+		Share:       NewShareClient(cfg),
+		Tag:         NewTagClient(cfg),
+		User:        NewUserClient(cfg),
 	}, nil
 }
 
@@ -196,19 +214,21 @@ func (c *Client) Close() error {
 // Use adds the mutation hooks to all the entity clients.
 // In order to add hooks to a specific client, call: `client.Node.Use(...)`.
 func (c *Client) Use(hooks ...Hook) {
-	c.File.Use(hooks...)
-	c.Filetype.Use(hooks...)
-	c.Tag.Use(hooks...)
-	c.User.Use(hooks...)
+	for _, n := range []interface{ Use(...Hook) }{
+		c.File, c.Filetype, c.KeyRotation, c.SearchJob, c.Share, c.Tag, c.User,
+	} {
+		n.Use(hooks...)
+	}
 }
 
 // Intercept adds the query interceptors to all the entity clients.
 // In order to add interceptors to a specific client, call: `client.Node.Intercept(...)`.
 func (c *Client) Intercept(interceptors ...Interceptor) {
-	c.File.Intercept(interceptors...)
-	c.Filetype.Intercept(interceptors...)
-	c.Tag.Intercept(interceptors...)
-	c.User.Intercept(interceptors...)
+	for _, n := range []interface{ Intercept(...Interceptor) }{
+		c.File, c.Filetype, c.KeyRotation, c.SearchJob, c.Share, c.Tag, c.User,
+	} {
+		n.Intercept(interceptors...)
+	}
 }
 
 // Mutate implements the ent.Mutator interface.
@@ -218,6 +238,12 @@ func (c *Client) Mutate(ctx context.Context, m Mutation) (Value, error) {
 		return c.File.mutate(ctx, m)
 	case *FiletypeMutation:
 		return c.Filetype.mutate(ctx, m)
+	case *KeyRotationMutation:
+		return c.KeyRotation.mutate(ctx, m)
+	case *SearchJobMutation:
+		return c.SearchJob.mutate(ctx, m)
+	case *ShareMutation:
+		return c.Share.mutate(ctx, m)
 	case *TagMutation:
 		return c.Tag.mutate(ctx, m)
 	case *UserMutation:
@@ -383,6 +409,22 @@ func (c *FileClient) QueryTags(f *File) *TagQuery {
 	return query
 }
 
+// QueryShares queries the shares edge of a File.
+func (c *FileClient) QueryShares(f *File) *ShareQuery {
+	query := (&ShareClient{config: c.config}).Query()
+	query.path = func(context.Context) (fromV *sql.Selector, _ error) {
+		id := f.ID
+		step := sqlgraph.NewStep(
+			sqlgraph.From(file.Table, file.FieldID, id),
+			sqlgraph.To(share.Table, share.FieldID),
+			sqlgraph.Edge(sqlgraph.O2M, false, file.SharesTable, file.SharesColumn),
+		)
+		fromV = sqlgraph.Neighbors(f.driver.Dialect(), step)
+		return fromV, nil
+	}
+	return query
+}
+
 // Hooks returns the client hooks.
 func (c *FileClient) Hooks() []Hook {
 	return c.hooks.File
@@ -390,7 +432,8 @@ func (c *FileClient) Hooks() []Hook {
 
 // Interceptors returns the client interceptors.
 func (c *FileClient) Interceptors() []Interceptor {
-	return c.inters.File
+	inters := c.inters.File
+	return append(inters[:len(inters):len(inters)], file.Interceptors[:]...)
 }
 
 func (c *FileClient) mutate(ctx context.Context, m *FileMutation) (Value, error) {
@@ -557,6 +600,453 @@ func (c *FiletypeClient) mutate(ctx context.Context, m *FiletypeMutation) (Value
 	}
 }
 
+// KeyRotationClient is a client for the KeyRotation schema.
+type KeyRotationClient struct {
+	config
+}
+
+// NewKeyRotationClient returns a client for the KeyRotation from the given config.
+func NewKeyRotationClient(c config) *KeyRotationClient {
+	return &KeyRotationClient{config: c}
+}
+
+// Use adds a list of mutation hooks to the hooks stack.
+// A call to `Use(f, g, h)` equals to `keyrotation.Hooks(f(g(h())))`.
+func (c *KeyRotationClient) Use(hooks ...Hook) {
+	c.hooks.KeyRotation = append(c.hooks.KeyRotation, hooks...)
+}
+
+// Intercept adds a list of query interceptors to the interceptors stack.
+// A call to `Intercept(f, g, h)` equals to `keyrotation.Intercept(f(g(h())))`.
+func (c *KeyRotationClient) Intercept(interceptors ...Interceptor) {
+	c.inters.KeyRotation = append(c.inters.KeyRotation, interceptors...)
+}
+
+// Create returns a builder for creating a KeyRotation entity.
+func (c *KeyRotationClient) Create() *KeyRotationCreate {
+	mutation := newKeyRotationMutation(c.config, OpCreate)
+	return &KeyRotationCreate{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// CreateBulk returns a builder for creating a bulk of KeyRotation entities.
+func (c *KeyRotationClient) CreateBulk(builders ...*KeyRotationCreate) *KeyRotationCreateBulk {
+	return &KeyRotationCreateBulk{config: c.config, builders: builders}
+}
+
+// MapCreateBulk creates a bulk creation builder from the given slice. For each item in the slice, the function creates
+// a builder and applies setFunc on it.
+func (c *KeyRotationClient) MapCreateBulk(slice any, setFunc func(*KeyRotationCreate, int)) *KeyRotationCreateBulk {
+	rv := reflect.ValueOf(slice)
+	if rv.Kind() != reflect.Slice {
+		return &KeyRotationCreateBulk{err: fmt.Errorf("calling to KeyRotationClient.MapCreateBulk with wrong type %T, need slice", slice)}
+	}
+	builders := make([]*KeyRotationCreate, rv.Len())
+	for i := 0; i < rv.Len(); i++ {
+		builders[i] = c.Create()
+		setFunc(builders[i], i)
+	}
+	return &KeyRotationCreateBulk{config: c.config, builders: builders}
+}
+
+// Update returns an update builder for KeyRotation.
+func (c *KeyRotationClient) Update() *KeyRotationUpdate {
+	mutation := newKeyRotationMutation(c.config, OpUpdate)
+	return &KeyRotationUpdate{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// UpdateOne returns an update builder for the given entity.
+func (c *KeyRotationClient) UpdateOne(kr *KeyRotation) *KeyRotationUpdateOne {
+	mutation := newKeyRotationMutation(c.config, OpUpdateOne, withKeyRotation(kr))
+	return &KeyRotationUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// UpdateOneID returns an update builder for the given id.
+func (c *KeyRotationClient) UpdateOneID(id int) *KeyRotationUpdateOne {
+	mutation := newKeyRotationMutation(c.config, OpUpdateOne, withKeyRotationID(id))
+	return &KeyRotationUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// Delete returns a delete builder for KeyRotation.
+func (c *KeyRotationClient) Delete() *KeyRotationDelete {
+	mutation := newKeyRotationMutation(c.config, OpDelete)
+	return &KeyRotationDelete{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// DeleteOne returns a builder for deleting the given entity.
+func (c *KeyRotationClient) DeleteOne(kr *KeyRotation) *KeyRotationDeleteOne {
+	return c.DeleteOneID(kr.ID)
+}
+
+// DeleteOneID returns a builder for deleting the given entity by its id.
+func (c *KeyRotationClient) DeleteOneID(id int) *KeyRotationDeleteOne {
+	builder := c.Delete().Where(keyrotation.ID(id))
+	builder.mutation.id = &id
+	builder.mutation.op = OpDeleteOne
+	return &KeyRotationDeleteOne{builder}
+}
+
+// Query returns a query builder for KeyRotation.
+func (c *KeyRotationClient) Query() *KeyRotationQuery {
+	return &KeyRotationQuery{
+		config: c.config,
+		ctx:    &QueryContext{Type: TypeKeyRotation},
+		inters: c.Interceptors(),
+	}
+}
+
+// Get returns a KeyRotation entity by its id.
+func (c *KeyRotationClient) Get(ctx context.Context, id int) (*KeyRotation, error) {
+	return c.Query().Where(keyrotation.ID(id)).Only(ctx)
+}
+
+// GetX is like Get, but panics if an error occurs.
+func (c *KeyRotationClient) GetX(ctx context.Context, id int) *KeyRotation {
+	obj, err := c.Get(ctx, id)
+	if err != nil {
+		panic(err)
+	}
+	return obj
+}
+
+// Hooks returns the client hooks.
+func (c *KeyRotationClient) Hooks() []Hook {
+	return c.hooks.KeyRotation
+}
+
+// Interceptors returns the client interceptors.
+func (c *KeyRotationClient) Interceptors() []Interceptor {
+	return c.inters.KeyRotation
+}
+
+func (c *KeyRotationClient) mutate(ctx context.Context, m *KeyRotationMutation) (Value, error) {
+	switch m.Op() {
+	case OpCreate:
+		return (&KeyRotationCreate{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+	case OpUpdate:
+		return (&KeyRotationUpdate{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+	case OpUpdateOne:
+		return (&KeyRotationUpdateOne{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+	case OpDelete, OpDeleteOne:
+		return (&KeyRotationDelete{config: c.config, hooks: c.Hooks(), mutation: m}).Exec(ctx)
+	default:
+		return nil, fmt.Errorf("ent: unknown KeyRotation mutation op: %q", m.Op())
+	}
+}
+
+// SearchJobClient is a client for the SearchJob schema.
+type SearchJobClient struct {
+	config
+}
+
+// NewSearchJobClient returns a client for the SearchJob from the given config.
+func NewSearchJobClient(c config) *SearchJobClient {
+	return &SearchJobClient{config: c}
+}
+
+// Use adds a list of mutation hooks to the hooks stack.
+// A call to `Use(f, g, h)` equals to `searchjob.Hooks(f(g(h())))`.
+func (c *SearchJobClient) Use(hooks ...Hook) {
+	c.hooks.SearchJob = append(c.hooks.SearchJob, hooks...)
+}
+
+// Intercept adds a list of query interceptors to the interceptors stack.
+// A call to `Intercept(f, g, h)` equals to `searchjob.Intercept(f(g(h())))`.
+func (c *SearchJobClient) Intercept(interceptors ...Interceptor) {
+	c.inters.SearchJob = append(c.inters.SearchJob, interceptors...)
+}
+
+// Create returns a builder for creating a SearchJob entity.
+func (c *SearchJobClient) Create() *SearchJobCreate {
+	mutation := newSearchJobMutation(c.config, OpCreate)
+	return &SearchJobCreate{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// CreateBulk returns a builder for creating a bulk of SearchJob entities.
+func (c *SearchJobClient) CreateBulk(builders ...*SearchJobCreate) *SearchJobCreateBulk {
+	return &SearchJobCreateBulk{config: c.config, builders: builders}
+}
+
+// MapCreateBulk creates a bulk creation builder from the given slice. For each item in the slice, the function creates
+// a builder and applies setFunc on it.
+func (c *SearchJobClient) MapCreateBulk(slice any, setFunc func(*SearchJobCreate, int)) *SearchJobCreateBulk {
+	rv := reflect.ValueOf(slice)
+	if rv.Kind() != reflect.Slice {
+		return &SearchJobCreateBulk{err: fmt.Errorf("calling to SearchJobClient.MapCreateBulk with wrong type %T, need slice", slice)}
+	}
+	builders := make([]*SearchJobCreate, rv.Len())
+	for i := 0; i < rv.Len(); i++ {
+		builders[i] = c.Create()
+		setFunc(builders[i], i)
+	}
+	return &SearchJobCreateBulk{config: c.config, builders: builders}
+}
+
+// Update returns an update builder for SearchJob.
+func (c *SearchJobClient) Update() *SearchJobUpdate {
+	mutation := newSearchJobMutation(c.config, OpUpdate)
+	return &SearchJobUpdate{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// UpdateOne returns an update builder for the given entity.
+func (c *SearchJobClient) UpdateOne(sj *SearchJob) *SearchJobUpdateOne {
+	mutation := newSearchJobMutation(c.config, OpUpdateOne, withSearchJob(sj))
+	return &SearchJobUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// UpdateOneID returns an update builder for the given id.
+func (c *SearchJobClient) UpdateOneID(id int) *SearchJobUpdateOne {
+	mutation := newSearchJobMutation(c.config, OpUpdateOne, withSearchJobID(id))
+	return &SearchJobUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// Delete returns a delete builder for SearchJob.
+func (c *SearchJobClient) Delete() *SearchJobDelete {
+	mutation := newSearchJobMutation(c.config, OpDelete)
+	return &SearchJobDelete{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// DeleteOne returns a builder for deleting the given entity.
+func (c *SearchJobClient) DeleteOne(sj *SearchJob) *SearchJobDeleteOne {
+	return c.DeleteOneID(sj.ID)
+}
+
+// DeleteOneID returns a builder for deleting the given entity by its id.
+func (c *SearchJobClient) DeleteOneID(id int) *SearchJobDeleteOne {
+	builder := c.Delete().Where(searchjob.ID(id))
+	builder.mutation.id = &id
+	builder.mutation.op = OpDeleteOne
+	return &SearchJobDeleteOne{builder}
+}
+
+// Query returns a query builder for SearchJob.
+func (c *SearchJobClient) Query() *SearchJobQuery {
+	return &SearchJobQuery{
+		config: c.config,
+		ctx:    &QueryContext{Type: TypeSearchJob},
+		inters: c.Interceptors(),
+	}
+}
+
+// Get returns a SearchJob entity by its id.
+func (c *SearchJobClient) Get(ctx context.Context, id int) (*SearchJob, error) {
+	return c.Query().Where(searchjob.ID(id)).Only(ctx)
+}
+
+// GetX is like Get, but panics if an error occurs.
+func (c *SearchJobClient) GetX(ctx context.Context, id int) *SearchJob {
+	obj, err := c.Get(ctx, id)
+	if err != nil {
+		panic(err)
+	}
+	return obj
+}
+
+// QueryUser queries the user edge of a SearchJob.
+func (c *SearchJobClient) QueryUser(sj *SearchJob) *UserQuery {
+	query := (&UserClient{config: c.config}).Query()
+	query.path = func(context.Context) (fromV *sql.Selector, _ error) {
+		id := sj.ID
+		step := sqlgraph.NewStep(
+			sqlgraph.From(searchjob.Table, searchjob.FieldID, id),
+			sqlgraph.To(user.Table, user.FieldID),
+			sqlgraph.Edge(sqlgraph.M2O, true, searchjob.UserTable, searchjob.UserColumn),
+		)
+		fromV = sqlgraph.Neighbors(sj.driver.Dialect(), step)
+		return fromV, nil
+	}
+	return query
+}
+
+// Hooks returns the client hooks.
+func (c *SearchJobClient) Hooks() []Hook {
+	return c.hooks.SearchJob
+}
+
+// Interceptors returns the client interceptors.
+func (c *SearchJobClient) Interceptors() []Interceptor {
+	return c.inters.SearchJob
+}
+
+func (c *SearchJobClient) mutate(ctx context.Context, m *SearchJobMutation) (Value, error) {
+	switch m.Op() {
+	case OpCreate:
+		return (&SearchJobCreate{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+	case OpUpdate:
+		return (&SearchJobUpdate{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+	case OpUpdateOne:
+		return (&SearchJobUpdateOne{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+	case OpDelete, OpDeleteOne:
+		return (&SearchJobDelete{config: c.config, hooks: c.Hooks(), mutation: m}).Exec(ctx)
+	default:
+		return nil, fmt.Errorf("ent: unknown SearchJob mutation op: %q", m.Op())
+	}
+}
+
+// ShareClient is a client for the Share schema.
+type ShareClient struct {
+	config
+}
+
+// NewShareClient returns a client for the Share from the given config.
+func NewShareClient(c config) *ShareClient {
+	return &ShareClient{config: c}
+}
+
+// Use adds a list of mutation hooks to the hooks stack.
+// A call to `Use(f, g, h)` equals to `share.Hooks(f(g(h())))`.
+func (c *ShareClient) Use(hooks ...Hook) {
+	c.hooks.Share = append(c.hooks.Share, hooks...)
+}
+
+// Intercept adds a list of query interceptors to the interceptors stack.
+// A call to `Intercept(f, g, h)` equals to `share.Intercept(f(g(h())))`.
+func (c *ShareClient) Intercept(interceptors ...Interceptor) {
+	c.inters.Share = append(c.inters.Share, interceptors...)
+}
+
+// Create returns a builder for creating a Share entity.
+func (c *ShareClient) Create() *ShareCreate {
+	mutation := newShareMutation(c.config, OpCreate)
+	return &ShareCreate{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// CreateBulk returns a builder for creating a bulk of Share entities.
+func (c *ShareClient) CreateBulk(builders ...*ShareCreate) *ShareCreateBulk {
+	return &ShareCreateBulk{config: c.config, builders: builders}
+}
+
+// MapCreateBulk creates a bulk creation builder from the given slice. For each item in the slice, the function creates
+// a builder and applies setFunc on it.
+func (c *ShareClient) MapCreateBulk(slice any, setFunc func(*ShareCreate, int)) *ShareCreateBulk {
+	rv := reflect.ValueOf(slice)
+	if rv.Kind() != reflect.Slice {
+		return &ShareCreateBulk{err: fmt.Errorf("calling to ShareClient.MapCreateBulk with wrong type %T, need slice", slice)}
+	}
+	builders := make([]*ShareCreate, rv.Len())
+	for i := 0; i < rv.Len(); i++ {
+		builders[i] = c.Create()
+		setFunc(builders[i], i)
+	}
+	return &ShareCreateBulk{config: c.config, builders: builders}
+}
+
+// Update returns an update builder for Share.
+func (c *ShareClient) Update() *ShareUpdate {
+	mutation := newShareMutation(c.config, OpUpdate)
+	return &ShareUpdate{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// UpdateOne returns an update builder for the given entity.
+func (c *ShareClient) UpdateOne(s *Share) *ShareUpdateOne {
+	mutation := newShareMutation(c.config, OpUpdateOne, withShare(s))
+	return &ShareUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// UpdateOneID returns an update builder for the given id.
+func (c *ShareClient) UpdateOneID(id int) *ShareUpdateOne {
+	mutation := newShareMutation(c.config, OpUpdateOne, withShareID(id))
+	return &ShareUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// Delete returns a delete builder for Share.
+func (c *ShareClient) Delete() *ShareDelete {
+	mutation := newShareMutation(c.config, OpDelete)
+	return &ShareDelete{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// DeleteOne returns a builder for deleting the given entity.
+func (c *ShareClient) DeleteOne(s *Share) *ShareDeleteOne {
+	return c.DeleteOneID(s.ID)
+}
+
+// DeleteOneID returns a builder for deleting the given entity by its id.
+func (c *ShareClient) DeleteOneID(id int) *ShareDeleteOne {
+	builder := c.Delete().Where(share.ID(id))
+	builder.mutation.id = &id
+	builder.mutation.op = OpDeleteOne
+	return &ShareDeleteOne{builder}
+}
+
+// Query returns a query builder for Share.
+func (c *ShareClient) Query() *ShareQuery {
+	return &ShareQuery{
+		config: c.config,
+		ctx:    &QueryContext{Type: TypeShare},
+		inters: c.Interceptors(),
+	}
+}
+
+// Get returns a Share entity by its id.
+func (c *ShareClient) Get(ctx context.Context, id int) (*Share, error) {
+	return c.Query().Where(share.ID(id)).Only(ctx)
+}
+
+// GetX is like Get, but panics if an error occurs.
+func (c *ShareClient) GetX(ctx context.Context, id int) *Share {
+	obj, err := c.Get(ctx, id)
+	if err != nil {
+		panic(err)
+	}
+	return obj
+}
+
+// QueryFile queries the file edge of a Share.
+func (c *ShareClient) QueryFile(s *Share) *FileQuery {
+	query := (&FileClient{config: c.config}).Query()
+	query.path = func(context.Context) (fromV *sql.Selector, _ error) {
+		id := s.ID
+		step := sqlgraph.NewStep(
+			sqlgraph.From(share.Table, share.FieldID, id),
+			sqlgraph.To(file.Table, file.FieldID),
+			sqlgraph.Edge(sqlgraph.M2O, true, share.FileTable, share.FileColumn),
+		)
+		fromV = sqlgraph.Neighbors(s.driver.Dialect(), step)
+		return fromV, nil
+	}
+	return query
+}
+
+// QueryUser queries the user edge of a Share.
+func (c *ShareClient) QueryUser(s *Share) *UserQuery {
+	query := (&UserClient{config: c.config}).Query()
+	query.path = func(context.Context) (fromV *sql.Selector, _ error) {
+		id := s.ID
+		step := sqlgraph.NewStep(
+			sqlgraph.From(share.Table, share.FieldID, id),
+			sqlgraph.To(user.Table, user.FieldID),
+			sqlgraph.Edge(sqlgraph.M2O, true, share.UserTable, share.UserColumn),
+		)
+		fromV = sqlgraph.Neighbors(s.driver.Dialect(), step)
+		return fromV, nil
+	}
+	return query
+}
+
+// Hooks returns the client hooks.
+func (c *ShareClient) Hooks() []Hook {
+	return c.hooks.Share
+}
+
+// Interceptors returns the client interceptors.
+func (c *ShareClient) Interceptors() []Interceptor {
+	return c.inters.Share
+}
+
+func (c *ShareClient) mutate(ctx context.Context, m *ShareMutation) (Value, error) {
+	switch m.Op() {
+	case OpCreate:
+		return (&ShareCreate{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+	case OpUpdate:
+		return (&ShareUpdate{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+	case OpUpdateOne:
+		return (&ShareUpdateOne{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+	case OpDelete, OpDeleteOne:
+		return (&ShareDelete{config: c.config, hooks: c.Hooks(), mutation: m}).Exec(ctx)
+	default:
+		return nil, fmt.Errorf("ent: unknown Share mutation op: %q", m.Op())
+	}
+}
+
 // TagClient is a client for the Tag schema.
 type TagClient struct {
 	config
@@ -830,6 +1320,38 @@ func (c *UserClient) QueryFiles(u *User) *FileQuery {
 	return query
 }
 
+// QuerySearchJobs queries the search_jobs edge of a User.
+func (c *UserClient) QuerySearchJobs(u *User) *SearchJobQuery {
+	query := (&SearchJobClient{config: c.config}).Query()
+	query.path = func(context.Context) (fromV *sql.Selector, _ error) {
+		id := u.ID
+		step := sqlgraph.NewStep(
+			sqlgraph.From(user.Table, user.FieldID, id),
+			sqlgraph.To(searchjob.Table, searchjob.FieldID),
+			sqlgraph.Edge(sqlgraph.O2M, false, user.SearchJobsTable, user.SearchJobsColumn),
+		)
+		fromV = sqlgraph.Neighbors(u.driver.Dialect(), step)
+		return fromV, nil
+	}
+	return query
+}
+
+// QueryShares queries the shares edge of a User.
+func (c *UserClient) QueryShares(u *User) *ShareQuery {
+	query := (&ShareClient{config: c.config}).Query()
+	query.path = func(context.Context) (fromV *sql.Selector, _ error) {
+		id := u.ID
+		step := sqlgraph.NewStep(
+			sqlgraph.From(user.Table, user.FieldID, id),
+			sqlgraph.To(share.Table, share.FieldID),
+			sqlgraph.Edge(sqlgraph.O2M, false, user.SharesTable, user.SharesColumn),
+		)
+		fromV = sqlgraph.Neighbors(u.driver.Dialect(), step)
+		return fromV, nil
+	}
+	return query
+}
+
 // Hooks returns the client hooks.
 func (c *UserClient) Hooks() []Hook {
 	return c.hooks.User
@@ -858,9 +1380,9 @@ func (c *UserClient) mutate(ctx context.Context, m *UserMutation) (Value, error)
 // hooks and interceptors per client, for fast access.
 type (
 	hooks struct {
-		File, Filetype, Tag, User []ent.Hook
+		File, Filetype, KeyRotation, SearchJob, Share, Tag, User []ent.Hook
 	}
 	inters struct {
-		File, Filetype, Tag, User []ent.Interceptor
+		File, Filetype, KeyRotation, SearchJob, Share, Tag, User []ent.Interceptor
 	}
 )
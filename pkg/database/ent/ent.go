@@ -14,6 +14,9 @@ import (
 	"entgo.io/ent/dialect/sql/sqlgraph"
 	"github.com/lebleuciel/maani/pkg/database/ent/file"
 	"github.com/lebleuciel/maani/pkg/database/ent/filetype"
+	"github.com/lebleuciel/maani/pkg/database/ent/keyrotation"
+	"github.com/lebleuciel/maani/pkg/database/ent/searchjob"
+	"github.com/lebleuciel/maani/pkg/database/ent/share"
 	"github.com/lebleuciel/maani/pkg/database/ent/tag"
 	"github.com/lebleuciel/maani/pkg/database/ent/user"
 )
@@ -76,10 +79,13 @@ var (
 func checkColumn(table, column string) error {
 	initCheck.Do(func() {
 		columnCheck = sql.NewColumnCheck(map[string]func(string) bool{
-			file.Table:     file.ValidColumn,
-			filetype.Table: filetype.ValidColumn,
-			tag.Table:      tag.ValidColumn,
-			user.Table:     user.ValidColumn,
+			file.Table:        file.ValidColumn,
+			filetype.Table:    filetype.ValidColumn,
+			keyrotation.Table: keyrotation.ValidColumn,
+			searchjob.Table:   searchjob.ValidColumn,
+			share.Table:       share.ValidColumn,
+			tag.Table:         tag.ValidColumn,
+			user.Table:        user.ValidColumn,
 		})
 	})
 	return columnCheck(table, column)
@@ -29,11 +29,39 @@ type File struct {
 	Size int `json:"size,omitempty"`
 	// Type holds the value of the "type" field.
 	Type string `json:"type,omitempty"`
+	// Delete file after it has been downloaded once
+	BurnAfterRead bool `json:"burn_after_read,omitempty"`
+	// Perceptual difference hash of image content, stored as signed bits of an uint64
+	Phash *int64 `json:"phash,omitempty"`
+	// Id of key-encryption key which wrapped data key, or key content is encrypted with when there is no data key
+	KeyID string `json:"key_id,omitempty"`
+	// Data key of content sealed by key-encryption key, null for files stored before envelope encryption
+	WrappedKey []byte `json:"-"`
+	// Hex SHA-256 of plaintext content, also the address of its blob. Empty for files stored before content addressing
+	Sha256 string `json:"sha256,omitempty"`
+	// Width of images as displayed, after exif orientation is applied. Zero for other files
+	Width int `json:"width,omitempty"`
+	// Height of images as displayed, after exif orientation is applied. Zero for other files
+	Height int `json:"height,omitempty"`
+	// Most common color of images as #rrggbb, empty for other files
+	DominantColor string `json:"dominant_color,omitempty"`
+	// Url files saved from search results were downloaded from, empty for uploaded files
+	SourceURL string `json:"source_url,omitempty"`
+	// Query of the search files saved from search results were found by, empty for uploaded files
+	SearchQuery string `json:"search_query,omitempty"`
+	// Tags of file joined by spaces, full-text search reads them from files along with name and search query
+	TagNames string `json:"tag_names,omitempty"`
+	// Manufacturer of camera which took the photo, from exif metadata
+	CameraMake string `json:"camera_make,omitempty"`
+	// Model of camera which took the photo, from exif metadata
+	CameraModel string `json:"camera_model,omitempty"`
+	// When photo was taken, from exif metadata
+	TakenAt *time.Time `json:"taken_at,omitempty"`
 	// CreatedAt holds the value of the "created_at" field.
 	CreatedAt *time.Time `json:"created_at,omitempty"`
 	// UpdatedAt holds the value of the "updated_at" field.
 	UpdatedAt time.Time `json:"updated_at,omitempty"`
-	// DeletedAt holds the value of the "deleted_at" field.
+	// When file was moved to trash, it is purged after retention period of trash
 	DeletedAt *time.Time `json:"deleted_at,omitempty"`
 	// Edges holds the relations/edges for other nodes in the graph.
 	// The values are being populated by the FileQuery when eager-loading is set.
@@ -44,14 +72,16 @@ type File struct {
 // FileEdges holds the relations/edges for other nodes in the graph.
 type FileEdges struct {
 	// User holds the value of the user edge.
-	User *User `json:"files"`
+	User *User `json:"user"`
 	// Filetype holds the value of the filetype edge.
-	Filetype *Filetype `json:"files"`
+	Filetype *Filetype `json:"filetype"`
 	// Tags holds the value of the tags edge.
 	Tags []*Tag `json:"tags,omitempty"`
+	// Shares holds the value of the shares edge.
+	Shares []*Share `json:"shares,omitempty"`
 	// loadedTypes holds the information for reporting if a
 	// type was loaded (or requested) in eager-loading or not.
-	loadedTypes [3]bool
+	loadedTypes [4]bool
 }
 
 // UserOrErr returns the User value or an error if the edge
@@ -89,16 +119,29 @@ func (e FileEdges) TagsOrErr() ([]*Tag, error) {
 	return nil, &NotLoadedError{edge: "tags"}
 }
 
+// SharesOrErr returns the Shares value or an error if the edge
+// was not loaded in eager-loading.
+func (e FileEdges) SharesOrErr() ([]*Share, error) {
+	if e.loadedTypes[3] {
+		return e.Shares, nil
+	}
+	return nil, &NotLoadedError{edge: "shares"}
+}
+
 // scanValues returns the types for scanning values from sql.Rows.
 func (*File) scanValues(columns []string) ([]any, error) {
 	values := make([]any, len(columns))
 	for i := range columns {
 		switch columns[i] {
-		case file.FieldID, file.FieldUserID, file.FieldSize:
+		case file.FieldWrappedKey:
+			values[i] = new([]byte)
+		case file.FieldBurnAfterRead:
+			values[i] = new(sql.NullBool)
+		case file.FieldID, file.FieldUserID, file.FieldSize, file.FieldPhash, file.FieldWidth, file.FieldHeight:
 			values[i] = new(sql.NullInt64)
-		case file.FieldName, file.FieldUUID, file.FieldType:
+		case file.FieldName, file.FieldUUID, file.FieldType, file.FieldKeyID, file.FieldSha256, file.FieldDominantColor, file.FieldSourceURL, file.FieldSearchQuery, file.FieldTagNames, file.FieldCameraMake, file.FieldCameraModel:
 			values[i] = new(sql.NullString)
-		case file.FieldCreatedAt, file.FieldUpdatedAt, file.FieldDeletedAt:
+		case file.FieldTakenAt, file.FieldCreatedAt, file.FieldUpdatedAt, file.FieldDeletedAt:
 			values[i] = new(sql.NullTime)
 		default:
 			values[i] = new(sql.UnknownType)
@@ -151,6 +194,92 @@ func (f *File) assignValues(columns []string, values []any) error {
 			} else if value.Valid {
 				f.Type = value.String
 			}
+		case file.FieldBurnAfterRead:
+			if value, ok := values[i].(*sql.NullBool); !ok {
+				return fmt.Errorf("unexpected type %T for field burn_after_read", values[i])
+			} else if value.Valid {
+				f.BurnAfterRead = value.Bool
+			}
+		case file.FieldPhash:
+			if value, ok := values[i].(*sql.NullInt64); !ok {
+				return fmt.Errorf("unexpected type %T for field phash", values[i])
+			} else if value.Valid {
+				f.Phash = new(int64)
+				*f.Phash = value.Int64
+			}
+		case file.FieldKeyID:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field key_id", values[i])
+			} else if value.Valid {
+				f.KeyID = value.String
+			}
+		case file.FieldWrappedKey:
+			if value, ok := values[i].(*[]byte); !ok {
+				return fmt.Errorf("unexpected type %T for field wrapped_key", values[i])
+			} else if value != nil {
+				f.WrappedKey = *value
+			}
+		case file.FieldSha256:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field sha256", values[i])
+			} else if value.Valid {
+				f.Sha256 = value.String
+			}
+		case file.FieldWidth:
+			if value, ok := values[i].(*sql.NullInt64); !ok {
+				return fmt.Errorf("unexpected type %T for field width", values[i])
+			} else if value.Valid {
+				f.Width = int(value.Int64)
+			}
+		case file.FieldHeight:
+			if value, ok := values[i].(*sql.NullInt64); !ok {
+				return fmt.Errorf("unexpected type %T for field height", values[i])
+			} else if value.Valid {
+				f.Height = int(value.Int64)
+			}
+		case file.FieldDominantColor:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field dominant_color", values[i])
+			} else if value.Valid {
+				f.DominantColor = value.String
+			}
+		case file.FieldSourceURL:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field source_url", values[i])
+			} else if value.Valid {
+				f.SourceURL = value.String
+			}
+		case file.FieldSearchQuery:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field search_query", values[i])
+			} else if value.Valid {
+				f.SearchQuery = value.String
+			}
+		case file.FieldTagNames:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field tag_names", values[i])
+			} else if value.Valid {
+				f.TagNames = value.String
+			}
+		case file.FieldCameraMake:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field camera_make", values[i])
+			} else if value.Valid {
+				f.CameraMake = value.String
+			}
+		case file.FieldCameraModel:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field camera_model", values[i])
+			} else if value.Valid {
+				f.CameraModel = value.String
+			}
+		case file.FieldTakenAt:
+			if value, ok := values[i].(*sql.NullTime); !ok {
+				return fmt.Errorf("unexpected type %T for field taken_at", values[i])
+			} else if value.Valid {
+				f.TakenAt = new(time.Time)
+				*f.TakenAt = value.Time
+			}
 		case file.FieldCreatedAt:
 			if value, ok := values[i].(*sql.NullTime); !ok {
 				return fmt.Errorf("unexpected type %T for field created_at", values[i])
@@ -199,6 +328,11 @@ func (f *File) QueryTags() *TagQuery {
 	return NewFileClient(f.config).QueryTags(f)
 }
 
+// QueryShares queries the "shares" edge of the File entity.
+func (f *File) QueryShares() *ShareQuery {
+	return NewFileClient(f.config).QueryShares(f)
+}
+
 // Update returns a builder for updating this File.
 // Note that you need to call File.Unwrap() before calling this method if this File
 // was returned from a transaction, and the transaction was committed or rolled back.
@@ -237,6 +371,51 @@ func (f *File) String() string {
 	builder.WriteString("type=")
 	builder.WriteString(f.Type)
 	builder.WriteString(", ")
+	builder.WriteString("burn_after_read=")
+	builder.WriteString(fmt.Sprintf("%v", f.BurnAfterRead))
+	builder.WriteString(", ")
+	if v := f.Phash; v != nil {
+		builder.WriteString("phash=")
+		builder.WriteString(fmt.Sprintf("%v", *v))
+	}
+	builder.WriteString(", ")
+	builder.WriteString("key_id=")
+	builder.WriteString(f.KeyID)
+	builder.WriteString(", ")
+	builder.WriteString("wrapped_key=<sensitive>")
+	builder.WriteString(", ")
+	builder.WriteString("sha256=")
+	builder.WriteString(f.Sha256)
+	builder.WriteString(", ")
+	builder.WriteString("width=")
+	builder.WriteString(fmt.Sprintf("%v", f.Width))
+	builder.WriteString(", ")
+	builder.WriteString("height=")
+	builder.WriteString(fmt.Sprintf("%v", f.Height))
+	builder.WriteString(", ")
+	builder.WriteString("dominant_color=")
+	builder.WriteString(f.DominantColor)
+	builder.WriteString(", ")
+	builder.WriteString("source_url=")
+	builder.WriteString(f.SourceURL)
+	builder.WriteString(", ")
+	builder.WriteString("search_query=")
+	builder.WriteString(f.SearchQuery)
+	builder.WriteString(", ")
+	builder.WriteString("tag_names=")
+	builder.WriteString(f.TagNames)
+	builder.WriteString(", ")
+	builder.WriteString("camera_make=")
+	builder.WriteString(f.CameraMake)
+	builder.WriteString(", ")
+	builder.WriteString("camera_model=")
+	builder.WriteString(f.CameraModel)
+	builder.WriteString(", ")
+	if v := f.TakenAt; v != nil {
+		builder.WriteString("taken_at=")
+		builder.WriteString(v.Format(time.ANSIC))
+	}
+	builder.WriteString(", ")
 	if v := f.CreatedAt; v != nil {
 		builder.WriteString("created_at=")
 		builder.WriteString(v.Format(time.ANSIC))
@@ -5,6 +5,7 @@ package file
 import (
 	"time"
 
+	"entgo.io/ent"
 	"entgo.io/ent/dialect/sql"
 	"entgo.io/ent/dialect/sql/sqlgraph"
 )
@@ -24,6 +25,34 @@ const (
 	FieldSize = "size"
 	// FieldType holds the string denoting the type field in the database.
 	FieldType = "type"
+	// FieldBurnAfterRead holds the string denoting the burn_after_read field in the database.
+	FieldBurnAfterRead = "burn_after_read"
+	// FieldPhash holds the string denoting the phash field in the database.
+	FieldPhash = "phash"
+	// FieldKeyID holds the string denoting the key_id field in the database.
+	FieldKeyID = "key_id"
+	// FieldWrappedKey holds the string denoting the wrapped_key field in the database.
+	FieldWrappedKey = "wrapped_key"
+	// FieldSha256 holds the string denoting the sha256 field in the database.
+	FieldSha256 = "sha256"
+	// FieldWidth holds the string denoting the width field in the database.
+	FieldWidth = "width"
+	// FieldHeight holds the string denoting the height field in the database.
+	FieldHeight = "height"
+	// FieldDominantColor holds the string denoting the dominant_color field in the database.
+	FieldDominantColor = "dominant_color"
+	// FieldSourceURL holds the string denoting the source_url field in the database.
+	FieldSourceURL = "source_url"
+	// FieldSearchQuery holds the string denoting the search_query field in the database.
+	FieldSearchQuery = "search_query"
+	// FieldTagNames holds the string denoting the tag_names field in the database.
+	FieldTagNames = "tag_names"
+	// FieldCameraMake holds the string denoting the camera_make field in the database.
+	FieldCameraMake = "camera_make"
+	// FieldCameraModel holds the string denoting the camera_model field in the database.
+	FieldCameraModel = "camera_model"
+	// FieldTakenAt holds the string denoting the taken_at field in the database.
+	FieldTakenAt = "taken_at"
 	// FieldCreatedAt holds the string denoting the created_at field in the database.
 	FieldCreatedAt = "created_at"
 	// FieldUpdatedAt holds the string denoting the updated_at field in the database.
@@ -36,6 +65,8 @@ const (
 	EdgeFiletype = "filetype"
 	// EdgeTags holds the string denoting the tags edge name in mutations.
 	EdgeTags = "tags"
+	// EdgeShares holds the string denoting the shares edge name in mutations.
+	EdgeShares = "shares"
 	// FiletypeFieldID holds the string denoting the ID field of the Filetype.
 	FiletypeFieldID = "type"
 	// TagFieldID holds the string denoting the ID field of the Tag.
@@ -61,6 +92,13 @@ const (
 	// TagsInverseTable is the table name for the Tag entity.
 	// It exists in this package in order to avoid circular dependency with the "tag" package.
 	TagsInverseTable = "tags"
+	// SharesTable is the table that holds the shares relation/edge.
+	SharesTable = "shares"
+	// SharesInverseTable is the table name for the Share entity.
+	// It exists in this package in order to avoid circular dependency with the "share" package.
+	SharesInverseTable = "shares"
+	// SharesColumn is the table column denoting the shares relation/edge.
+	SharesColumn = "file_id"
 )
 
 // Columns holds all SQL columns for file fields.
@@ -71,6 +109,20 @@ var Columns = []string{
 	FieldUUID,
 	FieldSize,
 	FieldType,
+	FieldBurnAfterRead,
+	FieldPhash,
+	FieldKeyID,
+	FieldWrappedKey,
+	FieldSha256,
+	FieldWidth,
+	FieldHeight,
+	FieldDominantColor,
+	FieldSourceURL,
+	FieldSearchQuery,
+	FieldTagNames,
+	FieldCameraMake,
+	FieldCameraModel,
+	FieldTakenAt,
 	FieldCreatedAt,
 	FieldUpdatedAt,
 	FieldDeletedAt,
@@ -92,21 +144,61 @@ func ValidColumn(column string) bool {
 	return false
 }
 
+// Note that the variables below are initialized by the runtime
+// package on the initialization of the application. Therefore,
+// it should be imported in the main as follows:
+//
+//	import _ "github.com/lebleuciel/maani/pkg/database/ent/runtime"
 var (
+	Interceptors [1]ent.Interceptor
 	// NameValidator is a validator for the "name" field. It is called by the builders before save.
 	NameValidator func(string) error
 	// UUIDValidator is a validator for the "uuid" field. It is called by the builders before save.
 	UUIDValidator func(string) error
 	// TypeValidator is a validator for the "type" field. It is called by the builders before save.
 	TypeValidator func(string) error
+	// DefaultBurnAfterRead holds the default value on creation for the "burn_after_read" field.
+	DefaultBurnAfterRead bool
+	// DefaultKeyID holds the default value on creation for the "key_id" field.
+	DefaultKeyID string
+	// KeyIDValidator is a validator for the "key_id" field. It is called by the builders before save.
+	KeyIDValidator func(string) error
+	// DefaultSha256 holds the default value on creation for the "sha256" field.
+	DefaultSha256 string
+	// Sha256Validator is a validator for the "sha256" field. It is called by the builders before save.
+	Sha256Validator func(string) error
+	// DefaultWidth holds the default value on creation for the "width" field.
+	DefaultWidth int
+	// DefaultHeight holds the default value on creation for the "height" field.
+	DefaultHeight int
+	// DefaultDominantColor holds the default value on creation for the "dominant_color" field.
+	DefaultDominantColor string
+	// DominantColorValidator is a validator for the "dominant_color" field. It is called by the builders before save.
+	DominantColorValidator func(string) error
+	// DefaultSourceURL holds the default value on creation for the "source_url" field.
+	DefaultSourceURL string
+	// SourceURLValidator is a validator for the "source_url" field. It is called by the builders before save.
+	SourceURLValidator func(string) error
+	// DefaultSearchQuery holds the default value on creation for the "search_query" field.
+	DefaultSearchQuery string
+	// SearchQueryValidator is a validator for the "search_query" field. It is called by the builders before save.
+	SearchQueryValidator func(string) error
+	// DefaultTagNames holds the default value on creation for the "tag_names" field.
+	DefaultTagNames string
+	// DefaultCameraMake holds the default value on creation for the "camera_make" field.
+	DefaultCameraMake string
+	// CameraMakeValidator is a validator for the "camera_make" field. It is called by the builders before save.
+	CameraMakeValidator func(string) error
+	// DefaultCameraModel holds the default value on creation for the "camera_model" field.
+	DefaultCameraModel string
+	// CameraModelValidator is a validator for the "camera_model" field. It is called by the builders before save.
+	CameraModelValidator func(string) error
 	// DefaultCreatedAt holds the default value on creation for the "created_at" field.
 	DefaultCreatedAt func() time.Time
 	// DefaultUpdatedAt holds the default value on creation for the "updated_at" field.
 	DefaultUpdatedAt func() time.Time
 	// UpdateDefaultUpdatedAt holds the default value on update for the "updated_at" field.
 	UpdateDefaultUpdatedAt func() time.Time
-	// DefaultDeletedAt holds the default value on creation for the "deleted_at" field.
-	DefaultDeletedAt func() time.Time
 )
 
 // OrderOption defines the ordering options for the File queries.
@@ -142,6 +234,71 @@ func ByType(opts ...sql.OrderTermOption) OrderOption {
 	return sql.OrderByField(FieldType, opts...).ToFunc()
 }
 
+// ByBurnAfterRead orders the results by the burn_after_read field.
+func ByBurnAfterRead(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldBurnAfterRead, opts...).ToFunc()
+}
+
+// ByPhash orders the results by the phash field.
+func ByPhash(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldPhash, opts...).ToFunc()
+}
+
+// ByKeyID orders the results by the key_id field.
+func ByKeyID(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldKeyID, opts...).ToFunc()
+}
+
+// BySha256 orders the results by the sha256 field.
+func BySha256(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldSha256, opts...).ToFunc()
+}
+
+// ByWidth orders the results by the width field.
+func ByWidth(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldWidth, opts...).ToFunc()
+}
+
+// ByHeight orders the results by the height field.
+func ByHeight(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldHeight, opts...).ToFunc()
+}
+
+// ByDominantColor orders the results by the dominant_color field.
+func ByDominantColor(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldDominantColor, opts...).ToFunc()
+}
+
+// BySourceURL orders the results by the source_url field.
+func BySourceURL(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldSourceURL, opts...).ToFunc()
+}
+
+// BySearchQuery orders the results by the search_query field.
+func BySearchQuery(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldSearchQuery, opts...).ToFunc()
+}
+
+// ByTagNames orders the results by the tag_names field.
+func ByTagNames(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldTagNames, opts...).ToFunc()
+}
+
+// ByCameraMake orders the results by the camera_make field.
+func ByCameraMake(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldCameraMake, opts...).ToFunc()
+}
+
+// ByCameraModel orders the results by the camera_model field.
+func ByCameraModel(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldCameraModel, opts...).ToFunc()
+}
+
+// ByTakenAt orders the results by the taken_at field.
+func ByTakenAt(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldTakenAt, opts...).ToFunc()
+}
+
 // ByCreatedAt orders the results by the created_at field.
 func ByCreatedAt(opts ...sql.OrderTermOption) OrderOption {
 	return sql.OrderByField(FieldCreatedAt, opts...).ToFunc()
@@ -184,6 +341,20 @@ func ByTags(term sql.OrderTerm, terms ...sql.OrderTerm) OrderOption {
 		sqlgraph.OrderByNeighborTerms(s, newTagsStep(), append([]sql.OrderTerm{term}, terms...)...)
 	}
 }
+
+// BySharesCount orders the results by shares count.
+func BySharesCount(opts ...sql.OrderTermOption) OrderOption {
+	return func(s *sql.Selector) {
+		sqlgraph.OrderByNeighborsCount(s, newSharesStep(), opts...)
+	}
+}
+
+// ByShares orders the results by shares terms.
+func ByShares(term sql.OrderTerm, terms ...sql.OrderTerm) OrderOption {
+	return func(s *sql.Selector) {
+		sqlgraph.OrderByNeighborTerms(s, newSharesStep(), append([]sql.OrderTerm{term}, terms...)...)
+	}
+}
 func newUserStep() *sqlgraph.Step {
 	return sqlgraph.NewStep(
 		sqlgraph.From(Table, FieldID),
@@ -205,3 +376,10 @@ func newTagsStep() *sqlgraph.Step {
 		sqlgraph.Edge(sqlgraph.M2M, false, TagsTable, TagsPrimaryKey...),
 	)
 }
+func newSharesStep() *sqlgraph.Step {
+	return sqlgraph.NewStep(
+		sqlgraph.From(Table, FieldID),
+		sqlgraph.To(SharesInverseTable, FieldID),
+		sqlgraph.Edge(sqlgraph.O2M, false, SharesTable, SharesColumn),
+	)
+}
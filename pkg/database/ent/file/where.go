@@ -80,6 +80,76 @@ func Type(v string) predicate.File {
 	return predicate.File(sql.FieldEQ(FieldType, v))
 }
 
+// BurnAfterRead applies equality check predicate on the "burn_after_read" field. It's identical to BurnAfterReadEQ.
+func BurnAfterRead(v bool) predicate.File {
+	return predicate.File(sql.FieldEQ(FieldBurnAfterRead, v))
+}
+
+// Phash applies equality check predicate on the "phash" field. It's identical to PhashEQ.
+func Phash(v int64) predicate.File {
+	return predicate.File(sql.FieldEQ(FieldPhash, v))
+}
+
+// KeyID applies equality check predicate on the "key_id" field. It's identical to KeyIDEQ.
+func KeyID(v string) predicate.File {
+	return predicate.File(sql.FieldEQ(FieldKeyID, v))
+}
+
+// WrappedKey applies equality check predicate on the "wrapped_key" field. It's identical to WrappedKeyEQ.
+func WrappedKey(v []byte) predicate.File {
+	return predicate.File(sql.FieldEQ(FieldWrappedKey, v))
+}
+
+// Sha256 applies equality check predicate on the "sha256" field. It's identical to Sha256EQ.
+func Sha256(v string) predicate.File {
+	return predicate.File(sql.FieldEQ(FieldSha256, v))
+}
+
+// Width applies equality check predicate on the "width" field. It's identical to WidthEQ.
+func Width(v int) predicate.File {
+	return predicate.File(sql.FieldEQ(FieldWidth, v))
+}
+
+// Height applies equality check predicate on the "height" field. It's identical to HeightEQ.
+func Height(v int) predicate.File {
+	return predicate.File(sql.FieldEQ(FieldHeight, v))
+}
+
+// DominantColor applies equality check predicate on the "dominant_color" field. It's identical to DominantColorEQ.
+func DominantColor(v string) predicate.File {
+	return predicate.File(sql.FieldEQ(FieldDominantColor, v))
+}
+
+// SourceURL applies equality check predicate on the "source_url" field. It's identical to SourceURLEQ.
+func SourceURL(v string) predicate.File {
+	return predicate.File(sql.FieldEQ(FieldSourceURL, v))
+}
+
+// SearchQuery applies equality check predicate on the "search_query" field. It's identical to SearchQueryEQ.
+func SearchQuery(v string) predicate.File {
+	return predicate.File(sql.FieldEQ(FieldSearchQuery, v))
+}
+
+// TagNames applies equality check predicate on the "tag_names" field. It's identical to TagNamesEQ.
+func TagNames(v string) predicate.File {
+	return predicate.File(sql.FieldEQ(FieldTagNames, v))
+}
+
+// CameraMake applies equality check predicate on the "camera_make" field. It's identical to CameraMakeEQ.
+func CameraMake(v string) predicate.File {
+	return predicate.File(sql.FieldEQ(FieldCameraMake, v))
+}
+
+// CameraModel applies equality check predicate on the "camera_model" field. It's identical to CameraModelEQ.
+func CameraModel(v string) predicate.File {
+	return predicate.File(sql.FieldEQ(FieldCameraModel, v))
+}
+
+// TakenAt applies equality check predicate on the "taken_at" field. It's identical to TakenAtEQ.
+func TakenAt(v time.Time) predicate.File {
+	return predicate.File(sql.FieldEQ(FieldTakenAt, v))
+}
+
 // CreatedAt applies equality check predicate on the "created_at" field. It's identical to CreatedAtEQ.
 func CreatedAt(v time.Time) predicate.File {
 	return predicate.File(sql.FieldEQ(FieldCreatedAt, v))
@@ -350,6 +420,766 @@ func TypeContainsFold(v string) predicate.File {
 	return predicate.File(sql.FieldContainsFold(FieldType, v))
 }
 
+// BurnAfterReadEQ applies the EQ predicate on the "burn_after_read" field.
+func BurnAfterReadEQ(v bool) predicate.File {
+	return predicate.File(sql.FieldEQ(FieldBurnAfterRead, v))
+}
+
+// BurnAfterReadNEQ applies the NEQ predicate on the "burn_after_read" field.
+func BurnAfterReadNEQ(v bool) predicate.File {
+	return predicate.File(sql.FieldNEQ(FieldBurnAfterRead, v))
+}
+
+// PhashEQ applies the EQ predicate on the "phash" field.
+func PhashEQ(v int64) predicate.File {
+	return predicate.File(sql.FieldEQ(FieldPhash, v))
+}
+
+// PhashNEQ applies the NEQ predicate on the "phash" field.
+func PhashNEQ(v int64) predicate.File {
+	return predicate.File(sql.FieldNEQ(FieldPhash, v))
+}
+
+// PhashIn applies the In predicate on the "phash" field.
+func PhashIn(vs ...int64) predicate.File {
+	return predicate.File(sql.FieldIn(FieldPhash, vs...))
+}
+
+// PhashNotIn applies the NotIn predicate on the "phash" field.
+func PhashNotIn(vs ...int64) predicate.File {
+	return predicate.File(sql.FieldNotIn(FieldPhash, vs...))
+}
+
+// PhashGT applies the GT predicate on the "phash" field.
+func PhashGT(v int64) predicate.File {
+	return predicate.File(sql.FieldGT(FieldPhash, v))
+}
+
+// PhashGTE applies the GTE predicate on the "phash" field.
+func PhashGTE(v int64) predicate.File {
+	return predicate.File(sql.FieldGTE(FieldPhash, v))
+}
+
+// PhashLT applies the LT predicate on the "phash" field.
+func PhashLT(v int64) predicate.File {
+	return predicate.File(sql.FieldLT(FieldPhash, v))
+}
+
+// PhashLTE applies the LTE predicate on the "phash" field.
+func PhashLTE(v int64) predicate.File {
+	return predicate.File(sql.FieldLTE(FieldPhash, v))
+}
+
+// PhashIsNil applies the IsNil predicate on the "phash" field.
+func PhashIsNil() predicate.File {
+	return predicate.File(sql.FieldIsNull(FieldPhash))
+}
+
+// PhashNotNil applies the NotNil predicate on the "phash" field.
+func PhashNotNil() predicate.File {
+	return predicate.File(sql.FieldNotNull(FieldPhash))
+}
+
+// KeyIDEQ applies the EQ predicate on the "key_id" field.
+func KeyIDEQ(v string) predicate.File {
+	return predicate.File(sql.FieldEQ(FieldKeyID, v))
+}
+
+// KeyIDNEQ applies the NEQ predicate on the "key_id" field.
+func KeyIDNEQ(v string) predicate.File {
+	return predicate.File(sql.FieldNEQ(FieldKeyID, v))
+}
+
+// KeyIDIn applies the In predicate on the "key_id" field.
+func KeyIDIn(vs ...string) predicate.File {
+	return predicate.File(sql.FieldIn(FieldKeyID, vs...))
+}
+
+// KeyIDNotIn applies the NotIn predicate on the "key_id" field.
+func KeyIDNotIn(vs ...string) predicate.File {
+	return predicate.File(sql.FieldNotIn(FieldKeyID, vs...))
+}
+
+// KeyIDGT applies the GT predicate on the "key_id" field.
+func KeyIDGT(v string) predicate.File {
+	return predicate.File(sql.FieldGT(FieldKeyID, v))
+}
+
+// KeyIDGTE applies the GTE predicate on the "key_id" field.
+func KeyIDGTE(v string) predicate.File {
+	return predicate.File(sql.FieldGTE(FieldKeyID, v))
+}
+
+// KeyIDLT applies the LT predicate on the "key_id" field.
+func KeyIDLT(v string) predicate.File {
+	return predicate.File(sql.FieldLT(FieldKeyID, v))
+}
+
+// KeyIDLTE applies the LTE predicate on the "key_id" field.
+func KeyIDLTE(v string) predicate.File {
+	return predicate.File(sql.FieldLTE(FieldKeyID, v))
+}
+
+// KeyIDContains applies the Contains predicate on the "key_id" field.
+func KeyIDContains(v string) predicate.File {
+	return predicate.File(sql.FieldContains(FieldKeyID, v))
+}
+
+// KeyIDHasPrefix applies the HasPrefix predicate on the "key_id" field.
+func KeyIDHasPrefix(v string) predicate.File {
+	return predicate.File(sql.FieldHasPrefix(FieldKeyID, v))
+}
+
+// KeyIDHasSuffix applies the HasSuffix predicate on the "key_id" field.
+func KeyIDHasSuffix(v string) predicate.File {
+	return predicate.File(sql.FieldHasSuffix(FieldKeyID, v))
+}
+
+// KeyIDEqualFold applies the EqualFold predicate on the "key_id" field.
+func KeyIDEqualFold(v string) predicate.File {
+	return predicate.File(sql.FieldEqualFold(FieldKeyID, v))
+}
+
+// KeyIDContainsFold applies the ContainsFold predicate on the "key_id" field.
+func KeyIDContainsFold(v string) predicate.File {
+	return predicate.File(sql.FieldContainsFold(FieldKeyID, v))
+}
+
+// WrappedKeyEQ applies the EQ predicate on the "wrapped_key" field.
+func WrappedKeyEQ(v []byte) predicate.File {
+	return predicate.File(sql.FieldEQ(FieldWrappedKey, v))
+}
+
+// WrappedKeyNEQ applies the NEQ predicate on the "wrapped_key" field.
+func WrappedKeyNEQ(v []byte) predicate.File {
+	return predicate.File(sql.FieldNEQ(FieldWrappedKey, v))
+}
+
+// WrappedKeyIn applies the In predicate on the "wrapped_key" field.
+func WrappedKeyIn(vs ...[]byte) predicate.File {
+	return predicate.File(sql.FieldIn(FieldWrappedKey, vs...))
+}
+
+// WrappedKeyNotIn applies the NotIn predicate on the "wrapped_key" field.
+func WrappedKeyNotIn(vs ...[]byte) predicate.File {
+	return predicate.File(sql.FieldNotIn(FieldWrappedKey, vs...))
+}
+
+// WrappedKeyGT applies the GT predicate on the "wrapped_key" field.
+func WrappedKeyGT(v []byte) predicate.File {
+	return predicate.File(sql.FieldGT(FieldWrappedKey, v))
+}
+
+// WrappedKeyGTE applies the GTE predicate on the "wrapped_key" field.
+func WrappedKeyGTE(v []byte) predicate.File {
+	return predicate.File(sql.FieldGTE(FieldWrappedKey, v))
+}
+
+// WrappedKeyLT applies the LT predicate on the "wrapped_key" field.
+func WrappedKeyLT(v []byte) predicate.File {
+	return predicate.File(sql.FieldLT(FieldWrappedKey, v))
+}
+
+// WrappedKeyLTE applies the LTE predicate on the "wrapped_key" field.
+func WrappedKeyLTE(v []byte) predicate.File {
+	return predicate.File(sql.FieldLTE(FieldWrappedKey, v))
+}
+
+// WrappedKeyIsNil applies the IsNil predicate on the "wrapped_key" field.
+func WrappedKeyIsNil() predicate.File {
+	return predicate.File(sql.FieldIsNull(FieldWrappedKey))
+}
+
+// WrappedKeyNotNil applies the NotNil predicate on the "wrapped_key" field.
+func WrappedKeyNotNil() predicate.File {
+	return predicate.File(sql.FieldNotNull(FieldWrappedKey))
+}
+
+// Sha256EQ applies the EQ predicate on the "sha256" field.
+func Sha256EQ(v string) predicate.File {
+	return predicate.File(sql.FieldEQ(FieldSha256, v))
+}
+
+// Sha256NEQ applies the NEQ predicate on the "sha256" field.
+func Sha256NEQ(v string) predicate.File {
+	return predicate.File(sql.FieldNEQ(FieldSha256, v))
+}
+
+// Sha256In applies the In predicate on the "sha256" field.
+func Sha256In(vs ...string) predicate.File {
+	return predicate.File(sql.FieldIn(FieldSha256, vs...))
+}
+
+// Sha256NotIn applies the NotIn predicate on the "sha256" field.
+func Sha256NotIn(vs ...string) predicate.File {
+	return predicate.File(sql.FieldNotIn(FieldSha256, vs...))
+}
+
+// Sha256GT applies the GT predicate on the "sha256" field.
+func Sha256GT(v string) predicate.File {
+	return predicate.File(sql.FieldGT(FieldSha256, v))
+}
+
+// Sha256GTE applies the GTE predicate on the "sha256" field.
+func Sha256GTE(v string) predicate.File {
+	return predicate.File(sql.FieldGTE(FieldSha256, v))
+}
+
+// Sha256LT applies the LT predicate on the "sha256" field.
+func Sha256LT(v string) predicate.File {
+	return predicate.File(sql.FieldLT(FieldSha256, v))
+}
+
+// Sha256LTE applies the LTE predicate on the "sha256" field.
+func Sha256LTE(v string) predicate.File {
+	return predicate.File(sql.FieldLTE(FieldSha256, v))
+}
+
+// Sha256Contains applies the Contains predicate on the "sha256" field.
+func Sha256Contains(v string) predicate.File {
+	return predicate.File(sql.FieldContains(FieldSha256, v))
+}
+
+// Sha256HasPrefix applies the HasPrefix predicate on the "sha256" field.
+func Sha256HasPrefix(v string) predicate.File {
+	return predicate.File(sql.FieldHasPrefix(FieldSha256, v))
+}
+
+// Sha256HasSuffix applies the HasSuffix predicate on the "sha256" field.
+func Sha256HasSuffix(v string) predicate.File {
+	return predicate.File(sql.FieldHasSuffix(FieldSha256, v))
+}
+
+// Sha256EqualFold applies the EqualFold predicate on the "sha256" field.
+func Sha256EqualFold(v string) predicate.File {
+	return predicate.File(sql.FieldEqualFold(FieldSha256, v))
+}
+
+// Sha256ContainsFold applies the ContainsFold predicate on the "sha256" field.
+func Sha256ContainsFold(v string) predicate.File {
+	return predicate.File(sql.FieldContainsFold(FieldSha256, v))
+}
+
+// WidthEQ applies the EQ predicate on the "width" field.
+func WidthEQ(v int) predicate.File {
+	return predicate.File(sql.FieldEQ(FieldWidth, v))
+}
+
+// WidthNEQ applies the NEQ predicate on the "width" field.
+func WidthNEQ(v int) predicate.File {
+	return predicate.File(sql.FieldNEQ(FieldWidth, v))
+}
+
+// WidthIn applies the In predicate on the "width" field.
+func WidthIn(vs ...int) predicate.File {
+	return predicate.File(sql.FieldIn(FieldWidth, vs...))
+}
+
+// WidthNotIn applies the NotIn predicate on the "width" field.
+func WidthNotIn(vs ...int) predicate.File {
+	return predicate.File(sql.FieldNotIn(FieldWidth, vs...))
+}
+
+// WidthGT applies the GT predicate on the "width" field.
+func WidthGT(v int) predicate.File {
+	return predicate.File(sql.FieldGT(FieldWidth, v))
+}
+
+// WidthGTE applies the GTE predicate on the "width" field.
+func WidthGTE(v int) predicate.File {
+	return predicate.File(sql.FieldGTE(FieldWidth, v))
+}
+
+// WidthLT applies the LT predicate on the "width" field.
+func WidthLT(v int) predicate.File {
+	return predicate.File(sql.FieldLT(FieldWidth, v))
+}
+
+// WidthLTE applies the LTE predicate on the "width" field.
+func WidthLTE(v int) predicate.File {
+	return predicate.File(sql.FieldLTE(FieldWidth, v))
+}
+
+// HeightEQ applies the EQ predicate on the "height" field.
+func HeightEQ(v int) predicate.File {
+	return predicate.File(sql.FieldEQ(FieldHeight, v))
+}
+
+// HeightNEQ applies the NEQ predicate on the "height" field.
+func HeightNEQ(v int) predicate.File {
+	return predicate.File(sql.FieldNEQ(FieldHeight, v))
+}
+
+// HeightIn applies the In predicate on the "height" field.
+func HeightIn(vs ...int) predicate.File {
+	return predicate.File(sql.FieldIn(FieldHeight, vs...))
+}
+
+// HeightNotIn applies the NotIn predicate on the "height" field.
+func HeightNotIn(vs ...int) predicate.File {
+	return predicate.File(sql.FieldNotIn(FieldHeight, vs...))
+}
+
+// HeightGT applies the GT predicate on the "height" field.
+func HeightGT(v int) predicate.File {
+	return predicate.File(sql.FieldGT(FieldHeight, v))
+}
+
+// HeightGTE applies the GTE predicate on the "height" field.
+func HeightGTE(v int) predicate.File {
+	return predicate.File(sql.FieldGTE(FieldHeight, v))
+}
+
+// HeightLT applies the LT predicate on the "height" field.
+func HeightLT(v int) predicate.File {
+	return predicate.File(sql.FieldLT(FieldHeight, v))
+}
+
+// HeightLTE applies the LTE predicate on the "height" field.
+func HeightLTE(v int) predicate.File {
+	return predicate.File(sql.FieldLTE(FieldHeight, v))
+}
+
+// DominantColorEQ applies the EQ predicate on the "dominant_color" field.
+func DominantColorEQ(v string) predicate.File {
+	return predicate.File(sql.FieldEQ(FieldDominantColor, v))
+}
+
+// DominantColorNEQ applies the NEQ predicate on the "dominant_color" field.
+func DominantColorNEQ(v string) predicate.File {
+	return predicate.File(sql.FieldNEQ(FieldDominantColor, v))
+}
+
+// DominantColorIn applies the In predicate on the "dominant_color" field.
+func DominantColorIn(vs ...string) predicate.File {
+	return predicate.File(sql.FieldIn(FieldDominantColor, vs...))
+}
+
+// DominantColorNotIn applies the NotIn predicate on the "dominant_color" field.
+func DominantColorNotIn(vs ...string) predicate.File {
+	return predicate.File(sql.FieldNotIn(FieldDominantColor, vs...))
+}
+
+// DominantColorGT applies the GT predicate on the "dominant_color" field.
+func DominantColorGT(v string) predicate.File {
+	return predicate.File(sql.FieldGT(FieldDominantColor, v))
+}
+
+// DominantColorGTE applies the GTE predicate on the "dominant_color" field.
+func DominantColorGTE(v string) predicate.File {
+	return predicate.File(sql.FieldGTE(FieldDominantColor, v))
+}
+
+// DominantColorLT applies the LT predicate on the "dominant_color" field.
+func DominantColorLT(v string) predicate.File {
+	return predicate.File(sql.FieldLT(FieldDominantColor, v))
+}
+
+// DominantColorLTE applies the LTE predicate on the "dominant_color" field.
+func DominantColorLTE(v string) predicate.File {
+	return predicate.File(sql.FieldLTE(FieldDominantColor, v))
+}
+
+// DominantColorContains applies the Contains predicate on the "dominant_color" field.
+func DominantColorContains(v string) predicate.File {
+	return predicate.File(sql.FieldContains(FieldDominantColor, v))
+}
+
+// DominantColorHasPrefix applies the HasPrefix predicate on the "dominant_color" field.
+func DominantColorHasPrefix(v string) predicate.File {
+	return predicate.File(sql.FieldHasPrefix(FieldDominantColor, v))
+}
+
+// DominantColorHasSuffix applies the HasSuffix predicate on the "dominant_color" field.
+func DominantColorHasSuffix(v string) predicate.File {
+	return predicate.File(sql.FieldHasSuffix(FieldDominantColor, v))
+}
+
+// DominantColorEqualFold applies the EqualFold predicate on the "dominant_color" field.
+func DominantColorEqualFold(v string) predicate.File {
+	return predicate.File(sql.FieldEqualFold(FieldDominantColor, v))
+}
+
+// DominantColorContainsFold applies the ContainsFold predicate on the "dominant_color" field.
+func DominantColorContainsFold(v string) predicate.File {
+	return predicate.File(sql.FieldContainsFold(FieldDominantColor, v))
+}
+
+// SourceURLEQ applies the EQ predicate on the "source_url" field.
+func SourceURLEQ(v string) predicate.File {
+	return predicate.File(sql.FieldEQ(FieldSourceURL, v))
+}
+
+// SourceURLNEQ applies the NEQ predicate on the "source_url" field.
+func SourceURLNEQ(v string) predicate.File {
+	return predicate.File(sql.FieldNEQ(FieldSourceURL, v))
+}
+
+// SourceURLIn applies the In predicate on the "source_url" field.
+func SourceURLIn(vs ...string) predicate.File {
+	return predicate.File(sql.FieldIn(FieldSourceURL, vs...))
+}
+
+// SourceURLNotIn applies the NotIn predicate on the "source_url" field.
+func SourceURLNotIn(vs ...string) predicate.File {
+	return predicate.File(sql.FieldNotIn(FieldSourceURL, vs...))
+}
+
+// SourceURLGT applies the GT predicate on the "source_url" field.
+func SourceURLGT(v string) predicate.File {
+	return predicate.File(sql.FieldGT(FieldSourceURL, v))
+}
+
+// SourceURLGTE applies the GTE predicate on the "source_url" field.
+func SourceURLGTE(v string) predicate.File {
+	return predicate.File(sql.FieldGTE(FieldSourceURL, v))
+}
+
+// SourceURLLT applies the LT predicate on the "source_url" field.
+func SourceURLLT(v string) predicate.File {
+	return predicate.File(sql.FieldLT(FieldSourceURL, v))
+}
+
+// SourceURLLTE applies the LTE predicate on the "source_url" field.
+func SourceURLLTE(v string) predicate.File {
+	return predicate.File(sql.FieldLTE(FieldSourceURL, v))
+}
+
+// SourceURLContains applies the Contains predicate on the "source_url" field.
+func SourceURLContains(v string) predicate.File {
+	return predicate.File(sql.FieldContains(FieldSourceURL, v))
+}
+
+// SourceURLHasPrefix applies the HasPrefix predicate on the "source_url" field.
+func SourceURLHasPrefix(v string) predicate.File {
+	return predicate.File(sql.FieldHasPrefix(FieldSourceURL, v))
+}
+
+// SourceURLHasSuffix applies the HasSuffix predicate on the "source_url" field.
+func SourceURLHasSuffix(v string) predicate.File {
+	return predicate.File(sql.FieldHasSuffix(FieldSourceURL, v))
+}
+
+// SourceURLEqualFold applies the EqualFold predicate on the "source_url" field.
+func SourceURLEqualFold(v string) predicate.File {
+	return predicate.File(sql.FieldEqualFold(FieldSourceURL, v))
+}
+
+// SourceURLContainsFold applies the ContainsFold predicate on the "source_url" field.
+func SourceURLContainsFold(v string) predicate.File {
+	return predicate.File(sql.FieldContainsFold(FieldSourceURL, v))
+}
+
+// SearchQueryEQ applies the EQ predicate on the "search_query" field.
+func SearchQueryEQ(v string) predicate.File {
+	return predicate.File(sql.FieldEQ(FieldSearchQuery, v))
+}
+
+// SearchQueryNEQ applies the NEQ predicate on the "search_query" field.
+func SearchQueryNEQ(v string) predicate.File {
+	return predicate.File(sql.FieldNEQ(FieldSearchQuery, v))
+}
+
+// SearchQueryIn applies the In predicate on the "search_query" field.
+func SearchQueryIn(vs ...string) predicate.File {
+	return predicate.File(sql.FieldIn(FieldSearchQuery, vs...))
+}
+
+// SearchQueryNotIn applies the NotIn predicate on the "search_query" field.
+func SearchQueryNotIn(vs ...string) predicate.File {
+	return predicate.File(sql.FieldNotIn(FieldSearchQuery, vs...))
+}
+
+// SearchQueryGT applies the GT predicate on the "search_query" field.
+func SearchQueryGT(v string) predicate.File {
+	return predicate.File(sql.FieldGT(FieldSearchQuery, v))
+}
+
+// SearchQueryGTE applies the GTE predicate on the "search_query" field.
+func SearchQueryGTE(v string) predicate.File {
+	return predicate.File(sql.FieldGTE(FieldSearchQuery, v))
+}
+
+// SearchQueryLT applies the LT predicate on the "search_query" field.
+func SearchQueryLT(v string) predicate.File {
+	return predicate.File(sql.FieldLT(FieldSearchQuery, v))
+}
+
+// SearchQueryLTE applies the LTE predicate on the "search_query" field.
+func SearchQueryLTE(v string) predicate.File {
+	return predicate.File(sql.FieldLTE(FieldSearchQuery, v))
+}
+
+// SearchQueryContains applies the Contains predicate on the "search_query" field.
+func SearchQueryContains(v string) predicate.File {
+	return predicate.File(sql.FieldContains(FieldSearchQuery, v))
+}
+
+// SearchQueryHasPrefix applies the HasPrefix predicate on the "search_query" field.
+func SearchQueryHasPrefix(v string) predicate.File {
+	return predicate.File(sql.FieldHasPrefix(FieldSearchQuery, v))
+}
+
+// SearchQueryHasSuffix applies the HasSuffix predicate on the "search_query" field.
+func SearchQueryHasSuffix(v string) predicate.File {
+	return predicate.File(sql.FieldHasSuffix(FieldSearchQuery, v))
+}
+
+// SearchQueryEqualFold applies the EqualFold predicate on the "search_query" field.
+func SearchQueryEqualFold(v string) predicate.File {
+	return predicate.File(sql.FieldEqualFold(FieldSearchQuery, v))
+}
+
+// SearchQueryContainsFold applies the ContainsFold predicate on the "search_query" field.
+func SearchQueryContainsFold(v string) predicate.File {
+	return predicate.File(sql.FieldContainsFold(FieldSearchQuery, v))
+}
+
+// TagNamesEQ applies the EQ predicate on the "tag_names" field.
+func TagNamesEQ(v string) predicate.File {
+	return predicate.File(sql.FieldEQ(FieldTagNames, v))
+}
+
+// TagNamesNEQ applies the NEQ predicate on the "tag_names" field.
+func TagNamesNEQ(v string) predicate.File {
+	return predicate.File(sql.FieldNEQ(FieldTagNames, v))
+}
+
+// TagNamesIn applies the In predicate on the "tag_names" field.
+func TagNamesIn(vs ...string) predicate.File {
+	return predicate.File(sql.FieldIn(FieldTagNames, vs...))
+}
+
+// TagNamesNotIn applies the NotIn predicate on the "tag_names" field.
+func TagNamesNotIn(vs ...string) predicate.File {
+	return predicate.File(sql.FieldNotIn(FieldTagNames, vs...))
+}
+
+// TagNamesGT applies the GT predicate on the "tag_names" field.
+func TagNamesGT(v string) predicate.File {
+	return predicate.File(sql.FieldGT(FieldTagNames, v))
+}
+
+// TagNamesGTE applies the GTE predicate on the "tag_names" field.
+func TagNamesGTE(v string) predicate.File {
+	return predicate.File(sql.FieldGTE(FieldTagNames, v))
+}
+
+// TagNamesLT applies the LT predicate on the "tag_names" field.
+func TagNamesLT(v string) predicate.File {
+	return predicate.File(sql.FieldLT(FieldTagNames, v))
+}
+
+// TagNamesLTE applies the LTE predicate on the "tag_names" field.
+func TagNamesLTE(v string) predicate.File {
+	return predicate.File(sql.FieldLTE(FieldTagNames, v))
+}
+
+// TagNamesContains applies the Contains predicate on the "tag_names" field.
+func TagNamesContains(v string) predicate.File {
+	return predicate.File(sql.FieldContains(FieldTagNames, v))
+}
+
+// TagNamesHasPrefix applies the HasPrefix predicate on the "tag_names" field.
+func TagNamesHasPrefix(v string) predicate.File {
+	return predicate.File(sql.FieldHasPrefix(FieldTagNames, v))
+}
+
+// TagNamesHasSuffix applies the HasSuffix predicate on the "tag_names" field.
+func TagNamesHasSuffix(v string) predicate.File {
+	return predicate.File(sql.FieldHasSuffix(FieldTagNames, v))
+}
+
+// TagNamesEqualFold applies the EqualFold predicate on the "tag_names" field.
+func TagNamesEqualFold(v string) predicate.File {
+	return predicate.File(sql.FieldEqualFold(FieldTagNames, v))
+}
+
+// TagNamesContainsFold applies the ContainsFold predicate on the "tag_names" field.
+func TagNamesContainsFold(v string) predicate.File {
+	return predicate.File(sql.FieldContainsFold(FieldTagNames, v))
+}
+
+// CameraMakeEQ applies the EQ predicate on the "camera_make" field.
+func CameraMakeEQ(v string) predicate.File {
+	return predicate.File(sql.FieldEQ(FieldCameraMake, v))
+}
+
+// CameraMakeNEQ applies the NEQ predicate on the "camera_make" field.
+func CameraMakeNEQ(v string) predicate.File {
+	return predicate.File(sql.FieldNEQ(FieldCameraMake, v))
+}
+
+// CameraMakeIn applies the In predicate on the "camera_make" field.
+func CameraMakeIn(vs ...string) predicate.File {
+	return predicate.File(sql.FieldIn(FieldCameraMake, vs...))
+}
+
+// CameraMakeNotIn applies the NotIn predicate on the "camera_make" field.
+func CameraMakeNotIn(vs ...string) predicate.File {
+	return predicate.File(sql.FieldNotIn(FieldCameraMake, vs...))
+}
+
+// CameraMakeGT applies the GT predicate on the "camera_make" field.
+func CameraMakeGT(v string) predicate.File {
+	return predicate.File(sql.FieldGT(FieldCameraMake, v))
+}
+
+// CameraMakeGTE applies the GTE predicate on the "camera_make" field.
+func CameraMakeGTE(v string) predicate.File {
+	return predicate.File(sql.FieldGTE(FieldCameraMake, v))
+}
+
+// CameraMakeLT applies the LT predicate on the "camera_make" field.
+func CameraMakeLT(v string) predicate.File {
+	return predicate.File(sql.FieldLT(FieldCameraMake, v))
+}
+
+// CameraMakeLTE applies the LTE predicate on the "camera_make" field.
+func CameraMakeLTE(v string) predicate.File {
+	return predicate.File(sql.FieldLTE(FieldCameraMake, v))
+}
+
+// CameraMakeContains applies the Contains predicate on the "camera_make" field.
+func CameraMakeContains(v string) predicate.File {
+	return predicate.File(sql.FieldContains(FieldCameraMake, v))
+}
+
+// CameraMakeHasPrefix applies the HasPrefix predicate on the "camera_make" field.
+func CameraMakeHasPrefix(v string) predicate.File {
+	return predicate.File(sql.FieldHasPrefix(FieldCameraMake, v))
+}
+
+// CameraMakeHasSuffix applies the HasSuffix predicate on the "camera_make" field.
+func CameraMakeHasSuffix(v string) predicate.File {
+	return predicate.File(sql.FieldHasSuffix(FieldCameraMake, v))
+}
+
+// CameraMakeEqualFold applies the EqualFold predicate on the "camera_make" field.
+func CameraMakeEqualFold(v string) predicate.File {
+	return predicate.File(sql.FieldEqualFold(FieldCameraMake, v))
+}
+
+// CameraMakeContainsFold applies the ContainsFold predicate on the "camera_make" field.
+func CameraMakeContainsFold(v string) predicate.File {
+	return predicate.File(sql.FieldContainsFold(FieldCameraMake, v))
+}
+
+// CameraModelEQ applies the EQ predicate on the "camera_model" field.
+func CameraModelEQ(v string) predicate.File {
+	return predicate.File(sql.FieldEQ(FieldCameraModel, v))
+}
+
+// CameraModelNEQ applies the NEQ predicate on the "camera_model" field.
+func CameraModelNEQ(v string) predicate.File {
+	return predicate.File(sql.FieldNEQ(FieldCameraModel, v))
+}
+
+// CameraModelIn applies the In predicate on the "camera_model" field.
+func CameraModelIn(vs ...string) predicate.File {
+	return predicate.File(sql.FieldIn(FieldCameraModel, vs...))
+}
+
+// CameraModelNotIn applies the NotIn predicate on the "camera_model" field.
+func CameraModelNotIn(vs ...string) predicate.File {
+	return predicate.File(sql.FieldNotIn(FieldCameraModel, vs...))
+}
+
+// CameraModelGT applies the GT predicate on the "camera_model" field.
+func CameraModelGT(v string) predicate.File {
+	return predicate.File(sql.FieldGT(FieldCameraModel, v))
+}
+
+// CameraModelGTE applies the GTE predicate on the "camera_model" field.
+func CameraModelGTE(v string) predicate.File {
+	return predicate.File(sql.FieldGTE(FieldCameraModel, v))
+}
+
+// CameraModelLT applies the LT predicate on the "camera_model" field.
+func CameraModelLT(v string) predicate.File {
+	return predicate.File(sql.FieldLT(FieldCameraModel, v))
+}
+
+// CameraModelLTE applies the LTE predicate on the "camera_model" field.
+func CameraModelLTE(v string) predicate.File {
+	return predicate.File(sql.FieldLTE(FieldCameraModel, v))
+}
+
+// CameraModelContains applies the Contains predicate on the "camera_model" field.
+func CameraModelContains(v string) predicate.File {
+	return predicate.File(sql.FieldContains(FieldCameraModel, v))
+}
+
+// CameraModelHasPrefix applies the HasPrefix predicate on the "camera_model" field.
+func CameraModelHasPrefix(v string) predicate.File {
+	return predicate.File(sql.FieldHasPrefix(FieldCameraModel, v))
+}
+
+// CameraModelHasSuffix applies the HasSuffix predicate on the "camera_model" field.
+func CameraModelHasSuffix(v string) predicate.File {
+	return predicate.File(sql.FieldHasSuffix(FieldCameraModel, v))
+}
+
+// CameraModelEqualFold applies the EqualFold predicate on the "camera_model" field.
+func CameraModelEqualFold(v string) predicate.File {
+	return predicate.File(sql.FieldEqualFold(FieldCameraModel, v))
+}
+
+// CameraModelContainsFold applies the ContainsFold predicate on the "camera_model" field.
+func CameraModelContainsFold(v string) predicate.File {
+	return predicate.File(sql.FieldContainsFold(FieldCameraModel, v))
+}
+
+// TakenAtEQ applies the EQ predicate on the "taken_at" field.
+func TakenAtEQ(v time.Time) predicate.File {
+	return predicate.File(sql.FieldEQ(FieldTakenAt, v))
+}
+
+// TakenAtNEQ applies the NEQ predicate on the "taken_at" field.
+func TakenAtNEQ(v time.Time) predicate.File {
+	return predicate.File(sql.FieldNEQ(FieldTakenAt, v))
+}
+
+// TakenAtIn applies the In predicate on the "taken_at" field.
+func TakenAtIn(vs ...time.Time) predicate.File {
+	return predicate.File(sql.FieldIn(FieldTakenAt, vs...))
+}
+
+// TakenAtNotIn applies the NotIn predicate on the "taken_at" field.
+func TakenAtNotIn(vs ...time.Time) predicate.File {
+	return predicate.File(sql.FieldNotIn(FieldTakenAt, vs...))
+}
+
+// TakenAtGT applies the GT predicate on the "taken_at" field.
+func TakenAtGT(v time.Time) predicate.File {
+	return predicate.File(sql.FieldGT(FieldTakenAt, v))
+}
+
+// TakenAtGTE applies the GTE predicate on the "taken_at" field.
+func TakenAtGTE(v time.Time) predicate.File {
+	return predicate.File(sql.FieldGTE(FieldTakenAt, v))
+}
+
+// TakenAtLT applies the LT predicate on the "taken_at" field.
+func TakenAtLT(v time.Time) predicate.File {
+	return predicate.File(sql.FieldLT(FieldTakenAt, v))
+}
+
+// TakenAtLTE applies the LTE predicate on the "taken_at" field.
+func TakenAtLTE(v time.Time) predicate.File {
+	return predicate.File(sql.FieldLTE(FieldTakenAt, v))
+}
+
+// TakenAtIsNil applies the IsNil predicate on the "taken_at" field.
+func TakenAtIsNil() predicate.File {
+	return predicate.File(sql.FieldIsNull(FieldTakenAt))
+}
+
+// TakenAtNotNil applies the NotNil predicate on the "taken_at" field.
+func TakenAtNotNil() predicate.File {
+	return predicate.File(sql.FieldNotNull(FieldTakenAt))
+}
+
 // CreatedAtEQ applies the EQ predicate on the "created_at" field.
 func CreatedAtEQ(v time.Time) predicate.File {
 	return predicate.File(sql.FieldEQ(FieldCreatedAt, v))
@@ -559,6 +1389,29 @@ func HasTagsWith(preds ...predicate.Tag) predicate.File {
 	})
 }
 
+// HasShares applies the HasEdge predicate on the "shares" edge.
+func HasShares() predicate.File {
+	return predicate.File(func(s *sql.Selector) {
+		step := sqlgraph.NewStep(
+			sqlgraph.From(Table, FieldID),
+			sqlgraph.Edge(sqlgraph.O2M, false, SharesTable, SharesColumn),
+		)
+		sqlgraph.HasNeighbors(s, step)
+	})
+}
+
+// HasSharesWith applies the HasEdge predicate on the "shares" edge with a given conditions (other predicates).
+func HasSharesWith(preds ...predicate.Share) predicate.File {
+	return predicate.File(func(s *sql.Selector) {
+		step := newSharesStep()
+		sqlgraph.HasNeighborsWith(s, step, func(s *sql.Selector) {
+			for _, p := range preds {
+				p(s)
+			}
+		})
+	})
+}
+
 // And groups predicates with the AND operator between them.
 func And(predicates ...predicate.File) predicate.File {
 	return predicate.File(sql.AndPredicates(predicates...))
@@ -13,6 +13,7 @@ import (
 	"entgo.io/ent/schema/field"
 	"github.com/lebleuciel/maani/pkg/database/ent/file"
 	"github.com/lebleuciel/maani/pkg/database/ent/filetype"
+	"github.com/lebleuciel/maani/pkg/database/ent/share"
 	"github.com/lebleuciel/maani/pkg/database/ent/tag"
 	"github.com/lebleuciel/maani/pkg/database/ent/user"
 )
@@ -55,6 +56,194 @@ func (fc *FileCreate) SetType(s string) *FileCreate {
 	return fc
 }
 
+// SetBurnAfterRead sets the "burn_after_read" field.
+func (fc *FileCreate) SetBurnAfterRead(b bool) *FileCreate {
+	fc.mutation.SetBurnAfterRead(b)
+	return fc
+}
+
+// SetNillableBurnAfterRead sets the "burn_after_read" field if the given value is not nil.
+func (fc *FileCreate) SetNillableBurnAfterRead(b *bool) *FileCreate {
+	if b != nil {
+		fc.SetBurnAfterRead(*b)
+	}
+	return fc
+}
+
+// SetPhash sets the "phash" field.
+func (fc *FileCreate) SetPhash(i int64) *FileCreate {
+	fc.mutation.SetPhash(i)
+	return fc
+}
+
+// SetNillablePhash sets the "phash" field if the given value is not nil.
+func (fc *FileCreate) SetNillablePhash(i *int64) *FileCreate {
+	if i != nil {
+		fc.SetPhash(*i)
+	}
+	return fc
+}
+
+// SetKeyID sets the "key_id" field.
+func (fc *FileCreate) SetKeyID(s string) *FileCreate {
+	fc.mutation.SetKeyID(s)
+	return fc
+}
+
+// SetNillableKeyID sets the "key_id" field if the given value is not nil.
+func (fc *FileCreate) SetNillableKeyID(s *string) *FileCreate {
+	if s != nil {
+		fc.SetKeyID(*s)
+	}
+	return fc
+}
+
+// SetWrappedKey sets the "wrapped_key" field.
+func (fc *FileCreate) SetWrappedKey(b []byte) *FileCreate {
+	fc.mutation.SetWrappedKey(b)
+	return fc
+}
+
+// SetSha256 sets the "sha256" field.
+func (fc *FileCreate) SetSha256(s string) *FileCreate {
+	fc.mutation.SetSha256(s)
+	return fc
+}
+
+// SetNillableSha256 sets the "sha256" field if the given value is not nil.
+func (fc *FileCreate) SetNillableSha256(s *string) *FileCreate {
+	if s != nil {
+		fc.SetSha256(*s)
+	}
+	return fc
+}
+
+// SetWidth sets the "width" field.
+func (fc *FileCreate) SetWidth(i int) *FileCreate {
+	fc.mutation.SetWidth(i)
+	return fc
+}
+
+// SetNillableWidth sets the "width" field if the given value is not nil.
+func (fc *FileCreate) SetNillableWidth(i *int) *FileCreate {
+	if i != nil {
+		fc.SetWidth(*i)
+	}
+	return fc
+}
+
+// SetHeight sets the "height" field.
+func (fc *FileCreate) SetHeight(i int) *FileCreate {
+	fc.mutation.SetHeight(i)
+	return fc
+}
+
+// SetNillableHeight sets the "height" field if the given value is not nil.
+func (fc *FileCreate) SetNillableHeight(i *int) *FileCreate {
+	if i != nil {
+		fc.SetHeight(*i)
+	}
+	return fc
+}
+
+// SetDominantColor sets the "dominant_color" field.
+func (fc *FileCreate) SetDominantColor(s string) *FileCreate {
+	fc.mutation.SetDominantColor(s)
+	return fc
+}
+
+// SetNillableDominantColor sets the "dominant_color" field if the given value is not nil.
+func (fc *FileCreate) SetNillableDominantColor(s *string) *FileCreate {
+	if s != nil {
+		fc.SetDominantColor(*s)
+	}
+	return fc
+}
+
+// SetSourceURL sets the "source_url" field.
+func (fc *FileCreate) SetSourceURL(s string) *FileCreate {
+	fc.mutation.SetSourceURL(s)
+	return fc
+}
+
+// SetNillableSourceURL sets the "source_url" field if the given value is not nil.
+func (fc *FileCreate) SetNillableSourceURL(s *string) *FileCreate {
+	if s != nil {
+		fc.SetSourceURL(*s)
+	}
+	return fc
+}
+
+// SetSearchQuery sets the "search_query" field.
+func (fc *FileCreate) SetSearchQuery(s string) *FileCreate {
+	fc.mutation.SetSearchQuery(s)
+	return fc
+}
+
+// SetNillableSearchQuery sets the "search_query" field if the given value is not nil.
+func (fc *FileCreate) SetNillableSearchQuery(s *string) *FileCreate {
+	if s != nil {
+		fc.SetSearchQuery(*s)
+	}
+	return fc
+}
+
+// SetTagNames sets the "tag_names" field.
+func (fc *FileCreate) SetTagNames(s string) *FileCreate {
+	fc.mutation.SetTagNames(s)
+	return fc
+}
+
+// SetNillableTagNames sets the "tag_names" field if the given value is not nil.
+func (fc *FileCreate) SetNillableTagNames(s *string) *FileCreate {
+	if s != nil {
+		fc.SetTagNames(*s)
+	}
+	return fc
+}
+
+// SetCameraMake sets the "camera_make" field.
+func (fc *FileCreate) SetCameraMake(s string) *FileCreate {
+	fc.mutation.SetCameraMake(s)
+	return fc
+}
+
+// SetNillableCameraMake sets the "camera_make" field if the given value is not nil.
+func (fc *FileCreate) SetNillableCameraMake(s *string) *FileCreate {
+	if s != nil {
+		fc.SetCameraMake(*s)
+	}
+	return fc
+}
+
+// SetCameraModel sets the "camera_model" field.
+func (fc *FileCreate) SetCameraModel(s string) *FileCreate {
+	fc.mutation.SetCameraModel(s)
+	return fc
+}
+
+// SetNillableCameraModel sets the "camera_model" field if the given value is not nil.
+func (fc *FileCreate) SetNillableCameraModel(s *string) *FileCreate {
+	if s != nil {
+		fc.SetCameraModel(*s)
+	}
+	return fc
+}
+
+// SetTakenAt sets the "taken_at" field.
+func (fc *FileCreate) SetTakenAt(t time.Time) *FileCreate {
+	fc.mutation.SetTakenAt(t)
+	return fc
+}
+
+// SetNillableTakenAt sets the "taken_at" field if the given value is not nil.
+func (fc *FileCreate) SetNillableTakenAt(t *time.Time) *FileCreate {
+	if t != nil {
+		fc.SetTakenAt(*t)
+	}
+	return fc
+}
+
 // SetCreatedAt sets the "created_at" field.
 func (fc *FileCreate) SetCreatedAt(t time.Time) *FileCreate {
 	fc.mutation.SetCreatedAt(t)
@@ -128,6 +317,21 @@ func (fc *FileCreate) AddTags(t ...*Tag) *FileCreate {
 	return fc.AddTagIDs(ids...)
 }
 
+// AddShareIDs adds the "shares" edge to the Share entity by IDs.
+func (fc *FileCreate) AddShareIDs(ids ...int) *FileCreate {
+	fc.mutation.AddShareIDs(ids...)
+	return fc
+}
+
+// AddShares adds the "shares" edges to the Share entity.
+func (fc *FileCreate) AddShares(s ...*Share) *FileCreate {
+	ids := make([]int, len(s))
+	for i := range s {
+		ids[i] = s[i].ID
+	}
+	return fc.AddShareIDs(ids...)
+}
+
 // Mutation returns the FileMutation object of the builder.
 func (fc *FileCreate) Mutation() *FileMutation {
 	return fc.mutation
@@ -163,6 +367,50 @@ func (fc *FileCreate) ExecX(ctx context.Context) {
 
 // defaults sets the default values of the builder before save.
 func (fc *FileCreate) defaults() {
+	if _, ok := fc.mutation.BurnAfterRead(); !ok {
+		v := file.DefaultBurnAfterRead
+		fc.mutation.SetBurnAfterRead(v)
+	}
+	if _, ok := fc.mutation.KeyID(); !ok {
+		v := file.DefaultKeyID
+		fc.mutation.SetKeyID(v)
+	}
+	if _, ok := fc.mutation.Sha256(); !ok {
+		v := file.DefaultSha256
+		fc.mutation.SetSha256(v)
+	}
+	if _, ok := fc.mutation.Width(); !ok {
+		v := file.DefaultWidth
+		fc.mutation.SetWidth(v)
+	}
+	if _, ok := fc.mutation.Height(); !ok {
+		v := file.DefaultHeight
+		fc.mutation.SetHeight(v)
+	}
+	if _, ok := fc.mutation.DominantColor(); !ok {
+		v := file.DefaultDominantColor
+		fc.mutation.SetDominantColor(v)
+	}
+	if _, ok := fc.mutation.SourceURL(); !ok {
+		v := file.DefaultSourceURL
+		fc.mutation.SetSourceURL(v)
+	}
+	if _, ok := fc.mutation.SearchQuery(); !ok {
+		v := file.DefaultSearchQuery
+		fc.mutation.SetSearchQuery(v)
+	}
+	if _, ok := fc.mutation.TagNames(); !ok {
+		v := file.DefaultTagNames
+		fc.mutation.SetTagNames(v)
+	}
+	if _, ok := fc.mutation.CameraMake(); !ok {
+		v := file.DefaultCameraMake
+		fc.mutation.SetCameraMake(v)
+	}
+	if _, ok := fc.mutation.CameraModel(); !ok {
+		v := file.DefaultCameraModel
+		fc.mutation.SetCameraModel(v)
+	}
 	if _, ok := fc.mutation.CreatedAt(); !ok {
 		v := file.DefaultCreatedAt()
 		fc.mutation.SetCreatedAt(v)
@@ -171,10 +419,6 @@ func (fc *FileCreate) defaults() {
 		v := file.DefaultUpdatedAt()
 		fc.mutation.SetUpdatedAt(v)
 	}
-	if _, ok := fc.mutation.DeletedAt(); !ok {
-		v := file.DefaultDeletedAt()
-		fc.mutation.SetDeletedAt(v)
-	}
 }
 
 // check runs all checks and user-defined validators on the builder.
@@ -209,6 +453,74 @@ func (fc *FileCreate) check() error {
 			return &ValidationError{Name: "type", err: fmt.Errorf(`ent: validator failed for field "File.type": %w`, err)}
 		}
 	}
+	if _, ok := fc.mutation.BurnAfterRead(); !ok {
+		return &ValidationError{Name: "burn_after_read", err: errors.New(`ent: missing required field "File.burn_after_read"`)}
+	}
+	if _, ok := fc.mutation.KeyID(); !ok {
+		return &ValidationError{Name: "key_id", err: errors.New(`ent: missing required field "File.key_id"`)}
+	}
+	if v, ok := fc.mutation.KeyID(); ok {
+		if err := file.KeyIDValidator(v); err != nil {
+			return &ValidationError{Name: "key_id", err: fmt.Errorf(`ent: validator failed for field "File.key_id": %w`, err)}
+		}
+	}
+	if _, ok := fc.mutation.Sha256(); !ok {
+		return &ValidationError{Name: "sha256", err: errors.New(`ent: missing required field "File.sha256"`)}
+	}
+	if v, ok := fc.mutation.Sha256(); ok {
+		if err := file.Sha256Validator(v); err != nil {
+			return &ValidationError{Name: "sha256", err: fmt.Errorf(`ent: validator failed for field "File.sha256": %w`, err)}
+		}
+	}
+	if _, ok := fc.mutation.Width(); !ok {
+		return &ValidationError{Name: "width", err: errors.New(`ent: missing required field "File.width"`)}
+	}
+	if _, ok := fc.mutation.Height(); !ok {
+		return &ValidationError{Name: "height", err: errors.New(`ent: missing required field "File.height"`)}
+	}
+	if _, ok := fc.mutation.DominantColor(); !ok {
+		return &ValidationError{Name: "dominant_color", err: errors.New(`ent: missing required field "File.dominant_color"`)}
+	}
+	if v, ok := fc.mutation.DominantColor(); ok {
+		if err := file.DominantColorValidator(v); err != nil {
+			return &ValidationError{Name: "dominant_color", err: fmt.Errorf(`ent: validator failed for field "File.dominant_color": %w`, err)}
+		}
+	}
+	if _, ok := fc.mutation.SourceURL(); !ok {
+		return &ValidationError{Name: "source_url", err: errors.New(`ent: missing required field "File.source_url"`)}
+	}
+	if v, ok := fc.mutation.SourceURL(); ok {
+		if err := file.SourceURLValidator(v); err != nil {
+			return &ValidationError{Name: "source_url", err: fmt.Errorf(`ent: validator failed for field "File.source_url": %w`, err)}
+		}
+	}
+	if _, ok := fc.mutation.SearchQuery(); !ok {
+		return &ValidationError{Name: "search_query", err: errors.New(`ent: missing required field "File.search_query"`)}
+	}
+	if v, ok := fc.mutation.SearchQuery(); ok {
+		if err := file.SearchQueryValidator(v); err != nil {
+			return &ValidationError{Name: "search_query", err: fmt.Errorf(`ent: validator failed for field "File.search_query": %w`, err)}
+		}
+	}
+	if _, ok := fc.mutation.TagNames(); !ok {
+		return &ValidationError{Name: "tag_names", err: errors.New(`ent: missing required field "File.tag_names"`)}
+	}
+	if _, ok := fc.mutation.CameraMake(); !ok {
+		return &ValidationError{Name: "camera_make", err: errors.New(`ent: missing required field "File.camera_make"`)}
+	}
+	if v, ok := fc.mutation.CameraMake(); ok {
+		if err := file.CameraMakeValidator(v); err != nil {
+			return &ValidationError{Name: "camera_make", err: fmt.Errorf(`ent: validator failed for field "File.camera_make": %w`, err)}
+		}
+	}
+	if _, ok := fc.mutation.CameraModel(); !ok {
+		return &ValidationError{Name: "camera_model", err: errors.New(`ent: missing required field "File.camera_model"`)}
+	}
+	if v, ok := fc.mutation.CameraModel(); ok {
+		if err := file.CameraModelValidator(v); err != nil {
+			return &ValidationError{Name: "camera_model", err: fmt.Errorf(`ent: validator failed for field "File.camera_model": %w`, err)}
+		}
+	}
 	if _, ok := fc.mutation.UpdatedAt(); !ok {
 		return &ValidationError{Name: "updated_at", err: errors.New(`ent: missing required field "File.updated_at"`)}
 	}
@@ -257,6 +569,62 @@ func (fc *FileCreate) createSpec() (*File, *sqlgraph.CreateSpec) {
 		_spec.SetField(file.FieldSize, field.TypeInt, value)
 		_node.Size = value
 	}
+	if value, ok := fc.mutation.BurnAfterRead(); ok {
+		_spec.SetField(file.FieldBurnAfterRead, field.TypeBool, value)
+		_node.BurnAfterRead = value
+	}
+	if value, ok := fc.mutation.Phash(); ok {
+		_spec.SetField(file.FieldPhash, field.TypeInt64, value)
+		_node.Phash = &value
+	}
+	if value, ok := fc.mutation.KeyID(); ok {
+		_spec.SetField(file.FieldKeyID, field.TypeString, value)
+		_node.KeyID = value
+	}
+	if value, ok := fc.mutation.WrappedKey(); ok {
+		_spec.SetField(file.FieldWrappedKey, field.TypeBytes, value)
+		_node.WrappedKey = value
+	}
+	if value, ok := fc.mutation.Sha256(); ok {
+		_spec.SetField(file.FieldSha256, field.TypeString, value)
+		_node.Sha256 = value
+	}
+	if value, ok := fc.mutation.Width(); ok {
+		_spec.SetField(file.FieldWidth, field.TypeInt, value)
+		_node.Width = value
+	}
+	if value, ok := fc.mutation.Height(); ok {
+		_spec.SetField(file.FieldHeight, field.TypeInt, value)
+		_node.Height = value
+	}
+	if value, ok := fc.mutation.DominantColor(); ok {
+		_spec.SetField(file.FieldDominantColor, field.TypeString, value)
+		_node.DominantColor = value
+	}
+	if value, ok := fc.mutation.SourceURL(); ok {
+		_spec.SetField(file.FieldSourceURL, field.TypeString, value)
+		_node.SourceURL = value
+	}
+	if value, ok := fc.mutation.SearchQuery(); ok {
+		_spec.SetField(file.FieldSearchQuery, field.TypeString, value)
+		_node.SearchQuery = value
+	}
+	if value, ok := fc.mutation.TagNames(); ok {
+		_spec.SetField(file.FieldTagNames, field.TypeString, value)
+		_node.TagNames = value
+	}
+	if value, ok := fc.mutation.CameraMake(); ok {
+		_spec.SetField(file.FieldCameraMake, field.TypeString, value)
+		_node.CameraMake = value
+	}
+	if value, ok := fc.mutation.CameraModel(); ok {
+		_spec.SetField(file.FieldCameraModel, field.TypeString, value)
+		_node.CameraModel = value
+	}
+	if value, ok := fc.mutation.TakenAt(); ok {
+		_spec.SetField(file.FieldTakenAt, field.TypeTime, value)
+		_node.TakenAt = &value
+	}
 	if value, ok := fc.mutation.CreatedAt(); ok {
 		_spec.SetField(file.FieldCreatedAt, field.TypeTime, value)
 		_node.CreatedAt = &value
@@ -319,6 +687,22 @@ func (fc *FileCreate) createSpec() (*File, *sqlgraph.CreateSpec) {
 		}
 		_spec.Edges = append(_spec.Edges, edge)
 	}
+	if nodes := fc.mutation.SharesIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   file.SharesTable,
+			Columns: []string{file.SharesColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(share.FieldID, field.TypeInt),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges = append(_spec.Edges, edge)
+	}
 	return _node, _spec
 }
 
@@ -437,141 +821,345 @@ func (u *FileUpsert) UpdateType() *FileUpsert {
 	return u
 }
 
-// SetCreatedAt sets the "created_at" field.
-func (u *FileUpsert) SetCreatedAt(v time.Time) *FileUpsert {
-	u.Set(file.FieldCreatedAt, v)
+// SetBurnAfterRead sets the "burn_after_read" field.
+func (u *FileUpsert) SetBurnAfterRead(v bool) *FileUpsert {
+	u.Set(file.FieldBurnAfterRead, v)
 	return u
 }
 
-// UpdateCreatedAt sets the "created_at" field to the value that was provided on create.
-func (u *FileUpsert) UpdateCreatedAt() *FileUpsert {
-	u.SetExcluded(file.FieldCreatedAt)
+// UpdateBurnAfterRead sets the "burn_after_read" field to the value that was provided on create.
+func (u *FileUpsert) UpdateBurnAfterRead() *FileUpsert {
+	u.SetExcluded(file.FieldBurnAfterRead)
 	return u
 }
 
-// ClearCreatedAt clears the value of the "created_at" field.
-func (u *FileUpsert) ClearCreatedAt() *FileUpsert {
-	u.SetNull(file.FieldCreatedAt)
+// SetPhash sets the "phash" field.
+func (u *FileUpsert) SetPhash(v int64) *FileUpsert {
+	u.Set(file.FieldPhash, v)
 	return u
 }
 
-// SetUpdatedAt sets the "updated_at" field.
-func (u *FileUpsert) SetUpdatedAt(v time.Time) *FileUpsert {
-	u.Set(file.FieldUpdatedAt, v)
+// UpdatePhash sets the "phash" field to the value that was provided on create.
+func (u *FileUpsert) UpdatePhash() *FileUpsert {
+	u.SetExcluded(file.FieldPhash)
 	return u
 }
 
-// UpdateUpdatedAt sets the "updated_at" field to the value that was provided on create.
-func (u *FileUpsert) UpdateUpdatedAt() *FileUpsert {
-	u.SetExcluded(file.FieldUpdatedAt)
+// AddPhash adds v to the "phash" field.
+func (u *FileUpsert) AddPhash(v int64) *FileUpsert {
+	u.Add(file.FieldPhash, v)
 	return u
 }
 
-// SetDeletedAt sets the "deleted_at" field.
-func (u *FileUpsert) SetDeletedAt(v time.Time) *FileUpsert {
-	u.Set(file.FieldDeletedAt, v)
+// ClearPhash clears the value of the "phash" field.
+func (u *FileUpsert) ClearPhash() *FileUpsert {
+	u.SetNull(file.FieldPhash)
 	return u
 }
 
-// UpdateDeletedAt sets the "deleted_at" field to the value that was provided on create.
-func (u *FileUpsert) UpdateDeletedAt() *FileUpsert {
-	u.SetExcluded(file.FieldDeletedAt)
+// SetKeyID sets the "key_id" field.
+func (u *FileUpsert) SetKeyID(v string) *FileUpsert {
+	u.Set(file.FieldKeyID, v)
 	return u
 }
 
-// ClearDeletedAt clears the value of the "deleted_at" field.
-func (u *FileUpsert) ClearDeletedAt() *FileUpsert {
-	u.SetNull(file.FieldDeletedAt)
+// UpdateKeyID sets the "key_id" field to the value that was provided on create.
+func (u *FileUpsert) UpdateKeyID() *FileUpsert {
+	u.SetExcluded(file.FieldKeyID)
 	return u
 }
 
-// UpdateNewValues updates the mutable fields using the new values that were set on create.
-// Using this option is equivalent to using:
-//
-//	client.File.Create().
-//		OnConflict(
-//			sql.ResolveWithNewValues(),
-//		).
-//		Exec(ctx)
-func (u *FileUpsertOne) UpdateNewValues() *FileUpsertOne {
-	u.create.conflict = append(u.create.conflict, sql.ResolveWithNewValues())
+// SetWrappedKey sets the "wrapped_key" field.
+func (u *FileUpsert) SetWrappedKey(v []byte) *FileUpsert {
+	u.Set(file.FieldWrappedKey, v)
 	return u
 }
 
-// Ignore sets each column to itself in case of conflict.
-// Using this option is equivalent to using:
-//
-//	client.File.Create().
-//	    OnConflict(sql.ResolveWithIgnore()).
-//	    Exec(ctx)
-func (u *FileUpsertOne) Ignore() *FileUpsertOne {
-	u.create.conflict = append(u.create.conflict, sql.ResolveWithIgnore())
+// UpdateWrappedKey sets the "wrapped_key" field to the value that was provided on create.
+func (u *FileUpsert) UpdateWrappedKey() *FileUpsert {
+	u.SetExcluded(file.FieldWrappedKey)
 	return u
 }
 
-// DoNothing configures the conflict_action to `DO NOTHING`.
-// Supported only by SQLite and PostgreSQL.
-func (u *FileUpsertOne) DoNothing() *FileUpsertOne {
-	u.create.conflict = append(u.create.conflict, sql.DoNothing())
+// ClearWrappedKey clears the value of the "wrapped_key" field.
+func (u *FileUpsert) ClearWrappedKey() *FileUpsert {
+	u.SetNull(file.FieldWrappedKey)
 	return u
 }
 
-// Update allows overriding fields `UPDATE` values. See the FileCreate.OnConflict
-// documentation for more info.
-func (u *FileUpsertOne) Update(set func(*FileUpsert)) *FileUpsertOne {
-	u.create.conflict = append(u.create.conflict, sql.ResolveWith(func(update *sql.UpdateSet) {
-		set(&FileUpsert{UpdateSet: update})
-	}))
+// SetSha256 sets the "sha256" field.
+func (u *FileUpsert) SetSha256(v string) *FileUpsert {
+	u.Set(file.FieldSha256, v)
 	return u
 }
 
-// SetName sets the "name" field.
-func (u *FileUpsertOne) SetName(v string) *FileUpsertOne {
-	return u.Update(func(s *FileUpsert) {
-		s.SetName(v)
-	})
+// UpdateSha256 sets the "sha256" field to the value that was provided on create.
+func (u *FileUpsert) UpdateSha256() *FileUpsert {
+	u.SetExcluded(file.FieldSha256)
+	return u
 }
 
-// UpdateName sets the "name" field to the value that was provided on create.
-func (u *FileUpsertOne) UpdateName() *FileUpsertOne {
-	return u.Update(func(s *FileUpsert) {
-		s.UpdateName()
-	})
+// SetWidth sets the "width" field.
+func (u *FileUpsert) SetWidth(v int) *FileUpsert {
+	u.Set(file.FieldWidth, v)
+	return u
 }
 
-// SetUserID sets the "user_id" field.
-func (u *FileUpsertOne) SetUserID(v int) *FileUpsertOne {
-	return u.Update(func(s *FileUpsert) {
-		s.SetUserID(v)
-	})
+// UpdateWidth sets the "width" field to the value that was provided on create.
+func (u *FileUpsert) UpdateWidth() *FileUpsert {
+	u.SetExcluded(file.FieldWidth)
+	return u
 }
 
-// UpdateUserID sets the "user_id" field to the value that was provided on create.
-func (u *FileUpsertOne) UpdateUserID() *FileUpsertOne {
-	return u.Update(func(s *FileUpsert) {
-		s.UpdateUserID()
-	})
+// AddWidth adds v to the "width" field.
+func (u *FileUpsert) AddWidth(v int) *FileUpsert {
+	u.Add(file.FieldWidth, v)
+	return u
 }
 
-// SetUUID sets the "uuid" field.
-func (u *FileUpsertOne) SetUUID(v string) *FileUpsertOne {
-	return u.Update(func(s *FileUpsert) {
-		s.SetUUID(v)
-	})
+// SetHeight sets the "height" field.
+func (u *FileUpsert) SetHeight(v int) *FileUpsert {
+	u.Set(file.FieldHeight, v)
+	return u
 }
 
-// UpdateUUID sets the "uuid" field to the value that was provided on create.
-func (u *FileUpsertOne) UpdateUUID() *FileUpsertOne {
-	return u.Update(func(s *FileUpsert) {
-		s.UpdateUUID()
-	})
+// UpdateHeight sets the "height" field to the value that was provided on create.
+func (u *FileUpsert) UpdateHeight() *FileUpsert {
+	u.SetExcluded(file.FieldHeight)
+	return u
 }
 
-// SetSize sets the "size" field.
-func (u *FileUpsertOne) SetSize(v int) *FileUpsertOne {
-	return u.Update(func(s *FileUpsert) {
-		s.SetSize(v)
-	})
+// AddHeight adds v to the "height" field.
+func (u *FileUpsert) AddHeight(v int) *FileUpsert {
+	u.Add(file.FieldHeight, v)
+	return u
+}
+
+// SetDominantColor sets the "dominant_color" field.
+func (u *FileUpsert) SetDominantColor(v string) *FileUpsert {
+	u.Set(file.FieldDominantColor, v)
+	return u
+}
+
+// UpdateDominantColor sets the "dominant_color" field to the value that was provided on create.
+func (u *FileUpsert) UpdateDominantColor() *FileUpsert {
+	u.SetExcluded(file.FieldDominantColor)
+	return u
+}
+
+// SetSourceURL sets the "source_url" field.
+func (u *FileUpsert) SetSourceURL(v string) *FileUpsert {
+	u.Set(file.FieldSourceURL, v)
+	return u
+}
+
+// UpdateSourceURL sets the "source_url" field to the value that was provided on create.
+func (u *FileUpsert) UpdateSourceURL() *FileUpsert {
+	u.SetExcluded(file.FieldSourceURL)
+	return u
+}
+
+// SetSearchQuery sets the "search_query" field.
+func (u *FileUpsert) SetSearchQuery(v string) *FileUpsert {
+	u.Set(file.FieldSearchQuery, v)
+	return u
+}
+
+// UpdateSearchQuery sets the "search_query" field to the value that was provided on create.
+func (u *FileUpsert) UpdateSearchQuery() *FileUpsert {
+	u.SetExcluded(file.FieldSearchQuery)
+	return u
+}
+
+// SetTagNames sets the "tag_names" field.
+func (u *FileUpsert) SetTagNames(v string) *FileUpsert {
+	u.Set(file.FieldTagNames, v)
+	return u
+}
+
+// UpdateTagNames sets the "tag_names" field to the value that was provided on create.
+func (u *FileUpsert) UpdateTagNames() *FileUpsert {
+	u.SetExcluded(file.FieldTagNames)
+	return u
+}
+
+// SetCameraMake sets the "camera_make" field.
+func (u *FileUpsert) SetCameraMake(v string) *FileUpsert {
+	u.Set(file.FieldCameraMake, v)
+	return u
+}
+
+// UpdateCameraMake sets the "camera_make" field to the value that was provided on create.
+func (u *FileUpsert) UpdateCameraMake() *FileUpsert {
+	u.SetExcluded(file.FieldCameraMake)
+	return u
+}
+
+// SetCameraModel sets the "camera_model" field.
+func (u *FileUpsert) SetCameraModel(v string) *FileUpsert {
+	u.Set(file.FieldCameraModel, v)
+	return u
+}
+
+// UpdateCameraModel sets the "camera_model" field to the value that was provided on create.
+func (u *FileUpsert) UpdateCameraModel() *FileUpsert {
+	u.SetExcluded(file.FieldCameraModel)
+	return u
+}
+
+// SetTakenAt sets the "taken_at" field.
+func (u *FileUpsert) SetTakenAt(v time.Time) *FileUpsert {
+	u.Set(file.FieldTakenAt, v)
+	return u
+}
+
+// UpdateTakenAt sets the "taken_at" field to the value that was provided on create.
+func (u *FileUpsert) UpdateTakenAt() *FileUpsert {
+	u.SetExcluded(file.FieldTakenAt)
+	return u
+}
+
+// ClearTakenAt clears the value of the "taken_at" field.
+func (u *FileUpsert) ClearTakenAt() *FileUpsert {
+	u.SetNull(file.FieldTakenAt)
+	return u
+}
+
+// SetCreatedAt sets the "created_at" field.
+func (u *FileUpsert) SetCreatedAt(v time.Time) *FileUpsert {
+	u.Set(file.FieldCreatedAt, v)
+	return u
+}
+
+// UpdateCreatedAt sets the "created_at" field to the value that was provided on create.
+func (u *FileUpsert) UpdateCreatedAt() *FileUpsert {
+	u.SetExcluded(file.FieldCreatedAt)
+	return u
+}
+
+// ClearCreatedAt clears the value of the "created_at" field.
+func (u *FileUpsert) ClearCreatedAt() *FileUpsert {
+	u.SetNull(file.FieldCreatedAt)
+	return u
+}
+
+// SetUpdatedAt sets the "updated_at" field.
+func (u *FileUpsert) SetUpdatedAt(v time.Time) *FileUpsert {
+	u.Set(file.FieldUpdatedAt, v)
+	return u
+}
+
+// UpdateUpdatedAt sets the "updated_at" field to the value that was provided on create.
+func (u *FileUpsert) UpdateUpdatedAt() *FileUpsert {
+	u.SetExcluded(file.FieldUpdatedAt)
+	return u
+}
+
+// SetDeletedAt sets the "deleted_at" field.
+func (u *FileUpsert) SetDeletedAt(v time.Time) *FileUpsert {
+	u.Set(file.FieldDeletedAt, v)
+	return u
+}
+
+// UpdateDeletedAt sets the "deleted_at" field to the value that was provided on create.
+func (u *FileUpsert) UpdateDeletedAt() *FileUpsert {
+	u.SetExcluded(file.FieldDeletedAt)
+	return u
+}
+
+// ClearDeletedAt clears the value of the "deleted_at" field.
+func (u *FileUpsert) ClearDeletedAt() *FileUpsert {
+	u.SetNull(file.FieldDeletedAt)
+	return u
+}
+
+// UpdateNewValues updates the mutable fields using the new values that were set on create.
+// Using this option is equivalent to using:
+//
+//	client.File.Create().
+//		OnConflict(
+//			sql.ResolveWithNewValues(),
+//		).
+//		Exec(ctx)
+func (u *FileUpsertOne) UpdateNewValues() *FileUpsertOne {
+	u.create.conflict = append(u.create.conflict, sql.ResolveWithNewValues())
+	return u
+}
+
+// Ignore sets each column to itself in case of conflict.
+// Using this option is equivalent to using:
+//
+//	client.File.Create().
+//	    OnConflict(sql.ResolveWithIgnore()).
+//	    Exec(ctx)
+func (u *FileUpsertOne) Ignore() *FileUpsertOne {
+	u.create.conflict = append(u.create.conflict, sql.ResolveWithIgnore())
+	return u
+}
+
+// DoNothing configures the conflict_action to `DO NOTHING`.
+// Supported only by SQLite and PostgreSQL.
+func (u *FileUpsertOne) DoNothing() *FileUpsertOne {
+	u.create.conflict = append(u.create.conflict, sql.DoNothing())
+	return u
+}
+
+// Update allows overriding fields `UPDATE` values. See the FileCreate.OnConflict
+// documentation for more info.
+func (u *FileUpsertOne) Update(set func(*FileUpsert)) *FileUpsertOne {
+	u.create.conflict = append(u.create.conflict, sql.ResolveWith(func(update *sql.UpdateSet) {
+		set(&FileUpsert{UpdateSet: update})
+	}))
+	return u
+}
+
+// SetName sets the "name" field.
+func (u *FileUpsertOne) SetName(v string) *FileUpsertOne {
+	return u.Update(func(s *FileUpsert) {
+		s.SetName(v)
+	})
+}
+
+// UpdateName sets the "name" field to the value that was provided on create.
+func (u *FileUpsertOne) UpdateName() *FileUpsertOne {
+	return u.Update(func(s *FileUpsert) {
+		s.UpdateName()
+	})
+}
+
+// SetUserID sets the "user_id" field.
+func (u *FileUpsertOne) SetUserID(v int) *FileUpsertOne {
+	return u.Update(func(s *FileUpsert) {
+		s.SetUserID(v)
+	})
+}
+
+// UpdateUserID sets the "user_id" field to the value that was provided on create.
+func (u *FileUpsertOne) UpdateUserID() *FileUpsertOne {
+	return u.Update(func(s *FileUpsert) {
+		s.UpdateUserID()
+	})
+}
+
+// SetUUID sets the "uuid" field.
+func (u *FileUpsertOne) SetUUID(v string) *FileUpsertOne {
+	return u.Update(func(s *FileUpsert) {
+		s.SetUUID(v)
+	})
+}
+
+// UpdateUUID sets the "uuid" field to the value that was provided on create.
+func (u *FileUpsertOne) UpdateUUID() *FileUpsertOne {
+	return u.Update(func(s *FileUpsert) {
+		s.UpdateUUID()
+	})
+}
+
+// SetSize sets the "size" field.
+func (u *FileUpsertOne) SetSize(v int) *FileUpsertOne {
+	return u.Update(func(s *FileUpsert) {
+		s.SetSize(v)
+	})
 }
 
 // AddSize adds v to the "size" field.
@@ -602,6 +1190,244 @@ func (u *FileUpsertOne) UpdateType() *FileUpsertOne {
 	})
 }
 
+// SetBurnAfterRead sets the "burn_after_read" field.
+func (u *FileUpsertOne) SetBurnAfterRead(v bool) *FileUpsertOne {
+	return u.Update(func(s *FileUpsert) {
+		s.SetBurnAfterRead(v)
+	})
+}
+
+// UpdateBurnAfterRead sets the "burn_after_read" field to the value that was provided on create.
+func (u *FileUpsertOne) UpdateBurnAfterRead() *FileUpsertOne {
+	return u.Update(func(s *FileUpsert) {
+		s.UpdateBurnAfterRead()
+	})
+}
+
+// SetPhash sets the "phash" field.
+func (u *FileUpsertOne) SetPhash(v int64) *FileUpsertOne {
+	return u.Update(func(s *FileUpsert) {
+		s.SetPhash(v)
+	})
+}
+
+// AddPhash adds v to the "phash" field.
+func (u *FileUpsertOne) AddPhash(v int64) *FileUpsertOne {
+	return u.Update(func(s *FileUpsert) {
+		s.AddPhash(v)
+	})
+}
+
+// UpdatePhash sets the "phash" field to the value that was provided on create.
+func (u *FileUpsertOne) UpdatePhash() *FileUpsertOne {
+	return u.Update(func(s *FileUpsert) {
+		s.UpdatePhash()
+	})
+}
+
+// ClearPhash clears the value of the "phash" field.
+func (u *FileUpsertOne) ClearPhash() *FileUpsertOne {
+	return u.Update(func(s *FileUpsert) {
+		s.ClearPhash()
+	})
+}
+
+// SetKeyID sets the "key_id" field.
+func (u *FileUpsertOne) SetKeyID(v string) *FileUpsertOne {
+	return u.Update(func(s *FileUpsert) {
+		s.SetKeyID(v)
+	})
+}
+
+// UpdateKeyID sets the "key_id" field to the value that was provided on create.
+func (u *FileUpsertOne) UpdateKeyID() *FileUpsertOne {
+	return u.Update(func(s *FileUpsert) {
+		s.UpdateKeyID()
+	})
+}
+
+// SetWrappedKey sets the "wrapped_key" field.
+func (u *FileUpsertOne) SetWrappedKey(v []byte) *FileUpsertOne {
+	return u.Update(func(s *FileUpsert) {
+		s.SetWrappedKey(v)
+	})
+}
+
+// UpdateWrappedKey sets the "wrapped_key" field to the value that was provided on create.
+func (u *FileUpsertOne) UpdateWrappedKey() *FileUpsertOne {
+	return u.Update(func(s *FileUpsert) {
+		s.UpdateWrappedKey()
+	})
+}
+
+// ClearWrappedKey clears the value of the "wrapped_key" field.
+func (u *FileUpsertOne) ClearWrappedKey() *FileUpsertOne {
+	return u.Update(func(s *FileUpsert) {
+		s.ClearWrappedKey()
+	})
+}
+
+// SetSha256 sets the "sha256" field.
+func (u *FileUpsertOne) SetSha256(v string) *FileUpsertOne {
+	return u.Update(func(s *FileUpsert) {
+		s.SetSha256(v)
+	})
+}
+
+// UpdateSha256 sets the "sha256" field to the value that was provided on create.
+func (u *FileUpsertOne) UpdateSha256() *FileUpsertOne {
+	return u.Update(func(s *FileUpsert) {
+		s.UpdateSha256()
+	})
+}
+
+// SetWidth sets the "width" field.
+func (u *FileUpsertOne) SetWidth(v int) *FileUpsertOne {
+	return u.Update(func(s *FileUpsert) {
+		s.SetWidth(v)
+	})
+}
+
+// AddWidth adds v to the "width" field.
+func (u *FileUpsertOne) AddWidth(v int) *FileUpsertOne {
+	return u.Update(func(s *FileUpsert) {
+		s.AddWidth(v)
+	})
+}
+
+// UpdateWidth sets the "width" field to the value that was provided on create.
+func (u *FileUpsertOne) UpdateWidth() *FileUpsertOne {
+	return u.Update(func(s *FileUpsert) {
+		s.UpdateWidth()
+	})
+}
+
+// SetHeight sets the "height" field.
+func (u *FileUpsertOne) SetHeight(v int) *FileUpsertOne {
+	return u.Update(func(s *FileUpsert) {
+		s.SetHeight(v)
+	})
+}
+
+// AddHeight adds v to the "height" field.
+func (u *FileUpsertOne) AddHeight(v int) *FileUpsertOne {
+	return u.Update(func(s *FileUpsert) {
+		s.AddHeight(v)
+	})
+}
+
+// UpdateHeight sets the "height" field to the value that was provided on create.
+func (u *FileUpsertOne) UpdateHeight() *FileUpsertOne {
+	return u.Update(func(s *FileUpsert) {
+		s.UpdateHeight()
+	})
+}
+
+// SetDominantColor sets the "dominant_color" field.
+func (u *FileUpsertOne) SetDominantColor(v string) *FileUpsertOne {
+	return u.Update(func(s *FileUpsert) {
+		s.SetDominantColor(v)
+	})
+}
+
+// UpdateDominantColor sets the "dominant_color" field to the value that was provided on create.
+func (u *FileUpsertOne) UpdateDominantColor() *FileUpsertOne {
+	return u.Update(func(s *FileUpsert) {
+		s.UpdateDominantColor()
+	})
+}
+
+// SetSourceURL sets the "source_url" field.
+func (u *FileUpsertOne) SetSourceURL(v string) *FileUpsertOne {
+	return u.Update(func(s *FileUpsert) {
+		s.SetSourceURL(v)
+	})
+}
+
+// UpdateSourceURL sets the "source_url" field to the value that was provided on create.
+func (u *FileUpsertOne) UpdateSourceURL() *FileUpsertOne {
+	return u.Update(func(s *FileUpsert) {
+		s.UpdateSourceURL()
+	})
+}
+
+// SetSearchQuery sets the "search_query" field.
+func (u *FileUpsertOne) SetSearchQuery(v string) *FileUpsertOne {
+	return u.Update(func(s *FileUpsert) {
+		s.SetSearchQuery(v)
+	})
+}
+
+// UpdateSearchQuery sets the "search_query" field to the value that was provided on create.
+func (u *FileUpsertOne) UpdateSearchQuery() *FileUpsertOne {
+	return u.Update(func(s *FileUpsert) {
+		s.UpdateSearchQuery()
+	})
+}
+
+// SetTagNames sets the "tag_names" field.
+func (u *FileUpsertOne) SetTagNames(v string) *FileUpsertOne {
+	return u.Update(func(s *FileUpsert) {
+		s.SetTagNames(v)
+	})
+}
+
+// UpdateTagNames sets the "tag_names" field to the value that was provided on create.
+func (u *FileUpsertOne) UpdateTagNames() *FileUpsertOne {
+	return u.Update(func(s *FileUpsert) {
+		s.UpdateTagNames()
+	})
+}
+
+// SetCameraMake sets the "camera_make" field.
+func (u *FileUpsertOne) SetCameraMake(v string) *FileUpsertOne {
+	return u.Update(func(s *FileUpsert) {
+		s.SetCameraMake(v)
+	})
+}
+
+// UpdateCameraMake sets the "camera_make" field to the value that was provided on create.
+func (u *FileUpsertOne) UpdateCameraMake() *FileUpsertOne {
+	return u.Update(func(s *FileUpsert) {
+		s.UpdateCameraMake()
+	})
+}
+
+// SetCameraModel sets the "camera_model" field.
+func (u *FileUpsertOne) SetCameraModel(v string) *FileUpsertOne {
+	return u.Update(func(s *FileUpsert) {
+		s.SetCameraModel(v)
+	})
+}
+
+// UpdateCameraModel sets the "camera_model" field to the value that was provided on create.
+func (u *FileUpsertOne) UpdateCameraModel() *FileUpsertOne {
+	return u.Update(func(s *FileUpsert) {
+		s.UpdateCameraModel()
+	})
+}
+
+// SetTakenAt sets the "taken_at" field.
+func (u *FileUpsertOne) SetTakenAt(v time.Time) *FileUpsertOne {
+	return u.Update(func(s *FileUpsert) {
+		s.SetTakenAt(v)
+	})
+}
+
+// UpdateTakenAt sets the "taken_at" field to the value that was provided on create.
+func (u *FileUpsertOne) UpdateTakenAt() *FileUpsertOne {
+	return u.Update(func(s *FileUpsert) {
+		s.UpdateTakenAt()
+	})
+}
+
+// ClearTakenAt clears the value of the "taken_at" field.
+func (u *FileUpsertOne) ClearTakenAt() *FileUpsertOne {
+	return u.Update(func(s *FileUpsert) {
+		s.ClearTakenAt()
+	})
+}
+
 // SetCreatedAt sets the "created_at" field.
 func (u *FileUpsertOne) SetCreatedAt(v time.Time) *FileUpsertOne {
 	return u.Update(func(s *FileUpsert) {
@@ -939,6 +1765,244 @@ func (u *FileUpsertBulk) UpdateType() *FileUpsertBulk {
 	})
 }
 
+// SetBurnAfterRead sets the "burn_after_read" field.
+func (u *FileUpsertBulk) SetBurnAfterRead(v bool) *FileUpsertBulk {
+	return u.Update(func(s *FileUpsert) {
+		s.SetBurnAfterRead(v)
+	})
+}
+
+// UpdateBurnAfterRead sets the "burn_after_read" field to the value that was provided on create.
+func (u *FileUpsertBulk) UpdateBurnAfterRead() *FileUpsertBulk {
+	return u.Update(func(s *FileUpsert) {
+		s.UpdateBurnAfterRead()
+	})
+}
+
+// SetPhash sets the "phash" field.
+func (u *FileUpsertBulk) SetPhash(v int64) *FileUpsertBulk {
+	return u.Update(func(s *FileUpsert) {
+		s.SetPhash(v)
+	})
+}
+
+// AddPhash adds v to the "phash" field.
+func (u *FileUpsertBulk) AddPhash(v int64) *FileUpsertBulk {
+	return u.Update(func(s *FileUpsert) {
+		s.AddPhash(v)
+	})
+}
+
+// UpdatePhash sets the "phash" field to the value that was provided on create.
+func (u *FileUpsertBulk) UpdatePhash() *FileUpsertBulk {
+	return u.Update(func(s *FileUpsert) {
+		s.UpdatePhash()
+	})
+}
+
+// ClearPhash clears the value of the "phash" field.
+func (u *FileUpsertBulk) ClearPhash() *FileUpsertBulk {
+	return u.Update(func(s *FileUpsert) {
+		s.ClearPhash()
+	})
+}
+
+// SetKeyID sets the "key_id" field.
+func (u *FileUpsertBulk) SetKeyID(v string) *FileUpsertBulk {
+	return u.Update(func(s *FileUpsert) {
+		s.SetKeyID(v)
+	})
+}
+
+// UpdateKeyID sets the "key_id" field to the value that was provided on create.
+func (u *FileUpsertBulk) UpdateKeyID() *FileUpsertBulk {
+	return u.Update(func(s *FileUpsert) {
+		s.UpdateKeyID()
+	})
+}
+
+// SetWrappedKey sets the "wrapped_key" field.
+func (u *FileUpsertBulk) SetWrappedKey(v []byte) *FileUpsertBulk {
+	return u.Update(func(s *FileUpsert) {
+		s.SetWrappedKey(v)
+	})
+}
+
+// UpdateWrappedKey sets the "wrapped_key" field to the value that was provided on create.
+func (u *FileUpsertBulk) UpdateWrappedKey() *FileUpsertBulk {
+	return u.Update(func(s *FileUpsert) {
+		s.UpdateWrappedKey()
+	})
+}
+
+// ClearWrappedKey clears the value of the "wrapped_key" field.
+func (u *FileUpsertBulk) ClearWrappedKey() *FileUpsertBulk {
+	return u.Update(func(s *FileUpsert) {
+		s.ClearWrappedKey()
+	})
+}
+
+// SetSha256 sets the "sha256" field.
+func (u *FileUpsertBulk) SetSha256(v string) *FileUpsertBulk {
+	return u.Update(func(s *FileUpsert) {
+		s.SetSha256(v)
+	})
+}
+
+// UpdateSha256 sets the "sha256" field to the value that was provided on create.
+func (u *FileUpsertBulk) UpdateSha256() *FileUpsertBulk {
+	return u.Update(func(s *FileUpsert) {
+		s.UpdateSha256()
+	})
+}
+
+// SetWidth sets the "width" field.
+func (u *FileUpsertBulk) SetWidth(v int) *FileUpsertBulk {
+	return u.Update(func(s *FileUpsert) {
+		s.SetWidth(v)
+	})
+}
+
+// AddWidth adds v to the "width" field.
+func (u *FileUpsertBulk) AddWidth(v int) *FileUpsertBulk {
+	return u.Update(func(s *FileUpsert) {
+		s.AddWidth(v)
+	})
+}
+
+// UpdateWidth sets the "width" field to the value that was provided on create.
+func (u *FileUpsertBulk) UpdateWidth() *FileUpsertBulk {
+	return u.Update(func(s *FileUpsert) {
+		s.UpdateWidth()
+	})
+}
+
+// SetHeight sets the "height" field.
+func (u *FileUpsertBulk) SetHeight(v int) *FileUpsertBulk {
+	return u.Update(func(s *FileUpsert) {
+		s.SetHeight(v)
+	})
+}
+
+// AddHeight adds v to the "height" field.
+func (u *FileUpsertBulk) AddHeight(v int) *FileUpsertBulk {
+	return u.Update(func(s *FileUpsert) {
+		s.AddHeight(v)
+	})
+}
+
+// UpdateHeight sets the "height" field to the value that was provided on create.
+func (u *FileUpsertBulk) UpdateHeight() *FileUpsertBulk {
+	return u.Update(func(s *FileUpsert) {
+		s.UpdateHeight()
+	})
+}
+
+// SetDominantColor sets the "dominant_color" field.
+func (u *FileUpsertBulk) SetDominantColor(v string) *FileUpsertBulk {
+	return u.Update(func(s *FileUpsert) {
+		s.SetDominantColor(v)
+	})
+}
+
+// UpdateDominantColor sets the "dominant_color" field to the value that was provided on create.
+func (u *FileUpsertBulk) UpdateDominantColor() *FileUpsertBulk {
+	return u.Update(func(s *FileUpsert) {
+		s.UpdateDominantColor()
+	})
+}
+
+// SetSourceURL sets the "source_url" field.
+func (u *FileUpsertBulk) SetSourceURL(v string) *FileUpsertBulk {
+	return u.Update(func(s *FileUpsert) {
+		s.SetSourceURL(v)
+	})
+}
+
+// UpdateSourceURL sets the "source_url" field to the value that was provided on create.
+func (u *FileUpsertBulk) UpdateSourceURL() *FileUpsertBulk {
+	return u.Update(func(s *FileUpsert) {
+		s.UpdateSourceURL()
+	})
+}
+
+// SetSearchQuery sets the "search_query" field.
+func (u *FileUpsertBulk) SetSearchQuery(v string) *FileUpsertBulk {
+	return u.Update(func(s *FileUpsert) {
+		s.SetSearchQuery(v)
+	})
+}
+
+// UpdateSearchQuery sets the "search_query" field to the value that was provided on create.
+func (u *FileUpsertBulk) UpdateSearchQuery() *FileUpsertBulk {
+	return u.Update(func(s *FileUpsert) {
+		s.UpdateSearchQuery()
+	})
+}
+
+// SetTagNames sets the "tag_names" field.
+func (u *FileUpsertBulk) SetTagNames(v string) *FileUpsertBulk {
+	return u.Update(func(s *FileUpsert) {
+		s.SetTagNames(v)
+	})
+}
+
+// UpdateTagNames sets the "tag_names" field to the value that was provided on create.
+func (u *FileUpsertBulk) UpdateTagNames() *FileUpsertBulk {
+	return u.Update(func(s *FileUpsert) {
+		s.UpdateTagNames()
+	})
+}
+
+// SetCameraMake sets the "camera_make" field.
+func (u *FileUpsertBulk) SetCameraMake(v string) *FileUpsertBulk {
+	return u.Update(func(s *FileUpsert) {
+		s.SetCameraMake(v)
+	})
+}
+
+// UpdateCameraMake sets the "camera_make" field to the value that was provided on create.
+func (u *FileUpsertBulk) UpdateCameraMake() *FileUpsertBulk {
+	return u.Update(func(s *FileUpsert) {
+		s.UpdateCameraMake()
+	})
+}
+
+// SetCameraModel sets the "camera_model" field.
+func (u *FileUpsertBulk) SetCameraModel(v string) *FileUpsertBulk {
+	return u.Update(func(s *FileUpsert) {
+		s.SetCameraModel(v)
+	})
+}
+
+// UpdateCameraModel sets the "camera_model" field to the value that was provided on create.
+func (u *FileUpsertBulk) UpdateCameraModel() *FileUpsertBulk {
+	return u.Update(func(s *FileUpsert) {
+		s.UpdateCameraModel()
+	})
+}
+
+// SetTakenAt sets the "taken_at" field.
+func (u *FileUpsertBulk) SetTakenAt(v time.Time) *FileUpsertBulk {
+	return u.Update(func(s *FileUpsert) {
+		s.SetTakenAt(v)
+	})
+}
+
+// UpdateTakenAt sets the "taken_at" field to the value that was provided on create.
+func (u *FileUpsertBulk) UpdateTakenAt() *FileUpsertBulk {
+	return u.Update(func(s *FileUpsert) {
+		s.UpdateTakenAt()
+	})
+}
+
+// ClearTakenAt clears the value of the "taken_at" field.
+func (u *FileUpsertBulk) ClearTakenAt() *FileUpsertBulk {
+	return u.Update(func(s *FileUpsert) {
+		s.ClearTakenAt()
+	})
+}
+
 // SetCreatedAt sets the "created_at" field.
 func (u *FileUpsertBulk) SetCreatedAt(v time.Time) *FileUpsertBulk {
 	return u.Update(func(s *FileUpsert) {
@@ -15,6 +15,7 @@ import (
 	"github.com/lebleuciel/maani/pkg/database/ent/file"
 	"github.com/lebleuciel/maani/pkg/database/ent/filetype"
 	"github.com/lebleuciel/maani/pkg/database/ent/predicate"
+	"github.com/lebleuciel/maani/pkg/database/ent/share"
 	"github.com/lebleuciel/maani/pkg/database/ent/tag"
 	"github.com/lebleuciel/maani/pkg/database/ent/user"
 )
@@ -29,6 +30,7 @@ type FileQuery struct {
 	withUser     *UserQuery
 	withFiletype *FiletypeQuery
 	withTags     *TagQuery
+	withShares   *ShareQuery
 	modifiers    []func(*sql.Selector)
 	// intermediate query (i.e. traversal path).
 	sql  *sql.Selector
@@ -132,6 +134,28 @@ func (fq *FileQuery) QueryTags() *TagQuery {
 	return query
 }
 
+// QueryShares chains the current query on the "shares" edge.
+func (fq *FileQuery) QueryShares() *ShareQuery {
+	query := (&ShareClient{config: fq.config}).Query()
+	query.path = func(ctx context.Context) (fromU *sql.Selector, err error) {
+		if err := fq.prepareQuery(ctx); err != nil {
+			return nil, err
+		}
+		selector := fq.sqlQuery(ctx)
+		if err := selector.Err(); err != nil {
+			return nil, err
+		}
+		step := sqlgraph.NewStep(
+			sqlgraph.From(file.Table, file.FieldID, selector),
+			sqlgraph.To(share.Table, share.FieldID),
+			sqlgraph.Edge(sqlgraph.O2M, false, file.SharesTable, file.SharesColumn),
+		)
+		fromU = sqlgraph.SetNeighbors(fq.driver.Dialect(), step)
+		return fromU, nil
+	}
+	return query
+}
+
 // First returns the first File entity from the query.
 // Returns a *NotFoundError when no File was found.
 func (fq *FileQuery) First(ctx context.Context) (*File, error) {
@@ -327,6 +351,7 @@ func (fq *FileQuery) Clone() *FileQuery {
 		withUser:     fq.withUser.Clone(),
 		withFiletype: fq.withFiletype.Clone(),
 		withTags:     fq.withTags.Clone(),
+		withShares:   fq.withShares.Clone(),
 		// clone intermediate query.
 		sql:  fq.sql.Clone(),
 		path: fq.path,
@@ -366,6 +391,17 @@ func (fq *FileQuery) WithTags(opts ...func(*TagQuery)) *FileQuery {
 	return fq
 }
 
+// WithShares tells the query-builder to eager-load the nodes that are connected to
+// the "shares" edge. The optional arguments are used to configure the query builder of the edge.
+func (fq *FileQuery) WithShares(opts ...func(*ShareQuery)) *FileQuery {
+	query := (&ShareClient{config: fq.config}).Query()
+	for _, opt := range opts {
+		opt(query)
+	}
+	fq.withShares = query
+	return fq
+}
+
 // GroupBy is used to group vertices by one or more fields/columns.
 // It is often used with aggregate functions, like: count, max, mean, min, sum.
 //
@@ -444,10 +480,11 @@ func (fq *FileQuery) sqlAll(ctx context.Context, hooks ...queryHook) ([]*File, e
 	var (
 		nodes       = []*File{}
 		_spec       = fq.querySpec()
-		loadedTypes = [3]bool{
+		loadedTypes = [4]bool{
 			fq.withUser != nil,
 			fq.withFiletype != nil,
 			fq.withTags != nil,
+			fq.withShares != nil,
 		}
 	)
 	_spec.ScanValues = func(columns []string) ([]any, error) {
@@ -490,6 +527,13 @@ func (fq *FileQuery) sqlAll(ctx context.Context, hooks ...queryHook) ([]*File, e
 			return nil, err
 		}
 	}
+	if query := fq.withShares; query != nil {
+		if err := fq.loadShares(ctx, query, nodes,
+			func(n *File) { n.Edges.Shares = []*Share{} },
+			func(n *File, e *Share) { n.Edges.Shares = append(n.Edges.Shares, e) }); err != nil {
+			return nil, err
+		}
+	}
 	return nodes, nil
 }
 
@@ -612,6 +656,36 @@ func (fq *FileQuery) loadTags(ctx context.Context, query *TagQuery, nodes []*Fil
 	}
 	return nil
 }
+func (fq *FileQuery) loadShares(ctx context.Context, query *ShareQuery, nodes []*File, init func(*File), assign func(*File, *Share)) error {
+	fks := make([]driver.Value, 0, len(nodes))
+	nodeids := make(map[int]*File)
+	for i := range nodes {
+		fks = append(fks, nodes[i].ID)
+		nodeids[nodes[i].ID] = nodes[i]
+		if init != nil {
+			init(nodes[i])
+		}
+	}
+	if len(query.ctx.Fields) > 0 {
+		query.ctx.AppendFieldOnce(share.FieldFileID)
+	}
+	query.Where(predicate.Share(func(s *sql.Selector) {
+		s.Where(sql.InValues(s.C(file.SharesColumn), fks...))
+	}))
+	neighbors, err := query.All(ctx)
+	if err != nil {
+		return err
+	}
+	for _, n := range neighbors {
+		fk := n.FileID
+		node, ok := nodeids[fk]
+		if !ok {
+			return fmt.Errorf(`unexpected referenced foreign-key "file_id" returned %v for node %v`, fk, n.ID)
+		}
+		assign(node, n)
+	}
+	return nil
+}
 
 func (fq *FileQuery) sqlCount(ctx context.Context) (int, error) {
 	_spec := fq.querySpec()
@@ -732,6 +806,12 @@ func (fq *FileQuery) ForShare(opts ...sql.LockOption) *FileQuery {
 	return fq
 }
 
+// Modify adds a query modifier for attaching custom logic to queries.
+func (fq *FileQuery) Modify(modifiers ...func(s *sql.Selector)) *FileSelect {
+	fq.modifiers = append(fq.modifiers, modifiers...)
+	return fq.Select()
+}
+
 // FileGroupBy is the group-by builder for File entities.
 type FileGroupBy struct {
 	selector
@@ -821,3 +901,9 @@ func (fs *FileSelect) sqlScan(ctx context.Context, root *FileQuery, v any) error
 	defer rows.Close()
 	return sql.ScanSlice(rows, v)
 }
+
+// Modify adds a query modifier for attaching custom logic to queries.
+func (fs *FileSelect) Modify(modifiers ...func(s *sql.Selector)) *FileSelect {
+	fs.modifiers = append(fs.modifiers, modifiers...)
+	return fs
+}
@@ -14,6 +14,7 @@ import (
 	"github.com/lebleuciel/maani/pkg/database/ent/file"
 	"github.com/lebleuciel/maani/pkg/database/ent/filetype"
 	"github.com/lebleuciel/maani/pkg/database/ent/predicate"
+	"github.com/lebleuciel/maani/pkg/database/ent/share"
 	"github.com/lebleuciel/maani/pkg/database/ent/tag"
 	"github.com/lebleuciel/maani/pkg/database/ent/user"
 )
@@ -21,8 +22,9 @@ import (
 // FileUpdate is the builder for updating File entities.
 type FileUpdate struct {
 	config
-	hooks    []Hook
-	mutation *FileMutation
+	hooks     []Hook
+	mutation  *FileMutation
+	modifiers []func(*sql.UpdateBuilder)
 }
 
 // Where appends a list predicates to the FileUpdate builder.
@@ -108,6 +110,233 @@ func (fu *FileUpdate) SetNillableType(s *string) *FileUpdate {
 	return fu
 }
 
+// SetBurnAfterRead sets the "burn_after_read" field.
+func (fu *FileUpdate) SetBurnAfterRead(b bool) *FileUpdate {
+	fu.mutation.SetBurnAfterRead(b)
+	return fu
+}
+
+// SetNillableBurnAfterRead sets the "burn_after_read" field if the given value is not nil.
+func (fu *FileUpdate) SetNillableBurnAfterRead(b *bool) *FileUpdate {
+	if b != nil {
+		fu.SetBurnAfterRead(*b)
+	}
+	return fu
+}
+
+// SetPhash sets the "phash" field.
+func (fu *FileUpdate) SetPhash(i int64) *FileUpdate {
+	fu.mutation.ResetPhash()
+	fu.mutation.SetPhash(i)
+	return fu
+}
+
+// SetNillablePhash sets the "phash" field if the given value is not nil.
+func (fu *FileUpdate) SetNillablePhash(i *int64) *FileUpdate {
+	if i != nil {
+		fu.SetPhash(*i)
+	}
+	return fu
+}
+
+// AddPhash adds i to the "phash" field.
+func (fu *FileUpdate) AddPhash(i int64) *FileUpdate {
+	fu.mutation.AddPhash(i)
+	return fu
+}
+
+// ClearPhash clears the value of the "phash" field.
+func (fu *FileUpdate) ClearPhash() *FileUpdate {
+	fu.mutation.ClearPhash()
+	return fu
+}
+
+// SetKeyID sets the "key_id" field.
+func (fu *FileUpdate) SetKeyID(s string) *FileUpdate {
+	fu.mutation.SetKeyID(s)
+	return fu
+}
+
+// SetNillableKeyID sets the "key_id" field if the given value is not nil.
+func (fu *FileUpdate) SetNillableKeyID(s *string) *FileUpdate {
+	if s != nil {
+		fu.SetKeyID(*s)
+	}
+	return fu
+}
+
+// SetWrappedKey sets the "wrapped_key" field.
+func (fu *FileUpdate) SetWrappedKey(b []byte) *FileUpdate {
+	fu.mutation.SetWrappedKey(b)
+	return fu
+}
+
+// ClearWrappedKey clears the value of the "wrapped_key" field.
+func (fu *FileUpdate) ClearWrappedKey() *FileUpdate {
+	fu.mutation.ClearWrappedKey()
+	return fu
+}
+
+// SetSha256 sets the "sha256" field.
+func (fu *FileUpdate) SetSha256(s string) *FileUpdate {
+	fu.mutation.SetSha256(s)
+	return fu
+}
+
+// SetNillableSha256 sets the "sha256" field if the given value is not nil.
+func (fu *FileUpdate) SetNillableSha256(s *string) *FileUpdate {
+	if s != nil {
+		fu.SetSha256(*s)
+	}
+	return fu
+}
+
+// SetWidth sets the "width" field.
+func (fu *FileUpdate) SetWidth(i int) *FileUpdate {
+	fu.mutation.ResetWidth()
+	fu.mutation.SetWidth(i)
+	return fu
+}
+
+// SetNillableWidth sets the "width" field if the given value is not nil.
+func (fu *FileUpdate) SetNillableWidth(i *int) *FileUpdate {
+	if i != nil {
+		fu.SetWidth(*i)
+	}
+	return fu
+}
+
+// AddWidth adds i to the "width" field.
+func (fu *FileUpdate) AddWidth(i int) *FileUpdate {
+	fu.mutation.AddWidth(i)
+	return fu
+}
+
+// SetHeight sets the "height" field.
+func (fu *FileUpdate) SetHeight(i int) *FileUpdate {
+	fu.mutation.ResetHeight()
+	fu.mutation.SetHeight(i)
+	return fu
+}
+
+// SetNillableHeight sets the "height" field if the given value is not nil.
+func (fu *FileUpdate) SetNillableHeight(i *int) *FileUpdate {
+	if i != nil {
+		fu.SetHeight(*i)
+	}
+	return fu
+}
+
+// AddHeight adds i to the "height" field.
+func (fu *FileUpdate) AddHeight(i int) *FileUpdate {
+	fu.mutation.AddHeight(i)
+	return fu
+}
+
+// SetDominantColor sets the "dominant_color" field.
+func (fu *FileUpdate) SetDominantColor(s string) *FileUpdate {
+	fu.mutation.SetDominantColor(s)
+	return fu
+}
+
+// SetNillableDominantColor sets the "dominant_color" field if the given value is not nil.
+func (fu *FileUpdate) SetNillableDominantColor(s *string) *FileUpdate {
+	if s != nil {
+		fu.SetDominantColor(*s)
+	}
+	return fu
+}
+
+// SetSourceURL sets the "source_url" field.
+func (fu *FileUpdate) SetSourceURL(s string) *FileUpdate {
+	fu.mutation.SetSourceURL(s)
+	return fu
+}
+
+// SetNillableSourceURL sets the "source_url" field if the given value is not nil.
+func (fu *FileUpdate) SetNillableSourceURL(s *string) *FileUpdate {
+	if s != nil {
+		fu.SetSourceURL(*s)
+	}
+	return fu
+}
+
+// SetSearchQuery sets the "search_query" field.
+func (fu *FileUpdate) SetSearchQuery(s string) *FileUpdate {
+	fu.mutation.SetSearchQuery(s)
+	return fu
+}
+
+// SetNillableSearchQuery sets the "search_query" field if the given value is not nil.
+func (fu *FileUpdate) SetNillableSearchQuery(s *string) *FileUpdate {
+	if s != nil {
+		fu.SetSearchQuery(*s)
+	}
+	return fu
+}
+
+// SetTagNames sets the "tag_names" field.
+func (fu *FileUpdate) SetTagNames(s string) *FileUpdate {
+	fu.mutation.SetTagNames(s)
+	return fu
+}
+
+// SetNillableTagNames sets the "tag_names" field if the given value is not nil.
+func (fu *FileUpdate) SetNillableTagNames(s *string) *FileUpdate {
+	if s != nil {
+		fu.SetTagNames(*s)
+	}
+	return fu
+}
+
+// SetCameraMake sets the "camera_make" field.
+func (fu *FileUpdate) SetCameraMake(s string) *FileUpdate {
+	fu.mutation.SetCameraMake(s)
+	return fu
+}
+
+// SetNillableCameraMake sets the "camera_make" field if the given value is not nil.
+func (fu *FileUpdate) SetNillableCameraMake(s *string) *FileUpdate {
+	if s != nil {
+		fu.SetCameraMake(*s)
+	}
+	return fu
+}
+
+// SetCameraModel sets the "camera_model" field.
+func (fu *FileUpdate) SetCameraModel(s string) *FileUpdate {
+	fu.mutation.SetCameraModel(s)
+	return fu
+}
+
+// SetNillableCameraModel sets the "camera_model" field if the given value is not nil.
+func (fu *FileUpdate) SetNillableCameraModel(s *string) *FileUpdate {
+	if s != nil {
+		fu.SetCameraModel(*s)
+	}
+	return fu
+}
+
+// SetTakenAt sets the "taken_at" field.
+func (fu *FileUpdate) SetTakenAt(t time.Time) *FileUpdate {
+	fu.mutation.SetTakenAt(t)
+	return fu
+}
+
+// SetNillableTakenAt sets the "taken_at" field if the given value is not nil.
+func (fu *FileUpdate) SetNillableTakenAt(t *time.Time) *FileUpdate {
+	if t != nil {
+		fu.SetTakenAt(*t)
+	}
+	return fu
+}
+
+// ClearTakenAt clears the value of the "taken_at" field.
+func (fu *FileUpdate) ClearTakenAt() *FileUpdate {
+	fu.mutation.ClearTakenAt()
+	return fu
+}
+
 // SetCreatedAt sets the "created_at" field.
 func (fu *FileUpdate) SetCreatedAt(t time.Time) *FileUpdate {
 	fu.mutation.SetCreatedAt(t)
@@ -185,6 +414,21 @@ func (fu *FileUpdate) AddTags(t ...*Tag) *FileUpdate {
 	return fu.AddTagIDs(ids...)
 }
 
+// AddShareIDs adds the "shares" edge to the Share entity by IDs.
+func (fu *FileUpdate) AddShareIDs(ids ...int) *FileUpdate {
+	fu.mutation.AddShareIDs(ids...)
+	return fu
+}
+
+// AddShares adds the "shares" edges to the Share entity.
+func (fu *FileUpdate) AddShares(s ...*Share) *FileUpdate {
+	ids := make([]int, len(s))
+	for i := range s {
+		ids[i] = s[i].ID
+	}
+	return fu.AddShareIDs(ids...)
+}
+
 // Mutation returns the FileMutation object of the builder.
 func (fu *FileUpdate) Mutation() *FileMutation {
 	return fu.mutation
@@ -223,6 +467,27 @@ func (fu *FileUpdate) RemoveTags(t ...*Tag) *FileUpdate {
 	return fu.RemoveTagIDs(ids...)
 }
 
+// ClearShares clears all "shares" edges to the Share entity.
+func (fu *FileUpdate) ClearShares() *FileUpdate {
+	fu.mutation.ClearShares()
+	return fu
+}
+
+// RemoveShareIDs removes the "shares" edge to Share entities by IDs.
+func (fu *FileUpdate) RemoveShareIDs(ids ...int) *FileUpdate {
+	fu.mutation.RemoveShareIDs(ids...)
+	return fu
+}
+
+// RemoveShares removes "shares" edges to Share entities.
+func (fu *FileUpdate) RemoveShares(s ...*Share) *FileUpdate {
+	ids := make([]int, len(s))
+	for i := range s {
+		ids[i] = s[i].ID
+	}
+	return fu.RemoveShareIDs(ids...)
+}
+
 // Save executes the query and returns the number of nodes affected by the update operation.
 func (fu *FileUpdate) Save(ctx context.Context) (int, error) {
 	fu.defaults()
@@ -276,6 +541,41 @@ func (fu *FileUpdate) check() error {
 			return &ValidationError{Name: "type", err: fmt.Errorf(`ent: validator failed for field "File.type": %w`, err)}
 		}
 	}
+	if v, ok := fu.mutation.KeyID(); ok {
+		if err := file.KeyIDValidator(v); err != nil {
+			return &ValidationError{Name: "key_id", err: fmt.Errorf(`ent: validator failed for field "File.key_id": %w`, err)}
+		}
+	}
+	if v, ok := fu.mutation.Sha256(); ok {
+		if err := file.Sha256Validator(v); err != nil {
+			return &ValidationError{Name: "sha256", err: fmt.Errorf(`ent: validator failed for field "File.sha256": %w`, err)}
+		}
+	}
+	if v, ok := fu.mutation.DominantColor(); ok {
+		if err := file.DominantColorValidator(v); err != nil {
+			return &ValidationError{Name: "dominant_color", err: fmt.Errorf(`ent: validator failed for field "File.dominant_color": %w`, err)}
+		}
+	}
+	if v, ok := fu.mutation.SourceURL(); ok {
+		if err := file.SourceURLValidator(v); err != nil {
+			return &ValidationError{Name: "source_url", err: fmt.Errorf(`ent: validator failed for field "File.source_url": %w`, err)}
+		}
+	}
+	if v, ok := fu.mutation.SearchQuery(); ok {
+		if err := file.SearchQueryValidator(v); err != nil {
+			return &ValidationError{Name: "search_query", err: fmt.Errorf(`ent: validator failed for field "File.search_query": %w`, err)}
+		}
+	}
+	if v, ok := fu.mutation.CameraMake(); ok {
+		if err := file.CameraMakeValidator(v); err != nil {
+			return &ValidationError{Name: "camera_make", err: fmt.Errorf(`ent: validator failed for field "File.camera_make": %w`, err)}
+		}
+	}
+	if v, ok := fu.mutation.CameraModel(); ok {
+		if err := file.CameraModelValidator(v); err != nil {
+			return &ValidationError{Name: "camera_model", err: fmt.Errorf(`ent: validator failed for field "File.camera_model": %w`, err)}
+		}
+	}
 	if _, ok := fu.mutation.UserID(); fu.mutation.UserCleared() && !ok {
 		return errors.New(`ent: clearing a required unique edge "File.user"`)
 	}
@@ -285,6 +585,12 @@ func (fu *FileUpdate) check() error {
 	return nil
 }
 
+// Modify adds a statement modifier for attaching custom logic to the UPDATE statement.
+func (fu *FileUpdate) Modify(modifiers ...func(u *sql.UpdateBuilder)) *FileUpdate {
+	fu.modifiers = append(fu.modifiers, modifiers...)
+	return fu
+}
+
 func (fu *FileUpdate) sqlSave(ctx context.Context) (n int, err error) {
 	if err := fu.check(); err != nil {
 		return n, err
@@ -309,6 +615,66 @@ func (fu *FileUpdate) sqlSave(ctx context.Context) (n int, err error) {
 	if value, ok := fu.mutation.AddedSize(); ok {
 		_spec.AddField(file.FieldSize, field.TypeInt, value)
 	}
+	if value, ok := fu.mutation.BurnAfterRead(); ok {
+		_spec.SetField(file.FieldBurnAfterRead, field.TypeBool, value)
+	}
+	if value, ok := fu.mutation.Phash(); ok {
+		_spec.SetField(file.FieldPhash, field.TypeInt64, value)
+	}
+	if value, ok := fu.mutation.AddedPhash(); ok {
+		_spec.AddField(file.FieldPhash, field.TypeInt64, value)
+	}
+	if fu.mutation.PhashCleared() {
+		_spec.ClearField(file.FieldPhash, field.TypeInt64)
+	}
+	if value, ok := fu.mutation.KeyID(); ok {
+		_spec.SetField(file.FieldKeyID, field.TypeString, value)
+	}
+	if value, ok := fu.mutation.WrappedKey(); ok {
+		_spec.SetField(file.FieldWrappedKey, field.TypeBytes, value)
+	}
+	if fu.mutation.WrappedKeyCleared() {
+		_spec.ClearField(file.FieldWrappedKey, field.TypeBytes)
+	}
+	if value, ok := fu.mutation.Sha256(); ok {
+		_spec.SetField(file.FieldSha256, field.TypeString, value)
+	}
+	if value, ok := fu.mutation.Width(); ok {
+		_spec.SetField(file.FieldWidth, field.TypeInt, value)
+	}
+	if value, ok := fu.mutation.AddedWidth(); ok {
+		_spec.AddField(file.FieldWidth, field.TypeInt, value)
+	}
+	if value, ok := fu.mutation.Height(); ok {
+		_spec.SetField(file.FieldHeight, field.TypeInt, value)
+	}
+	if value, ok := fu.mutation.AddedHeight(); ok {
+		_spec.AddField(file.FieldHeight, field.TypeInt, value)
+	}
+	if value, ok := fu.mutation.DominantColor(); ok {
+		_spec.SetField(file.FieldDominantColor, field.TypeString, value)
+	}
+	if value, ok := fu.mutation.SourceURL(); ok {
+		_spec.SetField(file.FieldSourceURL, field.TypeString, value)
+	}
+	if value, ok := fu.mutation.SearchQuery(); ok {
+		_spec.SetField(file.FieldSearchQuery, field.TypeString, value)
+	}
+	if value, ok := fu.mutation.TagNames(); ok {
+		_spec.SetField(file.FieldTagNames, field.TypeString, value)
+	}
+	if value, ok := fu.mutation.CameraMake(); ok {
+		_spec.SetField(file.FieldCameraMake, field.TypeString, value)
+	}
+	if value, ok := fu.mutation.CameraModel(); ok {
+		_spec.SetField(file.FieldCameraModel, field.TypeString, value)
+	}
+	if value, ok := fu.mutation.TakenAt(); ok {
+		_spec.SetField(file.FieldTakenAt, field.TypeTime, value)
+	}
+	if fu.mutation.TakenAtCleared() {
+		_spec.ClearField(file.FieldTakenAt, field.TypeTime)
+	}
 	if value, ok := fu.mutation.CreatedAt(); ok {
 		_spec.SetField(file.FieldCreatedAt, field.TypeTime, value)
 	}
@@ -427,6 +793,52 @@ func (fu *FileUpdate) sqlSave(ctx context.Context) (n int, err error) {
 		}
 		_spec.Edges.Add = append(_spec.Edges.Add, edge)
 	}
+	if fu.mutation.SharesCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   file.SharesTable,
+			Columns: []string{file.SharesColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(share.FieldID, field.TypeInt),
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := fu.mutation.RemovedSharesIDs(); len(nodes) > 0 && !fu.mutation.SharesCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   file.SharesTable,
+			Columns: []string{file.SharesColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(share.FieldID, field.TypeInt),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := fu.mutation.SharesIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   file.SharesTable,
+			Columns: []string{file.SharesColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(share.FieldID, field.TypeInt),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
+	_spec.AddModifiers(fu.modifiers...)
 	if n, err = sqlgraph.UpdateNodes(ctx, fu.driver, _spec); err != nil {
 		if _, ok := err.(*sqlgraph.NotFoundError); ok {
 			err = &NotFoundError{file.Label}
@@ -442,9 +854,10 @@ func (fu *FileUpdate) sqlSave(ctx context.Context) (n int, err error) {
 // FileUpdateOne is the builder for updating a single File entity.
 type FileUpdateOne struct {
 	config
-	fields   []string
-	hooks    []Hook
-	mutation *FileMutation
+	fields    []string
+	hooks     []Hook
+	mutation  *FileMutation
+	modifiers []func(*sql.UpdateBuilder)
 }
 
 // SetName sets the "name" field.
@@ -524,6 +937,233 @@ func (fuo *FileUpdateOne) SetNillableType(s *string) *FileUpdateOne {
 	return fuo
 }
 
+// SetBurnAfterRead sets the "burn_after_read" field.
+func (fuo *FileUpdateOne) SetBurnAfterRead(b bool) *FileUpdateOne {
+	fuo.mutation.SetBurnAfterRead(b)
+	return fuo
+}
+
+// SetNillableBurnAfterRead sets the "burn_after_read" field if the given value is not nil.
+func (fuo *FileUpdateOne) SetNillableBurnAfterRead(b *bool) *FileUpdateOne {
+	if b != nil {
+		fuo.SetBurnAfterRead(*b)
+	}
+	return fuo
+}
+
+// SetPhash sets the "phash" field.
+func (fuo *FileUpdateOne) SetPhash(i int64) *FileUpdateOne {
+	fuo.mutation.ResetPhash()
+	fuo.mutation.SetPhash(i)
+	return fuo
+}
+
+// SetNillablePhash sets the "phash" field if the given value is not nil.
+func (fuo *FileUpdateOne) SetNillablePhash(i *int64) *FileUpdateOne {
+	if i != nil {
+		fuo.SetPhash(*i)
+	}
+	return fuo
+}
+
+// AddPhash adds i to the "phash" field.
+func (fuo *FileUpdateOne) AddPhash(i int64) *FileUpdateOne {
+	fuo.mutation.AddPhash(i)
+	return fuo
+}
+
+// ClearPhash clears the value of the "phash" field.
+func (fuo *FileUpdateOne) ClearPhash() *FileUpdateOne {
+	fuo.mutation.ClearPhash()
+	return fuo
+}
+
+// SetKeyID sets the "key_id" field.
+func (fuo *FileUpdateOne) SetKeyID(s string) *FileUpdateOne {
+	fuo.mutation.SetKeyID(s)
+	return fuo
+}
+
+// SetNillableKeyID sets the "key_id" field if the given value is not nil.
+func (fuo *FileUpdateOne) SetNillableKeyID(s *string) *FileUpdateOne {
+	if s != nil {
+		fuo.SetKeyID(*s)
+	}
+	return fuo
+}
+
+// SetWrappedKey sets the "wrapped_key" field.
+func (fuo *FileUpdateOne) SetWrappedKey(b []byte) *FileUpdateOne {
+	fuo.mutation.SetWrappedKey(b)
+	return fuo
+}
+
+// ClearWrappedKey clears the value of the "wrapped_key" field.
+func (fuo *FileUpdateOne) ClearWrappedKey() *FileUpdateOne {
+	fuo.mutation.ClearWrappedKey()
+	return fuo
+}
+
+// SetSha256 sets the "sha256" field.
+func (fuo *FileUpdateOne) SetSha256(s string) *FileUpdateOne {
+	fuo.mutation.SetSha256(s)
+	return fuo
+}
+
+// SetNillableSha256 sets the "sha256" field if the given value is not nil.
+func (fuo *FileUpdateOne) SetNillableSha256(s *string) *FileUpdateOne {
+	if s != nil {
+		fuo.SetSha256(*s)
+	}
+	return fuo
+}
+
+// SetWidth sets the "width" field.
+func (fuo *FileUpdateOne) SetWidth(i int) *FileUpdateOne {
+	fuo.mutation.ResetWidth()
+	fuo.mutation.SetWidth(i)
+	return fuo
+}
+
+// SetNillableWidth sets the "width" field if the given value is not nil.
+func (fuo *FileUpdateOne) SetNillableWidth(i *int) *FileUpdateOne {
+	if i != nil {
+		fuo.SetWidth(*i)
+	}
+	return fuo
+}
+
+// AddWidth adds i to the "width" field.
+func (fuo *FileUpdateOne) AddWidth(i int) *FileUpdateOne {
+	fuo.mutation.AddWidth(i)
+	return fuo
+}
+
+// SetHeight sets the "height" field.
+func (fuo *FileUpdateOne) SetHeight(i int) *FileUpdateOne {
+	fuo.mutation.ResetHeight()
+	fuo.mutation.SetHeight(i)
+	return fuo
+}
+
+// SetNillableHeight sets the "height" field if the given value is not nil.
+func (fuo *FileUpdateOne) SetNillableHeight(i *int) *FileUpdateOne {
+	if i != nil {
+		fuo.SetHeight(*i)
+	}
+	return fuo
+}
+
+// AddHeight adds i to the "height" field.
+func (fuo *FileUpdateOne) AddHeight(i int) *FileUpdateOne {
+	fuo.mutation.AddHeight(i)
+	return fuo
+}
+
+// SetDominantColor sets the "dominant_color" field.
+func (fuo *FileUpdateOne) SetDominantColor(s string) *FileUpdateOne {
+	fuo.mutation.SetDominantColor(s)
+	return fuo
+}
+
+// SetNillableDominantColor sets the "dominant_color" field if the given value is not nil.
+func (fuo *FileUpdateOne) SetNillableDominantColor(s *string) *FileUpdateOne {
+	if s != nil {
+		fuo.SetDominantColor(*s)
+	}
+	return fuo
+}
+
+// SetSourceURL sets the "source_url" field.
+func (fuo *FileUpdateOne) SetSourceURL(s string) *FileUpdateOne {
+	fuo.mutation.SetSourceURL(s)
+	return fuo
+}
+
+// SetNillableSourceURL sets the "source_url" field if the given value is not nil.
+func (fuo *FileUpdateOne) SetNillableSourceURL(s *string) *FileUpdateOne {
+	if s != nil {
+		fuo.SetSourceURL(*s)
+	}
+	return fuo
+}
+
+// SetSearchQuery sets the "search_query" field.
+func (fuo *FileUpdateOne) SetSearchQuery(s string) *FileUpdateOne {
+	fuo.mutation.SetSearchQuery(s)
+	return fuo
+}
+
+// SetNillableSearchQuery sets the "search_query" field if the given value is not nil.
+func (fuo *FileUpdateOne) SetNillableSearchQuery(s *string) *FileUpdateOne {
+	if s != nil {
+		fuo.SetSearchQuery(*s)
+	}
+	return fuo
+}
+
+// SetTagNames sets the "tag_names" field.
+func (fuo *FileUpdateOne) SetTagNames(s string) *FileUpdateOne {
+	fuo.mutation.SetTagNames(s)
+	return fuo
+}
+
+// SetNillableTagNames sets the "tag_names" field if the given value is not nil.
+func (fuo *FileUpdateOne) SetNillableTagNames(s *string) *FileUpdateOne {
+	if s != nil {
+		fuo.SetTagNames(*s)
+	}
+	return fuo
+}
+
+// SetCameraMake sets the "camera_make" field.
+func (fuo *FileUpdateOne) SetCameraMake(s string) *FileUpdateOne {
+	fuo.mutation.SetCameraMake(s)
+	return fuo
+}
+
+// SetNillableCameraMake sets the "camera_make" field if the given value is not nil.
+func (fuo *FileUpdateOne) SetNillableCameraMake(s *string) *FileUpdateOne {
+	if s != nil {
+		fuo.SetCameraMake(*s)
+	}
+	return fuo
+}
+
+// SetCameraModel sets the "camera_model" field.
+func (fuo *FileUpdateOne) SetCameraModel(s string) *FileUpdateOne {
+	fuo.mutation.SetCameraModel(s)
+	return fuo
+}
+
+// SetNillableCameraModel sets the "camera_model" field if the given value is not nil.
+func (fuo *FileUpdateOne) SetNillableCameraModel(s *string) *FileUpdateOne {
+	if s != nil {
+		fuo.SetCameraModel(*s)
+	}
+	return fuo
+}
+
+// SetTakenAt sets the "taken_at" field.
+func (fuo *FileUpdateOne) SetTakenAt(t time.Time) *FileUpdateOne {
+	fuo.mutation.SetTakenAt(t)
+	return fuo
+}
+
+// SetNillableTakenAt sets the "taken_at" field if the given value is not nil.
+func (fuo *FileUpdateOne) SetNillableTakenAt(t *time.Time) *FileUpdateOne {
+	if t != nil {
+		fuo.SetTakenAt(*t)
+	}
+	return fuo
+}
+
+// ClearTakenAt clears the value of the "taken_at" field.
+func (fuo *FileUpdateOne) ClearTakenAt() *FileUpdateOne {
+	fuo.mutation.ClearTakenAt()
+	return fuo
+}
+
 // SetCreatedAt sets the "created_at" field.
 func (fuo *FileUpdateOne) SetCreatedAt(t time.Time) *FileUpdateOne {
 	fuo.mutation.SetCreatedAt(t)
@@ -601,6 +1241,21 @@ func (fuo *FileUpdateOne) AddTags(t ...*Tag) *FileUpdateOne {
 	return fuo.AddTagIDs(ids...)
 }
 
+// AddShareIDs adds the "shares" edge to the Share entity by IDs.
+func (fuo *FileUpdateOne) AddShareIDs(ids ...int) *FileUpdateOne {
+	fuo.mutation.AddShareIDs(ids...)
+	return fuo
+}
+
+// AddShares adds the "shares" edges to the Share entity.
+func (fuo *FileUpdateOne) AddShares(s ...*Share) *FileUpdateOne {
+	ids := make([]int, len(s))
+	for i := range s {
+		ids[i] = s[i].ID
+	}
+	return fuo.AddShareIDs(ids...)
+}
+
 // Mutation returns the FileMutation object of the builder.
 func (fuo *FileUpdateOne) Mutation() *FileMutation {
 	return fuo.mutation
@@ -639,6 +1294,27 @@ func (fuo *FileUpdateOne) RemoveTags(t ...*Tag) *FileUpdateOne {
 	return fuo.RemoveTagIDs(ids...)
 }
 
+// ClearShares clears all "shares" edges to the Share entity.
+func (fuo *FileUpdateOne) ClearShares() *FileUpdateOne {
+	fuo.mutation.ClearShares()
+	return fuo
+}
+
+// RemoveShareIDs removes the "shares" edge to Share entities by IDs.
+func (fuo *FileUpdateOne) RemoveShareIDs(ids ...int) *FileUpdateOne {
+	fuo.mutation.RemoveShareIDs(ids...)
+	return fuo
+}
+
+// RemoveShares removes "shares" edges to Share entities.
+func (fuo *FileUpdateOne) RemoveShares(s ...*Share) *FileUpdateOne {
+	ids := make([]int, len(s))
+	for i := range s {
+		ids[i] = s[i].ID
+	}
+	return fuo.RemoveShareIDs(ids...)
+}
+
 // Where appends a list predicates to the FileUpdate builder.
 func (fuo *FileUpdateOne) Where(ps ...predicate.File) *FileUpdateOne {
 	fuo.mutation.Where(ps...)
@@ -705,6 +1381,41 @@ func (fuo *FileUpdateOne) check() error {
 			return &ValidationError{Name: "type", err: fmt.Errorf(`ent: validator failed for field "File.type": %w`, err)}
 		}
 	}
+	if v, ok := fuo.mutation.KeyID(); ok {
+		if err := file.KeyIDValidator(v); err != nil {
+			return &ValidationError{Name: "key_id", err: fmt.Errorf(`ent: validator failed for field "File.key_id": %w`, err)}
+		}
+	}
+	if v, ok := fuo.mutation.Sha256(); ok {
+		if err := file.Sha256Validator(v); err != nil {
+			return &ValidationError{Name: "sha256", err: fmt.Errorf(`ent: validator failed for field "File.sha256": %w`, err)}
+		}
+	}
+	if v, ok := fuo.mutation.DominantColor(); ok {
+		if err := file.DominantColorValidator(v); err != nil {
+			return &ValidationError{Name: "dominant_color", err: fmt.Errorf(`ent: validator failed for field "File.dominant_color": %w`, err)}
+		}
+	}
+	if v, ok := fuo.mutation.SourceURL(); ok {
+		if err := file.SourceURLValidator(v); err != nil {
+			return &ValidationError{Name: "source_url", err: fmt.Errorf(`ent: validator failed for field "File.source_url": %w`, err)}
+		}
+	}
+	if v, ok := fuo.mutation.SearchQuery(); ok {
+		if err := file.SearchQueryValidator(v); err != nil {
+			return &ValidationError{Name: "search_query", err: fmt.Errorf(`ent: validator failed for field "File.search_query": %w`, err)}
+		}
+	}
+	if v, ok := fuo.mutation.CameraMake(); ok {
+		if err := file.CameraMakeValidator(v); err != nil {
+			return &ValidationError{Name: "camera_make", err: fmt.Errorf(`ent: validator failed for field "File.camera_make": %w`, err)}
+		}
+	}
+	if v, ok := fuo.mutation.CameraModel(); ok {
+		if err := file.CameraModelValidator(v); err != nil {
+			return &ValidationError{Name: "camera_model", err: fmt.Errorf(`ent: validator failed for field "File.camera_model": %w`, err)}
+		}
+	}
 	if _, ok := fuo.mutation.UserID(); fuo.mutation.UserCleared() && !ok {
 		return errors.New(`ent: clearing a required unique edge "File.user"`)
 	}
@@ -714,6 +1425,12 @@ func (fuo *FileUpdateOne) check() error {
 	return nil
 }
 
+// Modify adds a statement modifier for attaching custom logic to the UPDATE statement.
+func (fuo *FileUpdateOne) Modify(modifiers ...func(u *sql.UpdateBuilder)) *FileUpdateOne {
+	fuo.modifiers = append(fuo.modifiers, modifiers...)
+	return fuo
+}
+
 func (fuo *FileUpdateOne) sqlSave(ctx context.Context) (_node *File, err error) {
 	if err := fuo.check(); err != nil {
 		return _node, err
@@ -755,6 +1472,66 @@ func (fuo *FileUpdateOne) sqlSave(ctx context.Context) (_node *File, err error)
 	if value, ok := fuo.mutation.AddedSize(); ok {
 		_spec.AddField(file.FieldSize, field.TypeInt, value)
 	}
+	if value, ok := fuo.mutation.BurnAfterRead(); ok {
+		_spec.SetField(file.FieldBurnAfterRead, field.TypeBool, value)
+	}
+	if value, ok := fuo.mutation.Phash(); ok {
+		_spec.SetField(file.FieldPhash, field.TypeInt64, value)
+	}
+	if value, ok := fuo.mutation.AddedPhash(); ok {
+		_spec.AddField(file.FieldPhash, field.TypeInt64, value)
+	}
+	if fuo.mutation.PhashCleared() {
+		_spec.ClearField(file.FieldPhash, field.TypeInt64)
+	}
+	if value, ok := fuo.mutation.KeyID(); ok {
+		_spec.SetField(file.FieldKeyID, field.TypeString, value)
+	}
+	if value, ok := fuo.mutation.WrappedKey(); ok {
+		_spec.SetField(file.FieldWrappedKey, field.TypeBytes, value)
+	}
+	if fuo.mutation.WrappedKeyCleared() {
+		_spec.ClearField(file.FieldWrappedKey, field.TypeBytes)
+	}
+	if value, ok := fuo.mutation.Sha256(); ok {
+		_spec.SetField(file.FieldSha256, field.TypeString, value)
+	}
+	if value, ok := fuo.mutation.Width(); ok {
+		_spec.SetField(file.FieldWidth, field.TypeInt, value)
+	}
+	if value, ok := fuo.mutation.AddedWidth(); ok {
+		_spec.AddField(file.FieldWidth, field.TypeInt, value)
+	}
+	if value, ok := fuo.mutation.Height(); ok {
+		_spec.SetField(file.FieldHeight, field.TypeInt, value)
+	}
+	if value, ok := fuo.mutation.AddedHeight(); ok {
+		_spec.AddField(file.FieldHeight, field.TypeInt, value)
+	}
+	if value, ok := fuo.mutation.DominantColor(); ok {
+		_spec.SetField(file.FieldDominantColor, field.TypeString, value)
+	}
+	if value, ok := fuo.mutation.SourceURL(); ok {
+		_spec.SetField(file.FieldSourceURL, field.TypeString, value)
+	}
+	if value, ok := fuo.mutation.SearchQuery(); ok {
+		_spec.SetField(file.FieldSearchQuery, field.TypeString, value)
+	}
+	if value, ok := fuo.mutation.TagNames(); ok {
+		_spec.SetField(file.FieldTagNames, field.TypeString, value)
+	}
+	if value, ok := fuo.mutation.CameraMake(); ok {
+		_spec.SetField(file.FieldCameraMake, field.TypeString, value)
+	}
+	if value, ok := fuo.mutation.CameraModel(); ok {
+		_spec.SetField(file.FieldCameraModel, field.TypeString, value)
+	}
+	if value, ok := fuo.mutation.TakenAt(); ok {
+		_spec.SetField(file.FieldTakenAt, field.TypeTime, value)
+	}
+	if fuo.mutation.TakenAtCleared() {
+		_spec.ClearField(file.FieldTakenAt, field.TypeTime)
+	}
 	if value, ok := fuo.mutation.CreatedAt(); ok {
 		_spec.SetField(file.FieldCreatedAt, field.TypeTime, value)
 	}
@@ -873,6 +1650,52 @@ func (fuo *FileUpdateOne) sqlSave(ctx context.Context) (_node *File, err error)
 		}
 		_spec.Edges.Add = append(_spec.Edges.Add, edge)
 	}
+	if fuo.mutation.SharesCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   file.SharesTable,
+			Columns: []string{file.SharesColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(share.FieldID, field.TypeInt),
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := fuo.mutation.RemovedSharesIDs(); len(nodes) > 0 && !fuo.mutation.SharesCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   file.SharesTable,
+			Columns: []string{file.SharesColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(share.FieldID, field.TypeInt),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := fuo.mutation.SharesIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   file.SharesTable,
+			Columns: []string{file.SharesColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(share.FieldID, field.TypeInt),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
+	_spec.AddModifiers(fuo.modifiers...)
 	_node = &File{config: fuo.config}
 	_spec.Assign = _node.assignValues
 	_spec.ScanValues = _node.scanValues
@@ -551,6 +551,12 @@ func (fq *FiletypeQuery) ForShare(opts ...sql.LockOption) *FiletypeQuery {
 	return fq
 }
 
+// Modify adds a query modifier for attaching custom logic to queries.
+func (fq *FiletypeQuery) Modify(modifiers ...func(s *sql.Selector)) *FiletypeSelect {
+	fq.modifiers = append(fq.modifiers, modifiers...)
+	return fq.Select()
+}
+
 // FiletypeGroupBy is the group-by builder for Filetype entities.
 type FiletypeGroupBy struct {
 	selector
@@ -640,3 +646,9 @@ func (fs *FiletypeSelect) sqlScan(ctx context.Context, root *FiletypeQuery, v an
 	defer rows.Close()
 	return sql.ScanSlice(rows, v)
 }
+
+// Modify adds a query modifier for attaching custom logic to queries.
+func (fs *FiletypeSelect) Modify(modifiers ...func(s *sql.Selector)) *FiletypeSelect {
+	fs.modifiers = append(fs.modifiers, modifiers...)
+	return fs
+}
@@ -19,8 +19,9 @@ import (
 // FiletypeUpdate is the builder for updating Filetype entities.
 type FiletypeUpdate struct {
 	config
-	hooks    []Hook
-	mutation *FiletypeMutation
+	hooks     []Hook
+	mutation  *FiletypeMutation
+	modifiers []func(*sql.UpdateBuilder)
 }
 
 // Where appends a list predicates to the FiletypeUpdate builder.
@@ -167,6 +168,12 @@ func (fu *FiletypeUpdate) defaults() {
 	}
 }
 
+// Modify adds a statement modifier for attaching custom logic to the UPDATE statement.
+func (fu *FiletypeUpdate) Modify(modifiers ...func(u *sql.UpdateBuilder)) *FiletypeUpdate {
+	fu.modifiers = append(fu.modifiers, modifiers...)
+	return fu
+}
+
 func (fu *FiletypeUpdate) sqlSave(ctx context.Context) (n int, err error) {
 	_spec := sqlgraph.NewUpdateSpec(filetype.Table, filetype.Columns, sqlgraph.NewFieldSpec(filetype.FieldID, field.TypeString))
 	if ps := fu.mutation.predicates; len(ps) > 0 {
@@ -239,6 +246,7 @@ func (fu *FiletypeUpdate) sqlSave(ctx context.Context) (n int, err error) {
 		}
 		_spec.Edges.Add = append(_spec.Edges.Add, edge)
 	}
+	_spec.AddModifiers(fu.modifiers...)
 	if n, err = sqlgraph.UpdateNodes(ctx, fu.driver, _spec); err != nil {
 		if _, ok := err.(*sqlgraph.NotFoundError); ok {
 			err = &NotFoundError{filetype.Label}
@@ -254,9 +262,10 @@ func (fu *FiletypeUpdate) sqlSave(ctx context.Context) (n int, err error) {
 // FiletypeUpdateOne is the builder for updating a single Filetype entity.
 type FiletypeUpdateOne struct {
 	config
-	fields   []string
-	hooks    []Hook
-	mutation *FiletypeMutation
+	fields    []string
+	hooks     []Hook
+	mutation  *FiletypeMutation
+	modifiers []func(*sql.UpdateBuilder)
 }
 
 // SetAllowedSize sets the "allowed_size" field.
@@ -410,6 +419,12 @@ func (fuo *FiletypeUpdateOne) defaults() {
 	}
 }
 
+// Modify adds a statement modifier for attaching custom logic to the UPDATE statement.
+func (fuo *FiletypeUpdateOne) Modify(modifiers ...func(u *sql.UpdateBuilder)) *FiletypeUpdateOne {
+	fuo.modifiers = append(fuo.modifiers, modifiers...)
+	return fuo
+}
+
 func (fuo *FiletypeUpdateOne) sqlSave(ctx context.Context) (_node *Filetype, err error) {
 	_spec := sqlgraph.NewUpdateSpec(filetype.Table, filetype.Columns, sqlgraph.NewFieldSpec(filetype.FieldID, field.TypeString))
 	id, ok := fuo.mutation.ID()
@@ -499,6 +514,7 @@ func (fuo *FiletypeUpdateOne) sqlSave(ctx context.Context) (_node *Filetype, err
 		}
 		_spec.Edges.Add = append(_spec.Edges.Add, edge)
 	}
+	_spec.AddModifiers(fuo.modifiers...)
 	_node = &Filetype{config: fuo.config}
 	_spec.Assign = _node.assignValues
 	_spec.ScanValues = _node.scanValues
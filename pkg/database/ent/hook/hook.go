@@ -33,6 +33,42 @@ func (f FiletypeFunc) Mutate(ctx context.Context, m ent.Mutation) (ent.Value, er
 	return nil, fmt.Errorf("unexpected mutation type %T. expect *ent.FiletypeMutation", m)
 }
 
+// The KeyRotationFunc type is an adapter to allow the use of ordinary
+// function as KeyRotation mutator.
+type KeyRotationFunc func(context.Context, *ent.KeyRotationMutation) (ent.Value, error)
+
+// Mutate calls f(ctx, m).
+func (f KeyRotationFunc) Mutate(ctx context.Context, m ent.Mutation) (ent.Value, error) {
+	if mv, ok := m.(*ent.KeyRotationMutation); ok {
+		return f(ctx, mv)
+	}
+	return nil, fmt.Errorf("unexpected mutation type %T. expect *ent.KeyRotationMutation", m)
+}
+
+// The SearchJobFunc type is an adapter to allow the use of ordinary
+// function as SearchJob mutator.
+type SearchJobFunc func(context.Context, *ent.SearchJobMutation) (ent.Value, error)
+
+// Mutate calls f(ctx, m).
+func (f SearchJobFunc) Mutate(ctx context.Context, m ent.Mutation) (ent.Value, error) {
+	if mv, ok := m.(*ent.SearchJobMutation); ok {
+		return f(ctx, mv)
+	}
+	return nil, fmt.Errorf("unexpected mutation type %T. expect *ent.SearchJobMutation", m)
+}
+
+// The ShareFunc type is an adapter to allow the use of ordinary
+// function as Share mutator.
+type ShareFunc func(context.Context, *ent.ShareMutation) (ent.Value, error)
+
+// Mutate calls f(ctx, m).
+func (f ShareFunc) Mutate(ctx context.Context, m ent.Mutation) (ent.Value, error) {
+	if mv, ok := m.(*ent.ShareMutation); ok {
+		return f(ctx, mv)
+	}
+	return nil, fmt.Errorf("unexpected mutation type %T. expect *ent.ShareMutation", m)
+}
+
 // The TagFunc type is an adapter to allow the use of ordinary
 // function as Tag mutator.
 type TagFunc func(context.Context, *ent.TagMutation) (ent.Value, error)
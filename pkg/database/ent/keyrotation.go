@@ -0,0 +1,200 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"fmt"
+	"strings"
+	"time"
+
+	"entgo.io/ent"
+	"entgo.io/ent/dialect/sql"
+	"github.com/lebleuciel/maani/pkg/database/ent/keyrotation"
+)
+
+// KeyRotation is the model entity for the KeyRotation schema.
+type KeyRotation struct {
+	config `json:"-"`
+	// ID of the ent.
+	ID int `json:"id,omitempty"`
+	// KeyID holds the value of the "key_id" field.
+	KeyID string `json:"key_id,omitempty"`
+	// Status holds the value of the "status" field.
+	Status keyrotation.Status `json:"status,omitempty"`
+	// Total holds the value of the "total" field.
+	Total int `json:"total,omitempty"`
+	// Done holds the value of the "done" field.
+	Done int `json:"done,omitempty"`
+	// Failed holds the value of the "failed" field.
+	Failed int `json:"failed,omitempty"`
+	// Error holds the value of the "error" field.
+	Error string `json:"error,omitempty"`
+	// CreatedAt holds the value of the "created_at" field.
+	CreatedAt *time.Time `json:"created_at,omitempty"`
+	// UpdatedAt holds the value of the "updated_at" field.
+	UpdatedAt time.Time `json:"updated_at,omitempty"`
+	// FinishedAt holds the value of the "finished_at" field.
+	FinishedAt   *time.Time `json:"finished_at,omitempty"`
+	selectValues sql.SelectValues
+}
+
+// scanValues returns the types for scanning values from sql.Rows.
+func (*KeyRotation) scanValues(columns []string) ([]any, error) {
+	values := make([]any, len(columns))
+	for i := range columns {
+		switch columns[i] {
+		case keyrotation.FieldID, keyrotation.FieldTotal, keyrotation.FieldDone, keyrotation.FieldFailed:
+			values[i] = new(sql.NullInt64)
+		case keyrotation.FieldKeyID, keyrotation.FieldStatus, keyrotation.FieldError:
+			values[i] = new(sql.NullString)
+		case keyrotation.FieldCreatedAt, keyrotation.FieldUpdatedAt, keyrotation.FieldFinishedAt:
+			values[i] = new(sql.NullTime)
+		default:
+			values[i] = new(sql.UnknownType)
+		}
+	}
+	return values, nil
+}
+
+// assignValues assigns the values that were returned from sql.Rows (after scanning)
+// to the KeyRotation fields.
+func (kr *KeyRotation) assignValues(columns []string, values []any) error {
+	if m, n := len(values), len(columns); m < n {
+		return fmt.Errorf("mismatch number of scan values: %d != %d", m, n)
+	}
+	for i := range columns {
+		switch columns[i] {
+		case keyrotation.FieldID:
+			value, ok := values[i].(*sql.NullInt64)
+			if !ok {
+				return fmt.Errorf("unexpected type %T for field id", value)
+			}
+			kr.ID = int(value.Int64)
+		case keyrotation.FieldKeyID:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field key_id", values[i])
+			} else if value.Valid {
+				kr.KeyID = value.String
+			}
+		case keyrotation.FieldStatus:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field status", values[i])
+			} else if value.Valid {
+				kr.Status = keyrotation.Status(value.String)
+			}
+		case keyrotation.FieldTotal:
+			if value, ok := values[i].(*sql.NullInt64); !ok {
+				return fmt.Errorf("unexpected type %T for field total", values[i])
+			} else if value.Valid {
+				kr.Total = int(value.Int64)
+			}
+		case keyrotation.FieldDone:
+			if value, ok := values[i].(*sql.NullInt64); !ok {
+				return fmt.Errorf("unexpected type %T for field done", values[i])
+			} else if value.Valid {
+				kr.Done = int(value.Int64)
+			}
+		case keyrotation.FieldFailed:
+			if value, ok := values[i].(*sql.NullInt64); !ok {
+				return fmt.Errorf("unexpected type %T for field failed", values[i])
+			} else if value.Valid {
+				kr.Failed = int(value.Int64)
+			}
+		case keyrotation.FieldError:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field error", values[i])
+			} else if value.Valid {
+				kr.Error = value.String
+			}
+		case keyrotation.FieldCreatedAt:
+			if value, ok := values[i].(*sql.NullTime); !ok {
+				return fmt.Errorf("unexpected type %T for field created_at", values[i])
+			} else if value.Valid {
+				kr.CreatedAt = new(time.Time)
+				*kr.CreatedAt = value.Time
+			}
+		case keyrotation.FieldUpdatedAt:
+			if value, ok := values[i].(*sql.NullTime); !ok {
+				return fmt.Errorf("unexpected type %T for field updated_at", values[i])
+			} else if value.Valid {
+				kr.UpdatedAt = value.Time
+			}
+		case keyrotation.FieldFinishedAt:
+			if value, ok := values[i].(*sql.NullTime); !ok {
+				return fmt.Errorf("unexpected type %T for field finished_at", values[i])
+			} else if value.Valid {
+				kr.FinishedAt = new(time.Time)
+				*kr.FinishedAt = value.Time
+			}
+		default:
+			kr.selectValues.Set(columns[i], values[i])
+		}
+	}
+	return nil
+}
+
+// Value returns the ent.Value that was dynamically selected and assigned to the KeyRotation.
+// This includes values selected through modifiers, order, etc.
+func (kr *KeyRotation) Value(name string) (ent.Value, error) {
+	return kr.selectValues.Get(name)
+}
+
+// Update returns a builder for updating this KeyRotation.
+// Note that you need to call KeyRotation.Unwrap() before calling this method if this KeyRotation
+// was returned from a transaction, and the transaction was committed or rolled back.
+func (kr *KeyRotation) Update() *KeyRotationUpdateOne {
+	return NewKeyRotationClient(kr.config).UpdateOne(kr)
+}
+
+// Unwrap unwraps the KeyRotation entity that was returned from a transaction after it was closed,
+// so that all future queries will be executed through the driver which created the transaction.
+func (kr *KeyRotation) Unwrap() *KeyRotation {
+	_tx, ok := kr.config.driver.(*txDriver)
+	if !ok {
+		panic("ent: KeyRotation is not a transactional entity")
+	}
+	kr.config.driver = _tx.drv
+	return kr
+}
+
+// String implements the fmt.Stringer.
+func (kr *KeyRotation) String() string {
+	var builder strings.Builder
+	builder.WriteString("KeyRotation(")
+	builder.WriteString(fmt.Sprintf("id=%v, ", kr.ID))
+	builder.WriteString("key_id=")
+	builder.WriteString(kr.KeyID)
+	builder.WriteString(", ")
+	builder.WriteString("status=")
+	builder.WriteString(fmt.Sprintf("%v", kr.Status))
+	builder.WriteString(", ")
+	builder.WriteString("total=")
+	builder.WriteString(fmt.Sprintf("%v", kr.Total))
+	builder.WriteString(", ")
+	builder.WriteString("done=")
+	builder.WriteString(fmt.Sprintf("%v", kr.Done))
+	builder.WriteString(", ")
+	builder.WriteString("failed=")
+	builder.WriteString(fmt.Sprintf("%v", kr.Failed))
+	builder.WriteString(", ")
+	builder.WriteString("error=")
+	builder.WriteString(kr.Error)
+	builder.WriteString(", ")
+	if v := kr.CreatedAt; v != nil {
+		builder.WriteString("created_at=")
+		builder.WriteString(v.Format(time.ANSIC))
+	}
+	builder.WriteString(", ")
+	builder.WriteString("updated_at=")
+	builder.WriteString(kr.UpdatedAt.Format(time.ANSIC))
+	builder.WriteString(", ")
+	if v := kr.FinishedAt; v != nil {
+		builder.WriteString("finished_at=")
+		builder.WriteString(v.Format(time.ANSIC))
+	}
+	builder.WriteByte(')')
+	return builder.String()
+}
+
+// KeyRotations is a parsable slice of KeyRotation.
+type KeyRotations []*KeyRotation
@@ -0,0 +1,530 @@
+// Code generated by ent, DO NOT EDIT.
+
+package keyrotation
+
+import (
+	"time"
+
+	"entgo.io/ent/dialect/sql"
+	"github.com/lebleuciel/maani/pkg/database/ent/predicate"
+)
+
+// ID filters vertices based on their ID field.
+func ID(id int) predicate.KeyRotation {
+	return predicate.KeyRotation(sql.FieldEQ(FieldID, id))
+}
+
+// IDEQ applies the EQ predicate on the ID field.
+func IDEQ(id int) predicate.KeyRotation {
+	return predicate.KeyRotation(sql.FieldEQ(FieldID, id))
+}
+
+// IDNEQ applies the NEQ predicate on the ID field.
+func IDNEQ(id int) predicate.KeyRotation {
+	return predicate.KeyRotation(sql.FieldNEQ(FieldID, id))
+}
+
+// IDIn applies the In predicate on the ID field.
+func IDIn(ids ...int) predicate.KeyRotation {
+	return predicate.KeyRotation(sql.FieldIn(FieldID, ids...))
+}
+
+// IDNotIn applies the NotIn predicate on the ID field.
+func IDNotIn(ids ...int) predicate.KeyRotation {
+	return predicate.KeyRotation(sql.FieldNotIn(FieldID, ids...))
+}
+
+// IDGT applies the GT predicate on the ID field.
+func IDGT(id int) predicate.KeyRotation {
+	return predicate.KeyRotation(sql.FieldGT(FieldID, id))
+}
+
+// IDGTE applies the GTE predicate on the ID field.
+func IDGTE(id int) predicate.KeyRotation {
+	return predicate.KeyRotation(sql.FieldGTE(FieldID, id))
+}
+
+// IDLT applies the LT predicate on the ID field.
+func IDLT(id int) predicate.KeyRotation {
+	return predicate.KeyRotation(sql.FieldLT(FieldID, id))
+}
+
+// IDLTE applies the LTE predicate on the ID field.
+func IDLTE(id int) predicate.KeyRotation {
+	return predicate.KeyRotation(sql.FieldLTE(FieldID, id))
+}
+
+// KeyID applies equality check predicate on the "key_id" field. It's identical to KeyIDEQ.
+func KeyID(v string) predicate.KeyRotation {
+	return predicate.KeyRotation(sql.FieldEQ(FieldKeyID, v))
+}
+
+// Total applies equality check predicate on the "total" field. It's identical to TotalEQ.
+func Total(v int) predicate.KeyRotation {
+	return predicate.KeyRotation(sql.FieldEQ(FieldTotal, v))
+}
+
+// Done applies equality check predicate on the "done" field. It's identical to DoneEQ.
+func Done(v int) predicate.KeyRotation {
+	return predicate.KeyRotation(sql.FieldEQ(FieldDone, v))
+}
+
+// Failed applies equality check predicate on the "failed" field. It's identical to FailedEQ.
+func Failed(v int) predicate.KeyRotation {
+	return predicate.KeyRotation(sql.FieldEQ(FieldFailed, v))
+}
+
+// Error applies equality check predicate on the "error" field. It's identical to ErrorEQ.
+func Error(v string) predicate.KeyRotation {
+	return predicate.KeyRotation(sql.FieldEQ(FieldError, v))
+}
+
+// CreatedAt applies equality check predicate on the "created_at" field. It's identical to CreatedAtEQ.
+func CreatedAt(v time.Time) predicate.KeyRotation {
+	return predicate.KeyRotation(sql.FieldEQ(FieldCreatedAt, v))
+}
+
+// UpdatedAt applies equality check predicate on the "updated_at" field. It's identical to UpdatedAtEQ.
+func UpdatedAt(v time.Time) predicate.KeyRotation {
+	return predicate.KeyRotation(sql.FieldEQ(FieldUpdatedAt, v))
+}
+
+// FinishedAt applies equality check predicate on the "finished_at" field. It's identical to FinishedAtEQ.
+func FinishedAt(v time.Time) predicate.KeyRotation {
+	return predicate.KeyRotation(sql.FieldEQ(FieldFinishedAt, v))
+}
+
+// KeyIDEQ applies the EQ predicate on the "key_id" field.
+func KeyIDEQ(v string) predicate.KeyRotation {
+	return predicate.KeyRotation(sql.FieldEQ(FieldKeyID, v))
+}
+
+// KeyIDNEQ applies the NEQ predicate on the "key_id" field.
+func KeyIDNEQ(v string) predicate.KeyRotation {
+	return predicate.KeyRotation(sql.FieldNEQ(FieldKeyID, v))
+}
+
+// KeyIDIn applies the In predicate on the "key_id" field.
+func KeyIDIn(vs ...string) predicate.KeyRotation {
+	return predicate.KeyRotation(sql.FieldIn(FieldKeyID, vs...))
+}
+
+// KeyIDNotIn applies the NotIn predicate on the "key_id" field.
+func KeyIDNotIn(vs ...string) predicate.KeyRotation {
+	return predicate.KeyRotation(sql.FieldNotIn(FieldKeyID, vs...))
+}
+
+// KeyIDGT applies the GT predicate on the "key_id" field.
+func KeyIDGT(v string) predicate.KeyRotation {
+	return predicate.KeyRotation(sql.FieldGT(FieldKeyID, v))
+}
+
+// KeyIDGTE applies the GTE predicate on the "key_id" field.
+func KeyIDGTE(v string) predicate.KeyRotation {
+	return predicate.KeyRotation(sql.FieldGTE(FieldKeyID, v))
+}
+
+// KeyIDLT applies the LT predicate on the "key_id" field.
+func KeyIDLT(v string) predicate.KeyRotation {
+	return predicate.KeyRotation(sql.FieldLT(FieldKeyID, v))
+}
+
+// KeyIDLTE applies the LTE predicate on the "key_id" field.
+func KeyIDLTE(v string) predicate.KeyRotation {
+	return predicate.KeyRotation(sql.FieldLTE(FieldKeyID, v))
+}
+
+// KeyIDContains applies the Contains predicate on the "key_id" field.
+func KeyIDContains(v string) predicate.KeyRotation {
+	return predicate.KeyRotation(sql.FieldContains(FieldKeyID, v))
+}
+
+// KeyIDHasPrefix applies the HasPrefix predicate on the "key_id" field.
+func KeyIDHasPrefix(v string) predicate.KeyRotation {
+	return predicate.KeyRotation(sql.FieldHasPrefix(FieldKeyID, v))
+}
+
+// KeyIDHasSuffix applies the HasSuffix predicate on the "key_id" field.
+func KeyIDHasSuffix(v string) predicate.KeyRotation {
+	return predicate.KeyRotation(sql.FieldHasSuffix(FieldKeyID, v))
+}
+
+// KeyIDEqualFold applies the EqualFold predicate on the "key_id" field.
+func KeyIDEqualFold(v string) predicate.KeyRotation {
+	return predicate.KeyRotation(sql.FieldEqualFold(FieldKeyID, v))
+}
+
+// KeyIDContainsFold applies the ContainsFold predicate on the "key_id" field.
+func KeyIDContainsFold(v string) predicate.KeyRotation {
+	return predicate.KeyRotation(sql.FieldContainsFold(FieldKeyID, v))
+}
+
+// StatusEQ applies the EQ predicate on the "status" field.
+func StatusEQ(v Status) predicate.KeyRotation {
+	return predicate.KeyRotation(sql.FieldEQ(FieldStatus, v))
+}
+
+// StatusNEQ applies the NEQ predicate on the "status" field.
+func StatusNEQ(v Status) predicate.KeyRotation {
+	return predicate.KeyRotation(sql.FieldNEQ(FieldStatus, v))
+}
+
+// StatusIn applies the In predicate on the "status" field.
+func StatusIn(vs ...Status) predicate.KeyRotation {
+	return predicate.KeyRotation(sql.FieldIn(FieldStatus, vs...))
+}
+
+// StatusNotIn applies the NotIn predicate on the "status" field.
+func StatusNotIn(vs ...Status) predicate.KeyRotation {
+	return predicate.KeyRotation(sql.FieldNotIn(FieldStatus, vs...))
+}
+
+// TotalEQ applies the EQ predicate on the "total" field.
+func TotalEQ(v int) predicate.KeyRotation {
+	return predicate.KeyRotation(sql.FieldEQ(FieldTotal, v))
+}
+
+// TotalNEQ applies the NEQ predicate on the "total" field.
+func TotalNEQ(v int) predicate.KeyRotation {
+	return predicate.KeyRotation(sql.FieldNEQ(FieldTotal, v))
+}
+
+// TotalIn applies the In predicate on the "total" field.
+func TotalIn(vs ...int) predicate.KeyRotation {
+	return predicate.KeyRotation(sql.FieldIn(FieldTotal, vs...))
+}
+
+// TotalNotIn applies the NotIn predicate on the "total" field.
+func TotalNotIn(vs ...int) predicate.KeyRotation {
+	return predicate.KeyRotation(sql.FieldNotIn(FieldTotal, vs...))
+}
+
+// TotalGT applies the GT predicate on the "total" field.
+func TotalGT(v int) predicate.KeyRotation {
+	return predicate.KeyRotation(sql.FieldGT(FieldTotal, v))
+}
+
+// TotalGTE applies the GTE predicate on the "total" field.
+func TotalGTE(v int) predicate.KeyRotation {
+	return predicate.KeyRotation(sql.FieldGTE(FieldTotal, v))
+}
+
+// TotalLT applies the LT predicate on the "total" field.
+func TotalLT(v int) predicate.KeyRotation {
+	return predicate.KeyRotation(sql.FieldLT(FieldTotal, v))
+}
+
+// TotalLTE applies the LTE predicate on the "total" field.
+func TotalLTE(v int) predicate.KeyRotation {
+	return predicate.KeyRotation(sql.FieldLTE(FieldTotal, v))
+}
+
+// DoneEQ applies the EQ predicate on the "done" field.
+func DoneEQ(v int) predicate.KeyRotation {
+	return predicate.KeyRotation(sql.FieldEQ(FieldDone, v))
+}
+
+// DoneNEQ applies the NEQ predicate on the "done" field.
+func DoneNEQ(v int) predicate.KeyRotation {
+	return predicate.KeyRotation(sql.FieldNEQ(FieldDone, v))
+}
+
+// DoneIn applies the In predicate on the "done" field.
+func DoneIn(vs ...int) predicate.KeyRotation {
+	return predicate.KeyRotation(sql.FieldIn(FieldDone, vs...))
+}
+
+// DoneNotIn applies the NotIn predicate on the "done" field.
+func DoneNotIn(vs ...int) predicate.KeyRotation {
+	return predicate.KeyRotation(sql.FieldNotIn(FieldDone, vs...))
+}
+
+// DoneGT applies the GT predicate on the "done" field.
+func DoneGT(v int) predicate.KeyRotation {
+	return predicate.KeyRotation(sql.FieldGT(FieldDone, v))
+}
+
+// DoneGTE applies the GTE predicate on the "done" field.
+func DoneGTE(v int) predicate.KeyRotation {
+	return predicate.KeyRotation(sql.FieldGTE(FieldDone, v))
+}
+
+// DoneLT applies the LT predicate on the "done" field.
+func DoneLT(v int) predicate.KeyRotation {
+	return predicate.KeyRotation(sql.FieldLT(FieldDone, v))
+}
+
+// DoneLTE applies the LTE predicate on the "done" field.
+func DoneLTE(v int) predicate.KeyRotation {
+	return predicate.KeyRotation(sql.FieldLTE(FieldDone, v))
+}
+
+// FailedEQ applies the EQ predicate on the "failed" field.
+func FailedEQ(v int) predicate.KeyRotation {
+	return predicate.KeyRotation(sql.FieldEQ(FieldFailed, v))
+}
+
+// FailedNEQ applies the NEQ predicate on the "failed" field.
+func FailedNEQ(v int) predicate.KeyRotation {
+	return predicate.KeyRotation(sql.FieldNEQ(FieldFailed, v))
+}
+
+// FailedIn applies the In predicate on the "failed" field.
+func FailedIn(vs ...int) predicate.KeyRotation {
+	return predicate.KeyRotation(sql.FieldIn(FieldFailed, vs...))
+}
+
+// FailedNotIn applies the NotIn predicate on the "failed" field.
+func FailedNotIn(vs ...int) predicate.KeyRotation {
+	return predicate.KeyRotation(sql.FieldNotIn(FieldFailed, vs...))
+}
+
+// FailedGT applies the GT predicate on the "failed" field.
+func FailedGT(v int) predicate.KeyRotation {
+	return predicate.KeyRotation(sql.FieldGT(FieldFailed, v))
+}
+
+// FailedGTE applies the GTE predicate on the "failed" field.
+func FailedGTE(v int) predicate.KeyRotation {
+	return predicate.KeyRotation(sql.FieldGTE(FieldFailed, v))
+}
+
+// FailedLT applies the LT predicate on the "failed" field.
+func FailedLT(v int) predicate.KeyRotation {
+	return predicate.KeyRotation(sql.FieldLT(FieldFailed, v))
+}
+
+// FailedLTE applies the LTE predicate on the "failed" field.
+func FailedLTE(v int) predicate.KeyRotation {
+	return predicate.KeyRotation(sql.FieldLTE(FieldFailed, v))
+}
+
+// ErrorEQ applies the EQ predicate on the "error" field.
+func ErrorEQ(v string) predicate.KeyRotation {
+	return predicate.KeyRotation(sql.FieldEQ(FieldError, v))
+}
+
+// ErrorNEQ applies the NEQ predicate on the "error" field.
+func ErrorNEQ(v string) predicate.KeyRotation {
+	return predicate.KeyRotation(sql.FieldNEQ(FieldError, v))
+}
+
+// ErrorIn applies the In predicate on the "error" field.
+func ErrorIn(vs ...string) predicate.KeyRotation {
+	return predicate.KeyRotation(sql.FieldIn(FieldError, vs...))
+}
+
+// ErrorNotIn applies the NotIn predicate on the "error" field.
+func ErrorNotIn(vs ...string) predicate.KeyRotation {
+	return predicate.KeyRotation(sql.FieldNotIn(FieldError, vs...))
+}
+
+// ErrorGT applies the GT predicate on the "error" field.
+func ErrorGT(v string) predicate.KeyRotation {
+	return predicate.KeyRotation(sql.FieldGT(FieldError, v))
+}
+
+// ErrorGTE applies the GTE predicate on the "error" field.
+func ErrorGTE(v string) predicate.KeyRotation {
+	return predicate.KeyRotation(sql.FieldGTE(FieldError, v))
+}
+
+// ErrorLT applies the LT predicate on the "error" field.
+func ErrorLT(v string) predicate.KeyRotation {
+	return predicate.KeyRotation(sql.FieldLT(FieldError, v))
+}
+
+// ErrorLTE applies the LTE predicate on the "error" field.
+func ErrorLTE(v string) predicate.KeyRotation {
+	return predicate.KeyRotation(sql.FieldLTE(FieldError, v))
+}
+
+// ErrorContains applies the Contains predicate on the "error" field.
+func ErrorContains(v string) predicate.KeyRotation {
+	return predicate.KeyRotation(sql.FieldContains(FieldError, v))
+}
+
+// ErrorHasPrefix applies the HasPrefix predicate on the "error" field.
+func ErrorHasPrefix(v string) predicate.KeyRotation {
+	return predicate.KeyRotation(sql.FieldHasPrefix(FieldError, v))
+}
+
+// ErrorHasSuffix applies the HasSuffix predicate on the "error" field.
+func ErrorHasSuffix(v string) predicate.KeyRotation {
+	return predicate.KeyRotation(sql.FieldHasSuffix(FieldError, v))
+}
+
+// ErrorIsNil applies the IsNil predicate on the "error" field.
+func ErrorIsNil() predicate.KeyRotation {
+	return predicate.KeyRotation(sql.FieldIsNull(FieldError))
+}
+
+// ErrorNotNil applies the NotNil predicate on the "error" field.
+func ErrorNotNil() predicate.KeyRotation {
+	return predicate.KeyRotation(sql.FieldNotNull(FieldError))
+}
+
+// ErrorEqualFold applies the EqualFold predicate on the "error" field.
+func ErrorEqualFold(v string) predicate.KeyRotation {
+	return predicate.KeyRotation(sql.FieldEqualFold(FieldError, v))
+}
+
+// ErrorContainsFold applies the ContainsFold predicate on the "error" field.
+func ErrorContainsFold(v string) predicate.KeyRotation {
+	return predicate.KeyRotation(sql.FieldContainsFold(FieldError, v))
+}
+
+// CreatedAtEQ applies the EQ predicate on the "created_at" field.
+func CreatedAtEQ(v time.Time) predicate.KeyRotation {
+	return predicate.KeyRotation(sql.FieldEQ(FieldCreatedAt, v))
+}
+
+// CreatedAtNEQ applies the NEQ predicate on the "created_at" field.
+func CreatedAtNEQ(v time.Time) predicate.KeyRotation {
+	return predicate.KeyRotation(sql.FieldNEQ(FieldCreatedAt, v))
+}
+
+// CreatedAtIn applies the In predicate on the "created_at" field.
+func CreatedAtIn(vs ...time.Time) predicate.KeyRotation {
+	return predicate.KeyRotation(sql.FieldIn(FieldCreatedAt, vs...))
+}
+
+// CreatedAtNotIn applies the NotIn predicate on the "created_at" field.
+func CreatedAtNotIn(vs ...time.Time) predicate.KeyRotation {
+	return predicate.KeyRotation(sql.FieldNotIn(FieldCreatedAt, vs...))
+}
+
+// CreatedAtGT applies the GT predicate on the "created_at" field.
+func CreatedAtGT(v time.Time) predicate.KeyRotation {
+	return predicate.KeyRotation(sql.FieldGT(FieldCreatedAt, v))
+}
+
+// CreatedAtGTE applies the GTE predicate on the "created_at" field.
+func CreatedAtGTE(v time.Time) predicate.KeyRotation {
+	return predicate.KeyRotation(sql.FieldGTE(FieldCreatedAt, v))
+}
+
+// CreatedAtLT applies the LT predicate on the "created_at" field.
+func CreatedAtLT(v time.Time) predicate.KeyRotation {
+	return predicate.KeyRotation(sql.FieldLT(FieldCreatedAt, v))
+}
+
+// CreatedAtLTE applies the LTE predicate on the "created_at" field.
+func CreatedAtLTE(v time.Time) predicate.KeyRotation {
+	return predicate.KeyRotation(sql.FieldLTE(FieldCreatedAt, v))
+}
+
+// CreatedAtIsNil applies the IsNil predicate on the "created_at" field.
+func CreatedAtIsNil() predicate.KeyRotation {
+	return predicate.KeyRotation(sql.FieldIsNull(FieldCreatedAt))
+}
+
+// CreatedAtNotNil applies the NotNil predicate on the "created_at" field.
+func CreatedAtNotNil() predicate.KeyRotation {
+	return predicate.KeyRotation(sql.FieldNotNull(FieldCreatedAt))
+}
+
+// UpdatedAtEQ applies the EQ predicate on the "updated_at" field.
+func UpdatedAtEQ(v time.Time) predicate.KeyRotation {
+	return predicate.KeyRotation(sql.FieldEQ(FieldUpdatedAt, v))
+}
+
+// UpdatedAtNEQ applies the NEQ predicate on the "updated_at" field.
+func UpdatedAtNEQ(v time.Time) predicate.KeyRotation {
+	return predicate.KeyRotation(sql.FieldNEQ(FieldUpdatedAt, v))
+}
+
+// UpdatedAtIn applies the In predicate on the "updated_at" field.
+func UpdatedAtIn(vs ...time.Time) predicate.KeyRotation {
+	return predicate.KeyRotation(sql.FieldIn(FieldUpdatedAt, vs...))
+}
+
+// UpdatedAtNotIn applies the NotIn predicate on the "updated_at" field.
+func UpdatedAtNotIn(vs ...time.Time) predicate.KeyRotation {
+	return predicate.KeyRotation(sql.FieldNotIn(FieldUpdatedAt, vs...))
+}
+
+// UpdatedAtGT applies the GT predicate on the "updated_at" field.
+func UpdatedAtGT(v time.Time) predicate.KeyRotation {
+	return predicate.KeyRotation(sql.FieldGT(FieldUpdatedAt, v))
+}
+
+// UpdatedAtGTE applies the GTE predicate on the "updated_at" field.
+func UpdatedAtGTE(v time.Time) predicate.KeyRotation {
+	return predicate.KeyRotation(sql.FieldGTE(FieldUpdatedAt, v))
+}
+
+// UpdatedAtLT applies the LT predicate on the "updated_at" field.
+func UpdatedAtLT(v time.Time) predicate.KeyRotation {
+	return predicate.KeyRotation(sql.FieldLT(FieldUpdatedAt, v))
+}
+
+// UpdatedAtLTE applies the LTE predicate on the "updated_at" field.
+func UpdatedAtLTE(v time.Time) predicate.KeyRotation {
+	return predicate.KeyRotation(sql.FieldLTE(FieldUpdatedAt, v))
+}
+
+// FinishedAtEQ applies the EQ predicate on the "finished_at" field.
+func FinishedAtEQ(v time.Time) predicate.KeyRotation {
+	return predicate.KeyRotation(sql.FieldEQ(FieldFinishedAt, v))
+}
+
+// FinishedAtNEQ applies the NEQ predicate on the "finished_at" field.
+func FinishedAtNEQ(v time.Time) predicate.KeyRotation {
+	return predicate.KeyRotation(sql.FieldNEQ(FieldFinishedAt, v))
+}
+
+// FinishedAtIn applies the In predicate on the "finished_at" field.
+func FinishedAtIn(vs ...time.Time) predicate.KeyRotation {
+	return predicate.KeyRotation(sql.FieldIn(FieldFinishedAt, vs...))
+}
+
+// FinishedAtNotIn applies the NotIn predicate on the "finished_at" field.
+func FinishedAtNotIn(vs ...time.Time) predicate.KeyRotation {
+	return predicate.KeyRotation(sql.FieldNotIn(FieldFinishedAt, vs...))
+}
+
+// FinishedAtGT applies the GT predicate on the "finished_at" field.
+func FinishedAtGT(v time.Time) predicate.KeyRotation {
+	return predicate.KeyRotation(sql.FieldGT(FieldFinishedAt, v))
+}
+
+// FinishedAtGTE applies the GTE predicate on the "finished_at" field.
+func FinishedAtGTE(v time.Time) predicate.KeyRotation {
+	return predicate.KeyRotation(sql.FieldGTE(FieldFinishedAt, v))
+}
+
+// FinishedAtLT applies the LT predicate on the "finished_at" field.
+func FinishedAtLT(v time.Time) predicate.KeyRotation {
+	return predicate.KeyRotation(sql.FieldLT(FieldFinishedAt, v))
+}
+
+// FinishedAtLTE applies the LTE predicate on the "finished_at" field.
+func FinishedAtLTE(v time.Time) predicate.KeyRotation {
+	return predicate.KeyRotation(sql.FieldLTE(FieldFinishedAt, v))
+}
+
+// FinishedAtIsNil applies the IsNil predicate on the "finished_at" field.
+func FinishedAtIsNil() predicate.KeyRotation {
+	return predicate.KeyRotation(sql.FieldIsNull(FieldFinishedAt))
+}
+
+// FinishedAtNotNil applies the NotNil predicate on the "finished_at" field.
+func FinishedAtNotNil() predicate.KeyRotation {
+	return predicate.KeyRotation(sql.FieldNotNull(FieldFinishedAt))
+}
+
+// And groups predicates with the AND operator between them.
+func And(predicates ...predicate.KeyRotation) predicate.KeyRotation {
+	return predicate.KeyRotation(sql.AndPredicates(predicates...))
+}
+
+// Or groups predicates with the OR operator between them.
+func Or(predicates ...predicate.KeyRotation) predicate.KeyRotation {
+	return predicate.KeyRotation(sql.OrPredicates(predicates...))
+}
+
+// Not applies the not operator on the given predicate.
+func Not(p predicate.KeyRotation) predicate.KeyRotation {
+	return predicate.KeyRotation(sql.NotPredicates(p))
+}
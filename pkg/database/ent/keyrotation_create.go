@@ -0,0 +1,1094 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/schema/field"
+	"github.com/lebleuciel/maani/pkg/database/ent/keyrotation"
+)
+
+// KeyRotationCreate is the builder for creating a KeyRotation entity.
+type KeyRotationCreate struct {
+	config
+	mutation *KeyRotationMutation
+	hooks    []Hook
+	conflict []sql.ConflictOption
+}
+
+// SetKeyID sets the "key_id" field.
+func (krc *KeyRotationCreate) SetKeyID(s string) *KeyRotationCreate {
+	krc.mutation.SetKeyID(s)
+	return krc
+}
+
+// SetStatus sets the "status" field.
+func (krc *KeyRotationCreate) SetStatus(k keyrotation.Status) *KeyRotationCreate {
+	krc.mutation.SetStatus(k)
+	return krc
+}
+
+// SetNillableStatus sets the "status" field if the given value is not nil.
+func (krc *KeyRotationCreate) SetNillableStatus(k *keyrotation.Status) *KeyRotationCreate {
+	if k != nil {
+		krc.SetStatus(*k)
+	}
+	return krc
+}
+
+// SetTotal sets the "total" field.
+func (krc *KeyRotationCreate) SetTotal(i int) *KeyRotationCreate {
+	krc.mutation.SetTotal(i)
+	return krc
+}
+
+// SetNillableTotal sets the "total" field if the given value is not nil.
+func (krc *KeyRotationCreate) SetNillableTotal(i *int) *KeyRotationCreate {
+	if i != nil {
+		krc.SetTotal(*i)
+	}
+	return krc
+}
+
+// SetDone sets the "done" field.
+func (krc *KeyRotationCreate) SetDone(i int) *KeyRotationCreate {
+	krc.mutation.SetDone(i)
+	return krc
+}
+
+// SetNillableDone sets the "done" field if the given value is not nil.
+func (krc *KeyRotationCreate) SetNillableDone(i *int) *KeyRotationCreate {
+	if i != nil {
+		krc.SetDone(*i)
+	}
+	return krc
+}
+
+// SetFailed sets the "failed" field.
+func (krc *KeyRotationCreate) SetFailed(i int) *KeyRotationCreate {
+	krc.mutation.SetFailed(i)
+	return krc
+}
+
+// SetNillableFailed sets the "failed" field if the given value is not nil.
+func (krc *KeyRotationCreate) SetNillableFailed(i *int) *KeyRotationCreate {
+	if i != nil {
+		krc.SetFailed(*i)
+	}
+	return krc
+}
+
+// SetError sets the "error" field.
+func (krc *KeyRotationCreate) SetError(s string) *KeyRotationCreate {
+	krc.mutation.SetError(s)
+	return krc
+}
+
+// SetNillableError sets the "error" field if the given value is not nil.
+func (krc *KeyRotationCreate) SetNillableError(s *string) *KeyRotationCreate {
+	if s != nil {
+		krc.SetError(*s)
+	}
+	return krc
+}
+
+// SetCreatedAt sets the "created_at" field.
+func (krc *KeyRotationCreate) SetCreatedAt(t time.Time) *KeyRotationCreate {
+	krc.mutation.SetCreatedAt(t)
+	return krc
+}
+
+// SetNillableCreatedAt sets the "created_at" field if the given value is not nil.
+func (krc *KeyRotationCreate) SetNillableCreatedAt(t *time.Time) *KeyRotationCreate {
+	if t != nil {
+		krc.SetCreatedAt(*t)
+	}
+	return krc
+}
+
+// SetUpdatedAt sets the "updated_at" field.
+func (krc *KeyRotationCreate) SetUpdatedAt(t time.Time) *KeyRotationCreate {
+	krc.mutation.SetUpdatedAt(t)
+	return krc
+}
+
+// SetNillableUpdatedAt sets the "updated_at" field if the given value is not nil.
+func (krc *KeyRotationCreate) SetNillableUpdatedAt(t *time.Time) *KeyRotationCreate {
+	if t != nil {
+		krc.SetUpdatedAt(*t)
+	}
+	return krc
+}
+
+// SetFinishedAt sets the "finished_at" field.
+func (krc *KeyRotationCreate) SetFinishedAt(t time.Time) *KeyRotationCreate {
+	krc.mutation.SetFinishedAt(t)
+	return krc
+}
+
+// SetNillableFinishedAt sets the "finished_at" field if the given value is not nil.
+func (krc *KeyRotationCreate) SetNillableFinishedAt(t *time.Time) *KeyRotationCreate {
+	if t != nil {
+		krc.SetFinishedAt(*t)
+	}
+	return krc
+}
+
+// Mutation returns the KeyRotationMutation object of the builder.
+func (krc *KeyRotationCreate) Mutation() *KeyRotationMutation {
+	return krc.mutation
+}
+
+// Save creates the KeyRotation in the database.
+func (krc *KeyRotationCreate) Save(ctx context.Context) (*KeyRotation, error) {
+	krc.defaults()
+	return withHooks(ctx, krc.sqlSave, krc.mutation, krc.hooks)
+}
+
+// SaveX calls Save and panics if Save returns an error.
+func (krc *KeyRotationCreate) SaveX(ctx context.Context) *KeyRotation {
+	v, err := krc.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
+
+// Exec executes the query.
+func (krc *KeyRotationCreate) Exec(ctx context.Context) error {
+	_, err := krc.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (krc *KeyRotationCreate) ExecX(ctx context.Context) {
+	if err := krc.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// defaults sets the default values of the builder before save.
+func (krc *KeyRotationCreate) defaults() {
+	if _, ok := krc.mutation.Status(); !ok {
+		v := keyrotation.DefaultStatus
+		krc.mutation.SetStatus(v)
+	}
+	if _, ok := krc.mutation.Total(); !ok {
+		v := keyrotation.DefaultTotal
+		krc.mutation.SetTotal(v)
+	}
+	if _, ok := krc.mutation.Done(); !ok {
+		v := keyrotation.DefaultDone
+		krc.mutation.SetDone(v)
+	}
+	if _, ok := krc.mutation.Failed(); !ok {
+		v := keyrotation.DefaultFailed
+		krc.mutation.SetFailed(v)
+	}
+	if _, ok := krc.mutation.CreatedAt(); !ok {
+		v := keyrotation.DefaultCreatedAt()
+		krc.mutation.SetCreatedAt(v)
+	}
+	if _, ok := krc.mutation.UpdatedAt(); !ok {
+		v := keyrotation.DefaultUpdatedAt()
+		krc.mutation.SetUpdatedAt(v)
+	}
+}
+
+// check runs all checks and user-defined validators on the builder.
+func (krc *KeyRotationCreate) check() error {
+	if _, ok := krc.mutation.KeyID(); !ok {
+		return &ValidationError{Name: "key_id", err: errors.New(`ent: missing required field "KeyRotation.key_id"`)}
+	}
+	if v, ok := krc.mutation.KeyID(); ok {
+		if err := keyrotation.KeyIDValidator(v); err != nil {
+			return &ValidationError{Name: "key_id", err: fmt.Errorf(`ent: validator failed for field "KeyRotation.key_id": %w`, err)}
+		}
+	}
+	if _, ok := krc.mutation.Status(); !ok {
+		return &ValidationError{Name: "status", err: errors.New(`ent: missing required field "KeyRotation.status"`)}
+	}
+	if v, ok := krc.mutation.Status(); ok {
+		if err := keyrotation.StatusValidator(v); err != nil {
+			return &ValidationError{Name: "status", err: fmt.Errorf(`ent: validator failed for field "KeyRotation.status": %w`, err)}
+		}
+	}
+	if _, ok := krc.mutation.Total(); !ok {
+		return &ValidationError{Name: "total", err: errors.New(`ent: missing required field "KeyRotation.total"`)}
+	}
+	if _, ok := krc.mutation.Done(); !ok {
+		return &ValidationError{Name: "done", err: errors.New(`ent: missing required field "KeyRotation.done"`)}
+	}
+	if _, ok := krc.mutation.Failed(); !ok {
+		return &ValidationError{Name: "failed", err: errors.New(`ent: missing required field "KeyRotation.failed"`)}
+	}
+	if _, ok := krc.mutation.UpdatedAt(); !ok {
+		return &ValidationError{Name: "updated_at", err: errors.New(`ent: missing required field "KeyRotation.updated_at"`)}
+	}
+	return nil
+}
+
+func (krc *KeyRotationCreate) sqlSave(ctx context.Context) (*KeyRotation, error) {
+	if err := krc.check(); err != nil {
+		return nil, err
+	}
+	_node, _spec := krc.createSpec()
+	if err := sqlgraph.CreateNode(ctx, krc.driver, _spec); err != nil {
+		if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return nil, err
+	}
+	id := _spec.ID.Value.(int64)
+	_node.ID = int(id)
+	krc.mutation.id = &_node.ID
+	krc.mutation.done = true
+	return _node, nil
+}
+
+func (krc *KeyRotationCreate) createSpec() (*KeyRotation, *sqlgraph.CreateSpec) {
+	var (
+		_node = &KeyRotation{config: krc.config}
+		_spec = sqlgraph.NewCreateSpec(keyrotation.Table, sqlgraph.NewFieldSpec(keyrotation.FieldID, field.TypeInt))
+	)
+	_spec.OnConflict = krc.conflict
+	if value, ok := krc.mutation.KeyID(); ok {
+		_spec.SetField(keyrotation.FieldKeyID, field.TypeString, value)
+		_node.KeyID = value
+	}
+	if value, ok := krc.mutation.Status(); ok {
+		_spec.SetField(keyrotation.FieldStatus, field.TypeEnum, value)
+		_node.Status = value
+	}
+	if value, ok := krc.mutation.Total(); ok {
+		_spec.SetField(keyrotation.FieldTotal, field.TypeInt, value)
+		_node.Total = value
+	}
+	if value, ok := krc.mutation.Done(); ok {
+		_spec.SetField(keyrotation.FieldDone, field.TypeInt, value)
+		_node.Done = value
+	}
+	if value, ok := krc.mutation.Failed(); ok {
+		_spec.SetField(keyrotation.FieldFailed, field.TypeInt, value)
+		_node.Failed = value
+	}
+	if value, ok := krc.mutation.Error(); ok {
+		_spec.SetField(keyrotation.FieldError, field.TypeString, value)
+		_node.Error = value
+	}
+	if value, ok := krc.mutation.CreatedAt(); ok {
+		_spec.SetField(keyrotation.FieldCreatedAt, field.TypeTime, value)
+		_node.CreatedAt = &value
+	}
+	if value, ok := krc.mutation.UpdatedAt(); ok {
+		_spec.SetField(keyrotation.FieldUpdatedAt, field.TypeTime, value)
+		_node.UpdatedAt = value
+	}
+	if value, ok := krc.mutation.FinishedAt(); ok {
+		_spec.SetField(keyrotation.FieldFinishedAt, field.TypeTime, value)
+		_node.FinishedAt = &value
+	}
+	return _node, _spec
+}
+
+// OnConflict allows configuring the `ON CONFLICT` / `ON DUPLICATE KEY` clause
+// of the `INSERT` statement. For example:
+//
+//	client.KeyRotation.Create().
+//		SetKeyID(v).
+//		OnConflict(
+//			// Update the row with the new values
+//			// the was proposed for insertion.
+//			sql.ResolveWithNewValues(),
+//		).
+//		// Override some of the fields with custom
+//		// update values.
+//		Update(func(u *ent.KeyRotationUpsert) {
+//			SetKeyID(v+v).
+//		}).
+//		Exec(ctx)
+func (krc *KeyRotationCreate) OnConflict(opts ...sql.ConflictOption) *KeyRotationUpsertOne {
+	krc.conflict = opts
+	return &KeyRotationUpsertOne{
+		create: krc,
+	}
+}
+
+// OnConflictColumns calls `OnConflict` and configures the columns
+// as conflict target. Using this option is equivalent to using:
+//
+//	client.KeyRotation.Create().
+//		OnConflict(sql.ConflictColumns(columns...)).
+//		Exec(ctx)
+func (krc *KeyRotationCreate) OnConflictColumns(columns ...string) *KeyRotationUpsertOne {
+	krc.conflict = append(krc.conflict, sql.ConflictColumns(columns...))
+	return &KeyRotationUpsertOne{
+		create: krc,
+	}
+}
+
+type (
+	// KeyRotationUpsertOne is the builder for "upsert"-ing
+	//  one KeyRotation node.
+	KeyRotationUpsertOne struct {
+		create *KeyRotationCreate
+	}
+
+	// KeyRotationUpsert is the "OnConflict" setter.
+	KeyRotationUpsert struct {
+		*sql.UpdateSet
+	}
+)
+
+// SetKeyID sets the "key_id" field.
+func (u *KeyRotationUpsert) SetKeyID(v string) *KeyRotationUpsert {
+	u.Set(keyrotation.FieldKeyID, v)
+	return u
+}
+
+// UpdateKeyID sets the "key_id" field to the value that was provided on create.
+func (u *KeyRotationUpsert) UpdateKeyID() *KeyRotationUpsert {
+	u.SetExcluded(keyrotation.FieldKeyID)
+	return u
+}
+
+// SetStatus sets the "status" field.
+func (u *KeyRotationUpsert) SetStatus(v keyrotation.Status) *KeyRotationUpsert {
+	u.Set(keyrotation.FieldStatus, v)
+	return u
+}
+
+// UpdateStatus sets the "status" field to the value that was provided on create.
+func (u *KeyRotationUpsert) UpdateStatus() *KeyRotationUpsert {
+	u.SetExcluded(keyrotation.FieldStatus)
+	return u
+}
+
+// SetTotal sets the "total" field.
+func (u *KeyRotationUpsert) SetTotal(v int) *KeyRotationUpsert {
+	u.Set(keyrotation.FieldTotal, v)
+	return u
+}
+
+// UpdateTotal sets the "total" field to the value that was provided on create.
+func (u *KeyRotationUpsert) UpdateTotal() *KeyRotationUpsert {
+	u.SetExcluded(keyrotation.FieldTotal)
+	return u
+}
+
+// AddTotal adds v to the "total" field.
+func (u *KeyRotationUpsert) AddTotal(v int) *KeyRotationUpsert {
+	u.Add(keyrotation.FieldTotal, v)
+	return u
+}
+
+// SetDone sets the "done" field.
+func (u *KeyRotationUpsert) SetDone(v int) *KeyRotationUpsert {
+	u.Set(keyrotation.FieldDone, v)
+	return u
+}
+
+// UpdateDone sets the "done" field to the value that was provided on create.
+func (u *KeyRotationUpsert) UpdateDone() *KeyRotationUpsert {
+	u.SetExcluded(keyrotation.FieldDone)
+	return u
+}
+
+// AddDone adds v to the "done" field.
+func (u *KeyRotationUpsert) AddDone(v int) *KeyRotationUpsert {
+	u.Add(keyrotation.FieldDone, v)
+	return u
+}
+
+// SetFailed sets the "failed" field.
+func (u *KeyRotationUpsert) SetFailed(v int) *KeyRotationUpsert {
+	u.Set(keyrotation.FieldFailed, v)
+	return u
+}
+
+// UpdateFailed sets the "failed" field to the value that was provided on create.
+func (u *KeyRotationUpsert) UpdateFailed() *KeyRotationUpsert {
+	u.SetExcluded(keyrotation.FieldFailed)
+	return u
+}
+
+// AddFailed adds v to the "failed" field.
+func (u *KeyRotationUpsert) AddFailed(v int) *KeyRotationUpsert {
+	u.Add(keyrotation.FieldFailed, v)
+	return u
+}
+
+// SetError sets the "error" field.
+func (u *KeyRotationUpsert) SetError(v string) *KeyRotationUpsert {
+	u.Set(keyrotation.FieldError, v)
+	return u
+}
+
+// UpdateError sets the "error" field to the value that was provided on create.
+func (u *KeyRotationUpsert) UpdateError() *KeyRotationUpsert {
+	u.SetExcluded(keyrotation.FieldError)
+	return u
+}
+
+// ClearError clears the value of the "error" field.
+func (u *KeyRotationUpsert) ClearError() *KeyRotationUpsert {
+	u.SetNull(keyrotation.FieldError)
+	return u
+}
+
+// SetCreatedAt sets the "created_at" field.
+func (u *KeyRotationUpsert) SetCreatedAt(v time.Time) *KeyRotationUpsert {
+	u.Set(keyrotation.FieldCreatedAt, v)
+	return u
+}
+
+// UpdateCreatedAt sets the "created_at" field to the value that was provided on create.
+func (u *KeyRotationUpsert) UpdateCreatedAt() *KeyRotationUpsert {
+	u.SetExcluded(keyrotation.FieldCreatedAt)
+	return u
+}
+
+// ClearCreatedAt clears the value of the "created_at" field.
+func (u *KeyRotationUpsert) ClearCreatedAt() *KeyRotationUpsert {
+	u.SetNull(keyrotation.FieldCreatedAt)
+	return u
+}
+
+// SetUpdatedAt sets the "updated_at" field.
+func (u *KeyRotationUpsert) SetUpdatedAt(v time.Time) *KeyRotationUpsert {
+	u.Set(keyrotation.FieldUpdatedAt, v)
+	return u
+}
+
+// UpdateUpdatedAt sets the "updated_at" field to the value that was provided on create.
+func (u *KeyRotationUpsert) UpdateUpdatedAt() *KeyRotationUpsert {
+	u.SetExcluded(keyrotation.FieldUpdatedAt)
+	return u
+}
+
+// SetFinishedAt sets the "finished_at" field.
+func (u *KeyRotationUpsert) SetFinishedAt(v time.Time) *KeyRotationUpsert {
+	u.Set(keyrotation.FieldFinishedAt, v)
+	return u
+}
+
+// UpdateFinishedAt sets the "finished_at" field to the value that was provided on create.
+func (u *KeyRotationUpsert) UpdateFinishedAt() *KeyRotationUpsert {
+	u.SetExcluded(keyrotation.FieldFinishedAt)
+	return u
+}
+
+// ClearFinishedAt clears the value of the "finished_at" field.
+func (u *KeyRotationUpsert) ClearFinishedAt() *KeyRotationUpsert {
+	u.SetNull(keyrotation.FieldFinishedAt)
+	return u
+}
+
+// UpdateNewValues updates the mutable fields using the new values that were set on create.
+// Using this option is equivalent to using:
+//
+//	client.KeyRotation.Create().
+//		OnConflict(
+//			sql.ResolveWithNewValues(),
+//		).
+//		Exec(ctx)
+func (u *KeyRotationUpsertOne) UpdateNewValues() *KeyRotationUpsertOne {
+	u.create.conflict = append(u.create.conflict, sql.ResolveWithNewValues())
+	return u
+}
+
+// Ignore sets each column to itself in case of conflict.
+// Using this option is equivalent to using:
+//
+//	client.KeyRotation.Create().
+//	    OnConflict(sql.ResolveWithIgnore()).
+//	    Exec(ctx)
+func (u *KeyRotationUpsertOne) Ignore() *KeyRotationUpsertOne {
+	u.create.conflict = append(u.create.conflict, sql.ResolveWithIgnore())
+	return u
+}
+
+// DoNothing configures the conflict_action to `DO NOTHING`.
+// Supported only by SQLite and PostgreSQL.
+func (u *KeyRotationUpsertOne) DoNothing() *KeyRotationUpsertOne {
+	u.create.conflict = append(u.create.conflict, sql.DoNothing())
+	return u
+}
+
+// Update allows overriding fields `UPDATE` values. See the KeyRotationCreate.OnConflict
+// documentation for more info.
+func (u *KeyRotationUpsertOne) Update(set func(*KeyRotationUpsert)) *KeyRotationUpsertOne {
+	u.create.conflict = append(u.create.conflict, sql.ResolveWith(func(update *sql.UpdateSet) {
+		set(&KeyRotationUpsert{UpdateSet: update})
+	}))
+	return u
+}
+
+// SetKeyID sets the "key_id" field.
+func (u *KeyRotationUpsertOne) SetKeyID(v string) *KeyRotationUpsertOne {
+	return u.Update(func(s *KeyRotationUpsert) {
+		s.SetKeyID(v)
+	})
+}
+
+// UpdateKeyID sets the "key_id" field to the value that was provided on create.
+func (u *KeyRotationUpsertOne) UpdateKeyID() *KeyRotationUpsertOne {
+	return u.Update(func(s *KeyRotationUpsert) {
+		s.UpdateKeyID()
+	})
+}
+
+// SetStatus sets the "status" field.
+func (u *KeyRotationUpsertOne) SetStatus(v keyrotation.Status) *KeyRotationUpsertOne {
+	return u.Update(func(s *KeyRotationUpsert) {
+		s.SetStatus(v)
+	})
+}
+
+// UpdateStatus sets the "status" field to the value that was provided on create.
+func (u *KeyRotationUpsertOne) UpdateStatus() *KeyRotationUpsertOne {
+	return u.Update(func(s *KeyRotationUpsert) {
+		s.UpdateStatus()
+	})
+}
+
+// SetTotal sets the "total" field.
+func (u *KeyRotationUpsertOne) SetTotal(v int) *KeyRotationUpsertOne {
+	return u.Update(func(s *KeyRotationUpsert) {
+		s.SetTotal(v)
+	})
+}
+
+// AddTotal adds v to the "total" field.
+func (u *KeyRotationUpsertOne) AddTotal(v int) *KeyRotationUpsertOne {
+	return u.Update(func(s *KeyRotationUpsert) {
+		s.AddTotal(v)
+	})
+}
+
+// UpdateTotal sets the "total" field to the value that was provided on create.
+func (u *KeyRotationUpsertOne) UpdateTotal() *KeyRotationUpsertOne {
+	return u.Update(func(s *KeyRotationUpsert) {
+		s.UpdateTotal()
+	})
+}
+
+// SetDone sets the "done" field.
+func (u *KeyRotationUpsertOne) SetDone(v int) *KeyRotationUpsertOne {
+	return u.Update(func(s *KeyRotationUpsert) {
+		s.SetDone(v)
+	})
+}
+
+// AddDone adds v to the "done" field.
+func (u *KeyRotationUpsertOne) AddDone(v int) *KeyRotationUpsertOne {
+	return u.Update(func(s *KeyRotationUpsert) {
+		s.AddDone(v)
+	})
+}
+
+// UpdateDone sets the "done" field to the value that was provided on create.
+func (u *KeyRotationUpsertOne) UpdateDone() *KeyRotationUpsertOne {
+	return u.Update(func(s *KeyRotationUpsert) {
+		s.UpdateDone()
+	})
+}
+
+// SetFailed sets the "failed" field.
+func (u *KeyRotationUpsertOne) SetFailed(v int) *KeyRotationUpsertOne {
+	return u.Update(func(s *KeyRotationUpsert) {
+		s.SetFailed(v)
+	})
+}
+
+// AddFailed adds v to the "failed" field.
+func (u *KeyRotationUpsertOne) AddFailed(v int) *KeyRotationUpsertOne {
+	return u.Update(func(s *KeyRotationUpsert) {
+		s.AddFailed(v)
+	})
+}
+
+// UpdateFailed sets the "failed" field to the value that was provided on create.
+func (u *KeyRotationUpsertOne) UpdateFailed() *KeyRotationUpsertOne {
+	return u.Update(func(s *KeyRotationUpsert) {
+		s.UpdateFailed()
+	})
+}
+
+// SetError sets the "error" field.
+func (u *KeyRotationUpsertOne) SetError(v string) *KeyRotationUpsertOne {
+	return u.Update(func(s *KeyRotationUpsert) {
+		s.SetError(v)
+	})
+}
+
+// UpdateError sets the "error" field to the value that was provided on create.
+func (u *KeyRotationUpsertOne) UpdateError() *KeyRotationUpsertOne {
+	return u.Update(func(s *KeyRotationUpsert) {
+		s.UpdateError()
+	})
+}
+
+// ClearError clears the value of the "error" field.
+func (u *KeyRotationUpsertOne) ClearError() *KeyRotationUpsertOne {
+	return u.Update(func(s *KeyRotationUpsert) {
+		s.ClearError()
+	})
+}
+
+// SetCreatedAt sets the "created_at" field.
+func (u *KeyRotationUpsertOne) SetCreatedAt(v time.Time) *KeyRotationUpsertOne {
+	return u.Update(func(s *KeyRotationUpsert) {
+		s.SetCreatedAt(v)
+	})
+}
+
+// UpdateCreatedAt sets the "created_at" field to the value that was provided on create.
+func (u *KeyRotationUpsertOne) UpdateCreatedAt() *KeyRotationUpsertOne {
+	return u.Update(func(s *KeyRotationUpsert) {
+		s.UpdateCreatedAt()
+	})
+}
+
+// ClearCreatedAt clears the value of the "created_at" field.
+func (u *KeyRotationUpsertOne) ClearCreatedAt() *KeyRotationUpsertOne {
+	return u.Update(func(s *KeyRotationUpsert) {
+		s.ClearCreatedAt()
+	})
+}
+
+// SetUpdatedAt sets the "updated_at" field.
+func (u *KeyRotationUpsertOne) SetUpdatedAt(v time.Time) *KeyRotationUpsertOne {
+	return u.Update(func(s *KeyRotationUpsert) {
+		s.SetUpdatedAt(v)
+	})
+}
+
+// UpdateUpdatedAt sets the "updated_at" field to the value that was provided on create.
+func (u *KeyRotationUpsertOne) UpdateUpdatedAt() *KeyRotationUpsertOne {
+	return u.Update(func(s *KeyRotationUpsert) {
+		s.UpdateUpdatedAt()
+	})
+}
+
+// SetFinishedAt sets the "finished_at" field.
+func (u *KeyRotationUpsertOne) SetFinishedAt(v time.Time) *KeyRotationUpsertOne {
+	return u.Update(func(s *KeyRotationUpsert) {
+		s.SetFinishedAt(v)
+	})
+}
+
+// UpdateFinishedAt sets the "finished_at" field to the value that was provided on create.
+func (u *KeyRotationUpsertOne) UpdateFinishedAt() *KeyRotationUpsertOne {
+	return u.Update(func(s *KeyRotationUpsert) {
+		s.UpdateFinishedAt()
+	})
+}
+
+// ClearFinishedAt clears the value of the "finished_at" field.
+func (u *KeyRotationUpsertOne) ClearFinishedAt() *KeyRotationUpsertOne {
+	return u.Update(func(s *KeyRotationUpsert) {
+		s.ClearFinishedAt()
+	})
+}
+
+// Exec executes the query.
+func (u *KeyRotationUpsertOne) Exec(ctx context.Context) error {
+	if len(u.create.conflict) == 0 {
+		return errors.New("ent: missing options for KeyRotationCreate.OnConflict")
+	}
+	return u.create.Exec(ctx)
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (u *KeyRotationUpsertOne) ExecX(ctx context.Context) {
+	if err := u.create.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// Exec executes the UPSERT query and returns the inserted/updated ID.
+func (u *KeyRotationUpsertOne) ID(ctx context.Context) (id int, err error) {
+	node, err := u.create.Save(ctx)
+	if err != nil {
+		return id, err
+	}
+	return node.ID, nil
+}
+
+// IDX is like ID, but panics if an error occurs.
+func (u *KeyRotationUpsertOne) IDX(ctx context.Context) int {
+	id, err := u.ID(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return id
+}
+
+// KeyRotationCreateBulk is the builder for creating many KeyRotation entities in bulk.
+type KeyRotationCreateBulk struct {
+	config
+	err      error
+	builders []*KeyRotationCreate
+	conflict []sql.ConflictOption
+}
+
+// Save creates the KeyRotation entities in the database.
+func (krcb *KeyRotationCreateBulk) Save(ctx context.Context) ([]*KeyRotation, error) {
+	if krcb.err != nil {
+		return nil, krcb.err
+	}
+	specs := make([]*sqlgraph.CreateSpec, len(krcb.builders))
+	nodes := make([]*KeyRotation, len(krcb.builders))
+	mutators := make([]Mutator, len(krcb.builders))
+	for i := range krcb.builders {
+		func(i int, root context.Context) {
+			builder := krcb.builders[i]
+			builder.defaults()
+			var mut Mutator = MutateFunc(func(ctx context.Context, m Mutation) (Value, error) {
+				mutation, ok := m.(*KeyRotationMutation)
+				if !ok {
+					return nil, fmt.Errorf("unexpected mutation type %T", m)
+				}
+				if err := builder.check(); err != nil {
+					return nil, err
+				}
+				builder.mutation = mutation
+				var err error
+				nodes[i], specs[i] = builder.createSpec()
+				if i < len(mutators)-1 {
+					_, err = mutators[i+1].Mutate(root, krcb.builders[i+1].mutation)
+				} else {
+					spec := &sqlgraph.BatchCreateSpec{Nodes: specs}
+					spec.OnConflict = krcb.conflict
+					// Invoke the actual operation on the latest mutation in the chain.
+					if err = sqlgraph.BatchCreate(ctx, krcb.driver, spec); err != nil {
+						if sqlgraph.IsConstraintError(err) {
+							err = &ConstraintError{msg: err.Error(), wrap: err}
+						}
+					}
+				}
+				if err != nil {
+					return nil, err
+				}
+				mutation.id = &nodes[i].ID
+				if specs[i].ID.Value != nil {
+					id := specs[i].ID.Value.(int64)
+					nodes[i].ID = int(id)
+				}
+				mutation.done = true
+				return nodes[i], nil
+			})
+			for i := len(builder.hooks) - 1; i >= 0; i-- {
+				mut = builder.hooks[i](mut)
+			}
+			mutators[i] = mut
+		}(i, ctx)
+	}
+	if len(mutators) > 0 {
+		if _, err := mutators[0].Mutate(ctx, krcb.builders[0].mutation); err != nil {
+			return nil, err
+		}
+	}
+	return nodes, nil
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (krcb *KeyRotationCreateBulk) SaveX(ctx context.Context) []*KeyRotation {
+	v, err := krcb.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
+
+// Exec executes the query.
+func (krcb *KeyRotationCreateBulk) Exec(ctx context.Context) error {
+	_, err := krcb.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (krcb *KeyRotationCreateBulk) ExecX(ctx context.Context) {
+	if err := krcb.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// OnConflict allows configuring the `ON CONFLICT` / `ON DUPLICATE KEY` clause
+// of the `INSERT` statement. For example:
+//
+//	client.KeyRotation.CreateBulk(builders...).
+//		OnConflict(
+//			// Update the row with the new values
+//			// the was proposed for insertion.
+//			sql.ResolveWithNewValues(),
+//		).
+//		// Override some of the fields with custom
+//		// update values.
+//		Update(func(u *ent.KeyRotationUpsert) {
+//			SetKeyID(v+v).
+//		}).
+//		Exec(ctx)
+func (krcb *KeyRotationCreateBulk) OnConflict(opts ...sql.ConflictOption) *KeyRotationUpsertBulk {
+	krcb.conflict = opts
+	return &KeyRotationUpsertBulk{
+		create: krcb,
+	}
+}
+
+// OnConflictColumns calls `OnConflict` and configures the columns
+// as conflict target. Using this option is equivalent to using:
+//
+//	client.KeyRotation.Create().
+//		OnConflict(sql.ConflictColumns(columns...)).
+//		Exec(ctx)
+func (krcb *KeyRotationCreateBulk) OnConflictColumns(columns ...string) *KeyRotationUpsertBulk {
+	krcb.conflict = append(krcb.conflict, sql.ConflictColumns(columns...))
+	return &KeyRotationUpsertBulk{
+		create: krcb,
+	}
+}
+
+// KeyRotationUpsertBulk is the builder for "upsert"-ing
+// a bulk of KeyRotation nodes.
+type KeyRotationUpsertBulk struct {
+	create *KeyRotationCreateBulk
+}
+
+// UpdateNewValues updates the mutable fields using the new values that
+// were set on create. Using this option is equivalent to using:
+//
+//	client.KeyRotation.Create().
+//		OnConflict(
+//			sql.ResolveWithNewValues(),
+//		).
+//		Exec(ctx)
+func (u *KeyRotationUpsertBulk) UpdateNewValues() *KeyRotationUpsertBulk {
+	u.create.conflict = append(u.create.conflict, sql.ResolveWithNewValues())
+	return u
+}
+
+// Ignore sets each column to itself in case of conflict.
+// Using this option is equivalent to using:
+//
+//	client.KeyRotation.Create().
+//		OnConflict(sql.ResolveWithIgnore()).
+//		Exec(ctx)
+func (u *KeyRotationUpsertBulk) Ignore() *KeyRotationUpsertBulk {
+	u.create.conflict = append(u.create.conflict, sql.ResolveWithIgnore())
+	return u
+}
+
+// DoNothing configures the conflict_action to `DO NOTHING`.
+// Supported only by SQLite and PostgreSQL.
+func (u *KeyRotationUpsertBulk) DoNothing() *KeyRotationUpsertBulk {
+	u.create.conflict = append(u.create.conflict, sql.DoNothing())
+	return u
+}
+
+// Update allows overriding fields `UPDATE` values. See the KeyRotationCreateBulk.OnConflict
+// documentation for more info.
+func (u *KeyRotationUpsertBulk) Update(set func(*KeyRotationUpsert)) *KeyRotationUpsertBulk {
+	u.create.conflict = append(u.create.conflict, sql.ResolveWith(func(update *sql.UpdateSet) {
+		set(&KeyRotationUpsert{UpdateSet: update})
+	}))
+	return u
+}
+
+// SetKeyID sets the "key_id" field.
+func (u *KeyRotationUpsertBulk) SetKeyID(v string) *KeyRotationUpsertBulk {
+	return u.Update(func(s *KeyRotationUpsert) {
+		s.SetKeyID(v)
+	})
+}
+
+// UpdateKeyID sets the "key_id" field to the value that was provided on create.
+func (u *KeyRotationUpsertBulk) UpdateKeyID() *KeyRotationUpsertBulk {
+	return u.Update(func(s *KeyRotationUpsert) {
+		s.UpdateKeyID()
+	})
+}
+
+// SetStatus sets the "status" field.
+func (u *KeyRotationUpsertBulk) SetStatus(v keyrotation.Status) *KeyRotationUpsertBulk {
+	return u.Update(func(s *KeyRotationUpsert) {
+		s.SetStatus(v)
+	})
+}
+
+// UpdateStatus sets the "status" field to the value that was provided on create.
+func (u *KeyRotationUpsertBulk) UpdateStatus() *KeyRotationUpsertBulk {
+	return u.Update(func(s *KeyRotationUpsert) {
+		s.UpdateStatus()
+	})
+}
+
+// SetTotal sets the "total" field.
+func (u *KeyRotationUpsertBulk) SetTotal(v int) *KeyRotationUpsertBulk {
+	return u.Update(func(s *KeyRotationUpsert) {
+		s.SetTotal(v)
+	})
+}
+
+// AddTotal adds v to the "total" field.
+func (u *KeyRotationUpsertBulk) AddTotal(v int) *KeyRotationUpsertBulk {
+	return u.Update(func(s *KeyRotationUpsert) {
+		s.AddTotal(v)
+	})
+}
+
+// UpdateTotal sets the "total" field to the value that was provided on create.
+func (u *KeyRotationUpsertBulk) UpdateTotal() *KeyRotationUpsertBulk {
+	return u.Update(func(s *KeyRotationUpsert) {
+		s.UpdateTotal()
+	})
+}
+
+// SetDone sets the "done" field.
+func (u *KeyRotationUpsertBulk) SetDone(v int) *KeyRotationUpsertBulk {
+	return u.Update(func(s *KeyRotationUpsert) {
+		s.SetDone(v)
+	})
+}
+
+// AddDone adds v to the "done" field.
+func (u *KeyRotationUpsertBulk) AddDone(v int) *KeyRotationUpsertBulk {
+	return u.Update(func(s *KeyRotationUpsert) {
+		s.AddDone(v)
+	})
+}
+
+// UpdateDone sets the "done" field to the value that was provided on create.
+func (u *KeyRotationUpsertBulk) UpdateDone() *KeyRotationUpsertBulk {
+	return u.Update(func(s *KeyRotationUpsert) {
+		s.UpdateDone()
+	})
+}
+
+// SetFailed sets the "failed" field.
+func (u *KeyRotationUpsertBulk) SetFailed(v int) *KeyRotationUpsertBulk {
+	return u.Update(func(s *KeyRotationUpsert) {
+		s.SetFailed(v)
+	})
+}
+
+// AddFailed adds v to the "failed" field.
+func (u *KeyRotationUpsertBulk) AddFailed(v int) *KeyRotationUpsertBulk {
+	return u.Update(func(s *KeyRotationUpsert) {
+		s.AddFailed(v)
+	})
+}
+
+// UpdateFailed sets the "failed" field to the value that was provided on create.
+func (u *KeyRotationUpsertBulk) UpdateFailed() *KeyRotationUpsertBulk {
+	return u.Update(func(s *KeyRotationUpsert) {
+		s.UpdateFailed()
+	})
+}
+
+// SetError sets the "error" field.
+func (u *KeyRotationUpsertBulk) SetError(v string) *KeyRotationUpsertBulk {
+	return u.Update(func(s *KeyRotationUpsert) {
+		s.SetError(v)
+	})
+}
+
+// UpdateError sets the "error" field to the value that was provided on create.
+func (u *KeyRotationUpsertBulk) UpdateError() *KeyRotationUpsertBulk {
+	return u.Update(func(s *KeyRotationUpsert) {
+		s.UpdateError()
+	})
+}
+
+// ClearError clears the value of the "error" field.
+func (u *KeyRotationUpsertBulk) ClearError() *KeyRotationUpsertBulk {
+	return u.Update(func(s *KeyRotationUpsert) {
+		s.ClearError()
+	})
+}
+
+// SetCreatedAt sets the "created_at" field.
+func (u *KeyRotationUpsertBulk) SetCreatedAt(v time.Time) *KeyRotationUpsertBulk {
+	return u.Update(func(s *KeyRotationUpsert) {
+		s.SetCreatedAt(v)
+	})
+}
+
+// UpdateCreatedAt sets the "created_at" field to the value that was provided on create.
+func (u *KeyRotationUpsertBulk) UpdateCreatedAt() *KeyRotationUpsertBulk {
+	return u.Update(func(s *KeyRotationUpsert) {
+		s.UpdateCreatedAt()
+	})
+}
+
+// ClearCreatedAt clears the value of the "created_at" field.
+func (u *KeyRotationUpsertBulk) ClearCreatedAt() *KeyRotationUpsertBulk {
+	return u.Update(func(s *KeyRotationUpsert) {
+		s.ClearCreatedAt()
+	})
+}
+
+// SetUpdatedAt sets the "updated_at" field.
+func (u *KeyRotationUpsertBulk) SetUpdatedAt(v time.Time) *KeyRotationUpsertBulk {
+	return u.Update(func(s *KeyRotationUpsert) {
+		s.SetUpdatedAt(v)
+	})
+}
+
+// UpdateUpdatedAt sets the "updated_at" field to the value that was provided on create.
+func (u *KeyRotationUpsertBulk) UpdateUpdatedAt() *KeyRotationUpsertBulk {
+	return u.Update(func(s *KeyRotationUpsert) {
+		s.UpdateUpdatedAt()
+	})
+}
+
+// SetFinishedAt sets the "finished_at" field.
+func (u *KeyRotationUpsertBulk) SetFinishedAt(v time.Time) *KeyRotationUpsertBulk {
+	return u.Update(func(s *KeyRotationUpsert) {
+		s.SetFinishedAt(v)
+	})
+}
+
+// UpdateFinishedAt sets the "finished_at" field to the value that was provided on create.
+func (u *KeyRotationUpsertBulk) UpdateFinishedAt() *KeyRotationUpsertBulk {
+	return u.Update(func(s *KeyRotationUpsert) {
+		s.UpdateFinishedAt()
+	})
+}
+
+// ClearFinishedAt clears the value of the "finished_at" field.
+func (u *KeyRotationUpsertBulk) ClearFinishedAt() *KeyRotationUpsertBulk {
+	return u.Update(func(s *KeyRotationUpsert) {
+		s.ClearFinishedAt()
+	})
+}
+
+// Exec executes the query.
+func (u *KeyRotationUpsertBulk) Exec(ctx context.Context) error {
+	if u.create.err != nil {
+		return u.create.err
+	}
+	for i, b := range u.create.builders {
+		if len(b.conflict) != 0 {
+			return fmt.Errorf("ent: OnConflict was set for builder %d. Set it on the KeyRotationCreateBulk instead", i)
+		}
+	}
+	if len(u.create.conflict) == 0 {
+		return errors.New("ent: missing options for KeyRotationCreateBulk.OnConflict")
+	}
+	return u.create.Exec(ctx)
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (u *KeyRotationUpsertBulk) ExecX(ctx context.Context) {
+	if err := u.create.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
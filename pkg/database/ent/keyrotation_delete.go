@@ -0,0 +1,88 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"context"
+
+	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/schema/field"
+	"github.com/lebleuciel/maani/pkg/database/ent/keyrotation"
+	"github.com/lebleuciel/maani/pkg/database/ent/predicate"
+)
+
+// KeyRotationDelete is the builder for deleting a KeyRotation entity.
+type KeyRotationDelete struct {
+	config
+	hooks    []Hook
+	mutation *KeyRotationMutation
+}
+
+// Where appends a list predicates to the KeyRotationDelete builder.
+func (krd *KeyRotationDelete) Where(ps ...predicate.KeyRotation) *KeyRotationDelete {
+	krd.mutation.Where(ps...)
+	return krd
+}
+
+// Exec executes the deletion query and returns how many vertices were deleted.
+func (krd *KeyRotationDelete) Exec(ctx context.Context) (int, error) {
+	return withHooks(ctx, krd.sqlExec, krd.mutation, krd.hooks)
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (krd *KeyRotationDelete) ExecX(ctx context.Context) int {
+	n, err := krd.Exec(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return n
+}
+
+func (krd *KeyRotationDelete) sqlExec(ctx context.Context) (int, error) {
+	_spec := sqlgraph.NewDeleteSpec(keyrotation.Table, sqlgraph.NewFieldSpec(keyrotation.FieldID, field.TypeInt))
+	if ps := krd.mutation.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	affected, err := sqlgraph.DeleteNodes(ctx, krd.driver, _spec)
+	if err != nil && sqlgraph.IsConstraintError(err) {
+		err = &ConstraintError{msg: err.Error(), wrap: err}
+	}
+	krd.mutation.done = true
+	return affected, err
+}
+
+// KeyRotationDeleteOne is the builder for deleting a single KeyRotation entity.
+type KeyRotationDeleteOne struct {
+	krd *KeyRotationDelete
+}
+
+// Where appends a list predicates to the KeyRotationDelete builder.
+func (krdo *KeyRotationDeleteOne) Where(ps ...predicate.KeyRotation) *KeyRotationDeleteOne {
+	krdo.krd.mutation.Where(ps...)
+	return krdo
+}
+
+// Exec executes the deletion query.
+func (krdo *KeyRotationDeleteOne) Exec(ctx context.Context) error {
+	n, err := krdo.krd.Exec(ctx)
+	switch {
+	case err != nil:
+		return err
+	case n == 0:
+		return &NotFoundError{keyrotation.Label}
+	default:
+		return nil
+	}
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (krdo *KeyRotationDeleteOne) ExecX(ctx context.Context) {
+	if err := krdo.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
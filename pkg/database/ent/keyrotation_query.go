@@ -0,0 +1,575 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"context"
+	"fmt"
+	"math"
+
+	"entgo.io/ent/dialect"
+	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/schema/field"
+	"github.com/lebleuciel/maani/pkg/database/ent/keyrotation"
+	"github.com/lebleuciel/maani/pkg/database/ent/predicate"
+)
+
+// KeyRotationQuery is the builder for querying KeyRotation entities.
+type KeyRotationQuery struct {
+	config
+	ctx        *QueryContext
+	order      []keyrotation.OrderOption
+	inters     []Interceptor
+	predicates []predicate.KeyRotation
+	modifiers  []func(*sql.Selector)
+	// intermediate query (i.e. traversal path).
+	sql  *sql.Selector
+	path func(context.Context) (*sql.Selector, error)
+}
+
+// Where adds a new predicate for the KeyRotationQuery builder.
+func (krq *KeyRotationQuery) Where(ps ...predicate.KeyRotation) *KeyRotationQuery {
+	krq.predicates = append(krq.predicates, ps...)
+	return krq
+}
+
+// Limit the number of records to be returned by this query.
+func (krq *KeyRotationQuery) Limit(limit int) *KeyRotationQuery {
+	krq.ctx.Limit = &limit
+	return krq
+}
+
+// Offset to start from.
+func (krq *KeyRotationQuery) Offset(offset int) *KeyRotationQuery {
+	krq.ctx.Offset = &offset
+	return krq
+}
+
+// Unique configures the query builder to filter duplicate records on query.
+// By default, unique is set to true, and can be disabled using this method.
+func (krq *KeyRotationQuery) Unique(unique bool) *KeyRotationQuery {
+	krq.ctx.Unique = &unique
+	return krq
+}
+
+// Order specifies how the records should be ordered.
+func (krq *KeyRotationQuery) Order(o ...keyrotation.OrderOption) *KeyRotationQuery {
+	krq.order = append(krq.order, o...)
+	return krq
+}
+
+// First returns the first KeyRotation entity from the query.
+// Returns a *NotFoundError when no KeyRotation was found.
+func (krq *KeyRotationQuery) First(ctx context.Context) (*KeyRotation, error) {
+	nodes, err := krq.Limit(1).All(setContextOp(ctx, krq.ctx, "First"))
+	if err != nil {
+		return nil, err
+	}
+	if len(nodes) == 0 {
+		return nil, &NotFoundError{keyrotation.Label}
+	}
+	return nodes[0], nil
+}
+
+// FirstX is like First, but panics if an error occurs.
+func (krq *KeyRotationQuery) FirstX(ctx context.Context) *KeyRotation {
+	node, err := krq.First(ctx)
+	if err != nil && !IsNotFound(err) {
+		panic(err)
+	}
+	return node
+}
+
+// FirstID returns the first KeyRotation ID from the query.
+// Returns a *NotFoundError when no KeyRotation ID was found.
+func (krq *KeyRotationQuery) FirstID(ctx context.Context) (id int, err error) {
+	var ids []int
+	if ids, err = krq.Limit(1).IDs(setContextOp(ctx, krq.ctx, "FirstID")); err != nil {
+		return
+	}
+	if len(ids) == 0 {
+		err = &NotFoundError{keyrotation.Label}
+		return
+	}
+	return ids[0], nil
+}
+
+// FirstIDX is like FirstID, but panics if an error occurs.
+func (krq *KeyRotationQuery) FirstIDX(ctx context.Context) int {
+	id, err := krq.FirstID(ctx)
+	if err != nil && !IsNotFound(err) {
+		panic(err)
+	}
+	return id
+}
+
+// Only returns a single KeyRotation entity found by the query, ensuring it only returns one.
+// Returns a *NotSingularError when more than one KeyRotation entity is found.
+// Returns a *NotFoundError when no KeyRotation entities are found.
+func (krq *KeyRotationQuery) Only(ctx context.Context) (*KeyRotation, error) {
+	nodes, err := krq.Limit(2).All(setContextOp(ctx, krq.ctx, "Only"))
+	if err != nil {
+		return nil, err
+	}
+	switch len(nodes) {
+	case 1:
+		return nodes[0], nil
+	case 0:
+		return nil, &NotFoundError{keyrotation.Label}
+	default:
+		return nil, &NotSingularError{keyrotation.Label}
+	}
+}
+
+// OnlyX is like Only, but panics if an error occurs.
+func (krq *KeyRotationQuery) OnlyX(ctx context.Context) *KeyRotation {
+	node, err := krq.Only(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return node
+}
+
+// OnlyID is like Only, but returns the only KeyRotation ID in the query.
+// Returns a *NotSingularError when more than one KeyRotation ID is found.
+// Returns a *NotFoundError when no entities are found.
+func (krq *KeyRotationQuery) OnlyID(ctx context.Context) (id int, err error) {
+	var ids []int
+	if ids, err = krq.Limit(2).IDs(setContextOp(ctx, krq.ctx, "OnlyID")); err != nil {
+		return
+	}
+	switch len(ids) {
+	case 1:
+		id = ids[0]
+	case 0:
+		err = &NotFoundError{keyrotation.Label}
+	default:
+		err = &NotSingularError{keyrotation.Label}
+	}
+	return
+}
+
+// OnlyIDX is like OnlyID, but panics if an error occurs.
+func (krq *KeyRotationQuery) OnlyIDX(ctx context.Context) int {
+	id, err := krq.OnlyID(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return id
+}
+
+// All executes the query and returns a list of KeyRotations.
+func (krq *KeyRotationQuery) All(ctx context.Context) ([]*KeyRotation, error) {
+	ctx = setContextOp(ctx, krq.ctx, "All")
+	if err := krq.prepareQuery(ctx); err != nil {
+		return nil, err
+	}
+	qr := querierAll[[]*KeyRotation, *KeyRotationQuery]()
+	return withInterceptors[[]*KeyRotation](ctx, krq, qr, krq.inters)
+}
+
+// AllX is like All, but panics if an error occurs.
+func (krq *KeyRotationQuery) AllX(ctx context.Context) []*KeyRotation {
+	nodes, err := krq.All(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return nodes
+}
+
+// IDs executes the query and returns a list of KeyRotation IDs.
+func (krq *KeyRotationQuery) IDs(ctx context.Context) (ids []int, err error) {
+	if krq.ctx.Unique == nil && krq.path != nil {
+		krq.Unique(true)
+	}
+	ctx = setContextOp(ctx, krq.ctx, "IDs")
+	if err = krq.Select(keyrotation.FieldID).Scan(ctx, &ids); err != nil {
+		return nil, err
+	}
+	return ids, nil
+}
+
+// IDsX is like IDs, but panics if an error occurs.
+func (krq *KeyRotationQuery) IDsX(ctx context.Context) []int {
+	ids, err := krq.IDs(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return ids
+}
+
+// Count returns the count of the given query.
+func (krq *KeyRotationQuery) Count(ctx context.Context) (int, error) {
+	ctx = setContextOp(ctx, krq.ctx, "Count")
+	if err := krq.prepareQuery(ctx); err != nil {
+		return 0, err
+	}
+	return withInterceptors[int](ctx, krq, querierCount[*KeyRotationQuery](), krq.inters)
+}
+
+// CountX is like Count, but panics if an error occurs.
+func (krq *KeyRotationQuery) CountX(ctx context.Context) int {
+	count, err := krq.Count(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return count
+}
+
+// Exist returns true if the query has elements in the graph.
+func (krq *KeyRotationQuery) Exist(ctx context.Context) (bool, error) {
+	ctx = setContextOp(ctx, krq.ctx, "Exist")
+	switch _, err := krq.FirstID(ctx); {
+	case IsNotFound(err):
+		return false, nil
+	case err != nil:
+		return false, fmt.Errorf("ent: check existence: %w", err)
+	default:
+		return true, nil
+	}
+}
+
+// ExistX is like Exist, but panics if an error occurs.
+func (krq *KeyRotationQuery) ExistX(ctx context.Context) bool {
+	exist, err := krq.Exist(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return exist
+}
+
+// Clone returns a duplicate of the KeyRotationQuery builder, including all associated steps. It can be
+// used to prepare common query builders and use them differently after the clone is made.
+func (krq *KeyRotationQuery) Clone() *KeyRotationQuery {
+	if krq == nil {
+		return nil
+	}
+	return &KeyRotationQuery{
+		config:     krq.config,
+		ctx:        krq.ctx.Clone(),
+		order:      append([]keyrotation.OrderOption{}, krq.order...),
+		inters:     append([]Interceptor{}, krq.inters...),
+		predicates: append([]predicate.KeyRotation{}, krq.predicates...),
+		// clone intermediate query.
+		sql:  krq.sql.Clone(),
+		path: krq.path,
+	}
+}
+
+// GroupBy is used to group vertices by one or more fields/columns.
+// It is often used with aggregate functions, like: count, max, mean, min, sum.
+//
+// Example:
+//
+//	var v []struct {
+//		KeyID string `json:"key_id,omitempty"`
+//		Count int `json:"count,omitempty"`
+//	}
+//
+//	client.KeyRotation.Query().
+//		GroupBy(keyrotation.FieldKeyID).
+//		Aggregate(ent.Count()).
+//		Scan(ctx, &v)
+func (krq *KeyRotationQuery) GroupBy(field string, fields ...string) *KeyRotationGroupBy {
+	krq.ctx.Fields = append([]string{field}, fields...)
+	grbuild := &KeyRotationGroupBy{build: krq}
+	grbuild.flds = &krq.ctx.Fields
+	grbuild.label = keyrotation.Label
+	grbuild.scan = grbuild.Scan
+	return grbuild
+}
+
+// Select allows the selection one or more fields/columns for the given query,
+// instead of selecting all fields in the entity.
+//
+// Example:
+//
+//	var v []struct {
+//		KeyID string `json:"key_id,omitempty"`
+//	}
+//
+//	client.KeyRotation.Query().
+//		Select(keyrotation.FieldKeyID).
+//		Scan(ctx, &v)
+func (krq *KeyRotationQuery) Select(fields ...string) *KeyRotationSelect {
+	krq.ctx.Fields = append(krq.ctx.Fields, fields...)
+	sbuild := &KeyRotationSelect{KeyRotationQuery: krq}
+	sbuild.label = keyrotation.Label
+	sbuild.flds, sbuild.scan = &krq.ctx.Fields, sbuild.Scan
+	return sbuild
+}
+
+// Aggregate returns a KeyRotationSelect configured with the given aggregations.
+func (krq *KeyRotationQuery) Aggregate(fns ...AggregateFunc) *KeyRotationSelect {
+	return krq.Select().Aggregate(fns...)
+}
+
+func (krq *KeyRotationQuery) prepareQuery(ctx context.Context) error {
+	for _, inter := range krq.inters {
+		if inter == nil {
+			return fmt.Errorf("ent: uninitialized interceptor (forgotten import ent/runtime?)")
+		}
+		if trv, ok := inter.(Traverser); ok {
+			if err := trv.Traverse(ctx, krq); err != nil {
+				return err
+			}
+		}
+	}
+	for _, f := range krq.ctx.Fields {
+		if !keyrotation.ValidColumn(f) {
+			return &ValidationError{Name: f, err: fmt.Errorf("ent: invalid field %q for query", f)}
+		}
+	}
+	if krq.path != nil {
+		prev, err := krq.path(ctx)
+		if err != nil {
+			return err
+		}
+		krq.sql = prev
+	}
+	return nil
+}
+
+func (krq *KeyRotationQuery) sqlAll(ctx context.Context, hooks ...queryHook) ([]*KeyRotation, error) {
+	var (
+		nodes = []*KeyRotation{}
+		_spec = krq.querySpec()
+	)
+	_spec.ScanValues = func(columns []string) ([]any, error) {
+		return (*KeyRotation).scanValues(nil, columns)
+	}
+	_spec.Assign = func(columns []string, values []any) error {
+		node := &KeyRotation{config: krq.config}
+		nodes = append(nodes, node)
+		return node.assignValues(columns, values)
+	}
+	if len(krq.modifiers) > 0 {
+		_spec.Modifiers = krq.modifiers
+	}
+	for i := range hooks {
+		hooks[i](ctx, _spec)
+	}
+	if err := sqlgraph.QueryNodes(ctx, krq.driver, _spec); err != nil {
+		return nil, err
+	}
+	if len(nodes) == 0 {
+		return nodes, nil
+	}
+	return nodes, nil
+}
+
+func (krq *KeyRotationQuery) sqlCount(ctx context.Context) (int, error) {
+	_spec := krq.querySpec()
+	if len(krq.modifiers) > 0 {
+		_spec.Modifiers = krq.modifiers
+	}
+	_spec.Node.Columns = krq.ctx.Fields
+	if len(krq.ctx.Fields) > 0 {
+		_spec.Unique = krq.ctx.Unique != nil && *krq.ctx.Unique
+	}
+	return sqlgraph.CountNodes(ctx, krq.driver, _spec)
+}
+
+func (krq *KeyRotationQuery) querySpec() *sqlgraph.QuerySpec {
+	_spec := sqlgraph.NewQuerySpec(keyrotation.Table, keyrotation.Columns, sqlgraph.NewFieldSpec(keyrotation.FieldID, field.TypeInt))
+	_spec.From = krq.sql
+	if unique := krq.ctx.Unique; unique != nil {
+		_spec.Unique = *unique
+	} else if krq.path != nil {
+		_spec.Unique = true
+	}
+	if fields := krq.ctx.Fields; len(fields) > 0 {
+		_spec.Node.Columns = make([]string, 0, len(fields))
+		_spec.Node.Columns = append(_spec.Node.Columns, keyrotation.FieldID)
+		for i := range fields {
+			if fields[i] != keyrotation.FieldID {
+				_spec.Node.Columns = append(_spec.Node.Columns, fields[i])
+			}
+		}
+	}
+	if ps := krq.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	if limit := krq.ctx.Limit; limit != nil {
+		_spec.Limit = *limit
+	}
+	if offset := krq.ctx.Offset; offset != nil {
+		_spec.Offset = *offset
+	}
+	if ps := krq.order; len(ps) > 0 {
+		_spec.Order = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	return _spec
+}
+
+func (krq *KeyRotationQuery) sqlQuery(ctx context.Context) *sql.Selector {
+	builder := sql.Dialect(krq.driver.Dialect())
+	t1 := builder.Table(keyrotation.Table)
+	columns := krq.ctx.Fields
+	if len(columns) == 0 {
+		columns = keyrotation.Columns
+	}
+	selector := builder.Select(t1.Columns(columns...)...).From(t1)
+	if krq.sql != nil {
+		selector = krq.sql
+		selector.Select(selector.Columns(columns...)...)
+	}
+	if krq.ctx.Unique != nil && *krq.ctx.Unique {
+		selector.Distinct()
+	}
+	for _, m := range krq.modifiers {
+		m(selector)
+	}
+	for _, p := range krq.predicates {
+		p(selector)
+	}
+	for _, p := range krq.order {
+		p(selector)
+	}
+	if offset := krq.ctx.Offset; offset != nil {
+		// limit is mandatory for offset clause. We start
+		// with default value, and override it below if needed.
+		selector.Offset(*offset).Limit(math.MaxInt32)
+	}
+	if limit := krq.ctx.Limit; limit != nil {
+		selector.Limit(*limit)
+	}
+	return selector
+}
+
+// ForUpdate locks the selected rows against concurrent updates, and prevent them from being
+// updated, deleted or "selected ... for update" by other sessions, until the transaction is
+// either committed or rolled-back.
+func (krq *KeyRotationQuery) ForUpdate(opts ...sql.LockOption) *KeyRotationQuery {
+	if krq.driver.Dialect() == dialect.Postgres {
+		krq.Unique(false)
+	}
+	krq.modifiers = append(krq.modifiers, func(s *sql.Selector) {
+		s.ForUpdate(opts...)
+	})
+	return krq
+}
+
+// ForShare behaves similarly to ForUpdate, except that it acquires a shared mode lock
+// on any rows that are read. Other sessions can read the rows, but cannot modify them
+// until your transaction commits.
+func (krq *KeyRotationQuery) ForShare(opts ...sql.LockOption) *KeyRotationQuery {
+	if krq.driver.Dialect() == dialect.Postgres {
+		krq.Unique(false)
+	}
+	krq.modifiers = append(krq.modifiers, func(s *sql.Selector) {
+		s.ForShare(opts...)
+	})
+	return krq
+}
+
+// Modify adds a query modifier for attaching custom logic to queries.
+func (krq *KeyRotationQuery) Modify(modifiers ...func(s *sql.Selector)) *KeyRotationSelect {
+	krq.modifiers = append(krq.modifiers, modifiers...)
+	return krq.Select()
+}
+
+// KeyRotationGroupBy is the group-by builder for KeyRotation entities.
+type KeyRotationGroupBy struct {
+	selector
+	build *KeyRotationQuery
+}
+
+// Aggregate adds the given aggregation functions to the group-by query.
+func (krgb *KeyRotationGroupBy) Aggregate(fns ...AggregateFunc) *KeyRotationGroupBy {
+	krgb.fns = append(krgb.fns, fns...)
+	return krgb
+}
+
+// Scan applies the selector query and scans the result into the given value.
+func (krgb *KeyRotationGroupBy) Scan(ctx context.Context, v any) error {
+	ctx = setContextOp(ctx, krgb.build.ctx, "GroupBy")
+	if err := krgb.build.prepareQuery(ctx); err != nil {
+		return err
+	}
+	return scanWithInterceptors[*KeyRotationQuery, *KeyRotationGroupBy](ctx, krgb.build, krgb, krgb.build.inters, v)
+}
+
+func (krgb *KeyRotationGroupBy) sqlScan(ctx context.Context, root *KeyRotationQuery, v any) error {
+	selector := root.sqlQuery(ctx).Select()
+	aggregation := make([]string, 0, len(krgb.fns))
+	for _, fn := range krgb.fns {
+		aggregation = append(aggregation, fn(selector))
+	}
+	if len(selector.SelectedColumns()) == 0 {
+		columns := make([]string, 0, len(*krgb.flds)+len(krgb.fns))
+		for _, f := range *krgb.flds {
+			columns = append(columns, selector.C(f))
+		}
+		columns = append(columns, aggregation...)
+		selector.Select(columns...)
+	}
+	selector.GroupBy(selector.Columns(*krgb.flds...)...)
+	if err := selector.Err(); err != nil {
+		return err
+	}
+	rows := &sql.Rows{}
+	query, args := selector.Query()
+	if err := krgb.build.driver.Query(ctx, query, args, rows); err != nil {
+		return err
+	}
+	defer rows.Close()
+	return sql.ScanSlice(rows, v)
+}
+
+// KeyRotationSelect is the builder for selecting fields of KeyRotation entities.
+type KeyRotationSelect struct {
+	*KeyRotationQuery
+	selector
+}
+
+// Aggregate adds the given aggregation functions to the selector query.
+func (krs *KeyRotationSelect) Aggregate(fns ...AggregateFunc) *KeyRotationSelect {
+	krs.fns = append(krs.fns, fns...)
+	return krs
+}
+
+// Scan applies the selector query and scans the result into the given value.
+func (krs *KeyRotationSelect) Scan(ctx context.Context, v any) error {
+	ctx = setContextOp(ctx, krs.ctx, "Select")
+	if err := krs.prepareQuery(ctx); err != nil {
+		return err
+	}
+	return scanWithInterceptors[*KeyRotationQuery, *KeyRotationSelect](ctx, krs.KeyRotationQuery, krs, krs.inters, v)
+}
+
+func (krs *KeyRotationSelect) sqlScan(ctx context.Context, root *KeyRotationQuery, v any) error {
+	selector := root.sqlQuery(ctx)
+	aggregation := make([]string, 0, len(krs.fns))
+	for _, fn := range krs.fns {
+		aggregation = append(aggregation, fn(selector))
+	}
+	switch n := len(*krs.selector.flds); {
+	case n == 0 && len(aggregation) > 0:
+		selector.Select(aggregation...)
+	case n != 0 && len(aggregation) > 0:
+		selector.AppendSelect(aggregation...)
+	}
+	rows := &sql.Rows{}
+	query, args := selector.Query()
+	if err := krs.driver.Query(ctx, query, args, rows); err != nil {
+		return err
+	}
+	defer rows.Close()
+	return sql.ScanSlice(rows, v)
+}
+
+// Modify adds a query modifier for attaching custom logic to queries.
+func (krs *KeyRotationSelect) Modify(modifiers ...func(s *sql.Selector)) *KeyRotationSelect {
+	krs.modifiers = append(krs.modifiers, modifiers...)
+	return krs
+}
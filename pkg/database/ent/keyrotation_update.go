@@ -0,0 +1,650 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/schema/field"
+	"github.com/lebleuciel/maani/pkg/database/ent/keyrotation"
+	"github.com/lebleuciel/maani/pkg/database/ent/predicate"
+)
+
+// KeyRotationUpdate is the builder for updating KeyRotation entities.
+type KeyRotationUpdate struct {
+	config
+	hooks     []Hook
+	mutation  *KeyRotationMutation
+	modifiers []func(*sql.UpdateBuilder)
+}
+
+// Where appends a list predicates to the KeyRotationUpdate builder.
+func (kru *KeyRotationUpdate) Where(ps ...predicate.KeyRotation) *KeyRotationUpdate {
+	kru.mutation.Where(ps...)
+	return kru
+}
+
+// SetKeyID sets the "key_id" field.
+func (kru *KeyRotationUpdate) SetKeyID(s string) *KeyRotationUpdate {
+	kru.mutation.SetKeyID(s)
+	return kru
+}
+
+// SetNillableKeyID sets the "key_id" field if the given value is not nil.
+func (kru *KeyRotationUpdate) SetNillableKeyID(s *string) *KeyRotationUpdate {
+	if s != nil {
+		kru.SetKeyID(*s)
+	}
+	return kru
+}
+
+// SetStatus sets the "status" field.
+func (kru *KeyRotationUpdate) SetStatus(k keyrotation.Status) *KeyRotationUpdate {
+	kru.mutation.SetStatus(k)
+	return kru
+}
+
+// SetNillableStatus sets the "status" field if the given value is not nil.
+func (kru *KeyRotationUpdate) SetNillableStatus(k *keyrotation.Status) *KeyRotationUpdate {
+	if k != nil {
+		kru.SetStatus(*k)
+	}
+	return kru
+}
+
+// SetTotal sets the "total" field.
+func (kru *KeyRotationUpdate) SetTotal(i int) *KeyRotationUpdate {
+	kru.mutation.ResetTotal()
+	kru.mutation.SetTotal(i)
+	return kru
+}
+
+// SetNillableTotal sets the "total" field if the given value is not nil.
+func (kru *KeyRotationUpdate) SetNillableTotal(i *int) *KeyRotationUpdate {
+	if i != nil {
+		kru.SetTotal(*i)
+	}
+	return kru
+}
+
+// AddTotal adds i to the "total" field.
+func (kru *KeyRotationUpdate) AddTotal(i int) *KeyRotationUpdate {
+	kru.mutation.AddTotal(i)
+	return kru
+}
+
+// SetDone sets the "done" field.
+func (kru *KeyRotationUpdate) SetDone(i int) *KeyRotationUpdate {
+	kru.mutation.ResetDone()
+	kru.mutation.SetDone(i)
+	return kru
+}
+
+// SetNillableDone sets the "done" field if the given value is not nil.
+func (kru *KeyRotationUpdate) SetNillableDone(i *int) *KeyRotationUpdate {
+	if i != nil {
+		kru.SetDone(*i)
+	}
+	return kru
+}
+
+// AddDone adds i to the "done" field.
+func (kru *KeyRotationUpdate) AddDone(i int) *KeyRotationUpdate {
+	kru.mutation.AddDone(i)
+	return kru
+}
+
+// SetFailed sets the "failed" field.
+func (kru *KeyRotationUpdate) SetFailed(i int) *KeyRotationUpdate {
+	kru.mutation.ResetFailed()
+	kru.mutation.SetFailed(i)
+	return kru
+}
+
+// SetNillableFailed sets the "failed" field if the given value is not nil.
+func (kru *KeyRotationUpdate) SetNillableFailed(i *int) *KeyRotationUpdate {
+	if i != nil {
+		kru.SetFailed(*i)
+	}
+	return kru
+}
+
+// AddFailed adds i to the "failed" field.
+func (kru *KeyRotationUpdate) AddFailed(i int) *KeyRotationUpdate {
+	kru.mutation.AddFailed(i)
+	return kru
+}
+
+// SetError sets the "error" field.
+func (kru *KeyRotationUpdate) SetError(s string) *KeyRotationUpdate {
+	kru.mutation.SetError(s)
+	return kru
+}
+
+// SetNillableError sets the "error" field if the given value is not nil.
+func (kru *KeyRotationUpdate) SetNillableError(s *string) *KeyRotationUpdate {
+	if s != nil {
+		kru.SetError(*s)
+	}
+	return kru
+}
+
+// ClearError clears the value of the "error" field.
+func (kru *KeyRotationUpdate) ClearError() *KeyRotationUpdate {
+	kru.mutation.ClearError()
+	return kru
+}
+
+// SetCreatedAt sets the "created_at" field.
+func (kru *KeyRotationUpdate) SetCreatedAt(t time.Time) *KeyRotationUpdate {
+	kru.mutation.SetCreatedAt(t)
+	return kru
+}
+
+// SetNillableCreatedAt sets the "created_at" field if the given value is not nil.
+func (kru *KeyRotationUpdate) SetNillableCreatedAt(t *time.Time) *KeyRotationUpdate {
+	if t != nil {
+		kru.SetCreatedAt(*t)
+	}
+	return kru
+}
+
+// ClearCreatedAt clears the value of the "created_at" field.
+func (kru *KeyRotationUpdate) ClearCreatedAt() *KeyRotationUpdate {
+	kru.mutation.ClearCreatedAt()
+	return kru
+}
+
+// SetUpdatedAt sets the "updated_at" field.
+func (kru *KeyRotationUpdate) SetUpdatedAt(t time.Time) *KeyRotationUpdate {
+	kru.mutation.SetUpdatedAt(t)
+	return kru
+}
+
+// SetFinishedAt sets the "finished_at" field.
+func (kru *KeyRotationUpdate) SetFinishedAt(t time.Time) *KeyRotationUpdate {
+	kru.mutation.SetFinishedAt(t)
+	return kru
+}
+
+// SetNillableFinishedAt sets the "finished_at" field if the given value is not nil.
+func (kru *KeyRotationUpdate) SetNillableFinishedAt(t *time.Time) *KeyRotationUpdate {
+	if t != nil {
+		kru.SetFinishedAt(*t)
+	}
+	return kru
+}
+
+// ClearFinishedAt clears the value of the "finished_at" field.
+func (kru *KeyRotationUpdate) ClearFinishedAt() *KeyRotationUpdate {
+	kru.mutation.ClearFinishedAt()
+	return kru
+}
+
+// Mutation returns the KeyRotationMutation object of the builder.
+func (kru *KeyRotationUpdate) Mutation() *KeyRotationMutation {
+	return kru.mutation
+}
+
+// Save executes the query and returns the number of nodes affected by the update operation.
+func (kru *KeyRotationUpdate) Save(ctx context.Context) (int, error) {
+	kru.defaults()
+	return withHooks(ctx, kru.sqlSave, kru.mutation, kru.hooks)
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (kru *KeyRotationUpdate) SaveX(ctx context.Context) int {
+	affected, err := kru.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return affected
+}
+
+// Exec executes the query.
+func (kru *KeyRotationUpdate) Exec(ctx context.Context) error {
+	_, err := kru.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (kru *KeyRotationUpdate) ExecX(ctx context.Context) {
+	if err := kru.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// defaults sets the default values of the builder before save.
+func (kru *KeyRotationUpdate) defaults() {
+	if _, ok := kru.mutation.UpdatedAt(); !ok {
+		v := keyrotation.UpdateDefaultUpdatedAt()
+		kru.mutation.SetUpdatedAt(v)
+	}
+}
+
+// check runs all checks and user-defined validators on the builder.
+func (kru *KeyRotationUpdate) check() error {
+	if v, ok := kru.mutation.KeyID(); ok {
+		if err := keyrotation.KeyIDValidator(v); err != nil {
+			return &ValidationError{Name: "key_id", err: fmt.Errorf(`ent: validator failed for field "KeyRotation.key_id": %w`, err)}
+		}
+	}
+	if v, ok := kru.mutation.Status(); ok {
+		if err := keyrotation.StatusValidator(v); err != nil {
+			return &ValidationError{Name: "status", err: fmt.Errorf(`ent: validator failed for field "KeyRotation.status": %w`, err)}
+		}
+	}
+	return nil
+}
+
+// Modify adds a statement modifier for attaching custom logic to the UPDATE statement.
+func (kru *KeyRotationUpdate) Modify(modifiers ...func(u *sql.UpdateBuilder)) *KeyRotationUpdate {
+	kru.modifiers = append(kru.modifiers, modifiers...)
+	return kru
+}
+
+func (kru *KeyRotationUpdate) sqlSave(ctx context.Context) (n int, err error) {
+	if err := kru.check(); err != nil {
+		return n, err
+	}
+	_spec := sqlgraph.NewUpdateSpec(keyrotation.Table, keyrotation.Columns, sqlgraph.NewFieldSpec(keyrotation.FieldID, field.TypeInt))
+	if ps := kru.mutation.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	if value, ok := kru.mutation.KeyID(); ok {
+		_spec.SetField(keyrotation.FieldKeyID, field.TypeString, value)
+	}
+	if value, ok := kru.mutation.Status(); ok {
+		_spec.SetField(keyrotation.FieldStatus, field.TypeEnum, value)
+	}
+	if value, ok := kru.mutation.Total(); ok {
+		_spec.SetField(keyrotation.FieldTotal, field.TypeInt, value)
+	}
+	if value, ok := kru.mutation.AddedTotal(); ok {
+		_spec.AddField(keyrotation.FieldTotal, field.TypeInt, value)
+	}
+	if value, ok := kru.mutation.Done(); ok {
+		_spec.SetField(keyrotation.FieldDone, field.TypeInt, value)
+	}
+	if value, ok := kru.mutation.AddedDone(); ok {
+		_spec.AddField(keyrotation.FieldDone, field.TypeInt, value)
+	}
+	if value, ok := kru.mutation.Failed(); ok {
+		_spec.SetField(keyrotation.FieldFailed, field.TypeInt, value)
+	}
+	if value, ok := kru.mutation.AddedFailed(); ok {
+		_spec.AddField(keyrotation.FieldFailed, field.TypeInt, value)
+	}
+	if value, ok := kru.mutation.Error(); ok {
+		_spec.SetField(keyrotation.FieldError, field.TypeString, value)
+	}
+	if kru.mutation.ErrorCleared() {
+		_spec.ClearField(keyrotation.FieldError, field.TypeString)
+	}
+	if value, ok := kru.mutation.CreatedAt(); ok {
+		_spec.SetField(keyrotation.FieldCreatedAt, field.TypeTime, value)
+	}
+	if kru.mutation.CreatedAtCleared() {
+		_spec.ClearField(keyrotation.FieldCreatedAt, field.TypeTime)
+	}
+	if value, ok := kru.mutation.UpdatedAt(); ok {
+		_spec.SetField(keyrotation.FieldUpdatedAt, field.TypeTime, value)
+	}
+	if value, ok := kru.mutation.FinishedAt(); ok {
+		_spec.SetField(keyrotation.FieldFinishedAt, field.TypeTime, value)
+	}
+	if kru.mutation.FinishedAtCleared() {
+		_spec.ClearField(keyrotation.FieldFinishedAt, field.TypeTime)
+	}
+	_spec.AddModifiers(kru.modifiers...)
+	if n, err = sqlgraph.UpdateNodes(ctx, kru.driver, _spec); err != nil {
+		if _, ok := err.(*sqlgraph.NotFoundError); ok {
+			err = &NotFoundError{keyrotation.Label}
+		} else if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return 0, err
+	}
+	kru.mutation.done = true
+	return n, nil
+}
+
+// KeyRotationUpdateOne is the builder for updating a single KeyRotation entity.
+type KeyRotationUpdateOne struct {
+	config
+	fields    []string
+	hooks     []Hook
+	mutation  *KeyRotationMutation
+	modifiers []func(*sql.UpdateBuilder)
+}
+
+// SetKeyID sets the "key_id" field.
+func (kruo *KeyRotationUpdateOne) SetKeyID(s string) *KeyRotationUpdateOne {
+	kruo.mutation.SetKeyID(s)
+	return kruo
+}
+
+// SetNillableKeyID sets the "key_id" field if the given value is not nil.
+func (kruo *KeyRotationUpdateOne) SetNillableKeyID(s *string) *KeyRotationUpdateOne {
+	if s != nil {
+		kruo.SetKeyID(*s)
+	}
+	return kruo
+}
+
+// SetStatus sets the "status" field.
+func (kruo *KeyRotationUpdateOne) SetStatus(k keyrotation.Status) *KeyRotationUpdateOne {
+	kruo.mutation.SetStatus(k)
+	return kruo
+}
+
+// SetNillableStatus sets the "status" field if the given value is not nil.
+func (kruo *KeyRotationUpdateOne) SetNillableStatus(k *keyrotation.Status) *KeyRotationUpdateOne {
+	if k != nil {
+		kruo.SetStatus(*k)
+	}
+	return kruo
+}
+
+// SetTotal sets the "total" field.
+func (kruo *KeyRotationUpdateOne) SetTotal(i int) *KeyRotationUpdateOne {
+	kruo.mutation.ResetTotal()
+	kruo.mutation.SetTotal(i)
+	return kruo
+}
+
+// SetNillableTotal sets the "total" field if the given value is not nil.
+func (kruo *KeyRotationUpdateOne) SetNillableTotal(i *int) *KeyRotationUpdateOne {
+	if i != nil {
+		kruo.SetTotal(*i)
+	}
+	return kruo
+}
+
+// AddTotal adds i to the "total" field.
+func (kruo *KeyRotationUpdateOne) AddTotal(i int) *KeyRotationUpdateOne {
+	kruo.mutation.AddTotal(i)
+	return kruo
+}
+
+// SetDone sets the "done" field.
+func (kruo *KeyRotationUpdateOne) SetDone(i int) *KeyRotationUpdateOne {
+	kruo.mutation.ResetDone()
+	kruo.mutation.SetDone(i)
+	return kruo
+}
+
+// SetNillableDone sets the "done" field if the given value is not nil.
+func (kruo *KeyRotationUpdateOne) SetNillableDone(i *int) *KeyRotationUpdateOne {
+	if i != nil {
+		kruo.SetDone(*i)
+	}
+	return kruo
+}
+
+// AddDone adds i to the "done" field.
+func (kruo *KeyRotationUpdateOne) AddDone(i int) *KeyRotationUpdateOne {
+	kruo.mutation.AddDone(i)
+	return kruo
+}
+
+// SetFailed sets the "failed" field.
+func (kruo *KeyRotationUpdateOne) SetFailed(i int) *KeyRotationUpdateOne {
+	kruo.mutation.ResetFailed()
+	kruo.mutation.SetFailed(i)
+	return kruo
+}
+
+// SetNillableFailed sets the "failed" field if the given value is not nil.
+func (kruo *KeyRotationUpdateOne) SetNillableFailed(i *int) *KeyRotationUpdateOne {
+	if i != nil {
+		kruo.SetFailed(*i)
+	}
+	return kruo
+}
+
+// AddFailed adds i to the "failed" field.
+func (kruo *KeyRotationUpdateOne) AddFailed(i int) *KeyRotationUpdateOne {
+	kruo.mutation.AddFailed(i)
+	return kruo
+}
+
+// SetError sets the "error" field.
+func (kruo *KeyRotationUpdateOne) SetError(s string) *KeyRotationUpdateOne {
+	kruo.mutation.SetError(s)
+	return kruo
+}
+
+// SetNillableError sets the "error" field if the given value is not nil.
+func (kruo *KeyRotationUpdateOne) SetNillableError(s *string) *KeyRotationUpdateOne {
+	if s != nil {
+		kruo.SetError(*s)
+	}
+	return kruo
+}
+
+// ClearError clears the value of the "error" field.
+func (kruo *KeyRotationUpdateOne) ClearError() *KeyRotationUpdateOne {
+	kruo.mutation.ClearError()
+	return kruo
+}
+
+// SetCreatedAt sets the "created_at" field.
+func (kruo *KeyRotationUpdateOne) SetCreatedAt(t time.Time) *KeyRotationUpdateOne {
+	kruo.mutation.SetCreatedAt(t)
+	return kruo
+}
+
+// SetNillableCreatedAt sets the "created_at" field if the given value is not nil.
+func (kruo *KeyRotationUpdateOne) SetNillableCreatedAt(t *time.Time) *KeyRotationUpdateOne {
+	if t != nil {
+		kruo.SetCreatedAt(*t)
+	}
+	return kruo
+}
+
+// ClearCreatedAt clears the value of the "created_at" field.
+func (kruo *KeyRotationUpdateOne) ClearCreatedAt() *KeyRotationUpdateOne {
+	kruo.mutation.ClearCreatedAt()
+	return kruo
+}
+
+// SetUpdatedAt sets the "updated_at" field.
+func (kruo *KeyRotationUpdateOne) SetUpdatedAt(t time.Time) *KeyRotationUpdateOne {
+	kruo.mutation.SetUpdatedAt(t)
+	return kruo
+}
+
+// SetFinishedAt sets the "finished_at" field.
+func (kruo *KeyRotationUpdateOne) SetFinishedAt(t time.Time) *KeyRotationUpdateOne {
+	kruo.mutation.SetFinishedAt(t)
+	return kruo
+}
+
+// SetNillableFinishedAt sets the "finished_at" field if the given value is not nil.
+func (kruo *KeyRotationUpdateOne) SetNillableFinishedAt(t *time.Time) *KeyRotationUpdateOne {
+	if t != nil {
+		kruo.SetFinishedAt(*t)
+	}
+	return kruo
+}
+
+// ClearFinishedAt clears the value of the "finished_at" field.
+func (kruo *KeyRotationUpdateOne) ClearFinishedAt() *KeyRotationUpdateOne {
+	kruo.mutation.ClearFinishedAt()
+	return kruo
+}
+
+// Mutation returns the KeyRotationMutation object of the builder.
+func (kruo *KeyRotationUpdateOne) Mutation() *KeyRotationMutation {
+	return kruo.mutation
+}
+
+// Where appends a list predicates to the KeyRotationUpdate builder.
+func (kruo *KeyRotationUpdateOne) Where(ps ...predicate.KeyRotation) *KeyRotationUpdateOne {
+	kruo.mutation.Where(ps...)
+	return kruo
+}
+
+// Select allows selecting one or more fields (columns) of the returned entity.
+// The default is selecting all fields defined in the entity schema.
+func (kruo *KeyRotationUpdateOne) Select(field string, fields ...string) *KeyRotationUpdateOne {
+	kruo.fields = append([]string{field}, fields...)
+	return kruo
+}
+
+// Save executes the query and returns the updated KeyRotation entity.
+func (kruo *KeyRotationUpdateOne) Save(ctx context.Context) (*KeyRotation, error) {
+	kruo.defaults()
+	return withHooks(ctx, kruo.sqlSave, kruo.mutation, kruo.hooks)
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (kruo *KeyRotationUpdateOne) SaveX(ctx context.Context) *KeyRotation {
+	node, err := kruo.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return node
+}
+
+// Exec executes the query on the entity.
+func (kruo *KeyRotationUpdateOne) Exec(ctx context.Context) error {
+	_, err := kruo.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (kruo *KeyRotationUpdateOne) ExecX(ctx context.Context) {
+	if err := kruo.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// defaults sets the default values of the builder before save.
+func (kruo *KeyRotationUpdateOne) defaults() {
+	if _, ok := kruo.mutation.UpdatedAt(); !ok {
+		v := keyrotation.UpdateDefaultUpdatedAt()
+		kruo.mutation.SetUpdatedAt(v)
+	}
+}
+
+// check runs all checks and user-defined validators on the builder.
+func (kruo *KeyRotationUpdateOne) check() error {
+	if v, ok := kruo.mutation.KeyID(); ok {
+		if err := keyrotation.KeyIDValidator(v); err != nil {
+			return &ValidationError{Name: "key_id", err: fmt.Errorf(`ent: validator failed for field "KeyRotation.key_id": %w`, err)}
+		}
+	}
+	if v, ok := kruo.mutation.Status(); ok {
+		if err := keyrotation.StatusValidator(v); err != nil {
+			return &ValidationError{Name: "status", err: fmt.Errorf(`ent: validator failed for field "KeyRotation.status": %w`, err)}
+		}
+	}
+	return nil
+}
+
+// Modify adds a statement modifier for attaching custom logic to the UPDATE statement.
+func (kruo *KeyRotationUpdateOne) Modify(modifiers ...func(u *sql.UpdateBuilder)) *KeyRotationUpdateOne {
+	kruo.modifiers = append(kruo.modifiers, modifiers...)
+	return kruo
+}
+
+func (kruo *KeyRotationUpdateOne) sqlSave(ctx context.Context) (_node *KeyRotation, err error) {
+	if err := kruo.check(); err != nil {
+		return _node, err
+	}
+	_spec := sqlgraph.NewUpdateSpec(keyrotation.Table, keyrotation.Columns, sqlgraph.NewFieldSpec(keyrotation.FieldID, field.TypeInt))
+	id, ok := kruo.mutation.ID()
+	if !ok {
+		return nil, &ValidationError{Name: "id", err: errors.New(`ent: missing "KeyRotation.id" for update`)}
+	}
+	_spec.Node.ID.Value = id
+	if fields := kruo.fields; len(fields) > 0 {
+		_spec.Node.Columns = make([]string, 0, len(fields))
+		_spec.Node.Columns = append(_spec.Node.Columns, keyrotation.FieldID)
+		for _, f := range fields {
+			if !keyrotation.ValidColumn(f) {
+				return nil, &ValidationError{Name: f, err: fmt.Errorf("ent: invalid field %q for query", f)}
+			}
+			if f != keyrotation.FieldID {
+				_spec.Node.Columns = append(_spec.Node.Columns, f)
+			}
+		}
+	}
+	if ps := kruo.mutation.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	if value, ok := kruo.mutation.KeyID(); ok {
+		_spec.SetField(keyrotation.FieldKeyID, field.TypeString, value)
+	}
+	if value, ok := kruo.mutation.Status(); ok {
+		_spec.SetField(keyrotation.FieldStatus, field.TypeEnum, value)
+	}
+	if value, ok := kruo.mutation.Total(); ok {
+		_spec.SetField(keyrotation.FieldTotal, field.TypeInt, value)
+	}
+	if value, ok := kruo.mutation.AddedTotal(); ok {
+		_spec.AddField(keyrotation.FieldTotal, field.TypeInt, value)
+	}
+	if value, ok := kruo.mutation.Done(); ok {
+		_spec.SetField(keyrotation.FieldDone, field.TypeInt, value)
+	}
+	if value, ok := kruo.mutation.AddedDone(); ok {
+		_spec.AddField(keyrotation.FieldDone, field.TypeInt, value)
+	}
+	if value, ok := kruo.mutation.Failed(); ok {
+		_spec.SetField(keyrotation.FieldFailed, field.TypeInt, value)
+	}
+	if value, ok := kruo.mutation.AddedFailed(); ok {
+		_spec.AddField(keyrotation.FieldFailed, field.TypeInt, value)
+	}
+	if value, ok := kruo.mutation.Error(); ok {
+		_spec.SetField(keyrotation.FieldError, field.TypeString, value)
+	}
+	if kruo.mutation.ErrorCleared() {
+		_spec.ClearField(keyrotation.FieldError, field.TypeString)
+	}
+	if value, ok := kruo.mutation.CreatedAt(); ok {
+		_spec.SetField(keyrotation.FieldCreatedAt, field.TypeTime, value)
+	}
+	if kruo.mutation.CreatedAtCleared() {
+		_spec.ClearField(keyrotation.FieldCreatedAt, field.TypeTime)
+	}
+	if value, ok := kruo.mutation.UpdatedAt(); ok {
+		_spec.SetField(keyrotation.FieldUpdatedAt, field.TypeTime, value)
+	}
+	if value, ok := kruo.mutation.FinishedAt(); ok {
+		_spec.SetField(keyrotation.FieldFinishedAt, field.TypeTime, value)
+	}
+	if kruo.mutation.FinishedAtCleared() {
+		_spec.ClearField(keyrotation.FieldFinishedAt, field.TypeTime)
+	}
+	_spec.AddModifiers(kruo.modifiers...)
+	_node = &KeyRotation{config: kruo.config}
+	_spec.Assign = _node.assignValues
+	_spec.ScanValues = _node.scanValues
+	if err = sqlgraph.UpdateNode(ctx, kruo.driver, _spec); err != nil {
+		if _, ok := err.(*sqlgraph.NotFoundError); ok {
+			err = &NotFoundError{keyrotation.Label}
+		} else if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return nil, err
+	}
+	kruo.mutation.done = true
+	return _node, nil
+}
@@ -11,13 +11,27 @@ var (
 	// FilesColumns holds the columns for the "files" table.
 	FilesColumns = []*schema.Column{
 		{Name: "id", Type: field.TypeInt, Increment: true},
-		{Name: "name", Type: field.TypeString, Size: 64},
+		{Name: "name", Type: field.TypeString, Size: 512},
 		{Name: "uuid", Type: field.TypeString, Unique: true, Size: 64},
 		{Name: "size", Type: field.TypeInt},
+		{Name: "burn_after_read", Type: field.TypeBool, Default: false},
+		{Name: "phash", Type: field.TypeInt64, Nullable: true},
+		{Name: "key_id", Type: field.TypeString, Size: 255, Default: ""},
+		{Name: "wrapped_key", Type: field.TypeBytes, Nullable: true},
+		{Name: "sha256", Type: field.TypeString, Size: 64, Default: ""},
+		{Name: "width", Type: field.TypeInt, Default: 0},
+		{Name: "height", Type: field.TypeInt, Default: 0},
+		{Name: "dominant_color", Type: field.TypeString, Size: 7, Default: ""},
+		{Name: "source_url", Type: field.TypeString, Size: 2048, Default: ""},
+		{Name: "search_query", Type: field.TypeString, Size: 512, Default: ""},
+		{Name: "tag_names", Type: field.TypeString, Size: 2147483647, Default: ""},
+		{Name: "camera_make", Type: field.TypeString, Size: 255, Default: ""},
+		{Name: "camera_model", Type: field.TypeString, Size: 255, Default: ""},
+		{Name: "taken_at", Type: field.TypeTime, Nullable: true},
 		{Name: "created_at", Type: field.TypeTime, Nullable: true},
 		{Name: "updated_at", Type: field.TypeTime},
 		{Name: "deleted_at", Type: field.TypeTime, Nullable: true},
-		{Name: "type", Type: field.TypeString, Size: 64},
+		{Name: "type", Type: field.TypeString, Size: 256},
 		{Name: "user_id", Type: field.TypeInt},
 	}
 	// FilesTable holds the schema information for the "files" table.
@@ -28,21 +42,33 @@ var (
 		ForeignKeys: []*schema.ForeignKey{
 			{
 				Symbol:     "files_filetypes_files",
-				Columns:    []*schema.Column{FilesColumns[7]},
+				Columns:    []*schema.Column{FilesColumns[21]},
 				RefColumns: []*schema.Column{FiletypesColumns[0]},
 				OnDelete:   schema.NoAction,
 			},
 			{
 				Symbol:     "files_users_files",
-				Columns:    []*schema.Column{FilesColumns[8]},
+				Columns:    []*schema.Column{FilesColumns[22]},
 				RefColumns: []*schema.Column{UsersColumns[0]},
 				OnDelete:   schema.NoAction,
 			},
 		},
+		Indexes: []*schema.Index{
+			{
+				Name:    "file_sha256",
+				Unique:  false,
+				Columns: []*schema.Column{FilesColumns[8]},
+			},
+			{
+				Name:    "file_deleted_at",
+				Unique:  false,
+				Columns: []*schema.Column{FilesColumns[20]},
+			},
+		},
 	}
 	// FiletypesColumns holds the columns for the "filetypes" table.
 	FiletypesColumns = []*schema.Column{
-		{Name: "type", Type: field.TypeString, Size: 64},
+		{Name: "type", Type: field.TypeString, Size: 256},
 		{Name: "allowed_size", Type: field.TypeInt, Default: 10000000},
 		{Name: "is_banned", Type: field.TypeBool, Default: false},
 		{Name: "created_at", Type: field.TypeTime, Nullable: true},
@@ -54,6 +80,101 @@ var (
 		Columns:    FiletypesColumns,
 		PrimaryKey: []*schema.Column{FiletypesColumns[0]},
 	}
+	// KeyRotationsColumns holds the columns for the "key_rotations" table.
+	KeyRotationsColumns = []*schema.Column{
+		{Name: "id", Type: field.TypeInt, Increment: true},
+		{Name: "key_id", Type: field.TypeString, Size: 255},
+		{Name: "status", Type: field.TypeEnum, Enums: []string{"running", "completed", "failed"}, Default: "running"},
+		{Name: "total", Type: field.TypeInt, Default: 0},
+		{Name: "done", Type: field.TypeInt, Default: 0},
+		{Name: "failed", Type: field.TypeInt, Default: 0},
+		{Name: "error", Type: field.TypeString, Nullable: true},
+		{Name: "created_at", Type: field.TypeTime, Nullable: true},
+		{Name: "updated_at", Type: field.TypeTime},
+		{Name: "finished_at", Type: field.TypeTime, Nullable: true},
+	}
+	// KeyRotationsTable holds the schema information for the "key_rotations" table.
+	KeyRotationsTable = &schema.Table{
+		Name:       "key_rotations",
+		Columns:    KeyRotationsColumns,
+		PrimaryKey: []*schema.Column{KeyRotationsColumns[0]},
+	}
+	// SearchJobsColumns holds the columns for the "search_jobs" table.
+	SearchJobsColumns = []*schema.Column{
+		{Name: "id", Type: field.TypeInt, Increment: true},
+		{Name: "query", Type: field.TypeString, Size: 512},
+		{Name: "max_results", Type: field.TypeInt},
+		{Name: "status", Type: field.TypeEnum, Enums: []string{"pending", "running", "completed", "failed"}, Default: "pending"},
+		{Name: "saved", Type: field.TypeInt, Default: 0},
+		{Name: "failed", Type: field.TypeInt, Default: 0},
+		{Name: "file_names", Type: field.TypeJSON, Nullable: true},
+		{Name: "deduplicated", Type: field.TypeInt, Default: 0},
+		{Name: "duplicate_file_names", Type: field.TypeJSON, Nullable: true},
+		{Name: "duplicate_of", Type: field.TypeJSON, Nullable: true},
+		{Name: "candidate_urls", Type: field.TypeJSON, Nullable: true},
+		{Name: "candidate_thumbnail_urls", Type: field.TypeJSON, Nullable: true},
+		{Name: "next_candidate", Type: field.TypeInt, Default: 0},
+		{Name: "runner", Type: field.TypeString, Nullable: true},
+		{Name: "heartbeat_at", Type: field.TypeTime, Nullable: true},
+		{Name: "error", Type: field.TypeString, Nullable: true},
+		{Name: "created_at", Type: field.TypeTime, Nullable: true},
+		{Name: "updated_at", Type: field.TypeTime},
+		{Name: "started_at", Type: field.TypeTime, Nullable: true},
+		{Name: "finished_at", Type: field.TypeTime, Nullable: true},
+		{Name: "user_id", Type: field.TypeInt},
+	}
+	// SearchJobsTable holds the schema information for the "search_jobs" table.
+	SearchJobsTable = &schema.Table{
+		Name:       "search_jobs",
+		Columns:    SearchJobsColumns,
+		PrimaryKey: []*schema.Column{SearchJobsColumns[0]},
+		ForeignKeys: []*schema.ForeignKey{
+			{
+				Symbol:     "search_jobs_users_search_jobs",
+				Columns:    []*schema.Column{SearchJobsColumns[20]},
+				RefColumns: []*schema.Column{UsersColumns[0]},
+				OnDelete:   schema.NoAction,
+			},
+		},
+	}
+	// SharesColumns holds the columns for the "shares" table.
+	SharesColumns = []*schema.Column{
+		{Name: "id", Type: field.TypeInt, Increment: true},
+		{Name: "permission", Type: field.TypeEnum, Enums: []string{"read", "write"}, Default: "read"},
+		{Name: "expires_at", Type: field.TypeTime, Nullable: true},
+		{Name: "max_downloads", Type: field.TypeInt, Nullable: true},
+		{Name: "downloads", Type: field.TypeInt, Default: 0},
+		{Name: "created_at", Type: field.TypeTime, Nullable: true},
+		{Name: "file_id", Type: field.TypeInt},
+		{Name: "user_id", Type: field.TypeInt, Nullable: true},
+	}
+	// SharesTable holds the schema information for the "shares" table.
+	SharesTable = &schema.Table{
+		Name:       "shares",
+		Columns:    SharesColumns,
+		PrimaryKey: []*schema.Column{SharesColumns[0]},
+		ForeignKeys: []*schema.ForeignKey{
+			{
+				Symbol:     "shares_files_shares",
+				Columns:    []*schema.Column{SharesColumns[6]},
+				RefColumns: []*schema.Column{FilesColumns[0]},
+				OnDelete:   schema.Cascade,
+			},
+			{
+				Symbol:     "shares_users_shares",
+				Columns:    []*schema.Column{SharesColumns[7]},
+				RefColumns: []*schema.Column{UsersColumns[0]},
+				OnDelete:   schema.Cascade,
+			},
+		},
+		Indexes: []*schema.Index{
+			{
+				Name:    "share_file_id_user_id",
+				Unique:  true,
+				Columns: []*schema.Column{SharesColumns[6], SharesColumns[7]},
+			},
+		},
+	}
 	// TagsColumns holds the columns for the "tags" table.
 	TagsColumns = []*schema.Column{
 		{Name: "name", Type: field.TypeString, Size: 64},
@@ -113,6 +234,9 @@ var (
 	Tables = []*schema.Table{
 		FilesTable,
 		FiletypesTable,
+		KeyRotationsTable,
+		SearchJobsTable,
+		SharesTable,
 		TagsTable,
 		UsersTable,
 		FileTagsTable,
@@ -122,6 +246,9 @@ var (
 func init() {
 	FilesTable.ForeignKeys[0].RefTable = FiletypesTable
 	FilesTable.ForeignKeys[1].RefTable = UsersTable
+	SearchJobsTable.ForeignKeys[0].RefTable = UsersTable
+	SharesTable.ForeignKeys[0].RefTable = FilesTable
+	SharesTable.ForeignKeys[1].RefTable = UsersTable
 	FileTagsTable.ForeignKeys[0].RefTable = FilesTable
 	FileTagsTable.ForeignKeys[1].RefTable = TagsTable
 }
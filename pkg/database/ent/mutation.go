@@ -13,7 +13,10 @@ import (
 	"entgo.io/ent/dialect/sql"
 	"github.com/lebleuciel/maani/pkg/database/ent/file"
 	"github.com/lebleuciel/maani/pkg/database/ent/filetype"
+	"github.com/lebleuciel/maani/pkg/database/ent/keyrotation"
 	"github.com/lebleuciel/maani/pkg/database/ent/predicate"
+	"github.com/lebleuciel/maani/pkg/database/ent/searchjob"
+	"github.com/lebleuciel/maani/pkg/database/ent/share"
 	"github.com/lebleuciel/maani/pkg/database/ent/tag"
 	"github.com/lebleuciel/maani/pkg/database/ent/user"
 )
@@ -27,10 +30,13 @@ const (
 	OpUpdateOne = ent.OpUpdateOne
 
 	// Node types.
-	TypeFile     = "File"
-	TypeFiletype = "Filetype"
-	TypeTag      = "Tag"
-	TypeUser     = "User"
+	TypeFile        = "File"
+	TypeFiletype    = "Filetype"
+	TypeKeyRotation = "KeyRotation"
+	TypeSearchJob   = "SearchJob"
+	TypeShare       = "Share"
+	TypeTag         = "Tag"
+	TypeUser        = "User"
 )
 
 // FileMutation represents an operation that mutates the File nodes in the graph.
@@ -43,6 +49,23 @@ type FileMutation struct {
 	uuid            *string
 	size            *int
 	addsize         *int
+	burn_after_read *bool
+	phash           *int64
+	addphash        *int64
+	key_id          *string
+	wrapped_key     *[]byte
+	sha256          *string
+	width           *int
+	addwidth        *int
+	height          *int
+	addheight       *int
+	dominant_color  *string
+	source_url      *string
+	search_query    *string
+	tag_names       *string
+	camera_make     *string
+	camera_model    *string
+	taken_at        *time.Time
 	created_at      *time.Time
 	updated_at      *time.Time
 	deleted_at      *time.Time
@@ -54,6 +77,9 @@ type FileMutation struct {
 	tags            map[string]struct{}
 	removedtags     map[string]struct{}
 	clearedtags     bool
+	shares          map[int]struct{}
+	removedshares   map[int]struct{}
+	clearedshares   bool
 	done            bool
 	oldValue        func(context.Context) (*File, error)
 	predicates      []predicate.File
@@ -357,13 +383,4210 @@ func (m *FileMutation) ResetType() {
 	m.filetype = nil
 }
 
+// SetBurnAfterRead sets the "burn_after_read" field.
+func (m *FileMutation) SetBurnAfterRead(b bool) {
+	m.burn_after_read = &b
+}
+
+// BurnAfterRead returns the value of the "burn_after_read" field in the mutation.
+func (m *FileMutation) BurnAfterRead() (r bool, exists bool) {
+	v := m.burn_after_read
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldBurnAfterRead returns the old "burn_after_read" field's value of the File entity.
+// If the File object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *FileMutation) OldBurnAfterRead(ctx context.Context) (v bool, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldBurnAfterRead is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldBurnAfterRead requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldBurnAfterRead: %w", err)
+	}
+	return oldValue.BurnAfterRead, nil
+}
+
+// ResetBurnAfterRead resets all changes to the "burn_after_read" field.
+func (m *FileMutation) ResetBurnAfterRead() {
+	m.burn_after_read = nil
+}
+
+// SetPhash sets the "phash" field.
+func (m *FileMutation) SetPhash(i int64) {
+	m.phash = &i
+	m.addphash = nil
+}
+
+// Phash returns the value of the "phash" field in the mutation.
+func (m *FileMutation) Phash() (r int64, exists bool) {
+	v := m.phash
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldPhash returns the old "phash" field's value of the File entity.
+// If the File object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *FileMutation) OldPhash(ctx context.Context) (v *int64, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldPhash is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldPhash requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldPhash: %w", err)
+	}
+	return oldValue.Phash, nil
+}
+
+// AddPhash adds i to the "phash" field.
+func (m *FileMutation) AddPhash(i int64) {
+	if m.addphash != nil {
+		*m.addphash += i
+	} else {
+		m.addphash = &i
+	}
+}
+
+// AddedPhash returns the value that was added to the "phash" field in this mutation.
+func (m *FileMutation) AddedPhash() (r int64, exists bool) {
+	v := m.addphash
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// ClearPhash clears the value of the "phash" field.
+func (m *FileMutation) ClearPhash() {
+	m.phash = nil
+	m.addphash = nil
+	m.clearedFields[file.FieldPhash] = struct{}{}
+}
+
+// PhashCleared returns if the "phash" field was cleared in this mutation.
+func (m *FileMutation) PhashCleared() bool {
+	_, ok := m.clearedFields[file.FieldPhash]
+	return ok
+}
+
+// ResetPhash resets all changes to the "phash" field.
+func (m *FileMutation) ResetPhash() {
+	m.phash = nil
+	m.addphash = nil
+	delete(m.clearedFields, file.FieldPhash)
+}
+
+// SetKeyID sets the "key_id" field.
+func (m *FileMutation) SetKeyID(s string) {
+	m.key_id = &s
+}
+
+// KeyID returns the value of the "key_id" field in the mutation.
+func (m *FileMutation) KeyID() (r string, exists bool) {
+	v := m.key_id
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldKeyID returns the old "key_id" field's value of the File entity.
+// If the File object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *FileMutation) OldKeyID(ctx context.Context) (v string, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldKeyID is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldKeyID requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldKeyID: %w", err)
+	}
+	return oldValue.KeyID, nil
+}
+
+// ResetKeyID resets all changes to the "key_id" field.
+func (m *FileMutation) ResetKeyID() {
+	m.key_id = nil
+}
+
+// SetWrappedKey sets the "wrapped_key" field.
+func (m *FileMutation) SetWrappedKey(b []byte) {
+	m.wrapped_key = &b
+}
+
+// WrappedKey returns the value of the "wrapped_key" field in the mutation.
+func (m *FileMutation) WrappedKey() (r []byte, exists bool) {
+	v := m.wrapped_key
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldWrappedKey returns the old "wrapped_key" field's value of the File entity.
+// If the File object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *FileMutation) OldWrappedKey(ctx context.Context) (v []byte, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldWrappedKey is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldWrappedKey requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldWrappedKey: %w", err)
+	}
+	return oldValue.WrappedKey, nil
+}
+
+// ClearWrappedKey clears the value of the "wrapped_key" field.
+func (m *FileMutation) ClearWrappedKey() {
+	m.wrapped_key = nil
+	m.clearedFields[file.FieldWrappedKey] = struct{}{}
+}
+
+// WrappedKeyCleared returns if the "wrapped_key" field was cleared in this mutation.
+func (m *FileMutation) WrappedKeyCleared() bool {
+	_, ok := m.clearedFields[file.FieldWrappedKey]
+	return ok
+}
+
+// ResetWrappedKey resets all changes to the "wrapped_key" field.
+func (m *FileMutation) ResetWrappedKey() {
+	m.wrapped_key = nil
+	delete(m.clearedFields, file.FieldWrappedKey)
+}
+
+// SetSha256 sets the "sha256" field.
+func (m *FileMutation) SetSha256(s string) {
+	m.sha256 = &s
+}
+
+// Sha256 returns the value of the "sha256" field in the mutation.
+func (m *FileMutation) Sha256() (r string, exists bool) {
+	v := m.sha256
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldSha256 returns the old "sha256" field's value of the File entity.
+// If the File object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *FileMutation) OldSha256(ctx context.Context) (v string, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldSha256 is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldSha256 requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldSha256: %w", err)
+	}
+	return oldValue.Sha256, nil
+}
+
+// ResetSha256 resets all changes to the "sha256" field.
+func (m *FileMutation) ResetSha256() {
+	m.sha256 = nil
+}
+
+// SetWidth sets the "width" field.
+func (m *FileMutation) SetWidth(i int) {
+	m.width = &i
+	m.addwidth = nil
+}
+
+// Width returns the value of the "width" field in the mutation.
+func (m *FileMutation) Width() (r int, exists bool) {
+	v := m.width
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldWidth returns the old "width" field's value of the File entity.
+// If the File object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *FileMutation) OldWidth(ctx context.Context) (v int, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldWidth is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldWidth requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldWidth: %w", err)
+	}
+	return oldValue.Width, nil
+}
+
+// AddWidth adds i to the "width" field.
+func (m *FileMutation) AddWidth(i int) {
+	if m.addwidth != nil {
+		*m.addwidth += i
+	} else {
+		m.addwidth = &i
+	}
+}
+
+// AddedWidth returns the value that was added to the "width" field in this mutation.
+func (m *FileMutation) AddedWidth() (r int, exists bool) {
+	v := m.addwidth
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// ResetWidth resets all changes to the "width" field.
+func (m *FileMutation) ResetWidth() {
+	m.width = nil
+	m.addwidth = nil
+}
+
+// SetHeight sets the "height" field.
+func (m *FileMutation) SetHeight(i int) {
+	m.height = &i
+	m.addheight = nil
+}
+
+// Height returns the value of the "height" field in the mutation.
+func (m *FileMutation) Height() (r int, exists bool) {
+	v := m.height
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldHeight returns the old "height" field's value of the File entity.
+// If the File object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *FileMutation) OldHeight(ctx context.Context) (v int, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldHeight is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldHeight requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldHeight: %w", err)
+	}
+	return oldValue.Height, nil
+}
+
+// AddHeight adds i to the "height" field.
+func (m *FileMutation) AddHeight(i int) {
+	if m.addheight != nil {
+		*m.addheight += i
+	} else {
+		m.addheight = &i
+	}
+}
+
+// AddedHeight returns the value that was added to the "height" field in this mutation.
+func (m *FileMutation) AddedHeight() (r int, exists bool) {
+	v := m.addheight
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// ResetHeight resets all changes to the "height" field.
+func (m *FileMutation) ResetHeight() {
+	m.height = nil
+	m.addheight = nil
+}
+
+// SetDominantColor sets the "dominant_color" field.
+func (m *FileMutation) SetDominantColor(s string) {
+	m.dominant_color = &s
+}
+
+// DominantColor returns the value of the "dominant_color" field in the mutation.
+func (m *FileMutation) DominantColor() (r string, exists bool) {
+	v := m.dominant_color
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldDominantColor returns the old "dominant_color" field's value of the File entity.
+// If the File object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *FileMutation) OldDominantColor(ctx context.Context) (v string, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldDominantColor is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldDominantColor requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldDominantColor: %w", err)
+	}
+	return oldValue.DominantColor, nil
+}
+
+// ResetDominantColor resets all changes to the "dominant_color" field.
+func (m *FileMutation) ResetDominantColor() {
+	m.dominant_color = nil
+}
+
+// SetSourceURL sets the "source_url" field.
+func (m *FileMutation) SetSourceURL(s string) {
+	m.source_url = &s
+}
+
+// SourceURL returns the value of the "source_url" field in the mutation.
+func (m *FileMutation) SourceURL() (r string, exists bool) {
+	v := m.source_url
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldSourceURL returns the old "source_url" field's value of the File entity.
+// If the File object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *FileMutation) OldSourceURL(ctx context.Context) (v string, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldSourceURL is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldSourceURL requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldSourceURL: %w", err)
+	}
+	return oldValue.SourceURL, nil
+}
+
+// ResetSourceURL resets all changes to the "source_url" field.
+func (m *FileMutation) ResetSourceURL() {
+	m.source_url = nil
+}
+
+// SetSearchQuery sets the "search_query" field.
+func (m *FileMutation) SetSearchQuery(s string) {
+	m.search_query = &s
+}
+
+// SearchQuery returns the value of the "search_query" field in the mutation.
+func (m *FileMutation) SearchQuery() (r string, exists bool) {
+	v := m.search_query
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldSearchQuery returns the old "search_query" field's value of the File entity.
+// If the File object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *FileMutation) OldSearchQuery(ctx context.Context) (v string, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldSearchQuery is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldSearchQuery requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldSearchQuery: %w", err)
+	}
+	return oldValue.SearchQuery, nil
+}
+
+// ResetSearchQuery resets all changes to the "search_query" field.
+func (m *FileMutation) ResetSearchQuery() {
+	m.search_query = nil
+}
+
+// SetTagNames sets the "tag_names" field.
+func (m *FileMutation) SetTagNames(s string) {
+	m.tag_names = &s
+}
+
+// TagNames returns the value of the "tag_names" field in the mutation.
+func (m *FileMutation) TagNames() (r string, exists bool) {
+	v := m.tag_names
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldTagNames returns the old "tag_names" field's value of the File entity.
+// If the File object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *FileMutation) OldTagNames(ctx context.Context) (v string, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldTagNames is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldTagNames requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldTagNames: %w", err)
+	}
+	return oldValue.TagNames, nil
+}
+
+// ResetTagNames resets all changes to the "tag_names" field.
+func (m *FileMutation) ResetTagNames() {
+	m.tag_names = nil
+}
+
+// SetCameraMake sets the "camera_make" field.
+func (m *FileMutation) SetCameraMake(s string) {
+	m.camera_make = &s
+}
+
+// CameraMake returns the value of the "camera_make" field in the mutation.
+func (m *FileMutation) CameraMake() (r string, exists bool) {
+	v := m.camera_make
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldCameraMake returns the old "camera_make" field's value of the File entity.
+// If the File object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *FileMutation) OldCameraMake(ctx context.Context) (v string, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldCameraMake is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldCameraMake requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldCameraMake: %w", err)
+	}
+	return oldValue.CameraMake, nil
+}
+
+// ResetCameraMake resets all changes to the "camera_make" field.
+func (m *FileMutation) ResetCameraMake() {
+	m.camera_make = nil
+}
+
+// SetCameraModel sets the "camera_model" field.
+func (m *FileMutation) SetCameraModel(s string) {
+	m.camera_model = &s
+}
+
+// CameraModel returns the value of the "camera_model" field in the mutation.
+func (m *FileMutation) CameraModel() (r string, exists bool) {
+	v := m.camera_model
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldCameraModel returns the old "camera_model" field's value of the File entity.
+// If the File object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *FileMutation) OldCameraModel(ctx context.Context) (v string, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldCameraModel is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldCameraModel requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldCameraModel: %w", err)
+	}
+	return oldValue.CameraModel, nil
+}
+
+// ResetCameraModel resets all changes to the "camera_model" field.
+func (m *FileMutation) ResetCameraModel() {
+	m.camera_model = nil
+}
+
+// SetTakenAt sets the "taken_at" field.
+func (m *FileMutation) SetTakenAt(t time.Time) {
+	m.taken_at = &t
+}
+
+// TakenAt returns the value of the "taken_at" field in the mutation.
+func (m *FileMutation) TakenAt() (r time.Time, exists bool) {
+	v := m.taken_at
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldTakenAt returns the old "taken_at" field's value of the File entity.
+// If the File object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *FileMutation) OldTakenAt(ctx context.Context) (v *time.Time, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldTakenAt is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldTakenAt requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldTakenAt: %w", err)
+	}
+	return oldValue.TakenAt, nil
+}
+
+// ClearTakenAt clears the value of the "taken_at" field.
+func (m *FileMutation) ClearTakenAt() {
+	m.taken_at = nil
+	m.clearedFields[file.FieldTakenAt] = struct{}{}
+}
+
+// TakenAtCleared returns if the "taken_at" field was cleared in this mutation.
+func (m *FileMutation) TakenAtCleared() bool {
+	_, ok := m.clearedFields[file.FieldTakenAt]
+	return ok
+}
+
+// ResetTakenAt resets all changes to the "taken_at" field.
+func (m *FileMutation) ResetTakenAt() {
+	m.taken_at = nil
+	delete(m.clearedFields, file.FieldTakenAt)
+}
+
+// SetCreatedAt sets the "created_at" field.
+func (m *FileMutation) SetCreatedAt(t time.Time) {
+	m.created_at = &t
+}
+
+// CreatedAt returns the value of the "created_at" field in the mutation.
+func (m *FileMutation) CreatedAt() (r time.Time, exists bool) {
+	v := m.created_at
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldCreatedAt returns the old "created_at" field's value of the File entity.
+// If the File object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *FileMutation) OldCreatedAt(ctx context.Context) (v *time.Time, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldCreatedAt is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldCreatedAt requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldCreatedAt: %w", err)
+	}
+	return oldValue.CreatedAt, nil
+}
+
+// ClearCreatedAt clears the value of the "created_at" field.
+func (m *FileMutation) ClearCreatedAt() {
+	m.created_at = nil
+	m.clearedFields[file.FieldCreatedAt] = struct{}{}
+}
+
+// CreatedAtCleared returns if the "created_at" field was cleared in this mutation.
+func (m *FileMutation) CreatedAtCleared() bool {
+	_, ok := m.clearedFields[file.FieldCreatedAt]
+	return ok
+}
+
+// ResetCreatedAt resets all changes to the "created_at" field.
+func (m *FileMutation) ResetCreatedAt() {
+	m.created_at = nil
+	delete(m.clearedFields, file.FieldCreatedAt)
+}
+
+// SetUpdatedAt sets the "updated_at" field.
+func (m *FileMutation) SetUpdatedAt(t time.Time) {
+	m.updated_at = &t
+}
+
+// UpdatedAt returns the value of the "updated_at" field in the mutation.
+func (m *FileMutation) UpdatedAt() (r time.Time, exists bool) {
+	v := m.updated_at
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldUpdatedAt returns the old "updated_at" field's value of the File entity.
+// If the File object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *FileMutation) OldUpdatedAt(ctx context.Context) (v time.Time, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldUpdatedAt is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldUpdatedAt requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldUpdatedAt: %w", err)
+	}
+	return oldValue.UpdatedAt, nil
+}
+
+// ResetUpdatedAt resets all changes to the "updated_at" field.
+func (m *FileMutation) ResetUpdatedAt() {
+	m.updated_at = nil
+}
+
+// SetDeletedAt sets the "deleted_at" field.
+func (m *FileMutation) SetDeletedAt(t time.Time) {
+	m.deleted_at = &t
+}
+
+// DeletedAt returns the value of the "deleted_at" field in the mutation.
+func (m *FileMutation) DeletedAt() (r time.Time, exists bool) {
+	v := m.deleted_at
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldDeletedAt returns the old "deleted_at" field's value of the File entity.
+// If the File object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *FileMutation) OldDeletedAt(ctx context.Context) (v *time.Time, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldDeletedAt is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldDeletedAt requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldDeletedAt: %w", err)
+	}
+	return oldValue.DeletedAt, nil
+}
+
+// ClearDeletedAt clears the value of the "deleted_at" field.
+func (m *FileMutation) ClearDeletedAt() {
+	m.deleted_at = nil
+	m.clearedFields[file.FieldDeletedAt] = struct{}{}
+}
+
+// DeletedAtCleared returns if the "deleted_at" field was cleared in this mutation.
+func (m *FileMutation) DeletedAtCleared() bool {
+	_, ok := m.clearedFields[file.FieldDeletedAt]
+	return ok
+}
+
+// ResetDeletedAt resets all changes to the "deleted_at" field.
+func (m *FileMutation) ResetDeletedAt() {
+	m.deleted_at = nil
+	delete(m.clearedFields, file.FieldDeletedAt)
+}
+
+// ClearUser clears the "user" edge to the User entity.
+func (m *FileMutation) ClearUser() {
+	m.cleareduser = true
+	m.clearedFields[file.FieldUserID] = struct{}{}
+}
+
+// UserCleared reports if the "user" edge to the User entity was cleared.
+func (m *FileMutation) UserCleared() bool {
+	return m.cleareduser
+}
+
+// UserIDs returns the "user" edge IDs in the mutation.
+// Note that IDs always returns len(IDs) <= 1 for unique edges, and you should use
+// UserID instead. It exists only for internal usage by the builders.
+func (m *FileMutation) UserIDs() (ids []int) {
+	if id := m.user; id != nil {
+		ids = append(ids, *id)
+	}
+	return
+}
+
+// ResetUser resets all changes to the "user" edge.
+func (m *FileMutation) ResetUser() {
+	m.user = nil
+	m.cleareduser = false
+}
+
+// SetFiletypeID sets the "filetype" edge to the Filetype entity by id.
+func (m *FileMutation) SetFiletypeID(id string) {
+	m.filetype = &id
+}
+
+// ClearFiletype clears the "filetype" edge to the Filetype entity.
+func (m *FileMutation) ClearFiletype() {
+	m.clearedfiletype = true
+	m.clearedFields[file.FieldType] = struct{}{}
+}
+
+// FiletypeCleared reports if the "filetype" edge to the Filetype entity was cleared.
+func (m *FileMutation) FiletypeCleared() bool {
+	return m.clearedfiletype
+}
+
+// FiletypeID returns the "filetype" edge ID in the mutation.
+func (m *FileMutation) FiletypeID() (id string, exists bool) {
+	if m.filetype != nil {
+		return *m.filetype, true
+	}
+	return
+}
+
+// FiletypeIDs returns the "filetype" edge IDs in the mutation.
+// Note that IDs always returns len(IDs) <= 1 for unique edges, and you should use
+// FiletypeID instead. It exists only for internal usage by the builders.
+func (m *FileMutation) FiletypeIDs() (ids []string) {
+	if id := m.filetype; id != nil {
+		ids = append(ids, *id)
+	}
+	return
+}
+
+// ResetFiletype resets all changes to the "filetype" edge.
+func (m *FileMutation) ResetFiletype() {
+	m.filetype = nil
+	m.clearedfiletype = false
+}
+
+// AddTagIDs adds the "tags" edge to the Tag entity by ids.
+func (m *FileMutation) AddTagIDs(ids ...string) {
+	if m.tags == nil {
+		m.tags = make(map[string]struct{})
+	}
+	for i := range ids {
+		m.tags[ids[i]] = struct{}{}
+	}
+}
+
+// ClearTags clears the "tags" edge to the Tag entity.
+func (m *FileMutation) ClearTags() {
+	m.clearedtags = true
+}
+
+// TagsCleared reports if the "tags" edge to the Tag entity was cleared.
+func (m *FileMutation) TagsCleared() bool {
+	return m.clearedtags
+}
+
+// RemoveTagIDs removes the "tags" edge to the Tag entity by IDs.
+func (m *FileMutation) RemoveTagIDs(ids ...string) {
+	if m.removedtags == nil {
+		m.removedtags = make(map[string]struct{})
+	}
+	for i := range ids {
+		delete(m.tags, ids[i])
+		m.removedtags[ids[i]] = struct{}{}
+	}
+}
+
+// RemovedTags returns the removed IDs of the "tags" edge to the Tag entity.
+func (m *FileMutation) RemovedTagsIDs() (ids []string) {
+	for id := range m.removedtags {
+		ids = append(ids, id)
+	}
+	return
+}
+
+// TagsIDs returns the "tags" edge IDs in the mutation.
+func (m *FileMutation) TagsIDs() (ids []string) {
+	for id := range m.tags {
+		ids = append(ids, id)
+	}
+	return
+}
+
+// ResetTags resets all changes to the "tags" edge.
+func (m *FileMutation) ResetTags() {
+	m.tags = nil
+	m.clearedtags = false
+	m.removedtags = nil
+}
+
+// AddShareIDs adds the "shares" edge to the Share entity by ids.
+func (m *FileMutation) AddShareIDs(ids ...int) {
+	if m.shares == nil {
+		m.shares = make(map[int]struct{})
+	}
+	for i := range ids {
+		m.shares[ids[i]] = struct{}{}
+	}
+}
+
+// ClearShares clears the "shares" edge to the Share entity.
+func (m *FileMutation) ClearShares() {
+	m.clearedshares = true
+}
+
+// SharesCleared reports if the "shares" edge to the Share entity was cleared.
+func (m *FileMutation) SharesCleared() bool {
+	return m.clearedshares
+}
+
+// RemoveShareIDs removes the "shares" edge to the Share entity by IDs.
+func (m *FileMutation) RemoveShareIDs(ids ...int) {
+	if m.removedshares == nil {
+		m.removedshares = make(map[int]struct{})
+	}
+	for i := range ids {
+		delete(m.shares, ids[i])
+		m.removedshares[ids[i]] = struct{}{}
+	}
+}
+
+// RemovedShares returns the removed IDs of the "shares" edge to the Share entity.
+func (m *FileMutation) RemovedSharesIDs() (ids []int) {
+	for id := range m.removedshares {
+		ids = append(ids, id)
+	}
+	return
+}
+
+// SharesIDs returns the "shares" edge IDs in the mutation.
+func (m *FileMutation) SharesIDs() (ids []int) {
+	for id := range m.shares {
+		ids = append(ids, id)
+	}
+	return
+}
+
+// ResetShares resets all changes to the "shares" edge.
+func (m *FileMutation) ResetShares() {
+	m.shares = nil
+	m.clearedshares = false
+	m.removedshares = nil
+}
+
+// Where appends a list predicates to the FileMutation builder.
+func (m *FileMutation) Where(ps ...predicate.File) {
+	m.predicates = append(m.predicates, ps...)
+}
+
+// WhereP appends storage-level predicates to the FileMutation builder. Using this method,
+// users can use type-assertion to append predicates that do not depend on any generated package.
+func (m *FileMutation) WhereP(ps ...func(*sql.Selector)) {
+	p := make([]predicate.File, len(ps))
+	for i := range ps {
+		p[i] = ps[i]
+	}
+	m.Where(p...)
+}
+
+// Op returns the operation name.
+func (m *FileMutation) Op() Op {
+	return m.op
+}
+
+// SetOp allows setting the mutation operation.
+func (m *FileMutation) SetOp(op Op) {
+	m.op = op
+}
+
+// Type returns the node type of this mutation (File).
+func (m *FileMutation) Type() string {
+	return m.typ
+}
+
+// Fields returns all fields that were changed during this mutation. Note that in
+// order to get all numeric fields that were incremented/decremented, call
+// AddedFields().
+func (m *FileMutation) Fields() []string {
+	fields := make([]string, 0, 22)
+	if m.name != nil {
+		fields = append(fields, file.FieldName)
+	}
+	if m.user != nil {
+		fields = append(fields, file.FieldUserID)
+	}
+	if m.uuid != nil {
+		fields = append(fields, file.FieldUUID)
+	}
+	if m.size != nil {
+		fields = append(fields, file.FieldSize)
+	}
+	if m.filetype != nil {
+		fields = append(fields, file.FieldType)
+	}
+	if m.burn_after_read != nil {
+		fields = append(fields, file.FieldBurnAfterRead)
+	}
+	if m.phash != nil {
+		fields = append(fields, file.FieldPhash)
+	}
+	if m.key_id != nil {
+		fields = append(fields, file.FieldKeyID)
+	}
+	if m.wrapped_key != nil {
+		fields = append(fields, file.FieldWrappedKey)
+	}
+	if m.sha256 != nil {
+		fields = append(fields, file.FieldSha256)
+	}
+	if m.width != nil {
+		fields = append(fields, file.FieldWidth)
+	}
+	if m.height != nil {
+		fields = append(fields, file.FieldHeight)
+	}
+	if m.dominant_color != nil {
+		fields = append(fields, file.FieldDominantColor)
+	}
+	if m.source_url != nil {
+		fields = append(fields, file.FieldSourceURL)
+	}
+	if m.search_query != nil {
+		fields = append(fields, file.FieldSearchQuery)
+	}
+	if m.tag_names != nil {
+		fields = append(fields, file.FieldTagNames)
+	}
+	if m.camera_make != nil {
+		fields = append(fields, file.FieldCameraMake)
+	}
+	if m.camera_model != nil {
+		fields = append(fields, file.FieldCameraModel)
+	}
+	if m.taken_at != nil {
+		fields = append(fields, file.FieldTakenAt)
+	}
+	if m.created_at != nil {
+		fields = append(fields, file.FieldCreatedAt)
+	}
+	if m.updated_at != nil {
+		fields = append(fields, file.FieldUpdatedAt)
+	}
+	if m.deleted_at != nil {
+		fields = append(fields, file.FieldDeletedAt)
+	}
+	return fields
+}
+
+// Field returns the value of a field with the given name. The second boolean
+// return value indicates that this field was not set, or was not defined in the
+// schema.
+func (m *FileMutation) Field(name string) (ent.Value, bool) {
+	switch name {
+	case file.FieldName:
+		return m.Name()
+	case file.FieldUserID:
+		return m.UserID()
+	case file.FieldUUID:
+		return m.UUID()
+	case file.FieldSize:
+		return m.Size()
+	case file.FieldType:
+		return m.GetType()
+	case file.FieldBurnAfterRead:
+		return m.BurnAfterRead()
+	case file.FieldPhash:
+		return m.Phash()
+	case file.FieldKeyID:
+		return m.KeyID()
+	case file.FieldWrappedKey:
+		return m.WrappedKey()
+	case file.FieldSha256:
+		return m.Sha256()
+	case file.FieldWidth:
+		return m.Width()
+	case file.FieldHeight:
+		return m.Height()
+	case file.FieldDominantColor:
+		return m.DominantColor()
+	case file.FieldSourceURL:
+		return m.SourceURL()
+	case file.FieldSearchQuery:
+		return m.SearchQuery()
+	case file.FieldTagNames:
+		return m.TagNames()
+	case file.FieldCameraMake:
+		return m.CameraMake()
+	case file.FieldCameraModel:
+		return m.CameraModel()
+	case file.FieldTakenAt:
+		return m.TakenAt()
+	case file.FieldCreatedAt:
+		return m.CreatedAt()
+	case file.FieldUpdatedAt:
+		return m.UpdatedAt()
+	case file.FieldDeletedAt:
+		return m.DeletedAt()
+	}
+	return nil, false
+}
+
+// OldField returns the old value of the field from the database. An error is
+// returned if the mutation operation is not UpdateOne, or the query to the
+// database failed.
+func (m *FileMutation) OldField(ctx context.Context, name string) (ent.Value, error) {
+	switch name {
+	case file.FieldName:
+		return m.OldName(ctx)
+	case file.FieldUserID:
+		return m.OldUserID(ctx)
+	case file.FieldUUID:
+		return m.OldUUID(ctx)
+	case file.FieldSize:
+		return m.OldSize(ctx)
+	case file.FieldType:
+		return m.OldType(ctx)
+	case file.FieldBurnAfterRead:
+		return m.OldBurnAfterRead(ctx)
+	case file.FieldPhash:
+		return m.OldPhash(ctx)
+	case file.FieldKeyID:
+		return m.OldKeyID(ctx)
+	case file.FieldWrappedKey:
+		return m.OldWrappedKey(ctx)
+	case file.FieldSha256:
+		return m.OldSha256(ctx)
+	case file.FieldWidth:
+		return m.OldWidth(ctx)
+	case file.FieldHeight:
+		return m.OldHeight(ctx)
+	case file.FieldDominantColor:
+		return m.OldDominantColor(ctx)
+	case file.FieldSourceURL:
+		return m.OldSourceURL(ctx)
+	case file.FieldSearchQuery:
+		return m.OldSearchQuery(ctx)
+	case file.FieldTagNames:
+		return m.OldTagNames(ctx)
+	case file.FieldCameraMake:
+		return m.OldCameraMake(ctx)
+	case file.FieldCameraModel:
+		return m.OldCameraModel(ctx)
+	case file.FieldTakenAt:
+		return m.OldTakenAt(ctx)
+	case file.FieldCreatedAt:
+		return m.OldCreatedAt(ctx)
+	case file.FieldUpdatedAt:
+		return m.OldUpdatedAt(ctx)
+	case file.FieldDeletedAt:
+		return m.OldDeletedAt(ctx)
+	}
+	return nil, fmt.Errorf("unknown File field %s", name)
+}
+
+// SetField sets the value of a field with the given name. It returns an error if
+// the field is not defined in the schema, or if the type mismatched the field
+// type.
+func (m *FileMutation) SetField(name string, value ent.Value) error {
+	switch name {
+	case file.FieldName:
+		v, ok := value.(string)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetName(v)
+		return nil
+	case file.FieldUserID:
+		v, ok := value.(int)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetUserID(v)
+		return nil
+	case file.FieldUUID:
+		v, ok := value.(string)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetUUID(v)
+		return nil
+	case file.FieldSize:
+		v, ok := value.(int)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetSize(v)
+		return nil
+	case file.FieldType:
+		v, ok := value.(string)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetType(v)
+		return nil
+	case file.FieldBurnAfterRead:
+		v, ok := value.(bool)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetBurnAfterRead(v)
+		return nil
+	case file.FieldPhash:
+		v, ok := value.(int64)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetPhash(v)
+		return nil
+	case file.FieldKeyID:
+		v, ok := value.(string)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetKeyID(v)
+		return nil
+	case file.FieldWrappedKey:
+		v, ok := value.([]byte)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetWrappedKey(v)
+		return nil
+	case file.FieldSha256:
+		v, ok := value.(string)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetSha256(v)
+		return nil
+	case file.FieldWidth:
+		v, ok := value.(int)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetWidth(v)
+		return nil
+	case file.FieldHeight:
+		v, ok := value.(int)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetHeight(v)
+		return nil
+	case file.FieldDominantColor:
+		v, ok := value.(string)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetDominantColor(v)
+		return nil
+	case file.FieldSourceURL:
+		v, ok := value.(string)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetSourceURL(v)
+		return nil
+	case file.FieldSearchQuery:
+		v, ok := value.(string)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetSearchQuery(v)
+		return nil
+	case file.FieldTagNames:
+		v, ok := value.(string)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetTagNames(v)
+		return nil
+	case file.FieldCameraMake:
+		v, ok := value.(string)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetCameraMake(v)
+		return nil
+	case file.FieldCameraModel:
+		v, ok := value.(string)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetCameraModel(v)
+		return nil
+	case file.FieldTakenAt:
+		v, ok := value.(time.Time)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetTakenAt(v)
+		return nil
+	case file.FieldCreatedAt:
+		v, ok := value.(time.Time)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetCreatedAt(v)
+		return nil
+	case file.FieldUpdatedAt:
+		v, ok := value.(time.Time)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetUpdatedAt(v)
+		return nil
+	case file.FieldDeletedAt:
+		v, ok := value.(time.Time)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetDeletedAt(v)
+		return nil
+	}
+	return fmt.Errorf("unknown File field %s", name)
+}
+
+// AddedFields returns all numeric fields that were incremented/decremented during
+// this mutation.
+func (m *FileMutation) AddedFields() []string {
+	var fields []string
+	if m.addsize != nil {
+		fields = append(fields, file.FieldSize)
+	}
+	if m.addphash != nil {
+		fields = append(fields, file.FieldPhash)
+	}
+	if m.addwidth != nil {
+		fields = append(fields, file.FieldWidth)
+	}
+	if m.addheight != nil {
+		fields = append(fields, file.FieldHeight)
+	}
+	return fields
+}
+
+// AddedField returns the numeric value that was incremented/decremented on a field
+// with the given name. The second boolean return value indicates that this field
+// was not set, or was not defined in the schema.
+func (m *FileMutation) AddedField(name string) (ent.Value, bool) {
+	switch name {
+	case file.FieldSize:
+		return m.AddedSize()
+	case file.FieldPhash:
+		return m.AddedPhash()
+	case file.FieldWidth:
+		return m.AddedWidth()
+	case file.FieldHeight:
+		return m.AddedHeight()
+	}
+	return nil, false
+}
+
+// AddField adds the value to the field with the given name. It returns an error if
+// the field is not defined in the schema, or if the type mismatched the field
+// type.
+func (m *FileMutation) AddField(name string, value ent.Value) error {
+	switch name {
+	case file.FieldSize:
+		v, ok := value.(int)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.AddSize(v)
+		return nil
+	case file.FieldPhash:
+		v, ok := value.(int64)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.AddPhash(v)
+		return nil
+	case file.FieldWidth:
+		v, ok := value.(int)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.AddWidth(v)
+		return nil
+	case file.FieldHeight:
+		v, ok := value.(int)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.AddHeight(v)
+		return nil
+	}
+	return fmt.Errorf("unknown File numeric field %s", name)
+}
+
+// ClearedFields returns all nullable fields that were cleared during this
+// mutation.
+func (m *FileMutation) ClearedFields() []string {
+	var fields []string
+	if m.FieldCleared(file.FieldPhash) {
+		fields = append(fields, file.FieldPhash)
+	}
+	if m.FieldCleared(file.FieldWrappedKey) {
+		fields = append(fields, file.FieldWrappedKey)
+	}
+	if m.FieldCleared(file.FieldTakenAt) {
+		fields = append(fields, file.FieldTakenAt)
+	}
+	if m.FieldCleared(file.FieldCreatedAt) {
+		fields = append(fields, file.FieldCreatedAt)
+	}
+	if m.FieldCleared(file.FieldDeletedAt) {
+		fields = append(fields, file.FieldDeletedAt)
+	}
+	return fields
+}
+
+// FieldCleared returns a boolean indicating if a field with the given name was
+// cleared in this mutation.
+func (m *FileMutation) FieldCleared(name string) bool {
+	_, ok := m.clearedFields[name]
+	return ok
+}
+
+// ClearField clears the value of the field with the given name. It returns an
+// error if the field is not defined in the schema.
+func (m *FileMutation) ClearField(name string) error {
+	switch name {
+	case file.FieldPhash:
+		m.ClearPhash()
+		return nil
+	case file.FieldWrappedKey:
+		m.ClearWrappedKey()
+		return nil
+	case file.FieldTakenAt:
+		m.ClearTakenAt()
+		return nil
+	case file.FieldCreatedAt:
+		m.ClearCreatedAt()
+		return nil
+	case file.FieldDeletedAt:
+		m.ClearDeletedAt()
+		return nil
+	}
+	return fmt.Errorf("unknown File nullable field %s", name)
+}
+
+// ResetField resets all changes in the mutation for the field with the given name.
+// It returns an error if the field is not defined in the schema.
+func (m *FileMutation) ResetField(name string) error {
+	switch name {
+	case file.FieldName:
+		m.ResetName()
+		return nil
+	case file.FieldUserID:
+		m.ResetUserID()
+		return nil
+	case file.FieldUUID:
+		m.ResetUUID()
+		return nil
+	case file.FieldSize:
+		m.ResetSize()
+		return nil
+	case file.FieldType:
+		m.ResetType()
+		return nil
+	case file.FieldBurnAfterRead:
+		m.ResetBurnAfterRead()
+		return nil
+	case file.FieldPhash:
+		m.ResetPhash()
+		return nil
+	case file.FieldKeyID:
+		m.ResetKeyID()
+		return nil
+	case file.FieldWrappedKey:
+		m.ResetWrappedKey()
+		return nil
+	case file.FieldSha256:
+		m.ResetSha256()
+		return nil
+	case file.FieldWidth:
+		m.ResetWidth()
+		return nil
+	case file.FieldHeight:
+		m.ResetHeight()
+		return nil
+	case file.FieldDominantColor:
+		m.ResetDominantColor()
+		return nil
+	case file.FieldSourceURL:
+		m.ResetSourceURL()
+		return nil
+	case file.FieldSearchQuery:
+		m.ResetSearchQuery()
+		return nil
+	case file.FieldTagNames:
+		m.ResetTagNames()
+		return nil
+	case file.FieldCameraMake:
+		m.ResetCameraMake()
+		return nil
+	case file.FieldCameraModel:
+		m.ResetCameraModel()
+		return nil
+	case file.FieldTakenAt:
+		m.ResetTakenAt()
+		return nil
+	case file.FieldCreatedAt:
+		m.ResetCreatedAt()
+		return nil
+	case file.FieldUpdatedAt:
+		m.ResetUpdatedAt()
+		return nil
+	case file.FieldDeletedAt:
+		m.ResetDeletedAt()
+		return nil
+	}
+	return fmt.Errorf("unknown File field %s", name)
+}
+
+// AddedEdges returns all edge names that were set/added in this mutation.
+func (m *FileMutation) AddedEdges() []string {
+	edges := make([]string, 0, 4)
+	if m.user != nil {
+		edges = append(edges, file.EdgeUser)
+	}
+	if m.filetype != nil {
+		edges = append(edges, file.EdgeFiletype)
+	}
+	if m.tags != nil {
+		edges = append(edges, file.EdgeTags)
+	}
+	if m.shares != nil {
+		edges = append(edges, file.EdgeShares)
+	}
+	return edges
+}
+
+// AddedIDs returns all IDs (to other nodes) that were added for the given edge
+// name in this mutation.
+func (m *FileMutation) AddedIDs(name string) []ent.Value {
+	switch name {
+	case file.EdgeUser:
+		if id := m.user; id != nil {
+			return []ent.Value{*id}
+		}
+	case file.EdgeFiletype:
+		if id := m.filetype; id != nil {
+			return []ent.Value{*id}
+		}
+	case file.EdgeTags:
+		ids := make([]ent.Value, 0, len(m.tags))
+		for id := range m.tags {
+			ids = append(ids, id)
+		}
+		return ids
+	case file.EdgeShares:
+		ids := make([]ent.Value, 0, len(m.shares))
+		for id := range m.shares {
+			ids = append(ids, id)
+		}
+		return ids
+	}
+	return nil
+}
+
+// RemovedEdges returns all edge names that were removed in this mutation.
+func (m *FileMutation) RemovedEdges() []string {
+	edges := make([]string, 0, 4)
+	if m.removedtags != nil {
+		edges = append(edges, file.EdgeTags)
+	}
+	if m.removedshares != nil {
+		edges = append(edges, file.EdgeShares)
+	}
+	return edges
+}
+
+// RemovedIDs returns all IDs (to other nodes) that were removed for the edge with
+// the given name in this mutation.
+func (m *FileMutation) RemovedIDs(name string) []ent.Value {
+	switch name {
+	case file.EdgeTags:
+		ids := make([]ent.Value, 0, len(m.removedtags))
+		for id := range m.removedtags {
+			ids = append(ids, id)
+		}
+		return ids
+	case file.EdgeShares:
+		ids := make([]ent.Value, 0, len(m.removedshares))
+		for id := range m.removedshares {
+			ids = append(ids, id)
+		}
+		return ids
+	}
+	return nil
+}
+
+// ClearedEdges returns all edge names that were cleared in this mutation.
+func (m *FileMutation) ClearedEdges() []string {
+	edges := make([]string, 0, 4)
+	if m.cleareduser {
+		edges = append(edges, file.EdgeUser)
+	}
+	if m.clearedfiletype {
+		edges = append(edges, file.EdgeFiletype)
+	}
+	if m.clearedtags {
+		edges = append(edges, file.EdgeTags)
+	}
+	if m.clearedshares {
+		edges = append(edges, file.EdgeShares)
+	}
+	return edges
+}
+
+// EdgeCleared returns a boolean which indicates if the edge with the given name
+// was cleared in this mutation.
+func (m *FileMutation) EdgeCleared(name string) bool {
+	switch name {
+	case file.EdgeUser:
+		return m.cleareduser
+	case file.EdgeFiletype:
+		return m.clearedfiletype
+	case file.EdgeTags:
+		return m.clearedtags
+	case file.EdgeShares:
+		return m.clearedshares
+	}
+	return false
+}
+
+// ClearEdge clears the value of the edge with the given name. It returns an error
+// if that edge is not defined in the schema.
+func (m *FileMutation) ClearEdge(name string) error {
+	switch name {
+	case file.EdgeUser:
+		m.ClearUser()
+		return nil
+	case file.EdgeFiletype:
+		m.ClearFiletype()
+		return nil
+	}
+	return fmt.Errorf("unknown File unique edge %s", name)
+}
+
+// ResetEdge resets all changes to the edge with the given name in this mutation.
+// It returns an error if the edge is not defined in the schema.
+func (m *FileMutation) ResetEdge(name string) error {
+	switch name {
+	case file.EdgeUser:
+		m.ResetUser()
+		return nil
+	case file.EdgeFiletype:
+		m.ResetFiletype()
+		return nil
+	case file.EdgeTags:
+		m.ResetTags()
+		return nil
+	case file.EdgeShares:
+		m.ResetShares()
+		return nil
+	}
+	return fmt.Errorf("unknown File edge %s", name)
+}
+
+// FiletypeMutation represents an operation that mutates the Filetype nodes in the graph.
+type FiletypeMutation struct {
+	config
+	op              Op
+	typ             string
+	id              *string
+	allowed_size    *int
+	addallowed_size *int
+	is_banned       *bool
+	created_at      *time.Time
+	updated_at      *time.Time
+	clearedFields   map[string]struct{}
+	files           map[int]struct{}
+	removedfiles    map[int]struct{}
+	clearedfiles    bool
+	done            bool
+	oldValue        func(context.Context) (*Filetype, error)
+	predicates      []predicate.Filetype
+}
+
+var _ ent.Mutation = (*FiletypeMutation)(nil)
+
+// filetypeOption allows management of the mutation configuration using functional options.
+type filetypeOption func(*FiletypeMutation)
+
+// newFiletypeMutation creates new mutation for the Filetype entity.
+func newFiletypeMutation(c config, op Op, opts ...filetypeOption) *FiletypeMutation {
+	m := &FiletypeMutation{
+		config:        c,
+		op:            op,
+		typ:           TypeFiletype,
+		clearedFields: make(map[string]struct{}),
+	}
+	for _, opt := range opts {
+		opt(m)
+	}
+	return m
+}
+
+// withFiletypeID sets the ID field of the mutation.
+func withFiletypeID(id string) filetypeOption {
+	return func(m *FiletypeMutation) {
+		var (
+			err   error
+			once  sync.Once
+			value *Filetype
+		)
+		m.oldValue = func(ctx context.Context) (*Filetype, error) {
+			once.Do(func() {
+				if m.done {
+					err = errors.New("querying old values post mutation is not allowed")
+				} else {
+					value, err = m.Client().Filetype.Get(ctx, id)
+				}
+			})
+			return value, err
+		}
+		m.id = &id
+	}
+}
+
+// withFiletype sets the old Filetype of the mutation.
+func withFiletype(node *Filetype) filetypeOption {
+	return func(m *FiletypeMutation) {
+		m.oldValue = func(context.Context) (*Filetype, error) {
+			return node, nil
+		}
+		m.id = &node.ID
+	}
+}
+
+// Client returns a new `ent.Client` from the mutation. If the mutation was
+// executed in a transaction (ent.Tx), a transactional client is returned.
+func (m FiletypeMutation) Client() *Client {
+	client := &Client{config: m.config}
+	client.init()
+	return client
+}
+
+// Tx returns an `ent.Tx` for mutations that were executed in transactions;
+// it returns an error otherwise.
+func (m FiletypeMutation) Tx() (*Tx, error) {
+	if _, ok := m.driver.(*txDriver); !ok {
+		return nil, errors.New("ent: mutation is not running in a transaction")
+	}
+	tx := &Tx{config: m.config}
+	tx.init()
+	return tx, nil
+}
+
+// SetID sets the value of the id field. Note that this
+// operation is only accepted on creation of Filetype entities.
+func (m *FiletypeMutation) SetID(id string) {
+	m.id = &id
+}
+
+// ID returns the ID value in the mutation. Note that the ID is only available
+// if it was provided to the builder or after it was returned from the database.
+func (m *FiletypeMutation) ID() (id string, exists bool) {
+	if m.id == nil {
+		return
+	}
+	return *m.id, true
+}
+
+// IDs queries the database and returns the entity ids that match the mutation's predicate.
+// That means, if the mutation is applied within a transaction with an isolation level such
+// as sql.LevelSerializable, the returned ids match the ids of the rows that will be updated
+// or updated by the mutation.
+func (m *FiletypeMutation) IDs(ctx context.Context) ([]string, error) {
+	switch {
+	case m.op.Is(OpUpdateOne | OpDeleteOne):
+		id, exists := m.ID()
+		if exists {
+			return []string{id}, nil
+		}
+		fallthrough
+	case m.op.Is(OpUpdate | OpDelete):
+		return m.Client().Filetype.Query().Where(m.predicates...).IDs(ctx)
+	default:
+		return nil, fmt.Errorf("IDs is not allowed on %s operations", m.op)
+	}
+}
+
+// SetAllowedSize sets the "allowed_size" field.
+func (m *FiletypeMutation) SetAllowedSize(i int) {
+	m.allowed_size = &i
+	m.addallowed_size = nil
+}
+
+// AllowedSize returns the value of the "allowed_size" field in the mutation.
+func (m *FiletypeMutation) AllowedSize() (r int, exists bool) {
+	v := m.allowed_size
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldAllowedSize returns the old "allowed_size" field's value of the Filetype entity.
+// If the Filetype object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *FiletypeMutation) OldAllowedSize(ctx context.Context) (v int, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldAllowedSize is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldAllowedSize requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldAllowedSize: %w", err)
+	}
+	return oldValue.AllowedSize, nil
+}
+
+// AddAllowedSize adds i to the "allowed_size" field.
+func (m *FiletypeMutation) AddAllowedSize(i int) {
+	if m.addallowed_size != nil {
+		*m.addallowed_size += i
+	} else {
+		m.addallowed_size = &i
+	}
+}
+
+// AddedAllowedSize returns the value that was added to the "allowed_size" field in this mutation.
+func (m *FiletypeMutation) AddedAllowedSize() (r int, exists bool) {
+	v := m.addallowed_size
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// ResetAllowedSize resets all changes to the "allowed_size" field.
+func (m *FiletypeMutation) ResetAllowedSize() {
+	m.allowed_size = nil
+	m.addallowed_size = nil
+}
+
+// SetIsBanned sets the "is_banned" field.
+func (m *FiletypeMutation) SetIsBanned(b bool) {
+	m.is_banned = &b
+}
+
+// IsBanned returns the value of the "is_banned" field in the mutation.
+func (m *FiletypeMutation) IsBanned() (r bool, exists bool) {
+	v := m.is_banned
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldIsBanned returns the old "is_banned" field's value of the Filetype entity.
+// If the Filetype object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *FiletypeMutation) OldIsBanned(ctx context.Context) (v bool, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldIsBanned is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldIsBanned requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldIsBanned: %w", err)
+	}
+	return oldValue.IsBanned, nil
+}
+
+// ResetIsBanned resets all changes to the "is_banned" field.
+func (m *FiletypeMutation) ResetIsBanned() {
+	m.is_banned = nil
+}
+
+// SetCreatedAt sets the "created_at" field.
+func (m *FiletypeMutation) SetCreatedAt(t time.Time) {
+	m.created_at = &t
+}
+
+// CreatedAt returns the value of the "created_at" field in the mutation.
+func (m *FiletypeMutation) CreatedAt() (r time.Time, exists bool) {
+	v := m.created_at
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldCreatedAt returns the old "created_at" field's value of the Filetype entity.
+// If the Filetype object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *FiletypeMutation) OldCreatedAt(ctx context.Context) (v *time.Time, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldCreatedAt is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldCreatedAt requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldCreatedAt: %w", err)
+	}
+	return oldValue.CreatedAt, nil
+}
+
+// ClearCreatedAt clears the value of the "created_at" field.
+func (m *FiletypeMutation) ClearCreatedAt() {
+	m.created_at = nil
+	m.clearedFields[filetype.FieldCreatedAt] = struct{}{}
+}
+
+// CreatedAtCleared returns if the "created_at" field was cleared in this mutation.
+func (m *FiletypeMutation) CreatedAtCleared() bool {
+	_, ok := m.clearedFields[filetype.FieldCreatedAt]
+	return ok
+}
+
+// ResetCreatedAt resets all changes to the "created_at" field.
+func (m *FiletypeMutation) ResetCreatedAt() {
+	m.created_at = nil
+	delete(m.clearedFields, filetype.FieldCreatedAt)
+}
+
+// SetUpdatedAt sets the "updated_at" field.
+func (m *FiletypeMutation) SetUpdatedAt(t time.Time) {
+	m.updated_at = &t
+}
+
+// UpdatedAt returns the value of the "updated_at" field in the mutation.
+func (m *FiletypeMutation) UpdatedAt() (r time.Time, exists bool) {
+	v := m.updated_at
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldUpdatedAt returns the old "updated_at" field's value of the Filetype entity.
+// If the Filetype object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *FiletypeMutation) OldUpdatedAt(ctx context.Context) (v time.Time, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldUpdatedAt is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldUpdatedAt requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldUpdatedAt: %w", err)
+	}
+	return oldValue.UpdatedAt, nil
+}
+
+// ResetUpdatedAt resets all changes to the "updated_at" field.
+func (m *FiletypeMutation) ResetUpdatedAt() {
+	m.updated_at = nil
+}
+
+// AddFileIDs adds the "files" edge to the File entity by ids.
+func (m *FiletypeMutation) AddFileIDs(ids ...int) {
+	if m.files == nil {
+		m.files = make(map[int]struct{})
+	}
+	for i := range ids {
+		m.files[ids[i]] = struct{}{}
+	}
+}
+
+// ClearFiles clears the "files" edge to the File entity.
+func (m *FiletypeMutation) ClearFiles() {
+	m.clearedfiles = true
+}
+
+// FilesCleared reports if the "files" edge to the File entity was cleared.
+func (m *FiletypeMutation) FilesCleared() bool {
+	return m.clearedfiles
+}
+
+// RemoveFileIDs removes the "files" edge to the File entity by IDs.
+func (m *FiletypeMutation) RemoveFileIDs(ids ...int) {
+	if m.removedfiles == nil {
+		m.removedfiles = make(map[int]struct{})
+	}
+	for i := range ids {
+		delete(m.files, ids[i])
+		m.removedfiles[ids[i]] = struct{}{}
+	}
+}
+
+// RemovedFiles returns the removed IDs of the "files" edge to the File entity.
+func (m *FiletypeMutation) RemovedFilesIDs() (ids []int) {
+	for id := range m.removedfiles {
+		ids = append(ids, id)
+	}
+	return
+}
+
+// FilesIDs returns the "files" edge IDs in the mutation.
+func (m *FiletypeMutation) FilesIDs() (ids []int) {
+	for id := range m.files {
+		ids = append(ids, id)
+	}
+	return
+}
+
+// ResetFiles resets all changes to the "files" edge.
+func (m *FiletypeMutation) ResetFiles() {
+	m.files = nil
+	m.clearedfiles = false
+	m.removedfiles = nil
+}
+
+// Where appends a list predicates to the FiletypeMutation builder.
+func (m *FiletypeMutation) Where(ps ...predicate.Filetype) {
+	m.predicates = append(m.predicates, ps...)
+}
+
+// WhereP appends storage-level predicates to the FiletypeMutation builder. Using this method,
+// users can use type-assertion to append predicates that do not depend on any generated package.
+func (m *FiletypeMutation) WhereP(ps ...func(*sql.Selector)) {
+	p := make([]predicate.Filetype, len(ps))
+	for i := range ps {
+		p[i] = ps[i]
+	}
+	m.Where(p...)
+}
+
+// Op returns the operation name.
+func (m *FiletypeMutation) Op() Op {
+	return m.op
+}
+
+// SetOp allows setting the mutation operation.
+func (m *FiletypeMutation) SetOp(op Op) {
+	m.op = op
+}
+
+// Type returns the node type of this mutation (Filetype).
+func (m *FiletypeMutation) Type() string {
+	return m.typ
+}
+
+// Fields returns all fields that were changed during this mutation. Note that in
+// order to get all numeric fields that were incremented/decremented, call
+// AddedFields().
+func (m *FiletypeMutation) Fields() []string {
+	fields := make([]string, 0, 4)
+	if m.allowed_size != nil {
+		fields = append(fields, filetype.FieldAllowedSize)
+	}
+	if m.is_banned != nil {
+		fields = append(fields, filetype.FieldIsBanned)
+	}
+	if m.created_at != nil {
+		fields = append(fields, filetype.FieldCreatedAt)
+	}
+	if m.updated_at != nil {
+		fields = append(fields, filetype.FieldUpdatedAt)
+	}
+	return fields
+}
+
+// Field returns the value of a field with the given name. The second boolean
+// return value indicates that this field was not set, or was not defined in the
+// schema.
+func (m *FiletypeMutation) Field(name string) (ent.Value, bool) {
+	switch name {
+	case filetype.FieldAllowedSize:
+		return m.AllowedSize()
+	case filetype.FieldIsBanned:
+		return m.IsBanned()
+	case filetype.FieldCreatedAt:
+		return m.CreatedAt()
+	case filetype.FieldUpdatedAt:
+		return m.UpdatedAt()
+	}
+	return nil, false
+}
+
+// OldField returns the old value of the field from the database. An error is
+// returned if the mutation operation is not UpdateOne, or the query to the
+// database failed.
+func (m *FiletypeMutation) OldField(ctx context.Context, name string) (ent.Value, error) {
+	switch name {
+	case filetype.FieldAllowedSize:
+		return m.OldAllowedSize(ctx)
+	case filetype.FieldIsBanned:
+		return m.OldIsBanned(ctx)
+	case filetype.FieldCreatedAt:
+		return m.OldCreatedAt(ctx)
+	case filetype.FieldUpdatedAt:
+		return m.OldUpdatedAt(ctx)
+	}
+	return nil, fmt.Errorf("unknown Filetype field %s", name)
+}
+
+// SetField sets the value of a field with the given name. It returns an error if
+// the field is not defined in the schema, or if the type mismatched the field
+// type.
+func (m *FiletypeMutation) SetField(name string, value ent.Value) error {
+	switch name {
+	case filetype.FieldAllowedSize:
+		v, ok := value.(int)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetAllowedSize(v)
+		return nil
+	case filetype.FieldIsBanned:
+		v, ok := value.(bool)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetIsBanned(v)
+		return nil
+	case filetype.FieldCreatedAt:
+		v, ok := value.(time.Time)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetCreatedAt(v)
+		return nil
+	case filetype.FieldUpdatedAt:
+		v, ok := value.(time.Time)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetUpdatedAt(v)
+		return nil
+	}
+	return fmt.Errorf("unknown Filetype field %s", name)
+}
+
+// AddedFields returns all numeric fields that were incremented/decremented during
+// this mutation.
+func (m *FiletypeMutation) AddedFields() []string {
+	var fields []string
+	if m.addallowed_size != nil {
+		fields = append(fields, filetype.FieldAllowedSize)
+	}
+	return fields
+}
+
+// AddedField returns the numeric value that was incremented/decremented on a field
+// with the given name. The second boolean return value indicates that this field
+// was not set, or was not defined in the schema.
+func (m *FiletypeMutation) AddedField(name string) (ent.Value, bool) {
+	switch name {
+	case filetype.FieldAllowedSize:
+		return m.AddedAllowedSize()
+	}
+	return nil, false
+}
+
+// AddField adds the value to the field with the given name. It returns an error if
+// the field is not defined in the schema, or if the type mismatched the field
+// type.
+func (m *FiletypeMutation) AddField(name string, value ent.Value) error {
+	switch name {
+	case filetype.FieldAllowedSize:
+		v, ok := value.(int)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.AddAllowedSize(v)
+		return nil
+	}
+	return fmt.Errorf("unknown Filetype numeric field %s", name)
+}
+
+// ClearedFields returns all nullable fields that were cleared during this
+// mutation.
+func (m *FiletypeMutation) ClearedFields() []string {
+	var fields []string
+	if m.FieldCleared(filetype.FieldCreatedAt) {
+		fields = append(fields, filetype.FieldCreatedAt)
+	}
+	return fields
+}
+
+// FieldCleared returns a boolean indicating if a field with the given name was
+// cleared in this mutation.
+func (m *FiletypeMutation) FieldCleared(name string) bool {
+	_, ok := m.clearedFields[name]
+	return ok
+}
+
+// ClearField clears the value of the field with the given name. It returns an
+// error if the field is not defined in the schema.
+func (m *FiletypeMutation) ClearField(name string) error {
+	switch name {
+	case filetype.FieldCreatedAt:
+		m.ClearCreatedAt()
+		return nil
+	}
+	return fmt.Errorf("unknown Filetype nullable field %s", name)
+}
+
+// ResetField resets all changes in the mutation for the field with the given name.
+// It returns an error if the field is not defined in the schema.
+func (m *FiletypeMutation) ResetField(name string) error {
+	switch name {
+	case filetype.FieldAllowedSize:
+		m.ResetAllowedSize()
+		return nil
+	case filetype.FieldIsBanned:
+		m.ResetIsBanned()
+		return nil
+	case filetype.FieldCreatedAt:
+		m.ResetCreatedAt()
+		return nil
+	case filetype.FieldUpdatedAt:
+		m.ResetUpdatedAt()
+		return nil
+	}
+	return fmt.Errorf("unknown Filetype field %s", name)
+}
+
+// AddedEdges returns all edge names that were set/added in this mutation.
+func (m *FiletypeMutation) AddedEdges() []string {
+	edges := make([]string, 0, 1)
+	if m.files != nil {
+		edges = append(edges, filetype.EdgeFiles)
+	}
+	return edges
+}
+
+// AddedIDs returns all IDs (to other nodes) that were added for the given edge
+// name in this mutation.
+func (m *FiletypeMutation) AddedIDs(name string) []ent.Value {
+	switch name {
+	case filetype.EdgeFiles:
+		ids := make([]ent.Value, 0, len(m.files))
+		for id := range m.files {
+			ids = append(ids, id)
+		}
+		return ids
+	}
+	return nil
+}
+
+// RemovedEdges returns all edge names that were removed in this mutation.
+func (m *FiletypeMutation) RemovedEdges() []string {
+	edges := make([]string, 0, 1)
+	if m.removedfiles != nil {
+		edges = append(edges, filetype.EdgeFiles)
+	}
+	return edges
+}
+
+// RemovedIDs returns all IDs (to other nodes) that were removed for the edge with
+// the given name in this mutation.
+func (m *FiletypeMutation) RemovedIDs(name string) []ent.Value {
+	switch name {
+	case filetype.EdgeFiles:
+		ids := make([]ent.Value, 0, len(m.removedfiles))
+		for id := range m.removedfiles {
+			ids = append(ids, id)
+		}
+		return ids
+	}
+	return nil
+}
+
+// ClearedEdges returns all edge names that were cleared in this mutation.
+func (m *FiletypeMutation) ClearedEdges() []string {
+	edges := make([]string, 0, 1)
+	if m.clearedfiles {
+		edges = append(edges, filetype.EdgeFiles)
+	}
+	return edges
+}
+
+// EdgeCleared returns a boolean which indicates if the edge with the given name
+// was cleared in this mutation.
+func (m *FiletypeMutation) EdgeCleared(name string) bool {
+	switch name {
+	case filetype.EdgeFiles:
+		return m.clearedfiles
+	}
+	return false
+}
+
+// ClearEdge clears the value of the edge with the given name. It returns an error
+// if that edge is not defined in the schema.
+func (m *FiletypeMutation) ClearEdge(name string) error {
+	switch name {
+	}
+	return fmt.Errorf("unknown Filetype unique edge %s", name)
+}
+
+// ResetEdge resets all changes to the edge with the given name in this mutation.
+// It returns an error if the edge is not defined in the schema.
+func (m *FiletypeMutation) ResetEdge(name string) error {
+	switch name {
+	case filetype.EdgeFiles:
+		m.ResetFiles()
+		return nil
+	}
+	return fmt.Errorf("unknown Filetype edge %s", name)
+}
+
+// KeyRotationMutation represents an operation that mutates the KeyRotation nodes in the graph.
+type KeyRotationMutation struct {
+	config
+	op            Op
+	typ           string
+	id            *int
+	key_id        *string
+	status        *keyrotation.Status
+	total         *int
+	addtotal      *int
+	_done         *int
+	add_done      *int
+	failed        *int
+	addfailed     *int
+	error         *string
+	created_at    *time.Time
+	updated_at    *time.Time
+	finished_at   *time.Time
+	clearedFields map[string]struct{}
+	done          bool
+	oldValue      func(context.Context) (*KeyRotation, error)
+	predicates    []predicate.KeyRotation
+}
+
+var _ ent.Mutation = (*KeyRotationMutation)(nil)
+
+// keyrotationOption allows management of the mutation configuration using functional options.
+type keyrotationOption func(*KeyRotationMutation)
+
+// newKeyRotationMutation creates new mutation for the KeyRotation entity.
+func newKeyRotationMutation(c config, op Op, opts ...keyrotationOption) *KeyRotationMutation {
+	m := &KeyRotationMutation{
+		config:        c,
+		op:            op,
+		typ:           TypeKeyRotation,
+		clearedFields: make(map[string]struct{}),
+	}
+	for _, opt := range opts {
+		opt(m)
+	}
+	return m
+}
+
+// withKeyRotationID sets the ID field of the mutation.
+func withKeyRotationID(id int) keyrotationOption {
+	return func(m *KeyRotationMutation) {
+		var (
+			err   error
+			once  sync.Once
+			value *KeyRotation
+		)
+		m.oldValue = func(ctx context.Context) (*KeyRotation, error) {
+			once.Do(func() {
+				if m.done {
+					err = errors.New("querying old values post mutation is not allowed")
+				} else {
+					value, err = m.Client().KeyRotation.Get(ctx, id)
+				}
+			})
+			return value, err
+		}
+		m.id = &id
+	}
+}
+
+// withKeyRotation sets the old KeyRotation of the mutation.
+func withKeyRotation(node *KeyRotation) keyrotationOption {
+	return func(m *KeyRotationMutation) {
+		m.oldValue = func(context.Context) (*KeyRotation, error) {
+			return node, nil
+		}
+		m.id = &node.ID
+	}
+}
+
+// Client returns a new `ent.Client` from the mutation. If the mutation was
+// executed in a transaction (ent.Tx), a transactional client is returned.
+func (m KeyRotationMutation) Client() *Client {
+	client := &Client{config: m.config}
+	client.init()
+	return client
+}
+
+// Tx returns an `ent.Tx` for mutations that were executed in transactions;
+// it returns an error otherwise.
+func (m KeyRotationMutation) Tx() (*Tx, error) {
+	if _, ok := m.driver.(*txDriver); !ok {
+		return nil, errors.New("ent: mutation is not running in a transaction")
+	}
+	tx := &Tx{config: m.config}
+	tx.init()
+	return tx, nil
+}
+
+// ID returns the ID value in the mutation. Note that the ID is only available
+// if it was provided to the builder or after it was returned from the database.
+func (m *KeyRotationMutation) ID() (id int, exists bool) {
+	if m.id == nil {
+		return
+	}
+	return *m.id, true
+}
+
+// IDs queries the database and returns the entity ids that match the mutation's predicate.
+// That means, if the mutation is applied within a transaction with an isolation level such
+// as sql.LevelSerializable, the returned ids match the ids of the rows that will be updated
+// or updated by the mutation.
+func (m *KeyRotationMutation) IDs(ctx context.Context) ([]int, error) {
+	switch {
+	case m.op.Is(OpUpdateOne | OpDeleteOne):
+		id, exists := m.ID()
+		if exists {
+			return []int{id}, nil
+		}
+		fallthrough
+	case m.op.Is(OpUpdate | OpDelete):
+		return m.Client().KeyRotation.Query().Where(m.predicates...).IDs(ctx)
+	default:
+		return nil, fmt.Errorf("IDs is not allowed on %s operations", m.op)
+	}
+}
+
+// SetKeyID sets the "key_id" field.
+func (m *KeyRotationMutation) SetKeyID(s string) {
+	m.key_id = &s
+}
+
+// KeyID returns the value of the "key_id" field in the mutation.
+func (m *KeyRotationMutation) KeyID() (r string, exists bool) {
+	v := m.key_id
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldKeyID returns the old "key_id" field's value of the KeyRotation entity.
+// If the KeyRotation object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *KeyRotationMutation) OldKeyID(ctx context.Context) (v string, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldKeyID is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldKeyID requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldKeyID: %w", err)
+	}
+	return oldValue.KeyID, nil
+}
+
+// ResetKeyID resets all changes to the "key_id" field.
+func (m *KeyRotationMutation) ResetKeyID() {
+	m.key_id = nil
+}
+
+// SetStatus sets the "status" field.
+func (m *KeyRotationMutation) SetStatus(k keyrotation.Status) {
+	m.status = &k
+}
+
+// Status returns the value of the "status" field in the mutation.
+func (m *KeyRotationMutation) Status() (r keyrotation.Status, exists bool) {
+	v := m.status
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldStatus returns the old "status" field's value of the KeyRotation entity.
+// If the KeyRotation object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *KeyRotationMutation) OldStatus(ctx context.Context) (v keyrotation.Status, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldStatus is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldStatus requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldStatus: %w", err)
+	}
+	return oldValue.Status, nil
+}
+
+// ResetStatus resets all changes to the "status" field.
+func (m *KeyRotationMutation) ResetStatus() {
+	m.status = nil
+}
+
+// SetTotal sets the "total" field.
+func (m *KeyRotationMutation) SetTotal(i int) {
+	m.total = &i
+	m.addtotal = nil
+}
+
+// Total returns the value of the "total" field in the mutation.
+func (m *KeyRotationMutation) Total() (r int, exists bool) {
+	v := m.total
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldTotal returns the old "total" field's value of the KeyRotation entity.
+// If the KeyRotation object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *KeyRotationMutation) OldTotal(ctx context.Context) (v int, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldTotal is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldTotal requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldTotal: %w", err)
+	}
+	return oldValue.Total, nil
+}
+
+// AddTotal adds i to the "total" field.
+func (m *KeyRotationMutation) AddTotal(i int) {
+	if m.addtotal != nil {
+		*m.addtotal += i
+	} else {
+		m.addtotal = &i
+	}
+}
+
+// AddedTotal returns the value that was added to the "total" field in this mutation.
+func (m *KeyRotationMutation) AddedTotal() (r int, exists bool) {
+	v := m.addtotal
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// ResetTotal resets all changes to the "total" field.
+func (m *KeyRotationMutation) ResetTotal() {
+	m.total = nil
+	m.addtotal = nil
+}
+
+// SetDone sets the "done" field.
+func (m *KeyRotationMutation) SetDone(i int) {
+	m._done = &i
+	m.add_done = nil
+}
+
+// Done returns the value of the "done" field in the mutation.
+func (m *KeyRotationMutation) Done() (r int, exists bool) {
+	v := m._done
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldDone returns the old "done" field's value of the KeyRotation entity.
+// If the KeyRotation object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *KeyRotationMutation) OldDone(ctx context.Context) (v int, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldDone is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldDone requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldDone: %w", err)
+	}
+	return oldValue.Done, nil
+}
+
+// AddDone adds i to the "done" field.
+func (m *KeyRotationMutation) AddDone(i int) {
+	if m.add_done != nil {
+		*m.add_done += i
+	} else {
+		m.add_done = &i
+	}
+}
+
+// AddedDone returns the value that was added to the "done" field in this mutation.
+func (m *KeyRotationMutation) AddedDone() (r int, exists bool) {
+	v := m.add_done
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// ResetDone resets all changes to the "done" field.
+func (m *KeyRotationMutation) ResetDone() {
+	m._done = nil
+	m.add_done = nil
+}
+
+// SetFailed sets the "failed" field.
+func (m *KeyRotationMutation) SetFailed(i int) {
+	m.failed = &i
+	m.addfailed = nil
+}
+
+// Failed returns the value of the "failed" field in the mutation.
+func (m *KeyRotationMutation) Failed() (r int, exists bool) {
+	v := m.failed
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldFailed returns the old "failed" field's value of the KeyRotation entity.
+// If the KeyRotation object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *KeyRotationMutation) OldFailed(ctx context.Context) (v int, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldFailed is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldFailed requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldFailed: %w", err)
+	}
+	return oldValue.Failed, nil
+}
+
+// AddFailed adds i to the "failed" field.
+func (m *KeyRotationMutation) AddFailed(i int) {
+	if m.addfailed != nil {
+		*m.addfailed += i
+	} else {
+		m.addfailed = &i
+	}
+}
+
+// AddedFailed returns the value that was added to the "failed" field in this mutation.
+func (m *KeyRotationMutation) AddedFailed() (r int, exists bool) {
+	v := m.addfailed
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// ResetFailed resets all changes to the "failed" field.
+func (m *KeyRotationMutation) ResetFailed() {
+	m.failed = nil
+	m.addfailed = nil
+}
+
+// SetError sets the "error" field.
+func (m *KeyRotationMutation) SetError(s string) {
+	m.error = &s
+}
+
+// Error returns the value of the "error" field in the mutation.
+func (m *KeyRotationMutation) Error() (r string, exists bool) {
+	v := m.error
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldError returns the old "error" field's value of the KeyRotation entity.
+// If the KeyRotation object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *KeyRotationMutation) OldError(ctx context.Context) (v string, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldError is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldError requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldError: %w", err)
+	}
+	return oldValue.Error, nil
+}
+
+// ClearError clears the value of the "error" field.
+func (m *KeyRotationMutation) ClearError() {
+	m.error = nil
+	m.clearedFields[keyrotation.FieldError] = struct{}{}
+}
+
+// ErrorCleared returns if the "error" field was cleared in this mutation.
+func (m *KeyRotationMutation) ErrorCleared() bool {
+	_, ok := m.clearedFields[keyrotation.FieldError]
+	return ok
+}
+
+// ResetError resets all changes to the "error" field.
+func (m *KeyRotationMutation) ResetError() {
+	m.error = nil
+	delete(m.clearedFields, keyrotation.FieldError)
+}
+
+// SetCreatedAt sets the "created_at" field.
+func (m *KeyRotationMutation) SetCreatedAt(t time.Time) {
+	m.created_at = &t
+}
+
+// CreatedAt returns the value of the "created_at" field in the mutation.
+func (m *KeyRotationMutation) CreatedAt() (r time.Time, exists bool) {
+	v := m.created_at
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldCreatedAt returns the old "created_at" field's value of the KeyRotation entity.
+// If the KeyRotation object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *KeyRotationMutation) OldCreatedAt(ctx context.Context) (v *time.Time, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldCreatedAt is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldCreatedAt requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldCreatedAt: %w", err)
+	}
+	return oldValue.CreatedAt, nil
+}
+
+// ClearCreatedAt clears the value of the "created_at" field.
+func (m *KeyRotationMutation) ClearCreatedAt() {
+	m.created_at = nil
+	m.clearedFields[keyrotation.FieldCreatedAt] = struct{}{}
+}
+
+// CreatedAtCleared returns if the "created_at" field was cleared in this mutation.
+func (m *KeyRotationMutation) CreatedAtCleared() bool {
+	_, ok := m.clearedFields[keyrotation.FieldCreatedAt]
+	return ok
+}
+
+// ResetCreatedAt resets all changes to the "created_at" field.
+func (m *KeyRotationMutation) ResetCreatedAt() {
+	m.created_at = nil
+	delete(m.clearedFields, keyrotation.FieldCreatedAt)
+}
+
+// SetUpdatedAt sets the "updated_at" field.
+func (m *KeyRotationMutation) SetUpdatedAt(t time.Time) {
+	m.updated_at = &t
+}
+
+// UpdatedAt returns the value of the "updated_at" field in the mutation.
+func (m *KeyRotationMutation) UpdatedAt() (r time.Time, exists bool) {
+	v := m.updated_at
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldUpdatedAt returns the old "updated_at" field's value of the KeyRotation entity.
+// If the KeyRotation object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *KeyRotationMutation) OldUpdatedAt(ctx context.Context) (v time.Time, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldUpdatedAt is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldUpdatedAt requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldUpdatedAt: %w", err)
+	}
+	return oldValue.UpdatedAt, nil
+}
+
+// ResetUpdatedAt resets all changes to the "updated_at" field.
+func (m *KeyRotationMutation) ResetUpdatedAt() {
+	m.updated_at = nil
+}
+
+// SetFinishedAt sets the "finished_at" field.
+func (m *KeyRotationMutation) SetFinishedAt(t time.Time) {
+	m.finished_at = &t
+}
+
+// FinishedAt returns the value of the "finished_at" field in the mutation.
+func (m *KeyRotationMutation) FinishedAt() (r time.Time, exists bool) {
+	v := m.finished_at
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldFinishedAt returns the old "finished_at" field's value of the KeyRotation entity.
+// If the KeyRotation object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *KeyRotationMutation) OldFinishedAt(ctx context.Context) (v *time.Time, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldFinishedAt is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldFinishedAt requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldFinishedAt: %w", err)
+	}
+	return oldValue.FinishedAt, nil
+}
+
+// ClearFinishedAt clears the value of the "finished_at" field.
+func (m *KeyRotationMutation) ClearFinishedAt() {
+	m.finished_at = nil
+	m.clearedFields[keyrotation.FieldFinishedAt] = struct{}{}
+}
+
+// FinishedAtCleared returns if the "finished_at" field was cleared in this mutation.
+func (m *KeyRotationMutation) FinishedAtCleared() bool {
+	_, ok := m.clearedFields[keyrotation.FieldFinishedAt]
+	return ok
+}
+
+// ResetFinishedAt resets all changes to the "finished_at" field.
+func (m *KeyRotationMutation) ResetFinishedAt() {
+	m.finished_at = nil
+	delete(m.clearedFields, keyrotation.FieldFinishedAt)
+}
+
+// Where appends a list predicates to the KeyRotationMutation builder.
+func (m *KeyRotationMutation) Where(ps ...predicate.KeyRotation) {
+	m.predicates = append(m.predicates, ps...)
+}
+
+// WhereP appends storage-level predicates to the KeyRotationMutation builder. Using this method,
+// users can use type-assertion to append predicates that do not depend on any generated package.
+func (m *KeyRotationMutation) WhereP(ps ...func(*sql.Selector)) {
+	p := make([]predicate.KeyRotation, len(ps))
+	for i := range ps {
+		p[i] = ps[i]
+	}
+	m.Where(p...)
+}
+
+// Op returns the operation name.
+func (m *KeyRotationMutation) Op() Op {
+	return m.op
+}
+
+// SetOp allows setting the mutation operation.
+func (m *KeyRotationMutation) SetOp(op Op) {
+	m.op = op
+}
+
+// Type returns the node type of this mutation (KeyRotation).
+func (m *KeyRotationMutation) Type() string {
+	return m.typ
+}
+
+// Fields returns all fields that were changed during this mutation. Note that in
+// order to get all numeric fields that were incremented/decremented, call
+// AddedFields().
+func (m *KeyRotationMutation) Fields() []string {
+	fields := make([]string, 0, 9)
+	if m.key_id != nil {
+		fields = append(fields, keyrotation.FieldKeyID)
+	}
+	if m.status != nil {
+		fields = append(fields, keyrotation.FieldStatus)
+	}
+	if m.total != nil {
+		fields = append(fields, keyrotation.FieldTotal)
+	}
+	if m._done != nil {
+		fields = append(fields, keyrotation.FieldDone)
+	}
+	if m.failed != nil {
+		fields = append(fields, keyrotation.FieldFailed)
+	}
+	if m.error != nil {
+		fields = append(fields, keyrotation.FieldError)
+	}
+	if m.created_at != nil {
+		fields = append(fields, keyrotation.FieldCreatedAt)
+	}
+	if m.updated_at != nil {
+		fields = append(fields, keyrotation.FieldUpdatedAt)
+	}
+	if m.finished_at != nil {
+		fields = append(fields, keyrotation.FieldFinishedAt)
+	}
+	return fields
+}
+
+// Field returns the value of a field with the given name. The second boolean
+// return value indicates that this field was not set, or was not defined in the
+// schema.
+func (m *KeyRotationMutation) Field(name string) (ent.Value, bool) {
+	switch name {
+	case keyrotation.FieldKeyID:
+		return m.KeyID()
+	case keyrotation.FieldStatus:
+		return m.Status()
+	case keyrotation.FieldTotal:
+		return m.Total()
+	case keyrotation.FieldDone:
+		return m.Done()
+	case keyrotation.FieldFailed:
+		return m.Failed()
+	case keyrotation.FieldError:
+		return m.Error()
+	case keyrotation.FieldCreatedAt:
+		return m.CreatedAt()
+	case keyrotation.FieldUpdatedAt:
+		return m.UpdatedAt()
+	case keyrotation.FieldFinishedAt:
+		return m.FinishedAt()
+	}
+	return nil, false
+}
+
+// OldField returns the old value of the field from the database. An error is
+// returned if the mutation operation is not UpdateOne, or the query to the
+// database failed.
+func (m *KeyRotationMutation) OldField(ctx context.Context, name string) (ent.Value, error) {
+	switch name {
+	case keyrotation.FieldKeyID:
+		return m.OldKeyID(ctx)
+	case keyrotation.FieldStatus:
+		return m.OldStatus(ctx)
+	case keyrotation.FieldTotal:
+		return m.OldTotal(ctx)
+	case keyrotation.FieldDone:
+		return m.OldDone(ctx)
+	case keyrotation.FieldFailed:
+		return m.OldFailed(ctx)
+	case keyrotation.FieldError:
+		return m.OldError(ctx)
+	case keyrotation.FieldCreatedAt:
+		return m.OldCreatedAt(ctx)
+	case keyrotation.FieldUpdatedAt:
+		return m.OldUpdatedAt(ctx)
+	case keyrotation.FieldFinishedAt:
+		return m.OldFinishedAt(ctx)
+	}
+	return nil, fmt.Errorf("unknown KeyRotation field %s", name)
+}
+
+// SetField sets the value of a field with the given name. It returns an error if
+// the field is not defined in the schema, or if the type mismatched the field
+// type.
+func (m *KeyRotationMutation) SetField(name string, value ent.Value) error {
+	switch name {
+	case keyrotation.FieldKeyID:
+		v, ok := value.(string)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetKeyID(v)
+		return nil
+	case keyrotation.FieldStatus:
+		v, ok := value.(keyrotation.Status)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetStatus(v)
+		return nil
+	case keyrotation.FieldTotal:
+		v, ok := value.(int)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetTotal(v)
+		return nil
+	case keyrotation.FieldDone:
+		v, ok := value.(int)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetDone(v)
+		return nil
+	case keyrotation.FieldFailed:
+		v, ok := value.(int)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetFailed(v)
+		return nil
+	case keyrotation.FieldError:
+		v, ok := value.(string)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetError(v)
+		return nil
+	case keyrotation.FieldCreatedAt:
+		v, ok := value.(time.Time)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetCreatedAt(v)
+		return nil
+	case keyrotation.FieldUpdatedAt:
+		v, ok := value.(time.Time)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetUpdatedAt(v)
+		return nil
+	case keyrotation.FieldFinishedAt:
+		v, ok := value.(time.Time)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetFinishedAt(v)
+		return nil
+	}
+	return fmt.Errorf("unknown KeyRotation field %s", name)
+}
+
+// AddedFields returns all numeric fields that were incremented/decremented during
+// this mutation.
+func (m *KeyRotationMutation) AddedFields() []string {
+	var fields []string
+	if m.addtotal != nil {
+		fields = append(fields, keyrotation.FieldTotal)
+	}
+	if m.add_done != nil {
+		fields = append(fields, keyrotation.FieldDone)
+	}
+	if m.addfailed != nil {
+		fields = append(fields, keyrotation.FieldFailed)
+	}
+	return fields
+}
+
+// AddedField returns the numeric value that was incremented/decremented on a field
+// with the given name. The second boolean return value indicates that this field
+// was not set, or was not defined in the schema.
+func (m *KeyRotationMutation) AddedField(name string) (ent.Value, bool) {
+	switch name {
+	case keyrotation.FieldTotal:
+		return m.AddedTotal()
+	case keyrotation.FieldDone:
+		return m.AddedDone()
+	case keyrotation.FieldFailed:
+		return m.AddedFailed()
+	}
+	return nil, false
+}
+
+// AddField adds the value to the field with the given name. It returns an error if
+// the field is not defined in the schema, or if the type mismatched the field
+// type.
+func (m *KeyRotationMutation) AddField(name string, value ent.Value) error {
+	switch name {
+	case keyrotation.FieldTotal:
+		v, ok := value.(int)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.AddTotal(v)
+		return nil
+	case keyrotation.FieldDone:
+		v, ok := value.(int)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.AddDone(v)
+		return nil
+	case keyrotation.FieldFailed:
+		v, ok := value.(int)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.AddFailed(v)
+		return nil
+	}
+	return fmt.Errorf("unknown KeyRotation numeric field %s", name)
+}
+
+// ClearedFields returns all nullable fields that were cleared during this
+// mutation.
+func (m *KeyRotationMutation) ClearedFields() []string {
+	var fields []string
+	if m.FieldCleared(keyrotation.FieldError) {
+		fields = append(fields, keyrotation.FieldError)
+	}
+	if m.FieldCleared(keyrotation.FieldCreatedAt) {
+		fields = append(fields, keyrotation.FieldCreatedAt)
+	}
+	if m.FieldCleared(keyrotation.FieldFinishedAt) {
+		fields = append(fields, keyrotation.FieldFinishedAt)
+	}
+	return fields
+}
+
+// FieldCleared returns a boolean indicating if a field with the given name was
+// cleared in this mutation.
+func (m *KeyRotationMutation) FieldCleared(name string) bool {
+	_, ok := m.clearedFields[name]
+	return ok
+}
+
+// ClearField clears the value of the field with the given name. It returns an
+// error if the field is not defined in the schema.
+func (m *KeyRotationMutation) ClearField(name string) error {
+	switch name {
+	case keyrotation.FieldError:
+		m.ClearError()
+		return nil
+	case keyrotation.FieldCreatedAt:
+		m.ClearCreatedAt()
+		return nil
+	case keyrotation.FieldFinishedAt:
+		m.ClearFinishedAt()
+		return nil
+	}
+	return fmt.Errorf("unknown KeyRotation nullable field %s", name)
+}
+
+// ResetField resets all changes in the mutation for the field with the given name.
+// It returns an error if the field is not defined in the schema.
+func (m *KeyRotationMutation) ResetField(name string) error {
+	switch name {
+	case keyrotation.FieldKeyID:
+		m.ResetKeyID()
+		return nil
+	case keyrotation.FieldStatus:
+		m.ResetStatus()
+		return nil
+	case keyrotation.FieldTotal:
+		m.ResetTotal()
+		return nil
+	case keyrotation.FieldDone:
+		m.ResetDone()
+		return nil
+	case keyrotation.FieldFailed:
+		m.ResetFailed()
+		return nil
+	case keyrotation.FieldError:
+		m.ResetError()
+		return nil
+	case keyrotation.FieldCreatedAt:
+		m.ResetCreatedAt()
+		return nil
+	case keyrotation.FieldUpdatedAt:
+		m.ResetUpdatedAt()
+		return nil
+	case keyrotation.FieldFinishedAt:
+		m.ResetFinishedAt()
+		return nil
+	}
+	return fmt.Errorf("unknown KeyRotation field %s", name)
+}
+
+// AddedEdges returns all edge names that were set/added in this mutation.
+func (m *KeyRotationMutation) AddedEdges() []string {
+	edges := make([]string, 0, 0)
+	return edges
+}
+
+// AddedIDs returns all IDs (to other nodes) that were added for the given edge
+// name in this mutation.
+func (m *KeyRotationMutation) AddedIDs(name string) []ent.Value {
+	return nil
+}
+
+// RemovedEdges returns all edge names that were removed in this mutation.
+func (m *KeyRotationMutation) RemovedEdges() []string {
+	edges := make([]string, 0, 0)
+	return edges
+}
+
+// RemovedIDs returns all IDs (to other nodes) that were removed for the edge with
+// the given name in this mutation.
+func (m *KeyRotationMutation) RemovedIDs(name string) []ent.Value {
+	return nil
+}
+
+// ClearedEdges returns all edge names that were cleared in this mutation.
+func (m *KeyRotationMutation) ClearedEdges() []string {
+	edges := make([]string, 0, 0)
+	return edges
+}
+
+// EdgeCleared returns a boolean which indicates if the edge with the given name
+// was cleared in this mutation.
+func (m *KeyRotationMutation) EdgeCleared(name string) bool {
+	return false
+}
+
+// ClearEdge clears the value of the edge with the given name. It returns an error
+// if that edge is not defined in the schema.
+func (m *KeyRotationMutation) ClearEdge(name string) error {
+	return fmt.Errorf("unknown KeyRotation unique edge %s", name)
+}
+
+// ResetEdge resets all changes to the edge with the given name in this mutation.
+// It returns an error if the edge is not defined in the schema.
+func (m *KeyRotationMutation) ResetEdge(name string) error {
+	return fmt.Errorf("unknown KeyRotation edge %s", name)
+}
+
+// SearchJobMutation represents an operation that mutates the SearchJob nodes in the graph.
+type SearchJobMutation struct {
+	config
+	op                             Op
+	typ                            string
+	id                             *int
+	query                          *string
+	max_results                    *int
+	addmax_results                 *int
+	status                         *searchjob.Status
+	saved                          *int
+	addsaved                       *int
+	failed                         *int
+	addfailed                      *int
+	file_names                     *[]string
+	appendfile_names               []string
+	deduplicated                   *int
+	adddeduplicated                *int
+	duplicate_file_names           *[]string
+	appendduplicate_file_names     []string
+	duplicate_of                   *[]string
+	appendduplicate_of             []string
+	candidate_urls                 *[]string
+	appendcandidate_urls           []string
+	candidate_thumbnail_urls       *[]string
+	appendcandidate_thumbnail_urls []string
+	next_candidate                 *int
+	addnext_candidate              *int
+	runner                         *string
+	heartbeat_at                   *time.Time
+	error                          *string
+	created_at                     *time.Time
+	updated_at                     *time.Time
+	started_at                     *time.Time
+	finished_at                    *time.Time
+	clearedFields                  map[string]struct{}
+	user                           *int
+	cleareduser                    bool
+	done                           bool
+	oldValue                       func(context.Context) (*SearchJob, error)
+	predicates                     []predicate.SearchJob
+}
+
+var _ ent.Mutation = (*SearchJobMutation)(nil)
+
+// searchjobOption allows management of the mutation configuration using functional options.
+type searchjobOption func(*SearchJobMutation)
+
+// newSearchJobMutation creates new mutation for the SearchJob entity.
+func newSearchJobMutation(c config, op Op, opts ...searchjobOption) *SearchJobMutation {
+	m := &SearchJobMutation{
+		config:        c,
+		op:            op,
+		typ:           TypeSearchJob,
+		clearedFields: make(map[string]struct{}),
+	}
+	for _, opt := range opts {
+		opt(m)
+	}
+	return m
+}
+
+// withSearchJobID sets the ID field of the mutation.
+func withSearchJobID(id int) searchjobOption {
+	return func(m *SearchJobMutation) {
+		var (
+			err   error
+			once  sync.Once
+			value *SearchJob
+		)
+		m.oldValue = func(ctx context.Context) (*SearchJob, error) {
+			once.Do(func() {
+				if m.done {
+					err = errors.New("querying old values post mutation is not allowed")
+				} else {
+					value, err = m.Client().SearchJob.Get(ctx, id)
+				}
+			})
+			return value, err
+		}
+		m.id = &id
+	}
+}
+
+// withSearchJob sets the old SearchJob of the mutation.
+func withSearchJob(node *SearchJob) searchjobOption {
+	return func(m *SearchJobMutation) {
+		m.oldValue = func(context.Context) (*SearchJob, error) {
+			return node, nil
+		}
+		m.id = &node.ID
+	}
+}
+
+// Client returns a new `ent.Client` from the mutation. If the mutation was
+// executed in a transaction (ent.Tx), a transactional client is returned.
+func (m SearchJobMutation) Client() *Client {
+	client := &Client{config: m.config}
+	client.init()
+	return client
+}
+
+// Tx returns an `ent.Tx` for mutations that were executed in transactions;
+// it returns an error otherwise.
+func (m SearchJobMutation) Tx() (*Tx, error) {
+	if _, ok := m.driver.(*txDriver); !ok {
+		return nil, errors.New("ent: mutation is not running in a transaction")
+	}
+	tx := &Tx{config: m.config}
+	tx.init()
+	return tx, nil
+}
+
+// ID returns the ID value in the mutation. Note that the ID is only available
+// if it was provided to the builder or after it was returned from the database.
+func (m *SearchJobMutation) ID() (id int, exists bool) {
+	if m.id == nil {
+		return
+	}
+	return *m.id, true
+}
+
+// IDs queries the database and returns the entity ids that match the mutation's predicate.
+// That means, if the mutation is applied within a transaction with an isolation level such
+// as sql.LevelSerializable, the returned ids match the ids of the rows that will be updated
+// or updated by the mutation.
+func (m *SearchJobMutation) IDs(ctx context.Context) ([]int, error) {
+	switch {
+	case m.op.Is(OpUpdateOne | OpDeleteOne):
+		id, exists := m.ID()
+		if exists {
+			return []int{id}, nil
+		}
+		fallthrough
+	case m.op.Is(OpUpdate | OpDelete):
+		return m.Client().SearchJob.Query().Where(m.predicates...).IDs(ctx)
+	default:
+		return nil, fmt.Errorf("IDs is not allowed on %s operations", m.op)
+	}
+}
+
+// SetQuery sets the "query" field.
+func (m *SearchJobMutation) SetQuery(s string) {
+	m.query = &s
+}
+
+// Query returns the value of the "query" field in the mutation.
+func (m *SearchJobMutation) Query() (r string, exists bool) {
+	v := m.query
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldQuery returns the old "query" field's value of the SearchJob entity.
+// If the SearchJob object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *SearchJobMutation) OldQuery(ctx context.Context) (v string, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldQuery is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldQuery requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldQuery: %w", err)
+	}
+	return oldValue.Query, nil
+}
+
+// ResetQuery resets all changes to the "query" field.
+func (m *SearchJobMutation) ResetQuery() {
+	m.query = nil
+}
+
+// SetMaxResults sets the "max_results" field.
+func (m *SearchJobMutation) SetMaxResults(i int) {
+	m.max_results = &i
+	m.addmax_results = nil
+}
+
+// MaxResults returns the value of the "max_results" field in the mutation.
+func (m *SearchJobMutation) MaxResults() (r int, exists bool) {
+	v := m.max_results
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldMaxResults returns the old "max_results" field's value of the SearchJob entity.
+// If the SearchJob object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *SearchJobMutation) OldMaxResults(ctx context.Context) (v int, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldMaxResults is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldMaxResults requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldMaxResults: %w", err)
+	}
+	return oldValue.MaxResults, nil
+}
+
+// AddMaxResults adds i to the "max_results" field.
+func (m *SearchJobMutation) AddMaxResults(i int) {
+	if m.addmax_results != nil {
+		*m.addmax_results += i
+	} else {
+		m.addmax_results = &i
+	}
+}
+
+// AddedMaxResults returns the value that was added to the "max_results" field in this mutation.
+func (m *SearchJobMutation) AddedMaxResults() (r int, exists bool) {
+	v := m.addmax_results
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// ResetMaxResults resets all changes to the "max_results" field.
+func (m *SearchJobMutation) ResetMaxResults() {
+	m.max_results = nil
+	m.addmax_results = nil
+}
+
+// SetUserID sets the "user_id" field.
+func (m *SearchJobMutation) SetUserID(i int) {
+	m.user = &i
+}
+
+// UserID returns the value of the "user_id" field in the mutation.
+func (m *SearchJobMutation) UserID() (r int, exists bool) {
+	v := m.user
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldUserID returns the old "user_id" field's value of the SearchJob entity.
+// If the SearchJob object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *SearchJobMutation) OldUserID(ctx context.Context) (v int, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldUserID is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldUserID requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldUserID: %w", err)
+	}
+	return oldValue.UserID, nil
+}
+
+// ResetUserID resets all changes to the "user_id" field.
+func (m *SearchJobMutation) ResetUserID() {
+	m.user = nil
+}
+
+// SetStatus sets the "status" field.
+func (m *SearchJobMutation) SetStatus(s searchjob.Status) {
+	m.status = &s
+}
+
+// Status returns the value of the "status" field in the mutation.
+func (m *SearchJobMutation) Status() (r searchjob.Status, exists bool) {
+	v := m.status
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldStatus returns the old "status" field's value of the SearchJob entity.
+// If the SearchJob object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *SearchJobMutation) OldStatus(ctx context.Context) (v searchjob.Status, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldStatus is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldStatus requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldStatus: %w", err)
+	}
+	return oldValue.Status, nil
+}
+
+// ResetStatus resets all changes to the "status" field.
+func (m *SearchJobMutation) ResetStatus() {
+	m.status = nil
+}
+
+// SetSaved sets the "saved" field.
+func (m *SearchJobMutation) SetSaved(i int) {
+	m.saved = &i
+	m.addsaved = nil
+}
+
+// Saved returns the value of the "saved" field in the mutation.
+func (m *SearchJobMutation) Saved() (r int, exists bool) {
+	v := m.saved
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldSaved returns the old "saved" field's value of the SearchJob entity.
+// If the SearchJob object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *SearchJobMutation) OldSaved(ctx context.Context) (v int, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldSaved is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldSaved requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldSaved: %w", err)
+	}
+	return oldValue.Saved, nil
+}
+
+// AddSaved adds i to the "saved" field.
+func (m *SearchJobMutation) AddSaved(i int) {
+	if m.addsaved != nil {
+		*m.addsaved += i
+	} else {
+		m.addsaved = &i
+	}
+}
+
+// AddedSaved returns the value that was added to the "saved" field in this mutation.
+func (m *SearchJobMutation) AddedSaved() (r int, exists bool) {
+	v := m.addsaved
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// ResetSaved resets all changes to the "saved" field.
+func (m *SearchJobMutation) ResetSaved() {
+	m.saved = nil
+	m.addsaved = nil
+}
+
+// SetFailed sets the "failed" field.
+func (m *SearchJobMutation) SetFailed(i int) {
+	m.failed = &i
+	m.addfailed = nil
+}
+
+// Failed returns the value of the "failed" field in the mutation.
+func (m *SearchJobMutation) Failed() (r int, exists bool) {
+	v := m.failed
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldFailed returns the old "failed" field's value of the SearchJob entity.
+// If the SearchJob object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *SearchJobMutation) OldFailed(ctx context.Context) (v int, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldFailed is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldFailed requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldFailed: %w", err)
+	}
+	return oldValue.Failed, nil
+}
+
+// AddFailed adds i to the "failed" field.
+func (m *SearchJobMutation) AddFailed(i int) {
+	if m.addfailed != nil {
+		*m.addfailed += i
+	} else {
+		m.addfailed = &i
+	}
+}
+
+// AddedFailed returns the value that was added to the "failed" field in this mutation.
+func (m *SearchJobMutation) AddedFailed() (r int, exists bool) {
+	v := m.addfailed
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// ResetFailed resets all changes to the "failed" field.
+func (m *SearchJobMutation) ResetFailed() {
+	m.failed = nil
+	m.addfailed = nil
+}
+
+// SetFileNames sets the "file_names" field.
+func (m *SearchJobMutation) SetFileNames(s []string) {
+	m.file_names = &s
+	m.appendfile_names = nil
+}
+
+// FileNames returns the value of the "file_names" field in the mutation.
+func (m *SearchJobMutation) FileNames() (r []string, exists bool) {
+	v := m.file_names
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldFileNames returns the old "file_names" field's value of the SearchJob entity.
+// If the SearchJob object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *SearchJobMutation) OldFileNames(ctx context.Context) (v []string, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldFileNames is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldFileNames requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldFileNames: %w", err)
+	}
+	return oldValue.FileNames, nil
+}
+
+// AppendFileNames adds s to the "file_names" field.
+func (m *SearchJobMutation) AppendFileNames(s []string) {
+	m.appendfile_names = append(m.appendfile_names, s...)
+}
+
+// AppendedFileNames returns the list of values that were appended to the "file_names" field in this mutation.
+func (m *SearchJobMutation) AppendedFileNames() ([]string, bool) {
+	if len(m.appendfile_names) == 0 {
+		return nil, false
+	}
+	return m.appendfile_names, true
+}
+
+// ClearFileNames clears the value of the "file_names" field.
+func (m *SearchJobMutation) ClearFileNames() {
+	m.file_names = nil
+	m.appendfile_names = nil
+	m.clearedFields[searchjob.FieldFileNames] = struct{}{}
+}
+
+// FileNamesCleared returns if the "file_names" field was cleared in this mutation.
+func (m *SearchJobMutation) FileNamesCleared() bool {
+	_, ok := m.clearedFields[searchjob.FieldFileNames]
+	return ok
+}
+
+// ResetFileNames resets all changes to the "file_names" field.
+func (m *SearchJobMutation) ResetFileNames() {
+	m.file_names = nil
+	m.appendfile_names = nil
+	delete(m.clearedFields, searchjob.FieldFileNames)
+}
+
+// SetDeduplicated sets the "deduplicated" field.
+func (m *SearchJobMutation) SetDeduplicated(i int) {
+	m.deduplicated = &i
+	m.adddeduplicated = nil
+}
+
+// Deduplicated returns the value of the "deduplicated" field in the mutation.
+func (m *SearchJobMutation) Deduplicated() (r int, exists bool) {
+	v := m.deduplicated
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldDeduplicated returns the old "deduplicated" field's value of the SearchJob entity.
+// If the SearchJob object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *SearchJobMutation) OldDeduplicated(ctx context.Context) (v int, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldDeduplicated is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldDeduplicated requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldDeduplicated: %w", err)
+	}
+	return oldValue.Deduplicated, nil
+}
+
+// AddDeduplicated adds i to the "deduplicated" field.
+func (m *SearchJobMutation) AddDeduplicated(i int) {
+	if m.adddeduplicated != nil {
+		*m.adddeduplicated += i
+	} else {
+		m.adddeduplicated = &i
+	}
+}
+
+// AddedDeduplicated returns the value that was added to the "deduplicated" field in this mutation.
+func (m *SearchJobMutation) AddedDeduplicated() (r int, exists bool) {
+	v := m.adddeduplicated
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// ResetDeduplicated resets all changes to the "deduplicated" field.
+func (m *SearchJobMutation) ResetDeduplicated() {
+	m.deduplicated = nil
+	m.adddeduplicated = nil
+}
+
+// SetDuplicateFileNames sets the "duplicate_file_names" field.
+func (m *SearchJobMutation) SetDuplicateFileNames(s []string) {
+	m.duplicate_file_names = &s
+	m.appendduplicate_file_names = nil
+}
+
+// DuplicateFileNames returns the value of the "duplicate_file_names" field in the mutation.
+func (m *SearchJobMutation) DuplicateFileNames() (r []string, exists bool) {
+	v := m.duplicate_file_names
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldDuplicateFileNames returns the old "duplicate_file_names" field's value of the SearchJob entity.
+// If the SearchJob object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *SearchJobMutation) OldDuplicateFileNames(ctx context.Context) (v []string, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldDuplicateFileNames is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldDuplicateFileNames requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldDuplicateFileNames: %w", err)
+	}
+	return oldValue.DuplicateFileNames, nil
+}
+
+// AppendDuplicateFileNames adds s to the "duplicate_file_names" field.
+func (m *SearchJobMutation) AppendDuplicateFileNames(s []string) {
+	m.appendduplicate_file_names = append(m.appendduplicate_file_names, s...)
+}
+
+// AppendedDuplicateFileNames returns the list of values that were appended to the "duplicate_file_names" field in this mutation.
+func (m *SearchJobMutation) AppendedDuplicateFileNames() ([]string, bool) {
+	if len(m.appendduplicate_file_names) == 0 {
+		return nil, false
+	}
+	return m.appendduplicate_file_names, true
+}
+
+// ClearDuplicateFileNames clears the value of the "duplicate_file_names" field.
+func (m *SearchJobMutation) ClearDuplicateFileNames() {
+	m.duplicate_file_names = nil
+	m.appendduplicate_file_names = nil
+	m.clearedFields[searchjob.FieldDuplicateFileNames] = struct{}{}
+}
+
+// DuplicateFileNamesCleared returns if the "duplicate_file_names" field was cleared in this mutation.
+func (m *SearchJobMutation) DuplicateFileNamesCleared() bool {
+	_, ok := m.clearedFields[searchjob.FieldDuplicateFileNames]
+	return ok
+}
+
+// ResetDuplicateFileNames resets all changes to the "duplicate_file_names" field.
+func (m *SearchJobMutation) ResetDuplicateFileNames() {
+	m.duplicate_file_names = nil
+	m.appendduplicate_file_names = nil
+	delete(m.clearedFields, searchjob.FieldDuplicateFileNames)
+}
+
+// SetDuplicateOf sets the "duplicate_of" field.
+func (m *SearchJobMutation) SetDuplicateOf(s []string) {
+	m.duplicate_of = &s
+	m.appendduplicate_of = nil
+}
+
+// DuplicateOf returns the value of the "duplicate_of" field in the mutation.
+func (m *SearchJobMutation) DuplicateOf() (r []string, exists bool) {
+	v := m.duplicate_of
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldDuplicateOf returns the old "duplicate_of" field's value of the SearchJob entity.
+// If the SearchJob object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *SearchJobMutation) OldDuplicateOf(ctx context.Context) (v []string, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldDuplicateOf is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldDuplicateOf requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldDuplicateOf: %w", err)
+	}
+	return oldValue.DuplicateOf, nil
+}
+
+// AppendDuplicateOf adds s to the "duplicate_of" field.
+func (m *SearchJobMutation) AppendDuplicateOf(s []string) {
+	m.appendduplicate_of = append(m.appendduplicate_of, s...)
+}
+
+// AppendedDuplicateOf returns the list of values that were appended to the "duplicate_of" field in this mutation.
+func (m *SearchJobMutation) AppendedDuplicateOf() ([]string, bool) {
+	if len(m.appendduplicate_of) == 0 {
+		return nil, false
+	}
+	return m.appendduplicate_of, true
+}
+
+// ClearDuplicateOf clears the value of the "duplicate_of" field.
+func (m *SearchJobMutation) ClearDuplicateOf() {
+	m.duplicate_of = nil
+	m.appendduplicate_of = nil
+	m.clearedFields[searchjob.FieldDuplicateOf] = struct{}{}
+}
+
+// DuplicateOfCleared returns if the "duplicate_of" field was cleared in this mutation.
+func (m *SearchJobMutation) DuplicateOfCleared() bool {
+	_, ok := m.clearedFields[searchjob.FieldDuplicateOf]
+	return ok
+}
+
+// ResetDuplicateOf resets all changes to the "duplicate_of" field.
+func (m *SearchJobMutation) ResetDuplicateOf() {
+	m.duplicate_of = nil
+	m.appendduplicate_of = nil
+	delete(m.clearedFields, searchjob.FieldDuplicateOf)
+}
+
+// SetCandidateUrls sets the "candidate_urls" field.
+func (m *SearchJobMutation) SetCandidateUrls(s []string) {
+	m.candidate_urls = &s
+	m.appendcandidate_urls = nil
+}
+
+// CandidateUrls returns the value of the "candidate_urls" field in the mutation.
+func (m *SearchJobMutation) CandidateUrls() (r []string, exists bool) {
+	v := m.candidate_urls
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldCandidateUrls returns the old "candidate_urls" field's value of the SearchJob entity.
+// If the SearchJob object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *SearchJobMutation) OldCandidateUrls(ctx context.Context) (v []string, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldCandidateUrls is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldCandidateUrls requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldCandidateUrls: %w", err)
+	}
+	return oldValue.CandidateUrls, nil
+}
+
+// AppendCandidateUrls adds s to the "candidate_urls" field.
+func (m *SearchJobMutation) AppendCandidateUrls(s []string) {
+	m.appendcandidate_urls = append(m.appendcandidate_urls, s...)
+}
+
+// AppendedCandidateUrls returns the list of values that were appended to the "candidate_urls" field in this mutation.
+func (m *SearchJobMutation) AppendedCandidateUrls() ([]string, bool) {
+	if len(m.appendcandidate_urls) == 0 {
+		return nil, false
+	}
+	return m.appendcandidate_urls, true
+}
+
+// ClearCandidateUrls clears the value of the "candidate_urls" field.
+func (m *SearchJobMutation) ClearCandidateUrls() {
+	m.candidate_urls = nil
+	m.appendcandidate_urls = nil
+	m.clearedFields[searchjob.FieldCandidateUrls] = struct{}{}
+}
+
+// CandidateUrlsCleared returns if the "candidate_urls" field was cleared in this mutation.
+func (m *SearchJobMutation) CandidateUrlsCleared() bool {
+	_, ok := m.clearedFields[searchjob.FieldCandidateUrls]
+	return ok
+}
+
+// ResetCandidateUrls resets all changes to the "candidate_urls" field.
+func (m *SearchJobMutation) ResetCandidateUrls() {
+	m.candidate_urls = nil
+	m.appendcandidate_urls = nil
+	delete(m.clearedFields, searchjob.FieldCandidateUrls)
+}
+
+// SetCandidateThumbnailUrls sets the "candidate_thumbnail_urls" field.
+func (m *SearchJobMutation) SetCandidateThumbnailUrls(s []string) {
+	m.candidate_thumbnail_urls = &s
+	m.appendcandidate_thumbnail_urls = nil
+}
+
+// CandidateThumbnailUrls returns the value of the "candidate_thumbnail_urls" field in the mutation.
+func (m *SearchJobMutation) CandidateThumbnailUrls() (r []string, exists bool) {
+	v := m.candidate_thumbnail_urls
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldCandidateThumbnailUrls returns the old "candidate_thumbnail_urls" field's value of the SearchJob entity.
+// If the SearchJob object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *SearchJobMutation) OldCandidateThumbnailUrls(ctx context.Context) (v []string, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldCandidateThumbnailUrls is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldCandidateThumbnailUrls requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldCandidateThumbnailUrls: %w", err)
+	}
+	return oldValue.CandidateThumbnailUrls, nil
+}
+
+// AppendCandidateThumbnailUrls adds s to the "candidate_thumbnail_urls" field.
+func (m *SearchJobMutation) AppendCandidateThumbnailUrls(s []string) {
+	m.appendcandidate_thumbnail_urls = append(m.appendcandidate_thumbnail_urls, s...)
+}
+
+// AppendedCandidateThumbnailUrls returns the list of values that were appended to the "candidate_thumbnail_urls" field in this mutation.
+func (m *SearchJobMutation) AppendedCandidateThumbnailUrls() ([]string, bool) {
+	if len(m.appendcandidate_thumbnail_urls) == 0 {
+		return nil, false
+	}
+	return m.appendcandidate_thumbnail_urls, true
+}
+
+// ClearCandidateThumbnailUrls clears the value of the "candidate_thumbnail_urls" field.
+func (m *SearchJobMutation) ClearCandidateThumbnailUrls() {
+	m.candidate_thumbnail_urls = nil
+	m.appendcandidate_thumbnail_urls = nil
+	m.clearedFields[searchjob.FieldCandidateThumbnailUrls] = struct{}{}
+}
+
+// CandidateThumbnailUrlsCleared returns if the "candidate_thumbnail_urls" field was cleared in this mutation.
+func (m *SearchJobMutation) CandidateThumbnailUrlsCleared() bool {
+	_, ok := m.clearedFields[searchjob.FieldCandidateThumbnailUrls]
+	return ok
+}
+
+// ResetCandidateThumbnailUrls resets all changes to the "candidate_thumbnail_urls" field.
+func (m *SearchJobMutation) ResetCandidateThumbnailUrls() {
+	m.candidate_thumbnail_urls = nil
+	m.appendcandidate_thumbnail_urls = nil
+	delete(m.clearedFields, searchjob.FieldCandidateThumbnailUrls)
+}
+
+// SetNextCandidate sets the "next_candidate" field.
+func (m *SearchJobMutation) SetNextCandidate(i int) {
+	m.next_candidate = &i
+	m.addnext_candidate = nil
+}
+
+// NextCandidate returns the value of the "next_candidate" field in the mutation.
+func (m *SearchJobMutation) NextCandidate() (r int, exists bool) {
+	v := m.next_candidate
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldNextCandidate returns the old "next_candidate" field's value of the SearchJob entity.
+// If the SearchJob object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *SearchJobMutation) OldNextCandidate(ctx context.Context) (v int, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldNextCandidate is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldNextCandidate requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldNextCandidate: %w", err)
+	}
+	return oldValue.NextCandidate, nil
+}
+
+// AddNextCandidate adds i to the "next_candidate" field.
+func (m *SearchJobMutation) AddNextCandidate(i int) {
+	if m.addnext_candidate != nil {
+		*m.addnext_candidate += i
+	} else {
+		m.addnext_candidate = &i
+	}
+}
+
+// AddedNextCandidate returns the value that was added to the "next_candidate" field in this mutation.
+func (m *SearchJobMutation) AddedNextCandidate() (r int, exists bool) {
+	v := m.addnext_candidate
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// ResetNextCandidate resets all changes to the "next_candidate" field.
+func (m *SearchJobMutation) ResetNextCandidate() {
+	m.next_candidate = nil
+	m.addnext_candidate = nil
+}
+
+// SetRunner sets the "runner" field.
+func (m *SearchJobMutation) SetRunner(s string) {
+	m.runner = &s
+}
+
+// Runner returns the value of the "runner" field in the mutation.
+func (m *SearchJobMutation) Runner() (r string, exists bool) {
+	v := m.runner
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldRunner returns the old "runner" field's value of the SearchJob entity.
+// If the SearchJob object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *SearchJobMutation) OldRunner(ctx context.Context) (v string, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldRunner is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldRunner requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldRunner: %w", err)
+	}
+	return oldValue.Runner, nil
+}
+
+// ClearRunner clears the value of the "runner" field.
+func (m *SearchJobMutation) ClearRunner() {
+	m.runner = nil
+	m.clearedFields[searchjob.FieldRunner] = struct{}{}
+}
+
+// RunnerCleared returns if the "runner" field was cleared in this mutation.
+func (m *SearchJobMutation) RunnerCleared() bool {
+	_, ok := m.clearedFields[searchjob.FieldRunner]
+	return ok
+}
+
+// ResetRunner resets all changes to the "runner" field.
+func (m *SearchJobMutation) ResetRunner() {
+	m.runner = nil
+	delete(m.clearedFields, searchjob.FieldRunner)
+}
+
+// SetHeartbeatAt sets the "heartbeat_at" field.
+func (m *SearchJobMutation) SetHeartbeatAt(t time.Time) {
+	m.heartbeat_at = &t
+}
+
+// HeartbeatAt returns the value of the "heartbeat_at" field in the mutation.
+func (m *SearchJobMutation) HeartbeatAt() (r time.Time, exists bool) {
+	v := m.heartbeat_at
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldHeartbeatAt returns the old "heartbeat_at" field's value of the SearchJob entity.
+// If the SearchJob object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *SearchJobMutation) OldHeartbeatAt(ctx context.Context) (v *time.Time, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldHeartbeatAt is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldHeartbeatAt requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldHeartbeatAt: %w", err)
+	}
+	return oldValue.HeartbeatAt, nil
+}
+
+// ClearHeartbeatAt clears the value of the "heartbeat_at" field.
+func (m *SearchJobMutation) ClearHeartbeatAt() {
+	m.heartbeat_at = nil
+	m.clearedFields[searchjob.FieldHeartbeatAt] = struct{}{}
+}
+
+// HeartbeatAtCleared returns if the "heartbeat_at" field was cleared in this mutation.
+func (m *SearchJobMutation) HeartbeatAtCleared() bool {
+	_, ok := m.clearedFields[searchjob.FieldHeartbeatAt]
+	return ok
+}
+
+// ResetHeartbeatAt resets all changes to the "heartbeat_at" field.
+func (m *SearchJobMutation) ResetHeartbeatAt() {
+	m.heartbeat_at = nil
+	delete(m.clearedFields, searchjob.FieldHeartbeatAt)
+}
+
+// SetError sets the "error" field.
+func (m *SearchJobMutation) SetError(s string) {
+	m.error = &s
+}
+
+// Error returns the value of the "error" field in the mutation.
+func (m *SearchJobMutation) Error() (r string, exists bool) {
+	v := m.error
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldError returns the old "error" field's value of the SearchJob entity.
+// If the SearchJob object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *SearchJobMutation) OldError(ctx context.Context) (v string, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldError is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldError requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldError: %w", err)
+	}
+	return oldValue.Error, nil
+}
+
+// ClearError clears the value of the "error" field.
+func (m *SearchJobMutation) ClearError() {
+	m.error = nil
+	m.clearedFields[searchjob.FieldError] = struct{}{}
+}
+
+// ErrorCleared returns if the "error" field was cleared in this mutation.
+func (m *SearchJobMutation) ErrorCleared() bool {
+	_, ok := m.clearedFields[searchjob.FieldError]
+	return ok
+}
+
+// ResetError resets all changes to the "error" field.
+func (m *SearchJobMutation) ResetError() {
+	m.error = nil
+	delete(m.clearedFields, searchjob.FieldError)
+}
+
 // SetCreatedAt sets the "created_at" field.
-func (m *FileMutation) SetCreatedAt(t time.Time) {
+func (m *SearchJobMutation) SetCreatedAt(t time.Time) {
 	m.created_at = &t
 }
 
 // CreatedAt returns the value of the "created_at" field in the mutation.
-func (m *FileMutation) CreatedAt() (r time.Time, exists bool) {
+func (m *SearchJobMutation) CreatedAt() (r time.Time, exists bool) {
 	v := m.created_at
 	if v == nil {
 		return
@@ -371,10 +4594,10 @@ func (m *FileMutation) CreatedAt() (r time.Time, exists bool) {
 	return *v, true
 }
 
-// OldCreatedAt returns the old "created_at" field's value of the File entity.
-// If the File object wasn't provided to the builder, the object is fetched from the database.
+// OldCreatedAt returns the old "created_at" field's value of the SearchJob entity.
+// If the SearchJob object wasn't provided to the builder, the object is fetched from the database.
 // An error is returned if the mutation operation is not UpdateOne, or the database query fails.
-func (m *FileMutation) OldCreatedAt(ctx context.Context) (v *time.Time, err error) {
+func (m *SearchJobMutation) OldCreatedAt(ctx context.Context) (v *time.Time, err error) {
 	if !m.op.Is(OpUpdateOne) {
 		return v, errors.New("OldCreatedAt is only allowed on UpdateOne operations")
 	}
@@ -389,30 +4612,30 @@ func (m *FileMutation) OldCreatedAt(ctx context.Context) (v *time.Time, err erro
 }
 
 // ClearCreatedAt clears the value of the "created_at" field.
-func (m *FileMutation) ClearCreatedAt() {
+func (m *SearchJobMutation) ClearCreatedAt() {
 	m.created_at = nil
-	m.clearedFields[file.FieldCreatedAt] = struct{}{}
+	m.clearedFields[searchjob.FieldCreatedAt] = struct{}{}
 }
 
 // CreatedAtCleared returns if the "created_at" field was cleared in this mutation.
-func (m *FileMutation) CreatedAtCleared() bool {
-	_, ok := m.clearedFields[file.FieldCreatedAt]
+func (m *SearchJobMutation) CreatedAtCleared() bool {
+	_, ok := m.clearedFields[searchjob.FieldCreatedAt]
 	return ok
 }
 
 // ResetCreatedAt resets all changes to the "created_at" field.
-func (m *FileMutation) ResetCreatedAt() {
+func (m *SearchJobMutation) ResetCreatedAt() {
 	m.created_at = nil
-	delete(m.clearedFields, file.FieldCreatedAt)
+	delete(m.clearedFields, searchjob.FieldCreatedAt)
 }
 
 // SetUpdatedAt sets the "updated_at" field.
-func (m *FileMutation) SetUpdatedAt(t time.Time) {
+func (m *SearchJobMutation) SetUpdatedAt(t time.Time) {
 	m.updated_at = &t
 }
 
 // UpdatedAt returns the value of the "updated_at" field in the mutation.
-func (m *FileMutation) UpdatedAt() (r time.Time, exists bool) {
+func (m *SearchJobMutation) UpdatedAt() (r time.Time, exists bool) {
 	v := m.updated_at
 	if v == nil {
 		return
@@ -420,10 +4643,10 @@ func (m *FileMutation) UpdatedAt() (r time.Time, exists bool) {
 	return *v, true
 }
 
-// OldUpdatedAt returns the old "updated_at" field's value of the File entity.
-// If the File object wasn't provided to the builder, the object is fetched from the database.
+// OldUpdatedAt returns the old "updated_at" field's value of the SearchJob entity.
+// If the SearchJob object wasn't provided to the builder, the object is fetched from the database.
 // An error is returned if the mutation operation is not UpdateOne, or the database query fails.
-func (m *FileMutation) OldUpdatedAt(ctx context.Context) (v time.Time, err error) {
+func (m *SearchJobMutation) OldUpdatedAt(ctx context.Context) (v time.Time, err error) {
 	if !m.op.Is(OpUpdateOne) {
 		return v, errors.New("OldUpdatedAt is only allowed on UpdateOne operations")
 	}
@@ -438,189 +4661,144 @@ func (m *FileMutation) OldUpdatedAt(ctx context.Context) (v time.Time, err error
 }
 
 // ResetUpdatedAt resets all changes to the "updated_at" field.
-func (m *FileMutation) ResetUpdatedAt() {
+func (m *SearchJobMutation) ResetUpdatedAt() {
 	m.updated_at = nil
 }
 
-// SetDeletedAt sets the "deleted_at" field.
-func (m *FileMutation) SetDeletedAt(t time.Time) {
-	m.deleted_at = &t
+// SetStartedAt sets the "started_at" field.
+func (m *SearchJobMutation) SetStartedAt(t time.Time) {
+	m.started_at = &t
 }
 
-// DeletedAt returns the value of the "deleted_at" field in the mutation.
-func (m *FileMutation) DeletedAt() (r time.Time, exists bool) {
-	v := m.deleted_at
+// StartedAt returns the value of the "started_at" field in the mutation.
+func (m *SearchJobMutation) StartedAt() (r time.Time, exists bool) {
+	v := m.started_at
 	if v == nil {
 		return
 	}
 	return *v, true
 }
 
-// OldDeletedAt returns the old "deleted_at" field's value of the File entity.
-// If the File object wasn't provided to the builder, the object is fetched from the database.
+// OldStartedAt returns the old "started_at" field's value of the SearchJob entity.
+// If the SearchJob object wasn't provided to the builder, the object is fetched from the database.
 // An error is returned if the mutation operation is not UpdateOne, or the database query fails.
-func (m *FileMutation) OldDeletedAt(ctx context.Context) (v *time.Time, err error) {
+func (m *SearchJobMutation) OldStartedAt(ctx context.Context) (v *time.Time, err error) {
 	if !m.op.Is(OpUpdateOne) {
-		return v, errors.New("OldDeletedAt is only allowed on UpdateOne operations")
+		return v, errors.New("OldStartedAt is only allowed on UpdateOne operations")
 	}
 	if m.id == nil || m.oldValue == nil {
-		return v, errors.New("OldDeletedAt requires an ID field in the mutation")
+		return v, errors.New("OldStartedAt requires an ID field in the mutation")
 	}
 	oldValue, err := m.oldValue(ctx)
 	if err != nil {
-		return v, fmt.Errorf("querying old value for OldDeletedAt: %w", err)
+		return v, fmt.Errorf("querying old value for OldStartedAt: %w", err)
 	}
-	return oldValue.DeletedAt, nil
+	return oldValue.StartedAt, nil
 }
 
-// ClearDeletedAt clears the value of the "deleted_at" field.
-func (m *FileMutation) ClearDeletedAt() {
-	m.deleted_at = nil
-	m.clearedFields[file.FieldDeletedAt] = struct{}{}
+// ClearStartedAt clears the value of the "started_at" field.
+func (m *SearchJobMutation) ClearStartedAt() {
+	m.started_at = nil
+	m.clearedFields[searchjob.FieldStartedAt] = struct{}{}
 }
 
-// DeletedAtCleared returns if the "deleted_at" field was cleared in this mutation.
-func (m *FileMutation) DeletedAtCleared() bool {
-	_, ok := m.clearedFields[file.FieldDeletedAt]
+// StartedAtCleared returns if the "started_at" field was cleared in this mutation.
+func (m *SearchJobMutation) StartedAtCleared() bool {
+	_, ok := m.clearedFields[searchjob.FieldStartedAt]
 	return ok
 }
 
-// ResetDeletedAt resets all changes to the "deleted_at" field.
-func (m *FileMutation) ResetDeletedAt() {
-	m.deleted_at = nil
-	delete(m.clearedFields, file.FieldDeletedAt)
-}
-
-// ClearUser clears the "user" edge to the User entity.
-func (m *FileMutation) ClearUser() {
-	m.cleareduser = true
-	m.clearedFields[file.FieldUserID] = struct{}{}
+// ResetStartedAt resets all changes to the "started_at" field.
+func (m *SearchJobMutation) ResetStartedAt() {
+	m.started_at = nil
+	delete(m.clearedFields, searchjob.FieldStartedAt)
 }
 
-// UserCleared reports if the "user" edge to the User entity was cleared.
-func (m *FileMutation) UserCleared() bool {
-	return m.cleareduser
+// SetFinishedAt sets the "finished_at" field.
+func (m *SearchJobMutation) SetFinishedAt(t time.Time) {
+	m.finished_at = &t
 }
 
-// UserIDs returns the "user" edge IDs in the mutation.
-// Note that IDs always returns len(IDs) <= 1 for unique edges, and you should use
-// UserID instead. It exists only for internal usage by the builders.
-func (m *FileMutation) UserIDs() (ids []int) {
-	if id := m.user; id != nil {
-		ids = append(ids, *id)
+// FinishedAt returns the value of the "finished_at" field in the mutation.
+func (m *SearchJobMutation) FinishedAt() (r time.Time, exists bool) {
+	v := m.finished_at
+	if v == nil {
+		return
 	}
-	return
-}
-
-// ResetUser resets all changes to the "user" edge.
-func (m *FileMutation) ResetUser() {
-	m.user = nil
-	m.cleareduser = false
-}
-
-// SetFiletypeID sets the "filetype" edge to the Filetype entity by id.
-func (m *FileMutation) SetFiletypeID(id string) {
-	m.filetype = &id
-}
-
-// ClearFiletype clears the "filetype" edge to the Filetype entity.
-func (m *FileMutation) ClearFiletype() {
-	m.clearedfiletype = true
-	m.clearedFields[file.FieldType] = struct{}{}
-}
-
-// FiletypeCleared reports if the "filetype" edge to the Filetype entity was cleared.
-func (m *FileMutation) FiletypeCleared() bool {
-	return m.clearedfiletype
+	return *v, true
 }
 
-// FiletypeID returns the "filetype" edge ID in the mutation.
-func (m *FileMutation) FiletypeID() (id string, exists bool) {
-	if m.filetype != nil {
-		return *m.filetype, true
+// OldFinishedAt returns the old "finished_at" field's value of the SearchJob entity.
+// If the SearchJob object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *SearchJobMutation) OldFinishedAt(ctx context.Context) (v *time.Time, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldFinishedAt is only allowed on UpdateOne operations")
 	}
-	return
-}
-
-// FiletypeIDs returns the "filetype" edge IDs in the mutation.
-// Note that IDs always returns len(IDs) <= 1 for unique edges, and you should use
-// FiletypeID instead. It exists only for internal usage by the builders.
-func (m *FileMutation) FiletypeIDs() (ids []string) {
-	if id := m.filetype; id != nil {
-		ids = append(ids, *id)
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldFinishedAt requires an ID field in the mutation")
 	}
-	return
-}
-
-// ResetFiletype resets all changes to the "filetype" edge.
-func (m *FileMutation) ResetFiletype() {
-	m.filetype = nil
-	m.clearedfiletype = false
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldFinishedAt: %w", err)
+	}
+	return oldValue.FinishedAt, nil
 }
 
-// AddTagIDs adds the "tags" edge to the Tag entity by ids.
-func (m *FileMutation) AddTagIDs(ids ...string) {
-	if m.tags == nil {
-		m.tags = make(map[string]struct{})
-	}
-	for i := range ids {
-		m.tags[ids[i]] = struct{}{}
-	}
+// ClearFinishedAt clears the value of the "finished_at" field.
+func (m *SearchJobMutation) ClearFinishedAt() {
+	m.finished_at = nil
+	m.clearedFields[searchjob.FieldFinishedAt] = struct{}{}
 }
 
-// ClearTags clears the "tags" edge to the Tag entity.
-func (m *FileMutation) ClearTags() {
-	m.clearedtags = true
+// FinishedAtCleared returns if the "finished_at" field was cleared in this mutation.
+func (m *SearchJobMutation) FinishedAtCleared() bool {
+	_, ok := m.clearedFields[searchjob.FieldFinishedAt]
+	return ok
 }
 
-// TagsCleared reports if the "tags" edge to the Tag entity was cleared.
-func (m *FileMutation) TagsCleared() bool {
-	return m.clearedtags
+// ResetFinishedAt resets all changes to the "finished_at" field.
+func (m *SearchJobMutation) ResetFinishedAt() {
+	m.finished_at = nil
+	delete(m.clearedFields, searchjob.FieldFinishedAt)
 }
 
-// RemoveTagIDs removes the "tags" edge to the Tag entity by IDs.
-func (m *FileMutation) RemoveTagIDs(ids ...string) {
-	if m.removedtags == nil {
-		m.removedtags = make(map[string]struct{})
-	}
-	for i := range ids {
-		delete(m.tags, ids[i])
-		m.removedtags[ids[i]] = struct{}{}
-	}
+// ClearUser clears the "user" edge to the User entity.
+func (m *SearchJobMutation) ClearUser() {
+	m.cleareduser = true
+	m.clearedFields[searchjob.FieldUserID] = struct{}{}
 }
 
-// RemovedTags returns the removed IDs of the "tags" edge to the Tag entity.
-func (m *FileMutation) RemovedTagsIDs() (ids []string) {
-	for id := range m.removedtags {
-		ids = append(ids, id)
-	}
-	return
+// UserCleared reports if the "user" edge to the User entity was cleared.
+func (m *SearchJobMutation) UserCleared() bool {
+	return m.cleareduser
 }
 
-// TagsIDs returns the "tags" edge IDs in the mutation.
-func (m *FileMutation) TagsIDs() (ids []string) {
-	for id := range m.tags {
-		ids = append(ids, id)
+// UserIDs returns the "user" edge IDs in the mutation.
+// Note that IDs always returns len(IDs) <= 1 for unique edges, and you should use
+// UserID instead. It exists only for internal usage by the builders.
+func (m *SearchJobMutation) UserIDs() (ids []int) {
+	if id := m.user; id != nil {
+		ids = append(ids, *id)
 	}
 	return
 }
 
-// ResetTags resets all changes to the "tags" edge.
-func (m *FileMutation) ResetTags() {
-	m.tags = nil
-	m.clearedtags = false
-	m.removedtags = nil
+// ResetUser resets all changes to the "user" edge.
+func (m *SearchJobMutation) ResetUser() {
+	m.user = nil
+	m.cleareduser = false
 }
 
-// Where appends a list predicates to the FileMutation builder.
-func (m *FileMutation) Where(ps ...predicate.File) {
+// Where appends a list predicates to the SearchJobMutation builder.
+func (m *SearchJobMutation) Where(ps ...predicate.SearchJob) {
 	m.predicates = append(m.predicates, ps...)
 }
 
-// WhereP appends storage-level predicates to the FileMutation builder. Using this method,
+// WhereP appends storage-level predicates to the SearchJobMutation builder. Using this method,
 // users can use type-assertion to append predicates that do not depend on any generated package.
-func (m *FileMutation) WhereP(ps ...func(*sql.Selector)) {
-	p := make([]predicate.File, len(ps))
+func (m *SearchJobMutation) WhereP(ps ...func(*sql.Selector)) {
+	p := make([]predicate.SearchJob, len(ps))
 	for i := range ps {
 		p[i] = ps[i]
 	}
@@ -628,48 +4806,84 @@ func (m *FileMutation) WhereP(ps ...func(*sql.Selector)) {
 }
 
 // Op returns the operation name.
-func (m *FileMutation) Op() Op {
+func (m *SearchJobMutation) Op() Op {
 	return m.op
 }
 
 // SetOp allows setting the mutation operation.
-func (m *FileMutation) SetOp(op Op) {
+func (m *SearchJobMutation) SetOp(op Op) {
 	m.op = op
 }
 
-// Type returns the node type of this mutation (File).
-func (m *FileMutation) Type() string {
+// Type returns the node type of this mutation (SearchJob).
+func (m *SearchJobMutation) Type() string {
 	return m.typ
 }
 
 // Fields returns all fields that were changed during this mutation. Note that in
 // order to get all numeric fields that were incremented/decremented, call
 // AddedFields().
-func (m *FileMutation) Fields() []string {
-	fields := make([]string, 0, 8)
-	if m.name != nil {
-		fields = append(fields, file.FieldName)
+func (m *SearchJobMutation) Fields() []string {
+	fields := make([]string, 0, 20)
+	if m.query != nil {
+		fields = append(fields, searchjob.FieldQuery)
+	}
+	if m.max_results != nil {
+		fields = append(fields, searchjob.FieldMaxResults)
 	}
 	if m.user != nil {
-		fields = append(fields, file.FieldUserID)
+		fields = append(fields, searchjob.FieldUserID)
 	}
-	if m.uuid != nil {
-		fields = append(fields, file.FieldUUID)
+	if m.status != nil {
+		fields = append(fields, searchjob.FieldStatus)
 	}
-	if m.size != nil {
-		fields = append(fields, file.FieldSize)
+	if m.saved != nil {
+		fields = append(fields, searchjob.FieldSaved)
 	}
-	if m.filetype != nil {
-		fields = append(fields, file.FieldType)
+	if m.failed != nil {
+		fields = append(fields, searchjob.FieldFailed)
+	}
+	if m.file_names != nil {
+		fields = append(fields, searchjob.FieldFileNames)
+	}
+	if m.deduplicated != nil {
+		fields = append(fields, searchjob.FieldDeduplicated)
+	}
+	if m.duplicate_file_names != nil {
+		fields = append(fields, searchjob.FieldDuplicateFileNames)
+	}
+	if m.duplicate_of != nil {
+		fields = append(fields, searchjob.FieldDuplicateOf)
+	}
+	if m.candidate_urls != nil {
+		fields = append(fields, searchjob.FieldCandidateUrls)
+	}
+	if m.candidate_thumbnail_urls != nil {
+		fields = append(fields, searchjob.FieldCandidateThumbnailUrls)
+	}
+	if m.next_candidate != nil {
+		fields = append(fields, searchjob.FieldNextCandidate)
+	}
+	if m.runner != nil {
+		fields = append(fields, searchjob.FieldRunner)
+	}
+	if m.heartbeat_at != nil {
+		fields = append(fields, searchjob.FieldHeartbeatAt)
+	}
+	if m.error != nil {
+		fields = append(fields, searchjob.FieldError)
 	}
 	if m.created_at != nil {
-		fields = append(fields, file.FieldCreatedAt)
+		fields = append(fields, searchjob.FieldCreatedAt)
 	}
 	if m.updated_at != nil {
-		fields = append(fields, file.FieldUpdatedAt)
+		fields = append(fields, searchjob.FieldUpdatedAt)
 	}
-	if m.deleted_at != nil {
-		fields = append(fields, file.FieldDeletedAt)
+	if m.started_at != nil {
+		fields = append(fields, searchjob.FieldStartedAt)
+	}
+	if m.finished_at != nil {
+		fields = append(fields, searchjob.FieldFinishedAt)
 	}
 	return fields
 }
@@ -677,24 +4891,48 @@ func (m *FileMutation) Fields() []string {
 // Field returns the value of a field with the given name. The second boolean
 // return value indicates that this field was not set, or was not defined in the
 // schema.
-func (m *FileMutation) Field(name string) (ent.Value, bool) {
+func (m *SearchJobMutation) Field(name string) (ent.Value, bool) {
 	switch name {
-	case file.FieldName:
-		return m.Name()
-	case file.FieldUserID:
+	case searchjob.FieldQuery:
+		return m.Query()
+	case searchjob.FieldMaxResults:
+		return m.MaxResults()
+	case searchjob.FieldUserID:
 		return m.UserID()
-	case file.FieldUUID:
-		return m.UUID()
-	case file.FieldSize:
-		return m.Size()
-	case file.FieldType:
-		return m.GetType()
-	case file.FieldCreatedAt:
+	case searchjob.FieldStatus:
+		return m.Status()
+	case searchjob.FieldSaved:
+		return m.Saved()
+	case searchjob.FieldFailed:
+		return m.Failed()
+	case searchjob.FieldFileNames:
+		return m.FileNames()
+	case searchjob.FieldDeduplicated:
+		return m.Deduplicated()
+	case searchjob.FieldDuplicateFileNames:
+		return m.DuplicateFileNames()
+	case searchjob.FieldDuplicateOf:
+		return m.DuplicateOf()
+	case searchjob.FieldCandidateUrls:
+		return m.CandidateUrls()
+	case searchjob.FieldCandidateThumbnailUrls:
+		return m.CandidateThumbnailUrls()
+	case searchjob.FieldNextCandidate:
+		return m.NextCandidate()
+	case searchjob.FieldRunner:
+		return m.Runner()
+	case searchjob.FieldHeartbeatAt:
+		return m.HeartbeatAt()
+	case searchjob.FieldError:
+		return m.Error()
+	case searchjob.FieldCreatedAt:
 		return m.CreatedAt()
-	case file.FieldUpdatedAt:
+	case searchjob.FieldUpdatedAt:
 		return m.UpdatedAt()
-	case file.FieldDeletedAt:
-		return m.DeletedAt()
+	case searchjob.FieldStartedAt:
+		return m.StartedAt()
+	case searchjob.FieldFinishedAt:
+		return m.FinishedAt()
 	}
 	return nil, false
 }
@@ -702,99 +4940,219 @@ func (m *FileMutation) Field(name string) (ent.Value, bool) {
 // OldField returns the old value of the field from the database. An error is
 // returned if the mutation operation is not UpdateOne, or the query to the
 // database failed.
-func (m *FileMutation) OldField(ctx context.Context, name string) (ent.Value, error) {
+func (m *SearchJobMutation) OldField(ctx context.Context, name string) (ent.Value, error) {
 	switch name {
-	case file.FieldName:
-		return m.OldName(ctx)
-	case file.FieldUserID:
+	case searchjob.FieldQuery:
+		return m.OldQuery(ctx)
+	case searchjob.FieldMaxResults:
+		return m.OldMaxResults(ctx)
+	case searchjob.FieldUserID:
 		return m.OldUserID(ctx)
-	case file.FieldUUID:
-		return m.OldUUID(ctx)
-	case file.FieldSize:
-		return m.OldSize(ctx)
-	case file.FieldType:
-		return m.OldType(ctx)
-	case file.FieldCreatedAt:
+	case searchjob.FieldStatus:
+		return m.OldStatus(ctx)
+	case searchjob.FieldSaved:
+		return m.OldSaved(ctx)
+	case searchjob.FieldFailed:
+		return m.OldFailed(ctx)
+	case searchjob.FieldFileNames:
+		return m.OldFileNames(ctx)
+	case searchjob.FieldDeduplicated:
+		return m.OldDeduplicated(ctx)
+	case searchjob.FieldDuplicateFileNames:
+		return m.OldDuplicateFileNames(ctx)
+	case searchjob.FieldDuplicateOf:
+		return m.OldDuplicateOf(ctx)
+	case searchjob.FieldCandidateUrls:
+		return m.OldCandidateUrls(ctx)
+	case searchjob.FieldCandidateThumbnailUrls:
+		return m.OldCandidateThumbnailUrls(ctx)
+	case searchjob.FieldNextCandidate:
+		return m.OldNextCandidate(ctx)
+	case searchjob.FieldRunner:
+		return m.OldRunner(ctx)
+	case searchjob.FieldHeartbeatAt:
+		return m.OldHeartbeatAt(ctx)
+	case searchjob.FieldError:
+		return m.OldError(ctx)
+	case searchjob.FieldCreatedAt:
 		return m.OldCreatedAt(ctx)
-	case file.FieldUpdatedAt:
+	case searchjob.FieldUpdatedAt:
 		return m.OldUpdatedAt(ctx)
-	case file.FieldDeletedAt:
-		return m.OldDeletedAt(ctx)
+	case searchjob.FieldStartedAt:
+		return m.OldStartedAt(ctx)
+	case searchjob.FieldFinishedAt:
+		return m.OldFinishedAt(ctx)
 	}
-	return nil, fmt.Errorf("unknown File field %s", name)
+	return nil, fmt.Errorf("unknown SearchJob field %s", name)
 }
 
 // SetField sets the value of a field with the given name. It returns an error if
 // the field is not defined in the schema, or if the type mismatched the field
 // type.
-func (m *FileMutation) SetField(name string, value ent.Value) error {
+func (m *SearchJobMutation) SetField(name string, value ent.Value) error {
 	switch name {
-	case file.FieldName:
+	case searchjob.FieldQuery:
 		v, ok := value.(string)
 		if !ok {
 			return fmt.Errorf("unexpected type %T for field %s", value, name)
 		}
-		m.SetName(v)
+		m.SetQuery(v)
+		return nil
+	case searchjob.FieldMaxResults:
+		v, ok := value.(int)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetMaxResults(v)
 		return nil
-	case file.FieldUserID:
+	case searchjob.FieldUserID:
 		v, ok := value.(int)
 		if !ok {
 			return fmt.Errorf("unexpected type %T for field %s", value, name)
 		}
 		m.SetUserID(v)
 		return nil
-	case file.FieldUUID:
-		v, ok := value.(string)
+	case searchjob.FieldStatus:
+		v, ok := value.(searchjob.Status)
 		if !ok {
 			return fmt.Errorf("unexpected type %T for field %s", value, name)
 		}
-		m.SetUUID(v)
+		m.SetStatus(v)
 		return nil
-	case file.FieldSize:
+	case searchjob.FieldSaved:
 		v, ok := value.(int)
 		if !ok {
 			return fmt.Errorf("unexpected type %T for field %s", value, name)
 		}
-		m.SetSize(v)
+		m.SetSaved(v)
 		return nil
-	case file.FieldType:
+	case searchjob.FieldFailed:
+		v, ok := value.(int)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetFailed(v)
+		return nil
+	case searchjob.FieldFileNames:
+		v, ok := value.([]string)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetFileNames(v)
+		return nil
+	case searchjob.FieldDeduplicated:
+		v, ok := value.(int)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetDeduplicated(v)
+		return nil
+	case searchjob.FieldDuplicateFileNames:
+		v, ok := value.([]string)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetDuplicateFileNames(v)
+		return nil
+	case searchjob.FieldDuplicateOf:
+		v, ok := value.([]string)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetDuplicateOf(v)
+		return nil
+	case searchjob.FieldCandidateUrls:
+		v, ok := value.([]string)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetCandidateUrls(v)
+		return nil
+	case searchjob.FieldCandidateThumbnailUrls:
+		v, ok := value.([]string)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetCandidateThumbnailUrls(v)
+		return nil
+	case searchjob.FieldNextCandidate:
+		v, ok := value.(int)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetNextCandidate(v)
+		return nil
+	case searchjob.FieldRunner:
 		v, ok := value.(string)
 		if !ok {
 			return fmt.Errorf("unexpected type %T for field %s", value, name)
 		}
-		m.SetType(v)
+		m.SetRunner(v)
 		return nil
-	case file.FieldCreatedAt:
+	case searchjob.FieldHeartbeatAt:
+		v, ok := value.(time.Time)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetHeartbeatAt(v)
+		return nil
+	case searchjob.FieldError:
+		v, ok := value.(string)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetError(v)
+		return nil
+	case searchjob.FieldCreatedAt:
 		v, ok := value.(time.Time)
 		if !ok {
 			return fmt.Errorf("unexpected type %T for field %s", value, name)
 		}
 		m.SetCreatedAt(v)
 		return nil
-	case file.FieldUpdatedAt:
+	case searchjob.FieldUpdatedAt:
 		v, ok := value.(time.Time)
 		if !ok {
 			return fmt.Errorf("unexpected type %T for field %s", value, name)
 		}
 		m.SetUpdatedAt(v)
 		return nil
-	case file.FieldDeletedAt:
+	case searchjob.FieldStartedAt:
 		v, ok := value.(time.Time)
 		if !ok {
 			return fmt.Errorf("unexpected type %T for field %s", value, name)
 		}
-		m.SetDeletedAt(v)
+		m.SetStartedAt(v)
+		return nil
+	case searchjob.FieldFinishedAt:
+		v, ok := value.(time.Time)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetFinishedAt(v)
 		return nil
 	}
-	return fmt.Errorf("unknown File field %s", name)
+	return fmt.Errorf("unknown SearchJob field %s", name)
 }
 
 // AddedFields returns all numeric fields that were incremented/decremented during
 // this mutation.
-func (m *FileMutation) AddedFields() []string {
+func (m *SearchJobMutation) AddedFields() []string {
 	var fields []string
-	if m.addsize != nil {
-		fields = append(fields, file.FieldSize)
+	if m.addmax_results != nil {
+		fields = append(fields, searchjob.FieldMaxResults)
+	}
+	if m.addsaved != nil {
+		fields = append(fields, searchjob.FieldSaved)
+	}
+	if m.addfailed != nil {
+		fields = append(fields, searchjob.FieldFailed)
+	}
+	if m.adddeduplicated != nil {
+		fields = append(fields, searchjob.FieldDeduplicated)
+	}
+	if m.addnext_candidate != nil {
+		fields = append(fields, searchjob.FieldNextCandidate)
 	}
 	return fields
 }
@@ -802,10 +5160,18 @@ func (m *FileMutation) AddedFields() []string {
 // AddedField returns the numeric value that was incremented/decremented on a field
 // with the given name. The second boolean return value indicates that this field
 // was not set, or was not defined in the schema.
-func (m *FileMutation) AddedField(name string) (ent.Value, bool) {
+func (m *SearchJobMutation) AddedField(name string) (ent.Value, bool) {
 	switch name {
-	case file.FieldSize:
-		return m.AddedSize()
+	case searchjob.FieldMaxResults:
+		return m.AddedMaxResults()
+	case searchjob.FieldSaved:
+		return m.AddedSaved()
+	case searchjob.FieldFailed:
+		return m.AddedFailed()
+	case searchjob.FieldDeduplicated:
+		return m.AddedDeduplicated()
+	case searchjob.FieldNextCandidate:
+		return m.AddedNextCandidate()
 	}
 	return nil, false
 }
@@ -813,236 +5179,311 @@ func (m *FileMutation) AddedField(name string) (ent.Value, bool) {
 // AddField adds the value to the field with the given name. It returns an error if
 // the field is not defined in the schema, or if the type mismatched the field
 // type.
-func (m *FileMutation) AddField(name string, value ent.Value) error {
+func (m *SearchJobMutation) AddField(name string, value ent.Value) error {
 	switch name {
-	case file.FieldSize:
+	case searchjob.FieldMaxResults:
 		v, ok := value.(int)
 		if !ok {
 			return fmt.Errorf("unexpected type %T for field %s", value, name)
 		}
-		m.AddSize(v)
+		m.AddMaxResults(v)
+		return nil
+	case searchjob.FieldSaved:
+		v, ok := value.(int)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.AddSaved(v)
+		return nil
+	case searchjob.FieldFailed:
+		v, ok := value.(int)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.AddFailed(v)
+		return nil
+	case searchjob.FieldDeduplicated:
+		v, ok := value.(int)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.AddDeduplicated(v)
+		return nil
+	case searchjob.FieldNextCandidate:
+		v, ok := value.(int)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.AddNextCandidate(v)
 		return nil
 	}
-	return fmt.Errorf("unknown File numeric field %s", name)
+	return fmt.Errorf("unknown SearchJob numeric field %s", name)
 }
 
 // ClearedFields returns all nullable fields that were cleared during this
 // mutation.
-func (m *FileMutation) ClearedFields() []string {
+func (m *SearchJobMutation) ClearedFields() []string {
 	var fields []string
-	if m.FieldCleared(file.FieldCreatedAt) {
-		fields = append(fields, file.FieldCreatedAt)
+	if m.FieldCleared(searchjob.FieldFileNames) {
+		fields = append(fields, searchjob.FieldFileNames)
 	}
-	if m.FieldCleared(file.FieldDeletedAt) {
-		fields = append(fields, file.FieldDeletedAt)
+	if m.FieldCleared(searchjob.FieldDuplicateFileNames) {
+		fields = append(fields, searchjob.FieldDuplicateFileNames)
+	}
+	if m.FieldCleared(searchjob.FieldDuplicateOf) {
+		fields = append(fields, searchjob.FieldDuplicateOf)
+	}
+	if m.FieldCleared(searchjob.FieldCandidateUrls) {
+		fields = append(fields, searchjob.FieldCandidateUrls)
+	}
+	if m.FieldCleared(searchjob.FieldCandidateThumbnailUrls) {
+		fields = append(fields, searchjob.FieldCandidateThumbnailUrls)
+	}
+	if m.FieldCleared(searchjob.FieldRunner) {
+		fields = append(fields, searchjob.FieldRunner)
+	}
+	if m.FieldCleared(searchjob.FieldHeartbeatAt) {
+		fields = append(fields, searchjob.FieldHeartbeatAt)
+	}
+	if m.FieldCleared(searchjob.FieldError) {
+		fields = append(fields, searchjob.FieldError)
+	}
+	if m.FieldCleared(searchjob.FieldCreatedAt) {
+		fields = append(fields, searchjob.FieldCreatedAt)
+	}
+	if m.FieldCleared(searchjob.FieldStartedAt) {
+		fields = append(fields, searchjob.FieldStartedAt)
+	}
+	if m.FieldCleared(searchjob.FieldFinishedAt) {
+		fields = append(fields, searchjob.FieldFinishedAt)
 	}
 	return fields
 }
 
 // FieldCleared returns a boolean indicating if a field with the given name was
 // cleared in this mutation.
-func (m *FileMutation) FieldCleared(name string) bool {
+func (m *SearchJobMutation) FieldCleared(name string) bool {
 	_, ok := m.clearedFields[name]
 	return ok
 }
 
 // ClearField clears the value of the field with the given name. It returns an
 // error if the field is not defined in the schema.
-func (m *FileMutation) ClearField(name string) error {
+func (m *SearchJobMutation) ClearField(name string) error {
 	switch name {
-	case file.FieldCreatedAt:
+	case searchjob.FieldFileNames:
+		m.ClearFileNames()
+		return nil
+	case searchjob.FieldDuplicateFileNames:
+		m.ClearDuplicateFileNames()
+		return nil
+	case searchjob.FieldDuplicateOf:
+		m.ClearDuplicateOf()
+		return nil
+	case searchjob.FieldCandidateUrls:
+		m.ClearCandidateUrls()
+		return nil
+	case searchjob.FieldCandidateThumbnailUrls:
+		m.ClearCandidateThumbnailUrls()
+		return nil
+	case searchjob.FieldRunner:
+		m.ClearRunner()
+		return nil
+	case searchjob.FieldHeartbeatAt:
+		m.ClearHeartbeatAt()
+		return nil
+	case searchjob.FieldError:
+		m.ClearError()
+		return nil
+	case searchjob.FieldCreatedAt:
 		m.ClearCreatedAt()
 		return nil
-	case file.FieldDeletedAt:
-		m.ClearDeletedAt()
+	case searchjob.FieldStartedAt:
+		m.ClearStartedAt()
+		return nil
+	case searchjob.FieldFinishedAt:
+		m.ClearFinishedAt()
 		return nil
 	}
-	return fmt.Errorf("unknown File nullable field %s", name)
+	return fmt.Errorf("unknown SearchJob nullable field %s", name)
 }
 
 // ResetField resets all changes in the mutation for the field with the given name.
 // It returns an error if the field is not defined in the schema.
-func (m *FileMutation) ResetField(name string) error {
+func (m *SearchJobMutation) ResetField(name string) error {
 	switch name {
-	case file.FieldName:
-		m.ResetName()
+	case searchjob.FieldQuery:
+		m.ResetQuery()
 		return nil
-	case file.FieldUserID:
+	case searchjob.FieldMaxResults:
+		m.ResetMaxResults()
+		return nil
+	case searchjob.FieldUserID:
 		m.ResetUserID()
 		return nil
-	case file.FieldUUID:
-		m.ResetUUID()
+	case searchjob.FieldStatus:
+		m.ResetStatus()
 		return nil
-	case file.FieldSize:
-		m.ResetSize()
+	case searchjob.FieldSaved:
+		m.ResetSaved()
 		return nil
-	case file.FieldType:
-		m.ResetType()
+	case searchjob.FieldFailed:
+		m.ResetFailed()
 		return nil
-	case file.FieldCreatedAt:
+	case searchjob.FieldFileNames:
+		m.ResetFileNames()
+		return nil
+	case searchjob.FieldDeduplicated:
+		m.ResetDeduplicated()
+		return nil
+	case searchjob.FieldDuplicateFileNames:
+		m.ResetDuplicateFileNames()
+		return nil
+	case searchjob.FieldDuplicateOf:
+		m.ResetDuplicateOf()
+		return nil
+	case searchjob.FieldCandidateUrls:
+		m.ResetCandidateUrls()
+		return nil
+	case searchjob.FieldCandidateThumbnailUrls:
+		m.ResetCandidateThumbnailUrls()
+		return nil
+	case searchjob.FieldNextCandidate:
+		m.ResetNextCandidate()
+		return nil
+	case searchjob.FieldRunner:
+		m.ResetRunner()
+		return nil
+	case searchjob.FieldHeartbeatAt:
+		m.ResetHeartbeatAt()
+		return nil
+	case searchjob.FieldError:
+		m.ResetError()
+		return nil
+	case searchjob.FieldCreatedAt:
 		m.ResetCreatedAt()
 		return nil
-	case file.FieldUpdatedAt:
+	case searchjob.FieldUpdatedAt:
 		m.ResetUpdatedAt()
 		return nil
-	case file.FieldDeletedAt:
-		m.ResetDeletedAt()
+	case searchjob.FieldStartedAt:
+		m.ResetStartedAt()
+		return nil
+	case searchjob.FieldFinishedAt:
+		m.ResetFinishedAt()
 		return nil
 	}
-	return fmt.Errorf("unknown File field %s", name)
+	return fmt.Errorf("unknown SearchJob field %s", name)
 }
 
 // AddedEdges returns all edge names that were set/added in this mutation.
-func (m *FileMutation) AddedEdges() []string {
-	edges := make([]string, 0, 3)
+func (m *SearchJobMutation) AddedEdges() []string {
+	edges := make([]string, 0, 1)
 	if m.user != nil {
-		edges = append(edges, file.EdgeUser)
-	}
-	if m.filetype != nil {
-		edges = append(edges, file.EdgeFiletype)
-	}
-	if m.tags != nil {
-		edges = append(edges, file.EdgeTags)
+		edges = append(edges, searchjob.EdgeUser)
 	}
 	return edges
 }
 
 // AddedIDs returns all IDs (to other nodes) that were added for the given edge
 // name in this mutation.
-func (m *FileMutation) AddedIDs(name string) []ent.Value {
+func (m *SearchJobMutation) AddedIDs(name string) []ent.Value {
 	switch name {
-	case file.EdgeUser:
+	case searchjob.EdgeUser:
 		if id := m.user; id != nil {
 			return []ent.Value{*id}
 		}
-	case file.EdgeFiletype:
-		if id := m.filetype; id != nil {
-			return []ent.Value{*id}
-		}
-	case file.EdgeTags:
-		ids := make([]ent.Value, 0, len(m.tags))
-		for id := range m.tags {
-			ids = append(ids, id)
-		}
-		return ids
 	}
 	return nil
 }
 
 // RemovedEdges returns all edge names that were removed in this mutation.
-func (m *FileMutation) RemovedEdges() []string {
-	edges := make([]string, 0, 3)
-	if m.removedtags != nil {
-		edges = append(edges, file.EdgeTags)
-	}
+func (m *SearchJobMutation) RemovedEdges() []string {
+	edges := make([]string, 0, 1)
 	return edges
 }
 
 // RemovedIDs returns all IDs (to other nodes) that were removed for the edge with
 // the given name in this mutation.
-func (m *FileMutation) RemovedIDs(name string) []ent.Value {
-	switch name {
-	case file.EdgeTags:
-		ids := make([]ent.Value, 0, len(m.removedtags))
-		for id := range m.removedtags {
-			ids = append(ids, id)
-		}
-		return ids
-	}
+func (m *SearchJobMutation) RemovedIDs(name string) []ent.Value {
 	return nil
 }
 
 // ClearedEdges returns all edge names that were cleared in this mutation.
-func (m *FileMutation) ClearedEdges() []string {
-	edges := make([]string, 0, 3)
+func (m *SearchJobMutation) ClearedEdges() []string {
+	edges := make([]string, 0, 1)
 	if m.cleareduser {
-		edges = append(edges, file.EdgeUser)
-	}
-	if m.clearedfiletype {
-		edges = append(edges, file.EdgeFiletype)
-	}
-	if m.clearedtags {
-		edges = append(edges, file.EdgeTags)
+		edges = append(edges, searchjob.EdgeUser)
 	}
 	return edges
 }
 
 // EdgeCleared returns a boolean which indicates if the edge with the given name
 // was cleared in this mutation.
-func (m *FileMutation) EdgeCleared(name string) bool {
+func (m *SearchJobMutation) EdgeCleared(name string) bool {
 	switch name {
-	case file.EdgeUser:
+	case searchjob.EdgeUser:
 		return m.cleareduser
-	case file.EdgeFiletype:
-		return m.clearedfiletype
-	case file.EdgeTags:
-		return m.clearedtags
 	}
 	return false
 }
 
 // ClearEdge clears the value of the edge with the given name. It returns an error
 // if that edge is not defined in the schema.
-func (m *FileMutation) ClearEdge(name string) error {
+func (m *SearchJobMutation) ClearEdge(name string) error {
 	switch name {
-	case file.EdgeUser:
+	case searchjob.EdgeUser:
 		m.ClearUser()
 		return nil
-	case file.EdgeFiletype:
-		m.ClearFiletype()
-		return nil
 	}
-	return fmt.Errorf("unknown File unique edge %s", name)
+	return fmt.Errorf("unknown SearchJob unique edge %s", name)
 }
 
 // ResetEdge resets all changes to the edge with the given name in this mutation.
 // It returns an error if the edge is not defined in the schema.
-func (m *FileMutation) ResetEdge(name string) error {
+func (m *SearchJobMutation) ResetEdge(name string) error {
 	switch name {
-	case file.EdgeUser:
+	case searchjob.EdgeUser:
 		m.ResetUser()
 		return nil
-	case file.EdgeFiletype:
-		m.ResetFiletype()
-		return nil
-	case file.EdgeTags:
-		m.ResetTags()
-		return nil
 	}
-	return fmt.Errorf("unknown File edge %s", name)
+	return fmt.Errorf("unknown SearchJob edge %s", name)
 }
 
-// FiletypeMutation represents an operation that mutates the Filetype nodes in the graph.
-type FiletypeMutation struct {
+// ShareMutation represents an operation that mutates the Share nodes in the graph.
+type ShareMutation struct {
 	config
-	op              Op
-	typ             string
-	id              *string
-	allowed_size    *int
-	addallowed_size *int
-	is_banned       *bool
-	created_at      *time.Time
-	updated_at      *time.Time
-	clearedFields   map[string]struct{}
-	files           map[int]struct{}
-	removedfiles    map[int]struct{}
-	clearedfiles    bool
-	done            bool
-	oldValue        func(context.Context) (*Filetype, error)
-	predicates      []predicate.Filetype
-}
-
-var _ ent.Mutation = (*FiletypeMutation)(nil)
-
-// filetypeOption allows management of the mutation configuration using functional options.
-type filetypeOption func(*FiletypeMutation)
-
-// newFiletypeMutation creates new mutation for the Filetype entity.
-func newFiletypeMutation(c config, op Op, opts ...filetypeOption) *FiletypeMutation {
-	m := &FiletypeMutation{
+	op               Op
+	typ              string
+	id               *int
+	permission       *share.Permission
+	expires_at       *time.Time
+	max_downloads    *int
+	addmax_downloads *int
+	downloads        *int
+	adddownloads     *int
+	created_at       *time.Time
+	clearedFields    map[string]struct{}
+	file             *int
+	clearedfile      bool
+	user             *int
+	cleareduser      bool
+	done             bool
+	oldValue         func(context.Context) (*Share, error)
+	predicates       []predicate.Share
+}
+
+var _ ent.Mutation = (*ShareMutation)(nil)
+
+// shareOption allows management of the mutation configuration using functional options.
+type shareOption func(*ShareMutation)
+
+// newShareMutation creates new mutation for the Share entity.
+func newShareMutation(c config, op Op, opts ...shareOption) *ShareMutation {
+	m := &ShareMutation{
 		config:        c,
 		op:            op,
-		typ:           TypeFiletype,
+		typ:           TypeShare,
 		clearedFields: make(map[string]struct{}),
 	}
 	for _, opt := range opts {
@@ -1051,20 +5492,20 @@ func newFiletypeMutation(c config, op Op, opts ...filetypeOption) *FiletypeMutat
 	return m
 }
 
-// withFiletypeID sets the ID field of the mutation.
-func withFiletypeID(id string) filetypeOption {
-	return func(m *FiletypeMutation) {
+// withShareID sets the ID field of the mutation.
+func withShareID(id int) shareOption {
+	return func(m *ShareMutation) {
 		var (
 			err   error
 			once  sync.Once
-			value *Filetype
+			value *Share
 		)
-		m.oldValue = func(ctx context.Context) (*Filetype, error) {
+		m.oldValue = func(ctx context.Context) (*Share, error) {
 			once.Do(func() {
 				if m.done {
 					err = errors.New("querying old values post mutation is not allowed")
 				} else {
-					value, err = m.Client().Filetype.Get(ctx, id)
+					value, err = m.Client().Share.Get(ctx, id)
 				}
 			})
 			return value, err
@@ -1073,10 +5514,10 @@ func withFiletypeID(id string) filetypeOption {
 	}
 }
 
-// withFiletype sets the old Filetype of the mutation.
-func withFiletype(node *Filetype) filetypeOption {
-	return func(m *FiletypeMutation) {
-		m.oldValue = func(context.Context) (*Filetype, error) {
+// withShare sets the old Share of the mutation.
+func withShare(node *Share) shareOption {
+	return func(m *ShareMutation) {
+		m.oldValue = func(context.Context) (*Share, error) {
 			return node, nil
 		}
 		m.id = &node.ID
@@ -1085,7 +5526,7 @@ func withFiletype(node *Filetype) filetypeOption {
 
 // Client returns a new `ent.Client` from the mutation. If the mutation was
 // executed in a transaction (ent.Tx), a transactional client is returned.
-func (m FiletypeMutation) Client() *Client {
+func (m ShareMutation) Client() *Client {
 	client := &Client{config: m.config}
 	client.init()
 	return client
@@ -1093,7 +5534,7 @@ func (m FiletypeMutation) Client() *Client {
 
 // Tx returns an `ent.Tx` for mutations that were executed in transactions;
 // it returns an error otherwise.
-func (m FiletypeMutation) Tx() (*Tx, error) {
+func (m ShareMutation) Tx() (*Tx, error) {
 	if _, ok := m.driver.(*txDriver); !ok {
 		return nil, errors.New("ent: mutation is not running in a transaction")
 	}
@@ -1102,15 +5543,9 @@ func (m FiletypeMutation) Tx() (*Tx, error) {
 	return tx, nil
 }
 
-// SetID sets the value of the id field. Note that this
-// operation is only accepted on creation of Filetype entities.
-func (m *FiletypeMutation) SetID(id string) {
-	m.id = &id
-}
-
 // ID returns the ID value in the mutation. Note that the ID is only available
 // if it was provided to the builder or after it was returned from the database.
-func (m *FiletypeMutation) ID() (id string, exists bool) {
+func (m *ShareMutation) ID() (id int, exists bool) {
 	if m.id == nil {
 		return
 	}
@@ -1121,261 +5556,429 @@ func (m *FiletypeMutation) ID() (id string, exists bool) {
 // That means, if the mutation is applied within a transaction with an isolation level such
 // as sql.LevelSerializable, the returned ids match the ids of the rows that will be updated
 // or updated by the mutation.
-func (m *FiletypeMutation) IDs(ctx context.Context) ([]string, error) {
+func (m *ShareMutation) IDs(ctx context.Context) ([]int, error) {
 	switch {
 	case m.op.Is(OpUpdateOne | OpDeleteOne):
 		id, exists := m.ID()
 		if exists {
-			return []string{id}, nil
+			return []int{id}, nil
 		}
 		fallthrough
 	case m.op.Is(OpUpdate | OpDelete):
-		return m.Client().Filetype.Query().Where(m.predicates...).IDs(ctx)
+		return m.Client().Share.Query().Where(m.predicates...).IDs(ctx)
 	default:
 		return nil, fmt.Errorf("IDs is not allowed on %s operations", m.op)
 	}
 }
 
-// SetAllowedSize sets the "allowed_size" field.
-func (m *FiletypeMutation) SetAllowedSize(i int) {
-	m.allowed_size = &i
-	m.addallowed_size = nil
+// SetFileID sets the "file_id" field.
+func (m *ShareMutation) SetFileID(i int) {
+	m.file = &i
 }
 
-// AllowedSize returns the value of the "allowed_size" field in the mutation.
-func (m *FiletypeMutation) AllowedSize() (r int, exists bool) {
-	v := m.allowed_size
+// FileID returns the value of the "file_id" field in the mutation.
+func (m *ShareMutation) FileID() (r int, exists bool) {
+	v := m.file
 	if v == nil {
 		return
 	}
 	return *v, true
 }
 
-// OldAllowedSize returns the old "allowed_size" field's value of the Filetype entity.
-// If the Filetype object wasn't provided to the builder, the object is fetched from the database.
+// OldFileID returns the old "file_id" field's value of the Share entity.
+// If the Share object wasn't provided to the builder, the object is fetched from the database.
 // An error is returned if the mutation operation is not UpdateOne, or the database query fails.
-func (m *FiletypeMutation) OldAllowedSize(ctx context.Context) (v int, err error) {
+func (m *ShareMutation) OldFileID(ctx context.Context) (v int, err error) {
 	if !m.op.Is(OpUpdateOne) {
-		return v, errors.New("OldAllowedSize is only allowed on UpdateOne operations")
+		return v, errors.New("OldFileID is only allowed on UpdateOne operations")
 	}
 	if m.id == nil || m.oldValue == nil {
-		return v, errors.New("OldAllowedSize requires an ID field in the mutation")
+		return v, errors.New("OldFileID requires an ID field in the mutation")
 	}
 	oldValue, err := m.oldValue(ctx)
 	if err != nil {
-		return v, fmt.Errorf("querying old value for OldAllowedSize: %w", err)
+		return v, fmt.Errorf("querying old value for OldFileID: %w", err)
 	}
-	return oldValue.AllowedSize, nil
+	return oldValue.FileID, nil
 }
 
-// AddAllowedSize adds i to the "allowed_size" field.
-func (m *FiletypeMutation) AddAllowedSize(i int) {
-	if m.addallowed_size != nil {
-		*m.addallowed_size += i
-	} else {
-		m.addallowed_size = &i
-	}
+// ResetFileID resets all changes to the "file_id" field.
+func (m *ShareMutation) ResetFileID() {
+	m.file = nil
 }
 
-// AddedAllowedSize returns the value that was added to the "allowed_size" field in this mutation.
-func (m *FiletypeMutation) AddedAllowedSize() (r int, exists bool) {
-	v := m.addallowed_size
+// SetUserID sets the "user_id" field.
+func (m *ShareMutation) SetUserID(i int) {
+	m.user = &i
+}
+
+// UserID returns the value of the "user_id" field in the mutation.
+func (m *ShareMutation) UserID() (r int, exists bool) {
+	v := m.user
 	if v == nil {
 		return
 	}
 	return *v, true
 }
 
-// ResetAllowedSize resets all changes to the "allowed_size" field.
-func (m *FiletypeMutation) ResetAllowedSize() {
-	m.allowed_size = nil
-	m.addallowed_size = nil
+// OldUserID returns the old "user_id" field's value of the Share entity.
+// If the Share object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *ShareMutation) OldUserID(ctx context.Context) (v *int, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldUserID is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldUserID requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldUserID: %w", err)
+	}
+	return oldValue.UserID, nil
 }
 
-// SetIsBanned sets the "is_banned" field.
-func (m *FiletypeMutation) SetIsBanned(b bool) {
-	m.is_banned = &b
+// ClearUserID clears the value of the "user_id" field.
+func (m *ShareMutation) ClearUserID() {
+	m.user = nil
+	m.clearedFields[share.FieldUserID] = struct{}{}
 }
 
-// IsBanned returns the value of the "is_banned" field in the mutation.
-func (m *FiletypeMutation) IsBanned() (r bool, exists bool) {
-	v := m.is_banned
+// UserIDCleared returns if the "user_id" field was cleared in this mutation.
+func (m *ShareMutation) UserIDCleared() bool {
+	_, ok := m.clearedFields[share.FieldUserID]
+	return ok
+}
+
+// ResetUserID resets all changes to the "user_id" field.
+func (m *ShareMutation) ResetUserID() {
+	m.user = nil
+	delete(m.clearedFields, share.FieldUserID)
+}
+
+// SetPermission sets the "permission" field.
+func (m *ShareMutation) SetPermission(s share.Permission) {
+	m.permission = &s
+}
+
+// Permission returns the value of the "permission" field in the mutation.
+func (m *ShareMutation) Permission() (r share.Permission, exists bool) {
+	v := m.permission
 	if v == nil {
 		return
 	}
 	return *v, true
 }
 
-// OldIsBanned returns the old "is_banned" field's value of the Filetype entity.
-// If the Filetype object wasn't provided to the builder, the object is fetched from the database.
+// OldPermission returns the old "permission" field's value of the Share entity.
+// If the Share object wasn't provided to the builder, the object is fetched from the database.
 // An error is returned if the mutation operation is not UpdateOne, or the database query fails.
-func (m *FiletypeMutation) OldIsBanned(ctx context.Context) (v bool, err error) {
+func (m *ShareMutation) OldPermission(ctx context.Context) (v share.Permission, err error) {
 	if !m.op.Is(OpUpdateOne) {
-		return v, errors.New("OldIsBanned is only allowed on UpdateOne operations")
+		return v, errors.New("OldPermission is only allowed on UpdateOne operations")
 	}
 	if m.id == nil || m.oldValue == nil {
-		return v, errors.New("OldIsBanned requires an ID field in the mutation")
+		return v, errors.New("OldPermission requires an ID field in the mutation")
 	}
 	oldValue, err := m.oldValue(ctx)
 	if err != nil {
-		return v, fmt.Errorf("querying old value for OldIsBanned: %w", err)
+		return v, fmt.Errorf("querying old value for OldPermission: %w", err)
 	}
-	return oldValue.IsBanned, nil
+	return oldValue.Permission, nil
 }
 
-// ResetIsBanned resets all changes to the "is_banned" field.
-func (m *FiletypeMutation) ResetIsBanned() {
-	m.is_banned = nil
+// ResetPermission resets all changes to the "permission" field.
+func (m *ShareMutation) ResetPermission() {
+	m.permission = nil
 }
 
-// SetCreatedAt sets the "created_at" field.
-func (m *FiletypeMutation) SetCreatedAt(t time.Time) {
-	m.created_at = &t
+// SetExpiresAt sets the "expires_at" field.
+func (m *ShareMutation) SetExpiresAt(t time.Time) {
+	m.expires_at = &t
 }
 
-// CreatedAt returns the value of the "created_at" field in the mutation.
-func (m *FiletypeMutation) CreatedAt() (r time.Time, exists bool) {
-	v := m.created_at
+// ExpiresAt returns the value of the "expires_at" field in the mutation.
+func (m *ShareMutation) ExpiresAt() (r time.Time, exists bool) {
+	v := m.expires_at
 	if v == nil {
 		return
 	}
 	return *v, true
 }
 
-// OldCreatedAt returns the old "created_at" field's value of the Filetype entity.
-// If the Filetype object wasn't provided to the builder, the object is fetched from the database.
+// OldExpiresAt returns the old "expires_at" field's value of the Share entity.
+// If the Share object wasn't provided to the builder, the object is fetched from the database.
 // An error is returned if the mutation operation is not UpdateOne, or the database query fails.
-func (m *FiletypeMutation) OldCreatedAt(ctx context.Context) (v *time.Time, err error) {
+func (m *ShareMutation) OldExpiresAt(ctx context.Context) (v *time.Time, err error) {
 	if !m.op.Is(OpUpdateOne) {
-		return v, errors.New("OldCreatedAt is only allowed on UpdateOne operations")
+		return v, errors.New("OldExpiresAt is only allowed on UpdateOne operations")
 	}
 	if m.id == nil || m.oldValue == nil {
-		return v, errors.New("OldCreatedAt requires an ID field in the mutation")
+		return v, errors.New("OldExpiresAt requires an ID field in the mutation")
 	}
 	oldValue, err := m.oldValue(ctx)
 	if err != nil {
-		return v, fmt.Errorf("querying old value for OldCreatedAt: %w", err)
+		return v, fmt.Errorf("querying old value for OldExpiresAt: %w", err)
 	}
-	return oldValue.CreatedAt, nil
+	return oldValue.ExpiresAt, nil
 }
 
-// ClearCreatedAt clears the value of the "created_at" field.
-func (m *FiletypeMutation) ClearCreatedAt() {
-	m.created_at = nil
-	m.clearedFields[filetype.FieldCreatedAt] = struct{}{}
+// ClearExpiresAt clears the value of the "expires_at" field.
+func (m *ShareMutation) ClearExpiresAt() {
+	m.expires_at = nil
+	m.clearedFields[share.FieldExpiresAt] = struct{}{}
 }
 
-// CreatedAtCleared returns if the "created_at" field was cleared in this mutation.
-func (m *FiletypeMutation) CreatedAtCleared() bool {
-	_, ok := m.clearedFields[filetype.FieldCreatedAt]
+// ExpiresAtCleared returns if the "expires_at" field was cleared in this mutation.
+func (m *ShareMutation) ExpiresAtCleared() bool {
+	_, ok := m.clearedFields[share.FieldExpiresAt]
 	return ok
 }
 
-// ResetCreatedAt resets all changes to the "created_at" field.
-func (m *FiletypeMutation) ResetCreatedAt() {
-	m.created_at = nil
-	delete(m.clearedFields, filetype.FieldCreatedAt)
+// ResetExpiresAt resets all changes to the "expires_at" field.
+func (m *ShareMutation) ResetExpiresAt() {
+	m.expires_at = nil
+	delete(m.clearedFields, share.FieldExpiresAt)
 }
 
-// SetUpdatedAt sets the "updated_at" field.
-func (m *FiletypeMutation) SetUpdatedAt(t time.Time) {
-	m.updated_at = &t
+// SetMaxDownloads sets the "max_downloads" field.
+func (m *ShareMutation) SetMaxDownloads(i int) {
+	m.max_downloads = &i
+	m.addmax_downloads = nil
 }
 
-// UpdatedAt returns the value of the "updated_at" field in the mutation.
-func (m *FiletypeMutation) UpdatedAt() (r time.Time, exists bool) {
-	v := m.updated_at
+// MaxDownloads returns the value of the "max_downloads" field in the mutation.
+func (m *ShareMutation) MaxDownloads() (r int, exists bool) {
+	v := m.max_downloads
 	if v == nil {
 		return
 	}
 	return *v, true
 }
 
-// OldUpdatedAt returns the old "updated_at" field's value of the Filetype entity.
-// If the Filetype object wasn't provided to the builder, the object is fetched from the database.
+// OldMaxDownloads returns the old "max_downloads" field's value of the Share entity.
+// If the Share object wasn't provided to the builder, the object is fetched from the database.
 // An error is returned if the mutation operation is not UpdateOne, or the database query fails.
-func (m *FiletypeMutation) OldUpdatedAt(ctx context.Context) (v time.Time, err error) {
+func (m *ShareMutation) OldMaxDownloads(ctx context.Context) (v *int, err error) {
 	if !m.op.Is(OpUpdateOne) {
-		return v, errors.New("OldUpdatedAt is only allowed on UpdateOne operations")
+		return v, errors.New("OldMaxDownloads is only allowed on UpdateOne operations")
 	}
 	if m.id == nil || m.oldValue == nil {
-		return v, errors.New("OldUpdatedAt requires an ID field in the mutation")
+		return v, errors.New("OldMaxDownloads requires an ID field in the mutation")
 	}
 	oldValue, err := m.oldValue(ctx)
 	if err != nil {
-		return v, fmt.Errorf("querying old value for OldUpdatedAt: %w", err)
+		return v, fmt.Errorf("querying old value for OldMaxDownloads: %w", err)
 	}
-	return oldValue.UpdatedAt, nil
+	return oldValue.MaxDownloads, nil
 }
 
-// ResetUpdatedAt resets all changes to the "updated_at" field.
-func (m *FiletypeMutation) ResetUpdatedAt() {
-	m.updated_at = nil
+// AddMaxDownloads adds i to the "max_downloads" field.
+func (m *ShareMutation) AddMaxDownloads(i int) {
+	if m.addmax_downloads != nil {
+		*m.addmax_downloads += i
+	} else {
+		m.addmax_downloads = &i
+	}
 }
 
-// AddFileIDs adds the "files" edge to the File entity by ids.
-func (m *FiletypeMutation) AddFileIDs(ids ...int) {
-	if m.files == nil {
-		m.files = make(map[int]struct{})
+// AddedMaxDownloads returns the value that was added to the "max_downloads" field in this mutation.
+func (m *ShareMutation) AddedMaxDownloads() (r int, exists bool) {
+	v := m.addmax_downloads
+	if v == nil {
+		return
 	}
-	for i := range ids {
-		m.files[ids[i]] = struct{}{}
+	return *v, true
+}
+
+// ClearMaxDownloads clears the value of the "max_downloads" field.
+func (m *ShareMutation) ClearMaxDownloads() {
+	m.max_downloads = nil
+	m.addmax_downloads = nil
+	m.clearedFields[share.FieldMaxDownloads] = struct{}{}
+}
+
+// MaxDownloadsCleared returns if the "max_downloads" field was cleared in this mutation.
+func (m *ShareMutation) MaxDownloadsCleared() bool {
+	_, ok := m.clearedFields[share.FieldMaxDownloads]
+	return ok
+}
+
+// ResetMaxDownloads resets all changes to the "max_downloads" field.
+func (m *ShareMutation) ResetMaxDownloads() {
+	m.max_downloads = nil
+	m.addmax_downloads = nil
+	delete(m.clearedFields, share.FieldMaxDownloads)
+}
+
+// SetDownloads sets the "downloads" field.
+func (m *ShareMutation) SetDownloads(i int) {
+	m.downloads = &i
+	m.adddownloads = nil
+}
+
+// Downloads returns the value of the "downloads" field in the mutation.
+func (m *ShareMutation) Downloads() (r int, exists bool) {
+	v := m.downloads
+	if v == nil {
+		return
 	}
+	return *v, true
 }
 
-// ClearFiles clears the "files" edge to the File entity.
-func (m *FiletypeMutation) ClearFiles() {
-	m.clearedfiles = true
+// OldDownloads returns the old "downloads" field's value of the Share entity.
+// If the Share object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *ShareMutation) OldDownloads(ctx context.Context) (v int, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldDownloads is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldDownloads requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldDownloads: %w", err)
+	}
+	return oldValue.Downloads, nil
 }
 
-// FilesCleared reports if the "files" edge to the File entity was cleared.
-func (m *FiletypeMutation) FilesCleared() bool {
-	return m.clearedfiles
+// AddDownloads adds i to the "downloads" field.
+func (m *ShareMutation) AddDownloads(i int) {
+	if m.adddownloads != nil {
+		*m.adddownloads += i
+	} else {
+		m.adddownloads = &i
+	}
 }
 
-// RemoveFileIDs removes the "files" edge to the File entity by IDs.
-func (m *FiletypeMutation) RemoveFileIDs(ids ...int) {
-	if m.removedfiles == nil {
-		m.removedfiles = make(map[int]struct{})
+// AddedDownloads returns the value that was added to the "downloads" field in this mutation.
+func (m *ShareMutation) AddedDownloads() (r int, exists bool) {
+	v := m.adddownloads
+	if v == nil {
+		return
 	}
-	for i := range ids {
-		delete(m.files, ids[i])
-		m.removedfiles[ids[i]] = struct{}{}
+	return *v, true
+}
+
+// ResetDownloads resets all changes to the "downloads" field.
+func (m *ShareMutation) ResetDownloads() {
+	m.downloads = nil
+	m.adddownloads = nil
+}
+
+// SetCreatedAt sets the "created_at" field.
+func (m *ShareMutation) SetCreatedAt(t time.Time) {
+	m.created_at = &t
+}
+
+// CreatedAt returns the value of the "created_at" field in the mutation.
+func (m *ShareMutation) CreatedAt() (r time.Time, exists bool) {
+	v := m.created_at
+	if v == nil {
+		return
 	}
+	return *v, true
 }
 
-// RemovedFiles returns the removed IDs of the "files" edge to the File entity.
-func (m *FiletypeMutation) RemovedFilesIDs() (ids []int) {
-	for id := range m.removedfiles {
-		ids = append(ids, id)
+// OldCreatedAt returns the old "created_at" field's value of the Share entity.
+// If the Share object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *ShareMutation) OldCreatedAt(ctx context.Context) (v *time.Time, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldCreatedAt is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldCreatedAt requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldCreatedAt: %w", err)
+	}
+	return oldValue.CreatedAt, nil
+}
+
+// ClearCreatedAt clears the value of the "created_at" field.
+func (m *ShareMutation) ClearCreatedAt() {
+	m.created_at = nil
+	m.clearedFields[share.FieldCreatedAt] = struct{}{}
+}
+
+// CreatedAtCleared returns if the "created_at" field was cleared in this mutation.
+func (m *ShareMutation) CreatedAtCleared() bool {
+	_, ok := m.clearedFields[share.FieldCreatedAt]
+	return ok
+}
+
+// ResetCreatedAt resets all changes to the "created_at" field.
+func (m *ShareMutation) ResetCreatedAt() {
+	m.created_at = nil
+	delete(m.clearedFields, share.FieldCreatedAt)
+}
+
+// ClearFile clears the "file" edge to the File entity.
+func (m *ShareMutation) ClearFile() {
+	m.clearedfile = true
+	m.clearedFields[share.FieldFileID] = struct{}{}
+}
+
+// FileCleared reports if the "file" edge to the File entity was cleared.
+func (m *ShareMutation) FileCleared() bool {
+	return m.clearedfile
+}
+
+// FileIDs returns the "file" edge IDs in the mutation.
+// Note that IDs always returns len(IDs) <= 1 for unique edges, and you should use
+// FileID instead. It exists only for internal usage by the builders.
+func (m *ShareMutation) FileIDs() (ids []int) {
+	if id := m.file; id != nil {
+		ids = append(ids, *id)
 	}
 	return
 }
 
-// FilesIDs returns the "files" edge IDs in the mutation.
-func (m *FiletypeMutation) FilesIDs() (ids []int) {
-	for id := range m.files {
-		ids = append(ids, id)
+// ResetFile resets all changes to the "file" edge.
+func (m *ShareMutation) ResetFile() {
+	m.file = nil
+	m.clearedfile = false
+}
+
+// ClearUser clears the "user" edge to the User entity.
+func (m *ShareMutation) ClearUser() {
+	m.cleareduser = true
+	m.clearedFields[share.FieldUserID] = struct{}{}
+}
+
+// UserCleared reports if the "user" edge to the User entity was cleared.
+func (m *ShareMutation) UserCleared() bool {
+	return m.UserIDCleared() || m.cleareduser
+}
+
+// UserIDs returns the "user" edge IDs in the mutation.
+// Note that IDs always returns len(IDs) <= 1 for unique edges, and you should use
+// UserID instead. It exists only for internal usage by the builders.
+func (m *ShareMutation) UserIDs() (ids []int) {
+	if id := m.user; id != nil {
+		ids = append(ids, *id)
 	}
 	return
 }
 
-// ResetFiles resets all changes to the "files" edge.
-func (m *FiletypeMutation) ResetFiles() {
-	m.files = nil
-	m.clearedfiles = false
-	m.removedfiles = nil
+// ResetUser resets all changes to the "user" edge.
+func (m *ShareMutation) ResetUser() {
+	m.user = nil
+	m.cleareduser = false
 }
 
-// Where appends a list predicates to the FiletypeMutation builder.
-func (m *FiletypeMutation) Where(ps ...predicate.Filetype) {
+// Where appends a list predicates to the ShareMutation builder.
+func (m *ShareMutation) Where(ps ...predicate.Share) {
 	m.predicates = append(m.predicates, ps...)
 }
 
-// WhereP appends storage-level predicates to the FiletypeMutation builder. Using this method,
+// WhereP appends storage-level predicates to the ShareMutation builder. Using this method,
 // users can use type-assertion to append predicates that do not depend on any generated package.
-func (m *FiletypeMutation) WhereP(ps ...func(*sql.Selector)) {
-	p := make([]predicate.Filetype, len(ps))
+func (m *ShareMutation) WhereP(ps ...func(*sql.Selector)) {
+	p := make([]predicate.Share, len(ps))
 	for i := range ps {
 		p[i] = ps[i]
 	}
@@ -1383,36 +5986,45 @@ func (m *FiletypeMutation) WhereP(ps ...func(*sql.Selector)) {
 }
 
 // Op returns the operation name.
-func (m *FiletypeMutation) Op() Op {
+func (m *ShareMutation) Op() Op {
 	return m.op
 }
 
 // SetOp allows setting the mutation operation.
-func (m *FiletypeMutation) SetOp(op Op) {
+func (m *ShareMutation) SetOp(op Op) {
 	m.op = op
 }
 
-// Type returns the node type of this mutation (Filetype).
-func (m *FiletypeMutation) Type() string {
+// Type returns the node type of this mutation (Share).
+func (m *ShareMutation) Type() string {
 	return m.typ
 }
 
 // Fields returns all fields that were changed during this mutation. Note that in
 // order to get all numeric fields that were incremented/decremented, call
 // AddedFields().
-func (m *FiletypeMutation) Fields() []string {
-	fields := make([]string, 0, 4)
-	if m.allowed_size != nil {
-		fields = append(fields, filetype.FieldAllowedSize)
+func (m *ShareMutation) Fields() []string {
+	fields := make([]string, 0, 7)
+	if m.file != nil {
+		fields = append(fields, share.FieldFileID)
 	}
-	if m.is_banned != nil {
-		fields = append(fields, filetype.FieldIsBanned)
+	if m.user != nil {
+		fields = append(fields, share.FieldUserID)
 	}
-	if m.created_at != nil {
-		fields = append(fields, filetype.FieldCreatedAt)
+	if m.permission != nil {
+		fields = append(fields, share.FieldPermission)
 	}
-	if m.updated_at != nil {
-		fields = append(fields, filetype.FieldUpdatedAt)
+	if m.expires_at != nil {
+		fields = append(fields, share.FieldExpiresAt)
+	}
+	if m.max_downloads != nil {
+		fields = append(fields, share.FieldMaxDownloads)
+	}
+	if m.downloads != nil {
+		fields = append(fields, share.FieldDownloads)
+	}
+	if m.created_at != nil {
+		fields = append(fields, share.FieldCreatedAt)
 	}
 	return fields
 }
@@ -1420,16 +6032,22 @@ func (m *FiletypeMutation) Fields() []string {
 // Field returns the value of a field with the given name. The second boolean
 // return value indicates that this field was not set, or was not defined in the
 // schema.
-func (m *FiletypeMutation) Field(name string) (ent.Value, bool) {
+func (m *ShareMutation) Field(name string) (ent.Value, bool) {
 	switch name {
-	case filetype.FieldAllowedSize:
-		return m.AllowedSize()
-	case filetype.FieldIsBanned:
-		return m.IsBanned()
-	case filetype.FieldCreatedAt:
+	case share.FieldFileID:
+		return m.FileID()
+	case share.FieldUserID:
+		return m.UserID()
+	case share.FieldPermission:
+		return m.Permission()
+	case share.FieldExpiresAt:
+		return m.ExpiresAt()
+	case share.FieldMaxDownloads:
+		return m.MaxDownloads()
+	case share.FieldDownloads:
+		return m.Downloads()
+	case share.FieldCreatedAt:
 		return m.CreatedAt()
-	case filetype.FieldUpdatedAt:
-		return m.UpdatedAt()
 	}
 	return nil, false
 }
@@ -1437,63 +6055,93 @@ func (m *FiletypeMutation) Field(name string) (ent.Value, bool) {
 // OldField returns the old value of the field from the database. An error is
 // returned if the mutation operation is not UpdateOne, or the query to the
 // database failed.
-func (m *FiletypeMutation) OldField(ctx context.Context, name string) (ent.Value, error) {
+func (m *ShareMutation) OldField(ctx context.Context, name string) (ent.Value, error) {
 	switch name {
-	case filetype.FieldAllowedSize:
-		return m.OldAllowedSize(ctx)
-	case filetype.FieldIsBanned:
-		return m.OldIsBanned(ctx)
-	case filetype.FieldCreatedAt:
+	case share.FieldFileID:
+		return m.OldFileID(ctx)
+	case share.FieldUserID:
+		return m.OldUserID(ctx)
+	case share.FieldPermission:
+		return m.OldPermission(ctx)
+	case share.FieldExpiresAt:
+		return m.OldExpiresAt(ctx)
+	case share.FieldMaxDownloads:
+		return m.OldMaxDownloads(ctx)
+	case share.FieldDownloads:
+		return m.OldDownloads(ctx)
+	case share.FieldCreatedAt:
 		return m.OldCreatedAt(ctx)
-	case filetype.FieldUpdatedAt:
-		return m.OldUpdatedAt(ctx)
 	}
-	return nil, fmt.Errorf("unknown Filetype field %s", name)
+	return nil, fmt.Errorf("unknown Share field %s", name)
 }
 
 // SetField sets the value of a field with the given name. It returns an error if
 // the field is not defined in the schema, or if the type mismatched the field
 // type.
-func (m *FiletypeMutation) SetField(name string, value ent.Value) error {
+func (m *ShareMutation) SetField(name string, value ent.Value) error {
 	switch name {
-	case filetype.FieldAllowedSize:
+	case share.FieldFileID:
 		v, ok := value.(int)
 		if !ok {
 			return fmt.Errorf("unexpected type %T for field %s", value, name)
 		}
-		m.SetAllowedSize(v)
+		m.SetFileID(v)
 		return nil
-	case filetype.FieldIsBanned:
-		v, ok := value.(bool)
+	case share.FieldUserID:
+		v, ok := value.(int)
 		if !ok {
 			return fmt.Errorf("unexpected type %T for field %s", value, name)
 		}
-		m.SetIsBanned(v)
+		m.SetUserID(v)
 		return nil
-	case filetype.FieldCreatedAt:
+	case share.FieldPermission:
+		v, ok := value.(share.Permission)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetPermission(v)
+		return nil
+	case share.FieldExpiresAt:
 		v, ok := value.(time.Time)
 		if !ok {
 			return fmt.Errorf("unexpected type %T for field %s", value, name)
 		}
-		m.SetCreatedAt(v)
+		m.SetExpiresAt(v)
 		return nil
-	case filetype.FieldUpdatedAt:
+	case share.FieldMaxDownloads:
+		v, ok := value.(int)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetMaxDownloads(v)
+		return nil
+	case share.FieldDownloads:
+		v, ok := value.(int)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetDownloads(v)
+		return nil
+	case share.FieldCreatedAt:
 		v, ok := value.(time.Time)
 		if !ok {
 			return fmt.Errorf("unexpected type %T for field %s", value, name)
 		}
-		m.SetUpdatedAt(v)
+		m.SetCreatedAt(v)
 		return nil
 	}
-	return fmt.Errorf("unknown Filetype field %s", name)
+	return fmt.Errorf("unknown Share field %s", name)
 }
 
 // AddedFields returns all numeric fields that were incremented/decremented during
 // this mutation.
-func (m *FiletypeMutation) AddedFields() []string {
+func (m *ShareMutation) AddedFields() []string {
 	var fields []string
-	if m.addallowed_size != nil {
-		fields = append(fields, filetype.FieldAllowedSize)
+	if m.addmax_downloads != nil {
+		fields = append(fields, share.FieldMaxDownloads)
+	}
+	if m.adddownloads != nil {
+		fields = append(fields, share.FieldDownloads)
 	}
 	return fields
 }
@@ -1501,10 +6149,12 @@ func (m *FiletypeMutation) AddedFields() []string {
 // AddedField returns the numeric value that was incremented/decremented on a field
 // with the given name. The second boolean return value indicates that this field
 // was not set, or was not defined in the schema.
-func (m *FiletypeMutation) AddedField(name string) (ent.Value, bool) {
+func (m *ShareMutation) AddedField(name string) (ent.Value, bool) {
 	switch name {
-	case filetype.FieldAllowedSize:
-		return m.AddedAllowedSize()
+	case share.FieldMaxDownloads:
+		return m.AddedMaxDownloads()
+	case share.FieldDownloads:
+		return m.AddedDownloads()
 	}
 	return nil, false
 }
@@ -1512,149 +6162,191 @@ func (m *FiletypeMutation) AddedField(name string) (ent.Value, bool) {
 // AddField adds the value to the field with the given name. It returns an error if
 // the field is not defined in the schema, or if the type mismatched the field
 // type.
-func (m *FiletypeMutation) AddField(name string, value ent.Value) error {
+func (m *ShareMutation) AddField(name string, value ent.Value) error {
 	switch name {
-	case filetype.FieldAllowedSize:
+	case share.FieldMaxDownloads:
 		v, ok := value.(int)
 		if !ok {
 			return fmt.Errorf("unexpected type %T for field %s", value, name)
 		}
-		m.AddAllowedSize(v)
+		m.AddMaxDownloads(v)
+		return nil
+	case share.FieldDownloads:
+		v, ok := value.(int)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.AddDownloads(v)
 		return nil
 	}
-	return fmt.Errorf("unknown Filetype numeric field %s", name)
+	return fmt.Errorf("unknown Share numeric field %s", name)
 }
 
 // ClearedFields returns all nullable fields that were cleared during this
 // mutation.
-func (m *FiletypeMutation) ClearedFields() []string {
+func (m *ShareMutation) ClearedFields() []string {
 	var fields []string
-	if m.FieldCleared(filetype.FieldCreatedAt) {
-		fields = append(fields, filetype.FieldCreatedAt)
+	if m.FieldCleared(share.FieldUserID) {
+		fields = append(fields, share.FieldUserID)
+	}
+	if m.FieldCleared(share.FieldExpiresAt) {
+		fields = append(fields, share.FieldExpiresAt)
+	}
+	if m.FieldCleared(share.FieldMaxDownloads) {
+		fields = append(fields, share.FieldMaxDownloads)
+	}
+	if m.FieldCleared(share.FieldCreatedAt) {
+		fields = append(fields, share.FieldCreatedAt)
 	}
 	return fields
 }
 
 // FieldCleared returns a boolean indicating if a field with the given name was
 // cleared in this mutation.
-func (m *FiletypeMutation) FieldCleared(name string) bool {
+func (m *ShareMutation) FieldCleared(name string) bool {
 	_, ok := m.clearedFields[name]
 	return ok
 }
 
 // ClearField clears the value of the field with the given name. It returns an
 // error if the field is not defined in the schema.
-func (m *FiletypeMutation) ClearField(name string) error {
+func (m *ShareMutation) ClearField(name string) error {
 	switch name {
-	case filetype.FieldCreatedAt:
+	case share.FieldUserID:
+		m.ClearUserID()
+		return nil
+	case share.FieldExpiresAt:
+		m.ClearExpiresAt()
+		return nil
+	case share.FieldMaxDownloads:
+		m.ClearMaxDownloads()
+		return nil
+	case share.FieldCreatedAt:
 		m.ClearCreatedAt()
 		return nil
 	}
-	return fmt.Errorf("unknown Filetype nullable field %s", name)
+	return fmt.Errorf("unknown Share nullable field %s", name)
 }
 
 // ResetField resets all changes in the mutation for the field with the given name.
 // It returns an error if the field is not defined in the schema.
-func (m *FiletypeMutation) ResetField(name string) error {
+func (m *ShareMutation) ResetField(name string) error {
 	switch name {
-	case filetype.FieldAllowedSize:
-		m.ResetAllowedSize()
+	case share.FieldFileID:
+		m.ResetFileID()
 		return nil
-	case filetype.FieldIsBanned:
-		m.ResetIsBanned()
+	case share.FieldUserID:
+		m.ResetUserID()
 		return nil
-	case filetype.FieldCreatedAt:
-		m.ResetCreatedAt()
+	case share.FieldPermission:
+		m.ResetPermission()
 		return nil
-	case filetype.FieldUpdatedAt:
-		m.ResetUpdatedAt()
+	case share.FieldExpiresAt:
+		m.ResetExpiresAt()
+		return nil
+	case share.FieldMaxDownloads:
+		m.ResetMaxDownloads()
+		return nil
+	case share.FieldDownloads:
+		m.ResetDownloads()
+		return nil
+	case share.FieldCreatedAt:
+		m.ResetCreatedAt()
 		return nil
 	}
-	return fmt.Errorf("unknown Filetype field %s", name)
+	return fmt.Errorf("unknown Share field %s", name)
 }
 
 // AddedEdges returns all edge names that were set/added in this mutation.
-func (m *FiletypeMutation) AddedEdges() []string {
-	edges := make([]string, 0, 1)
-	if m.files != nil {
-		edges = append(edges, filetype.EdgeFiles)
+func (m *ShareMutation) AddedEdges() []string {
+	edges := make([]string, 0, 2)
+	if m.file != nil {
+		edges = append(edges, share.EdgeFile)
+	}
+	if m.user != nil {
+		edges = append(edges, share.EdgeUser)
 	}
 	return edges
 }
 
 // AddedIDs returns all IDs (to other nodes) that were added for the given edge
 // name in this mutation.
-func (m *FiletypeMutation) AddedIDs(name string) []ent.Value {
+func (m *ShareMutation) AddedIDs(name string) []ent.Value {
 	switch name {
-	case filetype.EdgeFiles:
-		ids := make([]ent.Value, 0, len(m.files))
-		for id := range m.files {
-			ids = append(ids, id)
+	case share.EdgeFile:
+		if id := m.file; id != nil {
+			return []ent.Value{*id}
+		}
+	case share.EdgeUser:
+		if id := m.user; id != nil {
+			return []ent.Value{*id}
 		}
-		return ids
 	}
 	return nil
 }
 
 // RemovedEdges returns all edge names that were removed in this mutation.
-func (m *FiletypeMutation) RemovedEdges() []string {
-	edges := make([]string, 0, 1)
-	if m.removedfiles != nil {
-		edges = append(edges, filetype.EdgeFiles)
-	}
+func (m *ShareMutation) RemovedEdges() []string {
+	edges := make([]string, 0, 2)
 	return edges
 }
 
 // RemovedIDs returns all IDs (to other nodes) that were removed for the edge with
 // the given name in this mutation.
-func (m *FiletypeMutation) RemovedIDs(name string) []ent.Value {
-	switch name {
-	case filetype.EdgeFiles:
-		ids := make([]ent.Value, 0, len(m.removedfiles))
-		for id := range m.removedfiles {
-			ids = append(ids, id)
-		}
-		return ids
-	}
+func (m *ShareMutation) RemovedIDs(name string) []ent.Value {
 	return nil
 }
 
 // ClearedEdges returns all edge names that were cleared in this mutation.
-func (m *FiletypeMutation) ClearedEdges() []string {
-	edges := make([]string, 0, 1)
-	if m.clearedfiles {
-		edges = append(edges, filetype.EdgeFiles)
+func (m *ShareMutation) ClearedEdges() []string {
+	edges := make([]string, 0, 2)
+	if m.clearedfile {
+		edges = append(edges, share.EdgeFile)
+	}
+	if m.cleareduser {
+		edges = append(edges, share.EdgeUser)
 	}
 	return edges
 }
 
 // EdgeCleared returns a boolean which indicates if the edge with the given name
 // was cleared in this mutation.
-func (m *FiletypeMutation) EdgeCleared(name string) bool {
+func (m *ShareMutation) EdgeCleared(name string) bool {
 	switch name {
-	case filetype.EdgeFiles:
-		return m.clearedfiles
+	case share.EdgeFile:
+		return m.clearedfile
+	case share.EdgeUser:
+		return m.cleareduser
 	}
 	return false
 }
 
 // ClearEdge clears the value of the edge with the given name. It returns an error
 // if that edge is not defined in the schema.
-func (m *FiletypeMutation) ClearEdge(name string) error {
+func (m *ShareMutation) ClearEdge(name string) error {
 	switch name {
+	case share.EdgeFile:
+		m.ClearFile()
+		return nil
+	case share.EdgeUser:
+		m.ClearUser()
+		return nil
 	}
-	return fmt.Errorf("unknown Filetype unique edge %s", name)
+	return fmt.Errorf("unknown Share unique edge %s", name)
 }
 
 // ResetEdge resets all changes to the edge with the given name in this mutation.
 // It returns an error if the edge is not defined in the schema.
-func (m *FiletypeMutation) ResetEdge(name string) error {
+func (m *ShareMutation) ResetEdge(name string) error {
 	switch name {
-	case filetype.EdgeFiles:
-		m.ResetFiles()
+	case share.EdgeFile:
+		m.ResetFile()
+		return nil
+	case share.EdgeUser:
+		m.ResetUser()
 		return nil
 	}
-	return fmt.Errorf("unknown Filetype edge %s", name)
+	return fmt.Errorf("unknown Share edge %s", name)
 }
 
 // TagMutation represents an operation that mutates the Tag nodes in the graph.
@@ -2161,24 +6853,30 @@ func (m *TagMutation) ResetEdge(name string) error {
 // UserMutation represents an operation that mutates the User nodes in the graph.
 type UserMutation struct {
 	config
-	op            Op
-	typ           string
-	id            *int
-	first_name    *string
-	last_name     *string
-	email         *string
-	password      *string
-	access_type   *user.AccessType
-	created_at    *time.Time
-	updated_at    *time.Time
-	last_login_at *time.Time
-	clearedFields map[string]struct{}
-	files         map[int]struct{}
-	removedfiles  map[int]struct{}
-	clearedfiles  bool
-	done          bool
-	oldValue      func(context.Context) (*User, error)
-	predicates    []predicate.User
+	op                 Op
+	typ                string
+	id                 *int
+	first_name         *string
+	last_name          *string
+	email              *string
+	password           *string
+	access_type        *user.AccessType
+	created_at         *time.Time
+	updated_at         *time.Time
+	last_login_at      *time.Time
+	clearedFields      map[string]struct{}
+	files              map[int]struct{}
+	removedfiles       map[int]struct{}
+	clearedfiles       bool
+	search_jobs        map[int]struct{}
+	removedsearch_jobs map[int]struct{}
+	clearedsearch_jobs bool
+	shares             map[int]struct{}
+	removedshares      map[int]struct{}
+	clearedshares      bool
+	done               bool
+	oldValue           func(context.Context) (*User, error)
+	predicates         []predicate.User
 }
 
 var _ ent.Mutation = (*UserMutation)(nil)
@@ -2660,6 +7358,114 @@ func (m *UserMutation) ResetFiles() {
 	m.removedfiles = nil
 }
 
+// AddSearchJobIDs adds the "search_jobs" edge to the SearchJob entity by ids.
+func (m *UserMutation) AddSearchJobIDs(ids ...int) {
+	if m.search_jobs == nil {
+		m.search_jobs = make(map[int]struct{})
+	}
+	for i := range ids {
+		m.search_jobs[ids[i]] = struct{}{}
+	}
+}
+
+// ClearSearchJobs clears the "search_jobs" edge to the SearchJob entity.
+func (m *UserMutation) ClearSearchJobs() {
+	m.clearedsearch_jobs = true
+}
+
+// SearchJobsCleared reports if the "search_jobs" edge to the SearchJob entity was cleared.
+func (m *UserMutation) SearchJobsCleared() bool {
+	return m.clearedsearch_jobs
+}
+
+// RemoveSearchJobIDs removes the "search_jobs" edge to the SearchJob entity by IDs.
+func (m *UserMutation) RemoveSearchJobIDs(ids ...int) {
+	if m.removedsearch_jobs == nil {
+		m.removedsearch_jobs = make(map[int]struct{})
+	}
+	for i := range ids {
+		delete(m.search_jobs, ids[i])
+		m.removedsearch_jobs[ids[i]] = struct{}{}
+	}
+}
+
+// RemovedSearchJobs returns the removed IDs of the "search_jobs" edge to the SearchJob entity.
+func (m *UserMutation) RemovedSearchJobsIDs() (ids []int) {
+	for id := range m.removedsearch_jobs {
+		ids = append(ids, id)
+	}
+	return
+}
+
+// SearchJobsIDs returns the "search_jobs" edge IDs in the mutation.
+func (m *UserMutation) SearchJobsIDs() (ids []int) {
+	for id := range m.search_jobs {
+		ids = append(ids, id)
+	}
+	return
+}
+
+// ResetSearchJobs resets all changes to the "search_jobs" edge.
+func (m *UserMutation) ResetSearchJobs() {
+	m.search_jobs = nil
+	m.clearedsearch_jobs = false
+	m.removedsearch_jobs = nil
+}
+
+// AddShareIDs adds the "shares" edge to the Share entity by ids.
+func (m *UserMutation) AddShareIDs(ids ...int) {
+	if m.shares == nil {
+		m.shares = make(map[int]struct{})
+	}
+	for i := range ids {
+		m.shares[ids[i]] = struct{}{}
+	}
+}
+
+// ClearShares clears the "shares" edge to the Share entity.
+func (m *UserMutation) ClearShares() {
+	m.clearedshares = true
+}
+
+// SharesCleared reports if the "shares" edge to the Share entity was cleared.
+func (m *UserMutation) SharesCleared() bool {
+	return m.clearedshares
+}
+
+// RemoveShareIDs removes the "shares" edge to the Share entity by IDs.
+func (m *UserMutation) RemoveShareIDs(ids ...int) {
+	if m.removedshares == nil {
+		m.removedshares = make(map[int]struct{})
+	}
+	for i := range ids {
+		delete(m.shares, ids[i])
+		m.removedshares[ids[i]] = struct{}{}
+	}
+}
+
+// RemovedShares returns the removed IDs of the "shares" edge to the Share entity.
+func (m *UserMutation) RemovedSharesIDs() (ids []int) {
+	for id := range m.removedshares {
+		ids = append(ids, id)
+	}
+	return
+}
+
+// SharesIDs returns the "shares" edge IDs in the mutation.
+func (m *UserMutation) SharesIDs() (ids []int) {
+	for id := range m.shares {
+		ids = append(ids, id)
+	}
+	return
+}
+
+// ResetShares resets all changes to the "shares" edge.
+func (m *UserMutation) ResetShares() {
+	m.shares = nil
+	m.clearedshares = false
+	m.removedshares = nil
+}
+
 // Where appends a list predicates to the UserMutation builder.
 func (m *UserMutation) Where(ps ...predicate.User) {
 	m.predicates = append(m.predicates, ps...)
@@ -2933,10 +7739,16 @@ func (m *UserMutation) ResetField(name string) error {
 
 // AddedEdges returns all edge names that were set/added in this mutation.
 func (m *UserMutation) AddedEdges() []string {
-	edges := make([]string, 0, 1)
+	edges := make([]string, 0, 3)
 	if m.files != nil {
 		edges = append(edges, user.EdgeFiles)
 	}
+	if m.search_jobs != nil {
+		edges = append(edges, user.EdgeSearchJobs)
+	}
+	if m.shares != nil {
+		edges = append(edges, user.EdgeShares)
+	}
 	return edges
 }
 
@@ -2950,16 +7762,34 @@ func (m *UserMutation) AddedIDs(name string) []ent.Value {
 			ids = append(ids, id)
 		}
 		return ids
+	case user.EdgeSearchJobs:
+		ids := make([]ent.Value, 0, len(m.search_jobs))
+		for id := range m.search_jobs {
+			ids = append(ids, id)
+		}
+		return ids
+	case user.EdgeShares:
+		ids := make([]ent.Value, 0, len(m.shares))
+		for id := range m.shares {
+			ids = append(ids, id)
+		}
+		return ids
 	}
 	return nil
 }
 
 // RemovedEdges returns all edge names that were removed in this mutation.
 func (m *UserMutation) RemovedEdges() []string {
-	edges := make([]string, 0, 1)
+	edges := make([]string, 0, 3)
 	if m.removedfiles != nil {
 		edges = append(edges, user.EdgeFiles)
 	}
+	if m.removedsearch_jobs != nil {
+		edges = append(edges, user.EdgeSearchJobs)
+	}
+	if m.removedshares != nil {
+		edges = append(edges, user.EdgeShares)
+	}
 	return edges
 }
 
@@ -2973,16 +7803,34 @@ func (m *UserMutation) RemovedIDs(name string) []ent.Value {
 			ids = append(ids, id)
 		}
 		return ids
+	case user.EdgeSearchJobs:
+		ids := make([]ent.Value, 0, len(m.removedsearch_jobs))
+		for id := range m.removedsearch_jobs {
+			ids = append(ids, id)
+		}
+		return ids
+	case user.EdgeShares:
+		ids := make([]ent.Value, 0, len(m.removedshares))
+		for id := range m.removedshares {
+			ids = append(ids, id)
+		}
+		return ids
 	}
 	return nil
 }
 
 // ClearedEdges returns all edge names that were cleared in this mutation.
 func (m *UserMutation) ClearedEdges() []string {
-	edges := make([]string, 0, 1)
+	edges := make([]string, 0, 3)
 	if m.clearedfiles {
 		edges = append(edges, user.EdgeFiles)
 	}
+	if m.clearedsearch_jobs {
+		edges = append(edges, user.EdgeSearchJobs)
+	}
+	if m.clearedshares {
+		edges = append(edges, user.EdgeShares)
+	}
 	return edges
 }
 
@@ -2992,6 +7840,10 @@ func (m *UserMutation) EdgeCleared(name string) bool {
 	switch name {
 	case user.EdgeFiles:
 		return m.clearedfiles
+	case user.EdgeSearchJobs:
+		return m.clearedsearch_jobs
+	case user.EdgeShares:
+		return m.clearedshares
 	}
 	return false
 }
@@ -3011,6 +7863,12 @@ func (m *UserMutation) ResetEdge(name string) error {
 	case user.EdgeFiles:
 		m.ResetFiles()
 		return nil
+	case user.EdgeSearchJobs:
+		m.ResetSearchJobs()
+		return nil
+	case user.EdgeShares:
+		m.ResetShares()
+		return nil
 	}
 	return fmt.Errorf("unknown User edge %s", name)
 }
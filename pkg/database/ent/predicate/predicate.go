@@ -12,6 +12,15 @@ type File func(*sql.Selector)
 // Filetype is the predicate function for filetype builders.
 type Filetype func(*sql.Selector)
 
+// KeyRotation is the predicate function for keyrotation builders.
+type KeyRotation func(*sql.Selector)
+
+// SearchJob is the predicate function for searchjob builders.
+type SearchJob func(*sql.Selector)
+
+// Share is the predicate function for share builders.
+type Share func(*sql.Selector)
+
 // Tag is the predicate function for tag builders.
 type Tag func(*sql.Selector)
 
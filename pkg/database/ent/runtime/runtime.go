@@ -2,7 +2,387 @@
 
 package runtime
 
-// The schema-stitching logic is generated in github.com/lebleuciel/maani/pkg/database/ent/runtime.go
+import (
+	"time"
+
+	"github.com/lebleuciel/maani/pkg/database/ent/file"
+	"github.com/lebleuciel/maani/pkg/database/ent/filetype"
+	"github.com/lebleuciel/maani/pkg/database/ent/keyrotation"
+	"github.com/lebleuciel/maani/pkg/database/ent/schema"
+	"github.com/lebleuciel/maani/pkg/database/ent/searchjob"
+	"github.com/lebleuciel/maani/pkg/database/ent/share"
+	"github.com/lebleuciel/maani/pkg/database/ent/tag"
+	"github.com/lebleuciel/maani/pkg/database/ent/user"
+)
+
+// The init function reads all schema descriptors with runtime code
+// (default values, validators, hooks and policies) and stitches it
+// to their package variables.
+func init() {
+	fileInters := schema.File{}.Interceptors()
+	file.Interceptors[0] = fileInters[0]
+	fileFields := schema.File{}.Fields()
+	_ = fileFields
+	// fileDescName is the schema descriptor for name field.
+	fileDescName := fileFields[0].Descriptor()
+	// file.NameValidator is a validator for the "name" field. It is called by the builders before save.
+	file.NameValidator = func() func(string) error {
+		validators := fileDescName.Validators
+		fns := [...]func(string) error{
+			validators[0].(func(string) error),
+			validators[1].(func(string) error),
+			validators[2].(func(string) error),
+		}
+		return func(name string) error {
+			for _, fn := range fns {
+				if err := fn(name); err != nil {
+					return err
+				}
+			}
+			return nil
+		}
+	}()
+	// fileDescUUID is the schema descriptor for uuid field.
+	fileDescUUID := fileFields[2].Descriptor()
+	// file.UUIDValidator is a validator for the "uuid" field. It is called by the builders before save.
+	file.UUIDValidator = func() func(string) error {
+		validators := fileDescUUID.Validators
+		fns := [...]func(string) error{
+			validators[0].(func(string) error),
+			validators[1].(func(string) error),
+			validators[2].(func(string) error),
+		}
+		return func(uuid string) error {
+			for _, fn := range fns {
+				if err := fn(uuid); err != nil {
+					return err
+				}
+			}
+			return nil
+		}
+	}()
+	// fileDescType is the schema descriptor for type field.
+	fileDescType := fileFields[4].Descriptor()
+	// file.TypeValidator is a validator for the "type" field. It is called by the builders before save.
+	file.TypeValidator = func() func(string) error {
+		validators := fileDescType.Validators
+		fns := [...]func(string) error{
+			validators[0].(func(string) error),
+			validators[1].(func(string) error),
+			validators[2].(func(string) error),
+		}
+		return func(filetype string) error {
+			for _, fn := range fns {
+				if err := fn(filetype); err != nil {
+					return err
+				}
+			}
+			return nil
+		}
+	}()
+	// fileDescBurnAfterRead is the schema descriptor for burn_after_read field.
+	fileDescBurnAfterRead := fileFields[5].Descriptor()
+	// file.DefaultBurnAfterRead holds the default value on creation for the burn_after_read field.
+	file.DefaultBurnAfterRead = fileDescBurnAfterRead.Default.(bool)
+	// fileDescKeyID is the schema descriptor for key_id field.
+	fileDescKeyID := fileFields[7].Descriptor()
+	// file.DefaultKeyID holds the default value on creation for the key_id field.
+	file.DefaultKeyID = fileDescKeyID.Default.(string)
+	// file.KeyIDValidator is a validator for the "key_id" field. It is called by the builders before save.
+	file.KeyIDValidator = fileDescKeyID.Validators[0].(func(string) error)
+	// fileDescSha256 is the schema descriptor for sha256 field.
+	fileDescSha256 := fileFields[9].Descriptor()
+	// file.DefaultSha256 holds the default value on creation for the sha256 field.
+	file.DefaultSha256 = fileDescSha256.Default.(string)
+	// file.Sha256Validator is a validator for the "sha256" field. It is called by the builders before save.
+	file.Sha256Validator = fileDescSha256.Validators[0].(func(string) error)
+	// fileDescWidth is the schema descriptor for width field.
+	fileDescWidth := fileFields[10].Descriptor()
+	// file.DefaultWidth holds the default value on creation for the width field.
+	file.DefaultWidth = fileDescWidth.Default.(int)
+	// fileDescHeight is the schema descriptor for height field.
+	fileDescHeight := fileFields[11].Descriptor()
+	// file.DefaultHeight holds the default value on creation for the height field.
+	file.DefaultHeight = fileDescHeight.Default.(int)
+	// fileDescDominantColor is the schema descriptor for dominant_color field.
+	fileDescDominantColor := fileFields[12].Descriptor()
+	// file.DefaultDominantColor holds the default value on creation for the dominant_color field.
+	file.DefaultDominantColor = fileDescDominantColor.Default.(string)
+	// file.DominantColorValidator is a validator for the "dominant_color" field. It is called by the builders before save.
+	file.DominantColorValidator = fileDescDominantColor.Validators[0].(func(string) error)
+	// fileDescSourceURL is the schema descriptor for source_url field.
+	fileDescSourceURL := fileFields[13].Descriptor()
+	// file.DefaultSourceURL holds the default value on creation for the source_url field.
+	file.DefaultSourceURL = fileDescSourceURL.Default.(string)
+	// file.SourceURLValidator is a validator for the "source_url" field. It is called by the builders before save.
+	file.SourceURLValidator = fileDescSourceURL.Validators[0].(func(string) error)
+	// fileDescSearchQuery is the schema descriptor for search_query field.
+	fileDescSearchQuery := fileFields[14].Descriptor()
+	// file.DefaultSearchQuery holds the default value on creation for the search_query field.
+	file.DefaultSearchQuery = fileDescSearchQuery.Default.(string)
+	// file.SearchQueryValidator is a validator for the "search_query" field. It is called by the builders before save.
+	file.SearchQueryValidator = fileDescSearchQuery.Validators[0].(func(string) error)
+	// fileDescTagNames is the schema descriptor for tag_names field.
+	fileDescTagNames := fileFields[15].Descriptor()
+	// file.DefaultTagNames holds the default value on creation for the tag_names field.
+	file.DefaultTagNames = fileDescTagNames.Default.(string)
+	// fileDescCameraMake is the schema descriptor for camera_make field.
+	fileDescCameraMake := fileFields[16].Descriptor()
+	// file.DefaultCameraMake holds the default value on creation for the camera_make field.
+	file.DefaultCameraMake = fileDescCameraMake.Default.(string)
+	// file.CameraMakeValidator is a validator for the "camera_make" field. It is called by the builders before save.
+	file.CameraMakeValidator = fileDescCameraMake.Validators[0].(func(string) error)
+	// fileDescCameraModel is the schema descriptor for camera_model field.
+	fileDescCameraModel := fileFields[17].Descriptor()
+	// file.DefaultCameraModel holds the default value on creation for the camera_model field.
+	file.DefaultCameraModel = fileDescCameraModel.Default.(string)
+	// file.CameraModelValidator is a validator for the "camera_model" field. It is called by the builders before save.
+	file.CameraModelValidator = fileDescCameraModel.Validators[0].(func(string) error)
+	// fileDescCreatedAt is the schema descriptor for created_at field.
+	fileDescCreatedAt := fileFields[19].Descriptor()
+	// file.DefaultCreatedAt holds the default value on creation for the created_at field.
+	file.DefaultCreatedAt = fileDescCreatedAt.Default.(func() time.Time)
+	// fileDescUpdatedAt is the schema descriptor for updated_at field.
+	fileDescUpdatedAt := fileFields[20].Descriptor()
+	// file.DefaultUpdatedAt holds the default value on creation for the updated_at field.
+	file.DefaultUpdatedAt = fileDescUpdatedAt.Default.(func() time.Time)
+	// file.UpdateDefaultUpdatedAt holds the default value on update for the updated_at field.
+	file.UpdateDefaultUpdatedAt = fileDescUpdatedAt.UpdateDefault.(func() time.Time)
+	filetypeFields := schema.Filetype{}.Fields()
+	_ = filetypeFields
+	// filetypeDescAllowedSize is the schema descriptor for allowed_size field.
+	filetypeDescAllowedSize := filetypeFields[1].Descriptor()
+	// filetype.DefaultAllowedSize holds the default value on creation for the allowed_size field.
+	filetype.DefaultAllowedSize = filetypeDescAllowedSize.Default.(int)
+	// filetypeDescIsBanned is the schema descriptor for is_banned field.
+	filetypeDescIsBanned := filetypeFields[2].Descriptor()
+	// filetype.DefaultIsBanned holds the default value on creation for the is_banned field.
+	filetype.DefaultIsBanned = filetypeDescIsBanned.Default.(bool)
+	// filetypeDescCreatedAt is the schema descriptor for created_at field.
+	filetypeDescCreatedAt := filetypeFields[3].Descriptor()
+	// filetype.DefaultCreatedAt holds the default value on creation for the created_at field.
+	filetype.DefaultCreatedAt = filetypeDescCreatedAt.Default.(func() time.Time)
+	// filetypeDescUpdatedAt is the schema descriptor for updated_at field.
+	filetypeDescUpdatedAt := filetypeFields[4].Descriptor()
+	// filetype.DefaultUpdatedAt holds the default value on creation for the updated_at field.
+	filetype.DefaultUpdatedAt = filetypeDescUpdatedAt.Default.(func() time.Time)
+	// filetype.UpdateDefaultUpdatedAt holds the default value on update for the updated_at field.
+	filetype.UpdateDefaultUpdatedAt = filetypeDescUpdatedAt.UpdateDefault.(func() time.Time)
+	// filetypeDescID is the schema descriptor for id field.
+	filetypeDescID := filetypeFields[0].Descriptor()
+	// filetype.IDValidator is a validator for the "id" field. It is called by the builders before save.
+	filetype.IDValidator = func() func(string) error {
+		validators := filetypeDescID.Validators
+		fns := [...]func(string) error{
+			validators[0].(func(string) error),
+			validators[1].(func(string) error),
+			validators[2].(func(string) error),
+		}
+		return func(id string) error {
+			for _, fn := range fns {
+				if err := fn(id); err != nil {
+					return err
+				}
+			}
+			return nil
+		}
+	}()
+	keyrotationFields := schema.KeyRotation{}.Fields()
+	_ = keyrotationFields
+	// keyrotationDescKeyID is the schema descriptor for key_id field.
+	keyrotationDescKeyID := keyrotationFields[0].Descriptor()
+	// keyrotation.KeyIDValidator is a validator for the "key_id" field. It is called by the builders before save.
+	keyrotation.KeyIDValidator = func() func(string) error {
+		validators := keyrotationDescKeyID.Validators
+		fns := [...]func(string) error{
+			validators[0].(func(string) error),
+			validators[1].(func(string) error),
+		}
+		return func(key_id string) error {
+			for _, fn := range fns {
+				if err := fn(key_id); err != nil {
+					return err
+				}
+			}
+			return nil
+		}
+	}()
+	// keyrotationDescTotal is the schema descriptor for total field.
+	keyrotationDescTotal := keyrotationFields[2].Descriptor()
+	// keyrotation.DefaultTotal holds the default value on creation for the total field.
+	keyrotation.DefaultTotal = keyrotationDescTotal.Default.(int)
+	// keyrotationDescDone is the schema descriptor for done field.
+	keyrotationDescDone := keyrotationFields[3].Descriptor()
+	// keyrotation.DefaultDone holds the default value on creation for the done field.
+	keyrotation.DefaultDone = keyrotationDescDone.Default.(int)
+	// keyrotationDescFailed is the schema descriptor for failed field.
+	keyrotationDescFailed := keyrotationFields[4].Descriptor()
+	// keyrotation.DefaultFailed holds the default value on creation for the failed field.
+	keyrotation.DefaultFailed = keyrotationDescFailed.Default.(int)
+	// keyrotationDescCreatedAt is the schema descriptor for created_at field.
+	keyrotationDescCreatedAt := keyrotationFields[6].Descriptor()
+	// keyrotation.DefaultCreatedAt holds the default value on creation for the created_at field.
+	keyrotation.DefaultCreatedAt = keyrotationDescCreatedAt.Default.(func() time.Time)
+	// keyrotationDescUpdatedAt is the schema descriptor for updated_at field.
+	keyrotationDescUpdatedAt := keyrotationFields[7].Descriptor()
+	// keyrotation.DefaultUpdatedAt holds the default value on creation for the updated_at field.
+	keyrotation.DefaultUpdatedAt = keyrotationDescUpdatedAt.Default.(func() time.Time)
+	// keyrotation.UpdateDefaultUpdatedAt holds the default value on update for the updated_at field.
+	keyrotation.UpdateDefaultUpdatedAt = keyrotationDescUpdatedAt.UpdateDefault.(func() time.Time)
+	searchjobFields := schema.SearchJob{}.Fields()
+	_ = searchjobFields
+	// searchjobDescQuery is the schema descriptor for query field.
+	searchjobDescQuery := searchjobFields[0].Descriptor()
+	// searchjob.QueryValidator is a validator for the "query" field. It is called by the builders before save.
+	searchjob.QueryValidator = func() func(string) error {
+		validators := searchjobDescQuery.Validators
+		fns := [...]func(string) error{
+			validators[0].(func(string) error),
+			validators[1].(func(string) error),
+		}
+		return func(query string) error {
+			for _, fn := range fns {
+				if err := fn(query); err != nil {
+					return err
+				}
+			}
+			return nil
+		}
+	}()
+	// searchjobDescMaxResults is the schema descriptor for max_results field.
+	searchjobDescMaxResults := searchjobFields[1].Descriptor()
+	// searchjob.MaxResultsValidator is a validator for the "max_results" field. It is called by the builders before save.
+	searchjob.MaxResultsValidator = searchjobDescMaxResults.Validators[0].(func(int) error)
+	// searchjobDescSaved is the schema descriptor for saved field.
+	searchjobDescSaved := searchjobFields[4].Descriptor()
+	// searchjob.DefaultSaved holds the default value on creation for the saved field.
+	searchjob.DefaultSaved = searchjobDescSaved.Default.(int)
+	// searchjobDescFailed is the schema descriptor for failed field.
+	searchjobDescFailed := searchjobFields[5].Descriptor()
+	// searchjob.DefaultFailed holds the default value on creation for the failed field.
+	searchjob.DefaultFailed = searchjobDescFailed.Default.(int)
+	// searchjobDescDeduplicated is the schema descriptor for deduplicated field.
+	searchjobDescDeduplicated := searchjobFields[7].Descriptor()
+	// searchjob.DefaultDeduplicated holds the default value on creation for the deduplicated field.
+	searchjob.DefaultDeduplicated = searchjobDescDeduplicated.Default.(int)
+	// searchjobDescNextCandidate is the schema descriptor for next_candidate field.
+	searchjobDescNextCandidate := searchjobFields[12].Descriptor()
+	// searchjob.DefaultNextCandidate holds the default value on creation for the next_candidate field.
+	searchjob.DefaultNextCandidate = searchjobDescNextCandidate.Default.(int)
+	// searchjobDescCreatedAt is the schema descriptor for created_at field.
+	searchjobDescCreatedAt := searchjobFields[16].Descriptor()
+	// searchjob.DefaultCreatedAt holds the default value on creation for the created_at field.
+	searchjob.DefaultCreatedAt = searchjobDescCreatedAt.Default.(func() time.Time)
+	// searchjobDescUpdatedAt is the schema descriptor for updated_at field.
+	searchjobDescUpdatedAt := searchjobFields[17].Descriptor()
+	// searchjob.DefaultUpdatedAt holds the default value on creation for the updated_at field.
+	searchjob.DefaultUpdatedAt = searchjobDescUpdatedAt.Default.(func() time.Time)
+	// searchjob.UpdateDefaultUpdatedAt holds the default value on update for the updated_at field.
+	searchjob.UpdateDefaultUpdatedAt = searchjobDescUpdatedAt.UpdateDefault.(func() time.Time)
+	shareFields := schema.Share{}.Fields()
+	_ = shareFields
+	// shareDescMaxDownloads is the schema descriptor for max_downloads field.
+	shareDescMaxDownloads := shareFields[4].Descriptor()
+	// share.MaxDownloadsValidator is a validator for the "max_downloads" field. It is called by the builders before save.
+	share.MaxDownloadsValidator = shareDescMaxDownloads.Validators[0].(func(int) error)
+	// shareDescDownloads is the schema descriptor for downloads field.
+	shareDescDownloads := shareFields[5].Descriptor()
+	// share.DefaultDownloads holds the default value on creation for the downloads field.
+	share.DefaultDownloads = shareDescDownloads.Default.(int)
+	// shareDescCreatedAt is the schema descriptor for created_at field.
+	shareDescCreatedAt := shareFields[6].Descriptor()
+	// share.DefaultCreatedAt holds the default value on creation for the created_at field.
+	share.DefaultCreatedAt = shareDescCreatedAt.Default.(func() time.Time)
+	tagFields := schema.Tag{}.Fields()
+	_ = tagFields
+	// tagDescCreatedAt is the schema descriptor for created_at field.
+	tagDescCreatedAt := tagFields[1].Descriptor()
+	// tag.DefaultCreatedAt holds the default value on creation for the created_at field.
+	tag.DefaultCreatedAt = tagDescCreatedAt.Default.(func() time.Time)
+	// tagDescUpdatedAt is the schema descriptor for updated_at field.
+	tagDescUpdatedAt := tagFields[2].Descriptor()
+	// tag.DefaultUpdatedAt holds the default value on creation for the updated_at field.
+	tag.DefaultUpdatedAt = tagDescUpdatedAt.Default.(func() time.Time)
+	// tag.UpdateDefaultUpdatedAt holds the default value on update for the updated_at field.
+	tag.UpdateDefaultUpdatedAt = tagDescUpdatedAt.UpdateDefault.(func() time.Time)
+	// tagDescID is the schema descriptor for id field.
+	tagDescID := tagFields[0].Descriptor()
+	// tag.IDValidator is a validator for the "id" field. It is called by the builders before save.
+	tag.IDValidator = func() func(string) error {
+		validators := tagDescID.Validators
+		fns := [...]func(string) error{
+			validators[0].(func(string) error),
+			validators[1].(func(string) error),
+			validators[2].(func(string) error),
+		}
+		return func(id string) error {
+			for _, fn := range fns {
+				if err := fn(id); err != nil {
+					return err
+				}
+			}
+			return nil
+		}
+	}()
+	userFields := schema.User{}.Fields()
+	_ = userFields
+	// userDescFirstName is the schema descriptor for first_name field.
+	userDescFirstName := userFields[0].Descriptor()
+	// user.FirstNameValidator is a validator for the "first_name" field. It is called by the builders before save.
+	user.FirstNameValidator = func() func(string) error {
+		validators := userDescFirstName.Validators
+		fns := [...]func(string) error{
+			validators[0].(func(string) error),
+			validators[1].(func(string) error),
+			validators[2].(func(string) error),
+		}
+		return func(first_name string) error {
+			for _, fn := range fns {
+				if err := fn(first_name); err != nil {
+					return err
+				}
+			}
+			return nil
+		}
+	}()
+	// userDescLastName is the schema descriptor for last_name field.
+	userDescLastName := userFields[1].Descriptor()
+	// user.LastNameValidator is a validator for the "last_name" field. It is called by the builders before save.
+	user.LastNameValidator = func() func(string) error {
+		validators := userDescLastName.Validators
+		fns := [...]func(string) error{
+			validators[0].(func(string) error),
+			validators[1].(func(string) error),
+			validators[2].(func(string) error),
+		}
+		return func(last_name string) error {
+			for _, fn := range fns {
+				if err := fn(last_name); err != nil {
+					return err
+				}
+			}
+			return nil
+		}
+	}()
+	// userDescEmail is the schema descriptor for email field.
+	userDescEmail := userFields[2].Descriptor()
+	// user.EmailValidator is a validator for the "email" field. It is called by the builders before save.
+	user.EmailValidator = userDescEmail.Validators[0].(func(string) error)
+	// userDescPassword is the schema descriptor for password field.
+	userDescPassword := userFields[3].Descriptor()
+	// user.PasswordValidator is a validator for the "password" field. It is called by the builders before save.
+	user.PasswordValidator = userDescPassword.Validators[0].(func(string) error)
+	// userDescCreatedAt is the schema descriptor for created_at field.
+	userDescCreatedAt := userFields[5].Descriptor()
+	// user.DefaultCreatedAt holds the default value on creation for the created_at field.
+	user.DefaultCreatedAt = userDescCreatedAt.Default.(func() time.Time)
+	// userDescUpdatedAt is the schema descriptor for updated_at field.
+	userDescUpdatedAt := userFields[6].Descriptor()
+	// user.DefaultUpdatedAt holds the default value on creation for the updated_at field.
+	user.DefaultUpdatedAt = userDescUpdatedAt.Default.(func() time.Time)
+	// user.UpdateDefaultUpdatedAt holds the default value on update for the updated_at field.
+	user.UpdateDefaultUpdatedAt = userDescUpdatedAt.UpdateDefault.(func() time.Time)
+}
 
 const (
 	Version = "v0.12.5"                                         // Version of ent codegen.
@@ -1,11 +1,17 @@
 package schema
 
 import (
+	"context"
 	"time"
 
 	"entgo.io/ent"
+	"entgo.io/ent/dialect/entsql"
 	"entgo.io/ent/schema/edge"
 	"entgo.io/ent/schema/field"
+	"entgo.io/ent/schema/index"
+	gen "github.com/lebleuciel/maani/pkg/database/ent"
+	"github.com/lebleuciel/maani/pkg/database/ent/file"
+	"github.com/lebleuciel/maani/pkg/database/ent/intercept"
 )
 
 // File holds the schema definition for the File entity.
@@ -31,6 +37,58 @@ func (File) Fields() []ent.Field {
 			NotEmpty().
 			MinLen(1).
 			MaxLen(512),
+		field.Bool("burn_after_read").
+			Default(false).
+			Comment("Delete file after it has been downloaded once"),
+		field.Int64("phash").
+			Optional().
+			Nillable().
+			Comment("Perceptual difference hash of image content, stored as signed bits of an uint64"),
+		field.String("key_id").
+			Default("").
+			MaxLen(255).
+			Comment("Id of key-encryption key which wrapped data key, or key content is encrypted with when there is no data key"),
+		field.Bytes("wrapped_key").
+			Optional().
+			Sensitive().
+			Comment("Data key of content sealed by key-encryption key, null for files stored before envelope encryption"),
+		field.String("sha256").
+			Default("").
+			MaxLen(64).
+			Comment("Hex SHA-256 of plaintext content, also the address of its blob. Empty for files stored before content addressing"),
+		field.Int("width").
+			Default(0).
+			Comment("Width of images as displayed, after exif orientation is applied. Zero for other files"),
+		field.Int("height").
+			Default(0).
+			Comment("Height of images as displayed, after exif orientation is applied. Zero for other files"),
+		field.String("dominant_color").
+			Default("").
+			MaxLen(7).
+			Comment("Most common color of images as #rrggbb, empty for other files"),
+		field.String("source_url").
+			Default("").
+			MaxLen(2048).
+			Comment("Url files saved from search results were downloaded from, empty for uploaded files"),
+		field.String("search_query").
+			Default("").
+			MaxLen(512).
+			Comment("Query of the search files saved from search results were found by, empty for uploaded files"),
+		field.Text("tag_names").
+			Default("").
+			Comment("Tags of file joined by spaces, full-text search reads them from files along with name and search query"),
+		field.String("camera_make").
+			Default("").
+			MaxLen(255).
+			Comment("Manufacturer of camera which took the photo, from exif metadata"),
+		field.String("camera_model").
+			Default("").
+			MaxLen(255).
+			Comment("Model of camera which took the photo, from exif metadata"),
+		field.Time("taken_at").
+			Optional().
+			Nillable().
+			Comment("When photo was taken, from exif metadata"),
 		field.Time("created_at").
 			Default(time.Now).
 			Optional().
@@ -39,12 +97,42 @@ func (File) Fields() []ent.Field {
 			Default(time.Now).
 			UpdateDefault(time.Now),
 		field.Time("deleted_at").
-			Default(time.Now).
 			Optional().
-			Nillable(),
+			Nillable().
+			Comment("When file was moved to trash, it is purged after retention period of trash"),
+	}
+}
+
+// Indexes of the File.
+func (File) Indexes() []ent.Index {
+	return []ent.Index{
+		// Files with the same content share a blob
+		index.Fields("sha256"),
+		// Trashed files are purged by time they were trashed
+		index.Fields("deleted_at"),
+	}
+}
+
+// Interceptors of the File.
+func (File) Interceptors() []ent.Interceptor {
+	return []ent.Interceptor{
+		// Trashed files are left out of every query, unless context asks for them
+		intercept.TraverseFile(func(ctx context.Context, q *gen.FileQuery) error {
+			if withTrashed, _ := ctx.Value(withTrashedKey{}).(bool); !withTrashed {
+				q.Where(file.DeletedAtIsNil())
+			}
+			return nil
+		}),
 	}
 }
 
+type withTrashedKey struct{}
+
+// WithTrashed returns a context queries of files read with include trashed files
+func WithTrashed(ctx context.Context) context.Context {
+	return context.WithValue(ctx, withTrashedKey{}, true)
+}
+
 // Edges of the File.
 func (File) Edges() []ent.Edge {
 	return []ent.Edge{
@@ -53,14 +141,17 @@ func (File) Edges() []ent.Edge {
 			Ref("files").
 			Unique().
 			Required().
-			StructTag(`json:"files"`),
+			StructTag(`json:"user"`),
 		edge.From("filetype", Filetype.Type).
 			Field("type").
 			Ref("files").
 			Unique().
 			Required().
-			StructTag(`json:"files"`),
+			StructTag(`json:"filetype"`),
 
 		edge.To("tags", Tag.Type),
+		// Shares of a file are removed with it
+		edge.To("shares", Share.Type).
+			Annotations(entsql.OnDelete(entsql.Cascade)),
 	}
 }
@@ -0,0 +1,49 @@
+package schema
+
+import (
+	"time"
+
+	"entgo.io/ent"
+	"entgo.io/ent/schema/field"
+	"github.com/lebleuciel/maani/models"
+)
+
+// KeyRotation holds the schema definition for the KeyRotation entity.
+type KeyRotation struct {
+	ent.Schema
+}
+
+// Fields of the KeyRotation.
+func (KeyRotation) Fields() []ent.Field {
+	return []ent.Field{
+		field.String("key_id").
+			NotEmpty().
+			MaxLen(255),
+		field.Enum("status").
+			Values(models.KeyRotationRunning, models.KeyRotationCompleted, models.KeyRotationFailed).
+			Default(models.KeyRotationRunning),
+		field.Int("total").
+			Default(0),
+		field.Int("done").
+			Default(0),
+		field.Int("failed").
+			Default(0),
+		field.String("error").
+			Optional(),
+		field.Time("created_at").
+			Default(time.Now).
+			Optional().
+			Nillable(),
+		field.Time("updated_at").
+			Default(time.Now).
+			UpdateDefault(time.Now),
+		field.Time("finished_at").
+			Optional().
+			Nillable(),
+	}
+}
+
+// Edges of the KeyRotation.
+func (KeyRotation) Edges() []ent.Edge {
+	return nil
+}
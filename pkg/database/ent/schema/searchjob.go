@@ -0,0 +1,85 @@
+package schema
+
+import (
+	"time"
+
+	"entgo.io/ent"
+	"entgo.io/ent/schema/edge"
+	"entgo.io/ent/schema/field"
+	"github.com/lebleuciel/maani/models"
+)
+
+// SearchJob holds the schema definition for the SearchJob entity.
+type SearchJob struct {
+	ent.Schema
+}
+
+// Fields of the SearchJob.
+func (SearchJob) Fields() []ent.Field {
+	return []ent.Field{
+		field.String("query").
+			NotEmpty().
+			MaxLen(512),
+		field.Int("max_results").
+			NonNegative(),
+		field.Int("user_id"),
+		field.Enum("status").
+			Values(models.SearchJobPending, models.SearchJobRunning, models.SearchJobCompleted, models.SearchJobFailed).
+			Default(models.SearchJobPending),
+		field.Int("saved").
+			Default(0),
+		field.Int("failed").
+			Default(0),
+		field.Strings("file_names").
+			Optional(),
+		field.Int("deduplicated").
+			Default(0),
+		field.Strings("duplicate_file_names").
+			Optional(),
+		field.Strings("duplicate_of").
+			Optional().
+			Comment("Uuids of already saved files deduplicated results matched, in order of duplicate_file_names"),
+		field.Strings("candidate_urls").
+			Optional().
+			Comment("Urls of search results of the job, kept so a resumed job goes on with the same results"),
+		field.Strings("candidate_thumbnail_urls").
+			Optional().
+			Comment("Thumbnail urls of search results in order of candidate_urls, empty for results without one"),
+		field.Int("next_candidate").
+			Default(0).
+			Comment("Index of the first search result in candidate_urls which was not processed yet, a resumed job starts from it"),
+		field.String("runner").
+			Optional().
+			Comment("Id of store process which claimed the job, only it runs and updates the job"),
+		field.Time("heartbeat_at").
+			Optional().
+			Nillable().
+			Comment("When runner last reported it is running the job, other store processes claim the job once it is stale"),
+		field.String("error").
+			Optional(),
+		field.Time("created_at").
+			Default(time.Now).
+			Optional().
+			Nillable(),
+		field.Time("updated_at").
+			Default(time.Now).
+			UpdateDefault(time.Now),
+		field.Time("started_at").
+			Optional().
+			Nillable(),
+		field.Time("finished_at").
+			Optional().
+			Nillable(),
+	}
+}
+
+// Edges of the SearchJob.
+func (SearchJob) Edges() []ent.Edge {
+	return []ent.Edge{
+		edge.From("user", User.Type).
+			Field("user_id").
+			Ref("search_jobs").
+			Unique().
+			Required(),
+	}
+}
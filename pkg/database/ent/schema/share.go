@@ -0,0 +1,67 @@
+package schema
+
+import (
+	"time"
+
+	"entgo.io/ent"
+	"entgo.io/ent/schema/edge"
+	"entgo.io/ent/schema/field"
+	"entgo.io/ent/schema/index"
+	"github.com/lebleuciel/maani/models"
+)
+
+// Share holds the schema definition for the Share entity.
+type Share struct {
+	ent.Schema
+}
+
+// Fields of the Share.
+func (Share) Fields() []ent.Field {
+	return []ent.Field{
+		field.Int("file_id"),
+		// Share without a user is a public link
+		field.Int("user_id").
+			Optional().
+			Nillable(),
+		field.Enum("permission").
+			Values(models.ShareRead, models.ShareWrite).
+			Default(models.ShareRead),
+		field.Time("expires_at").
+			Optional().
+			Nillable(),
+		field.Int("max_downloads").
+			Optional().
+			Nillable().
+			Positive(),
+		field.Int("downloads").
+			Default(0),
+		field.Time("created_at").
+			Default(time.Now).
+			Optional().
+			Nillable(),
+	}
+}
+
+// Edges of the Share.
+func (Share) Edges() []ent.Edge {
+	return []ent.Edge{
+		edge.From("file", File.Type).
+			Field("file_id").
+			Ref("shares").
+			Unique().
+			Required(),
+		edge.From("user", User.Type).
+			Field("user_id").
+			Ref("shares").
+			Unique(),
+	}
+}
+
+// Indexes of the Share.
+func (Share) Indexes() []ent.Index {
+	return []ent.Index{
+		// File is shared with a user once, sharing it again updates the share
+		index.Fields("file_id", "user_id").
+			Unique(),
+	}
+}
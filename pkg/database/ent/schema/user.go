@@ -6,6 +6,7 @@ import (
 	"time"
 
 	"entgo.io/ent"
+	"entgo.io/ent/dialect/entsql"
 	"entgo.io/ent/schema/edge"
 	"entgo.io/ent/schema/field"
 	"github.com/lebleuciel/maani/models"
@@ -60,5 +61,8 @@ func (User) Fields() []ent.Field {
 func (User) Edges() []ent.Edge {
 	return []ent.Edge{
 		edge.To("files", File.Type),
+		edge.To("search_jobs", SearchJob.Type),
+		edge.To("shares", Share.Type).
+			Annotations(entsql.OnDelete(entsql.Cascade)),
 	}
 }
@@ -0,0 +1,371 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"encoding/json"
+	"fmt"
+	"strings"
+	"time"
+
+	"entgo.io/ent"
+	"entgo.io/ent/dialect/sql"
+	"github.com/lebleuciel/maani/pkg/database/ent/searchjob"
+	"github.com/lebleuciel/maani/pkg/database/ent/user"
+)
+
+// SearchJob is the model entity for the SearchJob schema.
+type SearchJob struct {
+	config `json:"-"`
+	// ID of the ent.
+	ID int `json:"id,omitempty"`
+	// Query holds the value of the "query" field.
+	Query string `json:"query,omitempty"`
+	// MaxResults holds the value of the "max_results" field.
+	MaxResults int `json:"max_results,omitempty"`
+	// UserID holds the value of the "user_id" field.
+	UserID int `json:"user_id,omitempty"`
+	// Status holds the value of the "status" field.
+	Status searchjob.Status `json:"status,omitempty"`
+	// Saved holds the value of the "saved" field.
+	Saved int `json:"saved,omitempty"`
+	// Failed holds the value of the "failed" field.
+	Failed int `json:"failed,omitempty"`
+	// FileNames holds the value of the "file_names" field.
+	FileNames []string `json:"file_names,omitempty"`
+	// Deduplicated holds the value of the "deduplicated" field.
+	Deduplicated int `json:"deduplicated,omitempty"`
+	// DuplicateFileNames holds the value of the "duplicate_file_names" field.
+	DuplicateFileNames []string `json:"duplicate_file_names,omitempty"`
+	// Uuids of already saved files deduplicated results matched, in order of duplicate_file_names
+	DuplicateOf []string `json:"duplicate_of,omitempty"`
+	// Urls of search results of the job, kept so a resumed job goes on with the same results
+	CandidateUrls []string `json:"candidate_urls,omitempty"`
+	// Thumbnail urls of search results in order of candidate_urls, empty for results without one
+	CandidateThumbnailUrls []string `json:"candidate_thumbnail_urls,omitempty"`
+	// Index of the first search result in candidate_urls which was not processed yet, a resumed job starts from it
+	NextCandidate int `json:"next_candidate,omitempty"`
+	// Id of store process which claimed the job, only it runs and updates the job
+	Runner string `json:"runner,omitempty"`
+	// When runner last reported it is running the job, other store processes claim the job once it is stale
+	HeartbeatAt *time.Time `json:"heartbeat_at,omitempty"`
+	// Error holds the value of the "error" field.
+	Error string `json:"error,omitempty"`
+	// CreatedAt holds the value of the "created_at" field.
+	CreatedAt *time.Time `json:"created_at,omitempty"`
+	// UpdatedAt holds the value of the "updated_at" field.
+	UpdatedAt time.Time `json:"updated_at,omitempty"`
+	// StartedAt holds the value of the "started_at" field.
+	StartedAt *time.Time `json:"started_at,omitempty"`
+	// FinishedAt holds the value of the "finished_at" field.
+	FinishedAt *time.Time `json:"finished_at,omitempty"`
+	// Edges holds the relations/edges for other nodes in the graph.
+	// The values are being populated by the SearchJobQuery when eager-loading is set.
+	Edges        SearchJobEdges `json:"edges"`
+	selectValues sql.SelectValues
+}
+
+// SearchJobEdges holds the relations/edges for other nodes in the graph.
+type SearchJobEdges struct {
+	// User holds the value of the user edge.
+	User *User `json:"user,omitempty"`
+	// loadedTypes holds the information for reporting if a
+	// type was loaded (or requested) in eager-loading or not.
+	loadedTypes [1]bool
+}
+
+// UserOrErr returns the User value or an error if the edge
+// was not loaded in eager-loading, or loaded but was not found.
+func (e SearchJobEdges) UserOrErr() (*User, error) {
+	if e.loadedTypes[0] {
+		if e.User == nil {
+			// Edge was loaded but was not found.
+			return nil, &NotFoundError{label: user.Label}
+		}
+		return e.User, nil
+	}
+	return nil, &NotLoadedError{edge: "user"}
+}
+
+// scanValues returns the types for scanning values from sql.Rows.
+func (*SearchJob) scanValues(columns []string) ([]any, error) {
+	values := make([]any, len(columns))
+	for i := range columns {
+		switch columns[i] {
+		case searchjob.FieldFileNames, searchjob.FieldDuplicateFileNames, searchjob.FieldDuplicateOf, searchjob.FieldCandidateUrls, searchjob.FieldCandidateThumbnailUrls:
+			values[i] = new([]byte)
+		case searchjob.FieldID, searchjob.FieldMaxResults, searchjob.FieldUserID, searchjob.FieldSaved, searchjob.FieldFailed, searchjob.FieldDeduplicated, searchjob.FieldNextCandidate:
+			values[i] = new(sql.NullInt64)
+		case searchjob.FieldQuery, searchjob.FieldStatus, searchjob.FieldRunner, searchjob.FieldError:
+			values[i] = new(sql.NullString)
+		case searchjob.FieldHeartbeatAt, searchjob.FieldCreatedAt, searchjob.FieldUpdatedAt, searchjob.FieldStartedAt, searchjob.FieldFinishedAt:
+			values[i] = new(sql.NullTime)
+		default:
+			values[i] = new(sql.UnknownType)
+		}
+	}
+	return values, nil
+}
+
+// assignValues assigns the values that were returned from sql.Rows (after scanning)
+// to the SearchJob fields.
+func (sj *SearchJob) assignValues(columns []string, values []any) error {
+	if m, n := len(values), len(columns); m < n {
+		return fmt.Errorf("mismatch number of scan values: %d != %d", m, n)
+	}
+	for i := range columns {
+		switch columns[i] {
+		case searchjob.FieldID:
+			value, ok := values[i].(*sql.NullInt64)
+			if !ok {
+				return fmt.Errorf("unexpected type %T for field id", value)
+			}
+			sj.ID = int(value.Int64)
+		case searchjob.FieldQuery:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field query", values[i])
+			} else if value.Valid {
+				sj.Query = value.String
+			}
+		case searchjob.FieldMaxResults:
+			if value, ok := values[i].(*sql.NullInt64); !ok {
+				return fmt.Errorf("unexpected type %T for field max_results", values[i])
+			} else if value.Valid {
+				sj.MaxResults = int(value.Int64)
+			}
+		case searchjob.FieldUserID:
+			if value, ok := values[i].(*sql.NullInt64); !ok {
+				return fmt.Errorf("unexpected type %T for field user_id", values[i])
+			} else if value.Valid {
+				sj.UserID = int(value.Int64)
+			}
+		case searchjob.FieldStatus:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field status", values[i])
+			} else if value.Valid {
+				sj.Status = searchjob.Status(value.String)
+			}
+		case searchjob.FieldSaved:
+			if value, ok := values[i].(*sql.NullInt64); !ok {
+				return fmt.Errorf("unexpected type %T for field saved", values[i])
+			} else if value.Valid {
+				sj.Saved = int(value.Int64)
+			}
+		case searchjob.FieldFailed:
+			if value, ok := values[i].(*sql.NullInt64); !ok {
+				return fmt.Errorf("unexpected type %T for field failed", values[i])
+			} else if value.Valid {
+				sj.Failed = int(value.Int64)
+			}
+		case searchjob.FieldFileNames:
+			if value, ok := values[i].(*[]byte); !ok {
+				return fmt.Errorf("unexpected type %T for field file_names", values[i])
+			} else if value != nil && len(*value) > 0 {
+				if err := json.Unmarshal(*value, &sj.FileNames); err != nil {
+					return fmt.Errorf("unmarshal field file_names: %w", err)
+				}
+			}
+		case searchjob.FieldDeduplicated:
+			if value, ok := values[i].(*sql.NullInt64); !ok {
+				return fmt.Errorf("unexpected type %T for field deduplicated", values[i])
+			} else if value.Valid {
+				sj.Deduplicated = int(value.Int64)
+			}
+		case searchjob.FieldDuplicateFileNames:
+			if value, ok := values[i].(*[]byte); !ok {
+				return fmt.Errorf("unexpected type %T for field duplicate_file_names", values[i])
+			} else if value != nil && len(*value) > 0 {
+				if err := json.Unmarshal(*value, &sj.DuplicateFileNames); err != nil {
+					return fmt.Errorf("unmarshal field duplicate_file_names: %w", err)
+				}
+			}
+		case searchjob.FieldDuplicateOf:
+			if value, ok := values[i].(*[]byte); !ok {
+				return fmt.Errorf("unexpected type %T for field duplicate_of", values[i])
+			} else if value != nil && len(*value) > 0 {
+				if err := json.Unmarshal(*value, &sj.DuplicateOf); err != nil {
+					return fmt.Errorf("unmarshal field duplicate_of: %w", err)
+				}
+			}
+		case searchjob.FieldCandidateUrls:
+			if value, ok := values[i].(*[]byte); !ok {
+				return fmt.Errorf("unexpected type %T for field candidate_urls", values[i])
+			} else if value != nil && len(*value) > 0 {
+				if err := json.Unmarshal(*value, &sj.CandidateUrls); err != nil {
+					return fmt.Errorf("unmarshal field candidate_urls: %w", err)
+				}
+			}
+		case searchjob.FieldCandidateThumbnailUrls:
+			if value, ok := values[i].(*[]byte); !ok {
+				return fmt.Errorf("unexpected type %T for field candidate_thumbnail_urls", values[i])
+			} else if value != nil && len(*value) > 0 {
+				if err := json.Unmarshal(*value, &sj.CandidateThumbnailUrls); err != nil {
+					return fmt.Errorf("unmarshal field candidate_thumbnail_urls: %w", err)
+				}
+			}
+		case searchjob.FieldNextCandidate:
+			if value, ok := values[i].(*sql.NullInt64); !ok {
+				return fmt.Errorf("unexpected type %T for field next_candidate", values[i])
+			} else if value.Valid {
+				sj.NextCandidate = int(value.Int64)
+			}
+		case searchjob.FieldRunner:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field runner", values[i])
+			} else if value.Valid {
+				sj.Runner = value.String
+			}
+		case searchjob.FieldHeartbeatAt:
+			if value, ok := values[i].(*sql.NullTime); !ok {
+				return fmt.Errorf("unexpected type %T for field heartbeat_at", values[i])
+			} else if value.Valid {
+				sj.HeartbeatAt = new(time.Time)
+				*sj.HeartbeatAt = value.Time
+			}
+		case searchjob.FieldError:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field error", values[i])
+			} else if value.Valid {
+				sj.Error = value.String
+			}
+		case searchjob.FieldCreatedAt:
+			if value, ok := values[i].(*sql.NullTime); !ok {
+				return fmt.Errorf("unexpected type %T for field created_at", values[i])
+			} else if value.Valid {
+				sj.CreatedAt = new(time.Time)
+				*sj.CreatedAt = value.Time
+			}
+		case searchjob.FieldUpdatedAt:
+			if value, ok := values[i].(*sql.NullTime); !ok {
+				return fmt.Errorf("unexpected type %T for field updated_at", values[i])
+			} else if value.Valid {
+				sj.UpdatedAt = value.Time
+			}
+		case searchjob.FieldStartedAt:
+			if value, ok := values[i].(*sql.NullTime); !ok {
+				return fmt.Errorf("unexpected type %T for field started_at", values[i])
+			} else if value.Valid {
+				sj.StartedAt = new(time.Time)
+				*sj.StartedAt = value.Time
+			}
+		case searchjob.FieldFinishedAt:
+			if value, ok := values[i].(*sql.NullTime); !ok {
+				return fmt.Errorf("unexpected type %T for field finished_at", values[i])
+			} else if value.Valid {
+				sj.FinishedAt = new(time.Time)
+				*sj.FinishedAt = value.Time
+			}
+		default:
+			sj.selectValues.Set(columns[i], values[i])
+		}
+	}
+	return nil
+}
+
+// Value returns the ent.Value that was dynamically selected and assigned to the SearchJob.
+// This includes values selected through modifiers, order, etc.
+func (sj *SearchJob) Value(name string) (ent.Value, error) {
+	return sj.selectValues.Get(name)
+}
+
+// QueryUser queries the "user" edge of the SearchJob entity.
+func (sj *SearchJob) QueryUser() *UserQuery {
+	return NewSearchJobClient(sj.config).QueryUser(sj)
+}
+
+// Update returns a builder for updating this SearchJob.
+// Note that you need to call SearchJob.Unwrap() before calling this method if this SearchJob
+// was returned from a transaction, and the transaction was committed or rolled back.
+func (sj *SearchJob) Update() *SearchJobUpdateOne {
+	return NewSearchJobClient(sj.config).UpdateOne(sj)
+}
+
+// Unwrap unwraps the SearchJob entity that was returned from a transaction after it was closed,
+// so that all future queries will be executed through the driver which created the transaction.
+func (sj *SearchJob) Unwrap() *SearchJob {
+	_tx, ok := sj.config.driver.(*txDriver)
+	if !ok {
+		panic("ent: SearchJob is not a transactional entity")
+	}
+	sj.config.driver = _tx.drv
+	return sj
+}
+
+// String implements the fmt.Stringer.
+func (sj *SearchJob) String() string {
+	var builder strings.Builder
+	builder.WriteString("SearchJob(")
+	builder.WriteString(fmt.Sprintf("id=%v, ", sj.ID))
+	builder.WriteString("query=")
+	builder.WriteString(sj.Query)
+	builder.WriteString(", ")
+	builder.WriteString("max_results=")
+	builder.WriteString(fmt.Sprintf("%v", sj.MaxResults))
+	builder.WriteString(", ")
+	builder.WriteString("user_id=")
+	builder.WriteString(fmt.Sprintf("%v", sj.UserID))
+	builder.WriteString(", ")
+	builder.WriteString("status=")
+	builder.WriteString(fmt.Sprintf("%v", sj.Status))
+	builder.WriteString(", ")
+	builder.WriteString("saved=")
+	builder.WriteString(fmt.Sprintf("%v", sj.Saved))
+	builder.WriteString(", ")
+	builder.WriteString("failed=")
+	builder.WriteString(fmt.Sprintf("%v", sj.Failed))
+	builder.WriteString(", ")
+	builder.WriteString("file_names=")
+	builder.WriteString(fmt.Sprintf("%v", sj.FileNames))
+	builder.WriteString(", ")
+	builder.WriteString("deduplicated=")
+	builder.WriteString(fmt.Sprintf("%v", sj.Deduplicated))
+	builder.WriteString(", ")
+	builder.WriteString("duplicate_file_names=")
+	builder.WriteString(fmt.Sprintf("%v", sj.DuplicateFileNames))
+	builder.WriteString(", ")
+	builder.WriteString("duplicate_of=")
+	builder.WriteString(fmt.Sprintf("%v", sj.DuplicateOf))
+	builder.WriteString(", ")
+	builder.WriteString("candidate_urls=")
+	builder.WriteString(fmt.Sprintf("%v", sj.CandidateUrls))
+	builder.WriteString(", ")
+	builder.WriteString("candidate_thumbnail_urls=")
+	builder.WriteString(fmt.Sprintf("%v", sj.CandidateThumbnailUrls))
+	builder.WriteString(", ")
+	builder.WriteString("next_candidate=")
+	builder.WriteString(fmt.Sprintf("%v", sj.NextCandidate))
+	builder.WriteString(", ")
+	builder.WriteString("runner=")
+	builder.WriteString(sj.Runner)
+	builder.WriteString(", ")
+	if v := sj.HeartbeatAt; v != nil {
+		builder.WriteString("heartbeat_at=")
+		builder.WriteString(v.Format(time.ANSIC))
+	}
+	builder.WriteString(", ")
+	builder.WriteString("error=")
+	builder.WriteString(sj.Error)
+	builder.WriteString(", ")
+	if v := sj.CreatedAt; v != nil {
+		builder.WriteString("created_at=")
+		builder.WriteString(v.Format(time.ANSIC))
+	}
+	builder.WriteString(", ")
+	builder.WriteString("updated_at=")
+	builder.WriteString(sj.UpdatedAt.Format(time.ANSIC))
+	builder.WriteString(", ")
+	if v := sj.StartedAt; v != nil {
+		builder.WriteString("started_at=")
+		builder.WriteString(v.Format(time.ANSIC))
+	}
+	builder.WriteString(", ")
+	if v := sj.FinishedAt; v != nil {
+		builder.WriteString("finished_at=")
+		builder.WriteString(v.Format(time.ANSIC))
+	}
+	builder.WriteByte(')')
+	return builder.String()
+}
+
+// SearchJobs is a parsable slice of SearchJob.
+type SearchJobs []*SearchJob
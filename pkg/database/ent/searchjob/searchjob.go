@@ -0,0 +1,250 @@
+// Code generated by ent, DO NOT EDIT.
+
+package searchjob
+
+import (
+	"fmt"
+	"time"
+
+	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqlgraph"
+)
+
+const (
+	// Label holds the string label denoting the searchjob type in the database.
+	Label = "search_job"
+	// FieldID holds the string denoting the id field in the database.
+	FieldID = "id"
+	// FieldQuery holds the string denoting the query field in the database.
+	FieldQuery = "query"
+	// FieldMaxResults holds the string denoting the max_results field in the database.
+	FieldMaxResults = "max_results"
+	// FieldUserID holds the string denoting the user_id field in the database.
+	FieldUserID = "user_id"
+	// FieldStatus holds the string denoting the status field in the database.
+	FieldStatus = "status"
+	// FieldSaved holds the string denoting the saved field in the database.
+	FieldSaved = "saved"
+	// FieldFailed holds the string denoting the failed field in the database.
+	FieldFailed = "failed"
+	// FieldFileNames holds the string denoting the file_names field in the database.
+	FieldFileNames = "file_names"
+	// FieldDeduplicated holds the string denoting the deduplicated field in the database.
+	FieldDeduplicated = "deduplicated"
+	// FieldDuplicateFileNames holds the string denoting the duplicate_file_names field in the database.
+	FieldDuplicateFileNames = "duplicate_file_names"
+	// FieldDuplicateOf holds the string denoting the duplicate_of field in the database.
+	FieldDuplicateOf = "duplicate_of"
+	// FieldCandidateUrls holds the string denoting the candidate_urls field in the database.
+	FieldCandidateUrls = "candidate_urls"
+	// FieldCandidateThumbnailUrls holds the string denoting the candidate_thumbnail_urls field in the database.
+	FieldCandidateThumbnailUrls = "candidate_thumbnail_urls"
+	// FieldNextCandidate holds the string denoting the next_candidate field in the database.
+	FieldNextCandidate = "next_candidate"
+	// FieldRunner holds the string denoting the runner field in the database.
+	FieldRunner = "runner"
+	// FieldHeartbeatAt holds the string denoting the heartbeat_at field in the database.
+	FieldHeartbeatAt = "heartbeat_at"
+	// FieldError holds the string denoting the error field in the database.
+	FieldError = "error"
+	// FieldCreatedAt holds the string denoting the created_at field in the database.
+	FieldCreatedAt = "created_at"
+	// FieldUpdatedAt holds the string denoting the updated_at field in the database.
+	FieldUpdatedAt = "updated_at"
+	// FieldStartedAt holds the string denoting the started_at field in the database.
+	FieldStartedAt = "started_at"
+	// FieldFinishedAt holds the string denoting the finished_at field in the database.
+	FieldFinishedAt = "finished_at"
+	// EdgeUser holds the string denoting the user edge name in mutations.
+	EdgeUser = "user"
+	// Table holds the table name of the searchjob in the database.
+	Table = "search_jobs"
+	// UserTable is the table that holds the user relation/edge.
+	UserTable = "search_jobs"
+	// UserInverseTable is the table name for the User entity.
+	// It exists in this package in order to avoid circular dependency with the "user" package.
+	UserInverseTable = "users"
+	// UserColumn is the table column denoting the user relation/edge.
+	UserColumn = "user_id"
+)
+
+// Columns holds all SQL columns for searchjob fields.
+var Columns = []string{
+	FieldID,
+	FieldQuery,
+	FieldMaxResults,
+	FieldUserID,
+	FieldStatus,
+	FieldSaved,
+	FieldFailed,
+	FieldFileNames,
+	FieldDeduplicated,
+	FieldDuplicateFileNames,
+	FieldDuplicateOf,
+	FieldCandidateUrls,
+	FieldCandidateThumbnailUrls,
+	FieldNextCandidate,
+	FieldRunner,
+	FieldHeartbeatAt,
+	FieldError,
+	FieldCreatedAt,
+	FieldUpdatedAt,
+	FieldStartedAt,
+	FieldFinishedAt,
+}
+
+// ValidColumn reports if the column name is valid (part of the table columns).
+func ValidColumn(column string) bool {
+	for i := range Columns {
+		if column == Columns[i] {
+			return true
+		}
+	}
+	return false
+}
+
+var (
+	// QueryValidator is a validator for the "query" field. It is called by the builders before save.
+	QueryValidator func(string) error
+	// MaxResultsValidator is a validator for the "max_results" field. It is called by the builders before save.
+	MaxResultsValidator func(int) error
+	// DefaultSaved holds the default value on creation for the "saved" field.
+	DefaultSaved int
+	// DefaultFailed holds the default value on creation for the "failed" field.
+	DefaultFailed int
+	// DefaultDeduplicated holds the default value on creation for the "deduplicated" field.
+	DefaultDeduplicated int
+	// DefaultNextCandidate holds the default value on creation for the "next_candidate" field.
+	DefaultNextCandidate int
+	// DefaultCreatedAt holds the default value on creation for the "created_at" field.
+	DefaultCreatedAt func() time.Time
+	// DefaultUpdatedAt holds the default value on creation for the "updated_at" field.
+	DefaultUpdatedAt func() time.Time
+	// UpdateDefaultUpdatedAt holds the default value on update for the "updated_at" field.
+	UpdateDefaultUpdatedAt func() time.Time
+)
+
+// Status defines the type for the "status" enum field.
+type Status string
+
+// StatusPending is the default value of the Status enum.
+const DefaultStatus = StatusPending
+
+// Status values.
+const (
+	StatusPending   Status = "pending"
+	StatusRunning   Status = "running"
+	StatusCompleted Status = "completed"
+	StatusFailed    Status = "failed"
+)
+
+func (s Status) String() string {
+	return string(s)
+}
+
+// StatusValidator is a validator for the "status" field enum values. It is called by the builders before save.
+func StatusValidator(s Status) error {
+	switch s {
+	case StatusPending, StatusRunning, StatusCompleted, StatusFailed:
+		return nil
+	default:
+		return fmt.Errorf("searchjob: invalid enum value for status field: %q", s)
+	}
+}
+
+// OrderOption defines the ordering options for the SearchJob queries.
+type OrderOption func(*sql.Selector)
+
+// ByID orders the results by the id field.
+func ByID(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldID, opts...).ToFunc()
+}
+
+// ByQuery orders the results by the query field.
+func ByQuery(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldQuery, opts...).ToFunc()
+}
+
+// ByMaxResults orders the results by the max_results field.
+func ByMaxResults(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldMaxResults, opts...).ToFunc()
+}
+
+// ByUserID orders the results by the user_id field.
+func ByUserID(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldUserID, opts...).ToFunc()
+}
+
+// ByStatus orders the results by the status field.
+func ByStatus(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldStatus, opts...).ToFunc()
+}
+
+// BySaved orders the results by the saved field.
+func BySaved(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldSaved, opts...).ToFunc()
+}
+
+// ByFailed orders the results by the failed field.
+func ByFailed(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldFailed, opts...).ToFunc()
+}
+
+// ByDeduplicated orders the results by the deduplicated field.
+func ByDeduplicated(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldDeduplicated, opts...).ToFunc()
+}
+
+// ByNextCandidate orders the results by the next_candidate field.
+func ByNextCandidate(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldNextCandidate, opts...).ToFunc()
+}
+
+// ByRunner orders the results by the runner field.
+func ByRunner(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldRunner, opts...).ToFunc()
+}
+
+// ByHeartbeatAt orders the results by the heartbeat_at field.
+func ByHeartbeatAt(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldHeartbeatAt, opts...).ToFunc()
+}
+
+// ByError orders the results by the error field.
+func ByError(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldError, opts...).ToFunc()
+}
+
+// ByCreatedAt orders the results by the created_at field.
+func ByCreatedAt(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldCreatedAt, opts...).ToFunc()
+}
+
+// ByUpdatedAt orders the results by the updated_at field.
+func ByUpdatedAt(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldUpdatedAt, opts...).ToFunc()
+}
+
+// ByStartedAt orders the results by the started_at field.
+func ByStartedAt(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldStartedAt, opts...).ToFunc()
+}
+
+// ByFinishedAt orders the results by the finished_at field.
+func ByFinishedAt(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldFinishedAt, opts...).ToFunc()
+}
+
+// ByUserField orders the results by user field.
+func ByUserField(field string, opts ...sql.OrderTermOption) OrderOption {
+	return func(s *sql.Selector) {
+		sqlgraph.OrderByNeighborTerms(s, newUserStep(), sql.OrderByField(field, opts...))
+	}
+}
+func newUserStep() *sqlgraph.Step {
+	return sqlgraph.NewStep(
+		sqlgraph.From(Table, FieldID),
+		sqlgraph.To(UserInverseTable, FieldID),
+		sqlgraph.Edge(sqlgraph.M2O, true, UserTable, UserColumn),
+	)
+}
@@ -0,0 +1,909 @@
+// Code generated by ent, DO NOT EDIT.
+
+package searchjob
+
+import (
+	"time"
+
+	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"github.com/lebleuciel/maani/pkg/database/ent/predicate"
+)
+
+// ID filters vertices based on their ID field.
+func ID(id int) predicate.SearchJob {
+	return predicate.SearchJob(sql.FieldEQ(FieldID, id))
+}
+
+// IDEQ applies the EQ predicate on the ID field.
+func IDEQ(id int) predicate.SearchJob {
+	return predicate.SearchJob(sql.FieldEQ(FieldID, id))
+}
+
+// IDNEQ applies the NEQ predicate on the ID field.
+func IDNEQ(id int) predicate.SearchJob {
+	return predicate.SearchJob(sql.FieldNEQ(FieldID, id))
+}
+
+// IDIn applies the In predicate on the ID field.
+func IDIn(ids ...int) predicate.SearchJob {
+	return predicate.SearchJob(sql.FieldIn(FieldID, ids...))
+}
+
+// IDNotIn applies the NotIn predicate on the ID field.
+func IDNotIn(ids ...int) predicate.SearchJob {
+	return predicate.SearchJob(sql.FieldNotIn(FieldID, ids...))
+}
+
+// IDGT applies the GT predicate on the ID field.
+func IDGT(id int) predicate.SearchJob {
+	return predicate.SearchJob(sql.FieldGT(FieldID, id))
+}
+
+// IDGTE applies the GTE predicate on the ID field.
+func IDGTE(id int) predicate.SearchJob {
+	return predicate.SearchJob(sql.FieldGTE(FieldID, id))
+}
+
+// IDLT applies the LT predicate on the ID field.
+func IDLT(id int) predicate.SearchJob {
+	return predicate.SearchJob(sql.FieldLT(FieldID, id))
+}
+
+// IDLTE applies the LTE predicate on the ID field.
+func IDLTE(id int) predicate.SearchJob {
+	return predicate.SearchJob(sql.FieldLTE(FieldID, id))
+}
+
+// Query applies equality check predicate on the "query" field. It's identical to QueryEQ.
+func Query(v string) predicate.SearchJob {
+	return predicate.SearchJob(sql.FieldEQ(FieldQuery, v))
+}
+
+// MaxResults applies equality check predicate on the "max_results" field. It's identical to MaxResultsEQ.
+func MaxResults(v int) predicate.SearchJob {
+	return predicate.SearchJob(sql.FieldEQ(FieldMaxResults, v))
+}
+
+// UserID applies equality check predicate on the "user_id" field. It's identical to UserIDEQ.
+func UserID(v int) predicate.SearchJob {
+	return predicate.SearchJob(sql.FieldEQ(FieldUserID, v))
+}
+
+// Saved applies equality check predicate on the "saved" field. It's identical to SavedEQ.
+func Saved(v int) predicate.SearchJob {
+	return predicate.SearchJob(sql.FieldEQ(FieldSaved, v))
+}
+
+// Failed applies equality check predicate on the "failed" field. It's identical to FailedEQ.
+func Failed(v int) predicate.SearchJob {
+	return predicate.SearchJob(sql.FieldEQ(FieldFailed, v))
+}
+
+// Deduplicated applies equality check predicate on the "deduplicated" field. It's identical to DeduplicatedEQ.
+func Deduplicated(v int) predicate.SearchJob {
+	return predicate.SearchJob(sql.FieldEQ(FieldDeduplicated, v))
+}
+
+// NextCandidate applies equality check predicate on the "next_candidate" field. It's identical to NextCandidateEQ.
+func NextCandidate(v int) predicate.SearchJob {
+	return predicate.SearchJob(sql.FieldEQ(FieldNextCandidate, v))
+}
+
+// Runner applies equality check predicate on the "runner" field. It's identical to RunnerEQ.
+func Runner(v string) predicate.SearchJob {
+	return predicate.SearchJob(sql.FieldEQ(FieldRunner, v))
+}
+
+// HeartbeatAt applies equality check predicate on the "heartbeat_at" field. It's identical to HeartbeatAtEQ.
+func HeartbeatAt(v time.Time) predicate.SearchJob {
+	return predicate.SearchJob(sql.FieldEQ(FieldHeartbeatAt, v))
+}
+
+// Error applies equality check predicate on the "error" field. It's identical to ErrorEQ.
+func Error(v string) predicate.SearchJob {
+	return predicate.SearchJob(sql.FieldEQ(FieldError, v))
+}
+
+// CreatedAt applies equality check predicate on the "created_at" field. It's identical to CreatedAtEQ.
+func CreatedAt(v time.Time) predicate.SearchJob {
+	return predicate.SearchJob(sql.FieldEQ(FieldCreatedAt, v))
+}
+
+// UpdatedAt applies equality check predicate on the "updated_at" field. It's identical to UpdatedAtEQ.
+func UpdatedAt(v time.Time) predicate.SearchJob {
+	return predicate.SearchJob(sql.FieldEQ(FieldUpdatedAt, v))
+}
+
+// StartedAt applies equality check predicate on the "started_at" field. It's identical to StartedAtEQ.
+func StartedAt(v time.Time) predicate.SearchJob {
+	return predicate.SearchJob(sql.FieldEQ(FieldStartedAt, v))
+}
+
+// FinishedAt applies equality check predicate on the "finished_at" field. It's identical to FinishedAtEQ.
+func FinishedAt(v time.Time) predicate.SearchJob {
+	return predicate.SearchJob(sql.FieldEQ(FieldFinishedAt, v))
+}
+
+// QueryEQ applies the EQ predicate on the "query" field.
+func QueryEQ(v string) predicate.SearchJob {
+	return predicate.SearchJob(sql.FieldEQ(FieldQuery, v))
+}
+
+// QueryNEQ applies the NEQ predicate on the "query" field.
+func QueryNEQ(v string) predicate.SearchJob {
+	return predicate.SearchJob(sql.FieldNEQ(FieldQuery, v))
+}
+
+// QueryIn applies the In predicate on the "query" field.
+func QueryIn(vs ...string) predicate.SearchJob {
+	return predicate.SearchJob(sql.FieldIn(FieldQuery, vs...))
+}
+
+// QueryNotIn applies the NotIn predicate on the "query" field.
+func QueryNotIn(vs ...string) predicate.SearchJob {
+	return predicate.SearchJob(sql.FieldNotIn(FieldQuery, vs...))
+}
+
+// QueryGT applies the GT predicate on the "query" field.
+func QueryGT(v string) predicate.SearchJob {
+	return predicate.SearchJob(sql.FieldGT(FieldQuery, v))
+}
+
+// QueryGTE applies the GTE predicate on the "query" field.
+func QueryGTE(v string) predicate.SearchJob {
+	return predicate.SearchJob(sql.FieldGTE(FieldQuery, v))
+}
+
+// QueryLT applies the LT predicate on the "query" field.
+func QueryLT(v string) predicate.SearchJob {
+	return predicate.SearchJob(sql.FieldLT(FieldQuery, v))
+}
+
+// QueryLTE applies the LTE predicate on the "query" field.
+func QueryLTE(v string) predicate.SearchJob {
+	return predicate.SearchJob(sql.FieldLTE(FieldQuery, v))
+}
+
+// QueryContains applies the Contains predicate on the "query" field.
+func QueryContains(v string) predicate.SearchJob {
+	return predicate.SearchJob(sql.FieldContains(FieldQuery, v))
+}
+
+// QueryHasPrefix applies the HasPrefix predicate on the "query" field.
+func QueryHasPrefix(v string) predicate.SearchJob {
+	return predicate.SearchJob(sql.FieldHasPrefix(FieldQuery, v))
+}
+
+// QueryHasSuffix applies the HasSuffix predicate on the "query" field.
+func QueryHasSuffix(v string) predicate.SearchJob {
+	return predicate.SearchJob(sql.FieldHasSuffix(FieldQuery, v))
+}
+
+// QueryEqualFold applies the EqualFold predicate on the "query" field.
+func QueryEqualFold(v string) predicate.SearchJob {
+	return predicate.SearchJob(sql.FieldEqualFold(FieldQuery, v))
+}
+
+// QueryContainsFold applies the ContainsFold predicate on the "query" field.
+func QueryContainsFold(v string) predicate.SearchJob {
+	return predicate.SearchJob(sql.FieldContainsFold(FieldQuery, v))
+}
+
+// MaxResultsEQ applies the EQ predicate on the "max_results" field.
+func MaxResultsEQ(v int) predicate.SearchJob {
+	return predicate.SearchJob(sql.FieldEQ(FieldMaxResults, v))
+}
+
+// MaxResultsNEQ applies the NEQ predicate on the "max_results" field.
+func MaxResultsNEQ(v int) predicate.SearchJob {
+	return predicate.SearchJob(sql.FieldNEQ(FieldMaxResults, v))
+}
+
+// MaxResultsIn applies the In predicate on the "max_results" field.
+func MaxResultsIn(vs ...int) predicate.SearchJob {
+	return predicate.SearchJob(sql.FieldIn(FieldMaxResults, vs...))
+}
+
+// MaxResultsNotIn applies the NotIn predicate on the "max_results" field.
+func MaxResultsNotIn(vs ...int) predicate.SearchJob {
+	return predicate.SearchJob(sql.FieldNotIn(FieldMaxResults, vs...))
+}
+
+// MaxResultsGT applies the GT predicate on the "max_results" field.
+func MaxResultsGT(v int) predicate.SearchJob {
+	return predicate.SearchJob(sql.FieldGT(FieldMaxResults, v))
+}
+
+// MaxResultsGTE applies the GTE predicate on the "max_results" field.
+func MaxResultsGTE(v int) predicate.SearchJob {
+	return predicate.SearchJob(sql.FieldGTE(FieldMaxResults, v))
+}
+
+// MaxResultsLT applies the LT predicate on the "max_results" field.
+func MaxResultsLT(v int) predicate.SearchJob {
+	return predicate.SearchJob(sql.FieldLT(FieldMaxResults, v))
+}
+
+// MaxResultsLTE applies the LTE predicate on the "max_results" field.
+func MaxResultsLTE(v int) predicate.SearchJob {
+	return predicate.SearchJob(sql.FieldLTE(FieldMaxResults, v))
+}
+
+// UserIDEQ applies the EQ predicate on the "user_id" field.
+func UserIDEQ(v int) predicate.SearchJob {
+	return predicate.SearchJob(sql.FieldEQ(FieldUserID, v))
+}
+
+// UserIDNEQ applies the NEQ predicate on the "user_id" field.
+func UserIDNEQ(v int) predicate.SearchJob {
+	return predicate.SearchJob(sql.FieldNEQ(FieldUserID, v))
+}
+
+// UserIDIn applies the In predicate on the "user_id" field.
+func UserIDIn(vs ...int) predicate.SearchJob {
+	return predicate.SearchJob(sql.FieldIn(FieldUserID, vs...))
+}
+
+// UserIDNotIn applies the NotIn predicate on the "user_id" field.
+func UserIDNotIn(vs ...int) predicate.SearchJob {
+	return predicate.SearchJob(sql.FieldNotIn(FieldUserID, vs...))
+}
+
+// StatusEQ applies the EQ predicate on the "status" field.
+func StatusEQ(v Status) predicate.SearchJob {
+	return predicate.SearchJob(sql.FieldEQ(FieldStatus, v))
+}
+
+// StatusNEQ applies the NEQ predicate on the "status" field.
+func StatusNEQ(v Status) predicate.SearchJob {
+	return predicate.SearchJob(sql.FieldNEQ(FieldStatus, v))
+}
+
+// StatusIn applies the In predicate on the "status" field.
+func StatusIn(vs ...Status) predicate.SearchJob {
+	return predicate.SearchJob(sql.FieldIn(FieldStatus, vs...))
+}
+
+// StatusNotIn applies the NotIn predicate on the "status" field.
+func StatusNotIn(vs ...Status) predicate.SearchJob {
+	return predicate.SearchJob(sql.FieldNotIn(FieldStatus, vs...))
+}
+
+// SavedEQ applies the EQ predicate on the "saved" field.
+func SavedEQ(v int) predicate.SearchJob {
+	return predicate.SearchJob(sql.FieldEQ(FieldSaved, v))
+}
+
+// SavedNEQ applies the NEQ predicate on the "saved" field.
+func SavedNEQ(v int) predicate.SearchJob {
+	return predicate.SearchJob(sql.FieldNEQ(FieldSaved, v))
+}
+
+// SavedIn applies the In predicate on the "saved" field.
+func SavedIn(vs ...int) predicate.SearchJob {
+	return predicate.SearchJob(sql.FieldIn(FieldSaved, vs...))
+}
+
+// SavedNotIn applies the NotIn predicate on the "saved" field.
+func SavedNotIn(vs ...int) predicate.SearchJob {
+	return predicate.SearchJob(sql.FieldNotIn(FieldSaved, vs...))
+}
+
+// SavedGT applies the GT predicate on the "saved" field.
+func SavedGT(v int) predicate.SearchJob {
+	return predicate.SearchJob(sql.FieldGT(FieldSaved, v))
+}
+
+// SavedGTE applies the GTE predicate on the "saved" field.
+func SavedGTE(v int) predicate.SearchJob {
+	return predicate.SearchJob(sql.FieldGTE(FieldSaved, v))
+}
+
+// SavedLT applies the LT predicate on the "saved" field.
+func SavedLT(v int) predicate.SearchJob {
+	return predicate.SearchJob(sql.FieldLT(FieldSaved, v))
+}
+
+// SavedLTE applies the LTE predicate on the "saved" field.
+func SavedLTE(v int) predicate.SearchJob {
+	return predicate.SearchJob(sql.FieldLTE(FieldSaved, v))
+}
+
+// FailedEQ applies the EQ predicate on the "failed" field.
+func FailedEQ(v int) predicate.SearchJob {
+	return predicate.SearchJob(sql.FieldEQ(FieldFailed, v))
+}
+
+// FailedNEQ applies the NEQ predicate on the "failed" field.
+func FailedNEQ(v int) predicate.SearchJob {
+	return predicate.SearchJob(sql.FieldNEQ(FieldFailed, v))
+}
+
+// FailedIn applies the In predicate on the "failed" field.
+func FailedIn(vs ...int) predicate.SearchJob {
+	return predicate.SearchJob(sql.FieldIn(FieldFailed, vs...))
+}
+
+// FailedNotIn applies the NotIn predicate on the "failed" field.
+func FailedNotIn(vs ...int) predicate.SearchJob {
+	return predicate.SearchJob(sql.FieldNotIn(FieldFailed, vs...))
+}
+
+// FailedGT applies the GT predicate on the "failed" field.
+func FailedGT(v int) predicate.SearchJob {
+	return predicate.SearchJob(sql.FieldGT(FieldFailed, v))
+}
+
+// FailedGTE applies the GTE predicate on the "failed" field.
+func FailedGTE(v int) predicate.SearchJob {
+	return predicate.SearchJob(sql.FieldGTE(FieldFailed, v))
+}
+
+// FailedLT applies the LT predicate on the "failed" field.
+func FailedLT(v int) predicate.SearchJob {
+	return predicate.SearchJob(sql.FieldLT(FieldFailed, v))
+}
+
+// FailedLTE applies the LTE predicate on the "failed" field.
+func FailedLTE(v int) predicate.SearchJob {
+	return predicate.SearchJob(sql.FieldLTE(FieldFailed, v))
+}
+
+// FileNamesIsNil applies the IsNil predicate on the "file_names" field.
+func FileNamesIsNil() predicate.SearchJob {
+	return predicate.SearchJob(sql.FieldIsNull(FieldFileNames))
+}
+
+// FileNamesNotNil applies the NotNil predicate on the "file_names" field.
+func FileNamesNotNil() predicate.SearchJob {
+	return predicate.SearchJob(sql.FieldNotNull(FieldFileNames))
+}
+
+// DeduplicatedEQ applies the EQ predicate on the "deduplicated" field.
+func DeduplicatedEQ(v int) predicate.SearchJob {
+	return predicate.SearchJob(sql.FieldEQ(FieldDeduplicated, v))
+}
+
+// DeduplicatedNEQ applies the NEQ predicate on the "deduplicated" field.
+func DeduplicatedNEQ(v int) predicate.SearchJob {
+	return predicate.SearchJob(sql.FieldNEQ(FieldDeduplicated, v))
+}
+
+// DeduplicatedIn applies the In predicate on the "deduplicated" field.
+func DeduplicatedIn(vs ...int) predicate.SearchJob {
+	return predicate.SearchJob(sql.FieldIn(FieldDeduplicated, vs...))
+}
+
+// DeduplicatedNotIn applies the NotIn predicate on the "deduplicated" field.
+func DeduplicatedNotIn(vs ...int) predicate.SearchJob {
+	return predicate.SearchJob(sql.FieldNotIn(FieldDeduplicated, vs...))
+}
+
+// DeduplicatedGT applies the GT predicate on the "deduplicated" field.
+func DeduplicatedGT(v int) predicate.SearchJob {
+	return predicate.SearchJob(sql.FieldGT(FieldDeduplicated, v))
+}
+
+// DeduplicatedGTE applies the GTE predicate on the "deduplicated" field.
+func DeduplicatedGTE(v int) predicate.SearchJob {
+	return predicate.SearchJob(sql.FieldGTE(FieldDeduplicated, v))
+}
+
+// DeduplicatedLT applies the LT predicate on the "deduplicated" field.
+func DeduplicatedLT(v int) predicate.SearchJob {
+	return predicate.SearchJob(sql.FieldLT(FieldDeduplicated, v))
+}
+
+// DeduplicatedLTE applies the LTE predicate on the "deduplicated" field.
+func DeduplicatedLTE(v int) predicate.SearchJob {
+	return predicate.SearchJob(sql.FieldLTE(FieldDeduplicated, v))
+}
+
+// DuplicateFileNamesIsNil applies the IsNil predicate on the "duplicate_file_names" field.
+func DuplicateFileNamesIsNil() predicate.SearchJob {
+	return predicate.SearchJob(sql.FieldIsNull(FieldDuplicateFileNames))
+}
+
+// DuplicateFileNamesNotNil applies the NotNil predicate on the "duplicate_file_names" field.
+func DuplicateFileNamesNotNil() predicate.SearchJob {
+	return predicate.SearchJob(sql.FieldNotNull(FieldDuplicateFileNames))
+}
+
+// DuplicateOfIsNil applies the IsNil predicate on the "duplicate_of" field.
+func DuplicateOfIsNil() predicate.SearchJob {
+	return predicate.SearchJob(sql.FieldIsNull(FieldDuplicateOf))
+}
+
+// DuplicateOfNotNil applies the NotNil predicate on the "duplicate_of" field.
+func DuplicateOfNotNil() predicate.SearchJob {
+	return predicate.SearchJob(sql.FieldNotNull(FieldDuplicateOf))
+}
+
+// CandidateUrlsIsNil applies the IsNil predicate on the "candidate_urls" field.
+func CandidateUrlsIsNil() predicate.SearchJob {
+	return predicate.SearchJob(sql.FieldIsNull(FieldCandidateUrls))
+}
+
+// CandidateUrlsNotNil applies the NotNil predicate on the "candidate_urls" field.
+func CandidateUrlsNotNil() predicate.SearchJob {
+	return predicate.SearchJob(sql.FieldNotNull(FieldCandidateUrls))
+}
+
+// CandidateThumbnailUrlsIsNil applies the IsNil predicate on the "candidate_thumbnail_urls" field.
+func CandidateThumbnailUrlsIsNil() predicate.SearchJob {
+	return predicate.SearchJob(sql.FieldIsNull(FieldCandidateThumbnailUrls))
+}
+
+// CandidateThumbnailUrlsNotNil applies the NotNil predicate on the "candidate_thumbnail_urls" field.
+func CandidateThumbnailUrlsNotNil() predicate.SearchJob {
+	return predicate.SearchJob(sql.FieldNotNull(FieldCandidateThumbnailUrls))
+}
+
+// NextCandidateEQ applies the EQ predicate on the "next_candidate" field.
+func NextCandidateEQ(v int) predicate.SearchJob {
+	return predicate.SearchJob(sql.FieldEQ(FieldNextCandidate, v))
+}
+
+// NextCandidateNEQ applies the NEQ predicate on the "next_candidate" field.
+func NextCandidateNEQ(v int) predicate.SearchJob {
+	return predicate.SearchJob(sql.FieldNEQ(FieldNextCandidate, v))
+}
+
+// NextCandidateIn applies the In predicate on the "next_candidate" field.
+func NextCandidateIn(vs ...int) predicate.SearchJob {
+	return predicate.SearchJob(sql.FieldIn(FieldNextCandidate, vs...))
+}
+
+// NextCandidateNotIn applies the NotIn predicate on the "next_candidate" field.
+func NextCandidateNotIn(vs ...int) predicate.SearchJob {
+	return predicate.SearchJob(sql.FieldNotIn(FieldNextCandidate, vs...))
+}
+
+// NextCandidateGT applies the GT predicate on the "next_candidate" field.
+func NextCandidateGT(v int) predicate.SearchJob {
+	return predicate.SearchJob(sql.FieldGT(FieldNextCandidate, v))
+}
+
+// NextCandidateGTE applies the GTE predicate on the "next_candidate" field.
+func NextCandidateGTE(v int) predicate.SearchJob {
+	return predicate.SearchJob(sql.FieldGTE(FieldNextCandidate, v))
+}
+
+// NextCandidateLT applies the LT predicate on the "next_candidate" field.
+func NextCandidateLT(v int) predicate.SearchJob {
+	return predicate.SearchJob(sql.FieldLT(FieldNextCandidate, v))
+}
+
+// NextCandidateLTE applies the LTE predicate on the "next_candidate" field.
+func NextCandidateLTE(v int) predicate.SearchJob {
+	return predicate.SearchJob(sql.FieldLTE(FieldNextCandidate, v))
+}
+
+// RunnerEQ applies the EQ predicate on the "runner" field.
+func RunnerEQ(v string) predicate.SearchJob {
+	return predicate.SearchJob(sql.FieldEQ(FieldRunner, v))
+}
+
+// RunnerNEQ applies the NEQ predicate on the "runner" field.
+func RunnerNEQ(v string) predicate.SearchJob {
+	return predicate.SearchJob(sql.FieldNEQ(FieldRunner, v))
+}
+
+// RunnerIn applies the In predicate on the "runner" field.
+func RunnerIn(vs ...string) predicate.SearchJob {
+	return predicate.SearchJob(sql.FieldIn(FieldRunner, vs...))
+}
+
+// RunnerNotIn applies the NotIn predicate on the "runner" field.
+func RunnerNotIn(vs ...string) predicate.SearchJob {
+	return predicate.SearchJob(sql.FieldNotIn(FieldRunner, vs...))
+}
+
+// RunnerGT applies the GT predicate on the "runner" field.
+func RunnerGT(v string) predicate.SearchJob {
+	return predicate.SearchJob(sql.FieldGT(FieldRunner, v))
+}
+
+// RunnerGTE applies the GTE predicate on the "runner" field.
+func RunnerGTE(v string) predicate.SearchJob {
+	return predicate.SearchJob(sql.FieldGTE(FieldRunner, v))
+}
+
+// RunnerLT applies the LT predicate on the "runner" field.
+func RunnerLT(v string) predicate.SearchJob {
+	return predicate.SearchJob(sql.FieldLT(FieldRunner, v))
+}
+
+// RunnerLTE applies the LTE predicate on the "runner" field.
+func RunnerLTE(v string) predicate.SearchJob {
+	return predicate.SearchJob(sql.FieldLTE(FieldRunner, v))
+}
+
+// RunnerContains applies the Contains predicate on the "runner" field.
+func RunnerContains(v string) predicate.SearchJob {
+	return predicate.SearchJob(sql.FieldContains(FieldRunner, v))
+}
+
+// RunnerHasPrefix applies the HasPrefix predicate on the "runner" field.
+func RunnerHasPrefix(v string) predicate.SearchJob {
+	return predicate.SearchJob(sql.FieldHasPrefix(FieldRunner, v))
+}
+
+// RunnerHasSuffix applies the HasSuffix predicate on the "runner" field.
+func RunnerHasSuffix(v string) predicate.SearchJob {
+	return predicate.SearchJob(sql.FieldHasSuffix(FieldRunner, v))
+}
+
+// RunnerIsNil applies the IsNil predicate on the "runner" field.
+func RunnerIsNil() predicate.SearchJob {
+	return predicate.SearchJob(sql.FieldIsNull(FieldRunner))
+}
+
+// RunnerNotNil applies the NotNil predicate on the "runner" field.
+func RunnerNotNil() predicate.SearchJob {
+	return predicate.SearchJob(sql.FieldNotNull(FieldRunner))
+}
+
+// RunnerEqualFold applies the EqualFold predicate on the "runner" field.
+func RunnerEqualFold(v string) predicate.SearchJob {
+	return predicate.SearchJob(sql.FieldEqualFold(FieldRunner, v))
+}
+
+// RunnerContainsFold applies the ContainsFold predicate on the "runner" field.
+func RunnerContainsFold(v string) predicate.SearchJob {
+	return predicate.SearchJob(sql.FieldContainsFold(FieldRunner, v))
+}
+
+// HeartbeatAtEQ applies the EQ predicate on the "heartbeat_at" field.
+func HeartbeatAtEQ(v time.Time) predicate.SearchJob {
+	return predicate.SearchJob(sql.FieldEQ(FieldHeartbeatAt, v))
+}
+
+// HeartbeatAtNEQ applies the NEQ predicate on the "heartbeat_at" field.
+func HeartbeatAtNEQ(v time.Time) predicate.SearchJob {
+	return predicate.SearchJob(sql.FieldNEQ(FieldHeartbeatAt, v))
+}
+
+// HeartbeatAtIn applies the In predicate on the "heartbeat_at" field.
+func HeartbeatAtIn(vs ...time.Time) predicate.SearchJob {
+	return predicate.SearchJob(sql.FieldIn(FieldHeartbeatAt, vs...))
+}
+
+// HeartbeatAtNotIn applies the NotIn predicate on the "heartbeat_at" field.
+func HeartbeatAtNotIn(vs ...time.Time) predicate.SearchJob {
+	return predicate.SearchJob(sql.FieldNotIn(FieldHeartbeatAt, vs...))
+}
+
+// HeartbeatAtGT applies the GT predicate on the "heartbeat_at" field.
+func HeartbeatAtGT(v time.Time) predicate.SearchJob {
+	return predicate.SearchJob(sql.FieldGT(FieldHeartbeatAt, v))
+}
+
+// HeartbeatAtGTE applies the GTE predicate on the "heartbeat_at" field.
+func HeartbeatAtGTE(v time.Time) predicate.SearchJob {
+	return predicate.SearchJob(sql.FieldGTE(FieldHeartbeatAt, v))
+}
+
+// HeartbeatAtLT applies the LT predicate on the "heartbeat_at" field.
+func HeartbeatAtLT(v time.Time) predicate.SearchJob {
+	return predicate.SearchJob(sql.FieldLT(FieldHeartbeatAt, v))
+}
+
+// HeartbeatAtLTE applies the LTE predicate on the "heartbeat_at" field.
+func HeartbeatAtLTE(v time.Time) predicate.SearchJob {
+	return predicate.SearchJob(sql.FieldLTE(FieldHeartbeatAt, v))
+}
+
+// HeartbeatAtIsNil applies the IsNil predicate on the "heartbeat_at" field.
+func HeartbeatAtIsNil() predicate.SearchJob {
+	return predicate.SearchJob(sql.FieldIsNull(FieldHeartbeatAt))
+}
+
+// HeartbeatAtNotNil applies the NotNil predicate on the "heartbeat_at" field.
+func HeartbeatAtNotNil() predicate.SearchJob {
+	return predicate.SearchJob(sql.FieldNotNull(FieldHeartbeatAt))
+}
+
+// ErrorEQ applies the EQ predicate on the "error" field.
+func ErrorEQ(v string) predicate.SearchJob {
+	return predicate.SearchJob(sql.FieldEQ(FieldError, v))
+}
+
+// ErrorNEQ applies the NEQ predicate on the "error" field.
+func ErrorNEQ(v string) predicate.SearchJob {
+	return predicate.SearchJob(sql.FieldNEQ(FieldError, v))
+}
+
+// ErrorIn applies the In predicate on the "error" field.
+func ErrorIn(vs ...string) predicate.SearchJob {
+	return predicate.SearchJob(sql.FieldIn(FieldError, vs...))
+}
+
+// ErrorNotIn applies the NotIn predicate on the "error" field.
+func ErrorNotIn(vs ...string) predicate.SearchJob {
+	return predicate.SearchJob(sql.FieldNotIn(FieldError, vs...))
+}
+
+// ErrorGT applies the GT predicate on the "error" field.
+func ErrorGT(v string) predicate.SearchJob {
+	return predicate.SearchJob(sql.FieldGT(FieldError, v))
+}
+
+// ErrorGTE applies the GTE predicate on the "error" field.
+func ErrorGTE(v string) predicate.SearchJob {
+	return predicate.SearchJob(sql.FieldGTE(FieldError, v))
+}
+
+// ErrorLT applies the LT predicate on the "error" field.
+func ErrorLT(v string) predicate.SearchJob {
+	return predicate.SearchJob(sql.FieldLT(FieldError, v))
+}
+
+// ErrorLTE applies the LTE predicate on the "error" field.
+func ErrorLTE(v string) predicate.SearchJob {
+	return predicate.SearchJob(sql.FieldLTE(FieldError, v))
+}
+
+// ErrorContains applies the Contains predicate on the "error" field.
+func ErrorContains(v string) predicate.SearchJob {
+	return predicate.SearchJob(sql.FieldContains(FieldError, v))
+}
+
+// ErrorHasPrefix applies the HasPrefix predicate on the "error" field.
+func ErrorHasPrefix(v string) predicate.SearchJob {
+	return predicate.SearchJob(sql.FieldHasPrefix(FieldError, v))
+}
+
+// ErrorHasSuffix applies the HasSuffix predicate on the "error" field.
+func ErrorHasSuffix(v string) predicate.SearchJob {
+	return predicate.SearchJob(sql.FieldHasSuffix(FieldError, v))
+}
+
+// ErrorIsNil applies the IsNil predicate on the "error" field.
+func ErrorIsNil() predicate.SearchJob {
+	return predicate.SearchJob(sql.FieldIsNull(FieldError))
+}
+
+// ErrorNotNil applies the NotNil predicate on the "error" field.
+func ErrorNotNil() predicate.SearchJob {
+	return predicate.SearchJob(sql.FieldNotNull(FieldError))
+}
+
+// ErrorEqualFold applies the EqualFold predicate on the "error" field.
+func ErrorEqualFold(v string) predicate.SearchJob {
+	return predicate.SearchJob(sql.FieldEqualFold(FieldError, v))
+}
+
+// ErrorContainsFold applies the ContainsFold predicate on the "error" field.
+func ErrorContainsFold(v string) predicate.SearchJob {
+	return predicate.SearchJob(sql.FieldContainsFold(FieldError, v))
+}
+
+// CreatedAtEQ applies the EQ predicate on the "created_at" field.
+func CreatedAtEQ(v time.Time) predicate.SearchJob {
+	return predicate.SearchJob(sql.FieldEQ(FieldCreatedAt, v))
+}
+
+// CreatedAtNEQ applies the NEQ predicate on the "created_at" field.
+func CreatedAtNEQ(v time.Time) predicate.SearchJob {
+	return predicate.SearchJob(sql.FieldNEQ(FieldCreatedAt, v))
+}
+
+// CreatedAtIn applies the In predicate on the "created_at" field.
+func CreatedAtIn(vs ...time.Time) predicate.SearchJob {
+	return predicate.SearchJob(sql.FieldIn(FieldCreatedAt, vs...))
+}
+
+// CreatedAtNotIn applies the NotIn predicate on the "created_at" field.
+func CreatedAtNotIn(vs ...time.Time) predicate.SearchJob {
+	return predicate.SearchJob(sql.FieldNotIn(FieldCreatedAt, vs...))
+}
+
+// CreatedAtGT applies the GT predicate on the "created_at" field.
+func CreatedAtGT(v time.Time) predicate.SearchJob {
+	return predicate.SearchJob(sql.FieldGT(FieldCreatedAt, v))
+}
+
+// CreatedAtGTE applies the GTE predicate on the "created_at" field.
+func CreatedAtGTE(v time.Time) predicate.SearchJob {
+	return predicate.SearchJob(sql.FieldGTE(FieldCreatedAt, v))
+}
+
+// CreatedAtLT applies the LT predicate on the "created_at" field.
+func CreatedAtLT(v time.Time) predicate.SearchJob {
+	return predicate.SearchJob(sql.FieldLT(FieldCreatedAt, v))
+}
+
+// CreatedAtLTE applies the LTE predicate on the "created_at" field.
+func CreatedAtLTE(v time.Time) predicate.SearchJob {
+	return predicate.SearchJob(sql.FieldLTE(FieldCreatedAt, v))
+}
+
+// CreatedAtIsNil applies the IsNil predicate on the "created_at" field.
+func CreatedAtIsNil() predicate.SearchJob {
+	return predicate.SearchJob(sql.FieldIsNull(FieldCreatedAt))
+}
+
+// CreatedAtNotNil applies the NotNil predicate on the "created_at" field.
+func CreatedAtNotNil() predicate.SearchJob {
+	return predicate.SearchJob(sql.FieldNotNull(FieldCreatedAt))
+}
+
+// UpdatedAtEQ applies the EQ predicate on the "updated_at" field.
+func UpdatedAtEQ(v time.Time) predicate.SearchJob {
+	return predicate.SearchJob(sql.FieldEQ(FieldUpdatedAt, v))
+}
+
+// UpdatedAtNEQ applies the NEQ predicate on the "updated_at" field.
+func UpdatedAtNEQ(v time.Time) predicate.SearchJob {
+	return predicate.SearchJob(sql.FieldNEQ(FieldUpdatedAt, v))
+}
+
+// UpdatedAtIn applies the In predicate on the "updated_at" field.
+func UpdatedAtIn(vs ...time.Time) predicate.SearchJob {
+	return predicate.SearchJob(sql.FieldIn(FieldUpdatedAt, vs...))
+}
+
+// UpdatedAtNotIn applies the NotIn predicate on the "updated_at" field.
+func UpdatedAtNotIn(vs ...time.Time) predicate.SearchJob {
+	return predicate.SearchJob(sql.FieldNotIn(FieldUpdatedAt, vs...))
+}
+
+// UpdatedAtGT applies the GT predicate on the "updated_at" field.
+func UpdatedAtGT(v time.Time) predicate.SearchJob {
+	return predicate.SearchJob(sql.FieldGT(FieldUpdatedAt, v))
+}
+
+// UpdatedAtGTE applies the GTE predicate on the "updated_at" field.
+func UpdatedAtGTE(v time.Time) predicate.SearchJob {
+	return predicate.SearchJob(sql.FieldGTE(FieldUpdatedAt, v))
+}
+
+// UpdatedAtLT applies the LT predicate on the "updated_at" field.
+func UpdatedAtLT(v time.Time) predicate.SearchJob {
+	return predicate.SearchJob(sql.FieldLT(FieldUpdatedAt, v))
+}
+
+// UpdatedAtLTE applies the LTE predicate on the "updated_at" field.
+func UpdatedAtLTE(v time.Time) predicate.SearchJob {
+	return predicate.SearchJob(sql.FieldLTE(FieldUpdatedAt, v))
+}
+
+// StartedAtEQ applies the EQ predicate on the "started_at" field.
+func StartedAtEQ(v time.Time) predicate.SearchJob {
+	return predicate.SearchJob(sql.FieldEQ(FieldStartedAt, v))
+}
+
+// StartedAtNEQ applies the NEQ predicate on the "started_at" field.
+func StartedAtNEQ(v time.Time) predicate.SearchJob {
+	return predicate.SearchJob(sql.FieldNEQ(FieldStartedAt, v))
+}
+
+// StartedAtIn applies the In predicate on the "started_at" field.
+func StartedAtIn(vs ...time.Time) predicate.SearchJob {
+	return predicate.SearchJob(sql.FieldIn(FieldStartedAt, vs...))
+}
+
+// StartedAtNotIn applies the NotIn predicate on the "started_at" field.
+func StartedAtNotIn(vs ...time.Time) predicate.SearchJob {
+	return predicate.SearchJob(sql.FieldNotIn(FieldStartedAt, vs...))
+}
+
+// StartedAtGT applies the GT predicate on the "started_at" field.
+func StartedAtGT(v time.Time) predicate.SearchJob {
+	return predicate.SearchJob(sql.FieldGT(FieldStartedAt, v))
+}
+
+// StartedAtGTE applies the GTE predicate on the "started_at" field.
+func StartedAtGTE(v time.Time) predicate.SearchJob {
+	return predicate.SearchJob(sql.FieldGTE(FieldStartedAt, v))
+}
+
+// StartedAtLT applies the LT predicate on the "started_at" field.
+func StartedAtLT(v time.Time) predicate.SearchJob {
+	return predicate.SearchJob(sql.FieldLT(FieldStartedAt, v))
+}
+
+// StartedAtLTE applies the LTE predicate on the "started_at" field.
+func StartedAtLTE(v time.Time) predicate.SearchJob {
+	return predicate.SearchJob(sql.FieldLTE(FieldStartedAt, v))
+}
+
+// StartedAtIsNil applies the IsNil predicate on the "started_at" field.
+func StartedAtIsNil() predicate.SearchJob {
+	return predicate.SearchJob(sql.FieldIsNull(FieldStartedAt))
+}
+
+// StartedAtNotNil applies the NotNil predicate on the "started_at" field.
+func StartedAtNotNil() predicate.SearchJob {
+	return predicate.SearchJob(sql.FieldNotNull(FieldStartedAt))
+}
+
+// FinishedAtEQ applies the EQ predicate on the "finished_at" field.
+func FinishedAtEQ(v time.Time) predicate.SearchJob {
+	return predicate.SearchJob(sql.FieldEQ(FieldFinishedAt, v))
+}
+
+// FinishedAtNEQ applies the NEQ predicate on the "finished_at" field.
+func FinishedAtNEQ(v time.Time) predicate.SearchJob {
+	return predicate.SearchJob(sql.FieldNEQ(FieldFinishedAt, v))
+}
+
+// FinishedAtIn applies the In predicate on the "finished_at" field.
+func FinishedAtIn(vs ...time.Time) predicate.SearchJob {
+	return predicate.SearchJob(sql.FieldIn(FieldFinishedAt, vs...))
+}
+
+// FinishedAtNotIn applies the NotIn predicate on the "finished_at" field.
+func FinishedAtNotIn(vs ...time.Time) predicate.SearchJob {
+	return predicate.SearchJob(sql.FieldNotIn(FieldFinishedAt, vs...))
+}
+
+// FinishedAtGT applies the GT predicate on the "finished_at" field.
+func FinishedAtGT(v time.Time) predicate.SearchJob {
+	return predicate.SearchJob(sql.FieldGT(FieldFinishedAt, v))
+}
+
+// FinishedAtGTE applies the GTE predicate on the "finished_at" field.
+func FinishedAtGTE(v time.Time) predicate.SearchJob {
+	return predicate.SearchJob(sql.FieldGTE(FieldFinishedAt, v))
+}
+
+// FinishedAtLT applies the LT predicate on the "finished_at" field.
+func FinishedAtLT(v time.Time) predicate.SearchJob {
+	return predicate.SearchJob(sql.FieldLT(FieldFinishedAt, v))
+}
+
+// FinishedAtLTE applies the LTE predicate on the "finished_at" field.
+func FinishedAtLTE(v time.Time) predicate.SearchJob {
+	return predicate.SearchJob(sql.FieldLTE(FieldFinishedAt, v))
+}
+
+// FinishedAtIsNil applies the IsNil predicate on the "finished_at" field.
+func FinishedAtIsNil() predicate.SearchJob {
+	return predicate.SearchJob(sql.FieldIsNull(FieldFinishedAt))
+}
+
+// FinishedAtNotNil applies the NotNil predicate on the "finished_at" field.
+func FinishedAtNotNil() predicate.SearchJob {
+	return predicate.SearchJob(sql.FieldNotNull(FieldFinishedAt))
+}
+
+// HasUser applies the HasEdge predicate on the "user" edge.
+func HasUser() predicate.SearchJob {
+	return predicate.SearchJob(func(s *sql.Selector) {
+		step := sqlgraph.NewStep(
+			sqlgraph.From(Table, FieldID),
+			sqlgraph.Edge(sqlgraph.M2O, true, UserTable, UserColumn),
+		)
+		sqlgraph.HasNeighbors(s, step)
+	})
+}
+
+// HasUserWith applies the HasEdge predicate on the "user" edge with a given conditions (other predicates).
+func HasUserWith(preds ...predicate.User) predicate.SearchJob {
+	return predicate.SearchJob(func(s *sql.Selector) {
+		step := newUserStep()
+		sqlgraph.HasNeighborsWith(s, step, func(s *sql.Selector) {
+			for _, p := range preds {
+				p(s)
+			}
+		})
+	})
+}
+
+// And groups predicates with the AND operator between them.
+func And(predicates ...predicate.SearchJob) predicate.SearchJob {
+	return predicate.SearchJob(sql.AndPredicates(predicates...))
+}
+
+// Or groups predicates with the OR operator between them.
+func Or(predicates ...predicate.SearchJob) predicate.SearchJob {
+	return predicate.SearchJob(sql.OrPredicates(predicates...))
+}
+
+// Not applies the not operator on the given predicate.
+func Not(p predicate.SearchJob) predicate.SearchJob {
+	return predicate.SearchJob(sql.NotPredicates(p))
+}
@@ -0,0 +1,1916 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/schema/field"
+	"github.com/lebleuciel/maani/pkg/database/ent/searchjob"
+	"github.com/lebleuciel/maani/pkg/database/ent/user"
+)
+
+// SearchJobCreate is the builder for creating a SearchJob entity.
+type SearchJobCreate struct {
+	config
+	mutation *SearchJobMutation
+	hooks    []Hook
+	conflict []sql.ConflictOption
+}
+
+// SetQuery sets the "query" field.
+func (sjc *SearchJobCreate) SetQuery(s string) *SearchJobCreate {
+	sjc.mutation.SetQuery(s)
+	return sjc
+}
+
+// SetMaxResults sets the "max_results" field.
+func (sjc *SearchJobCreate) SetMaxResults(i int) *SearchJobCreate {
+	sjc.mutation.SetMaxResults(i)
+	return sjc
+}
+
+// SetUserID sets the "user_id" field.
+func (sjc *SearchJobCreate) SetUserID(i int) *SearchJobCreate {
+	sjc.mutation.SetUserID(i)
+	return sjc
+}
+
+// SetStatus sets the "status" field.
+func (sjc *SearchJobCreate) SetStatus(s searchjob.Status) *SearchJobCreate {
+	sjc.mutation.SetStatus(s)
+	return sjc
+}
+
+// SetNillableStatus sets the "status" field if the given value is not nil.
+func (sjc *SearchJobCreate) SetNillableStatus(s *searchjob.Status) *SearchJobCreate {
+	if s != nil {
+		sjc.SetStatus(*s)
+	}
+	return sjc
+}
+
+// SetSaved sets the "saved" field.
+func (sjc *SearchJobCreate) SetSaved(i int) *SearchJobCreate {
+	sjc.mutation.SetSaved(i)
+	return sjc
+}
+
+// SetNillableSaved sets the "saved" field if the given value is not nil.
+func (sjc *SearchJobCreate) SetNillableSaved(i *int) *SearchJobCreate {
+	if i != nil {
+		sjc.SetSaved(*i)
+	}
+	return sjc
+}
+
+// SetFailed sets the "failed" field.
+func (sjc *SearchJobCreate) SetFailed(i int) *SearchJobCreate {
+	sjc.mutation.SetFailed(i)
+	return sjc
+}
+
+// SetNillableFailed sets the "failed" field if the given value is not nil.
+func (sjc *SearchJobCreate) SetNillableFailed(i *int) *SearchJobCreate {
+	if i != nil {
+		sjc.SetFailed(*i)
+	}
+	return sjc
+}
+
+// SetFileNames sets the "file_names" field.
+func (sjc *SearchJobCreate) SetFileNames(s []string) *SearchJobCreate {
+	sjc.mutation.SetFileNames(s)
+	return sjc
+}
+
+// SetDeduplicated sets the "deduplicated" field.
+func (sjc *SearchJobCreate) SetDeduplicated(i int) *SearchJobCreate {
+	sjc.mutation.SetDeduplicated(i)
+	return sjc
+}
+
+// SetNillableDeduplicated sets the "deduplicated" field if the given value is not nil.
+func (sjc *SearchJobCreate) SetNillableDeduplicated(i *int) *SearchJobCreate {
+	if i != nil {
+		sjc.SetDeduplicated(*i)
+	}
+	return sjc
+}
+
+// SetDuplicateFileNames sets the "duplicate_file_names" field.
+func (sjc *SearchJobCreate) SetDuplicateFileNames(s []string) *SearchJobCreate {
+	sjc.mutation.SetDuplicateFileNames(s)
+	return sjc
+}
+
+// SetDuplicateOf sets the "duplicate_of" field.
+func (sjc *SearchJobCreate) SetDuplicateOf(s []string) *SearchJobCreate {
+	sjc.mutation.SetDuplicateOf(s)
+	return sjc
+}
+
+// SetCandidateUrls sets the "candidate_urls" field.
+func (sjc *SearchJobCreate) SetCandidateUrls(s []string) *SearchJobCreate {
+	sjc.mutation.SetCandidateUrls(s)
+	return sjc
+}
+
+// SetCandidateThumbnailUrls sets the "candidate_thumbnail_urls" field.
+func (sjc *SearchJobCreate) SetCandidateThumbnailUrls(s []string) *SearchJobCreate {
+	sjc.mutation.SetCandidateThumbnailUrls(s)
+	return sjc
+}
+
+// SetNextCandidate sets the "next_candidate" field.
+func (sjc *SearchJobCreate) SetNextCandidate(i int) *SearchJobCreate {
+	sjc.mutation.SetNextCandidate(i)
+	return sjc
+}
+
+// SetNillableNextCandidate sets the "next_candidate" field if the given value is not nil.
+func (sjc *SearchJobCreate) SetNillableNextCandidate(i *int) *SearchJobCreate {
+	if i != nil {
+		sjc.SetNextCandidate(*i)
+	}
+	return sjc
+}
+
+// SetRunner sets the "runner" field.
+func (sjc *SearchJobCreate) SetRunner(s string) *SearchJobCreate {
+	sjc.mutation.SetRunner(s)
+	return sjc
+}
+
+// SetNillableRunner sets the "runner" field if the given value is not nil.
+func (sjc *SearchJobCreate) SetNillableRunner(s *string) *SearchJobCreate {
+	if s != nil {
+		sjc.SetRunner(*s)
+	}
+	return sjc
+}
+
+// SetHeartbeatAt sets the "heartbeat_at" field.
+func (sjc *SearchJobCreate) SetHeartbeatAt(t time.Time) *SearchJobCreate {
+	sjc.mutation.SetHeartbeatAt(t)
+	return sjc
+}
+
+// SetNillableHeartbeatAt sets the "heartbeat_at" field if the given value is not nil.
+func (sjc *SearchJobCreate) SetNillableHeartbeatAt(t *time.Time) *SearchJobCreate {
+	if t != nil {
+		sjc.SetHeartbeatAt(*t)
+	}
+	return sjc
+}
+
+// SetError sets the "error" field.
+func (sjc *SearchJobCreate) SetError(s string) *SearchJobCreate {
+	sjc.mutation.SetError(s)
+	return sjc
+}
+
+// SetNillableError sets the "error" field if the given value is not nil.
+func (sjc *SearchJobCreate) SetNillableError(s *string) *SearchJobCreate {
+	if s != nil {
+		sjc.SetError(*s)
+	}
+	return sjc
+}
+
+// SetCreatedAt sets the "created_at" field.
+func (sjc *SearchJobCreate) SetCreatedAt(t time.Time) *SearchJobCreate {
+	sjc.mutation.SetCreatedAt(t)
+	return sjc
+}
+
+// SetNillableCreatedAt sets the "created_at" field if the given value is not nil.
+func (sjc *SearchJobCreate) SetNillableCreatedAt(t *time.Time) *SearchJobCreate {
+	if t != nil {
+		sjc.SetCreatedAt(*t)
+	}
+	return sjc
+}
+
+// SetUpdatedAt sets the "updated_at" field.
+func (sjc *SearchJobCreate) SetUpdatedAt(t time.Time) *SearchJobCreate {
+	sjc.mutation.SetUpdatedAt(t)
+	return sjc
+}
+
+// SetNillableUpdatedAt sets the "updated_at" field if the given value is not nil.
+func (sjc *SearchJobCreate) SetNillableUpdatedAt(t *time.Time) *SearchJobCreate {
+	if t != nil {
+		sjc.SetUpdatedAt(*t)
+	}
+	return sjc
+}
+
+// SetStartedAt sets the "started_at" field.
+func (sjc *SearchJobCreate) SetStartedAt(t time.Time) *SearchJobCreate {
+	sjc.mutation.SetStartedAt(t)
+	return sjc
+}
+
+// SetNillableStartedAt sets the "started_at" field if the given value is not nil.
+func (sjc *SearchJobCreate) SetNillableStartedAt(t *time.Time) *SearchJobCreate {
+	if t != nil {
+		sjc.SetStartedAt(*t)
+	}
+	return sjc
+}
+
+// SetFinishedAt sets the "finished_at" field.
+func (sjc *SearchJobCreate) SetFinishedAt(t time.Time) *SearchJobCreate {
+	sjc.mutation.SetFinishedAt(t)
+	return sjc
+}
+
+// SetNillableFinishedAt sets the "finished_at" field if the given value is not nil.
+func (sjc *SearchJobCreate) SetNillableFinishedAt(t *time.Time) *SearchJobCreate {
+	if t != nil {
+		sjc.SetFinishedAt(*t)
+	}
+	return sjc
+}
+
+// SetUser sets the "user" edge to the User entity.
+func (sjc *SearchJobCreate) SetUser(u *User) *SearchJobCreate {
+	return sjc.SetUserID(u.ID)
+}
+
+// Mutation returns the SearchJobMutation object of the builder.
+func (sjc *SearchJobCreate) Mutation() *SearchJobMutation {
+	return sjc.mutation
+}
+
+// Save creates the SearchJob in the database.
+func (sjc *SearchJobCreate) Save(ctx context.Context) (*SearchJob, error) {
+	sjc.defaults()
+	return withHooks(ctx, sjc.sqlSave, sjc.mutation, sjc.hooks)
+}
+
+// SaveX calls Save and panics if Save returns an error.
+func (sjc *SearchJobCreate) SaveX(ctx context.Context) *SearchJob {
+	v, err := sjc.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
+
+// Exec executes the query.
+func (sjc *SearchJobCreate) Exec(ctx context.Context) error {
+	_, err := sjc.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (sjc *SearchJobCreate) ExecX(ctx context.Context) {
+	if err := sjc.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// defaults sets the default values of the builder before save.
+func (sjc *SearchJobCreate) defaults() {
+	if _, ok := sjc.mutation.Status(); !ok {
+		v := searchjob.DefaultStatus
+		sjc.mutation.SetStatus(v)
+	}
+	if _, ok := sjc.mutation.Saved(); !ok {
+		v := searchjob.DefaultSaved
+		sjc.mutation.SetSaved(v)
+	}
+	if _, ok := sjc.mutation.Failed(); !ok {
+		v := searchjob.DefaultFailed
+		sjc.mutation.SetFailed(v)
+	}
+	if _, ok := sjc.mutation.Deduplicated(); !ok {
+		v := searchjob.DefaultDeduplicated
+		sjc.mutation.SetDeduplicated(v)
+	}
+	if _, ok := sjc.mutation.NextCandidate(); !ok {
+		v := searchjob.DefaultNextCandidate
+		sjc.mutation.SetNextCandidate(v)
+	}
+	if _, ok := sjc.mutation.CreatedAt(); !ok {
+		v := searchjob.DefaultCreatedAt()
+		sjc.mutation.SetCreatedAt(v)
+	}
+	if _, ok := sjc.mutation.UpdatedAt(); !ok {
+		v := searchjob.DefaultUpdatedAt()
+		sjc.mutation.SetUpdatedAt(v)
+	}
+}
+
+// check runs all checks and user-defined validators on the builder.
+func (sjc *SearchJobCreate) check() error {
+	if _, ok := sjc.mutation.Query(); !ok {
+		return &ValidationError{Name: "query", err: errors.New(`ent: missing required field "SearchJob.query"`)}
+	}
+	if v, ok := sjc.mutation.Query(); ok {
+		if err := searchjob.QueryValidator(v); err != nil {
+			return &ValidationError{Name: "query", err: fmt.Errorf(`ent: validator failed for field "SearchJob.query": %w`, err)}
+		}
+	}
+	if _, ok := sjc.mutation.MaxResults(); !ok {
+		return &ValidationError{Name: "max_results", err: errors.New(`ent: missing required field "SearchJob.max_results"`)}
+	}
+	if v, ok := sjc.mutation.MaxResults(); ok {
+		if err := searchjob.MaxResultsValidator(v); err != nil {
+			return &ValidationError{Name: "max_results", err: fmt.Errorf(`ent: validator failed for field "SearchJob.max_results": %w`, err)}
+		}
+	}
+	if _, ok := sjc.mutation.UserID(); !ok {
+		return &ValidationError{Name: "user_id", err: errors.New(`ent: missing required field "SearchJob.user_id"`)}
+	}
+	if _, ok := sjc.mutation.Status(); !ok {
+		return &ValidationError{Name: "status", err: errors.New(`ent: missing required field "SearchJob.status"`)}
+	}
+	if v, ok := sjc.mutation.Status(); ok {
+		if err := searchjob.StatusValidator(v); err != nil {
+			return &ValidationError{Name: "status", err: fmt.Errorf(`ent: validator failed for field "SearchJob.status": %w`, err)}
+		}
+	}
+	if _, ok := sjc.mutation.Saved(); !ok {
+		return &ValidationError{Name: "saved", err: errors.New(`ent: missing required field "SearchJob.saved"`)}
+	}
+	if _, ok := sjc.mutation.Failed(); !ok {
+		return &ValidationError{Name: "failed", err: errors.New(`ent: missing required field "SearchJob.failed"`)}
+	}
+	if _, ok := sjc.mutation.Deduplicated(); !ok {
+		return &ValidationError{Name: "deduplicated", err: errors.New(`ent: missing required field "SearchJob.deduplicated"`)}
+	}
+	if _, ok := sjc.mutation.NextCandidate(); !ok {
+		return &ValidationError{Name: "next_candidate", err: errors.New(`ent: missing required field "SearchJob.next_candidate"`)}
+	}
+	if _, ok := sjc.mutation.UpdatedAt(); !ok {
+		return &ValidationError{Name: "updated_at", err: errors.New(`ent: missing required field "SearchJob.updated_at"`)}
+	}
+	if _, ok := sjc.mutation.UserID(); !ok {
+		return &ValidationError{Name: "user", err: errors.New(`ent: missing required edge "SearchJob.user"`)}
+	}
+	return nil
+}
+
+func (sjc *SearchJobCreate) sqlSave(ctx context.Context) (*SearchJob, error) {
+	if err := sjc.check(); err != nil {
+		return nil, err
+	}
+	_node, _spec := sjc.createSpec()
+	if err := sqlgraph.CreateNode(ctx, sjc.driver, _spec); err != nil {
+		if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return nil, err
+	}
+	id := _spec.ID.Value.(int64)
+	_node.ID = int(id)
+	sjc.mutation.id = &_node.ID
+	sjc.mutation.done = true
+	return _node, nil
+}
+
+func (sjc *SearchJobCreate) createSpec() (*SearchJob, *sqlgraph.CreateSpec) {
+	var (
+		_node = &SearchJob{config: sjc.config}
+		_spec = sqlgraph.NewCreateSpec(searchjob.Table, sqlgraph.NewFieldSpec(searchjob.FieldID, field.TypeInt))
+	)
+	_spec.OnConflict = sjc.conflict
+	if value, ok := sjc.mutation.Query(); ok {
+		_spec.SetField(searchjob.FieldQuery, field.TypeString, value)
+		_node.Query = value
+	}
+	if value, ok := sjc.mutation.MaxResults(); ok {
+		_spec.SetField(searchjob.FieldMaxResults, field.TypeInt, value)
+		_node.MaxResults = value
+	}
+	if value, ok := sjc.mutation.Status(); ok {
+		_spec.SetField(searchjob.FieldStatus, field.TypeEnum, value)
+		_node.Status = value
+	}
+	if value, ok := sjc.mutation.Saved(); ok {
+		_spec.SetField(searchjob.FieldSaved, field.TypeInt, value)
+		_node.Saved = value
+	}
+	if value, ok := sjc.mutation.Failed(); ok {
+		_spec.SetField(searchjob.FieldFailed, field.TypeInt, value)
+		_node.Failed = value
+	}
+	if value, ok := sjc.mutation.FileNames(); ok {
+		_spec.SetField(searchjob.FieldFileNames, field.TypeJSON, value)
+		_node.FileNames = value
+	}
+	if value, ok := sjc.mutation.Deduplicated(); ok {
+		_spec.SetField(searchjob.FieldDeduplicated, field.TypeInt, value)
+		_node.Deduplicated = value
+	}
+	if value, ok := sjc.mutation.DuplicateFileNames(); ok {
+		_spec.SetField(searchjob.FieldDuplicateFileNames, field.TypeJSON, value)
+		_node.DuplicateFileNames = value
+	}
+	if value, ok := sjc.mutation.DuplicateOf(); ok {
+		_spec.SetField(searchjob.FieldDuplicateOf, field.TypeJSON, value)
+		_node.DuplicateOf = value
+	}
+	if value, ok := sjc.mutation.CandidateUrls(); ok {
+		_spec.SetField(searchjob.FieldCandidateUrls, field.TypeJSON, value)
+		_node.CandidateUrls = value
+	}
+	if value, ok := sjc.mutation.CandidateThumbnailUrls(); ok {
+		_spec.SetField(searchjob.FieldCandidateThumbnailUrls, field.TypeJSON, value)
+		_node.CandidateThumbnailUrls = value
+	}
+	if value, ok := sjc.mutation.NextCandidate(); ok {
+		_spec.SetField(searchjob.FieldNextCandidate, field.TypeInt, value)
+		_node.NextCandidate = value
+	}
+	if value, ok := sjc.mutation.Runner(); ok {
+		_spec.SetField(searchjob.FieldRunner, field.TypeString, value)
+		_node.Runner = value
+	}
+	if value, ok := sjc.mutation.HeartbeatAt(); ok {
+		_spec.SetField(searchjob.FieldHeartbeatAt, field.TypeTime, value)
+		_node.HeartbeatAt = &value
+	}
+	if value, ok := sjc.mutation.Error(); ok {
+		_spec.SetField(searchjob.FieldError, field.TypeString, value)
+		_node.Error = value
+	}
+	if value, ok := sjc.mutation.CreatedAt(); ok {
+		_spec.SetField(searchjob.FieldCreatedAt, field.TypeTime, value)
+		_node.CreatedAt = &value
+	}
+	if value, ok := sjc.mutation.UpdatedAt(); ok {
+		_spec.SetField(searchjob.FieldUpdatedAt, field.TypeTime, value)
+		_node.UpdatedAt = value
+	}
+	if value, ok := sjc.mutation.StartedAt(); ok {
+		_spec.SetField(searchjob.FieldStartedAt, field.TypeTime, value)
+		_node.StartedAt = &value
+	}
+	if value, ok := sjc.mutation.FinishedAt(); ok {
+		_spec.SetField(searchjob.FieldFinishedAt, field.TypeTime, value)
+		_node.FinishedAt = &value
+	}
+	if nodes := sjc.mutation.UserIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   searchjob.UserTable,
+			Columns: []string{searchjob.UserColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(user.FieldID, field.TypeInt),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_node.UserID = nodes[0]
+		_spec.Edges = append(_spec.Edges, edge)
+	}
+	return _node, _spec
+}
+
+// OnConflict allows configuring the `ON CONFLICT` / `ON DUPLICATE KEY` clause
+// of the `INSERT` statement. For example:
+//
+//	client.SearchJob.Create().
+//		SetQuery(v).
+//		OnConflict(
+//			// Update the row with the new values
+//			// the was proposed for insertion.
+//			sql.ResolveWithNewValues(),
+//		).
+//		// Override some of the fields with custom
+//		// update values.
+//		Update(func(u *ent.SearchJobUpsert) {
+//			SetQuery(v+v).
+//		}).
+//		Exec(ctx)
+func (sjc *SearchJobCreate) OnConflict(opts ...sql.ConflictOption) *SearchJobUpsertOne {
+	sjc.conflict = opts
+	return &SearchJobUpsertOne{
+		create: sjc,
+	}
+}
+
+// OnConflictColumns calls `OnConflict` and configures the columns
+// as conflict target. Using this option is equivalent to using:
+//
+//	client.SearchJob.Create().
+//		OnConflict(sql.ConflictColumns(columns...)).
+//		Exec(ctx)
+func (sjc *SearchJobCreate) OnConflictColumns(columns ...string) *SearchJobUpsertOne {
+	sjc.conflict = append(sjc.conflict, sql.ConflictColumns(columns...))
+	return &SearchJobUpsertOne{
+		create: sjc,
+	}
+}
+
+type (
+	// SearchJobUpsertOne is the builder for "upsert"-ing
+	//  one SearchJob node.
+	SearchJobUpsertOne struct {
+		create *SearchJobCreate
+	}
+
+	// SearchJobUpsert is the "OnConflict" setter.
+	SearchJobUpsert struct {
+		*sql.UpdateSet
+	}
+)
+
+// SetQuery sets the "query" field.
+func (u *SearchJobUpsert) SetQuery(v string) *SearchJobUpsert {
+	u.Set(searchjob.FieldQuery, v)
+	return u
+}
+
+// UpdateQuery sets the "query" field to the value that was provided on create.
+func (u *SearchJobUpsert) UpdateQuery() *SearchJobUpsert {
+	u.SetExcluded(searchjob.FieldQuery)
+	return u
+}
+
+// SetMaxResults sets the "max_results" field.
+func (u *SearchJobUpsert) SetMaxResults(v int) *SearchJobUpsert {
+	u.Set(searchjob.FieldMaxResults, v)
+	return u
+}
+
+// UpdateMaxResults sets the "max_results" field to the value that was provided on create.
+func (u *SearchJobUpsert) UpdateMaxResults() *SearchJobUpsert {
+	u.SetExcluded(searchjob.FieldMaxResults)
+	return u
+}
+
+// AddMaxResults adds v to the "max_results" field.
+func (u *SearchJobUpsert) AddMaxResults(v int) *SearchJobUpsert {
+	u.Add(searchjob.FieldMaxResults, v)
+	return u
+}
+
+// SetUserID sets the "user_id" field.
+func (u *SearchJobUpsert) SetUserID(v int) *SearchJobUpsert {
+	u.Set(searchjob.FieldUserID, v)
+	return u
+}
+
+// UpdateUserID sets the "user_id" field to the value that was provided on create.
+func (u *SearchJobUpsert) UpdateUserID() *SearchJobUpsert {
+	u.SetExcluded(searchjob.FieldUserID)
+	return u
+}
+
+// SetStatus sets the "status" field.
+func (u *SearchJobUpsert) SetStatus(v searchjob.Status) *SearchJobUpsert {
+	u.Set(searchjob.FieldStatus, v)
+	return u
+}
+
+// UpdateStatus sets the "status" field to the value that was provided on create.
+func (u *SearchJobUpsert) UpdateStatus() *SearchJobUpsert {
+	u.SetExcluded(searchjob.FieldStatus)
+	return u
+}
+
+// SetSaved sets the "saved" field.
+func (u *SearchJobUpsert) SetSaved(v int) *SearchJobUpsert {
+	u.Set(searchjob.FieldSaved, v)
+	return u
+}
+
+// UpdateSaved sets the "saved" field to the value that was provided on create.
+func (u *SearchJobUpsert) UpdateSaved() *SearchJobUpsert {
+	u.SetExcluded(searchjob.FieldSaved)
+	return u
+}
+
+// AddSaved adds v to the "saved" field.
+func (u *SearchJobUpsert) AddSaved(v int) *SearchJobUpsert {
+	u.Add(searchjob.FieldSaved, v)
+	return u
+}
+
+// SetFailed sets the "failed" field.
+func (u *SearchJobUpsert) SetFailed(v int) *SearchJobUpsert {
+	u.Set(searchjob.FieldFailed, v)
+	return u
+}
+
+// UpdateFailed sets the "failed" field to the value that was provided on create.
+func (u *SearchJobUpsert) UpdateFailed() *SearchJobUpsert {
+	u.SetExcluded(searchjob.FieldFailed)
+	return u
+}
+
+// AddFailed adds v to the "failed" field.
+func (u *SearchJobUpsert) AddFailed(v int) *SearchJobUpsert {
+	u.Add(searchjob.FieldFailed, v)
+	return u
+}
+
+// SetFileNames sets the "file_names" field.
+func (u *SearchJobUpsert) SetFileNames(v []string) *SearchJobUpsert {
+	u.Set(searchjob.FieldFileNames, v)
+	return u
+}
+
+// UpdateFileNames sets the "file_names" field to the value that was provided on create.
+func (u *SearchJobUpsert) UpdateFileNames() *SearchJobUpsert {
+	u.SetExcluded(searchjob.FieldFileNames)
+	return u
+}
+
+// ClearFileNames clears the value of the "file_names" field.
+func (u *SearchJobUpsert) ClearFileNames() *SearchJobUpsert {
+	u.SetNull(searchjob.FieldFileNames)
+	return u
+}
+
+// SetDeduplicated sets the "deduplicated" field.
+func (u *SearchJobUpsert) SetDeduplicated(v int) *SearchJobUpsert {
+	u.Set(searchjob.FieldDeduplicated, v)
+	return u
+}
+
+// UpdateDeduplicated sets the "deduplicated" field to the value that was provided on create.
+func (u *SearchJobUpsert) UpdateDeduplicated() *SearchJobUpsert {
+	u.SetExcluded(searchjob.FieldDeduplicated)
+	return u
+}
+
+// AddDeduplicated adds v to the "deduplicated" field.
+func (u *SearchJobUpsert) AddDeduplicated(v int) *SearchJobUpsert {
+	u.Add(searchjob.FieldDeduplicated, v)
+	return u
+}
+
+// SetDuplicateFileNames sets the "duplicate_file_names" field.
+func (u *SearchJobUpsert) SetDuplicateFileNames(v []string) *SearchJobUpsert {
+	u.Set(searchjob.FieldDuplicateFileNames, v)
+	return u
+}
+
+// UpdateDuplicateFileNames sets the "duplicate_file_names" field to the value that was provided on create.
+func (u *SearchJobUpsert) UpdateDuplicateFileNames() *SearchJobUpsert {
+	u.SetExcluded(searchjob.FieldDuplicateFileNames)
+	return u
+}
+
+// ClearDuplicateFileNames clears the value of the "duplicate_file_names" field.
+func (u *SearchJobUpsert) ClearDuplicateFileNames() *SearchJobUpsert {
+	u.SetNull(searchjob.FieldDuplicateFileNames)
+	return u
+}
+
+// SetDuplicateOf sets the "duplicate_of" field.
+func (u *SearchJobUpsert) SetDuplicateOf(v []string) *SearchJobUpsert {
+	u.Set(searchjob.FieldDuplicateOf, v)
+	return u
+}
+
+// UpdateDuplicateOf sets the "duplicate_of" field to the value that was provided on create.
+func (u *SearchJobUpsert) UpdateDuplicateOf() *SearchJobUpsert {
+	u.SetExcluded(searchjob.FieldDuplicateOf)
+	return u
+}
+
+// ClearDuplicateOf clears the value of the "duplicate_of" field.
+func (u *SearchJobUpsert) ClearDuplicateOf() *SearchJobUpsert {
+	u.SetNull(searchjob.FieldDuplicateOf)
+	return u
+}
+
+// SetCandidateUrls sets the "candidate_urls" field.
+func (u *SearchJobUpsert) SetCandidateUrls(v []string) *SearchJobUpsert {
+	u.Set(searchjob.FieldCandidateUrls, v)
+	return u
+}
+
+// UpdateCandidateUrls sets the "candidate_urls" field to the value that was provided on create.
+func (u *SearchJobUpsert) UpdateCandidateUrls() *SearchJobUpsert {
+	u.SetExcluded(searchjob.FieldCandidateUrls)
+	return u
+}
+
+// ClearCandidateUrls clears the value of the "candidate_urls" field.
+func (u *SearchJobUpsert) ClearCandidateUrls() *SearchJobUpsert {
+	u.SetNull(searchjob.FieldCandidateUrls)
+	return u
+}
+
+// SetCandidateThumbnailUrls sets the "candidate_thumbnail_urls" field.
+func (u *SearchJobUpsert) SetCandidateThumbnailUrls(v []string) *SearchJobUpsert {
+	u.Set(searchjob.FieldCandidateThumbnailUrls, v)
+	return u
+}
+
+// UpdateCandidateThumbnailUrls sets the "candidate_thumbnail_urls" field to the value that was provided on create.
+func (u *SearchJobUpsert) UpdateCandidateThumbnailUrls() *SearchJobUpsert {
+	u.SetExcluded(searchjob.FieldCandidateThumbnailUrls)
+	return u
+}
+
+// ClearCandidateThumbnailUrls clears the value of the "candidate_thumbnail_urls" field.
+func (u *SearchJobUpsert) ClearCandidateThumbnailUrls() *SearchJobUpsert {
+	u.SetNull(searchjob.FieldCandidateThumbnailUrls)
+	return u
+}
+
+// SetNextCandidate sets the "next_candidate" field.
+func (u *SearchJobUpsert) SetNextCandidate(v int) *SearchJobUpsert {
+	u.Set(searchjob.FieldNextCandidate, v)
+	return u
+}
+
+// UpdateNextCandidate sets the "next_candidate" field to the value that was provided on create.
+func (u *SearchJobUpsert) UpdateNextCandidate() *SearchJobUpsert {
+	u.SetExcluded(searchjob.FieldNextCandidate)
+	return u
+}
+
+// AddNextCandidate adds v to the "next_candidate" field.
+func (u *SearchJobUpsert) AddNextCandidate(v int) *SearchJobUpsert {
+	u.Add(searchjob.FieldNextCandidate, v)
+	return u
+}
+
+// SetRunner sets the "runner" field.
+func (u *SearchJobUpsert) SetRunner(v string) *SearchJobUpsert {
+	u.Set(searchjob.FieldRunner, v)
+	return u
+}
+
+// UpdateRunner sets the "runner" field to the value that was provided on create.
+func (u *SearchJobUpsert) UpdateRunner() *SearchJobUpsert {
+	u.SetExcluded(searchjob.FieldRunner)
+	return u
+}
+
+// ClearRunner clears the value of the "runner" field.
+func (u *SearchJobUpsert) ClearRunner() *SearchJobUpsert {
+	u.SetNull(searchjob.FieldRunner)
+	return u
+}
+
+// SetHeartbeatAt sets the "heartbeat_at" field.
+func (u *SearchJobUpsert) SetHeartbeatAt(v time.Time) *SearchJobUpsert {
+	u.Set(searchjob.FieldHeartbeatAt, v)
+	return u
+}
+
+// UpdateHeartbeatAt sets the "heartbeat_at" field to the value that was provided on create.
+func (u *SearchJobUpsert) UpdateHeartbeatAt() *SearchJobUpsert {
+	u.SetExcluded(searchjob.FieldHeartbeatAt)
+	return u
+}
+
+// ClearHeartbeatAt clears the value of the "heartbeat_at" field.
+func (u *SearchJobUpsert) ClearHeartbeatAt() *SearchJobUpsert {
+	u.SetNull(searchjob.FieldHeartbeatAt)
+	return u
+}
+
+// SetError sets the "error" field.
+func (u *SearchJobUpsert) SetError(v string) *SearchJobUpsert {
+	u.Set(searchjob.FieldError, v)
+	return u
+}
+
+// UpdateError sets the "error" field to the value that was provided on create.
+func (u *SearchJobUpsert) UpdateError() *SearchJobUpsert {
+	u.SetExcluded(searchjob.FieldError)
+	return u
+}
+
+// ClearError clears the value of the "error" field.
+func (u *SearchJobUpsert) ClearError() *SearchJobUpsert {
+	u.SetNull(searchjob.FieldError)
+	return u
+}
+
+// SetCreatedAt sets the "created_at" field.
+func (u *SearchJobUpsert) SetCreatedAt(v time.Time) *SearchJobUpsert {
+	u.Set(searchjob.FieldCreatedAt, v)
+	return u
+}
+
+// UpdateCreatedAt sets the "created_at" field to the value that was provided on create.
+func (u *SearchJobUpsert) UpdateCreatedAt() *SearchJobUpsert {
+	u.SetExcluded(searchjob.FieldCreatedAt)
+	return u
+}
+
+// ClearCreatedAt clears the value of the "created_at" field.
+func (u *SearchJobUpsert) ClearCreatedAt() *SearchJobUpsert {
+	u.SetNull(searchjob.FieldCreatedAt)
+	return u
+}
+
+// SetUpdatedAt sets the "updated_at" field.
+func (u *SearchJobUpsert) SetUpdatedAt(v time.Time) *SearchJobUpsert {
+	u.Set(searchjob.FieldUpdatedAt, v)
+	return u
+}
+
+// UpdateUpdatedAt sets the "updated_at" field to the value that was provided on create.
+func (u *SearchJobUpsert) UpdateUpdatedAt() *SearchJobUpsert {
+	u.SetExcluded(searchjob.FieldUpdatedAt)
+	return u
+}
+
+// SetStartedAt sets the "started_at" field.
+func (u *SearchJobUpsert) SetStartedAt(v time.Time) *SearchJobUpsert {
+	u.Set(searchjob.FieldStartedAt, v)
+	return u
+}
+
+// UpdateStartedAt sets the "started_at" field to the value that was provided on create.
+func (u *SearchJobUpsert) UpdateStartedAt() *SearchJobUpsert {
+	u.SetExcluded(searchjob.FieldStartedAt)
+	return u
+}
+
+// ClearStartedAt clears the value of the "started_at" field.
+func (u *SearchJobUpsert) ClearStartedAt() *SearchJobUpsert {
+	u.SetNull(searchjob.FieldStartedAt)
+	return u
+}
+
+// SetFinishedAt sets the "finished_at" field.
+func (u *SearchJobUpsert) SetFinishedAt(v time.Time) *SearchJobUpsert {
+	u.Set(searchjob.FieldFinishedAt, v)
+	return u
+}
+
+// UpdateFinishedAt sets the "finished_at" field to the value that was provided on create.
+func (u *SearchJobUpsert) UpdateFinishedAt() *SearchJobUpsert {
+	u.SetExcluded(searchjob.FieldFinishedAt)
+	return u
+}
+
+// ClearFinishedAt clears the value of the "finished_at" field.
+func (u *SearchJobUpsert) ClearFinishedAt() *SearchJobUpsert {
+	u.SetNull(searchjob.FieldFinishedAt)
+	return u
+}
+
+// UpdateNewValues updates the mutable fields using the new values that were set on create.
+// Using this option is equivalent to using:
+//
+//	client.SearchJob.Create().
+//		OnConflict(
+//			sql.ResolveWithNewValues(),
+//		).
+//		Exec(ctx)
+func (u *SearchJobUpsertOne) UpdateNewValues() *SearchJobUpsertOne {
+	u.create.conflict = append(u.create.conflict, sql.ResolveWithNewValues())
+	return u
+}
+
+// Ignore sets each column to itself in case of conflict.
+// Using this option is equivalent to using:
+//
+//	client.SearchJob.Create().
+//	    OnConflict(sql.ResolveWithIgnore()).
+//	    Exec(ctx)
+func (u *SearchJobUpsertOne) Ignore() *SearchJobUpsertOne {
+	u.create.conflict = append(u.create.conflict, sql.ResolveWithIgnore())
+	return u
+}
+
+// DoNothing configures the conflict_action to `DO NOTHING`.
+// Supported only by SQLite and PostgreSQL.
+func (u *SearchJobUpsertOne) DoNothing() *SearchJobUpsertOne {
+	u.create.conflict = append(u.create.conflict, sql.DoNothing())
+	return u
+}
+
+// Update allows overriding fields `UPDATE` values. See the SearchJobCreate.OnConflict
+// documentation for more info.
+func (u *SearchJobUpsertOne) Update(set func(*SearchJobUpsert)) *SearchJobUpsertOne {
+	u.create.conflict = append(u.create.conflict, sql.ResolveWith(func(update *sql.UpdateSet) {
+		set(&SearchJobUpsert{UpdateSet: update})
+	}))
+	return u
+}
+
+// SetQuery sets the "query" field.
+func (u *SearchJobUpsertOne) SetQuery(v string) *SearchJobUpsertOne {
+	return u.Update(func(s *SearchJobUpsert) {
+		s.SetQuery(v)
+	})
+}
+
+// UpdateQuery sets the "query" field to the value that was provided on create.
+func (u *SearchJobUpsertOne) UpdateQuery() *SearchJobUpsertOne {
+	return u.Update(func(s *SearchJobUpsert) {
+		s.UpdateQuery()
+	})
+}
+
+// SetMaxResults sets the "max_results" field.
+func (u *SearchJobUpsertOne) SetMaxResults(v int) *SearchJobUpsertOne {
+	return u.Update(func(s *SearchJobUpsert) {
+		s.SetMaxResults(v)
+	})
+}
+
+// AddMaxResults adds v to the "max_results" field.
+func (u *SearchJobUpsertOne) AddMaxResults(v int) *SearchJobUpsertOne {
+	return u.Update(func(s *SearchJobUpsert) {
+		s.AddMaxResults(v)
+	})
+}
+
+// UpdateMaxResults sets the "max_results" field to the value that was provided on create.
+func (u *SearchJobUpsertOne) UpdateMaxResults() *SearchJobUpsertOne {
+	return u.Update(func(s *SearchJobUpsert) {
+		s.UpdateMaxResults()
+	})
+}
+
+// SetUserID sets the "user_id" field.
+func (u *SearchJobUpsertOne) SetUserID(v int) *SearchJobUpsertOne {
+	return u.Update(func(s *SearchJobUpsert) {
+		s.SetUserID(v)
+	})
+}
+
+// UpdateUserID sets the "user_id" field to the value that was provided on create.
+func (u *SearchJobUpsertOne) UpdateUserID() *SearchJobUpsertOne {
+	return u.Update(func(s *SearchJobUpsert) {
+		s.UpdateUserID()
+	})
+}
+
+// SetStatus sets the "status" field.
+func (u *SearchJobUpsertOne) SetStatus(v searchjob.Status) *SearchJobUpsertOne {
+	return u.Update(func(s *SearchJobUpsert) {
+		s.SetStatus(v)
+	})
+}
+
+// UpdateStatus sets the "status" field to the value that was provided on create.
+func (u *SearchJobUpsertOne) UpdateStatus() *SearchJobUpsertOne {
+	return u.Update(func(s *SearchJobUpsert) {
+		s.UpdateStatus()
+	})
+}
+
+// SetSaved sets the "saved" field.
+func (u *SearchJobUpsertOne) SetSaved(v int) *SearchJobUpsertOne {
+	return u.Update(func(s *SearchJobUpsert) {
+		s.SetSaved(v)
+	})
+}
+
+// AddSaved adds v to the "saved" field.
+func (u *SearchJobUpsertOne) AddSaved(v int) *SearchJobUpsertOne {
+	return u.Update(func(s *SearchJobUpsert) {
+		s.AddSaved(v)
+	})
+}
+
+// UpdateSaved sets the "saved" field to the value that was provided on create.
+func (u *SearchJobUpsertOne) UpdateSaved() *SearchJobUpsertOne {
+	return u.Update(func(s *SearchJobUpsert) {
+		s.UpdateSaved()
+	})
+}
+
+// SetFailed sets the "failed" field.
+func (u *SearchJobUpsertOne) SetFailed(v int) *SearchJobUpsertOne {
+	return u.Update(func(s *SearchJobUpsert) {
+		s.SetFailed(v)
+	})
+}
+
+// AddFailed adds v to the "failed" field.
+func (u *SearchJobUpsertOne) AddFailed(v int) *SearchJobUpsertOne {
+	return u.Update(func(s *SearchJobUpsert) {
+		s.AddFailed(v)
+	})
+}
+
+// UpdateFailed sets the "failed" field to the value that was provided on create.
+func (u *SearchJobUpsertOne) UpdateFailed() *SearchJobUpsertOne {
+	return u.Update(func(s *SearchJobUpsert) {
+		s.UpdateFailed()
+	})
+}
+
+// SetFileNames sets the "file_names" field.
+func (u *SearchJobUpsertOne) SetFileNames(v []string) *SearchJobUpsertOne {
+	return u.Update(func(s *SearchJobUpsert) {
+		s.SetFileNames(v)
+	})
+}
+
+// UpdateFileNames sets the "file_names" field to the value that was provided on create.
+func (u *SearchJobUpsertOne) UpdateFileNames() *SearchJobUpsertOne {
+	return u.Update(func(s *SearchJobUpsert) {
+		s.UpdateFileNames()
+	})
+}
+
+// ClearFileNames clears the value of the "file_names" field.
+func (u *SearchJobUpsertOne) ClearFileNames() *SearchJobUpsertOne {
+	return u.Update(func(s *SearchJobUpsert) {
+		s.ClearFileNames()
+	})
+}
+
+// SetDeduplicated sets the "deduplicated" field.
+func (u *SearchJobUpsertOne) SetDeduplicated(v int) *SearchJobUpsertOne {
+	return u.Update(func(s *SearchJobUpsert) {
+		s.SetDeduplicated(v)
+	})
+}
+
+// AddDeduplicated adds v to the "deduplicated" field.
+func (u *SearchJobUpsertOne) AddDeduplicated(v int) *SearchJobUpsertOne {
+	return u.Update(func(s *SearchJobUpsert) {
+		s.AddDeduplicated(v)
+	})
+}
+
+// UpdateDeduplicated sets the "deduplicated" field to the value that was provided on create.
+func (u *SearchJobUpsertOne) UpdateDeduplicated() *SearchJobUpsertOne {
+	return u.Update(func(s *SearchJobUpsert) {
+		s.UpdateDeduplicated()
+	})
+}
+
+// SetDuplicateFileNames sets the "duplicate_file_names" field.
+func (u *SearchJobUpsertOne) SetDuplicateFileNames(v []string) *SearchJobUpsertOne {
+	return u.Update(func(s *SearchJobUpsert) {
+		s.SetDuplicateFileNames(v)
+	})
+}
+
+// UpdateDuplicateFileNames sets the "duplicate_file_names" field to the value that was provided on create.
+func (u *SearchJobUpsertOne) UpdateDuplicateFileNames() *SearchJobUpsertOne {
+	return u.Update(func(s *SearchJobUpsert) {
+		s.UpdateDuplicateFileNames()
+	})
+}
+
+// ClearDuplicateFileNames clears the value of the "duplicate_file_names" field.
+func (u *SearchJobUpsertOne) ClearDuplicateFileNames() *SearchJobUpsertOne {
+	return u.Update(func(s *SearchJobUpsert) {
+		s.ClearDuplicateFileNames()
+	})
+}
+
+// SetDuplicateOf sets the "duplicate_of" field.
+func (u *SearchJobUpsertOne) SetDuplicateOf(v []string) *SearchJobUpsertOne {
+	return u.Update(func(s *SearchJobUpsert) {
+		s.SetDuplicateOf(v)
+	})
+}
+
+// UpdateDuplicateOf sets the "duplicate_of" field to the value that was provided on create.
+func (u *SearchJobUpsertOne) UpdateDuplicateOf() *SearchJobUpsertOne {
+	return u.Update(func(s *SearchJobUpsert) {
+		s.UpdateDuplicateOf()
+	})
+}
+
+// ClearDuplicateOf clears the value of the "duplicate_of" field.
+func (u *SearchJobUpsertOne) ClearDuplicateOf() *SearchJobUpsertOne {
+	return u.Update(func(s *SearchJobUpsert) {
+		s.ClearDuplicateOf()
+	})
+}
+
+// SetCandidateUrls sets the "candidate_urls" field.
+func (u *SearchJobUpsertOne) SetCandidateUrls(v []string) *SearchJobUpsertOne {
+	return u.Update(func(s *SearchJobUpsert) {
+		s.SetCandidateUrls(v)
+	})
+}
+
+// UpdateCandidateUrls sets the "candidate_urls" field to the value that was provided on create.
+func (u *SearchJobUpsertOne) UpdateCandidateUrls() *SearchJobUpsertOne {
+	return u.Update(func(s *SearchJobUpsert) {
+		s.UpdateCandidateUrls()
+	})
+}
+
+// ClearCandidateUrls clears the value of the "candidate_urls" field.
+func (u *SearchJobUpsertOne) ClearCandidateUrls() *SearchJobUpsertOne {
+	return u.Update(func(s *SearchJobUpsert) {
+		s.ClearCandidateUrls()
+	})
+}
+
+// SetCandidateThumbnailUrls sets the "candidate_thumbnail_urls" field.
+func (u *SearchJobUpsertOne) SetCandidateThumbnailUrls(v []string) *SearchJobUpsertOne {
+	return u.Update(func(s *SearchJobUpsert) {
+		s.SetCandidateThumbnailUrls(v)
+	})
+}
+
+// UpdateCandidateThumbnailUrls sets the "candidate_thumbnail_urls" field to the value that was provided on create.
+func (u *SearchJobUpsertOne) UpdateCandidateThumbnailUrls() *SearchJobUpsertOne {
+	return u.Update(func(s *SearchJobUpsert) {
+		s.UpdateCandidateThumbnailUrls()
+	})
+}
+
+// ClearCandidateThumbnailUrls clears the value of the "candidate_thumbnail_urls" field.
+func (u *SearchJobUpsertOne) ClearCandidateThumbnailUrls() *SearchJobUpsertOne {
+	return u.Update(func(s *SearchJobUpsert) {
+		s.ClearCandidateThumbnailUrls()
+	})
+}
+
+// SetNextCandidate sets the "next_candidate" field.
+func (u *SearchJobUpsertOne) SetNextCandidate(v int) *SearchJobUpsertOne {
+	return u.Update(func(s *SearchJobUpsert) {
+		s.SetNextCandidate(v)
+	})
+}
+
+// AddNextCandidate adds v to the "next_candidate" field.
+func (u *SearchJobUpsertOne) AddNextCandidate(v int) *SearchJobUpsertOne {
+	return u.Update(func(s *SearchJobUpsert) {
+		s.AddNextCandidate(v)
+	})
+}
+
+// UpdateNextCandidate sets the "next_candidate" field to the value that was provided on create.
+func (u *SearchJobUpsertOne) UpdateNextCandidate() *SearchJobUpsertOne {
+	return u.Update(func(s *SearchJobUpsert) {
+		s.UpdateNextCandidate()
+	})
+}
+
+// SetRunner sets the "runner" field.
+func (u *SearchJobUpsertOne) SetRunner(v string) *SearchJobUpsertOne {
+	return u.Update(func(s *SearchJobUpsert) {
+		s.SetRunner(v)
+	})
+}
+
+// UpdateRunner sets the "runner" field to the value that was provided on create.
+func (u *SearchJobUpsertOne) UpdateRunner() *SearchJobUpsertOne {
+	return u.Update(func(s *SearchJobUpsert) {
+		s.UpdateRunner()
+	})
+}
+
+// ClearRunner clears the value of the "runner" field.
+func (u *SearchJobUpsertOne) ClearRunner() *SearchJobUpsertOne {
+	return u.Update(func(s *SearchJobUpsert) {
+		s.ClearRunner()
+	})
+}
+
+// SetHeartbeatAt sets the "heartbeat_at" field.
+func (u *SearchJobUpsertOne) SetHeartbeatAt(v time.Time) *SearchJobUpsertOne {
+	return u.Update(func(s *SearchJobUpsert) {
+		s.SetHeartbeatAt(v)
+	})
+}
+
+// UpdateHeartbeatAt sets the "heartbeat_at" field to the value that was provided on create.
+func (u *SearchJobUpsertOne) UpdateHeartbeatAt() *SearchJobUpsertOne {
+	return u.Update(func(s *SearchJobUpsert) {
+		s.UpdateHeartbeatAt()
+	})
+}
+
+// ClearHeartbeatAt clears the value of the "heartbeat_at" field.
+func (u *SearchJobUpsertOne) ClearHeartbeatAt() *SearchJobUpsertOne {
+	return u.Update(func(s *SearchJobUpsert) {
+		s.ClearHeartbeatAt()
+	})
+}
+
+// SetError sets the "error" field.
+func (u *SearchJobUpsertOne) SetError(v string) *SearchJobUpsertOne {
+	return u.Update(func(s *SearchJobUpsert) {
+		s.SetError(v)
+	})
+}
+
+// UpdateError sets the "error" field to the value that was provided on create.
+func (u *SearchJobUpsertOne) UpdateError() *SearchJobUpsertOne {
+	return u.Update(func(s *SearchJobUpsert) {
+		s.UpdateError()
+	})
+}
+
+// ClearError clears the value of the "error" field.
+func (u *SearchJobUpsertOne) ClearError() *SearchJobUpsertOne {
+	return u.Update(func(s *SearchJobUpsert) {
+		s.ClearError()
+	})
+}
+
+// SetCreatedAt sets the "created_at" field.
+func (u *SearchJobUpsertOne) SetCreatedAt(v time.Time) *SearchJobUpsertOne {
+	return u.Update(func(s *SearchJobUpsert) {
+		s.SetCreatedAt(v)
+	})
+}
+
+// UpdateCreatedAt sets the "created_at" field to the value that was provided on create.
+func (u *SearchJobUpsertOne) UpdateCreatedAt() *SearchJobUpsertOne {
+	return u.Update(func(s *SearchJobUpsert) {
+		s.UpdateCreatedAt()
+	})
+}
+
+// ClearCreatedAt clears the value of the "created_at" field.
+func (u *SearchJobUpsertOne) ClearCreatedAt() *SearchJobUpsertOne {
+	return u.Update(func(s *SearchJobUpsert) {
+		s.ClearCreatedAt()
+	})
+}
+
+// SetUpdatedAt sets the "updated_at" field.
+func (u *SearchJobUpsertOne) SetUpdatedAt(v time.Time) *SearchJobUpsertOne {
+	return u.Update(func(s *SearchJobUpsert) {
+		s.SetUpdatedAt(v)
+	})
+}
+
+// UpdateUpdatedAt sets the "updated_at" field to the value that was provided on create.
+func (u *SearchJobUpsertOne) UpdateUpdatedAt() *SearchJobUpsertOne {
+	return u.Update(func(s *SearchJobUpsert) {
+		s.UpdateUpdatedAt()
+	})
+}
+
+// SetStartedAt sets the "started_at" field.
+func (u *SearchJobUpsertOne) SetStartedAt(v time.Time) *SearchJobUpsertOne {
+	return u.Update(func(s *SearchJobUpsert) {
+		s.SetStartedAt(v)
+	})
+}
+
+// UpdateStartedAt sets the "started_at" field to the value that was provided on create.
+func (u *SearchJobUpsertOne) UpdateStartedAt() *SearchJobUpsertOne {
+	return u.Update(func(s *SearchJobUpsert) {
+		s.UpdateStartedAt()
+	})
+}
+
+// ClearStartedAt clears the value of the "started_at" field.
+func (u *SearchJobUpsertOne) ClearStartedAt() *SearchJobUpsertOne {
+	return u.Update(func(s *SearchJobUpsert) {
+		s.ClearStartedAt()
+	})
+}
+
+// SetFinishedAt sets the "finished_at" field.
+func (u *SearchJobUpsertOne) SetFinishedAt(v time.Time) *SearchJobUpsertOne {
+	return u.Update(func(s *SearchJobUpsert) {
+		s.SetFinishedAt(v)
+	})
+}
+
+// UpdateFinishedAt sets the "finished_at" field to the value that was provided on create.
+func (u *SearchJobUpsertOne) UpdateFinishedAt() *SearchJobUpsertOne {
+	return u.Update(func(s *SearchJobUpsert) {
+		s.UpdateFinishedAt()
+	})
+}
+
+// ClearFinishedAt clears the value of the "finished_at" field.
+func (u *SearchJobUpsertOne) ClearFinishedAt() *SearchJobUpsertOne {
+	return u.Update(func(s *SearchJobUpsert) {
+		s.ClearFinishedAt()
+	})
+}
+
+// Exec executes the query.
+func (u *SearchJobUpsertOne) Exec(ctx context.Context) error {
+	if len(u.create.conflict) == 0 {
+		return errors.New("ent: missing options for SearchJobCreate.OnConflict")
+	}
+	return u.create.Exec(ctx)
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (u *SearchJobUpsertOne) ExecX(ctx context.Context) {
+	if err := u.create.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// Exec executes the UPSERT query and returns the inserted/updated ID.
+func (u *SearchJobUpsertOne) ID(ctx context.Context) (id int, err error) {
+	node, err := u.create.Save(ctx)
+	if err != nil {
+		return id, err
+	}
+	return node.ID, nil
+}
+
+// IDX is like ID, but panics if an error occurs.
+func (u *SearchJobUpsertOne) IDX(ctx context.Context) int {
+	id, err := u.ID(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return id
+}
+
+// SearchJobCreateBulk is the builder for creating many SearchJob entities in bulk.
+type SearchJobCreateBulk struct {
+	config
+	err      error
+	builders []*SearchJobCreate
+	conflict []sql.ConflictOption
+}
+
+// Save creates the SearchJob entities in the database.
+func (sjcb *SearchJobCreateBulk) Save(ctx context.Context) ([]*SearchJob, error) {
+	if sjcb.err != nil {
+		return nil, sjcb.err
+	}
+	specs := make([]*sqlgraph.CreateSpec, len(sjcb.builders))
+	nodes := make([]*SearchJob, len(sjcb.builders))
+	mutators := make([]Mutator, len(sjcb.builders))
+	for i := range sjcb.builders {
+		func(i int, root context.Context) {
+			builder := sjcb.builders[i]
+			builder.defaults()
+			var mut Mutator = MutateFunc(func(ctx context.Context, m Mutation) (Value, error) {
+				mutation, ok := m.(*SearchJobMutation)
+				if !ok {
+					return nil, fmt.Errorf("unexpected mutation type %T", m)
+				}
+				if err := builder.check(); err != nil {
+					return nil, err
+				}
+				builder.mutation = mutation
+				var err error
+				nodes[i], specs[i] = builder.createSpec()
+				if i < len(mutators)-1 {
+					_, err = mutators[i+1].Mutate(root, sjcb.builders[i+1].mutation)
+				} else {
+					spec := &sqlgraph.BatchCreateSpec{Nodes: specs}
+					spec.OnConflict = sjcb.conflict
+					// Invoke the actual operation on the latest mutation in the chain.
+					if err = sqlgraph.BatchCreate(ctx, sjcb.driver, spec); err != nil {
+						if sqlgraph.IsConstraintError(err) {
+							err = &ConstraintError{msg: err.Error(), wrap: err}
+						}
+					}
+				}
+				if err != nil {
+					return nil, err
+				}
+				mutation.id = &nodes[i].ID
+				if specs[i].ID.Value != nil {
+					id := specs[i].ID.Value.(int64)
+					nodes[i].ID = int(id)
+				}
+				mutation.done = true
+				return nodes[i], nil
+			})
+			for i := len(builder.hooks) - 1; i >= 0; i-- {
+				mut = builder.hooks[i](mut)
+			}
+			mutators[i] = mut
+		}(i, ctx)
+	}
+	if len(mutators) > 0 {
+		if _, err := mutators[0].Mutate(ctx, sjcb.builders[0].mutation); err != nil {
+			return nil, err
+		}
+	}
+	return nodes, nil
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (sjcb *SearchJobCreateBulk) SaveX(ctx context.Context) []*SearchJob {
+	v, err := sjcb.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
+
+// Exec executes the query.
+func (sjcb *SearchJobCreateBulk) Exec(ctx context.Context) error {
+	_, err := sjcb.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (sjcb *SearchJobCreateBulk) ExecX(ctx context.Context) {
+	if err := sjcb.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// OnConflict allows configuring the `ON CONFLICT` / `ON DUPLICATE KEY` clause
+// of the `INSERT` statement. For example:
+//
+//	client.SearchJob.CreateBulk(builders...).
+//		OnConflict(
+//			// Update the row with the new values
+//			// the was proposed for insertion.
+//			sql.ResolveWithNewValues(),
+//		).
+//		// Override some of the fields with custom
+//		// update values.
+//		Update(func(u *ent.SearchJobUpsert) {
+//			SetQuery(v+v).
+//		}).
+//		Exec(ctx)
+func (sjcb *SearchJobCreateBulk) OnConflict(opts ...sql.ConflictOption) *SearchJobUpsertBulk {
+	sjcb.conflict = opts
+	return &SearchJobUpsertBulk{
+		create: sjcb,
+	}
+}
+
+// OnConflictColumns calls `OnConflict` and configures the columns
+// as conflict target. Using this option is equivalent to using:
+//
+//	client.SearchJob.Create().
+//		OnConflict(sql.ConflictColumns(columns...)).
+//		Exec(ctx)
+func (sjcb *SearchJobCreateBulk) OnConflictColumns(columns ...string) *SearchJobUpsertBulk {
+	sjcb.conflict = append(sjcb.conflict, sql.ConflictColumns(columns...))
+	return &SearchJobUpsertBulk{
+		create: sjcb,
+	}
+}
+
+// SearchJobUpsertBulk is the builder for "upsert"-ing
+// a bulk of SearchJob nodes.
+type SearchJobUpsertBulk struct {
+	create *SearchJobCreateBulk
+}
+
+// UpdateNewValues updates the mutable fields using the new values that
+// were set on create. Using this option is equivalent to using:
+//
+//	client.SearchJob.Create().
+//		OnConflict(
+//			sql.ResolveWithNewValues(),
+//		).
+//		Exec(ctx)
+func (u *SearchJobUpsertBulk) UpdateNewValues() *SearchJobUpsertBulk {
+	u.create.conflict = append(u.create.conflict, sql.ResolveWithNewValues())
+	return u
+}
+
+// Ignore sets each column to itself in case of conflict.
+// Using this option is equivalent to using:
+//
+//	client.SearchJob.Create().
+//		OnConflict(sql.ResolveWithIgnore()).
+//		Exec(ctx)
+func (u *SearchJobUpsertBulk) Ignore() *SearchJobUpsertBulk {
+	u.create.conflict = append(u.create.conflict, sql.ResolveWithIgnore())
+	return u
+}
+
+// DoNothing configures the conflict_action to `DO NOTHING`.
+// Supported only by SQLite and PostgreSQL.
+func (u *SearchJobUpsertBulk) DoNothing() *SearchJobUpsertBulk {
+	u.create.conflict = append(u.create.conflict, sql.DoNothing())
+	return u
+}
+
+// Update allows overriding fields `UPDATE` values. See the SearchJobCreateBulk.OnConflict
+// documentation for more info.
+func (u *SearchJobUpsertBulk) Update(set func(*SearchJobUpsert)) *SearchJobUpsertBulk {
+	u.create.conflict = append(u.create.conflict, sql.ResolveWith(func(update *sql.UpdateSet) {
+		set(&SearchJobUpsert{UpdateSet: update})
+	}))
+	return u
+}
+
+// SetQuery sets the "query" field.
+func (u *SearchJobUpsertBulk) SetQuery(v string) *SearchJobUpsertBulk {
+	return u.Update(func(s *SearchJobUpsert) {
+		s.SetQuery(v)
+	})
+}
+
+// UpdateQuery sets the "query" field to the value that was provided on create.
+func (u *SearchJobUpsertBulk) UpdateQuery() *SearchJobUpsertBulk {
+	return u.Update(func(s *SearchJobUpsert) {
+		s.UpdateQuery()
+	})
+}
+
+// SetMaxResults sets the "max_results" field.
+func (u *SearchJobUpsertBulk) SetMaxResults(v int) *SearchJobUpsertBulk {
+	return u.Update(func(s *SearchJobUpsert) {
+		s.SetMaxResults(v)
+	})
+}
+
+// AddMaxResults adds v to the "max_results" field.
+func (u *SearchJobUpsertBulk) AddMaxResults(v int) *SearchJobUpsertBulk {
+	return u.Update(func(s *SearchJobUpsert) {
+		s.AddMaxResults(v)
+	})
+}
+
+// UpdateMaxResults sets the "max_results" field to the value that was provided on create.
+func (u *SearchJobUpsertBulk) UpdateMaxResults() *SearchJobUpsertBulk {
+	return u.Update(func(s *SearchJobUpsert) {
+		s.UpdateMaxResults()
+	})
+}
+
+// SetUserID sets the "user_id" field.
+func (u *SearchJobUpsertBulk) SetUserID(v int) *SearchJobUpsertBulk {
+	return u.Update(func(s *SearchJobUpsert) {
+		s.SetUserID(v)
+	})
+}
+
+// UpdateUserID sets the "user_id" field to the value that was provided on create.
+func (u *SearchJobUpsertBulk) UpdateUserID() *SearchJobUpsertBulk {
+	return u.Update(func(s *SearchJobUpsert) {
+		s.UpdateUserID()
+	})
+}
+
+// SetStatus sets the "status" field.
+func (u *SearchJobUpsertBulk) SetStatus(v searchjob.Status) *SearchJobUpsertBulk {
+	return u.Update(func(s *SearchJobUpsert) {
+		s.SetStatus(v)
+	})
+}
+
+// UpdateStatus sets the "status" field to the value that was provided on create.
+func (u *SearchJobUpsertBulk) UpdateStatus() *SearchJobUpsertBulk {
+	return u.Update(func(s *SearchJobUpsert) {
+		s.UpdateStatus()
+	})
+}
+
+// SetSaved sets the "saved" field.
+func (u *SearchJobUpsertBulk) SetSaved(v int) *SearchJobUpsertBulk {
+	return u.Update(func(s *SearchJobUpsert) {
+		s.SetSaved(v)
+	})
+}
+
+// AddSaved adds v to the "saved" field.
+func (u *SearchJobUpsertBulk) AddSaved(v int) *SearchJobUpsertBulk {
+	return u.Update(func(s *SearchJobUpsert) {
+		s.AddSaved(v)
+	})
+}
+
+// UpdateSaved sets the "saved" field to the value that was provided on create.
+func (u *SearchJobUpsertBulk) UpdateSaved() *SearchJobUpsertBulk {
+	return u.Update(func(s *SearchJobUpsert) {
+		s.UpdateSaved()
+	})
+}
+
+// SetFailed sets the "failed" field.
+func (u *SearchJobUpsertBulk) SetFailed(v int) *SearchJobUpsertBulk {
+	return u.Update(func(s *SearchJobUpsert) {
+		s.SetFailed(v)
+	})
+}
+
+// AddFailed adds v to the "failed" field.
+func (u *SearchJobUpsertBulk) AddFailed(v int) *SearchJobUpsertBulk {
+	return u.Update(func(s *SearchJobUpsert) {
+		s.AddFailed(v)
+	})
+}
+
+// UpdateFailed sets the "failed" field to the value that was provided on create.
+func (u *SearchJobUpsertBulk) UpdateFailed() *SearchJobUpsertBulk {
+	return u.Update(func(s *SearchJobUpsert) {
+		s.UpdateFailed()
+	})
+}
+
+// SetFileNames sets the "file_names" field.
+func (u *SearchJobUpsertBulk) SetFileNames(v []string) *SearchJobUpsertBulk {
+	return u.Update(func(s *SearchJobUpsert) {
+		s.SetFileNames(v)
+	})
+}
+
+// UpdateFileNames sets the "file_names" field to the value that was provided on create.
+func (u *SearchJobUpsertBulk) UpdateFileNames() *SearchJobUpsertBulk {
+	return u.Update(func(s *SearchJobUpsert) {
+		s.UpdateFileNames()
+	})
+}
+
+// ClearFileNames clears the value of the "file_names" field.
+func (u *SearchJobUpsertBulk) ClearFileNames() *SearchJobUpsertBulk {
+	return u.Update(func(s *SearchJobUpsert) {
+		s.ClearFileNames()
+	})
+}
+
+// SetDeduplicated sets the "deduplicated" field.
+func (u *SearchJobUpsertBulk) SetDeduplicated(v int) *SearchJobUpsertBulk {
+	return u.Update(func(s *SearchJobUpsert) {
+		s.SetDeduplicated(v)
+	})
+}
+
+// AddDeduplicated adds v to the "deduplicated" field.
+func (u *SearchJobUpsertBulk) AddDeduplicated(v int) *SearchJobUpsertBulk {
+	return u.Update(func(s *SearchJobUpsert) {
+		s.AddDeduplicated(v)
+	})
+}
+
+// UpdateDeduplicated sets the "deduplicated" field to the value that was provided on create.
+func (u *SearchJobUpsertBulk) UpdateDeduplicated() *SearchJobUpsertBulk {
+	return u.Update(func(s *SearchJobUpsert) {
+		s.UpdateDeduplicated()
+	})
+}
+
+// SetDuplicateFileNames sets the "duplicate_file_names" field.
+func (u *SearchJobUpsertBulk) SetDuplicateFileNames(v []string) *SearchJobUpsertBulk {
+	return u.Update(func(s *SearchJobUpsert) {
+		s.SetDuplicateFileNames(v)
+	})
+}
+
+// UpdateDuplicateFileNames sets the "duplicate_file_names" field to the value that was provided on create.
+func (u *SearchJobUpsertBulk) UpdateDuplicateFileNames() *SearchJobUpsertBulk {
+	return u.Update(func(s *SearchJobUpsert) {
+		s.UpdateDuplicateFileNames()
+	})
+}
+
+// ClearDuplicateFileNames clears the value of the "duplicate_file_names" field.
+func (u *SearchJobUpsertBulk) ClearDuplicateFileNames() *SearchJobUpsertBulk {
+	return u.Update(func(s *SearchJobUpsert) {
+		s.ClearDuplicateFileNames()
+	})
+}
+
+// SetDuplicateOf sets the "duplicate_of" field.
+func (u *SearchJobUpsertBulk) SetDuplicateOf(v []string) *SearchJobUpsertBulk {
+	return u.Update(func(s *SearchJobUpsert) {
+		s.SetDuplicateOf(v)
+	})
+}
+
+// UpdateDuplicateOf sets the "duplicate_of" field to the value that was provided on create.
+func (u *SearchJobUpsertBulk) UpdateDuplicateOf() *SearchJobUpsertBulk {
+	return u.Update(func(s *SearchJobUpsert) {
+		s.UpdateDuplicateOf()
+	})
+}
+
+// ClearDuplicateOf clears the value of the "duplicate_of" field.
+func (u *SearchJobUpsertBulk) ClearDuplicateOf() *SearchJobUpsertBulk {
+	return u.Update(func(s *SearchJobUpsert) {
+		s.ClearDuplicateOf()
+	})
+}
+
+// SetCandidateUrls sets the "candidate_urls" field.
+func (u *SearchJobUpsertBulk) SetCandidateUrls(v []string) *SearchJobUpsertBulk {
+	return u.Update(func(s *SearchJobUpsert) {
+		s.SetCandidateUrls(v)
+	})
+}
+
+// UpdateCandidateUrls sets the "candidate_urls" field to the value that was provided on create.
+func (u *SearchJobUpsertBulk) UpdateCandidateUrls() *SearchJobUpsertBulk {
+	return u.Update(func(s *SearchJobUpsert) {
+		s.UpdateCandidateUrls()
+	})
+}
+
+// ClearCandidateUrls clears the value of the "candidate_urls" field.
+func (u *SearchJobUpsertBulk) ClearCandidateUrls() *SearchJobUpsertBulk {
+	return u.Update(func(s *SearchJobUpsert) {
+		s.ClearCandidateUrls()
+	})
+}
+
+// SetCandidateThumbnailUrls sets the "candidate_thumbnail_urls" field.
+func (u *SearchJobUpsertBulk) SetCandidateThumbnailUrls(v []string) *SearchJobUpsertBulk {
+	return u.Update(func(s *SearchJobUpsert) {
+		s.SetCandidateThumbnailUrls(v)
+	})
+}
+
+// UpdateCandidateThumbnailUrls sets the "candidate_thumbnail_urls" field to the value that was provided on create.
+func (u *SearchJobUpsertBulk) UpdateCandidateThumbnailUrls() *SearchJobUpsertBulk {
+	return u.Update(func(s *SearchJobUpsert) {
+		s.UpdateCandidateThumbnailUrls()
+	})
+}
+
+// ClearCandidateThumbnailUrls clears the value of the "candidate_thumbnail_urls" field.
+func (u *SearchJobUpsertBulk) ClearCandidateThumbnailUrls() *SearchJobUpsertBulk {
+	return u.Update(func(s *SearchJobUpsert) {
+		s.ClearCandidateThumbnailUrls()
+	})
+}
+
+// SetNextCandidate sets the "next_candidate" field.
+func (u *SearchJobUpsertBulk) SetNextCandidate(v int) *SearchJobUpsertBulk {
+	return u.Update(func(s *SearchJobUpsert) {
+		s.SetNextCandidate(v)
+	})
+}
+
+// AddNextCandidate adds v to the "next_candidate" field.
+func (u *SearchJobUpsertBulk) AddNextCandidate(v int) *SearchJobUpsertBulk {
+	return u.Update(func(s *SearchJobUpsert) {
+		s.AddNextCandidate(v)
+	})
+}
+
+// UpdateNextCandidate sets the "next_candidate" field to the value that was provided on create.
+func (u *SearchJobUpsertBulk) UpdateNextCandidate() *SearchJobUpsertBulk {
+	return u.Update(func(s *SearchJobUpsert) {
+		s.UpdateNextCandidate()
+	})
+}
+
+// SetRunner sets the "runner" field.
+func (u *SearchJobUpsertBulk) SetRunner(v string) *SearchJobUpsertBulk {
+	return u.Update(func(s *SearchJobUpsert) {
+		s.SetRunner(v)
+	})
+}
+
+// UpdateRunner sets the "runner" field to the value that was provided on create.
+func (u *SearchJobUpsertBulk) UpdateRunner() *SearchJobUpsertBulk {
+	return u.Update(func(s *SearchJobUpsert) {
+		s.UpdateRunner()
+	})
+}
+
+// ClearRunner clears the value of the "runner" field.
+func (u *SearchJobUpsertBulk) ClearRunner() *SearchJobUpsertBulk {
+	return u.Update(func(s *SearchJobUpsert) {
+		s.ClearRunner()
+	})
+}
+
+// SetHeartbeatAt sets the "heartbeat_at" field.
+func (u *SearchJobUpsertBulk) SetHeartbeatAt(v time.Time) *SearchJobUpsertBulk {
+	return u.Update(func(s *SearchJobUpsert) {
+		s.SetHeartbeatAt(v)
+	})
+}
+
+// UpdateHeartbeatAt sets the "heartbeat_at" field to the value that was provided on create.
+func (u *SearchJobUpsertBulk) UpdateHeartbeatAt() *SearchJobUpsertBulk {
+	return u.Update(func(s *SearchJobUpsert) {
+		s.UpdateHeartbeatAt()
+	})
+}
+
+// ClearHeartbeatAt clears the value of the "heartbeat_at" field.
+func (u *SearchJobUpsertBulk) ClearHeartbeatAt() *SearchJobUpsertBulk {
+	return u.Update(func(s *SearchJobUpsert) {
+		s.ClearHeartbeatAt()
+	})
+}
+
+// SetError sets the "error" field.
+func (u *SearchJobUpsertBulk) SetError(v string) *SearchJobUpsertBulk {
+	return u.Update(func(s *SearchJobUpsert) {
+		s.SetError(v)
+	})
+}
+
+// UpdateError sets the "error" field to the value that was provided on create.
+func (u *SearchJobUpsertBulk) UpdateError() *SearchJobUpsertBulk {
+	return u.Update(func(s *SearchJobUpsert) {
+		s.UpdateError()
+	})
+}
+
+// ClearError clears the value of the "error" field.
+func (u *SearchJobUpsertBulk) ClearError() *SearchJobUpsertBulk {
+	return u.Update(func(s *SearchJobUpsert) {
+		s.ClearError()
+	})
+}
+
+// SetCreatedAt sets the "created_at" field.
+func (u *SearchJobUpsertBulk) SetCreatedAt(v time.Time) *SearchJobUpsertBulk {
+	return u.Update(func(s *SearchJobUpsert) {
+		s.SetCreatedAt(v)
+	})
+}
+
+// UpdateCreatedAt sets the "created_at" field to the value that was provided on create.
+func (u *SearchJobUpsertBulk) UpdateCreatedAt() *SearchJobUpsertBulk {
+	return u.Update(func(s *SearchJobUpsert) {
+		s.UpdateCreatedAt()
+	})
+}
+
+// ClearCreatedAt clears the value of the "created_at" field.
+func (u *SearchJobUpsertBulk) ClearCreatedAt() *SearchJobUpsertBulk {
+	return u.Update(func(s *SearchJobUpsert) {
+		s.ClearCreatedAt()
+	})
+}
+
+// SetUpdatedAt sets the "updated_at" field.
+func (u *SearchJobUpsertBulk) SetUpdatedAt(v time.Time) *SearchJobUpsertBulk {
+	return u.Update(func(s *SearchJobUpsert) {
+		s.SetUpdatedAt(v)
+	})
+}
+
+// UpdateUpdatedAt sets the "updated_at" field to the value that was provided on create.
+func (u *SearchJobUpsertBulk) UpdateUpdatedAt() *SearchJobUpsertBulk {
+	return u.Update(func(s *SearchJobUpsert) {
+		s.UpdateUpdatedAt()
+	})
+}
+
+// SetStartedAt sets the "started_at" field.
+func (u *SearchJobUpsertBulk) SetStartedAt(v time.Time) *SearchJobUpsertBulk {
+	return u.Update(func(s *SearchJobUpsert) {
+		s.SetStartedAt(v)
+	})
+}
+
+// UpdateStartedAt sets the "started_at" field to the value that was provided on create.
+func (u *SearchJobUpsertBulk) UpdateStartedAt() *SearchJobUpsertBulk {
+	return u.Update(func(s *SearchJobUpsert) {
+		s.UpdateStartedAt()
+	})
+}
+
+// ClearStartedAt clears the value of the "started_at" field.
+func (u *SearchJobUpsertBulk) ClearStartedAt() *SearchJobUpsertBulk {
+	return u.Update(func(s *SearchJobUpsert) {
+		s.ClearStartedAt()
+	})
+}
+
+// SetFinishedAt sets the "finished_at" field.
+func (u *SearchJobUpsertBulk) SetFinishedAt(v time.Time) *SearchJobUpsertBulk {
+	return u.Update(func(s *SearchJobUpsert) {
+		s.SetFinishedAt(v)
+	})
+}
+
+// UpdateFinishedAt sets the "finished_at" field to the value that was provided on create.
+func (u *SearchJobUpsertBulk) UpdateFinishedAt() *SearchJobUpsertBulk {
+	return u.Update(func(s *SearchJobUpsert) {
+		s.UpdateFinishedAt()
+	})
+}
+
+// ClearFinishedAt clears the value of the "finished_at" field.
+func (u *SearchJobUpsertBulk) ClearFinishedAt() *SearchJobUpsertBulk {
+	return u.Update(func(s *SearchJobUpsert) {
+		s.ClearFinishedAt()
+	})
+}
+
+// Exec executes the query.
+func (u *SearchJobUpsertBulk) Exec(ctx context.Context) error {
+	if u.create.err != nil {
+		return u.create.err
+	}
+	for i, b := range u.create.builders {
+		if len(b.conflict) != 0 {
+			return fmt.Errorf("ent: OnConflict was set for builder %d. Set it on the SearchJobCreateBulk instead", i)
+		}
+	}
+	if len(u.create.conflict) == 0 {
+		return errors.New("ent: missing options for SearchJobCreateBulk.OnConflict")
+	}
+	return u.create.Exec(ctx)
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (u *SearchJobUpsertBulk) ExecX(ctx context.Context) {
+	if err := u.create.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
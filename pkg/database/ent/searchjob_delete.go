@@ -0,0 +1,88 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"context"
+
+	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/schema/field"
+	"github.com/lebleuciel/maani/pkg/database/ent/predicate"
+	"github.com/lebleuciel/maani/pkg/database/ent/searchjob"
+)
+
+// SearchJobDelete is the builder for deleting a SearchJob entity.
+type SearchJobDelete struct {
+	config
+	hooks    []Hook
+	mutation *SearchJobMutation
+}
+
+// Where appends a list predicates to the SearchJobDelete builder.
+func (sjd *SearchJobDelete) Where(ps ...predicate.SearchJob) *SearchJobDelete {
+	sjd.mutation.Where(ps...)
+	return sjd
+}
+
+// Exec executes the deletion query and returns how many vertices were deleted.
+func (sjd *SearchJobDelete) Exec(ctx context.Context) (int, error) {
+	return withHooks(ctx, sjd.sqlExec, sjd.mutation, sjd.hooks)
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (sjd *SearchJobDelete) ExecX(ctx context.Context) int {
+	n, err := sjd.Exec(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return n
+}
+
+func (sjd *SearchJobDelete) sqlExec(ctx context.Context) (int, error) {
+	_spec := sqlgraph.NewDeleteSpec(searchjob.Table, sqlgraph.NewFieldSpec(searchjob.FieldID, field.TypeInt))
+	if ps := sjd.mutation.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	affected, err := sqlgraph.DeleteNodes(ctx, sjd.driver, _spec)
+	if err != nil && sqlgraph.IsConstraintError(err) {
+		err = &ConstraintError{msg: err.Error(), wrap: err}
+	}
+	sjd.mutation.done = true
+	return affected, err
+}
+
+// SearchJobDeleteOne is the builder for deleting a single SearchJob entity.
+type SearchJobDeleteOne struct {
+	sjd *SearchJobDelete
+}
+
+// Where appends a list predicates to the SearchJobDelete builder.
+func (sjdo *SearchJobDeleteOne) Where(ps ...predicate.SearchJob) *SearchJobDeleteOne {
+	sjdo.sjd.mutation.Where(ps...)
+	return sjdo
+}
+
+// Exec executes the deletion query.
+func (sjdo *SearchJobDeleteOne) Exec(ctx context.Context) error {
+	n, err := sjdo.sjd.Exec(ctx)
+	switch {
+	case err != nil:
+		return err
+	case n == 0:
+		return &NotFoundError{searchjob.Label}
+	default:
+		return nil
+	}
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (sjdo *SearchJobDeleteOne) ExecX(ctx context.Context) {
+	if err := sjdo.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
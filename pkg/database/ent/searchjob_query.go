@@ -0,0 +1,654 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"context"
+	"fmt"
+	"math"
+
+	"entgo.io/ent/dialect"
+	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/schema/field"
+	"github.com/lebleuciel/maani/pkg/database/ent/predicate"
+	"github.com/lebleuciel/maani/pkg/database/ent/searchjob"
+	"github.com/lebleuciel/maani/pkg/database/ent/user"
+)
+
+// SearchJobQuery is the builder for querying SearchJob entities.
+type SearchJobQuery struct {
+	config
+	ctx        *QueryContext
+	order      []searchjob.OrderOption
+	inters     []Interceptor
+	predicates []predicate.SearchJob
+	withUser   *UserQuery
+	modifiers  []func(*sql.Selector)
+	// intermediate query (i.e. traversal path).
+	sql  *sql.Selector
+	path func(context.Context) (*sql.Selector, error)
+}
+
+// Where adds a new predicate for the SearchJobQuery builder.
+func (sjq *SearchJobQuery) Where(ps ...predicate.SearchJob) *SearchJobQuery {
+	sjq.predicates = append(sjq.predicates, ps...)
+	return sjq
+}
+
+// Limit the number of records to be returned by this query.
+func (sjq *SearchJobQuery) Limit(limit int) *SearchJobQuery {
+	sjq.ctx.Limit = &limit
+	return sjq
+}
+
+// Offset to start from.
+func (sjq *SearchJobQuery) Offset(offset int) *SearchJobQuery {
+	sjq.ctx.Offset = &offset
+	return sjq
+}
+
+// Unique configures the query builder to filter duplicate records on query.
+// By default, unique is set to true, and can be disabled using this method.
+func (sjq *SearchJobQuery) Unique(unique bool) *SearchJobQuery {
+	sjq.ctx.Unique = &unique
+	return sjq
+}
+
+// Order specifies how the records should be ordered.
+func (sjq *SearchJobQuery) Order(o ...searchjob.OrderOption) *SearchJobQuery {
+	sjq.order = append(sjq.order, o...)
+	return sjq
+}
+
+// QueryUser chains the current query on the "user" edge.
+func (sjq *SearchJobQuery) QueryUser() *UserQuery {
+	query := (&UserClient{config: sjq.config}).Query()
+	query.path = func(ctx context.Context) (fromU *sql.Selector, err error) {
+		if err := sjq.prepareQuery(ctx); err != nil {
+			return nil, err
+		}
+		selector := sjq.sqlQuery(ctx)
+		if err := selector.Err(); err != nil {
+			return nil, err
+		}
+		step := sqlgraph.NewStep(
+			sqlgraph.From(searchjob.Table, searchjob.FieldID, selector),
+			sqlgraph.To(user.Table, user.FieldID),
+			sqlgraph.Edge(sqlgraph.M2O, true, searchjob.UserTable, searchjob.UserColumn),
+		)
+		fromU = sqlgraph.SetNeighbors(sjq.driver.Dialect(), step)
+		return fromU, nil
+	}
+	return query
+}
+
+// First returns the first SearchJob entity from the query.
+// Returns a *NotFoundError when no SearchJob was found.
+func (sjq *SearchJobQuery) First(ctx context.Context) (*SearchJob, error) {
+	nodes, err := sjq.Limit(1).All(setContextOp(ctx, sjq.ctx, "First"))
+	if err != nil {
+		return nil, err
+	}
+	if len(nodes) == 0 {
+		return nil, &NotFoundError{searchjob.Label}
+	}
+	return nodes[0], nil
+}
+
+// FirstX is like First, but panics if an error occurs.
+func (sjq *SearchJobQuery) FirstX(ctx context.Context) *SearchJob {
+	node, err := sjq.First(ctx)
+	if err != nil && !IsNotFound(err) {
+		panic(err)
+	}
+	return node
+}
+
+// FirstID returns the first SearchJob ID from the query.
+// Returns a *NotFoundError when no SearchJob ID was found.
+func (sjq *SearchJobQuery) FirstID(ctx context.Context) (id int, err error) {
+	var ids []int
+	if ids, err = sjq.Limit(1).IDs(setContextOp(ctx, sjq.ctx, "FirstID")); err != nil {
+		return
+	}
+	if len(ids) == 0 {
+		err = &NotFoundError{searchjob.Label}
+		return
+	}
+	return ids[0], nil
+}
+
+// FirstIDX is like FirstID, but panics if an error occurs.
+func (sjq *SearchJobQuery) FirstIDX(ctx context.Context) int {
+	id, err := sjq.FirstID(ctx)
+	if err != nil && !IsNotFound(err) {
+		panic(err)
+	}
+	return id
+}
+
+// Only returns a single SearchJob entity found by the query, ensuring it only returns one.
+// Returns a *NotSingularError when more than one SearchJob entity is found.
+// Returns a *NotFoundError when no SearchJob entities are found.
+func (sjq *SearchJobQuery) Only(ctx context.Context) (*SearchJob, error) {
+	nodes, err := sjq.Limit(2).All(setContextOp(ctx, sjq.ctx, "Only"))
+	if err != nil {
+		return nil, err
+	}
+	switch len(nodes) {
+	case 1:
+		return nodes[0], nil
+	case 0:
+		return nil, &NotFoundError{searchjob.Label}
+	default:
+		return nil, &NotSingularError{searchjob.Label}
+	}
+}
+
+// OnlyX is like Only, but panics if an error occurs.
+func (sjq *SearchJobQuery) OnlyX(ctx context.Context) *SearchJob {
+	node, err := sjq.Only(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return node
+}
+
+// OnlyID is like Only, but returns the only SearchJob ID in the query.
+// Returns a *NotSingularError when more than one SearchJob ID is found.
+// Returns a *NotFoundError when no entities are found.
+func (sjq *SearchJobQuery) OnlyID(ctx context.Context) (id int, err error) {
+	var ids []int
+	if ids, err = sjq.Limit(2).IDs(setContextOp(ctx, sjq.ctx, "OnlyID")); err != nil {
+		return
+	}
+	switch len(ids) {
+	case 1:
+		id = ids[0]
+	case 0:
+		err = &NotFoundError{searchjob.Label}
+	default:
+		err = &NotSingularError{searchjob.Label}
+	}
+	return
+}
+
+// OnlyIDX is like OnlyID, but panics if an error occurs.
+func (sjq *SearchJobQuery) OnlyIDX(ctx context.Context) int {
+	id, err := sjq.OnlyID(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return id
+}
+
+// All executes the query and returns a list of SearchJobs.
+func (sjq *SearchJobQuery) All(ctx context.Context) ([]*SearchJob, error) {
+	ctx = setContextOp(ctx, sjq.ctx, "All")
+	if err := sjq.prepareQuery(ctx); err != nil {
+		return nil, err
+	}
+	qr := querierAll[[]*SearchJob, *SearchJobQuery]()
+	return withInterceptors[[]*SearchJob](ctx, sjq, qr, sjq.inters)
+}
+
+// AllX is like All, but panics if an error occurs.
+func (sjq *SearchJobQuery) AllX(ctx context.Context) []*SearchJob {
+	nodes, err := sjq.All(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return nodes
+}
+
+// IDs executes the query and returns a list of SearchJob IDs.
+func (sjq *SearchJobQuery) IDs(ctx context.Context) (ids []int, err error) {
+	if sjq.ctx.Unique == nil && sjq.path != nil {
+		sjq.Unique(true)
+	}
+	ctx = setContextOp(ctx, sjq.ctx, "IDs")
+	if err = sjq.Select(searchjob.FieldID).Scan(ctx, &ids); err != nil {
+		return nil, err
+	}
+	return ids, nil
+}
+
+// IDsX is like IDs, but panics if an error occurs.
+func (sjq *SearchJobQuery) IDsX(ctx context.Context) []int {
+	ids, err := sjq.IDs(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return ids
+}
+
+// Count returns the count of the given query.
+func (sjq *SearchJobQuery) Count(ctx context.Context) (int, error) {
+	ctx = setContextOp(ctx, sjq.ctx, "Count")
+	if err := sjq.prepareQuery(ctx); err != nil {
+		return 0, err
+	}
+	return withInterceptors[int](ctx, sjq, querierCount[*SearchJobQuery](), sjq.inters)
+}
+
+// CountX is like Count, but panics if an error occurs.
+func (sjq *SearchJobQuery) CountX(ctx context.Context) int {
+	count, err := sjq.Count(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return count
+}
+
+// Exist returns true if the query has elements in the graph.
+func (sjq *SearchJobQuery) Exist(ctx context.Context) (bool, error) {
+	ctx = setContextOp(ctx, sjq.ctx, "Exist")
+	switch _, err := sjq.FirstID(ctx); {
+	case IsNotFound(err):
+		return false, nil
+	case err != nil:
+		return false, fmt.Errorf("ent: check existence: %w", err)
+	default:
+		return true, nil
+	}
+}
+
+// ExistX is like Exist, but panics if an error occurs.
+func (sjq *SearchJobQuery) ExistX(ctx context.Context) bool {
+	exist, err := sjq.Exist(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return exist
+}
+
+// Clone returns a duplicate of the SearchJobQuery builder, including all associated steps. It can be
+// used to prepare common query builders and use them differently after the clone is made.
+func (sjq *SearchJobQuery) Clone() *SearchJobQuery {
+	if sjq == nil {
+		return nil
+	}
+	return &SearchJobQuery{
+		config:     sjq.config,
+		ctx:        sjq.ctx.Clone(),
+		order:      append([]searchjob.OrderOption{}, sjq.order...),
+		inters:     append([]Interceptor{}, sjq.inters...),
+		predicates: append([]predicate.SearchJob{}, sjq.predicates...),
+		withUser:   sjq.withUser.Clone(),
+		// clone intermediate query.
+		sql:  sjq.sql.Clone(),
+		path: sjq.path,
+	}
+}
+
+// WithUser tells the query-builder to eager-load the nodes that are connected to
+// the "user" edge. The optional arguments are used to configure the query builder of the edge.
+func (sjq *SearchJobQuery) WithUser(opts ...func(*UserQuery)) *SearchJobQuery {
+	query := (&UserClient{config: sjq.config}).Query()
+	for _, opt := range opts {
+		opt(query)
+	}
+	sjq.withUser = query
+	return sjq
+}
+
+// GroupBy is used to group vertices by one or more fields/columns.
+// It is often used with aggregate functions, like: count, max, mean, min, sum.
+//
+// Example:
+//
+//	var v []struct {
+//		Query string `json:"query,omitempty"`
+//		Count int `json:"count,omitempty"`
+//	}
+//
+//	client.SearchJob.Query().
+//		GroupBy(searchjob.FieldQuery).
+//		Aggregate(ent.Count()).
+//		Scan(ctx, &v)
+func (sjq *SearchJobQuery) GroupBy(field string, fields ...string) *SearchJobGroupBy {
+	sjq.ctx.Fields = append([]string{field}, fields...)
+	grbuild := &SearchJobGroupBy{build: sjq}
+	grbuild.flds = &sjq.ctx.Fields
+	grbuild.label = searchjob.Label
+	grbuild.scan = grbuild.Scan
+	return grbuild
+}
+
+// Select allows the selection one or more fields/columns for the given query,
+// instead of selecting all fields in the entity.
+//
+// Example:
+//
+//	var v []struct {
+//		Query string `json:"query,omitempty"`
+//	}
+//
+//	client.SearchJob.Query().
+//		Select(searchjob.FieldQuery).
+//		Scan(ctx, &v)
+func (sjq *SearchJobQuery) Select(fields ...string) *SearchJobSelect {
+	sjq.ctx.Fields = append(sjq.ctx.Fields, fields...)
+	sbuild := &SearchJobSelect{SearchJobQuery: sjq}
+	sbuild.label = searchjob.Label
+	sbuild.flds, sbuild.scan = &sjq.ctx.Fields, sbuild.Scan
+	return sbuild
+}
+
+// Aggregate returns a SearchJobSelect configured with the given aggregations.
+func (sjq *SearchJobQuery) Aggregate(fns ...AggregateFunc) *SearchJobSelect {
+	return sjq.Select().Aggregate(fns...)
+}
+
+func (sjq *SearchJobQuery) prepareQuery(ctx context.Context) error {
+	for _, inter := range sjq.inters {
+		if inter == nil {
+			return fmt.Errorf("ent: uninitialized interceptor (forgotten import ent/runtime?)")
+		}
+		if trv, ok := inter.(Traverser); ok {
+			if err := trv.Traverse(ctx, sjq); err != nil {
+				return err
+			}
+		}
+	}
+	for _, f := range sjq.ctx.Fields {
+		if !searchjob.ValidColumn(f) {
+			return &ValidationError{Name: f, err: fmt.Errorf("ent: invalid field %q for query", f)}
+		}
+	}
+	if sjq.path != nil {
+		prev, err := sjq.path(ctx)
+		if err != nil {
+			return err
+		}
+		sjq.sql = prev
+	}
+	return nil
+}
+
+func (sjq *SearchJobQuery) sqlAll(ctx context.Context, hooks ...queryHook) ([]*SearchJob, error) {
+	var (
+		nodes       = []*SearchJob{}
+		_spec       = sjq.querySpec()
+		loadedTypes = [1]bool{
+			sjq.withUser != nil,
+		}
+	)
+	_spec.ScanValues = func(columns []string) ([]any, error) {
+		return (*SearchJob).scanValues(nil, columns)
+	}
+	_spec.Assign = func(columns []string, values []any) error {
+		node := &SearchJob{config: sjq.config}
+		nodes = append(nodes, node)
+		node.Edges.loadedTypes = loadedTypes
+		return node.assignValues(columns, values)
+	}
+	if len(sjq.modifiers) > 0 {
+		_spec.Modifiers = sjq.modifiers
+	}
+	for i := range hooks {
+		hooks[i](ctx, _spec)
+	}
+	if err := sqlgraph.QueryNodes(ctx, sjq.driver, _spec); err != nil {
+		return nil, err
+	}
+	if len(nodes) == 0 {
+		return nodes, nil
+	}
+	if query := sjq.withUser; query != nil {
+		if err := sjq.loadUser(ctx, query, nodes, nil,
+			func(n *SearchJob, e *User) { n.Edges.User = e }); err != nil {
+			return nil, err
+		}
+	}
+	return nodes, nil
+}
+
+func (sjq *SearchJobQuery) loadUser(ctx context.Context, query *UserQuery, nodes []*SearchJob, init func(*SearchJob), assign func(*SearchJob, *User)) error {
+	ids := make([]int, 0, len(nodes))
+	nodeids := make(map[int][]*SearchJob)
+	for i := range nodes {
+		fk := nodes[i].UserID
+		if _, ok := nodeids[fk]; !ok {
+			ids = append(ids, fk)
+		}
+		nodeids[fk] = append(nodeids[fk], nodes[i])
+	}
+	if len(ids) == 0 {
+		return nil
+	}
+	query.Where(user.IDIn(ids...))
+	neighbors, err := query.All(ctx)
+	if err != nil {
+		return err
+	}
+	for _, n := range neighbors {
+		nodes, ok := nodeids[n.ID]
+		if !ok {
+			return fmt.Errorf(`unexpected foreign-key "user_id" returned %v`, n.ID)
+		}
+		for i := range nodes {
+			assign(nodes[i], n)
+		}
+	}
+	return nil
+}
+
+func (sjq *SearchJobQuery) sqlCount(ctx context.Context) (int, error) {
+	_spec := sjq.querySpec()
+	if len(sjq.modifiers) > 0 {
+		_spec.Modifiers = sjq.modifiers
+	}
+	_spec.Node.Columns = sjq.ctx.Fields
+	if len(sjq.ctx.Fields) > 0 {
+		_spec.Unique = sjq.ctx.Unique != nil && *sjq.ctx.Unique
+	}
+	return sqlgraph.CountNodes(ctx, sjq.driver, _spec)
+}
+
+func (sjq *SearchJobQuery) querySpec() *sqlgraph.QuerySpec {
+	_spec := sqlgraph.NewQuerySpec(searchjob.Table, searchjob.Columns, sqlgraph.NewFieldSpec(searchjob.FieldID, field.TypeInt))
+	_spec.From = sjq.sql
+	if unique := sjq.ctx.Unique; unique != nil {
+		_spec.Unique = *unique
+	} else if sjq.path != nil {
+		_spec.Unique = true
+	}
+	if fields := sjq.ctx.Fields; len(fields) > 0 {
+		_spec.Node.Columns = make([]string, 0, len(fields))
+		_spec.Node.Columns = append(_spec.Node.Columns, searchjob.FieldID)
+		for i := range fields {
+			if fields[i] != searchjob.FieldID {
+				_spec.Node.Columns = append(_spec.Node.Columns, fields[i])
+			}
+		}
+		if sjq.withUser != nil {
+			_spec.Node.AddColumnOnce(searchjob.FieldUserID)
+		}
+	}
+	if ps := sjq.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	if limit := sjq.ctx.Limit; limit != nil {
+		_spec.Limit = *limit
+	}
+	if offset := sjq.ctx.Offset; offset != nil {
+		_spec.Offset = *offset
+	}
+	if ps := sjq.order; len(ps) > 0 {
+		_spec.Order = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	return _spec
+}
+
+func (sjq *SearchJobQuery) sqlQuery(ctx context.Context) *sql.Selector {
+	builder := sql.Dialect(sjq.driver.Dialect())
+	t1 := builder.Table(searchjob.Table)
+	columns := sjq.ctx.Fields
+	if len(columns) == 0 {
+		columns = searchjob.Columns
+	}
+	selector := builder.Select(t1.Columns(columns...)...).From(t1)
+	if sjq.sql != nil {
+		selector = sjq.sql
+		selector.Select(selector.Columns(columns...)...)
+	}
+	if sjq.ctx.Unique != nil && *sjq.ctx.Unique {
+		selector.Distinct()
+	}
+	for _, m := range sjq.modifiers {
+		m(selector)
+	}
+	for _, p := range sjq.predicates {
+		p(selector)
+	}
+	for _, p := range sjq.order {
+		p(selector)
+	}
+	if offset := sjq.ctx.Offset; offset != nil {
+		// limit is mandatory for offset clause. We start
+		// with default value, and override it below if needed.
+		selector.Offset(*offset).Limit(math.MaxInt32)
+	}
+	if limit := sjq.ctx.Limit; limit != nil {
+		selector.Limit(*limit)
+	}
+	return selector
+}
+
+// ForUpdate locks the selected rows against concurrent updates, and prevent them from being
+// updated, deleted or "selected ... for update" by other sessions, until the transaction is
+// either committed or rolled-back.
+func (sjq *SearchJobQuery) ForUpdate(opts ...sql.LockOption) *SearchJobQuery {
+	if sjq.driver.Dialect() == dialect.Postgres {
+		sjq.Unique(false)
+	}
+	sjq.modifiers = append(sjq.modifiers, func(s *sql.Selector) {
+		s.ForUpdate(opts...)
+	})
+	return sjq
+}
+
+// ForShare behaves similarly to ForUpdate, except that it acquires a shared mode lock
+// on any rows that are read. Other sessions can read the rows, but cannot modify them
+// until your transaction commits.
+func (sjq *SearchJobQuery) ForShare(opts ...sql.LockOption) *SearchJobQuery {
+	if sjq.driver.Dialect() == dialect.Postgres {
+		sjq.Unique(false)
+	}
+	sjq.modifiers = append(sjq.modifiers, func(s *sql.Selector) {
+		s.ForShare(opts...)
+	})
+	return sjq
+}
+
+// Modify adds a query modifier for attaching custom logic to queries.
+func (sjq *SearchJobQuery) Modify(modifiers ...func(s *sql.Selector)) *SearchJobSelect {
+	sjq.modifiers = append(sjq.modifiers, modifiers...)
+	return sjq.Select()
+}
+
+// SearchJobGroupBy is the group-by builder for SearchJob entities.
+type SearchJobGroupBy struct {
+	selector
+	build *SearchJobQuery
+}
+
+// Aggregate adds the given aggregation functions to the group-by query.
+func (sjgb *SearchJobGroupBy) Aggregate(fns ...AggregateFunc) *SearchJobGroupBy {
+	sjgb.fns = append(sjgb.fns, fns...)
+	return sjgb
+}
+
+// Scan applies the selector query and scans the result into the given value.
+func (sjgb *SearchJobGroupBy) Scan(ctx context.Context, v any) error {
+	ctx = setContextOp(ctx, sjgb.build.ctx, "GroupBy")
+	if err := sjgb.build.prepareQuery(ctx); err != nil {
+		return err
+	}
+	return scanWithInterceptors[*SearchJobQuery, *SearchJobGroupBy](ctx, sjgb.build, sjgb, sjgb.build.inters, v)
+}
+
+func (sjgb *SearchJobGroupBy) sqlScan(ctx context.Context, root *SearchJobQuery, v any) error {
+	selector := root.sqlQuery(ctx).Select()
+	aggregation := make([]string, 0, len(sjgb.fns))
+	for _, fn := range sjgb.fns {
+		aggregation = append(aggregation, fn(selector))
+	}
+	if len(selector.SelectedColumns()) == 0 {
+		columns := make([]string, 0, len(*sjgb.flds)+len(sjgb.fns))
+		for _, f := range *sjgb.flds {
+			columns = append(columns, selector.C(f))
+		}
+		columns = append(columns, aggregation...)
+		selector.Select(columns...)
+	}
+	selector.GroupBy(selector.Columns(*sjgb.flds...)...)
+	if err := selector.Err(); err != nil {
+		return err
+	}
+	rows := &sql.Rows{}
+	query, args := selector.Query()
+	if err := sjgb.build.driver.Query(ctx, query, args, rows); err != nil {
+		return err
+	}
+	defer rows.Close()
+	return sql.ScanSlice(rows, v)
+}
+
+// SearchJobSelect is the builder for selecting fields of SearchJob entities.
+type SearchJobSelect struct {
+	*SearchJobQuery
+	selector
+}
+
+// Aggregate adds the given aggregation functions to the selector query.
+func (sjs *SearchJobSelect) Aggregate(fns ...AggregateFunc) *SearchJobSelect {
+	sjs.fns = append(sjs.fns, fns...)
+	return sjs
+}
+
+// Scan applies the selector query and scans the result into the given value.
+func (sjs *SearchJobSelect) Scan(ctx context.Context, v any) error {
+	ctx = setContextOp(ctx, sjs.ctx, "Select")
+	if err := sjs.prepareQuery(ctx); err != nil {
+		return err
+	}
+	return scanWithInterceptors[*SearchJobQuery, *SearchJobSelect](ctx, sjs.SearchJobQuery, sjs, sjs.inters, v)
+}
+
+func (sjs *SearchJobSelect) sqlScan(ctx context.Context, root *SearchJobQuery, v any) error {
+	selector := root.sqlQuery(ctx)
+	aggregation := make([]string, 0, len(sjs.fns))
+	for _, fn := range sjs.fns {
+		aggregation = append(aggregation, fn(selector))
+	}
+	switch n := len(*sjs.selector.flds); {
+	case n == 0 && len(aggregation) > 0:
+		selector.Select(aggregation...)
+	case n != 0 && len(aggregation) > 0:
+		selector.AppendSelect(aggregation...)
+	}
+	rows := &sql.Rows{}
+	query, args := selector.Query()
+	if err := sjs.driver.Query(ctx, query, args, rows); err != nil {
+		return err
+	}
+	defer rows.Close()
+	return sql.ScanSlice(rows, v)
+}
+
+// Modify adds a query modifier for attaching custom logic to queries.
+func (sjs *SearchJobSelect) Modify(modifiers ...func(s *sql.Selector)) *SearchJobSelect {
+	sjs.modifiers = append(sjs.modifiers, modifiers...)
+	return sjs
+}
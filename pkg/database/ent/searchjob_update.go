@@ -0,0 +1,1330 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/dialect/sql/sqljson"
+	"entgo.io/ent/schema/field"
+	"github.com/lebleuciel/maani/pkg/database/ent/predicate"
+	"github.com/lebleuciel/maani/pkg/database/ent/searchjob"
+	"github.com/lebleuciel/maani/pkg/database/ent/user"
+)
+
+// SearchJobUpdate is the builder for updating SearchJob entities.
+type SearchJobUpdate struct {
+	config
+	hooks     []Hook
+	mutation  *SearchJobMutation
+	modifiers []func(*sql.UpdateBuilder)
+}
+
+// Where appends a list predicates to the SearchJobUpdate builder.
+func (sju *SearchJobUpdate) Where(ps ...predicate.SearchJob) *SearchJobUpdate {
+	sju.mutation.Where(ps...)
+	return sju
+}
+
+// SetQuery sets the "query" field.
+func (sju *SearchJobUpdate) SetQuery(s string) *SearchJobUpdate {
+	sju.mutation.SetQuery(s)
+	return sju
+}
+
+// SetNillableQuery sets the "query" field if the given value is not nil.
+func (sju *SearchJobUpdate) SetNillableQuery(s *string) *SearchJobUpdate {
+	if s != nil {
+		sju.SetQuery(*s)
+	}
+	return sju
+}
+
+// SetMaxResults sets the "max_results" field.
+func (sju *SearchJobUpdate) SetMaxResults(i int) *SearchJobUpdate {
+	sju.mutation.ResetMaxResults()
+	sju.mutation.SetMaxResults(i)
+	return sju
+}
+
+// SetNillableMaxResults sets the "max_results" field if the given value is not nil.
+func (sju *SearchJobUpdate) SetNillableMaxResults(i *int) *SearchJobUpdate {
+	if i != nil {
+		sju.SetMaxResults(*i)
+	}
+	return sju
+}
+
+// AddMaxResults adds i to the "max_results" field.
+func (sju *SearchJobUpdate) AddMaxResults(i int) *SearchJobUpdate {
+	sju.mutation.AddMaxResults(i)
+	return sju
+}
+
+// SetUserID sets the "user_id" field.
+func (sju *SearchJobUpdate) SetUserID(i int) *SearchJobUpdate {
+	sju.mutation.SetUserID(i)
+	return sju
+}
+
+// SetNillableUserID sets the "user_id" field if the given value is not nil.
+func (sju *SearchJobUpdate) SetNillableUserID(i *int) *SearchJobUpdate {
+	if i != nil {
+		sju.SetUserID(*i)
+	}
+	return sju
+}
+
+// SetStatus sets the "status" field.
+func (sju *SearchJobUpdate) SetStatus(s searchjob.Status) *SearchJobUpdate {
+	sju.mutation.SetStatus(s)
+	return sju
+}
+
+// SetNillableStatus sets the "status" field if the given value is not nil.
+func (sju *SearchJobUpdate) SetNillableStatus(s *searchjob.Status) *SearchJobUpdate {
+	if s != nil {
+		sju.SetStatus(*s)
+	}
+	return sju
+}
+
+// SetSaved sets the "saved" field.
+func (sju *SearchJobUpdate) SetSaved(i int) *SearchJobUpdate {
+	sju.mutation.ResetSaved()
+	sju.mutation.SetSaved(i)
+	return sju
+}
+
+// SetNillableSaved sets the "saved" field if the given value is not nil.
+func (sju *SearchJobUpdate) SetNillableSaved(i *int) *SearchJobUpdate {
+	if i != nil {
+		sju.SetSaved(*i)
+	}
+	return sju
+}
+
+// AddSaved adds i to the "saved" field.
+func (sju *SearchJobUpdate) AddSaved(i int) *SearchJobUpdate {
+	sju.mutation.AddSaved(i)
+	return sju
+}
+
+// SetFailed sets the "failed" field.
+func (sju *SearchJobUpdate) SetFailed(i int) *SearchJobUpdate {
+	sju.mutation.ResetFailed()
+	sju.mutation.SetFailed(i)
+	return sju
+}
+
+// SetNillableFailed sets the "failed" field if the given value is not nil.
+func (sju *SearchJobUpdate) SetNillableFailed(i *int) *SearchJobUpdate {
+	if i != nil {
+		sju.SetFailed(*i)
+	}
+	return sju
+}
+
+// AddFailed adds i to the "failed" field.
+func (sju *SearchJobUpdate) AddFailed(i int) *SearchJobUpdate {
+	sju.mutation.AddFailed(i)
+	return sju
+}
+
+// SetFileNames sets the "file_names" field.
+func (sju *SearchJobUpdate) SetFileNames(s []string) *SearchJobUpdate {
+	sju.mutation.SetFileNames(s)
+	return sju
+}
+
+// AppendFileNames appends s to the "file_names" field.
+func (sju *SearchJobUpdate) AppendFileNames(s []string) *SearchJobUpdate {
+	sju.mutation.AppendFileNames(s)
+	return sju
+}
+
+// ClearFileNames clears the value of the "file_names" field.
+func (sju *SearchJobUpdate) ClearFileNames() *SearchJobUpdate {
+	sju.mutation.ClearFileNames()
+	return sju
+}
+
+// SetDeduplicated sets the "deduplicated" field.
+func (sju *SearchJobUpdate) SetDeduplicated(i int) *SearchJobUpdate {
+	sju.mutation.ResetDeduplicated()
+	sju.mutation.SetDeduplicated(i)
+	return sju
+}
+
+// SetNillableDeduplicated sets the "deduplicated" field if the given value is not nil.
+func (sju *SearchJobUpdate) SetNillableDeduplicated(i *int) *SearchJobUpdate {
+	if i != nil {
+		sju.SetDeduplicated(*i)
+	}
+	return sju
+}
+
+// AddDeduplicated adds i to the "deduplicated" field.
+func (sju *SearchJobUpdate) AddDeduplicated(i int) *SearchJobUpdate {
+	sju.mutation.AddDeduplicated(i)
+	return sju
+}
+
+// SetDuplicateFileNames sets the "duplicate_file_names" field.
+func (sju *SearchJobUpdate) SetDuplicateFileNames(s []string) *SearchJobUpdate {
+	sju.mutation.SetDuplicateFileNames(s)
+	return sju
+}
+
+// AppendDuplicateFileNames appends s to the "duplicate_file_names" field.
+func (sju *SearchJobUpdate) AppendDuplicateFileNames(s []string) *SearchJobUpdate {
+	sju.mutation.AppendDuplicateFileNames(s)
+	return sju
+}
+
+// ClearDuplicateFileNames clears the value of the "duplicate_file_names" field.
+func (sju *SearchJobUpdate) ClearDuplicateFileNames() *SearchJobUpdate {
+	sju.mutation.ClearDuplicateFileNames()
+	return sju
+}
+
+// SetDuplicateOf sets the "duplicate_of" field.
+func (sju *SearchJobUpdate) SetDuplicateOf(s []string) *SearchJobUpdate {
+	sju.mutation.SetDuplicateOf(s)
+	return sju
+}
+
+// AppendDuplicateOf appends s to the "duplicate_of" field.
+func (sju *SearchJobUpdate) AppendDuplicateOf(s []string) *SearchJobUpdate {
+	sju.mutation.AppendDuplicateOf(s)
+	return sju
+}
+
+// ClearDuplicateOf clears the value of the "duplicate_of" field.
+func (sju *SearchJobUpdate) ClearDuplicateOf() *SearchJobUpdate {
+	sju.mutation.ClearDuplicateOf()
+	return sju
+}
+
+// SetCandidateUrls sets the "candidate_urls" field.
+func (sju *SearchJobUpdate) SetCandidateUrls(s []string) *SearchJobUpdate {
+	sju.mutation.SetCandidateUrls(s)
+	return sju
+}
+
+// AppendCandidateUrls appends s to the "candidate_urls" field.
+func (sju *SearchJobUpdate) AppendCandidateUrls(s []string) *SearchJobUpdate {
+	sju.mutation.AppendCandidateUrls(s)
+	return sju
+}
+
+// ClearCandidateUrls clears the value of the "candidate_urls" field.
+func (sju *SearchJobUpdate) ClearCandidateUrls() *SearchJobUpdate {
+	sju.mutation.ClearCandidateUrls()
+	return sju
+}
+
+// SetCandidateThumbnailUrls sets the "candidate_thumbnail_urls" field.
+func (sju *SearchJobUpdate) SetCandidateThumbnailUrls(s []string) *SearchJobUpdate {
+	sju.mutation.SetCandidateThumbnailUrls(s)
+	return sju
+}
+
+// AppendCandidateThumbnailUrls appends s to the "candidate_thumbnail_urls" field.
+func (sju *SearchJobUpdate) AppendCandidateThumbnailUrls(s []string) *SearchJobUpdate {
+	sju.mutation.AppendCandidateThumbnailUrls(s)
+	return sju
+}
+
+// ClearCandidateThumbnailUrls clears the value of the "candidate_thumbnail_urls" field.
+func (sju *SearchJobUpdate) ClearCandidateThumbnailUrls() *SearchJobUpdate {
+	sju.mutation.ClearCandidateThumbnailUrls()
+	return sju
+}
+
+// SetNextCandidate sets the "next_candidate" field.
+func (sju *SearchJobUpdate) SetNextCandidate(i int) *SearchJobUpdate {
+	sju.mutation.ResetNextCandidate()
+	sju.mutation.SetNextCandidate(i)
+	return sju
+}
+
+// SetNillableNextCandidate sets the "next_candidate" field if the given value is not nil.
+func (sju *SearchJobUpdate) SetNillableNextCandidate(i *int) *SearchJobUpdate {
+	if i != nil {
+		sju.SetNextCandidate(*i)
+	}
+	return sju
+}
+
+// AddNextCandidate adds i to the "next_candidate" field.
+func (sju *SearchJobUpdate) AddNextCandidate(i int) *SearchJobUpdate {
+	sju.mutation.AddNextCandidate(i)
+	return sju
+}
+
+// SetRunner sets the "runner" field.
+func (sju *SearchJobUpdate) SetRunner(s string) *SearchJobUpdate {
+	sju.mutation.SetRunner(s)
+	return sju
+}
+
+// SetNillableRunner sets the "runner" field if the given value is not nil.
+func (sju *SearchJobUpdate) SetNillableRunner(s *string) *SearchJobUpdate {
+	if s != nil {
+		sju.SetRunner(*s)
+	}
+	return sju
+}
+
+// ClearRunner clears the value of the "runner" field.
+func (sju *SearchJobUpdate) ClearRunner() *SearchJobUpdate {
+	sju.mutation.ClearRunner()
+	return sju
+}
+
+// SetHeartbeatAt sets the "heartbeat_at" field.
+func (sju *SearchJobUpdate) SetHeartbeatAt(t time.Time) *SearchJobUpdate {
+	sju.mutation.SetHeartbeatAt(t)
+	return sju
+}
+
+// SetNillableHeartbeatAt sets the "heartbeat_at" field if the given value is not nil.
+func (sju *SearchJobUpdate) SetNillableHeartbeatAt(t *time.Time) *SearchJobUpdate {
+	if t != nil {
+		sju.SetHeartbeatAt(*t)
+	}
+	return sju
+}
+
+// ClearHeartbeatAt clears the value of the "heartbeat_at" field.
+func (sju *SearchJobUpdate) ClearHeartbeatAt() *SearchJobUpdate {
+	sju.mutation.ClearHeartbeatAt()
+	return sju
+}
+
+// SetError sets the "error" field.
+func (sju *SearchJobUpdate) SetError(s string) *SearchJobUpdate {
+	sju.mutation.SetError(s)
+	return sju
+}
+
+// SetNillableError sets the "error" field if the given value is not nil.
+func (sju *SearchJobUpdate) SetNillableError(s *string) *SearchJobUpdate {
+	if s != nil {
+		sju.SetError(*s)
+	}
+	return sju
+}
+
+// ClearError clears the value of the "error" field.
+func (sju *SearchJobUpdate) ClearError() *SearchJobUpdate {
+	sju.mutation.ClearError()
+	return sju
+}
+
+// SetCreatedAt sets the "created_at" field.
+func (sju *SearchJobUpdate) SetCreatedAt(t time.Time) *SearchJobUpdate {
+	sju.mutation.SetCreatedAt(t)
+	return sju
+}
+
+// SetNillableCreatedAt sets the "created_at" field if the given value is not nil.
+func (sju *SearchJobUpdate) SetNillableCreatedAt(t *time.Time) *SearchJobUpdate {
+	if t != nil {
+		sju.SetCreatedAt(*t)
+	}
+	return sju
+}
+
+// ClearCreatedAt clears the value of the "created_at" field.
+func (sju *SearchJobUpdate) ClearCreatedAt() *SearchJobUpdate {
+	sju.mutation.ClearCreatedAt()
+	return sju
+}
+
+// SetUpdatedAt sets the "updated_at" field.
+func (sju *SearchJobUpdate) SetUpdatedAt(t time.Time) *SearchJobUpdate {
+	sju.mutation.SetUpdatedAt(t)
+	return sju
+}
+
+// SetStartedAt sets the "started_at" field.
+func (sju *SearchJobUpdate) SetStartedAt(t time.Time) *SearchJobUpdate {
+	sju.mutation.SetStartedAt(t)
+	return sju
+}
+
+// SetNillableStartedAt sets the "started_at" field if the given value is not nil.
+func (sju *SearchJobUpdate) SetNillableStartedAt(t *time.Time) *SearchJobUpdate {
+	if t != nil {
+		sju.SetStartedAt(*t)
+	}
+	return sju
+}
+
+// ClearStartedAt clears the value of the "started_at" field.
+func (sju *SearchJobUpdate) ClearStartedAt() *SearchJobUpdate {
+	sju.mutation.ClearStartedAt()
+	return sju
+}
+
+// SetFinishedAt sets the "finished_at" field.
+func (sju *SearchJobUpdate) SetFinishedAt(t time.Time) *SearchJobUpdate {
+	sju.mutation.SetFinishedAt(t)
+	return sju
+}
+
+// SetNillableFinishedAt sets the "finished_at" field if the given value is not nil.
+func (sju *SearchJobUpdate) SetNillableFinishedAt(t *time.Time) *SearchJobUpdate {
+	if t != nil {
+		sju.SetFinishedAt(*t)
+	}
+	return sju
+}
+
+// ClearFinishedAt clears the value of the "finished_at" field.
+func (sju *SearchJobUpdate) ClearFinishedAt() *SearchJobUpdate {
+	sju.mutation.ClearFinishedAt()
+	return sju
+}
+
+// SetUser sets the "user" edge to the User entity.
+func (sju *SearchJobUpdate) SetUser(u *User) *SearchJobUpdate {
+	return sju.SetUserID(u.ID)
+}
+
+// Mutation returns the SearchJobMutation object of the builder.
+func (sju *SearchJobUpdate) Mutation() *SearchJobMutation {
+	return sju.mutation
+}
+
+// ClearUser clears the "user" edge to the User entity.
+func (sju *SearchJobUpdate) ClearUser() *SearchJobUpdate {
+	sju.mutation.ClearUser()
+	return sju
+}
+
+// Save executes the query and returns the number of nodes affected by the update operation.
+func (sju *SearchJobUpdate) Save(ctx context.Context) (int, error) {
+	sju.defaults()
+	return withHooks(ctx, sju.sqlSave, sju.mutation, sju.hooks)
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (sju *SearchJobUpdate) SaveX(ctx context.Context) int {
+	affected, err := sju.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return affected
+}
+
+// Exec executes the query.
+func (sju *SearchJobUpdate) Exec(ctx context.Context) error {
+	_, err := sju.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (sju *SearchJobUpdate) ExecX(ctx context.Context) {
+	if err := sju.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// defaults sets the default values of the builder before save.
+func (sju *SearchJobUpdate) defaults() {
+	if _, ok := sju.mutation.UpdatedAt(); !ok {
+		v := searchjob.UpdateDefaultUpdatedAt()
+		sju.mutation.SetUpdatedAt(v)
+	}
+}
+
+// check runs all checks and user-defined validators on the builder.
+func (sju *SearchJobUpdate) check() error {
+	if v, ok := sju.mutation.Query(); ok {
+		if err := searchjob.QueryValidator(v); err != nil {
+			return &ValidationError{Name: "query", err: fmt.Errorf(`ent: validator failed for field "SearchJob.query": %w`, err)}
+		}
+	}
+	if v, ok := sju.mutation.MaxResults(); ok {
+		if err := searchjob.MaxResultsValidator(v); err != nil {
+			return &ValidationError{Name: "max_results", err: fmt.Errorf(`ent: validator failed for field "SearchJob.max_results": %w`, err)}
+		}
+	}
+	if v, ok := sju.mutation.Status(); ok {
+		if err := searchjob.StatusValidator(v); err != nil {
+			return &ValidationError{Name: "status", err: fmt.Errorf(`ent: validator failed for field "SearchJob.status": %w`, err)}
+		}
+	}
+	if _, ok := sju.mutation.UserID(); sju.mutation.UserCleared() && !ok {
+		return errors.New(`ent: clearing a required unique edge "SearchJob.user"`)
+	}
+	return nil
+}
+
+// Modify adds a statement modifier for attaching custom logic to the UPDATE statement.
+func (sju *SearchJobUpdate) Modify(modifiers ...func(u *sql.UpdateBuilder)) *SearchJobUpdate {
+	sju.modifiers = append(sju.modifiers, modifiers...)
+	return sju
+}
+
+func (sju *SearchJobUpdate) sqlSave(ctx context.Context) (n int, err error) {
+	if err := sju.check(); err != nil {
+		return n, err
+	}
+	_spec := sqlgraph.NewUpdateSpec(searchjob.Table, searchjob.Columns, sqlgraph.NewFieldSpec(searchjob.FieldID, field.TypeInt))
+	if ps := sju.mutation.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	if value, ok := sju.mutation.Query(); ok {
+		_spec.SetField(searchjob.FieldQuery, field.TypeString, value)
+	}
+	if value, ok := sju.mutation.MaxResults(); ok {
+		_spec.SetField(searchjob.FieldMaxResults, field.TypeInt, value)
+	}
+	if value, ok := sju.mutation.AddedMaxResults(); ok {
+		_spec.AddField(searchjob.FieldMaxResults, field.TypeInt, value)
+	}
+	if value, ok := sju.mutation.Status(); ok {
+		_spec.SetField(searchjob.FieldStatus, field.TypeEnum, value)
+	}
+	if value, ok := sju.mutation.Saved(); ok {
+		_spec.SetField(searchjob.FieldSaved, field.TypeInt, value)
+	}
+	if value, ok := sju.mutation.AddedSaved(); ok {
+		_spec.AddField(searchjob.FieldSaved, field.TypeInt, value)
+	}
+	if value, ok := sju.mutation.Failed(); ok {
+		_spec.SetField(searchjob.FieldFailed, field.TypeInt, value)
+	}
+	if value, ok := sju.mutation.AddedFailed(); ok {
+		_spec.AddField(searchjob.FieldFailed, field.TypeInt, value)
+	}
+	if value, ok := sju.mutation.FileNames(); ok {
+		_spec.SetField(searchjob.FieldFileNames, field.TypeJSON, value)
+	}
+	if value, ok := sju.mutation.AppendedFileNames(); ok {
+		_spec.AddModifier(func(u *sql.UpdateBuilder) {
+			sqljson.Append(u, searchjob.FieldFileNames, value)
+		})
+	}
+	if sju.mutation.FileNamesCleared() {
+		_spec.ClearField(searchjob.FieldFileNames, field.TypeJSON)
+	}
+	if value, ok := sju.mutation.Deduplicated(); ok {
+		_spec.SetField(searchjob.FieldDeduplicated, field.TypeInt, value)
+	}
+	if value, ok := sju.mutation.AddedDeduplicated(); ok {
+		_spec.AddField(searchjob.FieldDeduplicated, field.TypeInt, value)
+	}
+	if value, ok := sju.mutation.DuplicateFileNames(); ok {
+		_spec.SetField(searchjob.FieldDuplicateFileNames, field.TypeJSON, value)
+	}
+	if value, ok := sju.mutation.AppendedDuplicateFileNames(); ok {
+		_spec.AddModifier(func(u *sql.UpdateBuilder) {
+			sqljson.Append(u, searchjob.FieldDuplicateFileNames, value)
+		})
+	}
+	if sju.mutation.DuplicateFileNamesCleared() {
+		_spec.ClearField(searchjob.FieldDuplicateFileNames, field.TypeJSON)
+	}
+	if value, ok := sju.mutation.DuplicateOf(); ok {
+		_spec.SetField(searchjob.FieldDuplicateOf, field.TypeJSON, value)
+	}
+	if value, ok := sju.mutation.AppendedDuplicateOf(); ok {
+		_spec.AddModifier(func(u *sql.UpdateBuilder) {
+			sqljson.Append(u, searchjob.FieldDuplicateOf, value)
+		})
+	}
+	if sju.mutation.DuplicateOfCleared() {
+		_spec.ClearField(searchjob.FieldDuplicateOf, field.TypeJSON)
+	}
+	if value, ok := sju.mutation.CandidateUrls(); ok {
+		_spec.SetField(searchjob.FieldCandidateUrls, field.TypeJSON, value)
+	}
+	if value, ok := sju.mutation.AppendedCandidateUrls(); ok {
+		_spec.AddModifier(func(u *sql.UpdateBuilder) {
+			sqljson.Append(u, searchjob.FieldCandidateUrls, value)
+		})
+	}
+	if sju.mutation.CandidateUrlsCleared() {
+		_spec.ClearField(searchjob.FieldCandidateUrls, field.TypeJSON)
+	}
+	if value, ok := sju.mutation.CandidateThumbnailUrls(); ok {
+		_spec.SetField(searchjob.FieldCandidateThumbnailUrls, field.TypeJSON, value)
+	}
+	if value, ok := sju.mutation.AppendedCandidateThumbnailUrls(); ok {
+		_spec.AddModifier(func(u *sql.UpdateBuilder) {
+			sqljson.Append(u, searchjob.FieldCandidateThumbnailUrls, value)
+		})
+	}
+	if sju.mutation.CandidateThumbnailUrlsCleared() {
+		_spec.ClearField(searchjob.FieldCandidateThumbnailUrls, field.TypeJSON)
+	}
+	if value, ok := sju.mutation.NextCandidate(); ok {
+		_spec.SetField(searchjob.FieldNextCandidate, field.TypeInt, value)
+	}
+	if value, ok := sju.mutation.AddedNextCandidate(); ok {
+		_spec.AddField(searchjob.FieldNextCandidate, field.TypeInt, value)
+	}
+	if value, ok := sju.mutation.Runner(); ok {
+		_spec.SetField(searchjob.FieldRunner, field.TypeString, value)
+	}
+	if sju.mutation.RunnerCleared() {
+		_spec.ClearField(searchjob.FieldRunner, field.TypeString)
+	}
+	if value, ok := sju.mutation.HeartbeatAt(); ok {
+		_spec.SetField(searchjob.FieldHeartbeatAt, field.TypeTime, value)
+	}
+	if sju.mutation.HeartbeatAtCleared() {
+		_spec.ClearField(searchjob.FieldHeartbeatAt, field.TypeTime)
+	}
+	if value, ok := sju.mutation.Error(); ok {
+		_spec.SetField(searchjob.FieldError, field.TypeString, value)
+	}
+	if sju.mutation.ErrorCleared() {
+		_spec.ClearField(searchjob.FieldError, field.TypeString)
+	}
+	if value, ok := sju.mutation.CreatedAt(); ok {
+		_spec.SetField(searchjob.FieldCreatedAt, field.TypeTime, value)
+	}
+	if sju.mutation.CreatedAtCleared() {
+		_spec.ClearField(searchjob.FieldCreatedAt, field.TypeTime)
+	}
+	if value, ok := sju.mutation.UpdatedAt(); ok {
+		_spec.SetField(searchjob.FieldUpdatedAt, field.TypeTime, value)
+	}
+	if value, ok := sju.mutation.StartedAt(); ok {
+		_spec.SetField(searchjob.FieldStartedAt, field.TypeTime, value)
+	}
+	if sju.mutation.StartedAtCleared() {
+		_spec.ClearField(searchjob.FieldStartedAt, field.TypeTime)
+	}
+	if value, ok := sju.mutation.FinishedAt(); ok {
+		_spec.SetField(searchjob.FieldFinishedAt, field.TypeTime, value)
+	}
+	if sju.mutation.FinishedAtCleared() {
+		_spec.ClearField(searchjob.FieldFinishedAt, field.TypeTime)
+	}
+	if sju.mutation.UserCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   searchjob.UserTable,
+			Columns: []string{searchjob.UserColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(user.FieldID, field.TypeInt),
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := sju.mutation.UserIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   searchjob.UserTable,
+			Columns: []string{searchjob.UserColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(user.FieldID, field.TypeInt),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
+	_spec.AddModifiers(sju.modifiers...)
+	if n, err = sqlgraph.UpdateNodes(ctx, sju.driver, _spec); err != nil {
+		if _, ok := err.(*sqlgraph.NotFoundError); ok {
+			err = &NotFoundError{searchjob.Label}
+		} else if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return 0, err
+	}
+	sju.mutation.done = true
+	return n, nil
+}
+
+// SearchJobUpdateOne is the builder for updating a single SearchJob entity.
+type SearchJobUpdateOne struct {
+	config
+	fields    []string
+	hooks     []Hook
+	mutation  *SearchJobMutation
+	modifiers []func(*sql.UpdateBuilder)
+}
+
+// SetQuery sets the "query" field.
+func (sjuo *SearchJobUpdateOne) SetQuery(s string) *SearchJobUpdateOne {
+	sjuo.mutation.SetQuery(s)
+	return sjuo
+}
+
+// SetNillableQuery sets the "query" field if the given value is not nil.
+func (sjuo *SearchJobUpdateOne) SetNillableQuery(s *string) *SearchJobUpdateOne {
+	if s != nil {
+		sjuo.SetQuery(*s)
+	}
+	return sjuo
+}
+
+// SetMaxResults sets the "max_results" field.
+func (sjuo *SearchJobUpdateOne) SetMaxResults(i int) *SearchJobUpdateOne {
+	sjuo.mutation.ResetMaxResults()
+	sjuo.mutation.SetMaxResults(i)
+	return sjuo
+}
+
+// SetNillableMaxResults sets the "max_results" field if the given value is not nil.
+func (sjuo *SearchJobUpdateOne) SetNillableMaxResults(i *int) *SearchJobUpdateOne {
+	if i != nil {
+		sjuo.SetMaxResults(*i)
+	}
+	return sjuo
+}
+
+// AddMaxResults adds i to the "max_results" field.
+func (sjuo *SearchJobUpdateOne) AddMaxResults(i int) *SearchJobUpdateOne {
+	sjuo.mutation.AddMaxResults(i)
+	return sjuo
+}
+
+// SetUserID sets the "user_id" field.
+func (sjuo *SearchJobUpdateOne) SetUserID(i int) *SearchJobUpdateOne {
+	sjuo.mutation.SetUserID(i)
+	return sjuo
+}
+
+// SetNillableUserID sets the "user_id" field if the given value is not nil.
+func (sjuo *SearchJobUpdateOne) SetNillableUserID(i *int) *SearchJobUpdateOne {
+	if i != nil {
+		sjuo.SetUserID(*i)
+	}
+	return sjuo
+}
+
+// SetStatus sets the "status" field.
+func (sjuo *SearchJobUpdateOne) SetStatus(s searchjob.Status) *SearchJobUpdateOne {
+	sjuo.mutation.SetStatus(s)
+	return sjuo
+}
+
+// SetNillableStatus sets the "status" field if the given value is not nil.
+func (sjuo *SearchJobUpdateOne) SetNillableStatus(s *searchjob.Status) *SearchJobUpdateOne {
+	if s != nil {
+		sjuo.SetStatus(*s)
+	}
+	return sjuo
+}
+
+// SetSaved sets the "saved" field.
+func (sjuo *SearchJobUpdateOne) SetSaved(i int) *SearchJobUpdateOne {
+	sjuo.mutation.ResetSaved()
+	sjuo.mutation.SetSaved(i)
+	return sjuo
+}
+
+// SetNillableSaved sets the "saved" field if the given value is not nil.
+func (sjuo *SearchJobUpdateOne) SetNillableSaved(i *int) *SearchJobUpdateOne {
+	if i != nil {
+		sjuo.SetSaved(*i)
+	}
+	return sjuo
+}
+
+// AddSaved adds i to the "saved" field.
+func (sjuo *SearchJobUpdateOne) AddSaved(i int) *SearchJobUpdateOne {
+	sjuo.mutation.AddSaved(i)
+	return sjuo
+}
+
+// SetFailed sets the "failed" field.
+func (sjuo *SearchJobUpdateOne) SetFailed(i int) *SearchJobUpdateOne {
+	sjuo.mutation.ResetFailed()
+	sjuo.mutation.SetFailed(i)
+	return sjuo
+}
+
+// SetNillableFailed sets the "failed" field if the given value is not nil.
+func (sjuo *SearchJobUpdateOne) SetNillableFailed(i *int) *SearchJobUpdateOne {
+	if i != nil {
+		sjuo.SetFailed(*i)
+	}
+	return sjuo
+}
+
+// AddFailed adds i to the "failed" field.
+func (sjuo *SearchJobUpdateOne) AddFailed(i int) *SearchJobUpdateOne {
+	sjuo.mutation.AddFailed(i)
+	return sjuo
+}
+
+// SetFileNames sets the "file_names" field.
+func (sjuo *SearchJobUpdateOne) SetFileNames(s []string) *SearchJobUpdateOne {
+	sjuo.mutation.SetFileNames(s)
+	return sjuo
+}
+
+// AppendFileNames appends s to the "file_names" field.
+func (sjuo *SearchJobUpdateOne) AppendFileNames(s []string) *SearchJobUpdateOne {
+	sjuo.mutation.AppendFileNames(s)
+	return sjuo
+}
+
+// ClearFileNames clears the value of the "file_names" field.
+func (sjuo *SearchJobUpdateOne) ClearFileNames() *SearchJobUpdateOne {
+	sjuo.mutation.ClearFileNames()
+	return sjuo
+}
+
+// SetDeduplicated sets the "deduplicated" field.
+func (sjuo *SearchJobUpdateOne) SetDeduplicated(i int) *SearchJobUpdateOne {
+	sjuo.mutation.ResetDeduplicated()
+	sjuo.mutation.SetDeduplicated(i)
+	return sjuo
+}
+
+// SetNillableDeduplicated sets the "deduplicated" field if the given value is not nil.
+func (sjuo *SearchJobUpdateOne) SetNillableDeduplicated(i *int) *SearchJobUpdateOne {
+	if i != nil {
+		sjuo.SetDeduplicated(*i)
+	}
+	return sjuo
+}
+
+// AddDeduplicated adds i to the "deduplicated" field.
+func (sjuo *SearchJobUpdateOne) AddDeduplicated(i int) *SearchJobUpdateOne {
+	sjuo.mutation.AddDeduplicated(i)
+	return sjuo
+}
+
+// SetDuplicateFileNames sets the "duplicate_file_names" field.
+func (sjuo *SearchJobUpdateOne) SetDuplicateFileNames(s []string) *SearchJobUpdateOne {
+	sjuo.mutation.SetDuplicateFileNames(s)
+	return sjuo
+}
+
+// AppendDuplicateFileNames appends s to the "duplicate_file_names" field.
+func (sjuo *SearchJobUpdateOne) AppendDuplicateFileNames(s []string) *SearchJobUpdateOne {
+	sjuo.mutation.AppendDuplicateFileNames(s)
+	return sjuo
+}
+
+// ClearDuplicateFileNames clears the value of the "duplicate_file_names" field.
+func (sjuo *SearchJobUpdateOne) ClearDuplicateFileNames() *SearchJobUpdateOne {
+	sjuo.mutation.ClearDuplicateFileNames()
+	return sjuo
+}
+
+// SetDuplicateOf sets the "duplicate_of" field.
+func (sjuo *SearchJobUpdateOne) SetDuplicateOf(s []string) *SearchJobUpdateOne {
+	sjuo.mutation.SetDuplicateOf(s)
+	return sjuo
+}
+
+// AppendDuplicateOf appends s to the "duplicate_of" field.
+func (sjuo *SearchJobUpdateOne) AppendDuplicateOf(s []string) *SearchJobUpdateOne {
+	sjuo.mutation.AppendDuplicateOf(s)
+	return sjuo
+}
+
+// ClearDuplicateOf clears the value of the "duplicate_of" field.
+func (sjuo *SearchJobUpdateOne) ClearDuplicateOf() *SearchJobUpdateOne {
+	sjuo.mutation.ClearDuplicateOf()
+	return sjuo
+}
+
+// SetCandidateUrls sets the "candidate_urls" field.
+func (sjuo *SearchJobUpdateOne) SetCandidateUrls(s []string) *SearchJobUpdateOne {
+	sjuo.mutation.SetCandidateUrls(s)
+	return sjuo
+}
+
+// AppendCandidateUrls appends s to the "candidate_urls" field.
+func (sjuo *SearchJobUpdateOne) AppendCandidateUrls(s []string) *SearchJobUpdateOne {
+	sjuo.mutation.AppendCandidateUrls(s)
+	return sjuo
+}
+
+// ClearCandidateUrls clears the value of the "candidate_urls" field.
+func (sjuo *SearchJobUpdateOne) ClearCandidateUrls() *SearchJobUpdateOne {
+	sjuo.mutation.ClearCandidateUrls()
+	return sjuo
+}
+
+// SetCandidateThumbnailUrls sets the "candidate_thumbnail_urls" field.
+func (sjuo *SearchJobUpdateOne) SetCandidateThumbnailUrls(s []string) *SearchJobUpdateOne {
+	sjuo.mutation.SetCandidateThumbnailUrls(s)
+	return sjuo
+}
+
+// AppendCandidateThumbnailUrls appends s to the "candidate_thumbnail_urls" field.
+func (sjuo *SearchJobUpdateOne) AppendCandidateThumbnailUrls(s []string) *SearchJobUpdateOne {
+	sjuo.mutation.AppendCandidateThumbnailUrls(s)
+	return sjuo
+}
+
+// ClearCandidateThumbnailUrls clears the value of the "candidate_thumbnail_urls" field.
+func (sjuo *SearchJobUpdateOne) ClearCandidateThumbnailUrls() *SearchJobUpdateOne {
+	sjuo.mutation.ClearCandidateThumbnailUrls()
+	return sjuo
+}
+
+// SetNextCandidate sets the "next_candidate" field.
+func (sjuo *SearchJobUpdateOne) SetNextCandidate(i int) *SearchJobUpdateOne {
+	sjuo.mutation.ResetNextCandidate()
+	sjuo.mutation.SetNextCandidate(i)
+	return sjuo
+}
+
+// SetNillableNextCandidate sets the "next_candidate" field if the given value is not nil.
+func (sjuo *SearchJobUpdateOne) SetNillableNextCandidate(i *int) *SearchJobUpdateOne {
+	if i != nil {
+		sjuo.SetNextCandidate(*i)
+	}
+	return sjuo
+}
+
+// AddNextCandidate adds i to the "next_candidate" field.
+func (sjuo *SearchJobUpdateOne) AddNextCandidate(i int) *SearchJobUpdateOne {
+	sjuo.mutation.AddNextCandidate(i)
+	return sjuo
+}
+
+// SetRunner sets the "runner" field.
+func (sjuo *SearchJobUpdateOne) SetRunner(s string) *SearchJobUpdateOne {
+	sjuo.mutation.SetRunner(s)
+	return sjuo
+}
+
+// SetNillableRunner sets the "runner" field if the given value is not nil.
+func (sjuo *SearchJobUpdateOne) SetNillableRunner(s *string) *SearchJobUpdateOne {
+	if s != nil {
+		sjuo.SetRunner(*s)
+	}
+	return sjuo
+}
+
+// ClearRunner clears the value of the "runner" field.
+func (sjuo *SearchJobUpdateOne) ClearRunner() *SearchJobUpdateOne {
+	sjuo.mutation.ClearRunner()
+	return sjuo
+}
+
+// SetHeartbeatAt sets the "heartbeat_at" field.
+func (sjuo *SearchJobUpdateOne) SetHeartbeatAt(t time.Time) *SearchJobUpdateOne {
+	sjuo.mutation.SetHeartbeatAt(t)
+	return sjuo
+}
+
+// SetNillableHeartbeatAt sets the "heartbeat_at" field if the given value is not nil.
+func (sjuo *SearchJobUpdateOne) SetNillableHeartbeatAt(t *time.Time) *SearchJobUpdateOne {
+	if t != nil {
+		sjuo.SetHeartbeatAt(*t)
+	}
+	return sjuo
+}
+
+// ClearHeartbeatAt clears the value of the "heartbeat_at" field.
+func (sjuo *SearchJobUpdateOne) ClearHeartbeatAt() *SearchJobUpdateOne {
+	sjuo.mutation.ClearHeartbeatAt()
+	return sjuo
+}
+
+// SetError sets the "error" field.
+func (sjuo *SearchJobUpdateOne) SetError(s string) *SearchJobUpdateOne {
+	sjuo.mutation.SetError(s)
+	return sjuo
+}
+
+// SetNillableError sets the "error" field if the given value is not nil.
+func (sjuo *SearchJobUpdateOne) SetNillableError(s *string) *SearchJobUpdateOne {
+	if s != nil {
+		sjuo.SetError(*s)
+	}
+	return sjuo
+}
+
+// ClearError clears the value of the "error" field.
+func (sjuo *SearchJobUpdateOne) ClearError() *SearchJobUpdateOne {
+	sjuo.mutation.ClearError()
+	return sjuo
+}
+
+// SetCreatedAt sets the "created_at" field.
+func (sjuo *SearchJobUpdateOne) SetCreatedAt(t time.Time) *SearchJobUpdateOne {
+	sjuo.mutation.SetCreatedAt(t)
+	return sjuo
+}
+
+// SetNillableCreatedAt sets the "created_at" field if the given value is not nil.
+func (sjuo *SearchJobUpdateOne) SetNillableCreatedAt(t *time.Time) *SearchJobUpdateOne {
+	if t != nil {
+		sjuo.SetCreatedAt(*t)
+	}
+	return sjuo
+}
+
+// ClearCreatedAt clears the value of the "created_at" field.
+func (sjuo *SearchJobUpdateOne) ClearCreatedAt() *SearchJobUpdateOne {
+	sjuo.mutation.ClearCreatedAt()
+	return sjuo
+}
+
+// SetUpdatedAt sets the "updated_at" field.
+func (sjuo *SearchJobUpdateOne) SetUpdatedAt(t time.Time) *SearchJobUpdateOne {
+	sjuo.mutation.SetUpdatedAt(t)
+	return sjuo
+}
+
+// SetStartedAt sets the "started_at" field.
+func (sjuo *SearchJobUpdateOne) SetStartedAt(t time.Time) *SearchJobUpdateOne {
+	sjuo.mutation.SetStartedAt(t)
+	return sjuo
+}
+
+// SetNillableStartedAt sets the "started_at" field if the given value is not nil.
+func (sjuo *SearchJobUpdateOne) SetNillableStartedAt(t *time.Time) *SearchJobUpdateOne {
+	if t != nil {
+		sjuo.SetStartedAt(*t)
+	}
+	return sjuo
+}
+
+// ClearStartedAt clears the value of the "started_at" field.
+func (sjuo *SearchJobUpdateOne) ClearStartedAt() *SearchJobUpdateOne {
+	sjuo.mutation.ClearStartedAt()
+	return sjuo
+}
+
+// SetFinishedAt sets the "finished_at" field.
+func (sjuo *SearchJobUpdateOne) SetFinishedAt(t time.Time) *SearchJobUpdateOne {
+	sjuo.mutation.SetFinishedAt(t)
+	return sjuo
+}
+
+// SetNillableFinishedAt sets the "finished_at" field if the given value is not nil.
+func (sjuo *SearchJobUpdateOne) SetNillableFinishedAt(t *time.Time) *SearchJobUpdateOne {
+	if t != nil {
+		sjuo.SetFinishedAt(*t)
+	}
+	return sjuo
+}
+
+// ClearFinishedAt clears the value of the "finished_at" field.
+func (sjuo *SearchJobUpdateOne) ClearFinishedAt() *SearchJobUpdateOne {
+	sjuo.mutation.ClearFinishedAt()
+	return sjuo
+}
+
+// SetUser sets the "user" edge to the User entity.
+func (sjuo *SearchJobUpdateOne) SetUser(u *User) *SearchJobUpdateOne {
+	return sjuo.SetUserID(u.ID)
+}
+
+// Mutation returns the SearchJobMutation object of the builder.
+func (sjuo *SearchJobUpdateOne) Mutation() *SearchJobMutation {
+	return sjuo.mutation
+}
+
+// ClearUser clears the "user" edge to the User entity.
+func (sjuo *SearchJobUpdateOne) ClearUser() *SearchJobUpdateOne {
+	sjuo.mutation.ClearUser()
+	return sjuo
+}
+
+// Where appends a list predicates to the SearchJobUpdate builder.
+func (sjuo *SearchJobUpdateOne) Where(ps ...predicate.SearchJob) *SearchJobUpdateOne {
+	sjuo.mutation.Where(ps...)
+	return sjuo
+}
+
+// Select allows selecting one or more fields (columns) of the returned entity.
+// The default is selecting all fields defined in the entity schema.
+func (sjuo *SearchJobUpdateOne) Select(field string, fields ...string) *SearchJobUpdateOne {
+	sjuo.fields = append([]string{field}, fields...)
+	return sjuo
+}
+
+// Save executes the query and returns the updated SearchJob entity.
+func (sjuo *SearchJobUpdateOne) Save(ctx context.Context) (*SearchJob, error) {
+	sjuo.defaults()
+	return withHooks(ctx, sjuo.sqlSave, sjuo.mutation, sjuo.hooks)
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (sjuo *SearchJobUpdateOne) SaveX(ctx context.Context) *SearchJob {
+	node, err := sjuo.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return node
+}
+
+// Exec executes the query on the entity.
+func (sjuo *SearchJobUpdateOne) Exec(ctx context.Context) error {
+	_, err := sjuo.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (sjuo *SearchJobUpdateOne) ExecX(ctx context.Context) {
+	if err := sjuo.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// defaults sets the default values of the builder before save.
+func (sjuo *SearchJobUpdateOne) defaults() {
+	if _, ok := sjuo.mutation.UpdatedAt(); !ok {
+		v := searchjob.UpdateDefaultUpdatedAt()
+		sjuo.mutation.SetUpdatedAt(v)
+	}
+}
+
+// check runs all checks and user-defined validators on the builder.
+func (sjuo *SearchJobUpdateOne) check() error {
+	if v, ok := sjuo.mutation.Query(); ok {
+		if err := searchjob.QueryValidator(v); err != nil {
+			return &ValidationError{Name: "query", err: fmt.Errorf(`ent: validator failed for field "SearchJob.query": %w`, err)}
+		}
+	}
+	if v, ok := sjuo.mutation.MaxResults(); ok {
+		if err := searchjob.MaxResultsValidator(v); err != nil {
+			return &ValidationError{Name: "max_results", err: fmt.Errorf(`ent: validator failed for field "SearchJob.max_results": %w`, err)}
+		}
+	}
+	if v, ok := sjuo.mutation.Status(); ok {
+		if err := searchjob.StatusValidator(v); err != nil {
+			return &ValidationError{Name: "status", err: fmt.Errorf(`ent: validator failed for field "SearchJob.status": %w`, err)}
+		}
+	}
+	if _, ok := sjuo.mutation.UserID(); sjuo.mutation.UserCleared() && !ok {
+		return errors.New(`ent: clearing a required unique edge "SearchJob.user"`)
+	}
+	return nil
+}
+
+// Modify adds a statement modifier for attaching custom logic to the UPDATE statement.
+func (sjuo *SearchJobUpdateOne) Modify(modifiers ...func(u *sql.UpdateBuilder)) *SearchJobUpdateOne {
+	sjuo.modifiers = append(sjuo.modifiers, modifiers...)
+	return sjuo
+}
+
+func (sjuo *SearchJobUpdateOne) sqlSave(ctx context.Context) (_node *SearchJob, err error) {
+	if err := sjuo.check(); err != nil {
+		return _node, err
+	}
+	_spec := sqlgraph.NewUpdateSpec(searchjob.Table, searchjob.Columns, sqlgraph.NewFieldSpec(searchjob.FieldID, field.TypeInt))
+	id, ok := sjuo.mutation.ID()
+	if !ok {
+		return nil, &ValidationError{Name: "id", err: errors.New(`ent: missing "SearchJob.id" for update`)}
+	}
+	_spec.Node.ID.Value = id
+	if fields := sjuo.fields; len(fields) > 0 {
+		_spec.Node.Columns = make([]string, 0, len(fields))
+		_spec.Node.Columns = append(_spec.Node.Columns, searchjob.FieldID)
+		for _, f := range fields {
+			if !searchjob.ValidColumn(f) {
+				return nil, &ValidationError{Name: f, err: fmt.Errorf("ent: invalid field %q for query", f)}
+			}
+			if f != searchjob.FieldID {
+				_spec.Node.Columns = append(_spec.Node.Columns, f)
+			}
+		}
+	}
+	if ps := sjuo.mutation.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	if value, ok := sjuo.mutation.Query(); ok {
+		_spec.SetField(searchjob.FieldQuery, field.TypeString, value)
+	}
+	if value, ok := sjuo.mutation.MaxResults(); ok {
+		_spec.SetField(searchjob.FieldMaxResults, field.TypeInt, value)
+	}
+	if value, ok := sjuo.mutation.AddedMaxResults(); ok {
+		_spec.AddField(searchjob.FieldMaxResults, field.TypeInt, value)
+	}
+	if value, ok := sjuo.mutation.Status(); ok {
+		_spec.SetField(searchjob.FieldStatus, field.TypeEnum, value)
+	}
+	if value, ok := sjuo.mutation.Saved(); ok {
+		_spec.SetField(searchjob.FieldSaved, field.TypeInt, value)
+	}
+	if value, ok := sjuo.mutation.AddedSaved(); ok {
+		_spec.AddField(searchjob.FieldSaved, field.TypeInt, value)
+	}
+	if value, ok := sjuo.mutation.Failed(); ok {
+		_spec.SetField(searchjob.FieldFailed, field.TypeInt, value)
+	}
+	if value, ok := sjuo.mutation.AddedFailed(); ok {
+		_spec.AddField(searchjob.FieldFailed, field.TypeInt, value)
+	}
+	if value, ok := sjuo.mutation.FileNames(); ok {
+		_spec.SetField(searchjob.FieldFileNames, field.TypeJSON, value)
+	}
+	if value, ok := sjuo.mutation.AppendedFileNames(); ok {
+		_spec.AddModifier(func(u *sql.UpdateBuilder) {
+			sqljson.Append(u, searchjob.FieldFileNames, value)
+		})
+	}
+	if sjuo.mutation.FileNamesCleared() {
+		_spec.ClearField(searchjob.FieldFileNames, field.TypeJSON)
+	}
+	if value, ok := sjuo.mutation.Deduplicated(); ok {
+		_spec.SetField(searchjob.FieldDeduplicated, field.TypeInt, value)
+	}
+	if value, ok := sjuo.mutation.AddedDeduplicated(); ok {
+		_spec.AddField(searchjob.FieldDeduplicated, field.TypeInt, value)
+	}
+	if value, ok := sjuo.mutation.DuplicateFileNames(); ok {
+		_spec.SetField(searchjob.FieldDuplicateFileNames, field.TypeJSON, value)
+	}
+	if value, ok := sjuo.mutation.AppendedDuplicateFileNames(); ok {
+		_spec.AddModifier(func(u *sql.UpdateBuilder) {
+			sqljson.Append(u, searchjob.FieldDuplicateFileNames, value)
+		})
+	}
+	if sjuo.mutation.DuplicateFileNamesCleared() {
+		_spec.ClearField(searchjob.FieldDuplicateFileNames, field.TypeJSON)
+	}
+	if value, ok := sjuo.mutation.DuplicateOf(); ok {
+		_spec.SetField(searchjob.FieldDuplicateOf, field.TypeJSON, value)
+	}
+	if value, ok := sjuo.mutation.AppendedDuplicateOf(); ok {
+		_spec.AddModifier(func(u *sql.UpdateBuilder) {
+			sqljson.Append(u, searchjob.FieldDuplicateOf, value)
+		})
+	}
+	if sjuo.mutation.DuplicateOfCleared() {
+		_spec.ClearField(searchjob.FieldDuplicateOf, field.TypeJSON)
+	}
+	if value, ok := sjuo.mutation.CandidateUrls(); ok {
+		_spec.SetField(searchjob.FieldCandidateUrls, field.TypeJSON, value)
+	}
+	if value, ok := sjuo.mutation.AppendedCandidateUrls(); ok {
+		_spec.AddModifier(func(u *sql.UpdateBuilder) {
+			sqljson.Append(u, searchjob.FieldCandidateUrls, value)
+		})
+	}
+	if sjuo.mutation.CandidateUrlsCleared() {
+		_spec.ClearField(searchjob.FieldCandidateUrls, field.TypeJSON)
+	}
+	if value, ok := sjuo.mutation.CandidateThumbnailUrls(); ok {
+		_spec.SetField(searchjob.FieldCandidateThumbnailUrls, field.TypeJSON, value)
+	}
+	if value, ok := sjuo.mutation.AppendedCandidateThumbnailUrls(); ok {
+		_spec.AddModifier(func(u *sql.UpdateBuilder) {
+			sqljson.Append(u, searchjob.FieldCandidateThumbnailUrls, value)
+		})
+	}
+	if sjuo.mutation.CandidateThumbnailUrlsCleared() {
+		_spec.ClearField(searchjob.FieldCandidateThumbnailUrls, field.TypeJSON)
+	}
+	if value, ok := sjuo.mutation.NextCandidate(); ok {
+		_spec.SetField(searchjob.FieldNextCandidate, field.TypeInt, value)
+	}
+	if value, ok := sjuo.mutation.AddedNextCandidate(); ok {
+		_spec.AddField(searchjob.FieldNextCandidate, field.TypeInt, value)
+	}
+	if value, ok := sjuo.mutation.Runner(); ok {
+		_spec.SetField(searchjob.FieldRunner, field.TypeString, value)
+	}
+	if sjuo.mutation.RunnerCleared() {
+		_spec.ClearField(searchjob.FieldRunner, field.TypeString)
+	}
+	if value, ok := sjuo.mutation.HeartbeatAt(); ok {
+		_spec.SetField(searchjob.FieldHeartbeatAt, field.TypeTime, value)
+	}
+	if sjuo.mutation.HeartbeatAtCleared() {
+		_spec.ClearField(searchjob.FieldHeartbeatAt, field.TypeTime)
+	}
+	if value, ok := sjuo.mutation.Error(); ok {
+		_spec.SetField(searchjob.FieldError, field.TypeString, value)
+	}
+	if sjuo.mutation.ErrorCleared() {
+		_spec.ClearField(searchjob.FieldError, field.TypeString)
+	}
+	if value, ok := sjuo.mutation.CreatedAt(); ok {
+		_spec.SetField(searchjob.FieldCreatedAt, field.TypeTime, value)
+	}
+	if sjuo.mutation.CreatedAtCleared() {
+		_spec.ClearField(searchjob.FieldCreatedAt, field.TypeTime)
+	}
+	if value, ok := sjuo.mutation.UpdatedAt(); ok {
+		_spec.SetField(searchjob.FieldUpdatedAt, field.TypeTime, value)
+	}
+	if value, ok := sjuo.mutation.StartedAt(); ok {
+		_spec.SetField(searchjob.FieldStartedAt, field.TypeTime, value)
+	}
+	if sjuo.mutation.StartedAtCleared() {
+		_spec.ClearField(searchjob.FieldStartedAt, field.TypeTime)
+	}
+	if value, ok := sjuo.mutation.FinishedAt(); ok {
+		_spec.SetField(searchjob.FieldFinishedAt, field.TypeTime, value)
+	}
+	if sjuo.mutation.FinishedAtCleared() {
+		_spec.ClearField(searchjob.FieldFinishedAt, field.TypeTime)
+	}
+	if sjuo.mutation.UserCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   searchjob.UserTable,
+			Columns: []string{searchjob.UserColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(user.FieldID, field.TypeInt),
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := sjuo.mutation.UserIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   searchjob.UserTable,
+			Columns: []string{searchjob.UserColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(user.FieldID, field.TypeInt),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
+	_spec.AddModifiers(sjuo.modifiers...)
+	_node = &SearchJob{config: sjuo.config}
+	_spec.Assign = _node.assignValues
+	_spec.ScanValues = _node.scanValues
+	if err = sqlgraph.UpdateNode(ctx, sjuo.driver, _spec); err != nil {
+		if _, ok := err.(*sqlgraph.NotFoundError); ok {
+			err = &NotFoundError{searchjob.Label}
+		} else if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return nil, err
+	}
+	sjuo.mutation.done = true
+	return _node, nil
+}
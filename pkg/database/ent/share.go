@@ -0,0 +1,236 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"fmt"
+	"strings"
+	"time"
+
+	"entgo.io/ent"
+	"entgo.io/ent/dialect/sql"
+	"github.com/lebleuciel/maani/pkg/database/ent/file"
+	"github.com/lebleuciel/maani/pkg/database/ent/share"
+	"github.com/lebleuciel/maani/pkg/database/ent/user"
+)
+
+// Share is the model entity for the Share schema.
+type Share struct {
+	config `json:"-"`
+	// ID of the ent.
+	ID int `json:"id,omitempty"`
+	// FileID holds the value of the "file_id" field.
+	FileID int `json:"file_id,omitempty"`
+	// UserID holds the value of the "user_id" field.
+	UserID *int `json:"user_id,omitempty"`
+	// Permission holds the value of the "permission" field.
+	Permission share.Permission `json:"permission,omitempty"`
+	// ExpiresAt holds the value of the "expires_at" field.
+	ExpiresAt *time.Time `json:"expires_at,omitempty"`
+	// MaxDownloads holds the value of the "max_downloads" field.
+	MaxDownloads *int `json:"max_downloads,omitempty"`
+	// Downloads holds the value of the "downloads" field.
+	Downloads int `json:"downloads,omitempty"`
+	// CreatedAt holds the value of the "created_at" field.
+	CreatedAt *time.Time `json:"created_at,omitempty"`
+	// Edges holds the relations/edges for other nodes in the graph.
+	// The values are being populated by the ShareQuery when eager-loading is set.
+	Edges        ShareEdges `json:"edges"`
+	selectValues sql.SelectValues
+}
+
+// ShareEdges holds the relations/edges for other nodes in the graph.
+type ShareEdges struct {
+	// File holds the value of the file edge.
+	File *File `json:"file,omitempty"`
+	// User holds the value of the user edge.
+	User *User `json:"user,omitempty"`
+	// loadedTypes holds the information for reporting if a
+	// type was loaded (or requested) in eager-loading or not.
+	loadedTypes [2]bool
+}
+
+// FileOrErr returns the File value or an error if the edge
+// was not loaded in eager-loading, or loaded but was not found.
+func (e ShareEdges) FileOrErr() (*File, error) {
+	if e.loadedTypes[0] {
+		if e.File == nil {
+			// Edge was loaded but was not found.
+			return nil, &NotFoundError{label: file.Label}
+		}
+		return e.File, nil
+	}
+	return nil, &NotLoadedError{edge: "file"}
+}
+
+// UserOrErr returns the User value or an error if the edge
+// was not loaded in eager-loading, or loaded but was not found.
+func (e ShareEdges) UserOrErr() (*User, error) {
+	if e.loadedTypes[1] {
+		if e.User == nil {
+			// Edge was loaded but was not found.
+			return nil, &NotFoundError{label: user.Label}
+		}
+		return e.User, nil
+	}
+	return nil, &NotLoadedError{edge: "user"}
+}
+
+// scanValues returns the types for scanning values from sql.Rows.
+func (*Share) scanValues(columns []string) ([]any, error) {
+	values := make([]any, len(columns))
+	for i := range columns {
+		switch columns[i] {
+		case share.FieldID, share.FieldFileID, share.FieldUserID, share.FieldMaxDownloads, share.FieldDownloads:
+			values[i] = new(sql.NullInt64)
+		case share.FieldPermission:
+			values[i] = new(sql.NullString)
+		case share.FieldExpiresAt, share.FieldCreatedAt:
+			values[i] = new(sql.NullTime)
+		default:
+			values[i] = new(sql.UnknownType)
+		}
+	}
+	return values, nil
+}
+
+// assignValues assigns the values that were returned from sql.Rows (after scanning)
+// to the Share fields.
+func (s *Share) assignValues(columns []string, values []any) error {
+	if m, n := len(values), len(columns); m < n {
+		return fmt.Errorf("mismatch number of scan values: %d != %d", m, n)
+	}
+	for i := range columns {
+		switch columns[i] {
+		case share.FieldID:
+			value, ok := values[i].(*sql.NullInt64)
+			if !ok {
+				return fmt.Errorf("unexpected type %T for field id", value)
+			}
+			s.ID = int(value.Int64)
+		case share.FieldFileID:
+			if value, ok := values[i].(*sql.NullInt64); !ok {
+				return fmt.Errorf("unexpected type %T for field file_id", values[i])
+			} else if value.Valid {
+				s.FileID = int(value.Int64)
+			}
+		case share.FieldUserID:
+			if value, ok := values[i].(*sql.NullInt64); !ok {
+				return fmt.Errorf("unexpected type %T for field user_id", values[i])
+			} else if value.Valid {
+				s.UserID = new(int)
+				*s.UserID = int(value.Int64)
+			}
+		case share.FieldPermission:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field permission", values[i])
+			} else if value.Valid {
+				s.Permission = share.Permission(value.String)
+			}
+		case share.FieldExpiresAt:
+			if value, ok := values[i].(*sql.NullTime); !ok {
+				return fmt.Errorf("unexpected type %T for field expires_at", values[i])
+			} else if value.Valid {
+				s.ExpiresAt = new(time.Time)
+				*s.ExpiresAt = value.Time
+			}
+		case share.FieldMaxDownloads:
+			if value, ok := values[i].(*sql.NullInt64); !ok {
+				return fmt.Errorf("unexpected type %T for field max_downloads", values[i])
+			} else if value.Valid {
+				s.MaxDownloads = new(int)
+				*s.MaxDownloads = int(value.Int64)
+			}
+		case share.FieldDownloads:
+			if value, ok := values[i].(*sql.NullInt64); !ok {
+				return fmt.Errorf("unexpected type %T for field downloads", values[i])
+			} else if value.Valid {
+				s.Downloads = int(value.Int64)
+			}
+		case share.FieldCreatedAt:
+			if value, ok := values[i].(*sql.NullTime); !ok {
+				return fmt.Errorf("unexpected type %T for field created_at", values[i])
+			} else if value.Valid {
+				s.CreatedAt = new(time.Time)
+				*s.CreatedAt = value.Time
+			}
+		default:
+			s.selectValues.Set(columns[i], values[i])
+		}
+	}
+	return nil
+}
+
+// Value returns the ent.Value that was dynamically selected and assigned to the Share.
+// This includes values selected through modifiers, order, etc.
+func (s *Share) Value(name string) (ent.Value, error) {
+	return s.selectValues.Get(name)
+}
+
+// QueryFile queries the "file" edge of the Share entity.
+func (s *Share) QueryFile() *FileQuery {
+	return NewShareClient(s.config).QueryFile(s)
+}
+
+// QueryUser queries the "user" edge of the Share entity.
+func (s *Share) QueryUser() *UserQuery {
+	return NewShareClient(s.config).QueryUser(s)
+}
+
+// Update returns a builder for updating this Share.
+// Note that you need to call Share.Unwrap() before calling this method if this Share
+// was returned from a transaction, and the transaction was committed or rolled back.
+func (s *Share) Update() *ShareUpdateOne {
+	return NewShareClient(s.config).UpdateOne(s)
+}
+
+// Unwrap unwraps the Share entity that was returned from a transaction after it was closed,
+// so that all future queries will be executed through the driver which created the transaction.
+func (s *Share) Unwrap() *Share {
+	_tx, ok := s.config.driver.(*txDriver)
+	if !ok {
+		panic("ent: Share is not a transactional entity")
+	}
+	s.config.driver = _tx.drv
+	return s
+}
+
+// String implements the fmt.Stringer.
+func (s *Share) String() string {
+	var builder strings.Builder
+	builder.WriteString("Share(")
+	builder.WriteString(fmt.Sprintf("id=%v, ", s.ID))
+	builder.WriteString("file_id=")
+	builder.WriteString(fmt.Sprintf("%v", s.FileID))
+	builder.WriteString(", ")
+	if v := s.UserID; v != nil {
+		builder.WriteString("user_id=")
+		builder.WriteString(fmt.Sprintf("%v", *v))
+	}
+	builder.WriteString(", ")
+	builder.WriteString("permission=")
+	builder.WriteString(fmt.Sprintf("%v", s.Permission))
+	builder.WriteString(", ")
+	if v := s.ExpiresAt; v != nil {
+		builder.WriteString("expires_at=")
+		builder.WriteString(v.Format(time.ANSIC))
+	}
+	builder.WriteString(", ")
+	if v := s.MaxDownloads; v != nil {
+		builder.WriteString("max_downloads=")
+		builder.WriteString(fmt.Sprintf("%v", *v))
+	}
+	builder.WriteString(", ")
+	builder.WriteString("downloads=")
+	builder.WriteString(fmt.Sprintf("%v", s.Downloads))
+	builder.WriteString(", ")
+	if v := s.CreatedAt; v != nil {
+		builder.WriteString("created_at=")
+		builder.WriteString(v.Format(time.ANSIC))
+	}
+	builder.WriteByte(')')
+	return builder.String()
+}
+
+// Shares is a parsable slice of Share.
+type Shares []*Share
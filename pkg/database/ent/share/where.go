@@ -0,0 +1,407 @@
+// Code generated by ent, DO NOT EDIT.
+
+package share
+
+import (
+	"time"
+
+	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"github.com/lebleuciel/maani/pkg/database/ent/predicate"
+)
+
+// ID filters vertices based on their ID field.
+func ID(id int) predicate.Share {
+	return predicate.Share(sql.FieldEQ(FieldID, id))
+}
+
+// IDEQ applies the EQ predicate on the ID field.
+func IDEQ(id int) predicate.Share {
+	return predicate.Share(sql.FieldEQ(FieldID, id))
+}
+
+// IDNEQ applies the NEQ predicate on the ID field.
+func IDNEQ(id int) predicate.Share {
+	return predicate.Share(sql.FieldNEQ(FieldID, id))
+}
+
+// IDIn applies the In predicate on the ID field.
+func IDIn(ids ...int) predicate.Share {
+	return predicate.Share(sql.FieldIn(FieldID, ids...))
+}
+
+// IDNotIn applies the NotIn predicate on the ID field.
+func IDNotIn(ids ...int) predicate.Share {
+	return predicate.Share(sql.FieldNotIn(FieldID, ids...))
+}
+
+// IDGT applies the GT predicate on the ID field.
+func IDGT(id int) predicate.Share {
+	return predicate.Share(sql.FieldGT(FieldID, id))
+}
+
+// IDGTE applies the GTE predicate on the ID field.
+func IDGTE(id int) predicate.Share {
+	return predicate.Share(sql.FieldGTE(FieldID, id))
+}
+
+// IDLT applies the LT predicate on the ID field.
+func IDLT(id int) predicate.Share {
+	return predicate.Share(sql.FieldLT(FieldID, id))
+}
+
+// IDLTE applies the LTE predicate on the ID field.
+func IDLTE(id int) predicate.Share {
+	return predicate.Share(sql.FieldLTE(FieldID, id))
+}
+
+// FileID applies equality check predicate on the "file_id" field. It's identical to FileIDEQ.
+func FileID(v int) predicate.Share {
+	return predicate.Share(sql.FieldEQ(FieldFileID, v))
+}
+
+// UserID applies equality check predicate on the "user_id" field. It's identical to UserIDEQ.
+func UserID(v int) predicate.Share {
+	return predicate.Share(sql.FieldEQ(FieldUserID, v))
+}
+
+// ExpiresAt applies equality check predicate on the "expires_at" field. It's identical to ExpiresAtEQ.
+func ExpiresAt(v time.Time) predicate.Share {
+	return predicate.Share(sql.FieldEQ(FieldExpiresAt, v))
+}
+
+// MaxDownloads applies equality check predicate on the "max_downloads" field. It's identical to MaxDownloadsEQ.
+func MaxDownloads(v int) predicate.Share {
+	return predicate.Share(sql.FieldEQ(FieldMaxDownloads, v))
+}
+
+// Downloads applies equality check predicate on the "downloads" field. It's identical to DownloadsEQ.
+func Downloads(v int) predicate.Share {
+	return predicate.Share(sql.FieldEQ(FieldDownloads, v))
+}
+
+// CreatedAt applies equality check predicate on the "created_at" field. It's identical to CreatedAtEQ.
+func CreatedAt(v time.Time) predicate.Share {
+	return predicate.Share(sql.FieldEQ(FieldCreatedAt, v))
+}
+
+// FileIDEQ applies the EQ predicate on the "file_id" field.
+func FileIDEQ(v int) predicate.Share {
+	return predicate.Share(sql.FieldEQ(FieldFileID, v))
+}
+
+// FileIDNEQ applies the NEQ predicate on the "file_id" field.
+func FileIDNEQ(v int) predicate.Share {
+	return predicate.Share(sql.FieldNEQ(FieldFileID, v))
+}
+
+// FileIDIn applies the In predicate on the "file_id" field.
+func FileIDIn(vs ...int) predicate.Share {
+	return predicate.Share(sql.FieldIn(FieldFileID, vs...))
+}
+
+// FileIDNotIn applies the NotIn predicate on the "file_id" field.
+func FileIDNotIn(vs ...int) predicate.Share {
+	return predicate.Share(sql.FieldNotIn(FieldFileID, vs...))
+}
+
+// UserIDEQ applies the EQ predicate on the "user_id" field.
+func UserIDEQ(v int) predicate.Share {
+	return predicate.Share(sql.FieldEQ(FieldUserID, v))
+}
+
+// UserIDNEQ applies the NEQ predicate on the "user_id" field.
+func UserIDNEQ(v int) predicate.Share {
+	return predicate.Share(sql.FieldNEQ(FieldUserID, v))
+}
+
+// UserIDIn applies the In predicate on the "user_id" field.
+func UserIDIn(vs ...int) predicate.Share {
+	return predicate.Share(sql.FieldIn(FieldUserID, vs...))
+}
+
+// UserIDNotIn applies the NotIn predicate on the "user_id" field.
+func UserIDNotIn(vs ...int) predicate.Share {
+	return predicate.Share(sql.FieldNotIn(FieldUserID, vs...))
+}
+
+// UserIDIsNil applies the IsNil predicate on the "user_id" field.
+func UserIDIsNil() predicate.Share {
+	return predicate.Share(sql.FieldIsNull(FieldUserID))
+}
+
+// UserIDNotNil applies the NotNil predicate on the "user_id" field.
+func UserIDNotNil() predicate.Share {
+	return predicate.Share(sql.FieldNotNull(FieldUserID))
+}
+
+// PermissionEQ applies the EQ predicate on the "permission" field.
+func PermissionEQ(v Permission) predicate.Share {
+	return predicate.Share(sql.FieldEQ(FieldPermission, v))
+}
+
+// PermissionNEQ applies the NEQ predicate on the "permission" field.
+func PermissionNEQ(v Permission) predicate.Share {
+	return predicate.Share(sql.FieldNEQ(FieldPermission, v))
+}
+
+// PermissionIn applies the In predicate on the "permission" field.
+func PermissionIn(vs ...Permission) predicate.Share {
+	return predicate.Share(sql.FieldIn(FieldPermission, vs...))
+}
+
+// PermissionNotIn applies the NotIn predicate on the "permission" field.
+func PermissionNotIn(vs ...Permission) predicate.Share {
+	return predicate.Share(sql.FieldNotIn(FieldPermission, vs...))
+}
+
+// ExpiresAtEQ applies the EQ predicate on the "expires_at" field.
+func ExpiresAtEQ(v time.Time) predicate.Share {
+	return predicate.Share(sql.FieldEQ(FieldExpiresAt, v))
+}
+
+// ExpiresAtNEQ applies the NEQ predicate on the "expires_at" field.
+func ExpiresAtNEQ(v time.Time) predicate.Share {
+	return predicate.Share(sql.FieldNEQ(FieldExpiresAt, v))
+}
+
+// ExpiresAtIn applies the In predicate on the "expires_at" field.
+func ExpiresAtIn(vs ...time.Time) predicate.Share {
+	return predicate.Share(sql.FieldIn(FieldExpiresAt, vs...))
+}
+
+// ExpiresAtNotIn applies the NotIn predicate on the "expires_at" field.
+func ExpiresAtNotIn(vs ...time.Time) predicate.Share {
+	return predicate.Share(sql.FieldNotIn(FieldExpiresAt, vs...))
+}
+
+// ExpiresAtGT applies the GT predicate on the "expires_at" field.
+func ExpiresAtGT(v time.Time) predicate.Share {
+	return predicate.Share(sql.FieldGT(FieldExpiresAt, v))
+}
+
+// ExpiresAtGTE applies the GTE predicate on the "expires_at" field.
+func ExpiresAtGTE(v time.Time) predicate.Share {
+	return predicate.Share(sql.FieldGTE(FieldExpiresAt, v))
+}
+
+// ExpiresAtLT applies the LT predicate on the "expires_at" field.
+func ExpiresAtLT(v time.Time) predicate.Share {
+	return predicate.Share(sql.FieldLT(FieldExpiresAt, v))
+}
+
+// ExpiresAtLTE applies the LTE predicate on the "expires_at" field.
+func ExpiresAtLTE(v time.Time) predicate.Share {
+	return predicate.Share(sql.FieldLTE(FieldExpiresAt, v))
+}
+
+// ExpiresAtIsNil applies the IsNil predicate on the "expires_at" field.
+func ExpiresAtIsNil() predicate.Share {
+	return predicate.Share(sql.FieldIsNull(FieldExpiresAt))
+}
+
+// ExpiresAtNotNil applies the NotNil predicate on the "expires_at" field.
+func ExpiresAtNotNil() predicate.Share {
+	return predicate.Share(sql.FieldNotNull(FieldExpiresAt))
+}
+
+// MaxDownloadsEQ applies the EQ predicate on the "max_downloads" field.
+func MaxDownloadsEQ(v int) predicate.Share {
+	return predicate.Share(sql.FieldEQ(FieldMaxDownloads, v))
+}
+
+// MaxDownloadsNEQ applies the NEQ predicate on the "max_downloads" field.
+func MaxDownloadsNEQ(v int) predicate.Share {
+	return predicate.Share(sql.FieldNEQ(FieldMaxDownloads, v))
+}
+
+// MaxDownloadsIn applies the In predicate on the "max_downloads" field.
+func MaxDownloadsIn(vs ...int) predicate.Share {
+	return predicate.Share(sql.FieldIn(FieldMaxDownloads, vs...))
+}
+
+// MaxDownloadsNotIn applies the NotIn predicate on the "max_downloads" field.
+func MaxDownloadsNotIn(vs ...int) predicate.Share {
+	return predicate.Share(sql.FieldNotIn(FieldMaxDownloads, vs...))
+}
+
+// MaxDownloadsGT applies the GT predicate on the "max_downloads" field.
+func MaxDownloadsGT(v int) predicate.Share {
+	return predicate.Share(sql.FieldGT(FieldMaxDownloads, v))
+}
+
+// MaxDownloadsGTE applies the GTE predicate on the "max_downloads" field.
+func MaxDownloadsGTE(v int) predicate.Share {
+	return predicate.Share(sql.FieldGTE(FieldMaxDownloads, v))
+}
+
+// MaxDownloadsLT applies the LT predicate on the "max_downloads" field.
+func MaxDownloadsLT(v int) predicate.Share {
+	return predicate.Share(sql.FieldLT(FieldMaxDownloads, v))
+}
+
+// MaxDownloadsLTE applies the LTE predicate on the "max_downloads" field.
+func MaxDownloadsLTE(v int) predicate.Share {
+	return predicate.Share(sql.FieldLTE(FieldMaxDownloads, v))
+}
+
+// MaxDownloadsIsNil applies the IsNil predicate on the "max_downloads" field.
+func MaxDownloadsIsNil() predicate.Share {
+	return predicate.Share(sql.FieldIsNull(FieldMaxDownloads))
+}
+
+// MaxDownloadsNotNil applies the NotNil predicate on the "max_downloads" field.
+func MaxDownloadsNotNil() predicate.Share {
+	return predicate.Share(sql.FieldNotNull(FieldMaxDownloads))
+}
+
+// DownloadsEQ applies the EQ predicate on the "downloads" field.
+func DownloadsEQ(v int) predicate.Share {
+	return predicate.Share(sql.FieldEQ(FieldDownloads, v))
+}
+
+// DownloadsNEQ applies the NEQ predicate on the "downloads" field.
+func DownloadsNEQ(v int) predicate.Share {
+	return predicate.Share(sql.FieldNEQ(FieldDownloads, v))
+}
+
+// DownloadsIn applies the In predicate on the "downloads" field.
+func DownloadsIn(vs ...int) predicate.Share {
+	return predicate.Share(sql.FieldIn(FieldDownloads, vs...))
+}
+
+// DownloadsNotIn applies the NotIn predicate on the "downloads" field.
+func DownloadsNotIn(vs ...int) predicate.Share {
+	return predicate.Share(sql.FieldNotIn(FieldDownloads, vs...))
+}
+
+// DownloadsGT applies the GT predicate on the "downloads" field.
+func DownloadsGT(v int) predicate.Share {
+	return predicate.Share(sql.FieldGT(FieldDownloads, v))
+}
+
+// DownloadsGTE applies the GTE predicate on the "downloads" field.
+func DownloadsGTE(v int) predicate.Share {
+	return predicate.Share(sql.FieldGTE(FieldDownloads, v))
+}
+
+// DownloadsLT applies the LT predicate on the "downloads" field.
+func DownloadsLT(v int) predicate.Share {
+	return predicate.Share(sql.FieldLT(FieldDownloads, v))
+}
+
+// DownloadsLTE applies the LTE predicate on the "downloads" field.
+func DownloadsLTE(v int) predicate.Share {
+	return predicate.Share(sql.FieldLTE(FieldDownloads, v))
+}
+
+// CreatedAtEQ applies the EQ predicate on the "created_at" field.
+func CreatedAtEQ(v time.Time) predicate.Share {
+	return predicate.Share(sql.FieldEQ(FieldCreatedAt, v))
+}
+
+// CreatedAtNEQ applies the NEQ predicate on the "created_at" field.
+func CreatedAtNEQ(v time.Time) predicate.Share {
+	return predicate.Share(sql.FieldNEQ(FieldCreatedAt, v))
+}
+
+// CreatedAtIn applies the In predicate on the "created_at" field.
+func CreatedAtIn(vs ...time.Time) predicate.Share {
+	return predicate.Share(sql.FieldIn(FieldCreatedAt, vs...))
+}
+
+// CreatedAtNotIn applies the NotIn predicate on the "created_at" field.
+func CreatedAtNotIn(vs ...time.Time) predicate.Share {
+	return predicate.Share(sql.FieldNotIn(FieldCreatedAt, vs...))
+}
+
+// CreatedAtGT applies the GT predicate on the "created_at" field.
+func CreatedAtGT(v time.Time) predicate.Share {
+	return predicate.Share(sql.FieldGT(FieldCreatedAt, v))
+}
+
+// CreatedAtGTE applies the GTE predicate on the "created_at" field.
+func CreatedAtGTE(v time.Time) predicate.Share {
+	return predicate.Share(sql.FieldGTE(FieldCreatedAt, v))
+}
+
+// CreatedAtLT applies the LT predicate on the "created_at" field.
+func CreatedAtLT(v time.Time) predicate.Share {
+	return predicate.Share(sql.FieldLT(FieldCreatedAt, v))
+}
+
+// CreatedAtLTE applies the LTE predicate on the "created_at" field.
+func CreatedAtLTE(v time.Time) predicate.Share {
+	return predicate.Share(sql.FieldLTE(FieldCreatedAt, v))
+}
+
+// CreatedAtIsNil applies the IsNil predicate on the "created_at" field.
+func CreatedAtIsNil() predicate.Share {
+	return predicate.Share(sql.FieldIsNull(FieldCreatedAt))
+}
+
+// CreatedAtNotNil applies the NotNil predicate on the "created_at" field.
+func CreatedAtNotNil() predicate.Share {
+	return predicate.Share(sql.FieldNotNull(FieldCreatedAt))
+}
+
+// HasFile applies the HasEdge predicate on the "file" edge.
+func HasFile() predicate.Share {
+	return predicate.Share(func(s *sql.Selector) {
+		step := sqlgraph.NewStep(
+			sqlgraph.From(Table, FieldID),
+			sqlgraph.Edge(sqlgraph.M2O, true, FileTable, FileColumn),
+		)
+		sqlgraph.HasNeighbors(s, step)
+	})
+}
+
+// HasFileWith applies the HasEdge predicate on the "file" edge with a given conditions (other predicates).
+func HasFileWith(preds ...predicate.File) predicate.Share {
+	return predicate.Share(func(s *sql.Selector) {
+		step := newFileStep()
+		sqlgraph.HasNeighborsWith(s, step, func(s *sql.Selector) {
+			for _, p := range preds {
+				p(s)
+			}
+		})
+	})
+}
+
+// HasUser applies the HasEdge predicate on the "user" edge.
+func HasUser() predicate.Share {
+	return predicate.Share(func(s *sql.Selector) {
+		step := sqlgraph.NewStep(
+			sqlgraph.From(Table, FieldID),
+			sqlgraph.Edge(sqlgraph.M2O, true, UserTable, UserColumn),
+		)
+		sqlgraph.HasNeighbors(s, step)
+	})
+}
+
+// HasUserWith applies the HasEdge predicate on the "user" edge with a given conditions (other predicates).
+func HasUserWith(preds ...predicate.User) predicate.Share {
+	return predicate.Share(func(s *sql.Selector) {
+		step := newUserStep()
+		sqlgraph.HasNeighborsWith(s, step, func(s *sql.Selector) {
+			for _, p := range preds {
+				p(s)
+			}
+		})
+	})
+}
+
+// And groups predicates with the AND operator between them.
+func And(predicates ...predicate.Share) predicate.Share {
+	return predicate.Share(sql.AndPredicates(predicates...))
+}
+
+// Or groups predicates with the OR operator between them.
+func Or(predicates ...predicate.Share) predicate.Share {
+	return predicate.Share(sql.OrPredicates(predicates...))
+}
+
+// Not applies the not operator on the given predicate.
+func Not(p predicate.Share) predicate.Share {
+	return predicate.Share(sql.NotPredicates(p))
+}
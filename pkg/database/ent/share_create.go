@@ -0,0 +1,998 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/schema/field"
+	"github.com/lebleuciel/maani/pkg/database/ent/file"
+	"github.com/lebleuciel/maani/pkg/database/ent/share"
+	"github.com/lebleuciel/maani/pkg/database/ent/user"
+)
+
+// ShareCreate is the builder for creating a Share entity.
+type ShareCreate struct {
+	config
+	mutation *ShareMutation
+	hooks    []Hook
+	conflict []sql.ConflictOption
+}
+
+// SetFileID sets the "file_id" field.
+func (sc *ShareCreate) SetFileID(i int) *ShareCreate {
+	sc.mutation.SetFileID(i)
+	return sc
+}
+
+// SetUserID sets the "user_id" field.
+func (sc *ShareCreate) SetUserID(i int) *ShareCreate {
+	sc.mutation.SetUserID(i)
+	return sc
+}
+
+// SetNillableUserID sets the "user_id" field if the given value is not nil.
+func (sc *ShareCreate) SetNillableUserID(i *int) *ShareCreate {
+	if i != nil {
+		sc.SetUserID(*i)
+	}
+	return sc
+}
+
+// SetPermission sets the "permission" field.
+func (sc *ShareCreate) SetPermission(s share.Permission) *ShareCreate {
+	sc.mutation.SetPermission(s)
+	return sc
+}
+
+// SetNillablePermission sets the "permission" field if the given value is not nil.
+func (sc *ShareCreate) SetNillablePermission(s *share.Permission) *ShareCreate {
+	if s != nil {
+		sc.SetPermission(*s)
+	}
+	return sc
+}
+
+// SetExpiresAt sets the "expires_at" field.
+func (sc *ShareCreate) SetExpiresAt(t time.Time) *ShareCreate {
+	sc.mutation.SetExpiresAt(t)
+	return sc
+}
+
+// SetNillableExpiresAt sets the "expires_at" field if the given value is not nil.
+func (sc *ShareCreate) SetNillableExpiresAt(t *time.Time) *ShareCreate {
+	if t != nil {
+		sc.SetExpiresAt(*t)
+	}
+	return sc
+}
+
+// SetMaxDownloads sets the "max_downloads" field.
+func (sc *ShareCreate) SetMaxDownloads(i int) *ShareCreate {
+	sc.mutation.SetMaxDownloads(i)
+	return sc
+}
+
+// SetNillableMaxDownloads sets the "max_downloads" field if the given value is not nil.
+func (sc *ShareCreate) SetNillableMaxDownloads(i *int) *ShareCreate {
+	if i != nil {
+		sc.SetMaxDownloads(*i)
+	}
+	return sc
+}
+
+// SetDownloads sets the "downloads" field.
+func (sc *ShareCreate) SetDownloads(i int) *ShareCreate {
+	sc.mutation.SetDownloads(i)
+	return sc
+}
+
+// SetNillableDownloads sets the "downloads" field if the given value is not nil.
+func (sc *ShareCreate) SetNillableDownloads(i *int) *ShareCreate {
+	if i != nil {
+		sc.SetDownloads(*i)
+	}
+	return sc
+}
+
+// SetCreatedAt sets the "created_at" field.
+func (sc *ShareCreate) SetCreatedAt(t time.Time) *ShareCreate {
+	sc.mutation.SetCreatedAt(t)
+	return sc
+}
+
+// SetNillableCreatedAt sets the "created_at" field if the given value is not nil.
+func (sc *ShareCreate) SetNillableCreatedAt(t *time.Time) *ShareCreate {
+	if t != nil {
+		sc.SetCreatedAt(*t)
+	}
+	return sc
+}
+
+// SetFile sets the "file" edge to the File entity.
+func (sc *ShareCreate) SetFile(f *File) *ShareCreate {
+	return sc.SetFileID(f.ID)
+}
+
+// SetUser sets the "user" edge to the User entity.
+func (sc *ShareCreate) SetUser(u *User) *ShareCreate {
+	return sc.SetUserID(u.ID)
+}
+
+// Mutation returns the ShareMutation object of the builder.
+func (sc *ShareCreate) Mutation() *ShareMutation {
+	return sc.mutation
+}
+
+// Save creates the Share in the database.
+func (sc *ShareCreate) Save(ctx context.Context) (*Share, error) {
+	sc.defaults()
+	return withHooks(ctx, sc.sqlSave, sc.mutation, sc.hooks)
+}
+
+// SaveX calls Save and panics if Save returns an error.
+func (sc *ShareCreate) SaveX(ctx context.Context) *Share {
+	v, err := sc.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
+
+// Exec executes the query.
+func (sc *ShareCreate) Exec(ctx context.Context) error {
+	_, err := sc.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (sc *ShareCreate) ExecX(ctx context.Context) {
+	if err := sc.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// defaults sets the default values of the builder before save.
+func (sc *ShareCreate) defaults() {
+	if _, ok := sc.mutation.Permission(); !ok {
+		v := share.DefaultPermission
+		sc.mutation.SetPermission(v)
+	}
+	if _, ok := sc.mutation.Downloads(); !ok {
+		v := share.DefaultDownloads
+		sc.mutation.SetDownloads(v)
+	}
+	if _, ok := sc.mutation.CreatedAt(); !ok {
+		v := share.DefaultCreatedAt()
+		sc.mutation.SetCreatedAt(v)
+	}
+}
+
+// check runs all checks and user-defined validators on the builder.
+func (sc *ShareCreate) check() error {
+	if _, ok := sc.mutation.FileID(); !ok {
+		return &ValidationError{Name: "file_id", err: errors.New(`ent: missing required field "Share.file_id"`)}
+	}
+	if _, ok := sc.mutation.Permission(); !ok {
+		return &ValidationError{Name: "permission", err: errors.New(`ent: missing required field "Share.permission"`)}
+	}
+	if v, ok := sc.mutation.Permission(); ok {
+		if err := share.PermissionValidator(v); err != nil {
+			return &ValidationError{Name: "permission", err: fmt.Errorf(`ent: validator failed for field "Share.permission": %w`, err)}
+		}
+	}
+	if v, ok := sc.mutation.MaxDownloads(); ok {
+		if err := share.MaxDownloadsValidator(v); err != nil {
+			return &ValidationError{Name: "max_downloads", err: fmt.Errorf(`ent: validator failed for field "Share.max_downloads": %w`, err)}
+		}
+	}
+	if _, ok := sc.mutation.Downloads(); !ok {
+		return &ValidationError{Name: "downloads", err: errors.New(`ent: missing required field "Share.downloads"`)}
+	}
+	if _, ok := sc.mutation.FileID(); !ok {
+		return &ValidationError{Name: "file", err: errors.New(`ent: missing required edge "Share.file"`)}
+	}
+	return nil
+}
+
+func (sc *ShareCreate) sqlSave(ctx context.Context) (*Share, error) {
+	if err := sc.check(); err != nil {
+		return nil, err
+	}
+	_node, _spec := sc.createSpec()
+	if err := sqlgraph.CreateNode(ctx, sc.driver, _spec); err != nil {
+		if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return nil, err
+	}
+	id := _spec.ID.Value.(int64)
+	_node.ID = int(id)
+	sc.mutation.id = &_node.ID
+	sc.mutation.done = true
+	return _node, nil
+}
+
+func (sc *ShareCreate) createSpec() (*Share, *sqlgraph.CreateSpec) {
+	var (
+		_node = &Share{config: sc.config}
+		_spec = sqlgraph.NewCreateSpec(share.Table, sqlgraph.NewFieldSpec(share.FieldID, field.TypeInt))
+	)
+	_spec.OnConflict = sc.conflict
+	if value, ok := sc.mutation.Permission(); ok {
+		_spec.SetField(share.FieldPermission, field.TypeEnum, value)
+		_node.Permission = value
+	}
+	if value, ok := sc.mutation.ExpiresAt(); ok {
+		_spec.SetField(share.FieldExpiresAt, field.TypeTime, value)
+		_node.ExpiresAt = &value
+	}
+	if value, ok := sc.mutation.MaxDownloads(); ok {
+		_spec.SetField(share.FieldMaxDownloads, field.TypeInt, value)
+		_node.MaxDownloads = &value
+	}
+	if value, ok := sc.mutation.Downloads(); ok {
+		_spec.SetField(share.FieldDownloads, field.TypeInt, value)
+		_node.Downloads = value
+	}
+	if value, ok := sc.mutation.CreatedAt(); ok {
+		_spec.SetField(share.FieldCreatedAt, field.TypeTime, value)
+		_node.CreatedAt = &value
+	}
+	if nodes := sc.mutation.FileIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   share.FileTable,
+			Columns: []string{share.FileColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(file.FieldID, field.TypeInt),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_node.FileID = nodes[0]
+		_spec.Edges = append(_spec.Edges, edge)
+	}
+	if nodes := sc.mutation.UserIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   share.UserTable,
+			Columns: []string{share.UserColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(user.FieldID, field.TypeInt),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_node.UserID = &nodes[0]
+		_spec.Edges = append(_spec.Edges, edge)
+	}
+	return _node, _spec
+}
+
+// OnConflict allows configuring the `ON CONFLICT` / `ON DUPLICATE KEY` clause
+// of the `INSERT` statement. For example:
+//
+//	client.Share.Create().
+//		SetFileID(v).
+//		OnConflict(
+//			// Update the row with the new values
+//			// the was proposed for insertion.
+//			sql.ResolveWithNewValues(),
+//		).
+//		// Override some of the fields with custom
+//		// update values.
+//		Update(func(u *ent.ShareUpsert) {
+//			SetFileID(v+v).
+//		}).
+//		Exec(ctx)
+func (sc *ShareCreate) OnConflict(opts ...sql.ConflictOption) *ShareUpsertOne {
+	sc.conflict = opts
+	return &ShareUpsertOne{
+		create: sc,
+	}
+}
+
+// OnConflictColumns calls `OnConflict` and configures the columns
+// as conflict target. Using this option is equivalent to using:
+//
+//	client.Share.Create().
+//		OnConflict(sql.ConflictColumns(columns...)).
+//		Exec(ctx)
+func (sc *ShareCreate) OnConflictColumns(columns ...string) *ShareUpsertOne {
+	sc.conflict = append(sc.conflict, sql.ConflictColumns(columns...))
+	return &ShareUpsertOne{
+		create: sc,
+	}
+}
+
+type (
+	// ShareUpsertOne is the builder for "upsert"-ing
+	//  one Share node.
+	ShareUpsertOne struct {
+		create *ShareCreate
+	}
+
+	// ShareUpsert is the "OnConflict" setter.
+	ShareUpsert struct {
+		*sql.UpdateSet
+	}
+)
+
+// SetFileID sets the "file_id" field.
+func (u *ShareUpsert) SetFileID(v int) *ShareUpsert {
+	u.Set(share.FieldFileID, v)
+	return u
+}
+
+// UpdateFileID sets the "file_id" field to the value that was provided on create.
+func (u *ShareUpsert) UpdateFileID() *ShareUpsert {
+	u.SetExcluded(share.FieldFileID)
+	return u
+}
+
+// SetUserID sets the "user_id" field.
+func (u *ShareUpsert) SetUserID(v int) *ShareUpsert {
+	u.Set(share.FieldUserID, v)
+	return u
+}
+
+// UpdateUserID sets the "user_id" field to the value that was provided on create.
+func (u *ShareUpsert) UpdateUserID() *ShareUpsert {
+	u.SetExcluded(share.FieldUserID)
+	return u
+}
+
+// ClearUserID clears the value of the "user_id" field.
+func (u *ShareUpsert) ClearUserID() *ShareUpsert {
+	u.SetNull(share.FieldUserID)
+	return u
+}
+
+// SetPermission sets the "permission" field.
+func (u *ShareUpsert) SetPermission(v share.Permission) *ShareUpsert {
+	u.Set(share.FieldPermission, v)
+	return u
+}
+
+// UpdatePermission sets the "permission" field to the value that was provided on create.
+func (u *ShareUpsert) UpdatePermission() *ShareUpsert {
+	u.SetExcluded(share.FieldPermission)
+	return u
+}
+
+// SetExpiresAt sets the "expires_at" field.
+func (u *ShareUpsert) SetExpiresAt(v time.Time) *ShareUpsert {
+	u.Set(share.FieldExpiresAt, v)
+	return u
+}
+
+// UpdateExpiresAt sets the "expires_at" field to the value that was provided on create.
+func (u *ShareUpsert) UpdateExpiresAt() *ShareUpsert {
+	u.SetExcluded(share.FieldExpiresAt)
+	return u
+}
+
+// ClearExpiresAt clears the value of the "expires_at" field.
+func (u *ShareUpsert) ClearExpiresAt() *ShareUpsert {
+	u.SetNull(share.FieldExpiresAt)
+	return u
+}
+
+// SetMaxDownloads sets the "max_downloads" field.
+func (u *ShareUpsert) SetMaxDownloads(v int) *ShareUpsert {
+	u.Set(share.FieldMaxDownloads, v)
+	return u
+}
+
+// UpdateMaxDownloads sets the "max_downloads" field to the value that was provided on create.
+func (u *ShareUpsert) UpdateMaxDownloads() *ShareUpsert {
+	u.SetExcluded(share.FieldMaxDownloads)
+	return u
+}
+
+// AddMaxDownloads adds v to the "max_downloads" field.
+func (u *ShareUpsert) AddMaxDownloads(v int) *ShareUpsert {
+	u.Add(share.FieldMaxDownloads, v)
+	return u
+}
+
+// ClearMaxDownloads clears the value of the "max_downloads" field.
+func (u *ShareUpsert) ClearMaxDownloads() *ShareUpsert {
+	u.SetNull(share.FieldMaxDownloads)
+	return u
+}
+
+// SetDownloads sets the "downloads" field.
+func (u *ShareUpsert) SetDownloads(v int) *ShareUpsert {
+	u.Set(share.FieldDownloads, v)
+	return u
+}
+
+// UpdateDownloads sets the "downloads" field to the value that was provided on create.
+func (u *ShareUpsert) UpdateDownloads() *ShareUpsert {
+	u.SetExcluded(share.FieldDownloads)
+	return u
+}
+
+// AddDownloads adds v to the "downloads" field.
+func (u *ShareUpsert) AddDownloads(v int) *ShareUpsert {
+	u.Add(share.FieldDownloads, v)
+	return u
+}
+
+// SetCreatedAt sets the "created_at" field.
+func (u *ShareUpsert) SetCreatedAt(v time.Time) *ShareUpsert {
+	u.Set(share.FieldCreatedAt, v)
+	return u
+}
+
+// UpdateCreatedAt sets the "created_at" field to the value that was provided on create.
+func (u *ShareUpsert) UpdateCreatedAt() *ShareUpsert {
+	u.SetExcluded(share.FieldCreatedAt)
+	return u
+}
+
+// ClearCreatedAt clears the value of the "created_at" field.
+func (u *ShareUpsert) ClearCreatedAt() *ShareUpsert {
+	u.SetNull(share.FieldCreatedAt)
+	return u
+}
+
+// UpdateNewValues updates the mutable fields using the new values that were set on create.
+// Using this option is equivalent to using:
+//
+//	client.Share.Create().
+//		OnConflict(
+//			sql.ResolveWithNewValues(),
+//		).
+//		Exec(ctx)
+func (u *ShareUpsertOne) UpdateNewValues() *ShareUpsertOne {
+	u.create.conflict = append(u.create.conflict, sql.ResolveWithNewValues())
+	return u
+}
+
+// Ignore sets each column to itself in case of conflict.
+// Using this option is equivalent to using:
+//
+//	client.Share.Create().
+//	    OnConflict(sql.ResolveWithIgnore()).
+//	    Exec(ctx)
+func (u *ShareUpsertOne) Ignore() *ShareUpsertOne {
+	u.create.conflict = append(u.create.conflict, sql.ResolveWithIgnore())
+	return u
+}
+
+// DoNothing configures the conflict_action to `DO NOTHING`.
+// Supported only by SQLite and PostgreSQL.
+func (u *ShareUpsertOne) DoNothing() *ShareUpsertOne {
+	u.create.conflict = append(u.create.conflict, sql.DoNothing())
+	return u
+}
+
+// Update allows overriding fields `UPDATE` values. See the ShareCreate.OnConflict
+// documentation for more info.
+func (u *ShareUpsertOne) Update(set func(*ShareUpsert)) *ShareUpsertOne {
+	u.create.conflict = append(u.create.conflict, sql.ResolveWith(func(update *sql.UpdateSet) {
+		set(&ShareUpsert{UpdateSet: update})
+	}))
+	return u
+}
+
+// SetFileID sets the "file_id" field.
+func (u *ShareUpsertOne) SetFileID(v int) *ShareUpsertOne {
+	return u.Update(func(s *ShareUpsert) {
+		s.SetFileID(v)
+	})
+}
+
+// UpdateFileID sets the "file_id" field to the value that was provided on create.
+func (u *ShareUpsertOne) UpdateFileID() *ShareUpsertOne {
+	return u.Update(func(s *ShareUpsert) {
+		s.UpdateFileID()
+	})
+}
+
+// SetUserID sets the "user_id" field.
+func (u *ShareUpsertOne) SetUserID(v int) *ShareUpsertOne {
+	return u.Update(func(s *ShareUpsert) {
+		s.SetUserID(v)
+	})
+}
+
+// UpdateUserID sets the "user_id" field to the value that was provided on create.
+func (u *ShareUpsertOne) UpdateUserID() *ShareUpsertOne {
+	return u.Update(func(s *ShareUpsert) {
+		s.UpdateUserID()
+	})
+}
+
+// ClearUserID clears the value of the "user_id" field.
+func (u *ShareUpsertOne) ClearUserID() *ShareUpsertOne {
+	return u.Update(func(s *ShareUpsert) {
+		s.ClearUserID()
+	})
+}
+
+// SetPermission sets the "permission" field.
+func (u *ShareUpsertOne) SetPermission(v share.Permission) *ShareUpsertOne {
+	return u.Update(func(s *ShareUpsert) {
+		s.SetPermission(v)
+	})
+}
+
+// UpdatePermission sets the "permission" field to the value that was provided on create.
+func (u *ShareUpsertOne) UpdatePermission() *ShareUpsertOne {
+	return u.Update(func(s *ShareUpsert) {
+		s.UpdatePermission()
+	})
+}
+
+// SetExpiresAt sets the "expires_at" field.
+func (u *ShareUpsertOne) SetExpiresAt(v time.Time) *ShareUpsertOne {
+	return u.Update(func(s *ShareUpsert) {
+		s.SetExpiresAt(v)
+	})
+}
+
+// UpdateExpiresAt sets the "expires_at" field to the value that was provided on create.
+func (u *ShareUpsertOne) UpdateExpiresAt() *ShareUpsertOne {
+	return u.Update(func(s *ShareUpsert) {
+		s.UpdateExpiresAt()
+	})
+}
+
+// ClearExpiresAt clears the value of the "expires_at" field.
+func (u *ShareUpsertOne) ClearExpiresAt() *ShareUpsertOne {
+	return u.Update(func(s *ShareUpsert) {
+		s.ClearExpiresAt()
+	})
+}
+
+// SetMaxDownloads sets the "max_downloads" field.
+func (u *ShareUpsertOne) SetMaxDownloads(v int) *ShareUpsertOne {
+	return u.Update(func(s *ShareUpsert) {
+		s.SetMaxDownloads(v)
+	})
+}
+
+// AddMaxDownloads adds v to the "max_downloads" field.
+func (u *ShareUpsertOne) AddMaxDownloads(v int) *ShareUpsertOne {
+	return u.Update(func(s *ShareUpsert) {
+		s.AddMaxDownloads(v)
+	})
+}
+
+// UpdateMaxDownloads sets the "max_downloads" field to the value that was provided on create.
+func (u *ShareUpsertOne) UpdateMaxDownloads() *ShareUpsertOne {
+	return u.Update(func(s *ShareUpsert) {
+		s.UpdateMaxDownloads()
+	})
+}
+
+// ClearMaxDownloads clears the value of the "max_downloads" field.
+func (u *ShareUpsertOne) ClearMaxDownloads() *ShareUpsertOne {
+	return u.Update(func(s *ShareUpsert) {
+		s.ClearMaxDownloads()
+	})
+}
+
+// SetDownloads sets the "downloads" field.
+func (u *ShareUpsertOne) SetDownloads(v int) *ShareUpsertOne {
+	return u.Update(func(s *ShareUpsert) {
+		s.SetDownloads(v)
+	})
+}
+
+// AddDownloads adds v to the "downloads" field.
+func (u *ShareUpsertOne) AddDownloads(v int) *ShareUpsertOne {
+	return u.Update(func(s *ShareUpsert) {
+		s.AddDownloads(v)
+	})
+}
+
+// UpdateDownloads sets the "downloads" field to the value that was provided on create.
+func (u *ShareUpsertOne) UpdateDownloads() *ShareUpsertOne {
+	return u.Update(func(s *ShareUpsert) {
+		s.UpdateDownloads()
+	})
+}
+
+// SetCreatedAt sets the "created_at" field.
+func (u *ShareUpsertOne) SetCreatedAt(v time.Time) *ShareUpsertOne {
+	return u.Update(func(s *ShareUpsert) {
+		s.SetCreatedAt(v)
+	})
+}
+
+// UpdateCreatedAt sets the "created_at" field to the value that was provided on create.
+func (u *ShareUpsertOne) UpdateCreatedAt() *ShareUpsertOne {
+	return u.Update(func(s *ShareUpsert) {
+		s.UpdateCreatedAt()
+	})
+}
+
+// ClearCreatedAt clears the value of the "created_at" field.
+func (u *ShareUpsertOne) ClearCreatedAt() *ShareUpsertOne {
+	return u.Update(func(s *ShareUpsert) {
+		s.ClearCreatedAt()
+	})
+}
+
+// Exec executes the query.
+func (u *ShareUpsertOne) Exec(ctx context.Context) error {
+	if len(u.create.conflict) == 0 {
+		return errors.New("ent: missing options for ShareCreate.OnConflict")
+	}
+	return u.create.Exec(ctx)
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (u *ShareUpsertOne) ExecX(ctx context.Context) {
+	if err := u.create.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// Exec executes the UPSERT query and returns the inserted/updated ID.
+func (u *ShareUpsertOne) ID(ctx context.Context) (id int, err error) {
+	node, err := u.create.Save(ctx)
+	if err != nil {
+		return id, err
+	}
+	return node.ID, nil
+}
+
+// IDX is like ID, but panics if an error occurs.
+func (u *ShareUpsertOne) IDX(ctx context.Context) int {
+	id, err := u.ID(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return id
+}
+
+// ShareCreateBulk is the builder for creating many Share entities in bulk.
+type ShareCreateBulk struct {
+	config
+	err      error
+	builders []*ShareCreate
+	conflict []sql.ConflictOption
+}
+
+// Save creates the Share entities in the database.
+func (scb *ShareCreateBulk) Save(ctx context.Context) ([]*Share, error) {
+	if scb.err != nil {
+		return nil, scb.err
+	}
+	specs := make([]*sqlgraph.CreateSpec, len(scb.builders))
+	nodes := make([]*Share, len(scb.builders))
+	mutators := make([]Mutator, len(scb.builders))
+	for i := range scb.builders {
+		func(i int, root context.Context) {
+			builder := scb.builders[i]
+			builder.defaults()
+			var mut Mutator = MutateFunc(func(ctx context.Context, m Mutation) (Value, error) {
+				mutation, ok := m.(*ShareMutation)
+				if !ok {
+					return nil, fmt.Errorf("unexpected mutation type %T", m)
+				}
+				if err := builder.check(); err != nil {
+					return nil, err
+				}
+				builder.mutation = mutation
+				var err error
+				nodes[i], specs[i] = builder.createSpec()
+				if i < len(mutators)-1 {
+					_, err = mutators[i+1].Mutate(root, scb.builders[i+1].mutation)
+				} else {
+					spec := &sqlgraph.BatchCreateSpec{Nodes: specs}
+					spec.OnConflict = scb.conflict
+					// Invoke the actual operation on the latest mutation in the chain.
+					if err = sqlgraph.BatchCreate(ctx, scb.driver, spec); err != nil {
+						if sqlgraph.IsConstraintError(err) {
+							err = &ConstraintError{msg: err.Error(), wrap: err}
+						}
+					}
+				}
+				if err != nil {
+					return nil, err
+				}
+				mutation.id = &nodes[i].ID
+				if specs[i].ID.Value != nil {
+					id := specs[i].ID.Value.(int64)
+					nodes[i].ID = int(id)
+				}
+				mutation.done = true
+				return nodes[i], nil
+			})
+			for i := len(builder.hooks) - 1; i >= 0; i-- {
+				mut = builder.hooks[i](mut)
+			}
+			mutators[i] = mut
+		}(i, ctx)
+	}
+	if len(mutators) > 0 {
+		if _, err := mutators[0].Mutate(ctx, scb.builders[0].mutation); err != nil {
+			return nil, err
+		}
+	}
+	return nodes, nil
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (scb *ShareCreateBulk) SaveX(ctx context.Context) []*Share {
+	v, err := scb.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
+
+// Exec executes the query.
+func (scb *ShareCreateBulk) Exec(ctx context.Context) error {
+	_, err := scb.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (scb *ShareCreateBulk) ExecX(ctx context.Context) {
+	if err := scb.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// OnConflict allows configuring the `ON CONFLICT` / `ON DUPLICATE KEY` clause
+// of the `INSERT` statement. For example:
+//
+//	client.Share.CreateBulk(builders...).
+//		OnConflict(
+//			// Update the row with the new values
+//			// the was proposed for insertion.
+//			sql.ResolveWithNewValues(),
+//		).
+//		// Override some of the fields with custom
+//		// update values.
+//		Update(func(u *ent.ShareUpsert) {
+//			SetFileID(v+v).
+//		}).
+//		Exec(ctx)
+func (scb *ShareCreateBulk) OnConflict(opts ...sql.ConflictOption) *ShareUpsertBulk {
+	scb.conflict = opts
+	return &ShareUpsertBulk{
+		create: scb,
+	}
+}
+
+// OnConflictColumns calls `OnConflict` and configures the columns
+// as conflict target. Using this option is equivalent to using:
+//
+//	client.Share.Create().
+//		OnConflict(sql.ConflictColumns(columns...)).
+//		Exec(ctx)
+func (scb *ShareCreateBulk) OnConflictColumns(columns ...string) *ShareUpsertBulk {
+	scb.conflict = append(scb.conflict, sql.ConflictColumns(columns...))
+	return &ShareUpsertBulk{
+		create: scb,
+	}
+}
+
+// ShareUpsertBulk is the builder for "upsert"-ing
+// a bulk of Share nodes.
+type ShareUpsertBulk struct {
+	create *ShareCreateBulk
+}
+
+// UpdateNewValues updates the mutable fields using the new values that
+// were set on create. Using this option is equivalent to using:
+//
+//	client.Share.Create().
+//		OnConflict(
+//			sql.ResolveWithNewValues(),
+//		).
+//		Exec(ctx)
+func (u *ShareUpsertBulk) UpdateNewValues() *ShareUpsertBulk {
+	u.create.conflict = append(u.create.conflict, sql.ResolveWithNewValues())
+	return u
+}
+
+// Ignore sets each column to itself in case of conflict.
+// Using this option is equivalent to using:
+//
+//	client.Share.Create().
+//		OnConflict(sql.ResolveWithIgnore()).
+//		Exec(ctx)
+func (u *ShareUpsertBulk) Ignore() *ShareUpsertBulk {
+	u.create.conflict = append(u.create.conflict, sql.ResolveWithIgnore())
+	return u
+}
+
+// DoNothing configures the conflict_action to `DO NOTHING`.
+// Supported only by SQLite and PostgreSQL.
+func (u *ShareUpsertBulk) DoNothing() *ShareUpsertBulk {
+	u.create.conflict = append(u.create.conflict, sql.DoNothing())
+	return u
+}
+
+// Update allows overriding fields `UPDATE` values. See the ShareCreateBulk.OnConflict
+// documentation for more info.
+func (u *ShareUpsertBulk) Update(set func(*ShareUpsert)) *ShareUpsertBulk {
+	u.create.conflict = append(u.create.conflict, sql.ResolveWith(func(update *sql.UpdateSet) {
+		set(&ShareUpsert{UpdateSet: update})
+	}))
+	return u
+}
+
+// SetFileID sets the "file_id" field.
+func (u *ShareUpsertBulk) SetFileID(v int) *ShareUpsertBulk {
+	return u.Update(func(s *ShareUpsert) {
+		s.SetFileID(v)
+	})
+}
+
+// UpdateFileID sets the "file_id" field to the value that was provided on create.
+func (u *ShareUpsertBulk) UpdateFileID() *ShareUpsertBulk {
+	return u.Update(func(s *ShareUpsert) {
+		s.UpdateFileID()
+	})
+}
+
+// SetUserID sets the "user_id" field.
+func (u *ShareUpsertBulk) SetUserID(v int) *ShareUpsertBulk {
+	return u.Update(func(s *ShareUpsert) {
+		s.SetUserID(v)
+	})
+}
+
+// UpdateUserID sets the "user_id" field to the value that was provided on create.
+func (u *ShareUpsertBulk) UpdateUserID() *ShareUpsertBulk {
+	return u.Update(func(s *ShareUpsert) {
+		s.UpdateUserID()
+	})
+}
+
+// ClearUserID clears the value of the "user_id" field.
+func (u *ShareUpsertBulk) ClearUserID() *ShareUpsertBulk {
+	return u.Update(func(s *ShareUpsert) {
+		s.ClearUserID()
+	})
+}
+
+// SetPermission sets the "permission" field.
+func (u *ShareUpsertBulk) SetPermission(v share.Permission) *ShareUpsertBulk {
+	return u.Update(func(s *ShareUpsert) {
+		s.SetPermission(v)
+	})
+}
+
+// UpdatePermission sets the "permission" field to the value that was provided on create.
+func (u *ShareUpsertBulk) UpdatePermission() *ShareUpsertBulk {
+	return u.Update(func(s *ShareUpsert) {
+		s.UpdatePermission()
+	})
+}
+
+// SetExpiresAt sets the "expires_at" field.
+func (u *ShareUpsertBulk) SetExpiresAt(v time.Time) *ShareUpsertBulk {
+	return u.Update(func(s *ShareUpsert) {
+		s.SetExpiresAt(v)
+	})
+}
+
+// UpdateExpiresAt sets the "expires_at" field to the value that was provided on create.
+func (u *ShareUpsertBulk) UpdateExpiresAt() *ShareUpsertBulk {
+	return u.Update(func(s *ShareUpsert) {
+		s.UpdateExpiresAt()
+	})
+}
+
+// ClearExpiresAt clears the value of the "expires_at" field.
+func (u *ShareUpsertBulk) ClearExpiresAt() *ShareUpsertBulk {
+	return u.Update(func(s *ShareUpsert) {
+		s.ClearExpiresAt()
+	})
+}
+
+// SetMaxDownloads sets the "max_downloads" field.
+func (u *ShareUpsertBulk) SetMaxDownloads(v int) *ShareUpsertBulk {
+	return u.Update(func(s *ShareUpsert) {
+		s.SetMaxDownloads(v)
+	})
+}
+
+// AddMaxDownloads adds v to the "max_downloads" field.
+func (u *ShareUpsertBulk) AddMaxDownloads(v int) *ShareUpsertBulk {
+	return u.Update(func(s *ShareUpsert) {
+		s.AddMaxDownloads(v)
+	})
+}
+
+// UpdateMaxDownloads sets the "max_downloads" field to the value that was provided on create.
+func (u *ShareUpsertBulk) UpdateMaxDownloads() *ShareUpsertBulk {
+	return u.Update(func(s *ShareUpsert) {
+		s.UpdateMaxDownloads()
+	})
+}
+
+// ClearMaxDownloads clears the value of the "max_downloads" field.
+func (u *ShareUpsertBulk) ClearMaxDownloads() *ShareUpsertBulk {
+	return u.Update(func(s *ShareUpsert) {
+		s.ClearMaxDownloads()
+	})
+}
+
+// SetDownloads sets the "downloads" field.
+func (u *ShareUpsertBulk) SetDownloads(v int) *ShareUpsertBulk {
+	return u.Update(func(s *ShareUpsert) {
+		s.SetDownloads(v)
+	})
+}
+
+// AddDownloads adds v to the "downloads" field.
+func (u *ShareUpsertBulk) AddDownloads(v int) *ShareUpsertBulk {
+	return u.Update(func(s *ShareUpsert) {
+		s.AddDownloads(v)
+	})
+}
+
+// UpdateDownloads sets the "downloads" field to the value that was provided on create.
+func (u *ShareUpsertBulk) UpdateDownloads() *ShareUpsertBulk {
+	return u.Update(func(s *ShareUpsert) {
+		s.UpdateDownloads()
+	})
+}
+
+// SetCreatedAt sets the "created_at" field.
+func (u *ShareUpsertBulk) SetCreatedAt(v time.Time) *ShareUpsertBulk {
+	return u.Update(func(s *ShareUpsert) {
+		s.SetCreatedAt(v)
+	})
+}
+
+// UpdateCreatedAt sets the "created_at" field to the value that was provided on create.
+func (u *ShareUpsertBulk) UpdateCreatedAt() *ShareUpsertBulk {
+	return u.Update(func(s *ShareUpsert) {
+		s.UpdateCreatedAt()
+	})
+}
+
+// ClearCreatedAt clears the value of the "created_at" field.
+func (u *ShareUpsertBulk) ClearCreatedAt() *ShareUpsertBulk {
+	return u.Update(func(s *ShareUpsert) {
+		s.ClearCreatedAt()
+	})
+}
+
+// Exec executes the query.
+func (u *ShareUpsertBulk) Exec(ctx context.Context) error {
+	if u.create.err != nil {
+		return u.create.err
+	}
+	for i, b := range u.create.builders {
+		if len(b.conflict) != 0 {
+			return fmt.Errorf("ent: OnConflict was set for builder %d. Set it on the ShareCreateBulk instead", i)
+		}
+	}
+	if len(u.create.conflict) == 0 {
+		return errors.New("ent: missing options for ShareCreateBulk.OnConflict")
+	}
+	return u.create.Exec(ctx)
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (u *ShareUpsertBulk) ExecX(ctx context.Context) {
+	if err := u.create.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
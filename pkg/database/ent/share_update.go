@@ -0,0 +1,728 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/schema/field"
+	"github.com/lebleuciel/maani/pkg/database/ent/file"
+	"github.com/lebleuciel/maani/pkg/database/ent/predicate"
+	"github.com/lebleuciel/maani/pkg/database/ent/share"
+	"github.com/lebleuciel/maani/pkg/database/ent/user"
+)
+
+// ShareUpdate is the builder for updating Share entities.
+type ShareUpdate struct {
+	config
+	hooks     []Hook
+	mutation  *ShareMutation
+	modifiers []func(*sql.UpdateBuilder)
+}
+
+// Where appends a list predicates to the ShareUpdate builder.
+func (su *ShareUpdate) Where(ps ...predicate.Share) *ShareUpdate {
+	su.mutation.Where(ps...)
+	return su
+}
+
+// SetFileID sets the "file_id" field.
+func (su *ShareUpdate) SetFileID(i int) *ShareUpdate {
+	su.mutation.SetFileID(i)
+	return su
+}
+
+// SetNillableFileID sets the "file_id" field if the given value is not nil.
+func (su *ShareUpdate) SetNillableFileID(i *int) *ShareUpdate {
+	if i != nil {
+		su.SetFileID(*i)
+	}
+	return su
+}
+
+// SetUserID sets the "user_id" field.
+func (su *ShareUpdate) SetUserID(i int) *ShareUpdate {
+	su.mutation.SetUserID(i)
+	return su
+}
+
+// SetNillableUserID sets the "user_id" field if the given value is not nil.
+func (su *ShareUpdate) SetNillableUserID(i *int) *ShareUpdate {
+	if i != nil {
+		su.SetUserID(*i)
+	}
+	return su
+}
+
+// ClearUserID clears the value of the "user_id" field.
+func (su *ShareUpdate) ClearUserID() *ShareUpdate {
+	su.mutation.ClearUserID()
+	return su
+}
+
+// SetPermission sets the "permission" field.
+func (su *ShareUpdate) SetPermission(s share.Permission) *ShareUpdate {
+	su.mutation.SetPermission(s)
+	return su
+}
+
+// SetNillablePermission sets the "permission" field if the given value is not nil.
+func (su *ShareUpdate) SetNillablePermission(s *share.Permission) *ShareUpdate {
+	if s != nil {
+		su.SetPermission(*s)
+	}
+	return su
+}
+
+// SetExpiresAt sets the "expires_at" field.
+func (su *ShareUpdate) SetExpiresAt(t time.Time) *ShareUpdate {
+	su.mutation.SetExpiresAt(t)
+	return su
+}
+
+// SetNillableExpiresAt sets the "expires_at" field if the given value is not nil.
+func (su *ShareUpdate) SetNillableExpiresAt(t *time.Time) *ShareUpdate {
+	if t != nil {
+		su.SetExpiresAt(*t)
+	}
+	return su
+}
+
+// ClearExpiresAt clears the value of the "expires_at" field.
+func (su *ShareUpdate) ClearExpiresAt() *ShareUpdate {
+	su.mutation.ClearExpiresAt()
+	return su
+}
+
+// SetMaxDownloads sets the "max_downloads" field.
+func (su *ShareUpdate) SetMaxDownloads(i int) *ShareUpdate {
+	su.mutation.ResetMaxDownloads()
+	su.mutation.SetMaxDownloads(i)
+	return su
+}
+
+// SetNillableMaxDownloads sets the "max_downloads" field if the given value is not nil.
+func (su *ShareUpdate) SetNillableMaxDownloads(i *int) *ShareUpdate {
+	if i != nil {
+		su.SetMaxDownloads(*i)
+	}
+	return su
+}
+
+// AddMaxDownloads adds i to the "max_downloads" field.
+func (su *ShareUpdate) AddMaxDownloads(i int) *ShareUpdate {
+	su.mutation.AddMaxDownloads(i)
+	return su
+}
+
+// ClearMaxDownloads clears the value of the "max_downloads" field.
+func (su *ShareUpdate) ClearMaxDownloads() *ShareUpdate {
+	su.mutation.ClearMaxDownloads()
+	return su
+}
+
+// SetDownloads sets the "downloads" field.
+func (su *ShareUpdate) SetDownloads(i int) *ShareUpdate {
+	su.mutation.ResetDownloads()
+	su.mutation.SetDownloads(i)
+	return su
+}
+
+// SetNillableDownloads sets the "downloads" field if the given value is not nil.
+func (su *ShareUpdate) SetNillableDownloads(i *int) *ShareUpdate {
+	if i != nil {
+		su.SetDownloads(*i)
+	}
+	return su
+}
+
+// AddDownloads adds i to the "downloads" field.
+func (su *ShareUpdate) AddDownloads(i int) *ShareUpdate {
+	su.mutation.AddDownloads(i)
+	return su
+}
+
+// SetCreatedAt sets the "created_at" field.
+func (su *ShareUpdate) SetCreatedAt(t time.Time) *ShareUpdate {
+	su.mutation.SetCreatedAt(t)
+	return su
+}
+
+// SetNillableCreatedAt sets the "created_at" field if the given value is not nil.
+func (su *ShareUpdate) SetNillableCreatedAt(t *time.Time) *ShareUpdate {
+	if t != nil {
+		su.SetCreatedAt(*t)
+	}
+	return su
+}
+
+// ClearCreatedAt clears the value of the "created_at" field.
+func (su *ShareUpdate) ClearCreatedAt() *ShareUpdate {
+	su.mutation.ClearCreatedAt()
+	return su
+}
+
+// SetFile sets the "file" edge to the File entity.
+func (su *ShareUpdate) SetFile(f *File) *ShareUpdate {
+	return su.SetFileID(f.ID)
+}
+
+// SetUser sets the "user" edge to the User entity.
+func (su *ShareUpdate) SetUser(u *User) *ShareUpdate {
+	return su.SetUserID(u.ID)
+}
+
+// Mutation returns the ShareMutation object of the builder.
+func (su *ShareUpdate) Mutation() *ShareMutation {
+	return su.mutation
+}
+
+// ClearFile clears the "file" edge to the File entity.
+func (su *ShareUpdate) ClearFile() *ShareUpdate {
+	su.mutation.ClearFile()
+	return su
+}
+
+// ClearUser clears the "user" edge to the User entity.
+func (su *ShareUpdate) ClearUser() *ShareUpdate {
+	su.mutation.ClearUser()
+	return su
+}
+
+// Save executes the query and returns the number of nodes affected by the update operation.
+func (su *ShareUpdate) Save(ctx context.Context) (int, error) {
+	return withHooks(ctx, su.sqlSave, su.mutation, su.hooks)
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (su *ShareUpdate) SaveX(ctx context.Context) int {
+	affected, err := su.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return affected
+}
+
+// Exec executes the query.
+func (su *ShareUpdate) Exec(ctx context.Context) error {
+	_, err := su.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (su *ShareUpdate) ExecX(ctx context.Context) {
+	if err := su.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// check runs all checks and user-defined validators on the builder.
+func (su *ShareUpdate) check() error {
+	if v, ok := su.mutation.Permission(); ok {
+		if err := share.PermissionValidator(v); err != nil {
+			return &ValidationError{Name: "permission", err: fmt.Errorf(`ent: validator failed for field "Share.permission": %w`, err)}
+		}
+	}
+	if v, ok := su.mutation.MaxDownloads(); ok {
+		if err := share.MaxDownloadsValidator(v); err != nil {
+			return &ValidationError{Name: "max_downloads", err: fmt.Errorf(`ent: validator failed for field "Share.max_downloads": %w`, err)}
+		}
+	}
+	if _, ok := su.mutation.FileID(); su.mutation.FileCleared() && !ok {
+		return errors.New(`ent: clearing a required unique edge "Share.file"`)
+	}
+	return nil
+}
+
+// Modify adds a statement modifier for attaching custom logic to the UPDATE statement.
+func (su *ShareUpdate) Modify(modifiers ...func(u *sql.UpdateBuilder)) *ShareUpdate {
+	su.modifiers = append(su.modifiers, modifiers...)
+	return su
+}
+
+func (su *ShareUpdate) sqlSave(ctx context.Context) (n int, err error) {
+	if err := su.check(); err != nil {
+		return n, err
+	}
+	_spec := sqlgraph.NewUpdateSpec(share.Table, share.Columns, sqlgraph.NewFieldSpec(share.FieldID, field.TypeInt))
+	if ps := su.mutation.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	if value, ok := su.mutation.Permission(); ok {
+		_spec.SetField(share.FieldPermission, field.TypeEnum, value)
+	}
+	if value, ok := su.mutation.ExpiresAt(); ok {
+		_spec.SetField(share.FieldExpiresAt, field.TypeTime, value)
+	}
+	if su.mutation.ExpiresAtCleared() {
+		_spec.ClearField(share.FieldExpiresAt, field.TypeTime)
+	}
+	if value, ok := su.mutation.MaxDownloads(); ok {
+		_spec.SetField(share.FieldMaxDownloads, field.TypeInt, value)
+	}
+	if value, ok := su.mutation.AddedMaxDownloads(); ok {
+		_spec.AddField(share.FieldMaxDownloads, field.TypeInt, value)
+	}
+	if su.mutation.MaxDownloadsCleared() {
+		_spec.ClearField(share.FieldMaxDownloads, field.TypeInt)
+	}
+	if value, ok := su.mutation.Downloads(); ok {
+		_spec.SetField(share.FieldDownloads, field.TypeInt, value)
+	}
+	if value, ok := su.mutation.AddedDownloads(); ok {
+		_spec.AddField(share.FieldDownloads, field.TypeInt, value)
+	}
+	if value, ok := su.mutation.CreatedAt(); ok {
+		_spec.SetField(share.FieldCreatedAt, field.TypeTime, value)
+	}
+	if su.mutation.CreatedAtCleared() {
+		_spec.ClearField(share.FieldCreatedAt, field.TypeTime)
+	}
+	if su.mutation.FileCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   share.FileTable,
+			Columns: []string{share.FileColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(file.FieldID, field.TypeInt),
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := su.mutation.FileIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   share.FileTable,
+			Columns: []string{share.FileColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(file.FieldID, field.TypeInt),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
+	if su.mutation.UserCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   share.UserTable,
+			Columns: []string{share.UserColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(user.FieldID, field.TypeInt),
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := su.mutation.UserIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   share.UserTable,
+			Columns: []string{share.UserColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(user.FieldID, field.TypeInt),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
+	_spec.AddModifiers(su.modifiers...)
+	if n, err = sqlgraph.UpdateNodes(ctx, su.driver, _spec); err != nil {
+		if _, ok := err.(*sqlgraph.NotFoundError); ok {
+			err = &NotFoundError{share.Label}
+		} else if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return 0, err
+	}
+	su.mutation.done = true
+	return n, nil
+}
+
+// ShareUpdateOne is the builder for updating a single Share entity.
+type ShareUpdateOne struct {
+	config
+	fields    []string
+	hooks     []Hook
+	mutation  *ShareMutation
+	modifiers []func(*sql.UpdateBuilder)
+}
+
+// SetFileID sets the "file_id" field.
+func (suo *ShareUpdateOne) SetFileID(i int) *ShareUpdateOne {
+	suo.mutation.SetFileID(i)
+	return suo
+}
+
+// SetNillableFileID sets the "file_id" field if the given value is not nil.
+func (suo *ShareUpdateOne) SetNillableFileID(i *int) *ShareUpdateOne {
+	if i != nil {
+		suo.SetFileID(*i)
+	}
+	return suo
+}
+
+// SetUserID sets the "user_id" field.
+func (suo *ShareUpdateOne) SetUserID(i int) *ShareUpdateOne {
+	suo.mutation.SetUserID(i)
+	return suo
+}
+
+// SetNillableUserID sets the "user_id" field if the given value is not nil.
+func (suo *ShareUpdateOne) SetNillableUserID(i *int) *ShareUpdateOne {
+	if i != nil {
+		suo.SetUserID(*i)
+	}
+	return suo
+}
+
+// ClearUserID clears the value of the "user_id" field.
+func (suo *ShareUpdateOne) ClearUserID() *ShareUpdateOne {
+	suo.mutation.ClearUserID()
+	return suo
+}
+
+// SetPermission sets the "permission" field.
+func (suo *ShareUpdateOne) SetPermission(s share.Permission) *ShareUpdateOne {
+	suo.mutation.SetPermission(s)
+	return suo
+}
+
+// SetNillablePermission sets the "permission" field if the given value is not nil.
+func (suo *ShareUpdateOne) SetNillablePermission(s *share.Permission) *ShareUpdateOne {
+	if s != nil {
+		suo.SetPermission(*s)
+	}
+	return suo
+}
+
+// SetExpiresAt sets the "expires_at" field.
+func (suo *ShareUpdateOne) SetExpiresAt(t time.Time) *ShareUpdateOne {
+	suo.mutation.SetExpiresAt(t)
+	return suo
+}
+
+// SetNillableExpiresAt sets the "expires_at" field if the given value is not nil.
+func (suo *ShareUpdateOne) SetNillableExpiresAt(t *time.Time) *ShareUpdateOne {
+	if t != nil {
+		suo.SetExpiresAt(*t)
+	}
+	return suo
+}
+
+// ClearExpiresAt clears the value of the "expires_at" field.
+func (suo *ShareUpdateOne) ClearExpiresAt() *ShareUpdateOne {
+	suo.mutation.ClearExpiresAt()
+	return suo
+}
+
+// SetMaxDownloads sets the "max_downloads" field.
+func (suo *ShareUpdateOne) SetMaxDownloads(i int) *ShareUpdateOne {
+	suo.mutation.ResetMaxDownloads()
+	suo.mutation.SetMaxDownloads(i)
+	return suo
+}
+
+// SetNillableMaxDownloads sets the "max_downloads" field if the given value is not nil.
+func (suo *ShareUpdateOne) SetNillableMaxDownloads(i *int) *ShareUpdateOne {
+	if i != nil {
+		suo.SetMaxDownloads(*i)
+	}
+	return suo
+}
+
+// AddMaxDownloads adds i to the "max_downloads" field.
+func (suo *ShareUpdateOne) AddMaxDownloads(i int) *ShareUpdateOne {
+	suo.mutation.AddMaxDownloads(i)
+	return suo
+}
+
+// ClearMaxDownloads clears the value of the "max_downloads" field.
+func (suo *ShareUpdateOne) ClearMaxDownloads() *ShareUpdateOne {
+	suo.mutation.ClearMaxDownloads()
+	return suo
+}
+
+// SetDownloads sets the "downloads" field.
+func (suo *ShareUpdateOne) SetDownloads(i int) *ShareUpdateOne {
+	suo.mutation.ResetDownloads()
+	suo.mutation.SetDownloads(i)
+	return suo
+}
+
+// SetNillableDownloads sets the "downloads" field if the given value is not nil.
+func (suo *ShareUpdateOne) SetNillableDownloads(i *int) *ShareUpdateOne {
+	if i != nil {
+		suo.SetDownloads(*i)
+	}
+	return suo
+}
+
+// AddDownloads adds i to the "downloads" field.
+func (suo *ShareUpdateOne) AddDownloads(i int) *ShareUpdateOne {
+	suo.mutation.AddDownloads(i)
+	return suo
+}
+
+// SetCreatedAt sets the "created_at" field.
+func (suo *ShareUpdateOne) SetCreatedAt(t time.Time) *ShareUpdateOne {
+	suo.mutation.SetCreatedAt(t)
+	return suo
+}
+
+// SetNillableCreatedAt sets the "created_at" field if the given value is not nil.
+func (suo *ShareUpdateOne) SetNillableCreatedAt(t *time.Time) *ShareUpdateOne {
+	if t != nil {
+		suo.SetCreatedAt(*t)
+	}
+	return suo
+}
+
+// ClearCreatedAt clears the value of the "created_at" field.
+func (suo *ShareUpdateOne) ClearCreatedAt() *ShareUpdateOne {
+	suo.mutation.ClearCreatedAt()
+	return suo
+}
+
+// SetFile sets the "file" edge to the File entity.
+func (suo *ShareUpdateOne) SetFile(f *File) *ShareUpdateOne {
+	return suo.SetFileID(f.ID)
+}
+
+// SetUser sets the "user" edge to the User entity.
+func (suo *ShareUpdateOne) SetUser(u *User) *ShareUpdateOne {
+	return suo.SetUserID(u.ID)
+}
+
+// Mutation returns the ShareMutation object of the builder.
+func (suo *ShareUpdateOne) Mutation() *ShareMutation {
+	return suo.mutation
+}
+
+// ClearFile clears the "file" edge to the File entity.
+func (suo *ShareUpdateOne) ClearFile() *ShareUpdateOne {
+	suo.mutation.ClearFile()
+	return suo
+}
+
+// ClearUser clears the "user" edge to the User entity.
+func (suo *ShareUpdateOne) ClearUser() *ShareUpdateOne {
+	suo.mutation.ClearUser()
+	return suo
+}
+
+// Where appends a list predicates to the ShareUpdate builder.
+func (suo *ShareUpdateOne) Where(ps ...predicate.Share) *ShareUpdateOne {
+	suo.mutation.Where(ps...)
+	return suo
+}
+
+// Select allows selecting one or more fields (columns) of the returned entity.
+// The default is selecting all fields defined in the entity schema.
+func (suo *ShareUpdateOne) Select(field string, fields ...string) *ShareUpdateOne {
+	suo.fields = append([]string{field}, fields...)
+	return suo
+}
+
+// Save executes the query and returns the updated Share entity.
+func (suo *ShareUpdateOne) Save(ctx context.Context) (*Share, error) {
+	return withHooks(ctx, suo.sqlSave, suo.mutation, suo.hooks)
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (suo *ShareUpdateOne) SaveX(ctx context.Context) *Share {
+	node, err := suo.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return node
+}
+
+// Exec executes the query on the entity.
+func (suo *ShareUpdateOne) Exec(ctx context.Context) error {
+	_, err := suo.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (suo *ShareUpdateOne) ExecX(ctx context.Context) {
+	if err := suo.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// check runs all checks and user-defined validators on the builder.
+func (suo *ShareUpdateOne) check() error {
+	if v, ok := suo.mutation.Permission(); ok {
+		if err := share.PermissionValidator(v); err != nil {
+			return &ValidationError{Name: "permission", err: fmt.Errorf(`ent: validator failed for field "Share.permission": %w`, err)}
+		}
+	}
+	if v, ok := suo.mutation.MaxDownloads(); ok {
+		if err := share.MaxDownloadsValidator(v); err != nil {
+			return &ValidationError{Name: "max_downloads", err: fmt.Errorf(`ent: validator failed for field "Share.max_downloads": %w`, err)}
+		}
+	}
+	if _, ok := suo.mutation.FileID(); suo.mutation.FileCleared() && !ok {
+		return errors.New(`ent: clearing a required unique edge "Share.file"`)
+	}
+	return nil
+}
+
+// Modify adds a statement modifier for attaching custom logic to the UPDATE statement.
+func (suo *ShareUpdateOne) Modify(modifiers ...func(u *sql.UpdateBuilder)) *ShareUpdateOne {
+	suo.modifiers = append(suo.modifiers, modifiers...)
+	return suo
+}
+
+func (suo *ShareUpdateOne) sqlSave(ctx context.Context) (_node *Share, err error) {
+	if err := suo.check(); err != nil {
+		return _node, err
+	}
+	_spec := sqlgraph.NewUpdateSpec(share.Table, share.Columns, sqlgraph.NewFieldSpec(share.FieldID, field.TypeInt))
+	id, ok := suo.mutation.ID()
+	if !ok {
+		return nil, &ValidationError{Name: "id", err: errors.New(`ent: missing "Share.id" for update`)}
+	}
+	_spec.Node.ID.Value = id
+	if fields := suo.fields; len(fields) > 0 {
+		_spec.Node.Columns = make([]string, 0, len(fields))
+		_spec.Node.Columns = append(_spec.Node.Columns, share.FieldID)
+		for _, f := range fields {
+			if !share.ValidColumn(f) {
+				return nil, &ValidationError{Name: f, err: fmt.Errorf("ent: invalid field %q for query", f)}
+			}
+			if f != share.FieldID {
+				_spec.Node.Columns = append(_spec.Node.Columns, f)
+			}
+		}
+	}
+	if ps := suo.mutation.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	if value, ok := suo.mutation.Permission(); ok {
+		_spec.SetField(share.FieldPermission, field.TypeEnum, value)
+	}
+	if value, ok := suo.mutation.ExpiresAt(); ok {
+		_spec.SetField(share.FieldExpiresAt, field.TypeTime, value)
+	}
+	if suo.mutation.ExpiresAtCleared() {
+		_spec.ClearField(share.FieldExpiresAt, field.TypeTime)
+	}
+	if value, ok := suo.mutation.MaxDownloads(); ok {
+		_spec.SetField(share.FieldMaxDownloads, field.TypeInt, value)
+	}
+	if value, ok := suo.mutation.AddedMaxDownloads(); ok {
+		_spec.AddField(share.FieldMaxDownloads, field.TypeInt, value)
+	}
+	if suo.mutation.MaxDownloadsCleared() {
+		_spec.ClearField(share.FieldMaxDownloads, field.TypeInt)
+	}
+	if value, ok := suo.mutation.Downloads(); ok {
+		_spec.SetField(share.FieldDownloads, field.TypeInt, value)
+	}
+	if value, ok := suo.mutation.AddedDownloads(); ok {
+		_spec.AddField(share.FieldDownloads, field.TypeInt, value)
+	}
+	if value, ok := suo.mutation.CreatedAt(); ok {
+		_spec.SetField(share.FieldCreatedAt, field.TypeTime, value)
+	}
+	if suo.mutation.CreatedAtCleared() {
+		_spec.ClearField(share.FieldCreatedAt, field.TypeTime)
+	}
+	if suo.mutation.FileCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   share.FileTable,
+			Columns: []string{share.FileColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(file.FieldID, field.TypeInt),
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := suo.mutation.FileIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   share.FileTable,
+			Columns: []string{share.FileColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(file.FieldID, field.TypeInt),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
+	if suo.mutation.UserCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   share.UserTable,
+			Columns: []string{share.UserColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(user.FieldID, field.TypeInt),
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := suo.mutation.UserIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   share.UserTable,
+			Columns: []string{share.UserColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(user.FieldID, field.TypeInt),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
+	_spec.AddModifiers(suo.modifiers...)
+	_node = &Share{config: suo.config}
+	_spec.Assign = _node.assignValues
+	_spec.ScanValues = _node.scanValues
+	if err = sqlgraph.UpdateNode(ctx, suo.driver, _spec); err != nil {
+		if _, ok := err.(*sqlgraph.NotFoundError); ok {
+			err = &NotFoundError{share.Label}
+		} else if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return nil, err
+	}
+	suo.mutation.done = true
+	return _node, nil
+}
@@ -582,6 +582,12 @@ func (tq *TagQuery) ForShare(opts ...sql.LockOption) *TagQuery {
 	return tq
 }
 
+// Modify adds a query modifier for attaching custom logic to queries.
+func (tq *TagQuery) Modify(modifiers ...func(s *sql.Selector)) *TagSelect {
+	tq.modifiers = append(tq.modifiers, modifiers...)
+	return tq.Select()
+}
+
 // TagGroupBy is the group-by builder for Tag entities.
 type TagGroupBy struct {
 	selector
@@ -671,3 +677,9 @@ func (ts *TagSelect) sqlScan(ctx context.Context, root *TagQuery, v any) error {
 	defer rows.Close()
 	return sql.ScanSlice(rows, v)
 }
+
+// Modify adds a query modifier for attaching custom logic to queries.
+func (ts *TagSelect) Modify(modifiers ...func(s *sql.Selector)) *TagSelect {
+	ts.modifiers = append(ts.modifiers, modifiers...)
+	return ts
+}
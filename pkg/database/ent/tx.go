@@ -16,6 +16,12 @@ type Tx struct {
 	File *FileClient
 	// Filetype is the client for interacting with the Filetype builders.
 	Filetype *FiletypeClient
+	// KeyRotation is the client for interacting with the KeyRotation builders.
+	KeyRotation *KeyRotationClient
+	// SearchJob is the client for interacting with the SearchJob builders.
+	SearchJob *SearchJobClient
+	// Share is the client for interacting with the Share builders.
+	Share *ShareClient
 	// Tag is the client for interacting with the Tag builders.
 	Tag *TagClient
 	// User is the client for interacting with the User builders.
@@ -153,6 +159,9 @@ func (tx *Tx) Client() *Client {
 func (tx *Tx) init() {
 	tx.File = NewFileClient(tx.config)
 	tx.Filetype = NewFiletypeClient(tx.config)
+	tx.KeyRotation = NewKeyRotationClient(tx.config)
+	tx.SearchJob = NewSearchJobClient(tx.config)
+	tx.Share = NewShareClient(tx.config)
 	tx.Tag = NewTagClient(tx.config)
 	tx.User = NewUserClient(tx.config)
 }
@@ -43,9 +43,13 @@ type User struct {
 type UserEdges struct {
 	// Files holds the value of the files edge.
 	Files []*File `json:"files,omitempty"`
+	// SearchJobs holds the value of the search_jobs edge.
+	SearchJobs []*SearchJob `json:"search_jobs,omitempty"`
+	// Shares holds the value of the shares edge.
+	Shares []*Share `json:"shares,omitempty"`
 	// loadedTypes holds the information for reporting if a
 	// type was loaded (or requested) in eager-loading or not.
-	loadedTypes [1]bool
+	loadedTypes [3]bool
 }
 
 // FilesOrErr returns the Files value or an error if the edge
@@ -57,6 +61,24 @@ func (e UserEdges) FilesOrErr() ([]*File, error) {
 	return nil, &NotLoadedError{edge: "files"}
 }
 
+// SearchJobsOrErr returns the SearchJobs value or an error if the edge
+// was not loaded in eager-loading.
+func (e UserEdges) SearchJobsOrErr() ([]*SearchJob, error) {
+	if e.loadedTypes[1] {
+		return e.SearchJobs, nil
+	}
+	return nil, &NotLoadedError{edge: "search_jobs"}
+}
+
+// SharesOrErr returns the Shares value or an error if the edge
+// was not loaded in eager-loading.
+func (e UserEdges) SharesOrErr() ([]*Share, error) {
+	if e.loadedTypes[2] {
+		return e.Shares, nil
+	}
+	return nil, &NotLoadedError{edge: "shares"}
+}
+
 // scanValues returns the types for scanning values from sql.Rows.
 func (*User) scanValues(columns []string) ([]any, error) {
 	values := make([]any, len(columns))
@@ -158,6 +180,16 @@ func (u *User) QueryFiles() *FileQuery {
 	return NewUserClient(u.config).QueryFiles(u)
 }
 
+// QuerySearchJobs queries the "search_jobs" edge of the User entity.
+func (u *User) QuerySearchJobs() *SearchJobQuery {
+	return NewUserClient(u.config).QuerySearchJobs(u)
+}
+
+// QueryShares queries the "shares" edge of the User entity.
+func (u *User) QueryShares() *ShareQuery {
+	return NewUserClient(u.config).QueryShares(u)
+}
+
 // Update returns a builder for updating this User.
 // Note that you need to call User.Unwrap() before calling this method if this User
 // was returned from a transaction, and the transaction was committed or rolled back.
@@ -33,6 +33,10 @@ const (
 	FieldLastLoginAt = "last_login_at"
 	// EdgeFiles holds the string denoting the files edge name in mutations.
 	EdgeFiles = "files"
+	// EdgeSearchJobs holds the string denoting the search_jobs edge name in mutations.
+	EdgeSearchJobs = "search_jobs"
+	// EdgeShares holds the string denoting the shares edge name in mutations.
+	EdgeShares = "shares"
 	// Table holds the table name of the user in the database.
 	Table = "users"
 	// FilesTable is the table that holds the files relation/edge.
@@ -42,6 +46,20 @@ const (
 	FilesInverseTable = "files"
 	// FilesColumn is the table column denoting the files relation/edge.
 	FilesColumn = "user_id"
+	// SearchJobsTable is the table that holds the search_jobs relation/edge.
+	SearchJobsTable = "search_jobs"
+	// SearchJobsInverseTable is the table name for the SearchJob entity.
+	// It exists in this package in order to avoid circular dependency with the "searchjob" package.
+	SearchJobsInverseTable = "search_jobs"
+	// SearchJobsColumn is the table column denoting the search_jobs relation/edge.
+	SearchJobsColumn = "user_id"
+	// SharesTable is the table that holds the shares relation/edge.
+	SharesTable = "shares"
+	// SharesInverseTable is the table name for the Share entity.
+	// It exists in this package in order to avoid circular dependency with the "share" package.
+	SharesInverseTable = "shares"
+	// SharesColumn is the table column denoting the shares relation/edge.
+	SharesColumn = "user_id"
 )
 
 // Columns holds all SQL columns for user fields.
@@ -171,6 +189,34 @@ func ByFiles(term sql.OrderTerm, terms ...sql.OrderTerm) OrderOption {
 		sqlgraph.OrderByNeighborTerms(s, newFilesStep(), append([]sql.OrderTerm{term}, terms...)...)
 	}
 }
+
+// BySearchJobsCount orders the results by search_jobs count.
+func BySearchJobsCount(opts ...sql.OrderTermOption) OrderOption {
+	return func(s *sql.Selector) {
+		sqlgraph.OrderByNeighborsCount(s, newSearchJobsStep(), opts...)
+	}
+}
+
+// BySearchJobs orders the results by search_jobs terms.
+func BySearchJobs(term sql.OrderTerm, terms ...sql.OrderTerm) OrderOption {
+	return func(s *sql.Selector) {
+		sqlgraph.OrderByNeighborTerms(s, newSearchJobsStep(), append([]sql.OrderTerm{term}, terms...)...)
+	}
+}
+
+// BySharesCount orders the results by shares count.
+func BySharesCount(opts ...sql.OrderTermOption) OrderOption {
+	return func(s *sql.Selector) {
+		sqlgraph.OrderByNeighborsCount(s, newSharesStep(), opts...)
+	}
+}
+
+// ByShares orders the results by shares terms.
+func ByShares(term sql.OrderTerm, terms ...sql.OrderTerm) OrderOption {
+	return func(s *sql.Selector) {
+		sqlgraph.OrderByNeighborTerms(s, newSharesStep(), append([]sql.OrderTerm{term}, terms...)...)
+	}
+}
 func newFilesStep() *sqlgraph.Step {
 	return sqlgraph.NewStep(
 		sqlgraph.From(Table, FieldID),
@@ -178,3 +224,17 @@ func newFilesStep() *sqlgraph.Step {
 		sqlgraph.Edge(sqlgraph.O2M, false, FilesTable, FilesColumn),
 	)
 }
+func newSearchJobsStep() *sqlgraph.Step {
+	return sqlgraph.NewStep(
+		sqlgraph.From(Table, FieldID),
+		sqlgraph.To(SearchJobsInverseTable, FieldID),
+		sqlgraph.Edge(sqlgraph.O2M, false, SearchJobsTable, SearchJobsColumn),
+	)
+}
+func newSharesStep() *sqlgraph.Step {
+	return sqlgraph.NewStep(
+		sqlgraph.From(Table, FieldID),
+		sqlgraph.To(SharesInverseTable, FieldID),
+		sqlgraph.Edge(sqlgraph.O2M, false, SharesTable, SharesColumn),
+	)
+}
@@ -0,0 +1,13 @@
+package database
+
+import "github.com/pkg/errors"
+
+var ErrSearchJobNotFound = errors.New("Search job not found")
+var ErrSearchJobClaimed = errors.New("search job is finished or run by another runner")
+var ErrFileNotFound = errors.New("file not found")
+var ErrUserNotFound = errors.New("user not found")
+var ErrShareNotFound = errors.New("share not found")
+var ErrShareUsedUp = errors.New("share has expired or has no downloads left")
+var ErrTagNotFound = errors.New("tag not found")
+var ErrTagExists = errors.New("tag already exists")
+var ErrInvalidCursor = errors.New("Field cursor is not a cursor of this list")
@@ -0,0 +1,144 @@
+package database
+
+import (
+	"encoding/base64"
+	"encoding/json"
+	"net/url"
+	"slices"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/pkg/errors"
+)
+
+const (
+	// Lists are sorted by one of these fields, sort parameter starting with - sorts in descending order
+	SortCreatedAt = "created_at"
+	SortSize      = "size"
+	SortName      = "name"
+
+	DefaultListLimit = 20
+	MaxListLimit     = 100
+
+	listDateLayout = "2006-01-02"
+)
+
+// ListOptions pages, sorts and filters lists of files and users
+type ListOptions struct {
+	// Limit is number of items in a page
+	Limit int
+	// Sort is field list is sorted by, ties are sorted by id in the same direction
+	Sort string
+	Desc bool
+	// Cursor is position of last item of previous page, nil for the first page
+	Cursor *Cursor
+
+	// OwnerId only keeps files of a user, zero keeps files of every user
+	OwnerId int
+	// Type only keeps files of a media type, or of every subtype when it has no slash. For users it is their access type
+	Type string
+	// Tag only keeps files with a tag
+	Tag string
+	// CreatedAfter keeps items created on or after it, CreatedBefore keeps items created before it
+	CreatedAfter  *time.Time
+	CreatedBefore *time.Time
+}
+
+// Cursor is position of an item in a sorted list, clients get it encoded as an opaque string
+type Cursor struct {
+	// Sort is sort parameter of list cursor was made for, it can't be used with another sort
+	Sort string `json:"s"`
+	// Value is sort field of item, empty when list is sorted by creation time which follows ids
+	Value string `json:"v,omitempty"`
+	Id    int    `json:"i"`
+}
+
+// SortParam returns sort query parameter of options
+func (o ListOptions) SortParam() string {
+	if o.Desc {
+		return "-" + o.Sort
+	}
+	return o.Sort
+}
+
+// NextCursor returns encoded cursor of page following an item with id and value of sort field
+func (o ListOptions) NextCursor(id int, value string) string {
+	data, _ := json.Marshal(Cursor{Sort: o.SortParam(), Value: value, Id: id})
+	return base64.RawURLEncoding.EncodeToString(data)
+}
+
+// ParseListOptions reads limit, cursor, sort, owner, type, tag, after and before query parameters, sorts lists can be sorted by
+// are accepted and lists are sorted by the first one by default
+func ParseListOptions(query url.Values, sorts ...string) (ListOptions, error) {
+	options := ListOptions{Limit: DefaultListLimit, Sort: sorts[0]}
+	if value := query.Get("limit"); value != "" {
+		limit, err := strconv.Atoi(value)
+		if err != nil || limit <= 0 || limit > MaxListLimit {
+			return ListOptions{}, errors.Errorf("Field limit should be a number from 1 to %d", MaxListLimit)
+		}
+		options.Limit = limit
+	}
+
+	if value := query.Get("sort"); value != "" {
+		options.Sort, options.Desc = strings.TrimPrefix(value, "-"), strings.HasPrefix(value, "-")
+		if !slices.Contains(sorts, options.Sort) {
+			return ListOptions{}, errors.Errorf("Field sort should be one of %s, with - in front for descending order", strings.Join(sorts, ", "))
+		}
+	}
+
+	if value := query.Get("cursor"); value != "" {
+		cursor, err := decodeCursor(value)
+		if err != nil || cursor.Sort != options.SortParam() {
+			return ListOptions{}, ErrInvalidCursor
+		}
+		options.Cursor = &cursor
+	}
+
+	if value := query.Get("owner"); value != "" {
+		owner, err := strconv.Atoi(value)
+		if err != nil || owner <= 0 {
+			return ListOptions{}, errors.New("Field owner should be id of a user")
+		}
+		options.OwnerId = owner
+	}
+	options.Type = query.Get("type")
+	options.Tag = query.Get("tag")
+
+	var err error
+	options.CreatedAfter, err = parseListDate(query, "after")
+	if err != nil {
+		return ListOptions{}, err
+	}
+	options.CreatedBefore, err = parseListDate(query, "before")
+	if err != nil {
+		return ListOptions{}, err
+	}
+	return options, nil
+}
+
+// parseListDate reads a query parameter which is either a day or a time in RFC 3339, nil when it isn't given
+func parseListDate(query url.Values, field string) (*time.Time, error) {
+	value := query.Get(field)
+	if value == "" {
+		return nil, nil
+	}
+	at, err := time.Parse(listDateLayout, value)
+	if err != nil {
+		at, err = time.Parse(time.RFC3339, value)
+	}
+	if err != nil {
+		return nil, errors.Errorf("Field %s should be a date such as 2026-01-02 or a time such as 2026-01-02T15:04:05Z", field)
+	}
+	return &at, nil
+}
+
+func decodeCursor(value string) (Cursor, error) {
+	data, err := base64.RawURLEncoding.DecodeString(value)
+	if err != nil {
+		return Cursor{}, err
+	}
+	var cursor Cursor
+	err = json.Unmarshal(data, &cursor)
+	return cursor, err
+}
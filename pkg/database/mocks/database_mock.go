@@ -8,10 +8,12 @@ import (
 	context "context"
 	sql "database/sql"
 	reflect "reflect"
+	time "time"
 
 	gomock "github.com/golang/mock/gomock"
 	models "github.com/lebleuciel/maani/models"
 	database "github.com/lebleuciel/maani/pkg/database"
+	filequery "github.com/lebleuciel/maani/pkg/filequery"
 )
 
 // MockDatabase is a mock of Database interface.
@@ -37,6 +39,21 @@ func (m *MockDatabase) EXPECT() *MockDatabaseMockRecorder {
 	return m.recorder
 }
 
+// AddFileTags mocks base method.
+func (m *MockDatabase) AddFileTags(scope database.Scope, uuid string, tags []string) ([]string, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "AddFileTags", scope, uuid, tags)
+	ret0, _ := ret[0].([]string)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// AddFileTags indicates an expected call of AddFileTags.
+func (mr *MockDatabaseMockRecorder) AddFileTags(scope, uuid, tags interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "AddFileTags", reflect.TypeOf((*MockDatabase)(nil).AddFileTags), scope, uuid, tags)
+}
+
 // AddFileTypeIfNotExist mocks base method.
 func (m *MockDatabase) AddFileTypeIfNotExist(arg0 string) error {
 	m.ctrl.T.Helper()
@@ -51,6 +68,96 @@ func (mr *MockDatabaseMockRecorder) AddFileTypeIfNotExist(arg0 interface{}) *gom
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "AddFileTypeIfNotExist", reflect.TypeOf((*MockDatabase)(nil).AddFileTypeIfNotExist), arg0)
 }
 
+// ClaimSearchJob mocks base method.
+func (m *MockDatabase) ClaimSearchJob(id int, runner string, staleBefore time.Time) (models.SearchJob, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ClaimSearchJob", id, runner, staleBefore)
+	ret0, _ := ret[0].(models.SearchJob)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ClaimSearchJob indicates an expected call of ClaimSearchJob.
+func (mr *MockDatabaseMockRecorder) ClaimSearchJob(id, runner, staleBefore interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ClaimSearchJob", reflect.TypeOf((*MockDatabase)(nil).ClaimSearchJob), id, runner, staleBefore)
+}
+
+// CountFilesByChecksum mocks base method.
+func (m *MockDatabase) CountFilesByChecksum(sha256 string) (int, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "CountFilesByChecksum", sha256)
+	ret0, _ := ret[0].(int)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// CountFilesByChecksum indicates an expected call of CountFilesByChecksum.
+func (mr *MockDatabaseMockRecorder) CountFilesByChecksum(sha256 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CountFilesByChecksum", reflect.TypeOf((*MockDatabase)(nil).CountFilesByChecksum), sha256)
+}
+
+// CountFilesToRotate mocks base method.
+func (m *MockDatabase) CountFilesToRotate(keyId string) (int, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "CountFilesToRotate", keyId)
+	ret0, _ := ret[0].(int)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// CountFilesToRotate indicates an expected call of CountFilesToRotate.
+func (mr *MockDatabaseMockRecorder) CountFilesToRotate(keyId interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CountFilesToRotate", reflect.TypeOf((*MockDatabase)(nil).CountFilesToRotate), keyId)
+}
+
+// CreateKeyRotation mocks base method.
+func (m *MockDatabase) CreateKeyRotation(arg0 models.KeyRotation) (models.KeyRotation, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "CreateKeyRotation", arg0)
+	ret0, _ := ret[0].(models.KeyRotation)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// CreateKeyRotation indicates an expected call of CreateKeyRotation.
+func (mr *MockDatabaseMockRecorder) CreateKeyRotation(arg0 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CreateKeyRotation", reflect.TypeOf((*MockDatabase)(nil).CreateKeyRotation), arg0)
+}
+
+// CreateSearchJob mocks base method.
+func (m *MockDatabase) CreateSearchJob(arg0 models.SearchJob) (models.SearchJob, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "CreateSearchJob", arg0)
+	ret0, _ := ret[0].(models.SearchJob)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// CreateSearchJob indicates an expected call of CreateSearchJob.
+func (mr *MockDatabaseMockRecorder) CreateSearchJob(arg0 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CreateSearchJob", reflect.TypeOf((*MockDatabase)(nil).CreateSearchJob), arg0)
+}
+
+// CreateShare mocks base method.
+func (m *MockDatabase) CreateShare(arg0 models.Share) (models.Share, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "CreateShare", arg0)
+	ret0, _ := ret[0].(models.Share)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// CreateShare indicates an expected call of CreateShare.
+func (mr *MockDatabaseMockRecorder) CreateShare(arg0 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CreateShare", reflect.TypeOf((*MockDatabase)(nil).CreateShare), arg0)
+}
+
 // CreateUser mocks base method.
 func (m *MockDatabase) CreateUser(spec models.UserCreationParameters) (models.User, error) {
 	m.ctrl.T.Helper()
@@ -66,34 +173,108 @@ func (mr *MockDatabaseMockRecorder) CreateUser(spec interface{}) *gomock.Call {
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CreateUser", reflect.TypeOf((*MockDatabase)(nil).CreateUser), spec)
 }
 
-// GetFile mocks base method.
-func (m *MockDatabase) GetFile(arg0, arg1 []string) (models.File, error) {
+// DeleteFile mocks base method.
+func (m *MockDatabase) DeleteFile(scope database.Scope, uuid string) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "DeleteFile", scope, uuid)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// DeleteFile indicates an expected call of DeleteFile.
+func (mr *MockDatabaseMockRecorder) DeleteFile(scope, uuid interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DeleteFile", reflect.TypeOf((*MockDatabase)(nil).DeleteFile), scope, uuid)
+}
+
+// DeleteShare mocks base method.
+func (m *MockDatabase) DeleteShare(fileUUID string, id int) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "DeleteShare", fileUUID, id)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// DeleteShare indicates an expected call of DeleteShare.
+func (mr *MockDatabaseMockRecorder) DeleteShare(fileUUID, id interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DeleteShare", reflect.TypeOf((*MockDatabase)(nil).DeleteShare), fileUUID, id)
+}
+
+// FindFiles mocks base method.
+func (m *MockDatabase) FindFiles(scope database.Scope, names, tags []string) ([]models.File, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "FindFiles", scope, names, tags)
+	ret0, _ := ret[0].([]models.File)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// FindFiles indicates an expected call of FindFiles.
+func (mr *MockDatabaseMockRecorder) FindFiles(scope, names, tags interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "FindFiles", reflect.TypeOf((*MockDatabase)(nil).FindFiles), scope, names, tags)
+}
+
+// FindSimilarFile mocks base method.
+func (m *MockDatabase) FindSimilarFile(userId int, phash uint64, maxDistance int) (*models.File, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "FindSimilarFile", userId, phash, maxDistance)
+	ret0, _ := ret[0].(*models.File)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// FindSimilarFile indicates an expected call of FindSimilarFile.
+func (mr *MockDatabaseMockRecorder) FindSimilarFile(userId, phash, maxDistance interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "FindSimilarFile", reflect.TypeOf((*MockDatabase)(nil).FindSimilarFile), userId, phash, maxDistance)
+}
+
+// GetFileByChecksum mocks base method.
+func (m *MockDatabase) GetFileByChecksum(sha256 string) (*models.File, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetFileByChecksum", sha256)
+	ret0, _ := ret[0].(*models.File)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetFileByChecksum indicates an expected call of GetFileByChecksum.
+func (mr *MockDatabaseMockRecorder) GetFileByChecksum(sha256 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetFileByChecksum", reflect.TypeOf((*MockDatabase)(nil).GetFileByChecksum), sha256)
+}
+
+// GetFileByUUID mocks base method.
+func (m *MockDatabase) GetFileByUUID(scope database.Scope, uuid string) (models.File, error) {
 	m.ctrl.T.Helper()
-	ret := m.ctrl.Call(m, "GetFile", arg0, arg1)
+	ret := m.ctrl.Call(m, "GetFileByUUID", scope, uuid)
 	ret0, _ := ret[0].(models.File)
 	ret1, _ := ret[1].(error)
 	return ret0, ret1
 }
 
-// GetFile indicates an expected call of GetFile.
-func (mr *MockDatabaseMockRecorder) GetFile(arg0, arg1 interface{}) *gomock.Call {
+// GetFileByUUID indicates an expected call of GetFileByUUID.
+func (mr *MockDatabaseMockRecorder) GetFileByUUID(scope, uuid interface{}) *gomock.Call {
 	mr.mock.ctrl.T.Helper()
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetFile", reflect.TypeOf((*MockDatabase)(nil).GetFile), arg0, arg1)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetFileByUUID", reflect.TypeOf((*MockDatabase)(nil).GetFileByUUID), scope, uuid)
 }
 
 // GetFileList mocks base method.
-func (m *MockDatabase) GetFileList() ([]models.File, error) {
+func (m *MockDatabase) GetFileList(scope database.Scope, options database.ListOptions) ([]models.File, string, error) {
 	m.ctrl.T.Helper()
-	ret := m.ctrl.Call(m, "GetFileList")
+	ret := m.ctrl.Call(m, "GetFileList", scope, options)
 	ret0, _ := ret[0].([]models.File)
-	ret1, _ := ret[1].(error)
-	return ret0, ret1
+	ret1, _ := ret[1].(string)
+	ret2, _ := ret[2].(error)
+	return ret0, ret1, ret2
 }
 
 // GetFileList indicates an expected call of GetFileList.
-func (mr *MockDatabaseMockRecorder) GetFileList() *gomock.Call {
+func (mr *MockDatabaseMockRecorder) GetFileList(scope, options interface{}) *gomock.Call {
 	mr.mock.ctrl.T.Helper()
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetFileList", reflect.TypeOf((*MockDatabase)(nil).GetFileList))
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetFileList", reflect.TypeOf((*MockDatabase)(nil).GetFileList), scope, options)
 }
 
 // GetFileTypes mocks base method.
@@ -126,6 +307,156 @@ func (mr *MockDatabaseMockRecorder) GetFilesSize() *gomock.Call {
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetFilesSize", reflect.TypeOf((*MockDatabase)(nil).GetFilesSize))
 }
 
+// GetFilesToMigrate mocks base method.
+func (m *MockDatabase) GetFilesToMigrate(afterUUID string, limit int) ([]models.File, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetFilesToMigrate", afterUUID, limit)
+	ret0, _ := ret[0].([]models.File)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetFilesToMigrate indicates an expected call of GetFilesToMigrate.
+func (mr *MockDatabaseMockRecorder) GetFilesToMigrate(afterUUID, limit interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetFilesToMigrate", reflect.TypeOf((*MockDatabase)(nil).GetFilesToMigrate), afterUUID, limit)
+}
+
+// GetFilesToPurge mocks base method.
+func (m *MockDatabase) GetFilesToPurge(trashedBefore time.Time, limit int) ([]models.File, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetFilesToPurge", trashedBefore, limit)
+	ret0, _ := ret[0].([]models.File)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetFilesToPurge indicates an expected call of GetFilesToPurge.
+func (mr *MockDatabaseMockRecorder) GetFilesToPurge(trashedBefore, limit interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetFilesToPurge", reflect.TypeOf((*MockDatabase)(nil).GetFilesToPurge), trashedBefore, limit)
+}
+
+// GetFilesToRotate mocks base method.
+func (m *MockDatabase) GetFilesToRotate(keyId, afterUUID string, limit int) ([]models.File, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetFilesToRotate", keyId, afterUUID, limit)
+	ret0, _ := ret[0].([]models.File)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetFilesToRotate indicates an expected call of GetFilesToRotate.
+func (mr *MockDatabaseMockRecorder) GetFilesToRotate(keyId, afterUUID, limit interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetFilesToRotate", reflect.TypeOf((*MockDatabase)(nil).GetFilesToRotate), keyId, afterUUID, limit)
+}
+
+// GetRunningKeyRotation mocks base method.
+func (m *MockDatabase) GetRunningKeyRotation(keyId string) (*models.KeyRotation, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetRunningKeyRotation", keyId)
+	ret0, _ := ret[0].(*models.KeyRotation)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetRunningKeyRotation indicates an expected call of GetRunningKeyRotation.
+func (mr *MockDatabaseMockRecorder) GetRunningKeyRotation(keyId interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetRunningKeyRotation", reflect.TypeOf((*MockDatabase)(nil).GetRunningKeyRotation), keyId)
+}
+
+// GetSearchJob mocks base method.
+func (m *MockDatabase) GetSearchJob(id int) (models.SearchJob, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetSearchJob", id)
+	ret0, _ := ret[0].(models.SearchJob)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetSearchJob indicates an expected call of GetSearchJob.
+func (mr *MockDatabaseMockRecorder) GetSearchJob(id interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetSearchJob", reflect.TypeOf((*MockDatabase)(nil).GetSearchJob), id)
+}
+
+// GetSearchJobList mocks base method.
+func (m *MockDatabase) GetSearchJobList(scope database.Scope) ([]models.SearchJob, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetSearchJobList", scope)
+	ret0, _ := ret[0].([]models.SearchJob)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetSearchJobList indicates an expected call of GetSearchJobList.
+func (mr *MockDatabaseMockRecorder) GetSearchJobList(scope interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetSearchJobList", reflect.TypeOf((*MockDatabase)(nil).GetSearchJobList), scope)
+}
+
+// GetShareList mocks base method.
+func (m *MockDatabase) GetShareList(fileUUID string) ([]models.Share, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetShareList", fileUUID)
+	ret0, _ := ret[0].([]models.Share)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetShareList indicates an expected call of GetShareList.
+func (mr *MockDatabaseMockRecorder) GetShareList(fileUUID interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetShareList", reflect.TypeOf((*MockDatabase)(nil).GetShareList), fileUUID)
+}
+
+// GetTagList mocks base method.
+func (m *MockDatabase) GetTagList(scope database.Scope, prefix string, limit int) ([]models.TagCount, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetTagList", scope, prefix, limit)
+	ret0, _ := ret[0].([]models.TagCount)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetTagList indicates an expected call of GetTagList.
+func (mr *MockDatabaseMockRecorder) GetTagList(scope, prefix, limit interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetTagList", reflect.TypeOf((*MockDatabase)(nil).GetTagList), scope, prefix, limit)
+}
+
+// GetTrashList mocks base method.
+func (m *MockDatabase) GetTrashList(scope database.Scope) ([]models.File, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetTrashList", scope)
+	ret0, _ := ret[0].([]models.File)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetTrashList indicates an expected call of GetTrashList.
+func (mr *MockDatabaseMockRecorder) GetTrashList(scope interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetTrashList", reflect.TypeOf((*MockDatabase)(nil).GetTrashList), scope)
+}
+
+// GetUnfinishedSearchJobs mocks base method.
+func (m *MockDatabase) GetUnfinishedSearchJobs() ([]models.SearchJob, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetUnfinishedSearchJobs")
+	ret0, _ := ret[0].([]models.SearchJob)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetUnfinishedSearchJobs indicates an expected call of GetUnfinishedSearchJobs.
+func (mr *MockDatabaseMockRecorder) GetUnfinishedSearchJobs() *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetUnfinishedSearchJobs", reflect.TypeOf((*MockDatabase)(nil).GetUnfinishedSearchJobs))
+}
+
 // GetUserByEmail mocks base method.
 func (m *MockDatabase) GetUserByEmail(email string) (*models.UserWithPassword, error) {
 	m.ctrl.T.Helper()
@@ -142,18 +473,62 @@ func (mr *MockDatabaseMockRecorder) GetUserByEmail(email interface{}) *gomock.Ca
 }
 
 // GetUserList mocks base method.
-func (m *MockDatabase) GetUserList() ([]models.User, error) {
+func (m *MockDatabase) GetUserList(options database.ListOptions) ([]models.User, string, error) {
 	m.ctrl.T.Helper()
-	ret := m.ctrl.Call(m, "GetUserList")
+	ret := m.ctrl.Call(m, "GetUserList", options)
 	ret0, _ := ret[0].([]models.User)
+	ret1, _ := ret[1].(string)
+	ret2, _ := ret[2].(error)
+	return ret0, ret1, ret2
+}
+
+// GetUserList indicates an expected call of GetUserList.
+func (mr *MockDatabaseMockRecorder) GetUserList(options interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetUserList", reflect.TypeOf((*MockDatabase)(nil).GetUserList), options)
+}
+
+// HeartbeatSearchJob mocks base method.
+func (m *MockDatabase) HeartbeatSearchJob(id int, runner string) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "HeartbeatSearchJob", id, runner)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// HeartbeatSearchJob indicates an expected call of HeartbeatSearchJob.
+func (mr *MockDatabaseMockRecorder) HeartbeatSearchJob(id, runner interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "HeartbeatSearchJob", reflect.TypeOf((*MockDatabase)(nil).HeartbeatSearchJob), id, runner)
+}
+
+// LockChecksum mocks base method.
+func (m *MockDatabase) LockChecksum(sha256 string) (func(), error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "LockChecksum", sha256)
+	ret0, _ := ret[0].(func())
 	ret1, _ := ret[1].(error)
 	return ret0, ret1
 }
 
-// GetUserList indicates an expected call of GetUserList.
-func (mr *MockDatabaseMockRecorder) GetUserList() *gomock.Call {
+// LockChecksum indicates an expected call of LockChecksum.
+func (mr *MockDatabaseMockRecorder) LockChecksum(sha256 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "LockChecksum", reflect.TypeOf((*MockDatabase)(nil).LockChecksum), sha256)
+}
+
+// MergeTags mocks base method.
+func (m *MockDatabase) MergeTags(name, into string) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "MergeTags", name, into)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// MergeTags indicates an expected call of MergeTags.
+func (mr *MockDatabaseMockRecorder) MergeTags(name, into interface{}) *gomock.Call {
 	mr.mock.ctrl.T.Helper()
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetUserList", reflect.TypeOf((*MockDatabase)(nil).GetUserList))
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "MergeTags", reflect.TypeOf((*MockDatabase)(nil).MergeTags), name, into)
 }
 
 // NewSerializableTransaction mocks base method.
@@ -186,6 +561,65 @@ func (mr *MockDatabaseMockRecorder) NewTransaction(ctx, isolation interface{}) *
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "NewTransaction", reflect.TypeOf((*MockDatabase)(nil).NewTransaction), ctx, isolation)
 }
 
+// QueryFiles mocks base method.
+func (m *MockDatabase) QueryFiles(scope database.Scope, expr filequery.Expr, limit, offset int) ([]models.File, int, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "QueryFiles", scope, expr, limit, offset)
+	ret0, _ := ret[0].([]models.File)
+	ret1, _ := ret[1].(int)
+	ret2, _ := ret[2].(error)
+	return ret0, ret1, ret2
+}
+
+// QueryFiles indicates an expected call of QueryFiles.
+func (mr *MockDatabaseMockRecorder) QueryFiles(scope, expr, limit, offset interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "QueryFiles", reflect.TypeOf((*MockDatabase)(nil).QueryFiles), scope, expr, limit, offset)
+}
+
+// RemoveFileTags mocks base method.
+func (m *MockDatabase) RemoveFileTags(scope database.Scope, uuid string, tags []string) ([]string, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "RemoveFileTags", scope, uuid, tags)
+	ret0, _ := ret[0].([]string)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// RemoveFileTags indicates an expected call of RemoveFileTags.
+func (mr *MockDatabaseMockRecorder) RemoveFileTags(scope, uuid, tags interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "RemoveFileTags", reflect.TypeOf((*MockDatabase)(nil).RemoveFileTags), scope, uuid, tags)
+}
+
+// RenameTag mocks base method.
+func (m *MockDatabase) RenameTag(name, newName string) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "RenameTag", name, newName)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// RenameTag indicates an expected call of RenameTag.
+func (mr *MockDatabaseMockRecorder) RenameTag(name, newName interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "RenameTag", reflect.TypeOf((*MockDatabase)(nil).RenameTag), name, newName)
+}
+
+// RestoreFile mocks base method.
+func (m *MockDatabase) RestoreFile(scope database.Scope, uuid string) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "RestoreFile", scope, uuid)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// RestoreFile indicates an expected call of RestoreFile.
+func (mr *MockDatabaseMockRecorder) RestoreFile(scope, uuid interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "RestoreFile", reflect.TypeOf((*MockDatabase)(nil).RestoreFile), scope, uuid)
+}
+
 // SaveFile mocks base method.
 func (m *MockDatabase) SaveFile(arg0 models.File) error {
 	m.ctrl.T.Helper()
@@ -200,264 +634,1018 @@ func (mr *MockDatabaseMockRecorder) SaveFile(arg0 interface{}) *gomock.Call {
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "SaveFile", reflect.TypeOf((*MockDatabase)(nil).SaveFile), arg0)
 }
 
-// UpdateUserLastLogin mocks base method.
-func (m *MockDatabase) UpdateUserLastLogin(userId int) error {
+// SearchFilesText mocks base method.
+func (m *MockDatabase) SearchFilesText(scope database.Scope, text string, limit, offset int) ([]models.TextMatch, int, error) {
 	m.ctrl.T.Helper()
-	ret := m.ctrl.Call(m, "UpdateUserLastLogin", userId)
+	ret := m.ctrl.Call(m, "SearchFilesText", scope, text, limit, offset)
+	ret0, _ := ret[0].([]models.TextMatch)
+	ret1, _ := ret[1].(int)
+	ret2, _ := ret[2].(error)
+	return ret0, ret1, ret2
+}
+
+// SearchFilesText indicates an expected call of SearchFilesText.
+func (mr *MockDatabaseMockRecorder) SearchFilesText(scope, text, limit, offset interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "SearchFilesText", reflect.TypeOf((*MockDatabase)(nil).SearchFilesText), scope, text, limit, offset)
+}
+
+// TrashFile mocks base method.
+func (m *MockDatabase) TrashFile(scope database.Scope, uuid string) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "TrashFile", scope, uuid)
 	ret0, _ := ret[0].(error)
 	return ret0
 }
 
-// UpdateUserLastLogin indicates an expected call of UpdateUserLastLogin.
-func (mr *MockDatabaseMockRecorder) UpdateUserLastLogin(userId interface{}) *gomock.Call {
+// TrashFile indicates an expected call of TrashFile.
+func (mr *MockDatabaseMockRecorder) TrashFile(scope, uuid interface{}) *gomock.Call {
 	mr.mock.ctrl.T.Helper()
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "UpdateUserLastLogin", reflect.TypeOf((*MockDatabase)(nil).UpdateUserLastLogin), userId)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "TrashFile", reflect.TypeOf((*MockDatabase)(nil).TrashFile), scope, uuid)
 }
 
-// MockTransactionMethods is a mock of TransactionMethods interface.
-type MockTransactionMethods struct {
-	ctrl     *gomock.Controller
-	recorder *MockTransactionMethodsMockRecorder
+// UpdateFileBlob mocks base method.
+func (m *MockDatabase) UpdateFileBlob(uuid, sha256, keyId string, wrappedKey []byte) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "UpdateFileBlob", uuid, sha256, keyId, wrappedKey)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// UpdateFileBlob indicates an expected call of UpdateFileBlob.
+func (mr *MockDatabaseMockRecorder) UpdateFileBlob(uuid, sha256, keyId, wrappedKey interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "UpdateFileBlob", reflect.TypeOf((*MockDatabase)(nil).UpdateFileBlob), uuid, sha256, keyId, wrappedKey)
+}
+
+// UpdateFileKey mocks base method.
+func (m *MockDatabase) UpdateFileKey(uuid, keyId string, wrappedKey []byte) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "UpdateFileKey", uuid, keyId, wrappedKey)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// UpdateFileKey indicates an expected call of UpdateFileKey.
+func (mr *MockDatabaseMockRecorder) UpdateFileKey(uuid, keyId, wrappedKey interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "UpdateFileKey", reflect.TypeOf((*MockDatabase)(nil).UpdateFileKey), uuid, keyId, wrappedKey)
+}
+
+// UpdateFileType mocks base method.
+func (m *MockDatabase) UpdateFileType(uuid, typeId string) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "UpdateFileType", uuid, typeId)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// UpdateFileType indicates an expected call of UpdateFileType.
+func (mr *MockDatabaseMockRecorder) UpdateFileType(uuid, typeId interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "UpdateFileType", reflect.TypeOf((*MockDatabase)(nil).UpdateFileType), uuid, typeId)
+}
+
+// UpdateKeyRotation mocks base method.
+func (m *MockDatabase) UpdateKeyRotation(arg0 models.KeyRotation) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "UpdateKeyRotation", arg0)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// UpdateKeyRotation indicates an expected call of UpdateKeyRotation.
+func (mr *MockDatabaseMockRecorder) UpdateKeyRotation(arg0 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "UpdateKeyRotation", reflect.TypeOf((*MockDatabase)(nil).UpdateKeyRotation), arg0)
+}
+
+// UpdateSearchJob mocks base method.
+func (m *MockDatabase) UpdateSearchJob(arg0 models.SearchJob) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "UpdateSearchJob", arg0)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// UpdateSearchJob indicates an expected call of UpdateSearchJob.
+func (mr *MockDatabaseMockRecorder) UpdateSearchJob(arg0 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "UpdateSearchJob", reflect.TypeOf((*MockDatabase)(nil).UpdateSearchJob), arg0)
+}
+
+// UpdateUserLastLogin mocks base method.
+func (m *MockDatabase) UpdateUserLastLogin(userId int) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "UpdateUserLastLogin", userId)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// UpdateUserLastLogin indicates an expected call of UpdateUserLastLogin.
+func (mr *MockDatabaseMockRecorder) UpdateUserLastLogin(userId interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "UpdateUserLastLogin", reflect.TypeOf((*MockDatabase)(nil).UpdateUserLastLogin), userId)
+}
+
+// UseShareLink mocks base method.
+func (m *MockDatabase) UseShareLink(id int) (models.Share, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "UseShareLink", id)
+	ret0, _ := ret[0].(models.Share)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// UseShareLink indicates an expected call of UseShareLink.
+func (mr *MockDatabaseMockRecorder) UseShareLink(id interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "UseShareLink", reflect.TypeOf((*MockDatabase)(nil).UseShareLink), id)
+}
+
+// MockTransactionMethods is a mock of TransactionMethods interface.
+type MockTransactionMethods struct {
+	ctrl     *gomock.Controller
+	recorder *MockTransactionMethodsMockRecorder
+}
+
+// MockTransactionMethodsMockRecorder is the mock recorder for MockTransactionMethods.
+type MockTransactionMethodsMockRecorder struct {
+	mock *MockTransactionMethods
+}
+
+// NewMockTransactionMethods creates a new mock instance.
+func NewMockTransactionMethods(ctrl *gomock.Controller) *MockTransactionMethods {
+	mock := &MockTransactionMethods{ctrl: ctrl}
+	mock.recorder = &MockTransactionMethodsMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockTransactionMethods) EXPECT() *MockTransactionMethodsMockRecorder {
+	return m.recorder
+}
+
+// NewSerializableTransaction mocks base method.
+func (m *MockTransactionMethods) NewSerializableTransaction(ctx context.Context) (database.Transaction, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "NewSerializableTransaction", ctx)
+	ret0, _ := ret[0].(database.Transaction)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// NewSerializableTransaction indicates an expected call of NewSerializableTransaction.
+func (mr *MockTransactionMethodsMockRecorder) NewSerializableTransaction(ctx interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "NewSerializableTransaction", reflect.TypeOf((*MockTransactionMethods)(nil).NewSerializableTransaction), ctx)
+}
+
+// NewTransaction mocks base method.
+func (m *MockTransactionMethods) NewTransaction(ctx context.Context, isolation sql.IsolationLevel) (database.Transaction, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "NewTransaction", ctx, isolation)
+	ret0, _ := ret[0].(database.Transaction)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// NewTransaction indicates an expected call of NewTransaction.
+func (mr *MockTransactionMethodsMockRecorder) NewTransaction(ctx, isolation interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "NewTransaction", reflect.TypeOf((*MockTransactionMethods)(nil).NewTransaction), ctx, isolation)
+}
+
+// MockUsersDatabaseMethods is a mock of UsersDatabaseMethods interface.
+type MockUsersDatabaseMethods struct {
+	ctrl     *gomock.Controller
+	recorder *MockUsersDatabaseMethodsMockRecorder
+}
+
+// MockUsersDatabaseMethodsMockRecorder is the mock recorder for MockUsersDatabaseMethods.
+type MockUsersDatabaseMethodsMockRecorder struct {
+	mock *MockUsersDatabaseMethods
+}
+
+// NewMockUsersDatabaseMethods creates a new mock instance.
+func NewMockUsersDatabaseMethods(ctrl *gomock.Controller) *MockUsersDatabaseMethods {
+	mock := &MockUsersDatabaseMethods{ctrl: ctrl}
+	mock.recorder = &MockUsersDatabaseMethodsMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockUsersDatabaseMethods) EXPECT() *MockUsersDatabaseMethodsMockRecorder {
+	return m.recorder
+}
+
+// CreateUser mocks base method.
+func (m *MockUsersDatabaseMethods) CreateUser(spec models.UserCreationParameters) (models.User, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "CreateUser", spec)
+	ret0, _ := ret[0].(models.User)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// CreateUser indicates an expected call of CreateUser.
+func (mr *MockUsersDatabaseMethodsMockRecorder) CreateUser(spec interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CreateUser", reflect.TypeOf((*MockUsersDatabaseMethods)(nil).CreateUser), spec)
+}
+
+// GetUserByEmail mocks base method.
+func (m *MockUsersDatabaseMethods) GetUserByEmail(email string) (*models.UserWithPassword, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetUserByEmail", email)
+	ret0, _ := ret[0].(*models.UserWithPassword)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetUserByEmail indicates an expected call of GetUserByEmail.
+func (mr *MockUsersDatabaseMethodsMockRecorder) GetUserByEmail(email interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetUserByEmail", reflect.TypeOf((*MockUsersDatabaseMethods)(nil).GetUserByEmail), email)
+}
+
+// GetUserList mocks base method.
+func (m *MockUsersDatabaseMethods) GetUserList(options database.ListOptions) ([]models.User, string, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetUserList", options)
+	ret0, _ := ret[0].([]models.User)
+	ret1, _ := ret[1].(string)
+	ret2, _ := ret[2].(error)
+	return ret0, ret1, ret2
+}
+
+// GetUserList indicates an expected call of GetUserList.
+func (mr *MockUsersDatabaseMethodsMockRecorder) GetUserList(options interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetUserList", reflect.TypeOf((*MockUsersDatabaseMethods)(nil).GetUserList), options)
+}
+
+// UpdateUserLastLogin mocks base method.
+func (m *MockUsersDatabaseMethods) UpdateUserLastLogin(userId int) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "UpdateUserLastLogin", userId)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// UpdateUserLastLogin indicates an expected call of UpdateUserLastLogin.
+func (mr *MockUsersDatabaseMethodsMockRecorder) UpdateUserLastLogin(userId interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "UpdateUserLastLogin", reflect.TypeOf((*MockUsersDatabaseMethods)(nil).UpdateUserLastLogin), userId)
+}
+
+// MockFilesDatabaseMethods is a mock of FilesDatabaseMethods interface.
+type MockFilesDatabaseMethods struct {
+	ctrl     *gomock.Controller
+	recorder *MockFilesDatabaseMethodsMockRecorder
+}
+
+// MockFilesDatabaseMethodsMockRecorder is the mock recorder for MockFilesDatabaseMethods.
+type MockFilesDatabaseMethodsMockRecorder struct {
+	mock *MockFilesDatabaseMethods
+}
+
+// NewMockFilesDatabaseMethods creates a new mock instance.
+func NewMockFilesDatabaseMethods(ctrl *gomock.Controller) *MockFilesDatabaseMethods {
+	mock := &MockFilesDatabaseMethods{ctrl: ctrl}
+	mock.recorder = &MockFilesDatabaseMethodsMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockFilesDatabaseMethods) EXPECT() *MockFilesDatabaseMethodsMockRecorder {
+	return m.recorder
+}
+
+// AddFileTypeIfNotExist mocks base method.
+func (m *MockFilesDatabaseMethods) AddFileTypeIfNotExist(arg0 string) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "AddFileTypeIfNotExist", arg0)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// AddFileTypeIfNotExist indicates an expected call of AddFileTypeIfNotExist.
+func (mr *MockFilesDatabaseMethodsMockRecorder) AddFileTypeIfNotExist(arg0 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "AddFileTypeIfNotExist", reflect.TypeOf((*MockFilesDatabaseMethods)(nil).AddFileTypeIfNotExist), arg0)
+}
+
+// CountFilesByChecksum mocks base method.
+func (m *MockFilesDatabaseMethods) CountFilesByChecksum(sha256 string) (int, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "CountFilesByChecksum", sha256)
+	ret0, _ := ret[0].(int)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// CountFilesByChecksum indicates an expected call of CountFilesByChecksum.
+func (mr *MockFilesDatabaseMethodsMockRecorder) CountFilesByChecksum(sha256 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CountFilesByChecksum", reflect.TypeOf((*MockFilesDatabaseMethods)(nil).CountFilesByChecksum), sha256)
+}
+
+// DeleteFile mocks base method.
+func (m *MockFilesDatabaseMethods) DeleteFile(scope database.Scope, uuid string) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "DeleteFile", scope, uuid)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// DeleteFile indicates an expected call of DeleteFile.
+func (mr *MockFilesDatabaseMethodsMockRecorder) DeleteFile(scope, uuid interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DeleteFile", reflect.TypeOf((*MockFilesDatabaseMethods)(nil).DeleteFile), scope, uuid)
+}
+
+// FindFiles mocks base method.
+func (m *MockFilesDatabaseMethods) FindFiles(scope database.Scope, names, tags []string) ([]models.File, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "FindFiles", scope, names, tags)
+	ret0, _ := ret[0].([]models.File)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// FindFiles indicates an expected call of FindFiles.
+func (mr *MockFilesDatabaseMethodsMockRecorder) FindFiles(scope, names, tags interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "FindFiles", reflect.TypeOf((*MockFilesDatabaseMethods)(nil).FindFiles), scope, names, tags)
+}
+
+// FindSimilarFile mocks base method.
+func (m *MockFilesDatabaseMethods) FindSimilarFile(userId int, phash uint64, maxDistance int) (*models.File, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "FindSimilarFile", userId, phash, maxDistance)
+	ret0, _ := ret[0].(*models.File)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// FindSimilarFile indicates an expected call of FindSimilarFile.
+func (mr *MockFilesDatabaseMethodsMockRecorder) FindSimilarFile(userId, phash, maxDistance interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "FindSimilarFile", reflect.TypeOf((*MockFilesDatabaseMethods)(nil).FindSimilarFile), userId, phash, maxDistance)
+}
+
+// GetFileByChecksum mocks base method.
+func (m *MockFilesDatabaseMethods) GetFileByChecksum(sha256 string) (*models.File, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetFileByChecksum", sha256)
+	ret0, _ := ret[0].(*models.File)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetFileByChecksum indicates an expected call of GetFileByChecksum.
+func (mr *MockFilesDatabaseMethodsMockRecorder) GetFileByChecksum(sha256 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetFileByChecksum", reflect.TypeOf((*MockFilesDatabaseMethods)(nil).GetFileByChecksum), sha256)
+}
+
+// GetFileByUUID mocks base method.
+func (m *MockFilesDatabaseMethods) GetFileByUUID(scope database.Scope, uuid string) (models.File, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetFileByUUID", scope, uuid)
+	ret0, _ := ret[0].(models.File)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetFileByUUID indicates an expected call of GetFileByUUID.
+func (mr *MockFilesDatabaseMethodsMockRecorder) GetFileByUUID(scope, uuid interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetFileByUUID", reflect.TypeOf((*MockFilesDatabaseMethods)(nil).GetFileByUUID), scope, uuid)
+}
+
+// GetFileList mocks base method.
+func (m *MockFilesDatabaseMethods) GetFileList(scope database.Scope, options database.ListOptions) ([]models.File, string, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetFileList", scope, options)
+	ret0, _ := ret[0].([]models.File)
+	ret1, _ := ret[1].(string)
+	ret2, _ := ret[2].(error)
+	return ret0, ret1, ret2
+}
+
+// GetFileList indicates an expected call of GetFileList.
+func (mr *MockFilesDatabaseMethodsMockRecorder) GetFileList(scope, options interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetFileList", reflect.TypeOf((*MockFilesDatabaseMethods)(nil).GetFileList), scope, options)
+}
+
+// GetFileTypes mocks base method.
+func (m *MockFilesDatabaseMethods) GetFileTypes() ([]models.FileType, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetFileTypes")
+	ret0, _ := ret[0].([]models.FileType)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetFileTypes indicates an expected call of GetFileTypes.
+func (mr *MockFilesDatabaseMethodsMockRecorder) GetFileTypes() *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetFileTypes", reflect.TypeOf((*MockFilesDatabaseMethods)(nil).GetFileTypes))
+}
+
+// GetFilesSize mocks base method.
+func (m *MockFilesDatabaseMethods) GetFilesSize() (int, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetFilesSize")
+	ret0, _ := ret[0].(int)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetFilesSize indicates an expected call of GetFilesSize.
+func (mr *MockFilesDatabaseMethodsMockRecorder) GetFilesSize() *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetFilesSize", reflect.TypeOf((*MockFilesDatabaseMethods)(nil).GetFilesSize))
+}
+
+// GetFilesToMigrate mocks base method.
+func (m *MockFilesDatabaseMethods) GetFilesToMigrate(afterUUID string, limit int) ([]models.File, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetFilesToMigrate", afterUUID, limit)
+	ret0, _ := ret[0].([]models.File)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetFilesToMigrate indicates an expected call of GetFilesToMigrate.
+func (mr *MockFilesDatabaseMethodsMockRecorder) GetFilesToMigrate(afterUUID, limit interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetFilesToMigrate", reflect.TypeOf((*MockFilesDatabaseMethods)(nil).GetFilesToMigrate), afterUUID, limit)
+}
+
+// GetFilesToPurge mocks base method.
+func (m *MockFilesDatabaseMethods) GetFilesToPurge(trashedBefore time.Time, limit int) ([]models.File, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetFilesToPurge", trashedBefore, limit)
+	ret0, _ := ret[0].([]models.File)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetFilesToPurge indicates an expected call of GetFilesToPurge.
+func (mr *MockFilesDatabaseMethodsMockRecorder) GetFilesToPurge(trashedBefore, limit interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetFilesToPurge", reflect.TypeOf((*MockFilesDatabaseMethods)(nil).GetFilesToPurge), trashedBefore, limit)
+}
+
+// GetTrashList mocks base method.
+func (m *MockFilesDatabaseMethods) GetTrashList(scope database.Scope) ([]models.File, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetTrashList", scope)
+	ret0, _ := ret[0].([]models.File)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetTrashList indicates an expected call of GetTrashList.
+func (mr *MockFilesDatabaseMethodsMockRecorder) GetTrashList(scope interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetTrashList", reflect.TypeOf((*MockFilesDatabaseMethods)(nil).GetTrashList), scope)
+}
+
+// LockChecksum mocks base method.
+func (m *MockFilesDatabaseMethods) LockChecksum(sha256 string) (func(), error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "LockChecksum", sha256)
+	ret0, _ := ret[0].(func())
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// LockChecksum indicates an expected call of LockChecksum.
+func (mr *MockFilesDatabaseMethodsMockRecorder) LockChecksum(sha256 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "LockChecksum", reflect.TypeOf((*MockFilesDatabaseMethods)(nil).LockChecksum), sha256)
+}
+
+// QueryFiles mocks base method.
+func (m *MockFilesDatabaseMethods) QueryFiles(scope database.Scope, expr filequery.Expr, limit, offset int) ([]models.File, int, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "QueryFiles", scope, expr, limit, offset)
+	ret0, _ := ret[0].([]models.File)
+	ret1, _ := ret[1].(int)
+	ret2, _ := ret[2].(error)
+	return ret0, ret1, ret2
+}
+
+// QueryFiles indicates an expected call of QueryFiles.
+func (mr *MockFilesDatabaseMethodsMockRecorder) QueryFiles(scope, expr, limit, offset interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "QueryFiles", reflect.TypeOf((*MockFilesDatabaseMethods)(nil).QueryFiles), scope, expr, limit, offset)
+}
+
+// RestoreFile mocks base method.
+func (m *MockFilesDatabaseMethods) RestoreFile(scope database.Scope, uuid string) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "RestoreFile", scope, uuid)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// RestoreFile indicates an expected call of RestoreFile.
+func (mr *MockFilesDatabaseMethodsMockRecorder) RestoreFile(scope, uuid interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "RestoreFile", reflect.TypeOf((*MockFilesDatabaseMethods)(nil).RestoreFile), scope, uuid)
+}
+
+// SaveFile mocks base method.
+func (m *MockFilesDatabaseMethods) SaveFile(arg0 models.File) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "SaveFile", arg0)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// SaveFile indicates an expected call of SaveFile.
+func (mr *MockFilesDatabaseMethodsMockRecorder) SaveFile(arg0 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "SaveFile", reflect.TypeOf((*MockFilesDatabaseMethods)(nil).SaveFile), arg0)
+}
+
+// SearchFilesText mocks base method.
+func (m *MockFilesDatabaseMethods) SearchFilesText(scope database.Scope, text string, limit, offset int) ([]models.TextMatch, int, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "SearchFilesText", scope, text, limit, offset)
+	ret0, _ := ret[0].([]models.TextMatch)
+	ret1, _ := ret[1].(int)
+	ret2, _ := ret[2].(error)
+	return ret0, ret1, ret2
+}
+
+// SearchFilesText indicates an expected call of SearchFilesText.
+func (mr *MockFilesDatabaseMethodsMockRecorder) SearchFilesText(scope, text, limit, offset interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "SearchFilesText", reflect.TypeOf((*MockFilesDatabaseMethods)(nil).SearchFilesText), scope, text, limit, offset)
+}
+
+// TrashFile mocks base method.
+func (m *MockFilesDatabaseMethods) TrashFile(scope database.Scope, uuid string) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "TrashFile", scope, uuid)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// TrashFile indicates an expected call of TrashFile.
+func (mr *MockFilesDatabaseMethodsMockRecorder) TrashFile(scope, uuid interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "TrashFile", reflect.TypeOf((*MockFilesDatabaseMethods)(nil).TrashFile), scope, uuid)
+}
+
+// UpdateFileBlob mocks base method.
+func (m *MockFilesDatabaseMethods) UpdateFileBlob(uuid, sha256, keyId string, wrappedKey []byte) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "UpdateFileBlob", uuid, sha256, keyId, wrappedKey)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// UpdateFileBlob indicates an expected call of UpdateFileBlob.
+func (mr *MockFilesDatabaseMethodsMockRecorder) UpdateFileBlob(uuid, sha256, keyId, wrappedKey interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "UpdateFileBlob", reflect.TypeOf((*MockFilesDatabaseMethods)(nil).UpdateFileBlob), uuid, sha256, keyId, wrappedKey)
+}
+
+// UpdateFileKey mocks base method.
+func (m *MockFilesDatabaseMethods) UpdateFileKey(uuid, keyId string, wrappedKey []byte) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "UpdateFileKey", uuid, keyId, wrappedKey)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// UpdateFileKey indicates an expected call of UpdateFileKey.
+func (mr *MockFilesDatabaseMethodsMockRecorder) UpdateFileKey(uuid, keyId, wrappedKey interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "UpdateFileKey", reflect.TypeOf((*MockFilesDatabaseMethods)(nil).UpdateFileKey), uuid, keyId, wrappedKey)
+}
+
+// UpdateFileType mocks base method.
+func (m *MockFilesDatabaseMethods) UpdateFileType(uuid, typeId string) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "UpdateFileType", uuid, typeId)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// UpdateFileType indicates an expected call of UpdateFileType.
+func (mr *MockFilesDatabaseMethodsMockRecorder) UpdateFileType(uuid, typeId interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "UpdateFileType", reflect.TypeOf((*MockFilesDatabaseMethods)(nil).UpdateFileType), uuid, typeId)
+}
+
+// MockSearchJobsDatabaseMethods is a mock of SearchJobsDatabaseMethods interface.
+type MockSearchJobsDatabaseMethods struct {
+	ctrl     *gomock.Controller
+	recorder *MockSearchJobsDatabaseMethodsMockRecorder
+}
+
+// MockSearchJobsDatabaseMethodsMockRecorder is the mock recorder for MockSearchJobsDatabaseMethods.
+type MockSearchJobsDatabaseMethodsMockRecorder struct {
+	mock *MockSearchJobsDatabaseMethods
+}
+
+// NewMockSearchJobsDatabaseMethods creates a new mock instance.
+func NewMockSearchJobsDatabaseMethods(ctrl *gomock.Controller) *MockSearchJobsDatabaseMethods {
+	mock := &MockSearchJobsDatabaseMethods{ctrl: ctrl}
+	mock.recorder = &MockSearchJobsDatabaseMethodsMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockSearchJobsDatabaseMethods) EXPECT() *MockSearchJobsDatabaseMethodsMockRecorder {
+	return m.recorder
+}
+
+// ClaimSearchJob mocks base method.
+func (m *MockSearchJobsDatabaseMethods) ClaimSearchJob(id int, runner string, staleBefore time.Time) (models.SearchJob, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ClaimSearchJob", id, runner, staleBefore)
+	ret0, _ := ret[0].(models.SearchJob)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ClaimSearchJob indicates an expected call of ClaimSearchJob.
+func (mr *MockSearchJobsDatabaseMethodsMockRecorder) ClaimSearchJob(id, runner, staleBefore interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ClaimSearchJob", reflect.TypeOf((*MockSearchJobsDatabaseMethods)(nil).ClaimSearchJob), id, runner, staleBefore)
+}
+
+// CreateSearchJob mocks base method.
+func (m *MockSearchJobsDatabaseMethods) CreateSearchJob(arg0 models.SearchJob) (models.SearchJob, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "CreateSearchJob", arg0)
+	ret0, _ := ret[0].(models.SearchJob)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// CreateSearchJob indicates an expected call of CreateSearchJob.
+func (mr *MockSearchJobsDatabaseMethodsMockRecorder) CreateSearchJob(arg0 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CreateSearchJob", reflect.TypeOf((*MockSearchJobsDatabaseMethods)(nil).CreateSearchJob), arg0)
+}
+
+// GetSearchJob mocks base method.
+func (m *MockSearchJobsDatabaseMethods) GetSearchJob(id int) (models.SearchJob, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetSearchJob", id)
+	ret0, _ := ret[0].(models.SearchJob)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetSearchJob indicates an expected call of GetSearchJob.
+func (mr *MockSearchJobsDatabaseMethodsMockRecorder) GetSearchJob(id interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetSearchJob", reflect.TypeOf((*MockSearchJobsDatabaseMethods)(nil).GetSearchJob), id)
+}
+
+// GetSearchJobList mocks base method.
+func (m *MockSearchJobsDatabaseMethods) GetSearchJobList(scope database.Scope) ([]models.SearchJob, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetSearchJobList", scope)
+	ret0, _ := ret[0].([]models.SearchJob)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetSearchJobList indicates an expected call of GetSearchJobList.
+func (mr *MockSearchJobsDatabaseMethodsMockRecorder) GetSearchJobList(scope interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetSearchJobList", reflect.TypeOf((*MockSearchJobsDatabaseMethods)(nil).GetSearchJobList), scope)
+}
+
+// GetUnfinishedSearchJobs mocks base method.
+func (m *MockSearchJobsDatabaseMethods) GetUnfinishedSearchJobs() ([]models.SearchJob, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetUnfinishedSearchJobs")
+	ret0, _ := ret[0].([]models.SearchJob)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetUnfinishedSearchJobs indicates an expected call of GetUnfinishedSearchJobs.
+func (mr *MockSearchJobsDatabaseMethodsMockRecorder) GetUnfinishedSearchJobs() *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetUnfinishedSearchJobs", reflect.TypeOf((*MockSearchJobsDatabaseMethods)(nil).GetUnfinishedSearchJobs))
+}
+
+// HeartbeatSearchJob mocks base method.
+func (m *MockSearchJobsDatabaseMethods) HeartbeatSearchJob(id int, runner string) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "HeartbeatSearchJob", id, runner)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// HeartbeatSearchJob indicates an expected call of HeartbeatSearchJob.
+func (mr *MockSearchJobsDatabaseMethodsMockRecorder) HeartbeatSearchJob(id, runner interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "HeartbeatSearchJob", reflect.TypeOf((*MockSearchJobsDatabaseMethods)(nil).HeartbeatSearchJob), id, runner)
+}
+
+// UpdateSearchJob mocks base method.
+func (m *MockSearchJobsDatabaseMethods) UpdateSearchJob(arg0 models.SearchJob) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "UpdateSearchJob", arg0)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// UpdateSearchJob indicates an expected call of UpdateSearchJob.
+func (mr *MockSearchJobsDatabaseMethodsMockRecorder) UpdateSearchJob(arg0 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "UpdateSearchJob", reflect.TypeOf((*MockSearchJobsDatabaseMethods)(nil).UpdateSearchJob), arg0)
+}
+
+// MockSharesDatabaseMethods is a mock of SharesDatabaseMethods interface.
+type MockSharesDatabaseMethods struct {
+	ctrl     *gomock.Controller
+	recorder *MockSharesDatabaseMethodsMockRecorder
+}
+
+// MockSharesDatabaseMethodsMockRecorder is the mock recorder for MockSharesDatabaseMethods.
+type MockSharesDatabaseMethodsMockRecorder struct {
+	mock *MockSharesDatabaseMethods
+}
+
+// NewMockSharesDatabaseMethods creates a new mock instance.
+func NewMockSharesDatabaseMethods(ctrl *gomock.Controller) *MockSharesDatabaseMethods {
+	mock := &MockSharesDatabaseMethods{ctrl: ctrl}
+	mock.recorder = &MockSharesDatabaseMethodsMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockSharesDatabaseMethods) EXPECT() *MockSharesDatabaseMethodsMockRecorder {
+	return m.recorder
+}
+
+// CreateShare mocks base method.
+func (m *MockSharesDatabaseMethods) CreateShare(arg0 models.Share) (models.Share, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "CreateShare", arg0)
+	ret0, _ := ret[0].(models.Share)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
 }
 
-// MockTransactionMethodsMockRecorder is the mock recorder for MockTransactionMethods.
-type MockTransactionMethodsMockRecorder struct {
-	mock *MockTransactionMethods
+// CreateShare indicates an expected call of CreateShare.
+func (mr *MockSharesDatabaseMethodsMockRecorder) CreateShare(arg0 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CreateShare", reflect.TypeOf((*MockSharesDatabaseMethods)(nil).CreateShare), arg0)
 }
 
-// NewMockTransactionMethods creates a new mock instance.
-func NewMockTransactionMethods(ctrl *gomock.Controller) *MockTransactionMethods {
-	mock := &MockTransactionMethods{ctrl: ctrl}
-	mock.recorder = &MockTransactionMethodsMockRecorder{mock}
-	return mock
+// DeleteShare mocks base method.
+func (m *MockSharesDatabaseMethods) DeleteShare(fileUUID string, id int) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "DeleteShare", fileUUID, id)
+	ret0, _ := ret[0].(error)
+	return ret0
 }
 
-// EXPECT returns an object that allows the caller to indicate expected use.
-func (m *MockTransactionMethods) EXPECT() *MockTransactionMethodsMockRecorder {
-	return m.recorder
+// DeleteShare indicates an expected call of DeleteShare.
+func (mr *MockSharesDatabaseMethodsMockRecorder) DeleteShare(fileUUID, id interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DeleteShare", reflect.TypeOf((*MockSharesDatabaseMethods)(nil).DeleteShare), fileUUID, id)
 }
 
-// NewSerializableTransaction mocks base method.
-func (m *MockTransactionMethods) NewSerializableTransaction(ctx context.Context) (database.Transaction, error) {
+// GetShareList mocks base method.
+func (m *MockSharesDatabaseMethods) GetShareList(fileUUID string) ([]models.Share, error) {
 	m.ctrl.T.Helper()
-	ret := m.ctrl.Call(m, "NewSerializableTransaction", ctx)
-	ret0, _ := ret[0].(database.Transaction)
+	ret := m.ctrl.Call(m, "GetShareList", fileUUID)
+	ret0, _ := ret[0].([]models.Share)
 	ret1, _ := ret[1].(error)
 	return ret0, ret1
 }
 
-// NewSerializableTransaction indicates an expected call of NewSerializableTransaction.
-func (mr *MockTransactionMethodsMockRecorder) NewSerializableTransaction(ctx interface{}) *gomock.Call {
+// GetShareList indicates an expected call of GetShareList.
+func (mr *MockSharesDatabaseMethodsMockRecorder) GetShareList(fileUUID interface{}) *gomock.Call {
 	mr.mock.ctrl.T.Helper()
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "NewSerializableTransaction", reflect.TypeOf((*MockTransactionMethods)(nil).NewSerializableTransaction), ctx)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetShareList", reflect.TypeOf((*MockSharesDatabaseMethods)(nil).GetShareList), fileUUID)
 }
 
-// NewTransaction mocks base method.
-func (m *MockTransactionMethods) NewTransaction(ctx context.Context, isolation sql.IsolationLevel) (database.Transaction, error) {
+// UseShareLink mocks base method.
+func (m *MockSharesDatabaseMethods) UseShareLink(id int) (models.Share, error) {
 	m.ctrl.T.Helper()
-	ret := m.ctrl.Call(m, "NewTransaction", ctx, isolation)
-	ret0, _ := ret[0].(database.Transaction)
+	ret := m.ctrl.Call(m, "UseShareLink", id)
+	ret0, _ := ret[0].(models.Share)
 	ret1, _ := ret[1].(error)
 	return ret0, ret1
 }
 
-// NewTransaction indicates an expected call of NewTransaction.
-func (mr *MockTransactionMethodsMockRecorder) NewTransaction(ctx, isolation interface{}) *gomock.Call {
+// UseShareLink indicates an expected call of UseShareLink.
+func (mr *MockSharesDatabaseMethodsMockRecorder) UseShareLink(id interface{}) *gomock.Call {
 	mr.mock.ctrl.T.Helper()
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "NewTransaction", reflect.TypeOf((*MockTransactionMethods)(nil).NewTransaction), ctx, isolation)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "UseShareLink", reflect.TypeOf((*MockSharesDatabaseMethods)(nil).UseShareLink), id)
 }
 
-// MockUsersDatabaseMethods is a mock of UsersDatabaseMethods interface.
-type MockUsersDatabaseMethods struct {
+// MockTagsDatabaseMethods is a mock of TagsDatabaseMethods interface.
+type MockTagsDatabaseMethods struct {
 	ctrl     *gomock.Controller
-	recorder *MockUsersDatabaseMethodsMockRecorder
+	recorder *MockTagsDatabaseMethodsMockRecorder
 }
 
-// MockUsersDatabaseMethodsMockRecorder is the mock recorder for MockUsersDatabaseMethods.
-type MockUsersDatabaseMethodsMockRecorder struct {
-	mock *MockUsersDatabaseMethods
+// MockTagsDatabaseMethodsMockRecorder is the mock recorder for MockTagsDatabaseMethods.
+type MockTagsDatabaseMethodsMockRecorder struct {
+	mock *MockTagsDatabaseMethods
 }
 
-// NewMockUsersDatabaseMethods creates a new mock instance.
-func NewMockUsersDatabaseMethods(ctrl *gomock.Controller) *MockUsersDatabaseMethods {
-	mock := &MockUsersDatabaseMethods{ctrl: ctrl}
-	mock.recorder = &MockUsersDatabaseMethodsMockRecorder{mock}
+// NewMockTagsDatabaseMethods creates a new mock instance.
+func NewMockTagsDatabaseMethods(ctrl *gomock.Controller) *MockTagsDatabaseMethods {
+	mock := &MockTagsDatabaseMethods{ctrl: ctrl}
+	mock.recorder = &MockTagsDatabaseMethodsMockRecorder{mock}
 	return mock
 }
 
 // EXPECT returns an object that allows the caller to indicate expected use.
-func (m *MockUsersDatabaseMethods) EXPECT() *MockUsersDatabaseMethodsMockRecorder {
+func (m *MockTagsDatabaseMethods) EXPECT() *MockTagsDatabaseMethodsMockRecorder {
 	return m.recorder
 }
 
-// CreateUser mocks base method.
-func (m *MockUsersDatabaseMethods) CreateUser(spec models.UserCreationParameters) (models.User, error) {
+// AddFileTags mocks base method.
+func (m *MockTagsDatabaseMethods) AddFileTags(scope database.Scope, uuid string, tags []string) ([]string, error) {
 	m.ctrl.T.Helper()
-	ret := m.ctrl.Call(m, "CreateUser", spec)
-	ret0, _ := ret[0].(models.User)
+	ret := m.ctrl.Call(m, "AddFileTags", scope, uuid, tags)
+	ret0, _ := ret[0].([]string)
 	ret1, _ := ret[1].(error)
 	return ret0, ret1
 }
 
-// CreateUser indicates an expected call of CreateUser.
-func (mr *MockUsersDatabaseMethodsMockRecorder) CreateUser(spec interface{}) *gomock.Call {
+// AddFileTags indicates an expected call of AddFileTags.
+func (mr *MockTagsDatabaseMethodsMockRecorder) AddFileTags(scope, uuid, tags interface{}) *gomock.Call {
 	mr.mock.ctrl.T.Helper()
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CreateUser", reflect.TypeOf((*MockUsersDatabaseMethods)(nil).CreateUser), spec)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "AddFileTags", reflect.TypeOf((*MockTagsDatabaseMethods)(nil).AddFileTags), scope, uuid, tags)
 }
 
-// GetUserByEmail mocks base method.
-func (m *MockUsersDatabaseMethods) GetUserByEmail(email string) (*models.UserWithPassword, error) {
+// GetTagList mocks base method.
+func (m *MockTagsDatabaseMethods) GetTagList(scope database.Scope, prefix string, limit int) ([]models.TagCount, error) {
 	m.ctrl.T.Helper()
-	ret := m.ctrl.Call(m, "GetUserByEmail", email)
-	ret0, _ := ret[0].(*models.UserWithPassword)
+	ret := m.ctrl.Call(m, "GetTagList", scope, prefix, limit)
+	ret0, _ := ret[0].([]models.TagCount)
 	ret1, _ := ret[1].(error)
 	return ret0, ret1
 }
 
-// GetUserByEmail indicates an expected call of GetUserByEmail.
-func (mr *MockUsersDatabaseMethodsMockRecorder) GetUserByEmail(email interface{}) *gomock.Call {
+// GetTagList indicates an expected call of GetTagList.
+func (mr *MockTagsDatabaseMethodsMockRecorder) GetTagList(scope, prefix, limit interface{}) *gomock.Call {
 	mr.mock.ctrl.T.Helper()
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetUserByEmail", reflect.TypeOf((*MockUsersDatabaseMethods)(nil).GetUserByEmail), email)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetTagList", reflect.TypeOf((*MockTagsDatabaseMethods)(nil).GetTagList), scope, prefix, limit)
 }
 
-// GetUserList mocks base method.
-func (m *MockUsersDatabaseMethods) GetUserList() ([]models.User, error) {
+// MergeTags mocks base method.
+func (m *MockTagsDatabaseMethods) MergeTags(name, into string) error {
 	m.ctrl.T.Helper()
-	ret := m.ctrl.Call(m, "GetUserList")
-	ret0, _ := ret[0].([]models.User)
+	ret := m.ctrl.Call(m, "MergeTags", name, into)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// MergeTags indicates an expected call of MergeTags.
+func (mr *MockTagsDatabaseMethodsMockRecorder) MergeTags(name, into interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "MergeTags", reflect.TypeOf((*MockTagsDatabaseMethods)(nil).MergeTags), name, into)
+}
+
+// RemoveFileTags mocks base method.
+func (m *MockTagsDatabaseMethods) RemoveFileTags(scope database.Scope, uuid string, tags []string) ([]string, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "RemoveFileTags", scope, uuid, tags)
+	ret0, _ := ret[0].([]string)
 	ret1, _ := ret[1].(error)
 	return ret0, ret1
 }
 
-// GetUserList indicates an expected call of GetUserList.
-func (mr *MockUsersDatabaseMethodsMockRecorder) GetUserList() *gomock.Call {
+// RemoveFileTags indicates an expected call of RemoveFileTags.
+func (mr *MockTagsDatabaseMethodsMockRecorder) RemoveFileTags(scope, uuid, tags interface{}) *gomock.Call {
 	mr.mock.ctrl.T.Helper()
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetUserList", reflect.TypeOf((*MockUsersDatabaseMethods)(nil).GetUserList))
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "RemoveFileTags", reflect.TypeOf((*MockTagsDatabaseMethods)(nil).RemoveFileTags), scope, uuid, tags)
 }
 
-// UpdateUserLastLogin mocks base method.
-func (m *MockUsersDatabaseMethods) UpdateUserLastLogin(userId int) error {
+// RenameTag mocks base method.
+func (m *MockTagsDatabaseMethods) RenameTag(name, newName string) error {
 	m.ctrl.T.Helper()
-	ret := m.ctrl.Call(m, "UpdateUserLastLogin", userId)
+	ret := m.ctrl.Call(m, "RenameTag", name, newName)
 	ret0, _ := ret[0].(error)
 	return ret0
 }
 
-// UpdateUserLastLogin indicates an expected call of UpdateUserLastLogin.
-func (mr *MockUsersDatabaseMethodsMockRecorder) UpdateUserLastLogin(userId interface{}) *gomock.Call {
+// RenameTag indicates an expected call of RenameTag.
+func (mr *MockTagsDatabaseMethodsMockRecorder) RenameTag(name, newName interface{}) *gomock.Call {
 	mr.mock.ctrl.T.Helper()
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "UpdateUserLastLogin", reflect.TypeOf((*MockUsersDatabaseMethods)(nil).UpdateUserLastLogin), userId)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "RenameTag", reflect.TypeOf((*MockTagsDatabaseMethods)(nil).RenameTag), name, newName)
 }
 
-// MockFilesDatabaseMethods is a mock of FilesDatabaseMethods interface.
-type MockFilesDatabaseMethods struct {
+// MockKeyRotationsDatabaseMethods is a mock of KeyRotationsDatabaseMethods interface.
+type MockKeyRotationsDatabaseMethods struct {
 	ctrl     *gomock.Controller
-	recorder *MockFilesDatabaseMethodsMockRecorder
+	recorder *MockKeyRotationsDatabaseMethodsMockRecorder
 }
 
-// MockFilesDatabaseMethodsMockRecorder is the mock recorder for MockFilesDatabaseMethods.
-type MockFilesDatabaseMethodsMockRecorder struct {
-	mock *MockFilesDatabaseMethods
+// MockKeyRotationsDatabaseMethodsMockRecorder is the mock recorder for MockKeyRotationsDatabaseMethods.
+type MockKeyRotationsDatabaseMethodsMockRecorder struct {
+	mock *MockKeyRotationsDatabaseMethods
 }
 
-// NewMockFilesDatabaseMethods creates a new mock instance.
-func NewMockFilesDatabaseMethods(ctrl *gomock.Controller) *MockFilesDatabaseMethods {
-	mock := &MockFilesDatabaseMethods{ctrl: ctrl}
-	mock.recorder = &MockFilesDatabaseMethodsMockRecorder{mock}
+// NewMockKeyRotationsDatabaseMethods creates a new mock instance.
+func NewMockKeyRotationsDatabaseMethods(ctrl *gomock.Controller) *MockKeyRotationsDatabaseMethods {
+	mock := &MockKeyRotationsDatabaseMethods{ctrl: ctrl}
+	mock.recorder = &MockKeyRotationsDatabaseMethodsMockRecorder{mock}
 	return mock
 }
 
 // EXPECT returns an object that allows the caller to indicate expected use.
-func (m *MockFilesDatabaseMethods) EXPECT() *MockFilesDatabaseMethodsMockRecorder {
+func (m *MockKeyRotationsDatabaseMethods) EXPECT() *MockKeyRotationsDatabaseMethodsMockRecorder {
 	return m.recorder
 }
 
-// AddFileTypeIfNotExist mocks base method.
-func (m *MockFilesDatabaseMethods) AddFileTypeIfNotExist(arg0 string) error {
-	m.ctrl.T.Helper()
-	ret := m.ctrl.Call(m, "AddFileTypeIfNotExist", arg0)
-	ret0, _ := ret[0].(error)
-	return ret0
-}
-
-// AddFileTypeIfNotExist indicates an expected call of AddFileTypeIfNotExist.
-func (mr *MockFilesDatabaseMethodsMockRecorder) AddFileTypeIfNotExist(arg0 interface{}) *gomock.Call {
-	mr.mock.ctrl.T.Helper()
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "AddFileTypeIfNotExist", reflect.TypeOf((*MockFilesDatabaseMethods)(nil).AddFileTypeIfNotExist), arg0)
-}
-
-// GetFile mocks base method.
-func (m *MockFilesDatabaseMethods) GetFile(arg0, arg1 []string) (models.File, error) {
+// CountFilesToRotate mocks base method.
+func (m *MockKeyRotationsDatabaseMethods) CountFilesToRotate(keyId string) (int, error) {
 	m.ctrl.T.Helper()
-	ret := m.ctrl.Call(m, "GetFile", arg0, arg1)
-	ret0, _ := ret[0].(models.File)
+	ret := m.ctrl.Call(m, "CountFilesToRotate", keyId)
+	ret0, _ := ret[0].(int)
 	ret1, _ := ret[1].(error)
 	return ret0, ret1
 }
 
-// GetFile indicates an expected call of GetFile.
-func (mr *MockFilesDatabaseMethodsMockRecorder) GetFile(arg0, arg1 interface{}) *gomock.Call {
+// CountFilesToRotate indicates an expected call of CountFilesToRotate.
+func (mr *MockKeyRotationsDatabaseMethodsMockRecorder) CountFilesToRotate(keyId interface{}) *gomock.Call {
 	mr.mock.ctrl.T.Helper()
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetFile", reflect.TypeOf((*MockFilesDatabaseMethods)(nil).GetFile), arg0, arg1)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CountFilesToRotate", reflect.TypeOf((*MockKeyRotationsDatabaseMethods)(nil).CountFilesToRotate), keyId)
 }
 
-// GetFileList mocks base method.
-func (m *MockFilesDatabaseMethods) GetFileList() ([]models.File, error) {
+// CreateKeyRotation mocks base method.
+func (m *MockKeyRotationsDatabaseMethods) CreateKeyRotation(arg0 models.KeyRotation) (models.KeyRotation, error) {
 	m.ctrl.T.Helper()
-	ret := m.ctrl.Call(m, "GetFileList")
-	ret0, _ := ret[0].([]models.File)
+	ret := m.ctrl.Call(m, "CreateKeyRotation", arg0)
+	ret0, _ := ret[0].(models.KeyRotation)
 	ret1, _ := ret[1].(error)
 	return ret0, ret1
 }
 
-// GetFileList indicates an expected call of GetFileList.
-func (mr *MockFilesDatabaseMethodsMockRecorder) GetFileList() *gomock.Call {
+// CreateKeyRotation indicates an expected call of CreateKeyRotation.
+func (mr *MockKeyRotationsDatabaseMethodsMockRecorder) CreateKeyRotation(arg0 interface{}) *gomock.Call {
 	mr.mock.ctrl.T.Helper()
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetFileList", reflect.TypeOf((*MockFilesDatabaseMethods)(nil).GetFileList))
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CreateKeyRotation", reflect.TypeOf((*MockKeyRotationsDatabaseMethods)(nil).CreateKeyRotation), arg0)
 }
 
-// GetFileTypes mocks base method.
-func (m *MockFilesDatabaseMethods) GetFileTypes() ([]models.FileType, error) {
+// GetFilesToRotate mocks base method.
+func (m *MockKeyRotationsDatabaseMethods) GetFilesToRotate(keyId, afterUUID string, limit int) ([]models.File, error) {
 	m.ctrl.T.Helper()
-	ret := m.ctrl.Call(m, "GetFileTypes")
-	ret0, _ := ret[0].([]models.FileType)
+	ret := m.ctrl.Call(m, "GetFilesToRotate", keyId, afterUUID, limit)
+	ret0, _ := ret[0].([]models.File)
 	ret1, _ := ret[1].(error)
 	return ret0, ret1
 }
 
-// GetFileTypes indicates an expected call of GetFileTypes.
-func (mr *MockFilesDatabaseMethodsMockRecorder) GetFileTypes() *gomock.Call {
+// GetFilesToRotate indicates an expected call of GetFilesToRotate.
+func (mr *MockKeyRotationsDatabaseMethodsMockRecorder) GetFilesToRotate(keyId, afterUUID, limit interface{}) *gomock.Call {
 	mr.mock.ctrl.T.Helper()
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetFileTypes", reflect.TypeOf((*MockFilesDatabaseMethods)(nil).GetFileTypes))
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetFilesToRotate", reflect.TypeOf((*MockKeyRotationsDatabaseMethods)(nil).GetFilesToRotate), keyId, afterUUID, limit)
 }
 
-// GetFilesSize mocks base method.
-func (m *MockFilesDatabaseMethods) GetFilesSize() (int, error) {
+// GetRunningKeyRotation mocks base method.
+func (m *MockKeyRotationsDatabaseMethods) GetRunningKeyRotation(keyId string) (*models.KeyRotation, error) {
 	m.ctrl.T.Helper()
-	ret := m.ctrl.Call(m, "GetFilesSize")
-	ret0, _ := ret[0].(int)
+	ret := m.ctrl.Call(m, "GetRunningKeyRotation", keyId)
+	ret0, _ := ret[0].(*models.KeyRotation)
 	ret1, _ := ret[1].(error)
 	return ret0, ret1
 }
 
-// GetFilesSize indicates an expected call of GetFilesSize.
-func (mr *MockFilesDatabaseMethodsMockRecorder) GetFilesSize() *gomock.Call {
+// GetRunningKeyRotation indicates an expected call of GetRunningKeyRotation.
+func (mr *MockKeyRotationsDatabaseMethodsMockRecorder) GetRunningKeyRotation(keyId interface{}) *gomock.Call {
 	mr.mock.ctrl.T.Helper()
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetFilesSize", reflect.TypeOf((*MockFilesDatabaseMethods)(nil).GetFilesSize))
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetRunningKeyRotation", reflect.TypeOf((*MockKeyRotationsDatabaseMethods)(nil).GetRunningKeyRotation), keyId)
 }
 
-// SaveFile mocks base method.
-func (m *MockFilesDatabaseMethods) SaveFile(arg0 models.File) error {
+// UpdateKeyRotation mocks base method.
+func (m *MockKeyRotationsDatabaseMethods) UpdateKeyRotation(arg0 models.KeyRotation) error {
 	m.ctrl.T.Helper()
-	ret := m.ctrl.Call(m, "SaveFile", arg0)
+	ret := m.ctrl.Call(m, "UpdateKeyRotation", arg0)
 	ret0, _ := ret[0].(error)
 	return ret0
 }
 
-// SaveFile indicates an expected call of SaveFile.
-func (mr *MockFilesDatabaseMethodsMockRecorder) SaveFile(arg0 interface{}) *gomock.Call {
+// UpdateKeyRotation indicates an expected call of UpdateKeyRotation.
+func (mr *MockKeyRotationsDatabaseMethodsMockRecorder) UpdateKeyRotation(arg0 interface{}) *gomock.Call {
 	mr.mock.ctrl.T.Helper()
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "SaveFile", reflect.TypeOf((*MockFilesDatabaseMethods)(nil).SaveFile), arg0)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "UpdateKeyRotation", reflect.TypeOf((*MockKeyRotationsDatabaseMethods)(nil).UpdateKeyRotation), arg0)
 }
 
 // MockTransaction is a mock of Transaction interface.
@@ -511,6 +1699,21 @@ func (mr *MockTransactionMockRecorder) Commit() *gomock.Call {
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Commit", reflect.TypeOf((*MockTransaction)(nil).Commit))
 }
 
+// CountFilesByChecksum mocks base method.
+func (m *MockTransaction) CountFilesByChecksum(sha256 string) (int, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "CountFilesByChecksum", sha256)
+	ret0, _ := ret[0].(int)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// CountFilesByChecksum indicates an expected call of CountFilesByChecksum.
+func (mr *MockTransactionMockRecorder) CountFilesByChecksum(sha256 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CountFilesByChecksum", reflect.TypeOf((*MockTransaction)(nil).CountFilesByChecksum), sha256)
+}
+
 // CreateUser mocks base method.
 func (m *MockTransaction) CreateUser(spec models.UserCreationParameters) (models.User, error) {
 	m.ctrl.T.Helper()
@@ -526,34 +1729,94 @@ func (mr *MockTransactionMockRecorder) CreateUser(spec interface{}) *gomock.Call
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CreateUser", reflect.TypeOf((*MockTransaction)(nil).CreateUser), spec)
 }
 
-// GetFile mocks base method.
-func (m *MockTransaction) GetFile(arg0, arg1 []string) (models.File, error) {
+// DeleteFile mocks base method.
+func (m *MockTransaction) DeleteFile(scope database.Scope, uuid string) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "DeleteFile", scope, uuid)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// DeleteFile indicates an expected call of DeleteFile.
+func (mr *MockTransactionMockRecorder) DeleteFile(scope, uuid interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DeleteFile", reflect.TypeOf((*MockTransaction)(nil).DeleteFile), scope, uuid)
+}
+
+// FindFiles mocks base method.
+func (m *MockTransaction) FindFiles(scope database.Scope, names, tags []string) ([]models.File, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "FindFiles", scope, names, tags)
+	ret0, _ := ret[0].([]models.File)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// FindFiles indicates an expected call of FindFiles.
+func (mr *MockTransactionMockRecorder) FindFiles(scope, names, tags interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "FindFiles", reflect.TypeOf((*MockTransaction)(nil).FindFiles), scope, names, tags)
+}
+
+// FindSimilarFile mocks base method.
+func (m *MockTransaction) FindSimilarFile(userId int, phash uint64, maxDistance int) (*models.File, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "FindSimilarFile", userId, phash, maxDistance)
+	ret0, _ := ret[0].(*models.File)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// FindSimilarFile indicates an expected call of FindSimilarFile.
+func (mr *MockTransactionMockRecorder) FindSimilarFile(userId, phash, maxDistance interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "FindSimilarFile", reflect.TypeOf((*MockTransaction)(nil).FindSimilarFile), userId, phash, maxDistance)
+}
+
+// GetFileByChecksum mocks base method.
+func (m *MockTransaction) GetFileByChecksum(sha256 string) (*models.File, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetFileByChecksum", sha256)
+	ret0, _ := ret[0].(*models.File)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetFileByChecksum indicates an expected call of GetFileByChecksum.
+func (mr *MockTransactionMockRecorder) GetFileByChecksum(sha256 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetFileByChecksum", reflect.TypeOf((*MockTransaction)(nil).GetFileByChecksum), sha256)
+}
+
+// GetFileByUUID mocks base method.
+func (m *MockTransaction) GetFileByUUID(scope database.Scope, uuid string) (models.File, error) {
 	m.ctrl.T.Helper()
-	ret := m.ctrl.Call(m, "GetFile", arg0, arg1)
+	ret := m.ctrl.Call(m, "GetFileByUUID", scope, uuid)
 	ret0, _ := ret[0].(models.File)
 	ret1, _ := ret[1].(error)
 	return ret0, ret1
 }
 
-// GetFile indicates an expected call of GetFile.
-func (mr *MockTransactionMockRecorder) GetFile(arg0, arg1 interface{}) *gomock.Call {
+// GetFileByUUID indicates an expected call of GetFileByUUID.
+func (mr *MockTransactionMockRecorder) GetFileByUUID(scope, uuid interface{}) *gomock.Call {
 	mr.mock.ctrl.T.Helper()
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetFile", reflect.TypeOf((*MockTransaction)(nil).GetFile), arg0, arg1)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetFileByUUID", reflect.TypeOf((*MockTransaction)(nil).GetFileByUUID), scope, uuid)
 }
 
 // GetFileList mocks base method.
-func (m *MockTransaction) GetFileList() ([]models.File, error) {
+func (m *MockTransaction) GetFileList(scope database.Scope, options database.ListOptions) ([]models.File, string, error) {
 	m.ctrl.T.Helper()
-	ret := m.ctrl.Call(m, "GetFileList")
+	ret := m.ctrl.Call(m, "GetFileList", scope, options)
 	ret0, _ := ret[0].([]models.File)
-	ret1, _ := ret[1].(error)
-	return ret0, ret1
+	ret1, _ := ret[1].(string)
+	ret2, _ := ret[2].(error)
+	return ret0, ret1, ret2
 }
 
 // GetFileList indicates an expected call of GetFileList.
-func (mr *MockTransactionMockRecorder) GetFileList() *gomock.Call {
+func (mr *MockTransactionMockRecorder) GetFileList(scope, options interface{}) *gomock.Call {
 	mr.mock.ctrl.T.Helper()
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetFileList", reflect.TypeOf((*MockTransaction)(nil).GetFileList))
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetFileList", reflect.TypeOf((*MockTransaction)(nil).GetFileList), scope, options)
 }
 
 // GetFileTypes mocks base method.
@@ -586,6 +1849,51 @@ func (mr *MockTransactionMockRecorder) GetFilesSize() *gomock.Call {
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetFilesSize", reflect.TypeOf((*MockTransaction)(nil).GetFilesSize))
 }
 
+// GetFilesToMigrate mocks base method.
+func (m *MockTransaction) GetFilesToMigrate(afterUUID string, limit int) ([]models.File, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetFilesToMigrate", afterUUID, limit)
+	ret0, _ := ret[0].([]models.File)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetFilesToMigrate indicates an expected call of GetFilesToMigrate.
+func (mr *MockTransactionMockRecorder) GetFilesToMigrate(afterUUID, limit interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetFilesToMigrate", reflect.TypeOf((*MockTransaction)(nil).GetFilesToMigrate), afterUUID, limit)
+}
+
+// GetFilesToPurge mocks base method.
+func (m *MockTransaction) GetFilesToPurge(trashedBefore time.Time, limit int) ([]models.File, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetFilesToPurge", trashedBefore, limit)
+	ret0, _ := ret[0].([]models.File)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetFilesToPurge indicates an expected call of GetFilesToPurge.
+func (mr *MockTransactionMockRecorder) GetFilesToPurge(trashedBefore, limit interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetFilesToPurge", reflect.TypeOf((*MockTransaction)(nil).GetFilesToPurge), trashedBefore, limit)
+}
+
+// GetTrashList mocks base method.
+func (m *MockTransaction) GetTrashList(scope database.Scope) ([]models.File, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetTrashList", scope)
+	ret0, _ := ret[0].([]models.File)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetTrashList indicates an expected call of GetTrashList.
+func (mr *MockTransactionMockRecorder) GetTrashList(scope interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetTrashList", reflect.TypeOf((*MockTransaction)(nil).GetTrashList), scope)
+}
+
 // GetUserByEmail mocks base method.
 func (m *MockTransaction) GetUserByEmail(email string) (*models.UserWithPassword, error) {
 	m.ctrl.T.Helper()
@@ -602,18 +1910,64 @@ func (mr *MockTransactionMockRecorder) GetUserByEmail(email interface{}) *gomock
 }
 
 // GetUserList mocks base method.
-func (m *MockTransaction) GetUserList() ([]models.User, error) {
+func (m *MockTransaction) GetUserList(options database.ListOptions) ([]models.User, string, error) {
 	m.ctrl.T.Helper()
-	ret := m.ctrl.Call(m, "GetUserList")
+	ret := m.ctrl.Call(m, "GetUserList", options)
 	ret0, _ := ret[0].([]models.User)
+	ret1, _ := ret[1].(string)
+	ret2, _ := ret[2].(error)
+	return ret0, ret1, ret2
+}
+
+// GetUserList indicates an expected call of GetUserList.
+func (mr *MockTransactionMockRecorder) GetUserList(options interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetUserList", reflect.TypeOf((*MockTransaction)(nil).GetUserList), options)
+}
+
+// LockChecksum mocks base method.
+func (m *MockTransaction) LockChecksum(sha256 string) (func(), error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "LockChecksum", sha256)
+	ret0, _ := ret[0].(func())
 	ret1, _ := ret[1].(error)
 	return ret0, ret1
 }
 
-// GetUserList indicates an expected call of GetUserList.
-func (mr *MockTransactionMockRecorder) GetUserList() *gomock.Call {
+// LockChecksum indicates an expected call of LockChecksum.
+func (mr *MockTransactionMockRecorder) LockChecksum(sha256 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "LockChecksum", reflect.TypeOf((*MockTransaction)(nil).LockChecksum), sha256)
+}
+
+// QueryFiles mocks base method.
+func (m *MockTransaction) QueryFiles(scope database.Scope, expr filequery.Expr, limit, offset int) ([]models.File, int, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "QueryFiles", scope, expr, limit, offset)
+	ret0, _ := ret[0].([]models.File)
+	ret1, _ := ret[1].(int)
+	ret2, _ := ret[2].(error)
+	return ret0, ret1, ret2
+}
+
+// QueryFiles indicates an expected call of QueryFiles.
+func (mr *MockTransactionMockRecorder) QueryFiles(scope, expr, limit, offset interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "QueryFiles", reflect.TypeOf((*MockTransaction)(nil).QueryFiles), scope, expr, limit, offset)
+}
+
+// RestoreFile mocks base method.
+func (m *MockTransaction) RestoreFile(scope database.Scope, uuid string) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "RestoreFile", scope, uuid)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// RestoreFile indicates an expected call of RestoreFile.
+func (mr *MockTransactionMockRecorder) RestoreFile(scope, uuid interface{}) *gomock.Call {
 	mr.mock.ctrl.T.Helper()
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetUserList", reflect.TypeOf((*MockTransaction)(nil).GetUserList))
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "RestoreFile", reflect.TypeOf((*MockTransaction)(nil).RestoreFile), scope, uuid)
 }
 
 // Rollback mocks base method.
@@ -644,6 +1998,78 @@ func (mr *MockTransactionMockRecorder) SaveFile(arg0 interface{}) *gomock.Call {
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "SaveFile", reflect.TypeOf((*MockTransaction)(nil).SaveFile), arg0)
 }
 
+// SearchFilesText mocks base method.
+func (m *MockTransaction) SearchFilesText(scope database.Scope, text string, limit, offset int) ([]models.TextMatch, int, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "SearchFilesText", scope, text, limit, offset)
+	ret0, _ := ret[0].([]models.TextMatch)
+	ret1, _ := ret[1].(int)
+	ret2, _ := ret[2].(error)
+	return ret0, ret1, ret2
+}
+
+// SearchFilesText indicates an expected call of SearchFilesText.
+func (mr *MockTransactionMockRecorder) SearchFilesText(scope, text, limit, offset interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "SearchFilesText", reflect.TypeOf((*MockTransaction)(nil).SearchFilesText), scope, text, limit, offset)
+}
+
+// TrashFile mocks base method.
+func (m *MockTransaction) TrashFile(scope database.Scope, uuid string) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "TrashFile", scope, uuid)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// TrashFile indicates an expected call of TrashFile.
+func (mr *MockTransactionMockRecorder) TrashFile(scope, uuid interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "TrashFile", reflect.TypeOf((*MockTransaction)(nil).TrashFile), scope, uuid)
+}
+
+// UpdateFileBlob mocks base method.
+func (m *MockTransaction) UpdateFileBlob(uuid, sha256, keyId string, wrappedKey []byte) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "UpdateFileBlob", uuid, sha256, keyId, wrappedKey)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// UpdateFileBlob indicates an expected call of UpdateFileBlob.
+func (mr *MockTransactionMockRecorder) UpdateFileBlob(uuid, sha256, keyId, wrappedKey interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "UpdateFileBlob", reflect.TypeOf((*MockTransaction)(nil).UpdateFileBlob), uuid, sha256, keyId, wrappedKey)
+}
+
+// UpdateFileKey mocks base method.
+func (m *MockTransaction) UpdateFileKey(uuid, keyId string, wrappedKey []byte) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "UpdateFileKey", uuid, keyId, wrappedKey)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// UpdateFileKey indicates an expected call of UpdateFileKey.
+func (mr *MockTransactionMockRecorder) UpdateFileKey(uuid, keyId, wrappedKey interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "UpdateFileKey", reflect.TypeOf((*MockTransaction)(nil).UpdateFileKey), uuid, keyId, wrappedKey)
+}
+
+// UpdateFileType mocks base method.
+func (m *MockTransaction) UpdateFileType(uuid, typeId string) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "UpdateFileType", uuid, typeId)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// UpdateFileType indicates an expected call of UpdateFileType.
+func (mr *MockTransactionMockRecorder) UpdateFileType(uuid, typeId interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "UpdateFileType", reflect.TypeOf((*MockTransaction)(nil).UpdateFileType), uuid, typeId)
+}
+
 // UpdateUserLastLogin mocks base method.
 func (m *MockTransaction) UpdateUserLastLogin(userId int) error {
 	m.ctrl.T.Helper()
@@ -3,20 +3,30 @@ package postgres
 import (
 	"context"
 	"database/sql"
+	"database/sql/driver"
 	"fmt"
+	"strings"
 	"time"
 
 	"entgo.io/ent/dialect"
 	entsql "entgo.io/ent/dialect/sql"
+	entschema "entgo.io/ent/dialect/sql/schema"
 	_ "github.com/jackc/pgx/v5/stdlib"
 	"github.com/lebleuciel/maani/models"
 	"github.com/lebleuciel/maani/pkg/database"
 	"github.com/lebleuciel/maani/pkg/database/ent"
 	"github.com/lebleuciel/maani/pkg/database/ent/file"
 	"github.com/lebleuciel/maani/pkg/database/ent/filetype"
+	"github.com/lebleuciel/maani/pkg/database/ent/keyrotation"
 	"github.com/lebleuciel/maani/pkg/database/ent/migrate"
+	"github.com/lebleuciel/maani/pkg/database/ent/predicate"
+	_ "github.com/lebleuciel/maani/pkg/database/ent/runtime"
+	"github.com/lebleuciel/maani/pkg/database/ent/schema"
+	"github.com/lebleuciel/maani/pkg/database/ent/searchjob"
+	"github.com/lebleuciel/maani/pkg/database/ent/share"
 	"github.com/lebleuciel/maani/pkg/database/ent/tag"
 	"github.com/lebleuciel/maani/pkg/database/ent/user"
+	"github.com/lebleuciel/maani/pkg/filequery"
 	"github.com/pkg/errors"
 )
 
@@ -36,10 +46,38 @@ func (p PostgresDatabase) Migrate() error {
 	err := p.client.Schema.Create(
 		p.getCtx(),
 		migrate.WithGlobalUniqueID(true),
+		entschema.WithApplyHook(searchVectorHook),
 	)
 	if err != nil {
 		return errors.Wrap(err, "Could not migrate schema to db")
 	}
+
+	// Files stored before soft delete had deleted_at set when they were created, although none was ever trashed.
+	// It is cleared once, while no file has an empty deleted_at, for files trashed right when they were created.
+	_, err = p.db.ExecContext(p.getCtx(), `UPDATE files SET deleted_at = NULL
+		WHERE deleted_at < created_at + interval '1 second'
+		AND NOT EXISTS (SELECT 1 FROM files WHERE deleted_at IS NULL)`)
+	if err != nil {
+		return errors.Wrap(err, "Could not clear deleted_at of files stored before soft delete")
+	}
+
+	// Tags stored before they were normalized are merged into their lowercase tag
+	for _, query := range []string{
+		`INSERT INTO tags (name, created_at, updated_at) SELECT DISTINCT lower(name), now(), now() FROM tags WHERE name <> lower(name) ON CONFLICT (name) DO NOTHING`,
+		`INSERT INTO file_tags (file_id, tag_id) SELECT file_id, lower(tag_id) FROM file_tags WHERE tag_id <> lower(tag_id) ON CONFLICT DO NOTHING`,
+		`DELETE FROM tags WHERE name <> lower(name)`,
+	} {
+		_, err = p.db.ExecContext(p.getCtx(), query)
+		if err != nil {
+			return errors.Wrap(err, "Could not lowercase tags")
+		}
+	}
+
+	// Files stored before full-text search have tags without tag names, which are filled once
+	err = refreshTagNames(p.getCtx(), p.client, file.TagNamesEQ(""), file.HasTags())
+	if err != nil {
+		return errors.Wrap(err, "Could not fill tag names of files")
+	}
 	return nil
 }
 
@@ -187,28 +225,6 @@ func (p *PostgresDatabase) UpdateUserLastLogin(userId int) error {
 	return err
 }
 
-func (p *PostgresDatabase) GetUserList() ([]models.User, error) {
-	users, err := p.client.User.Query().All(p.getCtx())
-	if err != nil {
-		return nil, err
-	}
-
-	var result []models.User
-	for _, user := range users {
-		result = append(result, models.User{
-			Id:          user.ID,
-			FirstName:   user.FirstName,
-			LastName:    user.LastName,
-			Email:       *user.Email,
-			AccessType:  string(user.AccessType),
-			CreatedAt:   *user.CreatedAt,
-			UpdatedAt:   user.UpdatedAt,
-			LastLoginAt: user.LastLoginAt,
-		})
-	}
-	return result, nil
-}
-
 func (p *PostgresDatabase) AddFileTypeIfNotExist(id string) error {
 	filetype := p.client.Filetype.Query().Where(filetype.IDEQ(id)).FirstX(p.getCtx())
 	if filetype == nil {
@@ -240,7 +256,8 @@ func (p *PostgresDatabase) GetFilesSize() (int, error) {
 	var sum []struct {
 		Sum int
 	}
-	err := p.client.File.Query().Aggregate(ent.Sum(file.FieldSize)).Scan(p.getCtx(), &sum)
+	// Trashed files keep using storage until they are purged
+	err := p.client.File.Query().Aggregate(ent.Sum(file.FieldSize)).Scan(schema.WithTrashed(p.getCtx()), &sum)
 	if len(sum) == 0 {
 		return 0, err
 	}
@@ -248,35 +265,34 @@ func (p *PostgresDatabase) GetFilesSize() (int, error) {
 }
 
 func (p *PostgresDatabase) SaveFile(file models.File) error {
-	currentTagsMap := make(map[string]struct{})
-
-	currentTags, err := p.client.Tag.Query().All(p.getCtx())
-	if err != nil {
-		return errors.Wrap(err, "could not get tag on saving file")
-	}
-
-	for _, t := range currentTags {
-		currentTagsMap[t.ID] = struct{}{}
-	}
-
-	shouldBeAddTagsObject := make([]*ent.TagCreate, 0)
-	for _, t := range file.Tags {
-		if _, ok := currentTagsMap[t]; !ok {
-			shouldBeAddTagsObject = append(shouldBeAddTagsObject, p.client.Tag.Create().SetID(t))
-		}
-	}
-
-	err = p.client.Tag.CreateBulk(shouldBeAddTagsObject...).Exec(p.getCtx())
+	err := p.ensureTags(p.getCtx(), file.Tags)
 	if err != nil {
 		return errors.Wrap(err, "could not add tag on saving file")
 	}
 
-	_, err = p.client.File.Create().
+	create := p.client.File.Create().
 		SetName(file.Name).
 		SetUUID(file.UUID).
 		SetUserID(file.UserId).
 		SetFiletypeID(file.TypeId).
 		SetSize(file.Size).
+		SetBurnAfterRead(file.BurnAfterRead).
+		SetKeyID(file.KeyId).
+		SetWrappedKey(file.WrappedKey).
+		SetSha256(file.SHA256).
+		SetWidth(file.Width).
+		SetHeight(file.Height).
+		SetDominantColor(file.DominantColor).
+		SetSourceURL(file.SourceURL).
+		SetSearchQuery(file.SearchQuery).
+		SetTagNames(strings.Join(file.Tags, " ")).
+		SetCameraMake(file.CameraMake).
+		SetCameraModel(file.CameraModel).
+		SetNillableTakenAt(file.TakenAt)
+	if file.PHash != nil {
+		create = create.SetPhash(int64(*file.PHash))
+	}
+	_, err = create.
 		SetCreatedAt(time.Now()).
 		SetUpdatedAt(time.Now()).
 		AddTagIDs(file.Tags...).
@@ -285,53 +301,836 @@ func (p *PostgresDatabase) SaveFile(file models.File) error {
 	return err
 }
 
-func (p *PostgresDatabase) GetFile(name []string, tags []string) (models.File, error) {
-	tagQuery := p.client.Tag.Query()
+func (p *PostgresDatabase) FindFiles(scope database.Scope, names []string, tags []string) ([]models.File, error) {
+	query := p.client.File.Query().Where(inScope(scope))
+	if len(names) > 0 {
+		query = query.Where(file.NameIn(names...))
+	}
 	if len(tags) > 0 {
-		tagQuery = tagQuery.Where(tag.IDIn(tags...))
+		query = query.Where(file.HasTagsWith(tag.IDIn(tags...)))
 	}
-	fileQuery := tagQuery.QueryFiles()
-	if len(name) > 0 {
-		fileQuery = fileQuery.Where(file.NameIn(name...))
+	files, err := query.WithTags().Order(ent.Asc(file.FieldID)).All(p.getCtx())
+	if err != nil {
+		return nil, err
 	}
 
-	f := fileQuery.FirstX(p.getCtx())
-	if f == nil {
-		f = p.client.File.Query().Order(file.ByCreatedAt()).FirstX(p.getCtx())
+	result := make([]models.File, 0, len(files))
+	for _, f := range files {
+		result = append(result, toFileModel(f))
 	}
-	if f == nil {
-		return models.File{}, fmt.Errorf("file not found")
+	return result, nil
+}
+
+func (p *PostgresDatabase) QueryFiles(scope database.Scope, expr filequery.Expr, limit int, offset int) ([]models.File, int, error) {
+	matches, err := compileQuery(expr)
+	if err != nil {
+		return nil, 0, err
+	}
+	query := p.client.File.Query().Where(inScope(scope), matches)
+	total, err := query.Clone().Count(p.getCtx())
+	if err != nil {
+		return nil, 0, err
+	}
+	files, err := query.
+		WithTags().
+		Order(ent.Desc(file.FieldCreatedAt), ent.Desc(file.FieldID)).
+		Limit(limit).
+		Offset(offset).
+		All(p.getCtx())
+	if err != nil {
+		return nil, 0, err
 	}
 
-	err := p.client.File.DeleteOneID(f.ID).Exec(p.getCtx())
+	result := make([]models.File, 0, len(files))
+	for _, f := range files {
+		result = append(result, toFileModel(f))
+	}
+	return result, total, nil
+}
+
+func (p *PostgresDatabase) GetFileByUUID(scope database.Scope, uuid string) (models.File, error) {
+	f, err := p.client.File.Query().Where(file.UUIDEQ(uuid), inScope(scope)).WithTags().Only(p.getCtx())
 	if err != nil {
+		var e *ent.NotFoundError
+		if errors.As(err, &e) {
+			return models.File{}, database.ErrFileNotFound
+		}
 		return models.File{}, err
 	}
+	return toFileModel(f), nil
+}
+
+func (p *PostgresDatabase) DeleteFile(scope database.Scope, uuid string) error {
+	deleted, err := p.client.File.Delete().Where(file.UUIDEQ(uuid), inScope(scope)).Exec(p.getCtx())
+	if err != nil {
+		return err
+	}
+	if deleted == 0 {
+		return database.ErrFileNotFound
+	}
+	return nil
+}
+
+// TrashFile moves file to trash, trashed files are left out of queries until they are restored
+func (p *PostgresDatabase) TrashFile(scope database.Scope, uuid string) error {
+	trashed, err := p.client.File.Update().
+		Where(file.UUIDEQ(uuid), file.DeletedAtIsNil(), inScope(scope)).
+		SetDeletedAt(time.Now()).
+		Save(p.getCtx())
+	if err != nil {
+		return err
+	}
+	if trashed == 0 {
+		return database.ErrFileNotFound
+	}
+	return nil
+}
+
+func (p *PostgresDatabase) RestoreFile(scope database.Scope, uuid string) error {
+	restored, err := p.client.File.Update().
+		Where(file.UUIDEQ(uuid), file.DeletedAtNotNil(), inScope(scope)).
+		ClearDeletedAt().
+		Save(p.getCtx())
+	if err != nil {
+		return err
+	}
+	if restored == 0 {
+		return database.ErrFileNotFound
+	}
+	return nil
+}
+
+func (p *PostgresDatabase) GetTrashList(scope database.Scope) ([]models.File, error) {
+	files, err := p.client.File.Query().
+		Where(file.DeletedAtNotNil(), inScope(scope)).
+		WithTags().
+		Order(ent.Desc(file.FieldDeletedAt), ent.Asc(file.FieldID)).
+		All(schema.WithTrashed(p.getCtx()))
+	if err != nil {
+		return nil, err
+	}
+
+	result := make([]models.File, 0, len(files))
+	for _, f := range files {
+		result = append(result, toFileModel(f))
+	}
+	return result, nil
+}
+
+func (p *PostgresDatabase) GetFilesToPurge(trashedBefore time.Time, limit int) ([]models.File, error) {
+	files, err := p.client.File.Query().
+		Where(file.DeletedAtLT(trashedBefore)).
+		Order(ent.Asc(file.FieldID)).
+		Limit(limit).
+		All(schema.WithTrashed(p.getCtx()))
+	if err != nil {
+		return nil, err
+	}
+
+	result := make([]models.File, 0, len(files))
+	for _, f := range files {
+		result = append(result, toFileModel(f))
+	}
+	return result, nil
+}
+
+// inScope matches files in scope, which are files of its user and files shared with user unless it has every file
+func inScope(scope database.Scope) predicate.File {
+	if scope.All {
+		return func(*entsql.Selector) {}
+	}
+	permissions := []share.Permission{share.PermissionWrite}
+	if !scope.Write {
+		permissions = append(permissions, share.PermissionRead)
+	}
+	return file.Or(
+		file.UserIDEQ(scope.UserId),
+		file.HasSharesWith(
+			share.UserIDEQ(scope.UserId),
+			share.PermissionIn(permissions...),
+			share.Or(share.ExpiresAtIsNil(), share.ExpiresAtGT(time.Now())),
+		),
+	)
+}
+
+// FindSimilarFile returns a file of user whose perceptual hash differs in at most maxDistance bits, other than burn after
+// read files, nil when there is none
+func (p *PostgresDatabase) FindSimilarFile(userId int, phash uint64, maxDistance int) (*models.File, error) {
+	f, err := p.client.File.Query().
+		Where(
+			file.UserIDEQ(userId),
+			file.BurnAfterRead(false),
+			file.PhashNotNil(),
+			func(s *entsql.Selector) {
+				s.Where(entsql.P(func(b *entsql.Builder) {
+					b.WriteString("bit_count((").Ident(s.C(file.FieldPhash)).WriteString(" # ").Arg(int64(phash)).WriteString(")::bit(64)) <= ").Arg(maxDistance)
+				}))
+			},
+		).
+		Order(ent.Asc(file.FieldID)).
+		First(p.getCtx())
+	if err != nil {
+		var e *ent.NotFoundError
+		if errors.As(err, &e) {
+			return nil, nil
+		}
+		return nil, err
+	}
+
+	similar := toFileModel(f)
+	return &similar, nil
+}
+
+func (p *PostgresDatabase) UpdateFileKey(uuid string, keyId string, wrappedKey []byte) error {
+	_, err := p.client.File.Update().
+		Where(file.UUIDEQ(uuid)).
+		SetKeyID(keyId).
+		SetWrappedKey(wrappedKey).
+		Save(p.getCtx())
+	return err
+}
+
+// GetFileByChecksum returns the oldest file whose content has checksum sha256, nil when there is none
+func (p *PostgresDatabase) GetFileByChecksum(sha256 string) (*models.File, error) {
+	f, err := p.client.File.Query().
+		Where(file.Sha256EQ(sha256)).
+		Order(ent.Asc(file.FieldID)).
+		First(schema.WithTrashed(p.getCtx()))
+	if err != nil {
+		var e *ent.NotFoundError
+		if errors.As(err, &e) {
+			return nil, nil
+		}
+		return nil, err
+	}
+
+	existing := toFileModel(f)
+	return &existing, nil
+}
+
+func (p *PostgresDatabase) CountFilesByChecksum(sha256 string) (int, error) {
+	// Trashed files keep their blob until they are purged
+	return p.client.File.Query().Where(file.Sha256EQ(sha256)).Count(schema.WithTrashed(p.getCtx()))
+}
+
+// blobLockPrefix keeps advisory locks of blobs apart from other advisory locks
+const blobLockPrefix = "blob:"
 
-	return models.File{
-		Name:   f.Name,
-		UUID:   f.UUID,
-		Size:   f.Size,
-		TypeId: f.Type,
-		UserId: f.UserID,
+// LockChecksum takes a session advisory lock on checksum, on a connection which is kept until the lock is released
+func (p *PostgresDatabase) LockChecksum(sha256 string) (func(), error) {
+	conn, err := p.db.Conn(p.baseCtx)
+	if err != nil {
+		return nil, err
+	}
+	_, err = conn.ExecContext(p.baseCtx, "SELECT pg_advisory_lock(hashtextextended($1, 0))", blobLockPrefix+sha256)
+	if err != nil {
+		conn.Close()
+		return nil, errors.Wrap(err, "Could not lock blob")
+	}
+	return func() {
+		_, err := conn.ExecContext(context.Background(), "SELECT pg_advisory_unlock(hashtextextended($1, 0))", blobLockPrefix+sha256)
+		if err != nil {
+			// A connection still holding the lock must not go back to the pool, closing it releases the lock
+			conn.Raw(func(any) error { return driver.ErrBadConn })
+		}
+		conn.Close()
 	}, nil
 }
 
-func (p *PostgresDatabase) GetFileList() ([]models.File, error) {
-	files, err := p.client.File.Query().All(p.getCtx())
+func (p *PostgresDatabase) UpdateFileBlob(uuid string, sha256 string, keyId string, wrappedKey []byte) error {
+	_, err := p.client.File.Update().
+		Where(file.UUIDEQ(uuid)).
+		SetSha256(sha256).
+		SetKeyID(keyId).
+		SetWrappedKey(wrappedKey).
+		Save(p.getCtx())
+	return err
+}
+
+func (p *PostgresDatabase) UpdateFileType(uuid string, typeId string) error {
+	_, err := p.client.File.Update().
+		Where(file.UUIDEQ(uuid)).
+		SetType(typeId).
+		Save(p.getCtx())
+	return err
+}
+
+func (p *PostgresDatabase) GetFilesToMigrate(afterUUID string, limit int) ([]models.File, error) {
+	files, err := p.client.File.Query().
+		Where(file.Sha256EQ(""), file.UUIDGT(afterUUID)).
+		Order(ent.Asc(file.FieldUUID)).
+		Limit(limit).
+		All(schema.WithTrashed(p.getCtx()))
 	if err != nil {
 		return nil, err
 	}
 
-	var result []models.File
-	for _, file := range files {
-		result = append(result, models.File{
-			Name:   file.Name,
-			UUID:   file.UUID,
-			Size:   file.Size,
-			TypeId: file.Type,
-			UserId: file.UserID,
-		})
+	result := make([]models.File, 0, len(files))
+	for _, f := range files {
+		result = append(result, toFileModel(f))
 	}
 	return result, nil
 }
+
+func toFileModel(f *ent.File) models.File {
+	result := models.File{
+		Name:          f.Name,
+		UUID:          f.UUID,
+		Size:          f.Size,
+		TypeId:        f.Type,
+		UserId:        f.UserID,
+		BurnAfterRead: f.BurnAfterRead,
+		KeyId:         f.KeyID,
+		WrappedKey:    f.WrappedKey,
+		SHA256:        f.Sha256,
+		Width:         f.Width,
+		Height:        f.Height,
+		CameraMake:    f.CameraMake,
+		CameraModel:   f.CameraModel,
+		TakenAt:       f.TakenAt,
+		DominantColor: f.DominantColor,
+		SourceURL:     f.SourceURL,
+		SearchQuery:   f.SearchQuery,
+		CreatedAt:     f.CreatedAt,
+		DeletedAt:     f.DeletedAt,
+	}
+	// Tags are only known when they are queried with the file
+	for _, t := range f.Edges.Tags {
+		result.Tags = append(result.Tags, t.ID)
+	}
+	if f.Phash != nil {
+		phash := uint64(*f.Phash)
+		result.PHash = &phash
+	}
+	return result
+}
+
+func (p *PostgresDatabase) CreateSearchJob(job models.SearchJob) (models.SearchJob, error) {
+	j, err := p.client.SearchJob.Create().
+		SetQuery(job.Query).
+		SetMaxResults(job.MaxResults).
+		SetUserID(job.UserId).
+		SetStatus(searchjob.StatusPending).
+		SetCreatedAt(time.Now()).
+		SetUpdatedAt(time.Now()).
+		Save(p.getCtx())
+	if err != nil {
+		return models.SearchJob{}, errors.Wrap(err, "Could not create search job")
+	}
+	return toSearchJobModel(j), nil
+}
+
+func (p *PostgresDatabase) UpdateSearchJob(job models.SearchJob) error {
+	updated, err := p.client.SearchJob.Update().
+		Where(searchjob.ID(job.Id), searchjob.Runner(job.Runner)).
+		SetStatus(searchjob.Status(job.Status)).
+		SetSaved(job.Saved).
+		SetFailed(job.Failed).
+		SetFileNames(job.FileNames).
+		SetDeduplicated(job.Deduplicated).
+		SetDuplicateFileNames(job.DuplicateFileNames).
+		SetDuplicateOf(job.DuplicateOf).
+		SetCandidateUrls(job.CandidateURLs).
+		SetCandidateThumbnailUrls(job.CandidateThumbnailURLs).
+		SetNextCandidate(job.NextCandidate).
+		SetError(job.Error).
+		SetUpdatedAt(time.Now()).
+		SetHeartbeatAt(time.Now()).
+		SetNillableStartedAt(job.StartedAt).
+		SetNillableFinishedAt(job.FinishedAt).
+		Save(p.getCtx())
+	if err != nil {
+		return err
+	}
+	if updated == 0 {
+		return database.ErrSearchJobClaimed
+	}
+	return nil
+}
+
+// ClaimSearchJob claims a job in a single update, so of store processes claiming a job at once only one gets it
+func (p *PostgresDatabase) ClaimSearchJob(id int, runner string, staleBefore time.Time) (models.SearchJob, error) {
+	claimed, err := p.client.SearchJob.Update().
+		Where(
+			searchjob.ID(id),
+			searchjob.Or(
+				searchjob.StatusEQ(searchjob.StatusPending),
+				searchjob.And(
+					searchjob.StatusEQ(searchjob.StatusRunning),
+					searchjob.Or(searchjob.HeartbeatAtIsNil(), searchjob.HeartbeatAtLT(staleBefore)),
+				),
+			),
+		).
+		SetStatus(searchjob.StatusRunning).
+		SetRunner(runner).
+		SetHeartbeatAt(time.Now()).
+		Save(p.getCtx())
+	if err != nil {
+		return models.SearchJob{}, err
+	}
+	if claimed == 0 {
+		return models.SearchJob{}, database.ErrSearchJobClaimed
+	}
+	return p.GetSearchJob(id)
+}
+
+func (p *PostgresDatabase) HeartbeatSearchJob(id int, runner string) error {
+	updated, err := p.client.SearchJob.Update().
+		Where(searchjob.ID(id), searchjob.Runner(runner), searchjob.StatusEQ(searchjob.StatusRunning)).
+		SetHeartbeatAt(time.Now()).
+		Save(p.getCtx())
+	if err != nil {
+		return err
+	}
+	if updated == 0 {
+		return database.ErrSearchJobClaimed
+	}
+	return nil
+}
+
+func (p *PostgresDatabase) GetSearchJob(id int) (models.SearchJob, error) {
+	j, err := p.client.SearchJob.Get(p.getCtx(), id)
+	if err != nil {
+		var e *ent.NotFoundError
+		if errors.As(err, &e) {
+			return models.SearchJob{}, database.ErrSearchJobNotFound
+		}
+		return models.SearchJob{}, err
+	}
+	return toSearchJobModel(j), nil
+}
+
+// GetSearchJobList returns search jobs in scope, the most recently created first. Jobs of every user are in all scope,
+// otherwise only jobs of its user are
+func (p *PostgresDatabase) GetSearchJobList(scope database.Scope) ([]models.SearchJob, error) {
+	query := p.client.SearchJob.Query()
+	if !scope.All {
+		query.Where(searchjob.UserIDEQ(scope.UserId))
+	}
+	jobs, err := query.
+		Order(ent.Desc(searchjob.FieldCreatedAt)).
+		All(p.getCtx())
+	if err != nil {
+		return nil, err
+	}
+
+	result := make([]models.SearchJob, 0, len(jobs))
+	for _, j := range jobs {
+		result = append(result, toSearchJobModel(j))
+	}
+	return result, nil
+}
+
+func (p *PostgresDatabase) GetUnfinishedSearchJobs() ([]models.SearchJob, error) {
+	jobs, err := p.client.SearchJob.Query().
+		Where(searchjob.StatusIn(searchjob.StatusPending, searchjob.StatusRunning)).
+		Order(ent.Asc(searchjob.FieldCreatedAt)).
+		All(p.getCtx())
+	if err != nil {
+		return nil, err
+	}
+
+	result := make([]models.SearchJob, 0, len(jobs))
+	for _, j := range jobs {
+		result = append(result, toSearchJobModel(j))
+	}
+	return result, nil
+}
+
+func toSearchJobModel(j *ent.SearchJob) models.SearchJob {
+	job := models.SearchJob{
+		Id:                     j.ID,
+		UserId:                 j.UserID,
+		Query:                  j.Query,
+		MaxResults:             j.MaxResults,
+		Status:                 string(j.Status),
+		Saved:                  j.Saved,
+		Failed:                 j.Failed,
+		FileNames:              j.FileNames,
+		Deduplicated:           j.Deduplicated,
+		DuplicateFileNames:     j.DuplicateFileNames,
+		DuplicateOf:            j.DuplicateOf,
+		CandidateURLs:          j.CandidateUrls,
+		CandidateThumbnailURLs: j.CandidateThumbnailUrls,
+		NextCandidate:          j.NextCandidate,
+		Runner:                 j.Runner,
+		Error:                  j.Error,
+		UpdatedAt:              j.UpdatedAt,
+		StartedAt:              j.StartedAt,
+		FinishedAt:             j.FinishedAt,
+	}
+	if j.CreatedAt != nil {
+		job.CreatedAt = *j.CreatedAt
+	}
+	return job
+}
+
+func (p *PostgresDatabase) CreateKeyRotation(rotation models.KeyRotation) (models.KeyRotation, error) {
+	r, err := p.client.KeyRotation.Create().
+		SetKeyID(rotation.KeyId).
+		SetStatus(keyrotation.StatusRunning).
+		SetTotal(rotation.Total).
+		SetCreatedAt(time.Now()).
+		SetUpdatedAt(time.Now()).
+		Save(p.getCtx())
+	if err != nil {
+		return models.KeyRotation{}, errors.Wrap(err, "Could not create key rotation")
+	}
+	return toKeyRotationModel(r), nil
+}
+
+func (p *PostgresDatabase) UpdateKeyRotation(rotation models.KeyRotation) error {
+	return p.client.KeyRotation.UpdateOneID(rotation.Id).
+		SetStatus(keyrotation.Status(rotation.Status)).
+		SetTotal(rotation.Total).
+		SetDone(rotation.Done).
+		SetFailed(rotation.Failed).
+		SetError(rotation.Error).
+		SetUpdatedAt(time.Now()).
+		SetNillableFinishedAt(rotation.FinishedAt).
+		Exec(p.getCtx())
+}
+
+// GetRunningKeyRotation returns the latest unfinished rotation to keyId, nil when there is none
+func (p *PostgresDatabase) GetRunningKeyRotation(keyId string) (*models.KeyRotation, error) {
+	r, err := p.client.KeyRotation.Query().
+		Where(keyrotation.KeyIDEQ(keyId), keyrotation.StatusEQ(keyrotation.StatusRunning)).
+		Order(ent.Desc(keyrotation.FieldID)).
+		First(p.getCtx())
+	if err != nil {
+		var e *ent.NotFoundError
+		if errors.As(err, &e) {
+			return nil, nil
+		}
+		return nil, err
+	}
+
+	rotation := toKeyRotationModel(r)
+	return &rotation, nil
+}
+
+func (p *PostgresDatabase) GetFilesToRotate(keyId string, afterUUID string, limit int) ([]models.File, error) {
+	files, err := p.client.File.Query().
+		Where(file.Or(file.KeyIDNEQ(keyId), file.WrappedKeyIsNil()), file.UUIDGT(afterUUID)).
+		Order(ent.Asc(file.FieldUUID)).
+		Limit(limit).
+		All(schema.WithTrashed(p.getCtx()))
+	if err != nil {
+		return nil, err
+	}
+
+	result := make([]models.File, 0, len(files))
+	for _, f := range files {
+		result = append(result, toFileModel(f))
+	}
+	return result, nil
+}
+
+func (p *PostgresDatabase) CountFilesToRotate(keyId string) (int, error) {
+	return p.client.File.Query().Where(file.Or(file.KeyIDNEQ(keyId), file.WrappedKeyIsNil())).Count(schema.WithTrashed(p.getCtx()))
+}
+
+func toKeyRotationModel(r *ent.KeyRotation) models.KeyRotation {
+	rotation := models.KeyRotation{
+		Id:         r.ID,
+		KeyId:      r.KeyID,
+		Status:     string(r.Status),
+		Total:      r.Total,
+		Done:       r.Done,
+		Failed:     r.Failed,
+		Error:      r.Error,
+		UpdatedAt:  r.UpdatedAt,
+		FinishedAt: r.FinishedAt,
+	}
+	if r.CreatedAt != nil {
+		rotation.CreatedAt = *r.CreatedAt
+	}
+	return rotation
+}
+
+func (p *PostgresDatabase) CreateShare(spec models.Share) (models.Share, error) {
+	fileId, err := p.client.File.Query().Where(file.UUIDEQ(spec.FileId)).OnlyID(p.getCtx())
+	if err != nil {
+		var e *ent.NotFoundError
+		if errors.As(err, &e) {
+			return models.Share{}, database.ErrFileNotFound
+		}
+		return models.Share{}, err
+	}
+	if spec.UserId != nil {
+		exist, err := p.client.User.Query().Where(user.IDEQ(*spec.UserId)).Exist(p.getCtx())
+		if err != nil {
+			return models.Share{}, err
+		}
+		if !exist {
+			return models.Share{}, database.ErrUserNotFound
+		}
+	}
+
+	id, err := p.client.Share.Create().
+		SetFileID(fileId).
+		SetNillableUserID(spec.UserId).
+		SetPermission(share.Permission(spec.Permission)).
+		SetNillableExpiresAt(spec.ExpiresAt).
+		SetNillableMaxDownloads(spec.MaxDownloads).
+		OnConflictColumns(share.FieldFileID, share.FieldUserID).
+		Update(func(u *ent.ShareUpsert) {
+			u.UpdatePermission()
+			if spec.ExpiresAt == nil {
+				u.ClearExpiresAt()
+			} else {
+				u.UpdateExpiresAt()
+			}
+		}).
+		ID(p.getCtx())
+	if err != nil {
+		return models.Share{}, errors.Wrap(err, "Could not create share")
+	}
+	s, err := p.client.Share.Get(p.getCtx(), id)
+	if err != nil {
+		return models.Share{}, err
+	}
+	return toShareModel(s, spec.FileId), nil
+}
+
+func (p *PostgresDatabase) GetShareList(fileUUID string) ([]models.Share, error) {
+	shares, err := p.client.Share.Query().
+		Where(share.HasFileWith(file.UUIDEQ(fileUUID))).
+		Order(ent.Asc(share.FieldID)).
+		All(p.getCtx())
+	if err != nil {
+		return nil, err
+	}
+
+	result := make([]models.Share, 0, len(shares))
+	for _, s := range shares {
+		result = append(result, toShareModel(s, fileUUID))
+	}
+	return result, nil
+}
+
+func (p *PostgresDatabase) DeleteShare(fileUUID string, id int) error {
+	deleted, err := p.client.Share.Delete().
+		Where(share.IDEQ(id), share.HasFileWith(file.UUIDEQ(fileUUID))).
+		Exec(p.getCtx())
+	if err != nil {
+		return err
+	}
+	if deleted == 0 {
+		return database.ErrShareNotFound
+	}
+	return nil
+}
+
+// UseShareLink counts a download in the same statement limits of link are checked, so concurrent downloads can't exceed them
+func (p *PostgresDatabase) UseShareLink(id int) (models.Share, error) {
+	used, err := p.client.Share.Update().
+		Where(
+			share.IDEQ(id),
+			share.UserIDIsNil(),
+			share.Or(share.ExpiresAtIsNil(), share.ExpiresAtGT(time.Now())),
+			share.Or(share.MaxDownloadsIsNil(), func(s *entsql.Selector) {
+				s.Where(entsql.ColumnsLT(s.C(share.FieldDownloads), s.C(share.FieldMaxDownloads)))
+			}),
+		).
+		AddDownloads(1).
+		Save(p.getCtx())
+	if err != nil {
+		return models.Share{}, err
+	}
+
+	s, err := p.client.Share.Query().Where(share.IDEQ(id), share.UserIDIsNil()).WithFile().Only(p.getCtx())
+	if err != nil {
+		var e *ent.NotFoundError
+		if errors.As(err, &e) {
+			return models.Share{}, database.ErrShareNotFound
+		}
+		return models.Share{}, err
+	}
+	if used == 0 {
+		return models.Share{}, database.ErrShareUsedUp
+	}
+	return toShareModel(s, s.Edges.File.UUID), nil
+}
+
+func toShareModel(s *ent.Share, fileUUID string) models.Share {
+	return models.Share{
+		Id:           s.ID,
+		FileId:       fileUUID,
+		UserId:       s.UserID,
+		Permission:   string(s.Permission),
+		ExpiresAt:    s.ExpiresAt,
+		MaxDownloads: s.MaxDownloads,
+		Downloads:    s.Downloads,
+		CreatedAt:    s.CreatedAt,
+	}
+}
+
+func (p *PostgresDatabase) GetTagList(scope database.Scope, prefix string, limit int) ([]models.TagCount, error) {
+	var tags []models.TagCount
+	err := p.client.File.Query().
+		Where(inScope(scope)).
+		Modify(func(s *entsql.Selector) {
+			t := entsql.Table(file.TagsTable)
+			s.Join(t).On(s.C(file.FieldID), t.C(file.TagsPrimaryKey[0]))
+			// Columns of joined table are read once it is aliased by join
+			name := t.C(file.TagsPrimaryKey[1])
+			s.Where(entsql.HasPrefix(name, prefix)).
+				GroupBy(name).
+				OrderBy(entsql.Desc("count"), name).
+				Limit(limit)
+			s.Select(entsql.As(name, "name"), entsql.As(entsql.Count("*"), "count"))
+		}).
+		Scan(p.getCtx(), &tags)
+	if err != nil {
+		return nil, err
+	}
+	if tags == nil {
+		tags = make([]models.TagCount, 0)
+	}
+	return tags, nil
+}
+
+func (p *PostgresDatabase) AddFileTags(scope database.Scope, uuid string, tags []string) ([]string, error) {
+	ctx := p.getCtx()
+	f, err := p.getFileWithTags(ctx, scope, uuid)
+	if err != nil {
+		return nil, err
+	}
+	err = p.ensureTags(ctx, tags)
+	if err != nil {
+		return nil, errors.Wrap(err, "could not add tag")
+	}
+
+	// Tags file already has are skipped, adding them again would conflict with their edges
+	current := make(map[string]struct{}, len(f.Edges.Tags))
+	for _, t := range f.Edges.Tags {
+		current[t.ID] = struct{}{}
+	}
+	added := make([]string, 0, len(tags))
+	for _, t := range tags {
+		if _, ok := current[t]; !ok {
+			added = append(added, t)
+		}
+	}
+	if len(added) > 0 {
+		err = p.client.File.UpdateOne(f).AddTagIDs(added...).SetUpdatedAt(time.Now()).Exec(ctx)
+		if err != nil {
+			return nil, err
+		}
+		err = refreshTagNames(ctx, p.client, file.IDEQ(f.ID))
+		if err != nil {
+			return nil, err
+		}
+	}
+	return p.client.File.QueryTags(f).Order(ent.Asc(tag.FieldID)).IDs(ctx)
+}
+
+func (p *PostgresDatabase) RemoveFileTags(scope database.Scope, uuid string, tags []string) ([]string, error) {
+	ctx := p.getCtx()
+	f, err := p.getFileWithTags(ctx, scope, uuid)
+	if err != nil {
+		return nil, err
+	}
+	err = p.client.File.UpdateOne(f).RemoveTagIDs(tags...).SetUpdatedAt(time.Now()).Exec(ctx)
+	if err != nil {
+		return nil, err
+	}
+	err = refreshTagNames(ctx, p.client, file.IDEQ(f.ID))
+	if err != nil {
+		return nil, err
+	}
+	return p.client.File.QueryTags(f).Order(ent.Asc(tag.FieldID)).IDs(ctx)
+}
+
+func (p *PostgresDatabase) RenameTag(name string, newName string) error {
+	return p.moveTag(name, newName, true)
+}
+
+func (p *PostgresDatabase) MergeTags(name string, into string) error {
+	return p.moveTag(name, into, false)
+}
+
+// moveTag sets tag to on every file of tag from, trashed files included, and removes tag from in one transaction.
+// Tag to is created when create is set and must already exist otherwise.
+func (p *PostgresDatabase) moveTag(from string, to string, create bool) error {
+	ctx := schema.WithTrashed(p.getCtx())
+	tx, err := p.client.Tx(ctx)
+	if err != nil {
+		return err
+	}
+	err = func() error {
+		exist, err := tx.Tag.Query().Where(tag.IDEQ(from)).Exist(ctx)
+		if err != nil {
+			return err
+		}
+		if !exist {
+			return database.ErrTagNotFound
+		}
+		exist, err = tx.Tag.Query().Where(tag.IDEQ(to)).Exist(ctx)
+		if err != nil {
+			return err
+		}
+		if create && exist {
+			return database.ErrTagExists
+		}
+		if !create && !exist {
+			return database.ErrTagNotFound
+		}
+		if create {
+			err = tx.Tag.Create().SetID(to).SetCreatedAt(time.Now()).SetUpdatedAt(time.Now()).Exec(ctx)
+			if err != nil {
+				return err
+			}
+		}
+
+		err = tx.File.Update().
+			Where(file.HasTagsWith(tag.IDEQ(from)), file.Not(file.HasTagsWith(tag.IDEQ(to)))).
+			AddTagIDs(to).
+			Exec(ctx)
+		if err != nil {
+			return err
+		}
+		// Edges of tag are removed along with it
+		err = tx.Tag.DeleteOneID(from).Exec(ctx)
+		if err != nil {
+			return err
+		}
+		return refreshTagNames(ctx, tx.Client(), file.HasTagsWith(tag.IDEQ(to)))
+	}()
+	if err != nil {
+		if rerr := tx.Rollback(); rerr != nil {
+			return errors.Wrapf(err, "could not rollback moving tag: %v", rerr)
+		}
+		return err
+	}
+	return tx.Commit()
+}
+
+// getFileWithTags returns file in scope with its tags, ErrFileNotFound is returned when there is none
+func (p *PostgresDatabase) getFileWithTags(ctx context.Context, scope database.Scope, uuid string) (*ent.File, error) {
+	f, err := p.client.File.Query().Where(file.UUIDEQ(uuid), inScope(scope)).WithTags().Only(ctx)
+	if err != nil {
+		var e *ent.NotFoundError
+		if errors.As(err, &e) {
+			return nil, database.ErrFileNotFound
+		}
+		return nil, err
+	}
+	return f, nil
+}
+
+// ensureTags creates tags which don't exist yet, without reading tags which do
+func (p *PostgresDatabase) ensureTags(ctx context.Context, tags []string) error {
+	if len(tags) == 0 {
+		return nil
+	}
+	creates := make([]*ent.TagCreate, 0, len(tags))
+	for _, t := range tags {
+		creates = append(creates, p.client.Tag.Create().SetID(t).SetCreatedAt(time.Now()).SetUpdatedAt(time.Now()))
+	}
+	return p.client.Tag.CreateBulk(creates...).
+		OnConflictColumns(tag.FieldID).
+		DoNothing().
+		Exec(ctx)
+}
@@ -0,0 +1,80 @@
+package postgres
+
+import (
+	"github.com/lebleuciel/maani/pkg/database/ent/file"
+	"github.com/lebleuciel/maani/pkg/database/ent/predicate"
+	"github.com/lebleuciel/maani/pkg/database/ent/tag"
+	"github.com/lebleuciel/maani/pkg/filequery"
+	"github.com/pkg/errors"
+)
+
+// compileQuery compiles a parsed file query to a predicate of files
+func compileQuery(expr filequery.Expr) (predicate.File, error) {
+	switch e := expr.(type) {
+	case filequery.And:
+		left, right, err := compileOperands(e.Left, e.Right)
+		if err != nil {
+			return nil, err
+		}
+		return file.And(left, right), nil
+	case filequery.Or:
+		left, right, err := compileOperands(e.Left, e.Right)
+		if err != nil {
+			return nil, err
+		}
+		return file.Or(left, right), nil
+	case filequery.Not:
+		p, err := compileQuery(e.Expr)
+		if err != nil {
+			return nil, err
+		}
+		return file.Not(p), nil
+	case filequery.Tag:
+		return file.HasTagsWith(tag.IDEQ(e.Name)), nil
+	case filequery.Name:
+		return file.NameContainsFold(e.Contains), nil
+	case filequery.Type:
+		if e.Prefix {
+			return file.TypeHasPrefix(e.Type), nil
+		}
+		return file.TypeEQ(e.Type), nil
+	case filequery.Size:
+		return compileSize(e)
+	case filequery.Created:
+		if e.After {
+			return file.CreatedAtGTE(e.At), nil
+		}
+		return file.CreatedAtLT(e.At), nil
+	default:
+		return nil, errors.Errorf("unsupported query expression %T", expr)
+	}
+}
+
+func compileOperands(left filequery.Expr, right filequery.Expr) (predicate.File, predicate.File, error) {
+	l, err := compileQuery(left)
+	if err != nil {
+		return nil, nil, err
+	}
+	r, err := compileQuery(right)
+	if err != nil {
+		return nil, nil, err
+	}
+	return l, r, nil
+}
+
+func compileSize(e filequery.Size) (predicate.File, error) {
+	switch e.Op {
+	case filequery.OpEQ:
+		return file.SizeEQ(e.Bytes), nil
+	case filequery.OpLT:
+		return file.SizeLT(e.Bytes), nil
+	case filequery.OpLTE:
+		return file.SizeLTE(e.Bytes), nil
+	case filequery.OpGT:
+		return file.SizeGT(e.Bytes), nil
+	case filequery.OpGTE:
+		return file.SizeGTE(e.Bytes), nil
+	default:
+		return nil, errors.Errorf("unsupported size comparison %q", e.Op)
+	}
+}
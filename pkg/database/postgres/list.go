@@ -0,0 +1,197 @@
+package postgres
+
+import (
+	"strconv"
+	"strings"
+
+	entsql "entgo.io/ent/dialect/sql"
+	"github.com/lebleuciel/maani/models"
+	"github.com/lebleuciel/maani/pkg/database"
+	"github.com/lebleuciel/maani/pkg/database/ent"
+	"github.com/lebleuciel/maani/pkg/database/ent/file"
+	"github.com/lebleuciel/maani/pkg/database/ent/predicate"
+	"github.com/lebleuciel/maani/pkg/database/ent/tag"
+	"github.com/lebleuciel/maani/pkg/database/ent/user"
+)
+
+// GetFileList returns a page of files in scope matching filters of options, with cursor of next page which is empty on the last page
+func (p *PostgresDatabase) GetFileList(scope database.Scope, options database.ListOptions) ([]models.File, string, error) {
+	column := ""
+	var after any
+	switch options.Sort {
+	case database.SortSize:
+		column = file.FieldSize
+		if options.Cursor != nil {
+			size, err := strconv.Atoi(options.Cursor.Value)
+			if err != nil {
+				return nil, "", database.ErrInvalidCursor
+			}
+			after = size
+		}
+	case database.SortName:
+		column = file.FieldName
+		if options.Cursor != nil {
+			after = options.Cursor.Value
+		}
+	}
+
+	query := p.client.File.Query().Where(inScope(scope))
+	if options.OwnerId != 0 {
+		query.Where(file.UserIDEQ(options.OwnerId))
+	}
+	if options.Type != "" {
+		mediaType, wildcard := strings.CutSuffix(strings.ToLower(options.Type), "/*")
+		if wildcard || !strings.Contains(mediaType, "/") {
+			query.Where(file.TypeHasPrefix(mediaType + "/"))
+		} else {
+			query.Where(file.TypeEQ(mediaType))
+		}
+	}
+	if options.Tag != "" {
+		query.Where(file.HasTagsWith(tag.IDEQ(options.Tag)))
+	}
+	if options.CreatedAfter != nil {
+		query.Where(file.CreatedAtGTE(*options.CreatedAfter))
+	}
+	if options.CreatedBefore != nil {
+		query.Where(file.CreatedAtLT(*options.CreatedBefore))
+	}
+	if options.Cursor != nil {
+		query.Where(predicate.File(listAfter(options, column, after)))
+	}
+
+	files, err := query.
+		WithTags().
+		Order(file.OrderOption(listOrder(options, column))).
+		Limit(options.Limit + 1).
+		All(p.getCtx())
+	if err != nil {
+		return nil, "", err
+	}
+
+	nextCursor := ""
+	if len(files) > options.Limit {
+		files = files[:options.Limit]
+		last := files[len(files)-1]
+		switch options.Sort {
+		case database.SortSize:
+			nextCursor = options.NextCursor(last.ID, strconv.Itoa(last.Size))
+		case database.SortName:
+			nextCursor = options.NextCursor(last.ID, last.Name)
+		default:
+			nextCursor = options.NextCursor(last.ID, "")
+		}
+	}
+
+	result := make([]models.File, 0, len(files))
+	for _, f := range files {
+		result = append(result, toFileModel(f))
+	}
+	return result, nextCursor, nil
+}
+
+// GetUserList returns a page of users matching access type and creation time filters of options, with cursor of next page
+// which is empty on the last page. Users sorted by name are sorted by their last name
+func (p *PostgresDatabase) GetUserList(options database.ListOptions) ([]models.User, string, error) {
+	column := ""
+	var after any
+	if options.Sort == database.SortName {
+		column = user.FieldLastName
+		if options.Cursor != nil {
+			after = options.Cursor.Value
+		}
+	}
+
+	query := p.client.User.Query()
+	if options.Type != "" {
+		query.Where(user.AccessTypeEQ(user.AccessType(options.Type)))
+	}
+	if options.CreatedAfter != nil {
+		query.Where(user.CreatedAtGTE(*options.CreatedAfter))
+	}
+	if options.CreatedBefore != nil {
+		query.Where(user.CreatedAtLT(*options.CreatedBefore))
+	}
+	if options.Cursor != nil {
+		query.Where(predicate.User(listAfter(options, column, after)))
+	}
+
+	users, err := query.
+		Order(user.OrderOption(listOrder(options, column))).
+		Limit(options.Limit + 1).
+		All(p.getCtx())
+	if err != nil {
+		return nil, "", err
+	}
+
+	nextCursor := ""
+	if len(users) > options.Limit {
+		users = users[:options.Limit]
+		last := users[len(users)-1]
+		if options.Sort == database.SortName {
+			nextCursor = options.NextCursor(last.ID, last.LastName)
+		} else {
+			nextCursor = options.NextCursor(last.ID, "")
+		}
+	}
+
+	result := make([]models.User, 0, len(users))
+	for _, u := range users {
+		result = append(result, toUserModel(u))
+	}
+	return result, nextCursor, nil
+}
+
+func toUserModel(u *ent.User) models.User {
+	result := models.User{
+		Id:          u.ID,
+		FirstName:   u.FirstName,
+		LastName:    u.LastName,
+		AccessType:  string(u.AccessType),
+		UpdatedAt:   u.UpdatedAt,
+		LastLoginAt: u.LastLoginAt,
+	}
+	if u.Email != nil {
+		result.Email = *u.Email
+	}
+	if u.CreatedAt != nil {
+		result.CreatedAt = *u.CreatedAt
+	}
+	return result
+}
+
+// listOrder orders a list by column and then by id, in direction of options. Lists sorted by creation time are ordered by
+// id alone, which is given in order of creation, since creation time of older rows may be unset
+func listOrder(options database.ListOptions, column string) func(*entsql.Selector) {
+	return func(s *entsql.Selector) {
+		for _, c := range listColumns(s, column) {
+			if options.Desc {
+				s.OrderBy(entsql.Desc(c))
+			} else {
+				s.OrderBy(entsql.Asc(c))
+			}
+		}
+	}
+}
+
+// listAfter matches items after cursor of options in order of listOrder, after is value of column in cursor
+func listAfter(options database.ListOptions, column string, after any) func(*entsql.Selector) {
+	return func(s *entsql.Selector) {
+		args := []any{options.Cursor.Id}
+		if column != "" {
+			args = []any{after, options.Cursor.Id}
+		}
+		if options.Desc {
+			s.Where(entsql.CompositeLT(listColumns(s, column), args...))
+		} else {
+			s.Where(entsql.CompositeGT(listColumns(s, column), args...))
+		}
+	}
+}
+
+func listColumns(s *entsql.Selector, column string) []string {
+	if column == "" {
+		return []string{s.C("id")}
+	}
+	return []string{s.C(column), s.C("id")}
+}
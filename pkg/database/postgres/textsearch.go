@@ -0,0 +1,169 @@
+package postgres
+
+import (
+	"context"
+	"fmt"
+	"html"
+	"strings"
+	"unicode"
+
+	"ariga.io/atlas/sql/migrate"
+	"entgo.io/ent/dialect"
+	entsql "entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/schema"
+	"github.com/lebleuciel/maani/models"
+	"github.com/lebleuciel/maani/pkg/database"
+	"github.com/lebleuciel/maani/pkg/database/ent"
+	"github.com/lebleuciel/maani/pkg/database/ent/file"
+	"github.com/lebleuciel/maani/pkg/database/ent/predicate"
+	"github.com/pkg/errors"
+)
+
+const (
+	// searchVectorColumn is the generated tsvector column of files full-text search matches
+	searchVectorColumn = "search_vector"
+	// textSearchConfig is text search configuration of search vector and queries matched against it
+	textSearchConfig = "english"
+	// maxTextQueryWords is the most words of a full-text query which are matched, the rest are ignored
+	maxTextQueryWords = 16
+)
+
+// Highlighted words are marked by control characters, which are replaced by tags once the rest of text is escaped
+const (
+	highlightStart = "\x02"
+	highlightStop  = "\x03"
+)
+
+// headlineOptions highlight every matching word of headline
+var headlineOptions = `StartSel="` + highlightStart + `", StopSel="` + highlightStop + `", HighlightAll=true`
+
+// searchVectorMigrations add search vector of files and its index. Search vector is generated from columns of file,
+// so tags are read from tag_names which is kept in sync with tags of file. Names are split at dots, dashes and
+// underscores, which text search parser would keep in a single word.
+var searchVectorMigrations = []string{
+	`ALTER TABLE files ADD COLUMN IF NOT EXISTS ` + searchVectorColumn + ` tsvector GENERATED ALWAYS AS (
+		setweight(to_tsvector('` + textSearchConfig + `', translate(coalesce(name, ''), '._-', '   ')), 'A') ||
+		setweight(to_tsvector('` + textSearchConfig + `', coalesce(tag_names, '')), 'B') ||
+		setweight(to_tsvector('` + textSearchConfig + `', coalesce(search_query, '')), 'C')
+	) STORED`,
+	`CREATE INDEX IF NOT EXISTS file_search_vector ON files USING GIN (` + searchVectorColumn + `)`,
+}
+
+// searchVectorHook applies search vector migrations along with migration of ent schema, which can't describe
+// generated columns and GIN indexes. They are idempotent, so they are applied on every migration.
+func searchVectorHook(next schema.Applier) schema.Applier {
+	return schema.ApplyFunc(func(ctx context.Context, conn dialect.ExecQuerier, plan *migrate.Plan) error {
+		err := next.Apply(ctx, conn, plan)
+		if err != nil {
+			return err
+		}
+		for _, query := range searchVectorMigrations {
+			err = conn.Exec(ctx, query, []any{}, nil)
+			if err != nil {
+				return errors.Wrap(err, "Could not add search vector of files")
+			}
+		}
+		return nil
+	})
+}
+
+func (p *PostgresDatabase) SearchFilesText(scope database.Scope, text string, limit int, offset int) ([]models.TextMatch, int, error) {
+	tsquery := prefixQuery(text)
+	if tsquery == "" {
+		return make([]models.TextMatch, 0), 0, nil
+	}
+	query := p.client.File.Query().Where(inScope(scope), func(s *entsql.Selector) {
+		s.Where(entsql.P(func(b *entsql.Builder) {
+			b.WriteString(s.C(searchVectorColumn)).WriteString(" @@ ")
+			writeTSQuery(b, tsquery)
+		}))
+	})
+	total, err := query.Clone().Count(p.getCtx())
+	if err != nil {
+		return nil, 0, err
+	}
+
+	files, err := query.
+		WithTags().
+		Modify(func(s *entsql.Selector) {
+			s.AppendSelectExprAs(entsql.ExprFunc(func(b *entsql.Builder) {
+				b.WriteString("ts_rank(").WriteString(s.C(searchVectorColumn)).WriteString(", ")
+				writeTSQuery(b, tsquery)
+				b.WriteString(")::float8")
+			}), "rank")
+			// Tags are sent with files, so only name and search query are highlighted
+			s.AppendSelectExprAs(entsql.ExprFunc(func(b *entsql.Builder) {
+				b.WriteString("ts_headline('" + textSearchConfig + "', concat_ws(' ', ").
+					WriteString(s.C(file.FieldName)).WriteString(", ").
+					WriteString(s.C(file.FieldSearchQuery)).WriteString("), ")
+				writeTSQuery(b, tsquery)
+				b.WriteString(", ").Arg(headlineOptions).WriteString(")")
+			}), "highlight")
+			s.OrderBy(entsql.Desc("rank"), entsql.Desc(s.C(file.FieldID)))
+		}).
+		Limit(limit).
+		Offset(offset).
+		All(p.getCtx())
+	if err != nil {
+		return nil, 0, err
+	}
+
+	result := make([]models.TextMatch, 0, len(files))
+	for _, f := range files {
+		match := models.TextMatch{File: toFileModel(f)}
+		if rank, err := f.Value("rank"); err == nil {
+			match.Rank, _ = rank.(float64)
+		}
+		if highlight, err := f.Value("highlight"); err == nil {
+			match.Highlight = escapeHighlight(highlight)
+		}
+		result = append(result, match)
+	}
+	return result, total, nil
+}
+
+// prefixQuery returns a tsquery matching files with every word of text, or words starting with it so partial names are
+// found too. Words only have letters and digits, so text can't change syntax of query.
+func prefixQuery(text string) string {
+	words := strings.FieldsFunc(strings.ToLower(text), func(r rune) bool {
+		return !unicode.IsLetter(r) && !unicode.IsDigit(r)
+	})
+	if len(words) > maxTextQueryWords {
+		words = words[:maxTextQueryWords]
+	}
+	for i := range words {
+		words[i] += ":*"
+	}
+	return strings.Join(words, " & ")
+}
+
+func writeTSQuery(b *entsql.Builder, tsquery string) {
+	b.WriteString("to_tsquery('" + textSearchConfig + "', ").Arg(tsquery).WriteString(")")
+}
+
+// escapeHighlight escapes headline so it can be shown as html, with highlighted words in mark tags
+func escapeHighlight(value any) string {
+	var headline string
+	switch v := value.(type) {
+	case string:
+		headline = v
+	case []byte:
+		headline = string(v)
+	}
+	headline = html.EscapeString(headline)
+	headline = strings.ReplaceAll(headline, highlightStart, "<mark>")
+	return strings.ReplaceAll(headline, highlightStop, "</mark>")
+}
+
+// refreshTagNames copies tags of files matching predicates to their tag names, which search vector is generated from
+func refreshTagNames(ctx context.Context, client *ent.Client, predicates ...predicate.File) error {
+	return client.File.Update().
+		Where(predicates...).
+		Modify(func(u *entsql.UpdateBuilder) {
+			u.Set(file.FieldTagNames, entsql.Expr(fmt.Sprintf(
+				`(SELECT coalesce(string_agg(%[2]s, ' ' ORDER BY %[2]s), '') FROM %[1]s WHERE %[1]s.%[3]s = %[4]s.%[5]s)`,
+				file.TagsTable, file.TagsPrimaryKey[1], file.TagsPrimaryKey[0], file.Table, file.FieldID,
+			)))
+		}).
+		Exec(ctx)
+}
@@ -0,0 +1,23 @@
+package postgres
+
+import (
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestPrefixQuery(t *testing.T) {
+	assert.Equal(t, "summer:* & trip:* & 2024:*", prefixQuery("Summer_trip-2024"))
+	// Syntax of tsquery in text is dropped
+	assert.Equal(t, "cat:* & dog:*", prefixQuery("cat | !dog':*"))
+	assert.Equal(t, "", prefixQuery(" !&| "))
+	assert.Len(t, strings.Split(prefixQuery(strings.Repeat("word ", 20)), " & "), maxTextQueryWords)
+}
+
+func TestEscapeHighlight(t *testing.T) {
+	headline := "<b>" + highlightStart + "summer" + highlightStop + "</b> trip"
+	assert.Equal(t, "&lt;b&gt;<mark>summer</mark>&lt;/b&gt; trip", escapeHighlight(headline))
+	assert.Equal(t, "<mark>cat</mark>", escapeHighlight([]byte(highlightStart+"cat"+highlightStop)))
+	assert.Equal(t, "", escapeHighlight(nil))
+}
@@ -0,0 +1,32 @@
+package database
+
+// Scope limits which files database methods see, files out of scope are reported as not found
+type Scope struct {
+	// UserId is id of user whose files and files shared with are in scope, unless All is set
+	UserId int
+	// All puts files of every user in scope, for admins and maintenance tasks
+	All bool
+	// Write leaves out files shared with user without write permission
+	Write bool
+}
+
+// OwnerScope returns scope of files owned by user and files shared with user
+func OwnerScope(userId int) Scope {
+	return Scope{UserId: userId}
+}
+
+// AllScope returns scope of files of every user
+func AllScope() Scope {
+	return Scope{All: true}
+}
+
+// ForWrite returns scope of files user can modify
+func (s Scope) ForWrite() Scope {
+	s.Write = true
+	return s
+}
+
+// Owns reports whether file of owner with userId is owned by user of scope, admins own every file
+func (s Scope) Owns(userId int) bool {
+	return s.All || s.UserId == userId
+}
@@ -0,0 +1,86 @@
+package encryption
+
+import (
+	"crypto/rand"
+	"io"
+)
+
+const (
+	// DataKeyId is recorded in header of blobs encrypted with their own data key, which is stored wrapped in database
+	DataKeyId = "dek"
+	// DataKeySize is the size of generated data keys, used as AES-256 keys
+	DataKeySize = 32
+)
+
+// GenerateDataKey returns a random key for encrypting a single file
+func GenerateDataKey() ([]byte, error) {
+	dek := make([]byte, DataKeySize)
+	if _, err := io.ReadFull(rand.Reader, dek); err != nil {
+		return nil, err
+	}
+	return dek, nil
+}
+
+// WrapDataKey seals dek with active key of provider and returns wrapped key with the id of key which wrapped it
+func WrapDataKey(provider KeyProvider, dek []byte) ([]byte, string, error) {
+	kekId := provider.ActiveId()
+	kek, err := provider.Key(kekId)
+	if err != nil {
+		return nil, "", err
+	}
+	gcm, err := newGCM(kek)
+	if err != nil {
+		return nil, "", err
+	}
+	nonce := make([]byte, gcm.NonceSize())
+	if _, err := io.ReadFull(rand.Reader, nonce); err != nil {
+		return nil, "", err
+	}
+	// Key id is authenticated, so a wrapped key can not be presented as wrapped by another key
+	return gcm.Seal(nonce, nonce, dek, []byte(kekId)), kekId, nil
+}
+
+// UnwrapDataKey opens a data key wrapped by key kekId of provider
+func UnwrapDataKey(provider KeyProvider, wrapped []byte, kekId string) ([]byte, error) {
+	kek, err := provider.Key(kekId)
+	if err != nil {
+		return nil, err
+	}
+	gcm, err := newGCM(kek)
+	if err != nil {
+		return nil, err
+	}
+	if len(wrapped) < gcm.NonceSize() {
+		return nil, ErrCorrupted
+	}
+	dek, err := gcm.Open(nil, wrapped[:gcm.NonceSize()], wrapped[gcm.NonceSize():], []byte(kekId))
+	if err != nil {
+		return nil, ErrCorrupted
+	}
+	return dek, nil
+}
+
+// RewrapDataKey moves a wrapped data key to active key of provider, blob encrypted with the data key is not touched
+func RewrapDataKey(provider KeyProvider, wrapped []byte, kekId string) ([]byte, string, error) {
+	dek, err := UnwrapDataKey(provider, wrapped, kekId)
+	if err != nil {
+		return nil, "", err
+	}
+	return WrapDataKey(provider, dek)
+}
+
+// FileKeys resolves keys of one stored file, its data key and for blobs written before envelope encryption the keys of provider
+type FileKeys struct {
+	DataKey  []byte
+	Provider KeyProvider
+}
+
+func (k FileKeys) Key(id string) ([]byte, error) {
+	if id == DataKeyId {
+		if k.DataKey == nil {
+			return nil, ErrMissingDataKey
+		}
+		return k.DataKey, nil
+	}
+	return k.Provider.Key(id)
+}
@@ -0,0 +1,53 @@
+package encryption
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestDataKey_WrapRewrap(t *testing.T) {
+	dek, err := GenerateDataKey()
+	assert.NoError(t, err)
+	assert.Len(t, dek, DataKeySize)
+
+	wrapped, kekId, err := WrapDataKey(testKeyring, dek)
+	assert.NoError(t, err)
+	assert.Equal(t, "new", kekId)
+	assert.NotContains(t, string(wrapped), string(dek))
+
+	unwrapped, err := UnwrapDataKey(testKeyring, wrapped, kekId)
+	assert.NoError(t, err)
+	assert.Equal(t, dek, unwrapped)
+
+	// Presenting the wrapped key as wrapped by another key fails even when key material would match
+	_, err = UnwrapDataKey(testKeyring, wrapped, "old")
+	assert.ErrorIs(t, err, ErrCorrupted)
+
+	rotated, err := NewKeyring(map[string]string{
+		"new":  "fedcba9876543210fedcba9876543210",
+		"next": "abcdefabcdefabcdefabcdefabcdefab",
+	}, "next", "")
+	assert.NoError(t, err)
+	rewrapped, rewrappedId, err := RewrapDataKey(rotated, wrapped, kekId)
+	assert.NoError(t, err)
+	assert.Equal(t, "next", rewrappedId)
+	unwrapped, err = UnwrapDataKey(rotated, rewrapped, rewrappedId)
+	assert.NoError(t, err)
+	assert.Equal(t, dek, unwrapped)
+}
+
+func TestFileKeys(t *testing.T) {
+	keys := FileKeys{Provider: testKeyring}
+	_, err := keys.Key(DataKeyId)
+	assert.ErrorIs(t, err, ErrMissingDataKey)
+
+	keys.DataKey = []byte("data-key-of-file-0123456789abcde")
+	key, err := keys.Key(DataKeyId)
+	assert.NoError(t, err)
+	assert.Equal(t, keys.DataKey, key)
+
+	key, err = keys.Key("old")
+	assert.NoError(t, err)
+	assert.Equal(t, "0123456789abcdef", string(key))
+}
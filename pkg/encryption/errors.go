@@ -0,0 +1,16 @@
+package encryption
+
+import "github.com/pkg/errors"
+
+var ErrNotSegmented = errors.New("blob is not in segmented encryption format")
+var ErrUnsupportedVersion = errors.New("segmented encryption format version is not supported")
+var ErrCorrupted = errors.New("encrypted blob is corrupted or was tampered with")
+var ErrInvalidSegmentSize = errors.New("segment size must be positive")
+var ErrTooManySegments = errors.New("blob has too many segments")
+var ErrClosed = errors.New("encryption writer is closed")
+var ErrInvalidKeyId = errors.New("key id must be between 1 and 255 bytes")
+var ErrUnknownKeyId = errors.New("key id is not in keyring")
+var ErrInvalidKey = errors.New("key must be 16, 24 or 32 bytes")
+var ErrUnknownKeyProvider = errors.New("key provider is not supported, supports: settings, file, env")
+var ErrMissingDataKey = errors.New("blob is encrypted with a data key but file has no wrapped data key")
+var ErrReservedKeyId = errors.New("key id dek is reserved for data keys")
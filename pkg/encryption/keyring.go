@@ -0,0 +1,92 @@
+package encryption
+
+import (
+	"fmt"
+
+	"github.com/lebleuciel/maani/pkg/settings"
+)
+
+// DefaultKeyId is the id of store.encryptKey, which also decrypts blobs written before keys had ids
+const DefaultKeyId = "default"
+
+// KeySource resolves keys by the id recorded in blob header, empty id asks for the legacy key
+type KeySource interface {
+	Key(id string) ([]byte, error)
+}
+
+// Keyring holds key-encryption keys by id and the active key new data keys are wrapped with
+type Keyring struct {
+	keys     map[string][]byte
+	activeId string
+	legacy   []byte
+}
+
+// NewKeyring validates keys and returns a keyring wrapping with key of activeId.
+// legacyKey decrypts blobs written before keys had ids, it may be empty when there are none.
+func NewKeyring(keys map[string]string, activeId string, legacyKey string) (*Keyring, error) {
+	k := &Keyring{
+		keys:     make(map[string][]byte, len(keys)),
+		activeId: activeId,
+		legacy:   []byte(legacyKey),
+	}
+	for id, key := range keys {
+		if id == "" || len(id) > MaxKeyIdSize {
+			return nil, ErrInvalidKeyId
+		}
+		if id == DataKeyId {
+			return nil, ErrReservedKeyId
+		}
+		if !validKeySize(len(key)) {
+			return nil, fmt.Errorf("key %s: %w", id, ErrInvalidKey)
+		}
+		k.keys[id] = []byte(key)
+	}
+	if _, ok := k.keys[activeId]; !ok {
+		return nil, fmt.Errorf("active key %s: %w", activeId, ErrUnknownKeyId)
+	}
+	if len(k.legacy) > 0 && !validKeySize(len(k.legacy)) {
+		return nil, fmt.Errorf("legacy key: %w", ErrInvalidKey)
+	}
+	return k, nil
+}
+
+// KeyringFromSettings builds keyring of store.encryptKeys, store.encryptKey is always available as DefaultKeyId
+func KeyringFromSettings(st settings.Settings) (*Keyring, error) {
+	keys := make(map[string]string, len(st.BackendServer.EncryptKeys)+1)
+	for id, key := range st.BackendServer.EncryptKeys {
+		keys[id] = key
+	}
+	if key, ok := keys[DefaultKeyId]; ok && key != st.BackendServer.EncryptKey {
+		return nil, fmt.Errorf("key %s must match store.encryptKey: %w", DefaultKeyId, ErrInvalidKey)
+	}
+	keys[DefaultKeyId] = st.BackendServer.EncryptKey
+
+	activeId := st.BackendServer.ActiveKeyId
+	if activeId == "" {
+		activeId = DefaultKeyId
+	}
+	return NewKeyring(keys, activeId, st.BackendServer.EncryptKey)
+}
+
+// ActiveId returns the id of key new data keys are wrapped with
+func (k *Keyring) ActiveId() string {
+	return k.activeId
+}
+
+func (k *Keyring) Key(id string) ([]byte, error) {
+	if id == "" {
+		if len(k.legacy) == 0 {
+			return nil, fmt.Errorf("legacy key: %w", ErrUnknownKeyId)
+		}
+		return k.legacy, nil
+	}
+	key, ok := k.keys[id]
+	if !ok {
+		return nil, fmt.Errorf("key %s: %w", id, ErrUnknownKeyId)
+	}
+	return key, nil
+}
+
+func validKeySize(size int) bool {
+	return size == 16 || size == 24 || size == 32
+}
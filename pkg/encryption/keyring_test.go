@@ -0,0 +1,60 @@
+package encryption
+
+import (
+	"testing"
+
+	"github.com/lebleuciel/maani/pkg/settings"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestNewKeyring(t *testing.T) {
+	t.Run("Unknown active key", func(t *testing.T) {
+		_, err := NewKeyring(map[string]string{"a": "0123456789abcdef"}, "b", "0123456789abcdef")
+		assert.ErrorIs(t, err, ErrUnknownKeyId)
+	})
+	t.Run("Invalid key size", func(t *testing.T) {
+		_, err := NewKeyring(map[string]string{"a": "short"}, "a", "0123456789abcdef")
+		assert.ErrorIs(t, err, ErrInvalidKey)
+	})
+	t.Run("Valid", func(t *testing.T) {
+		k, err := NewKeyring(map[string]string{"a": "0123456789abcdef", "b": "fedcba9876543210"}, "b", "legacy-key-16byt")
+		assert.NoError(t, err)
+		assert.Equal(t, "b", k.ActiveId())
+		key, err := k.Key("a")
+		assert.NoError(t, err)
+		assert.Equal(t, "0123456789abcdef", string(key))
+		key, err = k.Key("")
+		assert.NoError(t, err)
+		assert.Equal(t, "legacy-key-16byt", string(key))
+		_, err = k.Key("c")
+		assert.ErrorIs(t, err, ErrUnknownKeyId)
+	})
+	t.Run("Without legacy key", func(t *testing.T) {
+		k, err := NewKeyring(map[string]string{"a": "0123456789abcdef"}, "a", "")
+		assert.NoError(t, err)
+		_, err = k.Key("")
+		assert.ErrorIs(t, err, ErrUnknownKeyId)
+	})
+}
+
+func TestKeyringFromSettings(t *testing.T) {
+	var st settings.Settings
+	st.BackendServer.EncryptKey = "0123456789abcdef"
+
+	k, err := KeyringFromSettings(st)
+	assert.NoError(t, err)
+	assert.Equal(t, DefaultKeyId, k.ActiveId())
+
+	st.BackendServer.EncryptKeys = map[string]string{"2024-06": "fedcba9876543210"}
+	st.BackendServer.ActiveKeyId = "2024-06"
+	k, err = KeyringFromSettings(st)
+	assert.NoError(t, err)
+	assert.Equal(t, "2024-06", k.ActiveId())
+	key, err := k.Key(DefaultKeyId)
+	assert.NoError(t, err)
+	assert.Equal(t, st.BackendServer.EncryptKey, string(key))
+
+	st.BackendServer.EncryptKeys[DefaultKeyId] = "another-16-bytes"
+	_, err = KeyringFromSettings(st)
+	assert.ErrorIs(t, err, ErrInvalidKey)
+}
@@ -0,0 +1,84 @@
+package encryption
+
+import (
+	"fmt"
+	"os"
+	"path/filepath"
+	"regexp"
+	"strings"
+
+	"github.com/lebleuciel/maani/pkg/settings"
+)
+
+const (
+	SettingsKeyProvider = "settings"
+	FileKeyProvider     = "file"
+	EnvKeyProvider      = "env"
+)
+
+// fileKeyId matches key ids usable as file and environment variable names
+var fileKeyId = regexp.MustCompile(`^[A-Za-z0-9_\-]+$`)
+
+// KeyProvider supplies key-encryption keys which wrap data keys of files
+type KeyProvider interface {
+	KeySource
+	// ActiveId returns the id of key new data keys are wrapped with
+	ActiveId() string
+}
+
+// NewKeyProvider returns key provider chosen by store.keyProvider
+func NewKeyProvider(st settings.Settings) (KeyProvider, error) {
+	activeId := st.BackendServer.ActiveKeyId
+	if activeId == "" {
+		activeId = DefaultKeyId
+	}
+	switch st.BackendServer.KeyProvider {
+	case "", SettingsKeyProvider:
+		return KeyringFromSettings(st)
+	case FileKeyProvider:
+		return NewFileKeyProvider(st.BackendServer.KeyDir, activeId)
+	case EnvKeyProvider:
+		return NewEnvKeyProvider(st.BackendServer.KeyEnvPrefix, activeId)
+	}
+	return nil, ErrUnknownKeyProvider
+}
+
+// NewFileKeyProvider loads one key per file of dir, named by key id, such as mounted secrets.
+// Key "default" also decrypts blobs written before keys had ids.
+func NewFileKeyProvider(dir string, activeId string) (KeyProvider, error) {
+	entries, err := os.ReadDir(dir)
+	if err != nil {
+		return nil, err
+	}
+	keys := make(map[string]string, len(entries))
+	for _, entry := range entries {
+		// Secret mounts keep their bookkeeping in hidden entries
+		if entry.IsDir() || strings.HasPrefix(entry.Name(), ".") || !fileKeyId.MatchString(entry.Name()) {
+			continue
+		}
+		key, err := os.ReadFile(filepath.Join(dir, entry.Name()))
+		if err != nil {
+			return nil, err
+		}
+		keys[entry.Name()] = strings.TrimRight(string(key), "\r\n")
+	}
+	return NewKeyring(keys, activeId, keys[DefaultKeyId])
+}
+
+// NewEnvKeyProvider loads keys from environment variables named prefix followed by key id, such as MAANI_KEK_2024.
+// Key "default" also decrypts blobs written before keys had ids.
+func NewEnvKeyProvider(prefix string, activeId string) (KeyProvider, error) {
+	if prefix == "" {
+		return nil, fmt.Errorf("env key provider: %w", ErrInvalidKeyId)
+	}
+	keys := make(map[string]string)
+	for _, env := range os.Environ() {
+		name, value, _ := strings.Cut(env, "=")
+		id, found := strings.CutPrefix(name, prefix)
+		if !found || !fileKeyId.MatchString(id) {
+			continue
+		}
+		keys[id] = value
+	}
+	return NewKeyring(keys, activeId, keys[DefaultKeyId])
+}
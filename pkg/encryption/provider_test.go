@@ -0,0 +1,56 @@
+package encryption
+
+import (
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/lebleuciel/maani/pkg/settings"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestNewFileKeyProvider(t *testing.T) {
+	dir := t.TempDir()
+	assert.NoError(t, os.WriteFile(filepath.Join(dir, "default"), []byte("0123456789abcdef\n"), 0o600))
+	assert.NoError(t, os.WriteFile(filepath.Join(dir, "2024-06"), []byte("fedcba9876543210"), 0o600))
+	assert.NoError(t, os.WriteFile(filepath.Join(dir, ".data"), []byte("not a key"), 0o600))
+
+	provider, err := NewFileKeyProvider(dir, "2024-06")
+	assert.NoError(t, err)
+	assert.Equal(t, "2024-06", provider.ActiveId())
+	key, err := provider.Key(DefaultKeyId)
+	assert.NoError(t, err)
+	assert.Equal(t, "0123456789abcdef", string(key))
+	key, err = provider.Key("")
+	assert.NoError(t, err)
+	assert.Equal(t, "0123456789abcdef", string(key))
+
+	_, err = NewFileKeyProvider(dir, "missing")
+	assert.ErrorIs(t, err, ErrUnknownKeyId)
+}
+
+func TestNewEnvKeyProvider(t *testing.T) {
+	t.Setenv("MAANI_TEST_KEK_primary", "0123456789abcdef")
+
+	provider, err := NewEnvKeyProvider("MAANI_TEST_KEK_", "primary")
+	assert.NoError(t, err)
+	assert.Equal(t, "primary", provider.ActiveId())
+	key, err := provider.Key("primary")
+	assert.NoError(t, err)
+	assert.Equal(t, "0123456789abcdef", string(key))
+	_, err = provider.Key("")
+	assert.ErrorIs(t, err, ErrUnknownKeyId)
+}
+
+func TestNewKeyProvider(t *testing.T) {
+	var st settings.Settings
+	st.BackendServer.EncryptKey = "0123456789abcdef"
+
+	provider, err := NewKeyProvider(st)
+	assert.NoError(t, err)
+	assert.Equal(t, DefaultKeyId, provider.ActiveId())
+
+	st.BackendServer.KeyProvider = "vault"
+	_, err = NewKeyProvider(st)
+	assert.ErrorIs(t, err, ErrUnknownKeyProvider)
+}
@@ -0,0 +1,302 @@
+// Package encryption implements the segmented AES-GCM format used for stored blobs.
+//
+// A blob starts with a header followed by encrypted segments:
+//
+//	magic "MNE1" | version (1 byte) | key id length (1 byte) | key id | segment size (uint32) | nonce prefix (7 bytes)
+//
+// Key id is DataKeyId for blobs encrypted with their own data key, older blobs name the keyring key they were encrypted with.
+// Version 1 headers have no key id fields and are decrypted with the legacy key of keyring.
+// Every segment holds up to segment size bytes of plaintext sealed with AES-GCM.
+// The nonce of a segment is the nonce prefix, a big endian segment counter and a flag
+// which is set only for the final segment, so reordered, dropped or truncated segments
+// fail authentication. The header is authenticated as additional data of every segment.
+package encryption
+
+import (
+	"bufio"
+	"bytes"
+	"crypto/aes"
+	"crypto/cipher"
+	"crypto/rand"
+	"encoding/binary"
+	"io"
+	"math"
+)
+
+const (
+	// DefaultSegmentSize is the plaintext size of each encrypted segment
+	DefaultSegmentSize = 64 * 1024
+
+	// MagicSize is the length of blob prefix IsSegmented needs
+	MagicSize = 4
+
+	version         = 2
+	noncePrefixSize = 7
+	nonceSize       = noncePrefixSize + 4 + 1
+	tagSize         = 16
+	// MaxKeyIdSize is the longest key id which fits into blob header
+	MaxKeyIdSize = math.MaxUint8
+)
+
+var magic = []byte("MNE1")
+
+type header struct {
+	keyId       string
+	segmentSize uint32
+	noncePrefix []byte
+	raw         []byte
+}
+
+func newHeader(keyId string, segmentSize int) (header, error) {
+	if keyId == "" || len(keyId) > MaxKeyIdSize {
+		return header{}, ErrInvalidKeyId
+	}
+	h := header{keyId: keyId, segmentSize: uint32(segmentSize), noncePrefix: make([]byte, noncePrefixSize)}
+	if _, err := io.ReadFull(rand.Reader, h.noncePrefix); err != nil {
+		return header{}, err
+	}
+	h.raw = append(h.raw, magic...)
+	h.raw = append(h.raw, version, byte(len(keyId)))
+	h.raw = append(h.raw, keyId...)
+	h.raw = binary.BigEndian.AppendUint32(h.raw, h.segmentSize)
+	h.raw = append(h.raw, h.noncePrefix...)
+	return h, nil
+}
+
+func readHeader(src io.Reader) (header, error) {
+	raw := make([]byte, MagicSize+1)
+	if _, err := io.ReadFull(src, raw); err != nil {
+		if err == io.EOF || err == io.ErrUnexpectedEOF {
+			return header{}, ErrNotSegmented
+		}
+		return header{}, err
+	}
+	if !bytes.Equal(raw[:MagicSize], magic) {
+		return header{}, ErrNotSegmented
+	}
+
+	var h header
+	switch raw[MagicSize] {
+	case 1:
+	case 2:
+		keyIdSize := make([]byte, 1)
+		if _, err := io.ReadFull(src, keyIdSize); err != nil {
+			return header{}, ErrCorrupted
+		}
+		keyId := make([]byte, keyIdSize[0])
+		if _, err := io.ReadFull(src, keyId); err != nil {
+			return header{}, ErrCorrupted
+		}
+		raw = append(raw, keyIdSize...)
+		raw = append(raw, keyId...)
+		h.keyId = string(keyId)
+		if h.keyId == "" {
+			return header{}, ErrCorrupted
+		}
+	default:
+		return header{}, ErrUnsupportedVersion
+	}
+
+	rest := make([]byte, 4+noncePrefixSize)
+	if _, err := io.ReadFull(src, rest); err != nil {
+		return header{}, ErrCorrupted
+	}
+	h.segmentSize = binary.BigEndian.Uint32(rest)
+	h.noncePrefix = rest[4:]
+	h.raw = append(raw, rest...)
+	if h.segmentSize == 0 {
+		return header{}, ErrCorrupted
+	}
+	return h, nil
+}
+
+func (h header) nonce(counter uint32, last bool) []byte {
+	nonce := make([]byte, nonceSize)
+	copy(nonce, h.noncePrefix)
+	binary.BigEndian.PutUint32(nonce[noncePrefixSize:], counter)
+	if last {
+		nonce[nonceSize-1] = 1
+	}
+	return nonce
+}
+
+func newGCM(key []byte) (cipher.AEAD, error) {
+	block, err := aes.NewCipher(key)
+	if err != nil {
+		return nil, err
+	}
+	return cipher.NewGCM(block)
+}
+
+// IsSegmented reports whether a blob prefix starts with the segmented format header
+func IsSegmented(prefix []byte) bool {
+	return len(prefix) >= MagicSize && bytes.Equal(prefix[:MagicSize], magic)
+}
+
+// Writer encrypts everything written to it into dst, Close must be called to seal the final segment
+type Writer struct {
+	dst     io.Writer
+	gcm     cipher.AEAD
+	header  header
+	buf     []byte
+	counter uint32
+	closed  bool
+}
+
+// NewWriter encrypts with key and records keyId in blob header
+func NewWriter(dst io.Writer, keyId string, key []byte) (*Writer, error) {
+	return NewWriterSize(dst, keyId, key, DefaultSegmentSize)
+}
+
+// NewWriterSize encrypts with key in segments of segmentSize and records keyId in blob header
+func NewWriterSize(dst io.Writer, keyId string, key []byte, segmentSize int) (*Writer, error) {
+	if segmentSize <= 0 {
+		return nil, ErrInvalidSegmentSize
+	}
+	gcm, err := newGCM(key)
+	if err != nil {
+		return nil, err
+	}
+	h, err := newHeader(keyId, segmentSize)
+	if err != nil {
+		return nil, err
+	}
+	if _, err := dst.Write(h.raw); err != nil {
+		return nil, err
+	}
+	return &Writer{
+		dst:    dst,
+		gcm:    gcm,
+		header: h,
+		buf:    make([]byte, 0, segmentSize),
+	}, nil
+}
+
+func (w *Writer) Write(p []byte) (int, error) {
+	if w.closed {
+		return 0, ErrClosed
+	}
+	written := 0
+	for len(p) > 0 {
+		// A full segment is only sealed once more data arrives, since the final segment is flagged differently
+		if len(w.buf) == cap(w.buf) {
+			if err := w.seal(false); err != nil {
+				return written, err
+			}
+		}
+		n := copy(w.buf[len(w.buf):cap(w.buf)], p)
+		w.buf = w.buf[:len(w.buf)+n]
+		p = p[n:]
+		written += n
+	}
+	return written, nil
+}
+
+// Close seals the final segment, it does not close dst
+func (w *Writer) Close() error {
+	if w.closed {
+		return nil
+	}
+	w.closed = true
+	return w.seal(true)
+}
+
+func (w *Writer) seal(last bool) error {
+	if w.counter == math.MaxUint32 {
+		return ErrTooManySegments
+	}
+	sealed := w.gcm.Seal(nil, w.header.nonce(w.counter, last), w.buf, w.header.raw)
+	w.counter++
+	w.buf = w.buf[:0]
+	_, err := w.dst.Write(sealed)
+	return err
+}
+
+// Reader decrypts a segmented blob, every segment is authenticated before its plaintext is returned
+type Reader struct {
+	src     *bufio.Reader
+	gcm     cipher.AEAD
+	header  header
+	segment []byte
+	plain   []byte
+	counter uint32
+	done    bool
+}
+
+// NewReader reads blob header and picks the key it was encrypted with from keys
+func NewReader(src io.Reader, keys KeySource) (*Reader, error) {
+	h, err := readHeader(src)
+	if err != nil {
+		return nil, err
+	}
+	key, err := keys.Key(h.keyId)
+	if err != nil {
+		return nil, err
+	}
+	gcm, err := newGCM(key)
+	if err != nil {
+		return nil, err
+	}
+	segment := int(h.segmentSize) + tagSize
+	return &Reader{
+		src:     bufio.NewReaderSize(src, segment+1),
+		gcm:     gcm,
+		header:  h,
+		segment: make([]byte, segment),
+	}, nil
+}
+
+// KeyId returns the id of key blob was encrypted with, empty for version 1 blobs
+func (r *Reader) KeyId() string {
+	return r.header.keyId
+}
+
+// PlaintextSize returns the plaintext size of the blob from its total size
+func (r *Reader) PlaintextSize(blobSize int64) int64 {
+	payload := blobSize - int64(len(r.header.raw))
+	segment := int64(r.header.segmentSize) + tagSize
+	segments := (payload + segment - 1) / segment
+	if segments == 0 {
+		segments = 1
+	}
+	return payload - segments*tagSize
+}
+
+func (r *Reader) Read(p []byte) (int, error) {
+	for len(r.plain) == 0 {
+		if r.done {
+			return 0, io.EOF
+		}
+		if err := r.open(); err != nil {
+			return 0, err
+		}
+	}
+	n := copy(p, r.plain)
+	r.plain = r.plain[n:]
+	return n, nil
+}
+
+func (r *Reader) open() error {
+	n, err := io.ReadFull(r.src, r.segment)
+	if err != nil && err != io.ErrUnexpectedEOF {
+		if err == io.EOF {
+			// The final segment is always present, even for empty plaintext
+			return ErrCorrupted
+		}
+		return err
+	}
+	last := n < len(r.segment)
+	if !last {
+		if _, peekErr := r.src.Peek(1); peekErr == io.EOF {
+			last = true
+		}
+	}
+	plain, err := r.gcm.Open(r.segment[:0:0], r.header.nonce(r.counter, last), r.segment[:n], r.header.raw)
+	if err != nil {
+		return ErrCorrupted
+	}
+	r.counter++
+	r.plain = plain
+	r.done = last
+	return nil
+}
@@ -0,0 +1,140 @@
+package encryption
+
+import (
+	"bytes"
+	"crypto/rand"
+	"io"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+var testKeyring, _ = NewKeyring(map[string]string{
+	"old": "0123456789abcdef",
+	"new": "fedcba9876543210fedcba9876543210",
+}, "new", "legacy-key-16byt")
+
+func encrypt(t *testing.T, plain []byte, segmentSize int) []byte {
+	var blob bytes.Buffer
+	key, err := testKeyring.Key("new")
+	assert.NoError(t, err)
+	w, err := NewWriterSize(&blob, "new", key, segmentSize)
+	assert.NoError(t, err)
+	// Odd sized writes cross segment boundaries
+	for chunk := plain; len(chunk) > 0; {
+		n := 7
+		if n > len(chunk) {
+			n = len(chunk)
+		}
+		_, err = w.Write(chunk[:n])
+		assert.NoError(t, err)
+		chunk = chunk[n:]
+	}
+	assert.NoError(t, w.Close())
+	return blob.Bytes()
+}
+
+func decrypt(blob []byte) ([]byte, error) {
+	r, err := NewReader(bytes.NewReader(blob), testKeyring)
+	if err != nil {
+		return nil, err
+	}
+	return io.ReadAll(r)
+}
+
+func TestStream_RoundTrip(t *testing.T) {
+	for _, size := range []int{0, 1, 31, 32, 33, 64, 100} {
+		plain := make([]byte, size)
+		_, err := rand.Read(plain)
+		assert.NoError(t, err)
+
+		blob := encrypt(t, plain, 32)
+		assert.True(t, IsSegmented(blob))
+		r, err := NewReader(bytes.NewReader(blob), testKeyring)
+		assert.NoError(t, err)
+		assert.Equal(t, "new", r.KeyId())
+		assert.Equal(t, int64(size), r.PlaintextSize(int64(len(blob))))
+		if size >= 16 {
+			assert.False(t, bytes.Contains(blob, plain[:16]))
+		}
+
+		got, err := decrypt(blob)
+		assert.NoError(t, err, "size %d", size)
+		assert.Equal(t, plain, got, "size %d", size)
+	}
+}
+
+func TestStream_Tampering(t *testing.T) {
+	plain := bytes.Repeat([]byte("segment"), 20)
+	blob := encrypt(t, plain, 32)
+	segment := 32 + tagSize
+	headerSize := MagicSize + 2 + len("new") + 4 + noncePrefixSize
+
+	t.Run("Flipped bit", func(t *testing.T) {
+		tampered := append([]byte{}, blob...)
+		tampered[headerSize+segment+3] ^= 1
+		_, err := decrypt(tampered)
+		assert.ErrorIs(t, err, ErrCorrupted)
+	})
+
+	t.Run("Truncated at segment boundary", func(t *testing.T) {
+		_, err := decrypt(blob[:headerSize+2*segment])
+		assert.ErrorIs(t, err, ErrCorrupted)
+	})
+
+	t.Run("Reordered segments", func(t *testing.T) {
+		tampered := append([]byte{}, blob[:headerSize]...)
+		tampered = append(tampered, blob[headerSize+segment:headerSize+2*segment]...)
+		tampered = append(tampered, blob[headerSize:headerSize+segment]...)
+		tampered = append(tampered, blob[headerSize+2*segment:]...)
+		_, err := decrypt(tampered)
+		assert.ErrorIs(t, err, ErrCorrupted)
+	})
+
+	t.Run("Modified header", func(t *testing.T) {
+		tampered := append([]byte{}, blob...)
+		tampered[headerSize-1] ^= 1
+		_, err := decrypt(tampered)
+		assert.ErrorIs(t, err, ErrCorrupted)
+	})
+
+	t.Run("Key id of another key", func(t *testing.T) {
+		tampered := append([]byte{}, blob[:MagicSize+2]...)
+		tampered[MagicSize+1] = byte(len("old"))
+		tampered = append(tampered, "old"...)
+		tampered = append(tampered, blob[MagicSize+2+len("new"):]...)
+		_, err := decrypt(tampered)
+		assert.ErrorIs(t, err, ErrCorrupted)
+	})
+
+	t.Run("Unknown key id", func(t *testing.T) {
+		tampered := append([]byte{}, blob...)
+		tampered[MagicSize+2] = 'x'
+		_, err := NewReader(bytes.NewReader(tampered), testKeyring)
+		assert.ErrorIs(t, err, ErrUnknownKeyId)
+	})
+
+	t.Run("Not segmented", func(t *testing.T) {
+		_, err := decrypt([]byte("legacy blob without header"))
+		assert.ErrorIs(t, err, ErrNotSegmented)
+	})
+}
+
+func TestStream_Version1(t *testing.T) {
+	// Version 1 blobs have no key id and are decrypted with legacy key
+	legacyKey, err := testKeyring.Key("")
+	assert.NoError(t, err)
+	gcm, err := newGCM(legacyKey)
+	assert.NoError(t, err)
+	h := header{segmentSize: 32, noncePrefix: make([]byte, noncePrefixSize)}
+	h.raw = append(append([]byte{}, magic...), 1, 0, 0, 0, 32)
+	h.raw = append(h.raw, h.noncePrefix...)
+	blob := gcm.Seal(append([]byte{}, h.raw...), h.nonce(0, true), []byte("version one"), h.raw)
+
+	r, err := NewReader(bytes.NewReader(blob), testKeyring)
+	assert.NoError(t, err)
+	assert.Equal(t, "", r.KeyId())
+	got, err := io.ReadAll(r)
+	assert.NoError(t, err)
+	assert.Equal(t, "version one", string(got))
+}
@@ -0,0 +1,173 @@
+package exif
+
+import (
+	"bytes"
+	"encoding/binary"
+	"hash/crc32"
+)
+
+var (
+	jpegSignature = []byte{0xFF, 0xD8}
+	pngSignature  = []byte("\x89PNG\r\n\x1a\n")
+	exifHeader    = []byte("Exif\x00\x00")
+)
+
+// segment is where TIFF structure of exif metadata, or a part of XMP metadata, is kept in an image file
+type segment struct {
+	start int
+	end   int
+	// chunk is start of the PNG chunk holding the segment, which is covered by a checksum, -1 for other formats
+	chunk int
+}
+
+// updateChecksum recomputes checksum of the PNG chunk holding segment after it is modified in place
+func (s segment) updateChecksum(content []byte) {
+	if s.chunk < 0 {
+		return
+	}
+	binary.BigEndian.PutUint32(content[s.end:], crc32.ChecksumIEEE(content[s.chunk+4:s.end]))
+}
+
+// locate finds exif metadata of a JPEG, PNG or WebP image
+func locate(content []byte) (segment, error) {
+	switch {
+	case bytes.HasPrefix(content, jpegSignature):
+		return locateJPEG(content)
+	case bytes.HasPrefix(content, pngSignature):
+		return locatePNG(content)
+	case len(content) >= 12 && string(content[:4]) == "RIFF" && string(content[8:12]) == "WEBP":
+		return locateWebP(content)
+	}
+	return segment{}, ErrNoExif
+}
+
+// locateJPEG finds the APP1 segment with exif header among segments before image data
+func locateJPEG(content []byte) (segment, error) {
+	seg := segment{chunk: -1}
+	err := walkJPEG(content, func(marker byte, start, end int) bool {
+		if marker == 0xE1 && bytes.HasPrefix(content[start:end], exifHeader) {
+			seg.start, seg.end = start+len(exifHeader), end
+			return true
+		}
+		return false
+	})
+	if err != nil {
+		return segment{}, err
+	}
+	if seg.end == 0 {
+		return segment{}, ErrNoExif
+	}
+	return seg, nil
+}
+
+// locatePNG finds the eXIf chunk, which holds exif metadata without a header
+func locatePNG(content []byte) (segment, error) {
+	seg := segment{}
+	err := walkPNG(content, func(chunk int, chunkType string, start, end int) bool {
+		if chunkType == "eXIf" {
+			seg = segment{start: start, end: end, chunk: chunk}
+			return true
+		}
+		return false
+	})
+	if err != nil {
+		return segment{}, err
+	}
+	if seg.end == 0 {
+		return segment{}, ErrNoExif
+	}
+	return seg, nil
+}
+
+// locateWebP finds the EXIF chunk of an extended WebP image, some writers keep exif header in it
+func locateWebP(content []byte) (segment, error) {
+	seg := segment{}
+	err := walkWebP(content, func(chunkType string, start, end int) bool {
+		if chunkType == "EXIF" {
+			if bytes.HasPrefix(content[start:end], exifHeader) {
+				start += len(exifHeader)
+			}
+			seg = segment{start: start, end: end, chunk: -1}
+			return true
+		}
+		return false
+	})
+	if err != nil {
+		return segment{}, err
+	}
+	if seg.end == 0 {
+		return segment{}, ErrNoExif
+	}
+	return seg, nil
+}
+
+// walkJPEG calls visit with marker and data range of segments before image data, until visit returns true
+func walkJPEG(content []byte, visit func(marker byte, start, end int) bool) error {
+	pos := len(jpegSignature)
+	for pos+4 <= len(content) {
+		if content[pos] != 0xFF {
+			return ErrMalformed
+		}
+		marker := content[pos+1]
+		switch {
+		case marker == 0xFF:
+			// Fill byte before a marker
+			pos++
+			continue
+		case marker == 0xDA || marker == 0xD9:
+			// Metadata only comes before start of scan
+			return nil
+		case marker == 0x01 || (marker >= 0xD0 && marker <= 0xD7):
+			// Markers without a length
+			pos += 2
+			continue
+		}
+
+		length := int(binary.BigEndian.Uint16(content[pos+2:]))
+		start, end := pos+4, pos+2+length
+		if length < 2 || end > len(content) {
+			return ErrMalformed
+		}
+		if visit(marker, start, end) {
+			return nil
+		}
+		pos = end
+	}
+	return nil
+}
+
+// walkPNG calls visit with start, type and data range of chunks before the last one, until visit returns true
+func walkPNG(content []byte, visit func(chunk int, chunkType string, start, end int) bool) error {
+	pos := len(pngSignature)
+	for pos+12 <= len(content) {
+		length := int(binary.BigEndian.Uint32(content[pos:]))
+		chunkType := string(content[pos+4 : pos+8])
+		start, end := pos+8, pos+8+length
+		if length < 0 || end+4 > len(content) {
+			return ErrMalformed
+		}
+		if chunkType == "IEND" || visit(pos, chunkType, start, end) {
+			return nil
+		}
+		pos = end + 4
+	}
+	return nil
+}
+
+// walkWebP calls visit with type and data range of chunks of a WebP image, until visit returns true
+func walkWebP(content []byte, visit func(chunkType string, start, end int) bool) error {
+	pos := 12
+	for pos+8 <= len(content) {
+		length := int(binary.LittleEndian.Uint32(content[pos+4:]))
+		start, end := pos+8, pos+8+length
+		if length < 0 || end > len(content) {
+			return ErrMalformed
+		}
+		if visit(string(content[pos:pos+4]), start, end) {
+			return nil
+		}
+		// Chunks are padded to an even size
+		pos = end + length%2
+	}
+	return nil
+}
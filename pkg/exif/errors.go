@@ -0,0 +1,7 @@
+package exif
+
+import "github.com/pkg/errors"
+
+var ErrNoExif = errors.New("image has no exif metadata")
+var ErrMalformed = errors.New("exif metadata is malformed")
+var ErrXMPLocation = errors.New("xmp metadata has gps location which can't be stripped")
@@ -0,0 +1,269 @@
+package exif
+
+import (
+	"bytes"
+	"encoding/binary"
+	"time"
+
+	"github.com/pkg/errors"
+)
+
+// Tags of exif metadata which are read
+const (
+	tagMake               = 0x010F
+	tagModel              = 0x0110
+	tagOrientation        = 0x0112
+	tagDateTime           = 0x0132
+	tagExifIFD            = 0x8769
+	tagGPSIFD             = 0x8825
+	tagDateTimeOriginal   = 0x9003
+	tagOffsetTimeOriginal = 0x9011
+)
+
+// Layouts of exif date times and their offset from UTC
+const (
+	dateTimeLayout = "2006:01:02 15:04:05"
+	offsetLayout   = "-07:00"
+)
+
+// typeSizes are sizes of values of exif field types in bytes by type id
+var typeSizes = map[uint16]int{
+	1: 1, 2: 1, 3: 2, 4: 4, 5: 8, 6: 1, 7: 1, 8: 2, 9: 4, 10: 8, 11: 4, 12: 8, 13: 4,
+}
+
+// Metadata holds exif fields of an image the store keeps
+type Metadata struct {
+	// Orientation tells how image should be rotated and flipped to be displayed, from 1 to 8 and zero when it is missing
+	Orientation int
+	Make        string
+	Model       string
+	// TakenAt is when photo was taken, in UTC unless the camera recorded its offset
+	TakenAt *time.Time
+	// HasGPS reports whether metadata has a GPS section with any fields
+	HasGPS bool
+}
+
+// Read parses exif metadata of a JPEG, PNG or WebP image, ErrNoExif is returned when it has none
+func Read(content []byte) (Metadata, error) {
+	seg, err := locate(content)
+	if err != nil {
+		return Metadata{}, err
+	}
+	t, err := newTiff(content[seg.start:seg.end])
+	if err != nil {
+		return Metadata{}, err
+	}
+	ifd0, err := t.ifd(t.firstIFD)
+	if err != nil {
+		return Metadata{}, err
+	}
+
+	var meta Metadata
+	var dateTime, dateTimeOriginal, offsetTimeOriginal string
+	for _, e := range ifd0 {
+		switch e.tag {
+		case tagMake:
+			meta.Make = t.string(e)
+		case tagModel:
+			meta.Model = t.string(e)
+		case tagOrientation:
+			if orientation := t.uint(e); orientation >= 1 && orientation <= 8 {
+				meta.Orientation = int(orientation)
+			}
+		case tagDateTime:
+			dateTime = t.string(e)
+		case tagGPSIFD:
+			gps, err := t.ifd(t.uint(e))
+			if err != nil {
+				return Metadata{}, err
+			}
+			meta.HasGPS = len(gps) > 0
+		case tagExifIFD:
+			exifIFD, err := t.ifd(t.uint(e))
+			if err != nil {
+				return Metadata{}, err
+			}
+			for _, e := range exifIFD {
+				switch e.tag {
+				case tagDateTimeOriginal:
+					dateTimeOriginal = t.string(e)
+				case tagOffsetTimeOriginal:
+					offsetTimeOriginal = t.string(e)
+				}
+			}
+		}
+	}
+
+	if dateTimeOriginal != "" {
+		meta.TakenAt = parseDateTime(dateTimeOriginal, offsetTimeOriginal)
+	} else if dateTime != "" {
+		meta.TakenAt = parseDateTime(dateTime, "")
+	}
+	return meta, nil
+}
+
+// StripGPS returns a copy of content with GPS section of its exif metadata and GPS properties of its XMP metadata cleared.
+// Cleared bytes are zeroed, or replaced by spaces in XMP, in place, so size of content and offsets of everything else are kept.
+// ErrXMPLocation is returned when XMP location can't be cleared in place.
+func StripGPS(content []byte) ([]byte, error) {
+	stripped := bytes.Clone(content)
+	err := stripExifGPS(stripped)
+	if err != nil && !errors.Is(err, ErrNoExif) {
+		return nil, err
+	}
+	err = stripXMPLocation(stripped)
+	if err != nil {
+		return nil, err
+	}
+	return stripped, nil
+}
+
+// stripExifGPS clears GPS section of exif metadata in place
+func stripExifGPS(content []byte) error {
+	seg, err := locate(content)
+	if err != nil {
+		return err
+	}
+	t, err := newTiff(content[seg.start:seg.end])
+	if err != nil {
+		return err
+	}
+	ifd0, err := t.ifd(t.firstIFD)
+	if err != nil {
+		return err
+	}
+
+	for _, e := range ifd0 {
+		if e.tag != tagGPSIFD {
+			continue
+		}
+		offset := t.uint(e)
+		gps, err := t.ifd(offset)
+		if err != nil {
+			return err
+		}
+		for _, field := range gps {
+			if start, end, ok := t.valueRange(field); ok && end-start > 4 {
+				clear(t.data[start:end])
+			}
+		}
+		// GPS section is left as an empty directory, so pointer to it stays valid
+		clear(t.data[int(offset) : int(offset)+2+len(gps)*12+4])
+	}
+	seg.updateChecksum(content)
+	return nil
+}
+
+func parseDateTime(value string, offset string) *time.Time {
+	location := time.UTC
+	if zone, err := time.Parse(offsetLayout, offset); err == nil {
+		_, seconds := zone.Zone()
+		location = time.FixedZone("", seconds)
+	}
+	takenAt, err := time.ParseInLocation(dateTimeLayout, value, location)
+	if err != nil {
+		return nil
+	}
+	return &takenAt
+}
+
+// tiff reads the TIFF structure exif metadata is kept in
+type tiff struct {
+	data     []byte
+	order    binary.ByteOrder
+	firstIFD uint32
+}
+
+// entry is a field of an image file directory
+type entry struct {
+	tag   uint16
+	kind  uint16
+	count uint32
+	// pos is position of entry in TIFF data
+	pos int
+}
+
+func newTiff(data []byte) (*tiff, error) {
+	if len(data) < 8 {
+		return nil, ErrMalformed
+	}
+	t := &tiff{data: data}
+	switch string(data[:2]) {
+	case "II":
+		t.order = binary.LittleEndian
+	case "MM":
+		t.order = binary.BigEndian
+	default:
+		return nil, ErrMalformed
+	}
+	if t.order.Uint16(data[2:]) != 42 {
+		return nil, ErrMalformed
+	}
+	t.firstIFD = t.order.Uint32(data[4:])
+	return t, nil
+}
+
+// ifd reads entries of the image file directory at offset
+func (t *tiff) ifd(offset uint32) ([]entry, error) {
+	start := int(offset)
+	if start < 8 || start+2 > len(t.data) {
+		return nil, ErrMalformed
+	}
+	count := int(t.order.Uint16(t.data[start:]))
+	if start+2+count*12+4 > len(t.data) {
+		return nil, ErrMalformed
+	}
+
+	entries := make([]entry, count)
+	for i := range entries {
+		pos := start + 2 + i*12
+		entries[i] = entry{
+			tag:   t.order.Uint16(t.data[pos:]),
+			kind:  t.order.Uint16(t.data[pos+2:]),
+			count: t.order.Uint32(t.data[pos+4:]),
+			pos:   pos,
+		}
+	}
+	return entries, nil
+}
+
+// valueRange returns where value of an entry is in TIFF data, values of up to 4 bytes are kept in the entry itself
+func (t *tiff) valueRange(e entry) (int, int, bool) {
+	size, ok := typeSizes[e.kind]
+	if !ok || uint64(e.count)*uint64(size) > uint64(len(t.data)) {
+		return 0, 0, false
+	}
+	length := int(e.count) * size
+	start := e.pos + 8
+	if length > 4 {
+		start = int(t.order.Uint32(t.data[e.pos+8:]))
+	}
+	if start+length > len(t.data) {
+		return 0, 0, false
+	}
+	return start, start + length, true
+}
+
+// string returns value of an ASCII entry without its terminating zeros
+func (t *tiff) string(e entry) string {
+	start, end, ok := t.valueRange(e)
+	if !ok || e.kind != 2 {
+		return ""
+	}
+	return string(bytes.TrimSpace(bytes.TrimRight(t.data[start:end], "\x00")))
+}
+
+// uint returns first value of a SHORT, LONG or IFD entry
+func (t *tiff) uint(e entry) uint32 {
+	start, _, ok := t.valueRange(e)
+	if !ok || e.count == 0 {
+		return 0
+	}
+	switch e.kind {
+	case 3:
+		return uint32(t.order.Uint16(t.data[start:]))
+	case 4, 13:
+		return t.order.Uint32(t.data[start:])
+	}
+	return 0
+}
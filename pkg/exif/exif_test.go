@@ -0,0 +1,170 @@
+package exif
+
+import (
+	"bytes"
+	"encoding/binary"
+	"hash/crc32"
+	"image"
+	"image/jpeg"
+	"image/png"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+)
+
+type testEntry struct {
+	tag   uint16
+	kind  uint16
+	count uint32
+	value []byte
+}
+
+func asciiEntry(tag uint16, value string) testEntry {
+	return testEntry{tag: tag, kind: 2, count: uint32(len(value) + 1), value: append([]byte(value), 0)}
+}
+
+func longEntry(tag uint16, value uint32) testEntry {
+	return testEntry{tag: tag, kind: 4, count: 1, value: binary.BigEndian.AppendUint32(nil, value)}
+}
+
+// appendIFD appends an image file directory and values of its entries to TIFF data and returns its offset
+func appendIFD(data []byte, entries []testEntry) ([]byte, uint32) {
+	offset := len(data)
+	valuesStart := offset + 2 + len(entries)*12 + 4
+	ifd := make([]byte, valuesStart-offset)
+	binary.BigEndian.PutUint16(ifd, uint16(len(entries)))
+	var values []byte
+	for i, e := range entries {
+		pos := 2 + i*12
+		binary.BigEndian.PutUint16(ifd[pos:], e.tag)
+		binary.BigEndian.PutUint16(ifd[pos+2:], e.kind)
+		binary.BigEndian.PutUint32(ifd[pos+4:], e.count)
+		if len(e.value) <= 4 {
+			copy(ifd[pos+8:], e.value)
+		} else {
+			binary.BigEndian.PutUint32(ifd[pos+8:], uint32(valuesStart+len(values)))
+			values = append(values, e.value...)
+		}
+	}
+	return append(append(data, ifd...), values...), uint32(offset)
+}
+
+// testExif returns big endian TIFF structure of exif metadata with camera, capture time, orientation and GPS location
+func testExif(t *testing.T) []byte {
+	t.Helper()
+	data := []byte{'M', 'M', 0, 42, 0, 0, 0, 0}
+	data, exifIFD := appendIFD(data, []testEntry{
+		asciiEntry(tagDateTimeOriginal, "2023:05:06 07:08:09"),
+		asciiEntry(tagOffsetTimeOriginal, "+03:30"),
+	})
+	latitude := make([]byte, 24)
+	for i, value := range []uint32{35, 1, 41, 1, 2024, 100} {
+		binary.BigEndian.PutUint32(latitude[i*4:], value)
+	}
+	data, gpsIFD := appendIFD(data, []testEntry{
+		asciiEntry(0x0001, "N"),
+		{tag: 0x0002, kind: 5, count: 3, value: latitude},
+	})
+	data, ifd0 := appendIFD(data, []testEntry{
+		asciiEntry(tagMake, "Canon"),
+		asciiEntry(tagModel, "EOS 5D"),
+		{tag: tagOrientation, kind: 3, count: 1, value: []byte{0, 6}},
+		asciiEntry(tagDateTime, "2024:01:01 00:00:00"),
+		longEntry(tagExifIFD, exifIFD),
+		longEntry(tagGPSIFD, gpsIFD),
+	})
+	binary.BigEndian.PutUint32(data[4:], ifd0)
+	return data
+}
+
+// jpegWithExif inserts an APP1 segment with exif metadata right after start of a JPEG image
+func jpegWithExif(t *testing.T, img image.Image, tiff []byte) []byte {
+	t.Helper()
+	var buf bytes.Buffer
+	assert.Nil(t, jpeg.Encode(&buf, img, nil))
+	content := buf.Bytes()
+
+	segment := []byte{0xFF, 0xE1, 0, 0}
+	binary.BigEndian.PutUint16(segment[2:], uint16(2+len(exifHeader)+len(tiff)))
+	segment = append(append(segment, exifHeader...), tiff...)
+	return append(append(append([]byte{}, content[:2]...), segment...), content[2:]...)
+}
+
+// pngWithExif inserts an eXIf chunk right after header chunk of a PNG image
+func pngWithExif(t *testing.T, img image.Image, tiff []byte) []byte {
+	var buf bytes.Buffer
+	assert.Nil(t, png.Encode(&buf, img))
+	content := buf.Bytes()
+
+	chunk := binary.BigEndian.AppendUint32(nil, uint32(len(tiff)))
+	chunk = append(append(chunk, "eXIf"...), tiff...)
+	chunk = binary.BigEndian.AppendUint32(chunk, crc32.ChecksumIEEE(chunk[4:]))
+	headerEnd := len(pngSignature) + 25
+	return append(append(append([]byte{}, content[:headerEnd]...), chunk...), content[headerEnd:]...)
+}
+
+func webpWithExif(tiff []byte) []byte {
+	chunk := append(append([]byte("EXIF"), binary.LittleEndian.AppendUint32(nil, uint32(len(exifHeader)+len(tiff)))...), exifHeader...)
+	chunk = append(chunk, tiff...)
+	if len(chunk)%2 == 1 {
+		chunk = append(chunk, 0)
+	}
+	content := append([]byte("RIFF"), binary.LittleEndian.AppendUint32(nil, uint32(4+len(chunk)))...)
+	return append(append(content, "WEBP"...), chunk...)
+}
+
+func TestRead(t *testing.T) {
+	img := image.NewGray(image.Rect(0, 0, 8, 4))
+	tiff := testExif(t)
+	takenAt := time.Date(2023, 5, 6, 7, 8, 9, 0, time.FixedZone("", 3*3600+30*60))
+
+	for name, content := range map[string][]byte{
+		"jpeg": jpegWithExif(t, img, tiff),
+		"png":  pngWithExif(t, img, tiff),
+		"webp": webpWithExif(tiff),
+	} {
+		t.Run(name, func(t *testing.T) {
+			meta, err := Read(content)
+			assert.Nil(t, err)
+			assert.Equal(t, 6, meta.Orientation)
+			assert.Equal(t, "Canon", meta.Make)
+			assert.Equal(t, "EOS 5D", meta.Model)
+			assert.True(t, meta.HasGPS)
+			if assert.NotNil(t, meta.TakenAt) {
+				assert.True(t, takenAt.Equal(*meta.TakenAt))
+			}
+
+			stripped, err := StripGPS(content)
+			assert.Nil(t, err)
+			assert.Len(t, stripped, len(content))
+			assert.False(t, bytes.Contains(stripped, []byte{0, 0, 0x07, 0xE8, 0, 0, 0, 100}))
+			meta, err = Read(stripped)
+			assert.Nil(t, err)
+			assert.False(t, meta.HasGPS)
+			assert.Equal(t, "Canon", meta.Make)
+			assert.Equal(t, 6, meta.Orientation)
+		})
+	}
+
+	t.Run("stripped png stays valid", func(t *testing.T) {
+		stripped, err := StripGPS(pngWithExif(t, img, tiff))
+		assert.Nil(t, err)
+		_, err = png.Decode(bytes.NewReader(stripped))
+		assert.Nil(t, err)
+	})
+	t.Run("no exif", func(t *testing.T) {
+		var buf bytes.Buffer
+		assert.Nil(t, png.Encode(&buf, img))
+		_, err := Read(buf.Bytes())
+		assert.Equal(t, ErrNoExif, err)
+		_, err = Read([]byte("plain text"))
+		assert.Equal(t, ErrNoExif, err)
+	})
+	t.Run("malformed", func(t *testing.T) {
+		broken := append([]byte{}, tiff...)
+		binary.BigEndian.PutUint32(broken[4:], 1<<20)
+		_, err := Read(jpegWithExif(t, img, broken))
+		assert.Equal(t, ErrMalformed, err)
+	})
+}
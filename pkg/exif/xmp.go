@@ -0,0 +1,334 @@
+package exif
+
+import (
+	"bytes"
+	"compress/zlib"
+	"encoding/binary"
+	"io"
+	"slices"
+)
+
+var (
+	xmpHeader          = []byte("http://ns.adobe.com/xap/1.0/\x00")
+	xmpExtensionHeader = []byte("http://ns.adobe.com/xmp/extension/\x00")
+)
+
+const (
+	// xmpKeyword is keyword of PNG text chunks which hold XMP metadata
+	xmpKeyword = "XML:com.adobe.xmp"
+	// exifNamespace is namespace of exif properties in XMP metadata, GPS location is kept by its GPS properties
+	exifNamespace = "http://ns.adobe.com/exif/1.0/"
+	// xmpExtensionInfoSize is size of GUID, full length and offset of packet before each part of extended XMP metadata
+	xmpExtensionInfoSize = 40
+	// maxXMPSize limits size of compressed XMP metadata once it is decompressed
+	maxXMPSize = 16 << 20
+)
+
+// xmpPacket is an XMP packet of an image file, which is kept in one segment or split over several
+type xmpPacket struct {
+	segments []segment
+	// compressed is set for zlib compressed PNG text, which can't be changed in place
+	compressed bool
+}
+
+// HasXMPLocation reports whether XMP metadata of a JPEG, PNG or WebP image has GPS location,
+// or its metadata can't be read to tell so
+func HasXMPLocation(content []byte) bool {
+	packets, err := locateXMP(content)
+	if err != nil {
+		return true
+	}
+	for _, packet := range packets {
+		_, locations, err := packet.locations(content)
+		if err != nil || len(locations) > 0 {
+			return true
+		}
+	}
+	return false
+}
+
+// stripXMPLocation blanks GPS properties of XMP metadata in place with spaces, which keeps the packet valid XML.
+// ErrXMPLocation is returned when they can't be blanked, such as in compressed PNG text.
+func stripXMPLocation(content []byte) error {
+	packets, err := locateXMP(content)
+	if err != nil {
+		return err
+	}
+	for _, packet := range packets {
+		text, locations, err := packet.locations(content)
+		if err != nil {
+			return err
+		}
+		if len(locations) == 0 {
+			continue
+		}
+		if packet.compressed {
+			return ErrXMPLocation
+		}
+		for _, location := range locations {
+			copy(text[location[0]:location[1]], bytes.Repeat([]byte{' '}, location[1]-location[0]))
+		}
+		// Packets split over segments are put back in the same parts
+		pos := 0
+		for _, seg := range packet.segments {
+			pos += copy(content[seg.start:seg.end], text[pos:])
+			seg.updateChecksum(content)
+		}
+	}
+	return nil
+}
+
+// locations returns text of packet and ranges of GPS properties in it
+func (p xmpPacket) locations(content []byte) ([]byte, [][2]int, error) {
+	parts := make([][]byte, 0, len(p.segments))
+	for _, seg := range p.segments {
+		parts = append(parts, content[seg.start:seg.end])
+	}
+	text := bytes.Join(parts, nil)
+	if p.compressed {
+		reader, err := zlib.NewReader(bytes.NewReader(text))
+		if err != nil {
+			return nil, nil, ErrXMPLocation
+		}
+		text, err = io.ReadAll(io.LimitReader(reader, maxXMPSize+1))
+		if err != nil || len(text) > maxXMPSize {
+			return nil, nil, ErrXMPLocation
+		}
+	}
+
+	locations, ok := xmpLocations(text)
+	if !ok {
+		return nil, nil, ErrXMPLocation
+	}
+	return text, locations, nil
+}
+
+// locateXMP finds XMP packets of a JPEG, PNG or WebP image
+func locateXMP(content []byte) ([]xmpPacket, error) {
+	switch {
+	case bytes.HasPrefix(content, jpegSignature):
+		return locateJPEGXMP(content)
+	case bytes.HasPrefix(content, pngSignature):
+		return locatePNGXMP(content)
+	case len(content) >= 12 && string(content[:4]) == "RIFF" && string(content[8:12]) == "WEBP":
+		var packets []xmpPacket
+		err := walkWebP(content, func(chunkType string, start, end int) bool {
+			if chunkType == "XMP " {
+				packets = append(packets, xmpPacket{segments: []segment{{start: start, end: end, chunk: -1}}})
+			}
+			return false
+		})
+		return packets, err
+	}
+	return nil, nil
+}
+
+// locateJPEGXMP finds APP1 segments with XMP metadata. Extended XMP metadata, which doesn't fit in a segment, is
+// split over several segments after its main packet, parts are put in order of their offset by GUID of their packet
+func locateJPEGXMP(content []byte) ([]xmpPacket, error) {
+	var packets []xmpPacket
+	extended := make(map[string][]segment)
+	malformed := false
+	err := walkJPEG(content, func(marker byte, start, end int) bool {
+		if marker != 0xE1 {
+			return false
+		}
+		data := content[start:end]
+		switch {
+		case bytes.HasPrefix(data, xmpHeader):
+			packets = append(packets, xmpPacket{segments: []segment{{start: start + len(xmpHeader), end: end, chunk: -1}}})
+		case bytes.HasPrefix(data, xmpExtensionHeader):
+			info := start + len(xmpExtensionHeader)
+			if info+xmpExtensionInfoSize > end {
+				malformed = true
+				return true
+			}
+			guid := string(content[info : info+32])
+			extended[guid] = append(extended[guid], segment{start: info + xmpExtensionInfoSize, end: end, chunk: -1})
+		}
+		return false
+	})
+	if err != nil {
+		return nil, err
+	}
+	if malformed {
+		return nil, ErrMalformed
+	}
+
+	for _, segments := range extended {
+		slices.SortFunc(segments, func(a, b segment) int {
+			return int(binary.BigEndian.Uint32(content[a.start-4:])) - int(binary.BigEndian.Uint32(content[b.start-4:]))
+		})
+		packets = append(packets, xmpPacket{segments: segments})
+	}
+	return packets, nil
+}
+
+// locatePNGXMP finds international or plain text chunks with XMP keyword
+func locatePNGXMP(content []byte) ([]xmpPacket, error) {
+	var packets []xmpPacket
+	malformed := false
+	err := walkPNG(content, func(chunk int, chunkType string, start, end int) bool {
+		if chunkType != "iTXt" && chunkType != "tEXt" {
+			return false
+		}
+		keyword, text, found := bytes.Cut(content[start:end], []byte{0})
+		if !found || string(keyword) != xmpKeyword {
+			return false
+		}
+		packet := xmpPacket{}
+		if chunkType == "iTXt" {
+			// Compression flag and method, then language and translated keyword come before text
+			if len(text) < 2 {
+				malformed = true
+				return true
+			}
+			packet.compressed = text[0] == 1
+			text = text[2:]
+			for i := 0; i < 2 && found; i++ {
+				_, text, found = bytes.Cut(text, []byte{0})
+			}
+			if !found {
+				malformed = true
+				return true
+			}
+		}
+		packet.segments = []segment{{start: end - len(text), end: end, chunk: chunk}}
+		packets = append(packets, packet)
+		return false
+	})
+	if err != nil {
+		return nil, err
+	}
+	if malformed {
+		return nil, ErrMalformed
+	}
+	return packets, nil
+}
+
+// xmpLocations returns ranges of GPS properties of exif namespace in an XMP packet, which are kept either as attributes
+// or as elements of a description. ok is false when a property can't be told apart from the rest of the packet.
+func xmpLocations(text []byte) ([][2]int, bool) {
+	var locations [][2]int
+	for _, prefix := range exifPrefixes(text) {
+		name := []byte(prefix + ":GPS")
+		pos := 0
+		for {
+			i := bytes.Index(text[pos:], name)
+			if i < 0 {
+				break
+			}
+			start := pos + i
+			pos = start + len(name)
+			for pos < len(text) && isXMLNameChar(text[pos]) {
+				pos++
+			}
+			if start == 0 {
+				continue
+			}
+
+			switch before := text[start-1]; {
+			case before == '<':
+				end := xmlElementEnd(text, start, pos)
+				if end < 0 {
+					return nil, false
+				}
+				locations = append(locations, [2]int{start - 1, end})
+				pos = end
+			case isXMLSpace(before):
+				// Names in text of other properties aren't followed by a value
+				if end := xmlAttributeEnd(text, pos); end >= 0 {
+					locations = append(locations, [2]int{start, end})
+					pos = end
+				}
+			}
+		}
+	}
+	return locations, true
+}
+
+// exifPrefixes returns prefixes bound to exif namespace in an XMP packet, exif is always included as it is the usual one
+func exifPrefixes(text []byte) []string {
+	prefixes := []string{"exif"}
+	declaration := []byte("xmlns:")
+	pos := 0
+	for {
+		i := bytes.Index(text[pos:], declaration)
+		if i < 0 {
+			return prefixes
+		}
+		start := pos + i + len(declaration)
+		pos = start
+		for pos < len(text) && isXMLNameChar(text[pos]) {
+			pos++
+		}
+		prefix := string(text[start:pos])
+		valueStart, valueEnd, ok := xmlAttributeValue(text, pos)
+		if ok && string(text[valueStart:valueEnd]) == exifNamespace && !slices.Contains(prefixes, prefix) {
+			prefixes = append(prefixes, prefix)
+		}
+	}
+}
+
+// xmlElementEnd returns end of an element whose name is at text[start:nameEnd], or -1 when it isn't closed
+func xmlElementEnd(text []byte, start, nameEnd int) int {
+	i := bytes.IndexByte(text[nameEnd:], '>')
+	if i < 0 {
+		return -1
+	}
+	tagEnd := nameEnd + i + 1
+	if text[tagEnd-2] == '/' {
+		return tagEnd
+	}
+	closing := append([]byte("</"), text[start:nameEnd]...)
+	i = bytes.Index(text[tagEnd:], closing)
+	if i < 0 {
+		return -1
+	}
+	closeEnd := tagEnd + i + len(closing)
+	i = bytes.IndexByte(text[closeEnd:], '>')
+	if i < 0 {
+		return -1
+	}
+	return closeEnd + i + 1
+}
+
+// xmlAttributeEnd returns end of value of an attribute whose name ends at pos, or -1 when no value follows it
+func xmlAttributeEnd(text []byte, pos int) int {
+	_, end, ok := xmlAttributeValue(text, pos)
+	if !ok {
+		return -1
+	}
+	// Closing quote follows the value
+	return end + 1
+}
+
+// xmlAttributeValue returns range of quoted value after equal sign of an attribute whose name ends at pos
+func xmlAttributeValue(text []byte, pos int) (int, int, bool) {
+	for pos < len(text) && isXMLSpace(text[pos]) {
+		pos++
+	}
+	if pos >= len(text) || text[pos] != '=' {
+		return 0, 0, false
+	}
+	pos++
+	for pos < len(text) && isXMLSpace(text[pos]) {
+		pos++
+	}
+	if pos >= len(text) || (text[pos] != '"' && text[pos] != '\'') {
+		return 0, 0, false
+	}
+	end := bytes.IndexByte(text[pos+1:], text[pos])
+	if end < 0 {
+		return 0, 0, false
+	}
+	return pos + 1, pos + 1 + end, true
+}
+
+func isXMLSpace(c byte) bool {
+	return c == ' ' || c == '\t' || c == '\n' || c == '\r'
+}
+
+func isXMLNameChar(c byte) bool {
+	return c >= 'a' && c <= 'z' || c >= 'A' && c <= 'Z' || c >= '0' && c <= '9' || c == '_' || c == '-' || c == '.'
+}
@@ -0,0 +1,180 @@
+package exif
+
+import (
+	"bytes"
+	"compress/zlib"
+	"encoding/binary"
+	"encoding/xml"
+	"hash/crc32"
+	"image"
+	"image/jpeg"
+	"image/png"
+	"io"
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+// testXMP is an XMP packet with GPS location both as attributes and elements, along with a property which is kept
+const testXMP = `<?xpacket begin="" id="W5M0MpCehiHzreSzNTczkc9d"?>
+<x:xmpmeta xmlns:x="adobe:ns:meta/">
+ <rdf:RDF xmlns:rdf="http://www.w3.org/1999/02/22-rdf-syntax-ns#">
+  <rdf:Description rdf:about="" xmlns:exif="http://ns.adobe.com/exif/1.0/" xmlns:xmp="http://ns.adobe.com/xap/1.0/"
+    xmlns:e="http://ns.adobe.com/exif/1.0/" exif:GPSLatitude="35,41.2024N" xmp:CreatorTool="Camera 1.0"
+    e:GPSAltitudeRef='0'>
+   <exif:GPSLongitude>51,23.1024E</exif:GPSLongitude>
+   <e:GPSAltitude>1191/1</e:GPSAltitude>
+   <exif:GPSMapDatum/>
+   <xmp:Label>exif:GPSLatitude is stripped</xmp:Label>
+  </rdf:Description>
+ </rdf:RDF>
+</x:xmpmeta>
+<?xpacket end="w"?>`
+
+// jpegWithXMP inserts APP1 segments with XMP metadata right after start of a JPEG image, a packet given in several parts
+// is kept as extended XMP metadata
+func jpegWithXMP(content []byte, parts ...string) []byte {
+	var segments []byte
+	offset := 0
+	for _, part := range parts {
+		data := append([]byte{}, xmpHeader...)
+		if len(parts) > 1 {
+			data = append(append([]byte{}, xmpExtensionHeader...), strings.Repeat("0", 32)...)
+			data = binary.BigEndian.AppendUint32(data, uint32(len(testXMP)))
+			data = binary.BigEndian.AppendUint32(data, uint32(offset))
+		}
+		data = append(data, part...)
+		offset += len(part)
+		segment := binary.BigEndian.AppendUint16([]byte{0xFF, 0xE1}, uint16(2+len(data)))
+		segments = append(segments, append(segment, data...)...)
+	}
+	return append(append(append([]byte{}, content[:2]...), segments...), content[2:]...)
+}
+
+// pngWithXMP inserts an iTXt chunk with XMP metadata, which is compressed when asked, right after header chunk of a PNG image
+func pngWithXMP(t *testing.T, img image.Image, packet string, compressed bool) []byte {
+	t.Helper()
+	var buf bytes.Buffer
+	assert.Nil(t, png.Encode(&buf, img))
+	content := buf.Bytes()
+
+	text := []byte(packet)
+	flag := byte(0)
+	if compressed {
+		var compressedText bytes.Buffer
+		writer := zlib.NewWriter(&compressedText)
+		_, err := writer.Write(text)
+		assert.Nil(t, err)
+		assert.Nil(t, writer.Close())
+		text, flag = compressedText.Bytes(), 1
+	}
+	data := append(append([]byte(xmpKeyword), 0, flag, 0, 0, 0), text...)
+	chunk := binary.BigEndian.AppendUint32(nil, uint32(len(data)))
+	chunk = append(append(chunk, "iTXt"...), data...)
+	chunk = binary.BigEndian.AppendUint32(chunk, crc32.ChecksumIEEE(chunk[4:]))
+	headerEnd := len(pngSignature) + 25
+	return append(append(append([]byte{}, content[:headerEnd]...), chunk...), content[headerEnd:]...)
+}
+
+func webpWithXMP(packet string) []byte {
+	chunk := append(append([]byte("XMP "), binary.LittleEndian.AppendUint32(nil, uint32(len(packet)))...), packet...)
+	if len(chunk)%2 == 1 {
+		chunk = append(chunk, 0)
+	}
+	content := append([]byte("RIFF"), binary.LittleEndian.AppendUint32(nil, uint32(4+len(chunk)))...)
+	return append(append(content, "WEBP"...), chunk...)
+}
+
+// assertValidXML asserts XMP packet in content is still well formed XML
+func assertValidXML(t *testing.T, content []byte) {
+	t.Helper()
+	start := bytes.Index(content, []byte("<x:xmpmeta"))
+	end := bytes.Index(content, []byte("</x:xmpmeta>"))
+	if !assert.True(t, start >= 0 && end > start) {
+		return
+	}
+	decoder := xml.NewDecoder(bytes.NewReader(content[start : end+len("</x:xmpmeta>")]))
+	for {
+		_, err := decoder.Token()
+		if err == io.EOF {
+			return
+		}
+		if !assert.Nil(t, err) {
+			return
+		}
+	}
+}
+
+func TestStripGPS_XMP(t *testing.T) {
+	img := image.NewGray(image.Rect(0, 0, 8, 4))
+	var buf bytes.Buffer
+	assert.Nil(t, jpeg.Encode(&buf, img, nil))
+	plainJPEG := buf.Bytes()
+	// Split in the middle of a GPS property, so it is only found once parts are joined
+	split := strings.Index(testXMP, "51,23")
+
+	for name, content := range map[string][]byte{
+		"jpeg":          jpegWithXMP(plainJPEG, testXMP),
+		"jpeg extended": jpegWithXMP(plainJPEG, testXMP[:split], testXMP[split:]),
+		"png":           pngWithXMP(t, img, testXMP, false),
+		"webp":          webpWithXMP(testXMP),
+	} {
+		t.Run(name, func(t *testing.T) {
+			_, err := Read(content)
+			assert.Equal(t, ErrNoExif, err)
+			assert.True(t, HasXMPLocation(content))
+
+			stripped, err := StripGPS(content)
+			assert.Nil(t, err)
+			assert.Len(t, stripped, len(content))
+			for _, location := range []string{"35,41.2024N", "51,23.1024E", "1191/1", "GPSAltitudeRef", "GPSMapDatum"} {
+				assert.False(t, bytes.Contains(stripped, []byte(location)), location)
+			}
+			// Other properties, and names of GPS properties in their text, are kept
+			assert.True(t, bytes.Contains(stripped, []byte(`xmp:CreatorTool="Camera 1.0"`)))
+			assert.True(t, bytes.Contains(stripped, []byte("<xmp:Label>exif:GPSLatitude is stripped</xmp:Label>")))
+			assert.False(t, HasXMPLocation(stripped))
+			if name != "jpeg extended" {
+				assertValidXML(t, stripped)
+			}
+		})
+	}
+
+	t.Run("exif and xmp", func(t *testing.T) {
+		content := jpegWithXMP(jpegWithExif(t, img, testExif(t)), testXMP)
+		stripped, err := StripGPS(content)
+		assert.Nil(t, err)
+		meta, err := Read(stripped)
+		assert.Nil(t, err)
+		assert.False(t, meta.HasGPS)
+		assert.Equal(t, "Canon", meta.Make)
+		assert.False(t, HasXMPLocation(stripped))
+		_, err = jpeg.Decode(bytes.NewReader(stripped))
+		assert.Nil(t, err)
+	})
+	t.Run("stripped png stays valid", func(t *testing.T) {
+		stripped, err := StripGPS(pngWithXMP(t, img, testXMP, false))
+		assert.Nil(t, err)
+		_, err = png.Decode(bytes.NewReader(stripped))
+		assert.Nil(t, err)
+	})
+	t.Run("compressed", func(t *testing.T) {
+		content := pngWithXMP(t, img, testXMP, true)
+		assert.True(t, HasXMPLocation(content))
+		_, err := StripGPS(content)
+		assert.Equal(t, ErrXMPLocation, err)
+	})
+	t.Run("no location", func(t *testing.T) {
+		packet := `<x:xmpmeta xmlns:x="adobe:ns:meta/"><rdf:RDF xmlns:rdf="http://www.w3.org/1999/02/22-rdf-syntax-ns#">` +
+			`<rdf:Description xmlns:exif="http://ns.adobe.com/exif/1.0/" exif:PixelXDimension="8"/></rdf:RDF></x:xmpmeta>`
+		assert.False(t, HasXMPLocation(jpegWithXMP(plainJPEG, packet)))
+		assert.False(t, HasXMPLocation(pngWithXMP(t, img, packet, true)))
+	})
+	t.Run("unclosed element", func(t *testing.T) {
+		content := webpWithXMP(`<rdf:Description><exif:GPSLatitude>35,41.2024N</rdf:Description>`)
+		assert.True(t, HasXMPLocation(content))
+		_, err := StripGPS(content)
+		assert.Equal(t, ErrXMPLocation, err)
+	})
+}
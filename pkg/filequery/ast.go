@@ -0,0 +1,104 @@
+package filequery
+
+import (
+	"fmt"
+	"strconv"
+	"strings"
+	"time"
+)
+
+// Expr is a node of parsed query, a boolean operator or a term matching files
+type Expr interface {
+	fmt.Stringer
+	expr()
+}
+
+// And matches files matched by both Left and Right
+type And struct {
+	Left  Expr
+	Right Expr
+}
+
+// Or matches files matched by Left or Right
+type Or struct {
+	Left  Expr
+	Right Expr
+}
+
+// Not matches files which are not matched by Expr
+type Not struct {
+	Expr Expr
+}
+
+// Tag matches files with tag Name
+type Tag struct {
+	Name string
+}
+
+// Name matches files whose name has Contains, ignoring case
+type Name struct {
+	Contains string
+}
+
+// Type matches files of media type Type, or of every subtype of Type when Prefix is set
+type Type struct {
+	Type   string
+	Prefix bool
+}
+
+// Size matches files whose size in bytes compares to Bytes by Op
+type Size struct {
+	Op    Op
+	Bytes int
+}
+
+// Created matches files stored before At, or at or after At when After is set
+type Created struct {
+	At    time.Time
+	After bool
+}
+
+// Op is comparison of a term
+type Op string
+
+const (
+	OpEQ  Op = "="
+	OpLT  Op = "<"
+	OpLTE Op = "<="
+	OpGT  Op = ">"
+	OpGTE Op = ">="
+)
+
+func (And) expr()     {}
+func (Or) expr()      {}
+func (Not) expr()     {}
+func (Tag) expr()     {}
+func (Name) expr()    {}
+func (Type) expr()    {}
+func (Size) expr()    {}
+func (Created) expr() {}
+
+func (e And) String() string { return "(" + e.Left.String() + " AND " + e.Right.String() + ")" }
+func (e Or) String() string  { return "(" + e.Left.String() + " OR " + e.Right.String() + ")" }
+func (e Not) String() string { return "NOT " + e.Expr.String() }
+func (e Tag) String() string { return "tag:" + e.Name }
+
+func (e Name) String() string {
+	return "name:" + strconv.Quote(e.Contains)
+}
+
+func (e Type) String() string {
+	if e.Prefix {
+		return "type:" + strings.TrimSuffix(e.Type, "/") + "/*"
+	}
+	return "type:" + e.Type
+}
+
+func (e Size) String() string { return "size" + string(e.Op) + strconv.Itoa(e.Bytes) }
+
+func (e Created) String() string {
+	if e.After {
+		return "after:" + e.At.Format(dateLayout)
+	}
+	return "before:" + e.At.Format(dateLayout)
+}
@@ -0,0 +1,6 @@
+package filequery
+
+import "github.com/pkg/errors"
+
+var ErrInvalidQuery = errors.New("invalid query")
+var ErrQueryTooComplex = errors.New("query is too complex")
@@ -0,0 +1,361 @@
+package filequery
+
+import (
+	"math"
+	"strconv"
+	"strings"
+	"time"
+	"unicode"
+
+	"github.com/lebleuciel/maani/pkg/helpers"
+	"github.com/pkg/errors"
+)
+
+// MaxTerms is the most terms a query can have, so queries can't build arbitrarily large database queries
+const MaxTerms = 32
+
+// MaxDepth is the most parentheses and NOT operators a term can be nested in, so parsing and the database query
+// built from it can't nest arbitrarily deep
+const MaxDepth = 16
+
+// MaxLength is the longest query in bytes
+const MaxLength = 1024
+
+// dateLayout is layout of dates of before and after terms, which are days in UTC
+const dateLayout = "2006-01-02"
+
+// sizeUnits are multipliers of size suffixes, the longest suffixes first so they are matched before shorter ones
+var sizeUnits = []struct {
+	suffix string
+	bytes  int
+}{
+	{"gb", 1 << 30},
+	{"mb", 1 << 20},
+	{"kb", 1 << 10},
+	{"b", 1},
+}
+
+type tokenKind int
+
+const (
+	tokenEOF tokenKind = iota
+	tokenWord
+	tokenAnd
+	tokenOr
+	tokenNot
+	tokenOpen
+	tokenClose
+)
+
+type token struct {
+	kind tokenKind
+	text string
+	pos  int
+}
+
+// Parse parses a query of terms joined by AND, OR and NOT, and grouped by parentheses.
+// Terms next to each other are joined by AND, which binds tighter than OR. Terms are:
+//
+//	cat                    files with tag cat, tag:cat is the same
+//	name:"summer trip"     files whose name has summer trip
+//	type:image/png         files of a media type, type:image matches every image
+//	size>100kb             files larger than 100 KiB, <, <=, >= and = compare the same way
+//	before:2026-01-01      files stored before a day, after matches files stored on or after it
+func Parse(input string) (Expr, error) {
+	if len(input) > MaxLength {
+		return nil, errors.Wrapf(ErrQueryTooComplex, "query should be at most %d characters long", MaxLength)
+	}
+	tokens, err := lex(input)
+	if err != nil {
+		return nil, err
+	}
+	p := parser{tokens: tokens}
+	if p.peek().kind == tokenEOF {
+		return nil, errors.Wrap(ErrInvalidQuery, "query is empty")
+	}
+	expr, err := p.parseOr()
+	if err != nil {
+		return nil, err
+	}
+	if t := p.peek(); t.kind != tokenEOF {
+		return nil, unexpected(t)
+	}
+	return expr, nil
+}
+
+type parser struct {
+	tokens []token
+	next   int
+	terms  int
+	// depth is number of parentheses and NOT operators around the next token
+	depth int
+}
+
+func (p *parser) peek() token {
+	return p.tokens[p.next]
+}
+
+func (p *parser) consume() token {
+	t := p.tokens[p.next]
+	if t.kind != tokenEOF {
+		p.next++
+	}
+	return t
+}
+
+func (p *parser) parseOr() (Expr, error) {
+	left, err := p.parseAnd()
+	if err != nil {
+		return nil, err
+	}
+	for p.peek().kind == tokenOr {
+		p.consume()
+		right, err := p.parseAnd()
+		if err != nil {
+			return nil, err
+		}
+		left = Or{Left: left, Right: right}
+	}
+	return left, nil
+}
+
+func (p *parser) parseAnd() (Expr, error) {
+	left, err := p.parseNot()
+	if err != nil {
+		return nil, err
+	}
+	for {
+		switch p.peek().kind {
+		case tokenAnd:
+			p.consume()
+		case tokenWord, tokenNot, tokenOpen:
+			// Terms next to each other are joined by AND
+		default:
+			return left, nil
+		}
+		right, err := p.parseNot()
+		if err != nil {
+			return nil, err
+		}
+		left = And{Left: left, Right: right}
+	}
+}
+
+// nest enters a group or NOT operator, leave must be called once it is parsed
+func (p *parser) nest() error {
+	p.depth++
+	if p.depth > MaxDepth {
+		return errors.Wrapf(ErrQueryTooComplex, "terms can be nested in at most %d parentheses and NOT operators", MaxDepth)
+	}
+	return nil
+}
+
+func (p *parser) leave() {
+	p.depth--
+}
+
+func (p *parser) parseNot() (Expr, error) {
+	if p.peek().kind == tokenNot {
+		p.consume()
+		if err := p.nest(); err != nil {
+			return nil, err
+		}
+		defer p.leave()
+		expr, err := p.parseNot()
+		if err != nil {
+			return nil, err
+		}
+		return Not{Expr: expr}, nil
+	}
+	return p.parsePrimary()
+}
+
+func (p *parser) parsePrimary() (Expr, error) {
+	t := p.consume()
+	switch t.kind {
+	case tokenOpen:
+		if err := p.nest(); err != nil {
+			return nil, err
+		}
+		defer p.leave()
+		expr, err := p.parseOr()
+		if err != nil {
+			return nil, err
+		}
+		if closing := p.consume(); closing.kind != tokenClose {
+			return nil, unexpected(closing)
+		}
+		return expr, nil
+	case tokenWord:
+		p.terms++
+		if p.terms > MaxTerms {
+			return nil, errors.Wrapf(ErrQueryTooComplex, "at most %d terms are allowed", MaxTerms)
+		}
+		return parseTerm(t)
+	default:
+		return nil, unexpected(t)
+	}
+}
+
+// parseTerm parses a word into a term, words without a field are tags
+func parseTerm(t token) (Expr, error) {
+	i := strings.IndexAny(t.text, ":<>=")
+	if i < 0 {
+		return parseTag(t, t.text)
+	}
+	field := t.text[:i]
+	op, value := splitOp(t.text[i:])
+
+	switch field {
+	case "tag", "name", "type", "before", "after":
+		if op != ":" {
+			return nil, invalidTerm(t, field+" only supports :")
+		}
+	case "size":
+		if op == ":" {
+			op = string(OpEQ)
+		}
+	default:
+		return nil, invalidTerm(t, "unknown field "+strconv.Quote(field))
+	}
+	if value == "" {
+		return nil, invalidTerm(t, field+" needs a value")
+	}
+
+	switch field {
+	case "tag":
+		return parseTag(t, value)
+	case "name":
+		return Name{Contains: value}, nil
+	case "type":
+		return parseType(value), nil
+	case "size":
+		bytes, err := parseSize(value)
+		if err != nil {
+			return nil, invalidTerm(t, "size should be a number of b, kb, mb or gb")
+		}
+		return Size{Op: Op(op), Bytes: bytes}, nil
+	default:
+		at, err := time.Parse(dateLayout, value)
+		if err != nil {
+			return nil, invalidTerm(t, field+" should be a date such as 2026-01-02")
+		}
+		return Created{At: at, After: field == "after"}, nil
+	}
+}
+
+func parseTag(t token, value string) (Expr, error) {
+	name, err := helpers.NormalizeTag(value)
+	if err != nil {
+		return nil, invalidTerm(t, err.Error())
+	}
+	return Tag{Name: name}, nil
+}
+
+func parseType(value string) Type {
+	value = strings.ToLower(value)
+	value, wildcard := strings.CutSuffix(value, "/*")
+	if wildcard || !strings.Contains(value, "/") {
+		return Type{Type: value + "/", Prefix: true}
+	}
+	return Type{Type: value}
+}
+
+func parseSize(value string) (int, error) {
+	value = strings.ToLower(value)
+	unit := 1
+	for _, u := range sizeUnits {
+		if number, found := strings.CutSuffix(value, u.suffix); found {
+			value, unit = number, u.bytes
+			break
+		}
+	}
+	number, err := strconv.ParseFloat(value, 64)
+	if err != nil || math.IsNaN(number) || number < 0 {
+		return 0, ErrInvalidQuery
+	}
+	// Infinite sizes and sizes which don't fit in an int are refused, as converting them to int is undefined
+	size := number * float64(unit)
+	if size >= math.MaxInt {
+		return 0, ErrInvalidQuery
+	}
+	return int(size), nil
+}
+
+// splitOp splits comparison at start of text from its value
+func splitOp(text string) (string, string) {
+	for _, op := range []string{">=", "<=", ":", "=", ">", "<"} {
+		if value, found := strings.CutPrefix(text, op); found {
+			return op, value
+		}
+	}
+	return "", text
+}
+
+// lex splits input into words, parentheses and operators. Operators are only uppercase so lowercase tags can be
+// the same words, and quoted parts of words may have spaces and parentheses, such as name:"summer trip".
+func lex(input string) ([]token, error) {
+	var tokens []token
+	runes := []rune(input)
+	for i := 0; i < len(runes); {
+		switch r := runes[i]; {
+		case unicode.IsSpace(r):
+			i++
+		case r == '(':
+			tokens = append(tokens, token{kind: tokenOpen, text: "(", pos: i})
+			i++
+		case r == ')':
+			tokens = append(tokens, token{kind: tokenClose, text: ")", pos: i})
+			i++
+		default:
+			start := i
+			quoted := false
+			var word strings.Builder
+			for i < len(runes) && !unicode.IsSpace(runes[i]) && runes[i] != '(' && runes[i] != ')' {
+				if runes[i] != '"' {
+					word.WriteRune(runes[i])
+					i++
+					continue
+				}
+				quoted = true
+				end := i + 1
+				for end < len(runes) && runes[end] != '"' {
+					end++
+				}
+				if end == len(runes) {
+					return nil, errors.Wrapf(ErrInvalidQuery, "unterminated quote at position %d", i+1)
+				}
+				word.WriteString(string(runes[i+1 : end]))
+				i = end + 1
+			}
+			tokens = append(tokens, wordToken(word.String(), start, quoted))
+		}
+	}
+	return append(tokens, token{kind: tokenEOF, pos: len(runes)}), nil
+}
+
+func wordToken(text string, pos int, quoted bool) token {
+	kind := tokenWord
+	if !quoted {
+		switch text {
+		case "AND":
+			kind = tokenAnd
+		case "OR":
+			kind = tokenOr
+		case "NOT":
+			kind = tokenNot
+		}
+	}
+	return token{kind: kind, text: text, pos: pos}
+}
+
+func unexpected(t token) error {
+	if t.kind == tokenEOF {
+		return errors.Wrap(ErrInvalidQuery, "unexpected end of query")
+	}
+	return errors.Wrapf(ErrInvalidQuery, "unexpected %q at position %d", t.text, t.pos+1)
+}
+
+func invalidTerm(t token, reason string) error {
+	return errors.Wrapf(ErrInvalidQuery, "%s at position %d", reason, t.pos+1)
+}
@@ -0,0 +1,134 @@
+package filequery
+
+import (
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestParse(t *testing.T) {
+	tests := []struct {
+		name  string
+		input string
+		want  string
+	}{
+		{"tag", "Cat", "tag:cat"},
+		{"explicit_tag", "tag:cat", "tag:cat"},
+		{"implicit_and", "cat dog", "(tag:cat AND tag:dog)"},
+		{"and_binds_tighter_than_or", "cat OR dog AND bird", "(tag:cat OR (tag:dog AND tag:bird))"},
+		{"parentheses", "cat AND (black OR white) AND NOT kitten", "((tag:cat AND (tag:black OR tag:white)) AND NOT tag:kitten)"},
+		{"lowercase_operators_are_tags", "cat and dog", "((tag:cat AND tag:and) AND tag:dog)"},
+		{"quoted_operator_is_tag", `"OR"`, "tag:or"},
+		{"double_not", "NOT NOT cat", "NOT NOT tag:cat"},
+		{"name", `name:"summer trip.jpg"`, `name:"summer trip.jpg"`},
+		{"type", "type:image/png", "type:image/png"},
+		{"type_prefix", "type:Image", "type:image/*"},
+		{"type_wildcard", "type:image/*", "type:image/*"},
+		{"size", "size>100kb", "size>102400"},
+		{"size_equal", "size:1.5mb", "size=1572864"},
+		{"size_at_most", "size<=10", "size<=10"},
+		{"size_near_max_int", "size<8000000000gb", "size<8589934592000000000"},
+		{"dates", "after:2025-01-01 before:2026-01-01", "(after:2025-01-01 AND before:2026-01-01)"},
+		{
+			"full",
+			"cat AND (black OR white) AND NOT kitten type:image/png size>100kb before:2026-01-01",
+			"(((((tag:cat AND (tag:black OR tag:white)) AND NOT tag:kitten) AND type:image/png) AND size>102400) AND before:2026-01-01)",
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			expr, err := Parse(tt.input)
+			assert.Nil(t, err)
+			if err == nil {
+				assert.Equal(t, tt.want, expr.String())
+			}
+		})
+	}
+}
+
+func TestParse_Terms(t *testing.T) {
+	expr, err := Parse("before:2026-01-02")
+	assert.Nil(t, err)
+	assert.Equal(t, Created{At: time.Date(2026, 1, 2, 0, 0, 0, 0, time.UTC)}, expr)
+
+	expr, err = Parse("size>=2gb")
+	assert.Nil(t, err)
+	assert.Equal(t, Size{Op: OpGTE, Bytes: 2 << 30}, expr)
+}
+
+func TestParse_Invalid(t *testing.T) {
+	tests := []struct {
+		name  string
+		input string
+	}{
+		{"empty", "  "},
+		{"unclosed_parenthesis", "(cat OR dog"},
+		{"unopened_parenthesis", "cat)"},
+		{"empty_parentheses", "()"},
+		{"dangling_operator", "cat AND"},
+		{"leading_operator", "OR cat"},
+		{"unterminated_quote", `name:"summer`},
+		{"unknown_field", "color:red"},
+		{"missing_value", "name:"},
+		{"invalid_tag", "a"},
+		{"invalid_size", "size>big"},
+		{"negative_size", "size>-1"},
+		{"nan_size", "size>nan"},
+		{"infinite_size", "size<inf"},
+		{"infinite_size_with_unit", "size<+Infkb"},
+		{"too_large_size", "size>1e300"},
+		{"too_large_size_with_unit", "size>9000000000gb"},
+		{"size_of_max_int", "size>9223372036854775807"},
+		{"invalid_date", "before:yesterday"},
+		{"comparison_of_tag", "tag>cat"},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			_, err := Parse(tt.input)
+			assert.ErrorIs(t, err, ErrInvalidQuery)
+		})
+	}
+}
+
+func TestParse_TooComplex(t *testing.T) {
+	_, err := Parse(strings.Repeat("cat ", MaxTerms))
+	assert.Nil(t, err)
+
+	_, err = Parse(strings.Repeat("cat ", MaxTerms+1))
+	assert.ErrorIs(t, err, ErrQueryTooComplex)
+}
+
+func TestParse_TooLong(t *testing.T) {
+	query := `name:"` + strings.Repeat("c", MaxLength-len(`name:""`)) + `"`
+	_, err := Parse(query)
+	assert.Nil(t, err)
+
+	_, err = Parse(query + " ")
+	assert.ErrorIs(t, err, ErrQueryTooComplex)
+}
+
+func TestParse_TooDeep(t *testing.T) {
+	tests := []struct {
+		name   string
+		nested func(depth int) string
+	}{
+		{"parentheses", func(depth int) string { return strings.Repeat("(", depth) + "cat" + strings.Repeat(")", depth) }},
+		{"not", func(depth int) string { return strings.Repeat("NOT ", depth) + "cat" }},
+		{"mixed", func(depth int) string { return strings.Repeat("(NOT ", depth/2) + "cat" + strings.Repeat(")", depth/2) }},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			_, err := Parse(tt.nested(MaxDepth))
+			assert.Nil(t, err)
+
+			_, err = Parse(tt.nested(MaxDepth + 2))
+			assert.ErrorIs(t, err, ErrQueryTooComplex)
+		})
+	}
+
+	// Groups next to each other aren't nested
+	_, err := Parse(strings.Repeat("(cat) ", MaxTerms))
+	assert.Nil(t, err)
+}
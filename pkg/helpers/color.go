@@ -0,0 +1,47 @@
+package helpers
+
+import (
+	"fmt"
+	"image"
+	"image/color"
+
+	"github.com/nfnt/resize"
+)
+
+// DominantColor returns the most common color of an image as #rrggbb.
+// The image is shrunk to at most 64x64 pixels, whose colors are grouped by their 4 high bits of each channel,
+// and the average color of the largest group is returned. Transparent pixels are not counted.
+func DominantColor(img image.Image) string {
+	small := resize.Thumbnail(64, 64, img, resize.Bilinear)
+	bounds := small.Bounds()
+
+	type bucket struct {
+		count   int
+		r, g, b int
+	}
+	buckets := make(map[uint16]*bucket)
+	var largest *bucket
+	for y := bounds.Min.Y; y < bounds.Max.Y; y++ {
+		for x := bounds.Min.X; x < bounds.Max.X; x++ {
+			c := color.NRGBAModel.Convert(small.At(x, y)).(color.NRGBA)
+			if c.A < 128 {
+				continue
+			}
+			key := uint16(c.R>>4)<<8 | uint16(c.G>>4)<<4 | uint16(c.B>>4)
+			b, ok := buckets[key]
+			if !ok {
+				b = &bucket{}
+				buckets[key] = b
+			}
+			b.count++
+			b.r, b.g, b.b = b.r+int(c.R), b.g+int(c.G), b.b+int(c.B)
+			if largest == nil || b.count > largest.count {
+				largest = b
+			}
+		}
+	}
+	if largest == nil {
+		return ""
+	}
+	return fmt.Sprintf("#%02x%02x%02x", largest.r/largest.count, largest.g/largest.count, largest.b/largest.count)
+}
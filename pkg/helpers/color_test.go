@@ -0,0 +1,23 @@
+package helpers
+
+import (
+	"image"
+	"image/color"
+	"image/draw"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestDominantColor(t *testing.T) {
+	img := image.NewNRGBA(image.Rect(0, 0, 100, 100))
+	draw.Draw(img, img.Bounds(), image.NewUniform(color.NRGBA{R: 0x20, G: 0x40, B: 0xc0, A: 255}), image.Point{}, draw.Src)
+	draw.Draw(img, image.Rect(0, 0, 30, 100), image.NewUniform(color.NRGBA{R: 0xff, A: 255}), image.Point{}, draw.Src)
+	assert.Equal(t, "#2040c0", DominantColor(img))
+
+	// Transparent pixels are not counted
+	draw.Draw(img, image.Rect(30, 0, 100, 100), image.Transparent, image.Point{}, draw.Src)
+	assert.Equal(t, "#ff0000", DominantColor(img))
+	draw.Draw(img, img.Bounds(), image.Transparent, image.Point{}, draw.Src)
+	assert.Equal(t, "", DominantColor(img))
+}
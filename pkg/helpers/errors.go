@@ -0,0 +1,13 @@
+package helpers
+
+import "github.com/pkg/errors"
+
+var ErrUnsupportedScheme = errors.New("url scheme is not allowed, only http, https and data are supported")
+var ErrBlockedAddress = errors.New("remote address is in a blocked network range")
+var ErrTooLarge = errors.New("remote file is larger than allowed size")
+var ErrNotImage = errors.New("remote file is not an image")
+var ErrTooManyRedirects = errors.New("remote file has too many redirects")
+var ErrInvalidDataUri = errors.New("data uri is not valid")
+var ErrChecksumMismatch = errors.New("file content does not match its checksum")
+var ErrUnsupportedImageFormat = errors.New("image format can not be encoded")
+var ErrInvalidTag = errors.New("tags should be 2 to 64 characters long, without spaces or commas")
@@ -0,0 +1,207 @@
+package helpers
+
+import (
+	"bytes"
+	"context"
+	"encoding/base64"
+	"fmt"
+	"io"
+	"net"
+	"net/http"
+	"net/url"
+	"path"
+	"strings"
+	"syscall"
+	"time"
+)
+
+// cgnatNetwork is the shared address space used by carrier-grade NAT, which is not covered by net.IP.IsPrivate
+var cgnatNetwork = &net.IPNet{IP: net.IPv4(100, 64, 0, 0), Mask: net.CIDRMask(10, 32)}
+
+// FetcherOptions configures safeguards of a Fetcher
+type FetcherOptions struct {
+	// MaxBytes is the largest body accepted, enforced while streaming
+	MaxBytes int64
+	// ConnectTimeout limits dialing remote host
+	ConnectTimeout time.Duration
+	// ReadTimeout limits waiting for response headers and reading the whole response
+	ReadTimeout time.Duration
+	// MaxRedirects is the number of redirects followed before giving up
+	MaxRedirects int
+	// AllowPrivateNetworks disables blocking of private, loopback and link-local addresses
+	AllowPrivateNetworks bool
+	// AllowType decides whether a sniffed image type with given size can be stored
+	AllowType func(contentType string, size int64) error
+}
+
+// FetchedFile is a remote file which passed all fetcher checks
+type FetchedFile struct {
+	Content []byte
+	// Name is the base name of url path, empty for data uris
+	Name string
+	Size int64
+	Type string
+}
+
+// Fetcher downloads remote images on behalf of users without exposing internal networks
+type Fetcher struct {
+	opts   FetcherOptions
+	client *http.Client
+}
+
+func NewFetcher(opts FetcherOptions) *Fetcher {
+	dialer := &net.Dialer{
+		Timeout: opts.ConnectTimeout,
+		Control: func(network, address string, _ syscall.RawConn) error {
+			// Control runs after DNS resolution, so address always holds the ip actually dialed
+			if opts.AllowPrivateNetworks {
+				return nil
+			}
+			host, _, err := net.SplitHostPort(address)
+			if err != nil {
+				return err
+			}
+			if IsBlockedIP(net.ParseIP(host)) {
+				return ErrBlockedAddress
+			}
+			return nil
+		},
+	}
+	transport := &http.Transport{
+		// Proxies would dial on our behalf and bypass address checks
+		Proxy:                 nil,
+		DialContext:           dialer.DialContext,
+		TLSHandshakeTimeout:   opts.ConnectTimeout,
+		ResponseHeaderTimeout: opts.ReadTimeout,
+	}
+	client := &http.Client{
+		Transport: transport,
+		CheckRedirect: func(req *http.Request, via []*http.Request) error {
+			if len(via) > opts.MaxRedirects {
+				return ErrTooManyRedirects
+			}
+			if req.URL.Scheme != "http" && req.URL.Scheme != "https" {
+				return ErrUnsupportedScheme
+			}
+			return nil
+		},
+	}
+	if opts.ConnectTimeout > 0 && opts.ReadTimeout > 0 {
+		client.Timeout = opts.ConnectTimeout + opts.ReadTimeout
+	}
+	return &Fetcher{
+		opts:   opts,
+		client: client,
+	}
+}
+
+// IsBlockedIP reports whether ip belongs to a network which must not be reached from user supplied urls
+func IsBlockedIP(ip net.IP) bool {
+	if ip == nil {
+		return true
+	}
+	return ip.IsPrivate() ||
+		ip.IsLoopback() ||
+		ip.IsLinkLocalUnicast() ||
+		ip.IsLinkLocalMulticast() ||
+		ip.IsInterfaceLocalMulticast() ||
+		ip.IsMulticast() ||
+		ip.IsUnspecified() ||
+		cgnatNetwork.Contains(ip)
+}
+
+// Fetch downloads an image from http, https or data url and validates its content
+func (f *Fetcher) Fetch(ctx context.Context, rawUrl string) (FetchedFile, error) {
+	u, err := url.Parse(rawUrl)
+	if err != nil {
+		return FetchedFile{}, err
+	}
+
+	var content []byte
+	var name string
+	switch u.Scheme {
+	case "http", "https":
+		content, err = f.fetchHttp(ctx, u)
+		name = path.Base(u.Path)
+	case "data":
+		content, err = f.decodeDataUri(rawUrl)
+	default:
+		return FetchedFile{}, ErrUnsupportedScheme
+	}
+	if err != nil {
+		return FetchedFile{}, err
+	}
+
+	fileType := DetectMediaType("", content)
+	if !strings.HasPrefix(fileType, "image/") {
+		return FetchedFile{}, ErrNotImage
+	}
+	size := int64(len(content))
+	if f.opts.AllowType != nil {
+		err = f.opts.AllowType(fileType, size)
+		if err != nil {
+			return FetchedFile{}, err
+		}
+	}
+
+	return FetchedFile{
+		Content: content,
+		Name:    name,
+		Size:    size,
+		Type:    fileType,
+	}, nil
+}
+
+func (f *Fetcher) fetchHttp(ctx context.Context, u *url.URL) ([]byte, error) {
+	req, err := http.NewRequestWithContext(ctx, http.MethodGet, u.String(), nil)
+	if err != nil {
+		return nil, err
+	}
+	resp, err := f.client.Do(req)
+	if err != nil {
+		return nil, err
+	}
+	defer resp.Body.Close()
+
+	if resp.StatusCode < 200 || resp.StatusCode >= 300 {
+		return nil, fmt.Errorf("remote file responded with status %d", resp.StatusCode)
+	}
+	if f.opts.MaxBytes > 0 && resp.ContentLength > f.opts.MaxBytes {
+		return nil, ErrTooLarge
+	}
+	return f.readLimited(resp.Body)
+}
+
+// decodeDataUri decodes base64 and percent-encoded data uris, such as inlined thumbnails of search result pages
+func (f *Fetcher) decodeDataUri(rawUrl string) ([]byte, error) {
+	header, payload, found := strings.Cut(strings.TrimPrefix(rawUrl, "data:"), ",")
+	if !found {
+		return nil, ErrInvalidDataUri
+	}
+	if strings.HasSuffix(header, ";base64") {
+		// Inlined thumbnails are sometimes wrapped or missing their padding
+		payload = strings.TrimRight(strings.Join(strings.Fields(payload), ""), "=")
+		return f.readLimited(base64.NewDecoder(base64.RawStdEncoding, strings.NewReader(payload)))
+	}
+	decoded, err := url.PathUnescape(payload)
+	if err != nil {
+		return nil, ErrInvalidDataUri
+	}
+	return f.readLimited(strings.NewReader(decoded))
+}
+
+// readLimited reads r and fails as soon as more than MaxBytes are read
+func (f *Fetcher) readLimited(r io.Reader) ([]byte, error) {
+	if f.opts.MaxBytes <= 0 {
+		return io.ReadAll(r)
+	}
+	var buf bytes.Buffer
+	n, err := io.Copy(&buf, io.LimitReader(r, f.opts.MaxBytes+1))
+	if err != nil {
+		return nil, err
+	}
+	if n > f.opts.MaxBytes {
+		return nil, ErrTooLarge
+	}
+	return buf.Bytes(), nil
+}
@@ -0,0 +1,117 @@
+package helpers
+
+import (
+	"bytes"
+	"context"
+	"encoding/base64"
+	"errors"
+	"image"
+	"image/png"
+	"net"
+	"net/http"
+	"net/http/httptest"
+	"net/url"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func pngBytes(t *testing.T) []byte {
+	var buf bytes.Buffer
+	assert.Nil(t, png.Encode(&buf, image.NewRGBA(image.Rect(0, 0, 8, 8))))
+	return buf.Bytes()
+}
+
+func newImageHost(t *testing.T) *httptest.Server {
+	img := pngBytes(t)
+	mux := http.NewServeMux()
+	mux.HandleFunc("/cat.png", func(w http.ResponseWriter, r *http.Request) {
+		w.Write(img)
+	})
+	mux.HandleFunc("/page.html", func(w http.ResponseWriter, r *http.Request) {
+		w.Write([]byte("<html><body>not an image</body></html>"))
+	})
+	mux.HandleFunc("/huge.png", func(w http.ResponseWriter, r *http.Request) {
+		// no content length, so the limit must be enforced while streaming
+		w.(http.Flusher).Flush()
+		w.Write(img)
+		w.Write(make([]byte, 4096))
+	})
+	mux.HandleFunc("/redirect", func(w http.ResponseWriter, r *http.Request) {
+		http.Redirect(w, r, "/cat.png", http.StatusFound)
+	})
+	srv := httptest.NewServer(mux)
+	t.Cleanup(srv.Close)
+	return srv
+}
+
+func TestFetcher_Fetch(t *testing.T) {
+	srv := newImageHost(t)
+	opts := FetcherOptions{
+		MaxBytes:             1024,
+		ConnectTimeout:       time.Second,
+		ReadTimeout:          time.Second,
+		MaxRedirects:         1,
+		AllowPrivateNetworks: true,
+	}
+
+	t.Run("image", func(t *testing.T) {
+		file, err := NewFetcher(opts).Fetch(context.Background(), srv.URL+"/cat.png")
+		assert.Nil(t, err)
+		assert.Equal(t, "image/png", file.Type)
+		assert.Equal(t, "cat.png", file.Name)
+		assert.Equal(t, int64(len(file.Content)), file.Size)
+	})
+	t.Run("redirect", func(t *testing.T) {
+		_, err := NewFetcher(opts).Fetch(context.Background(), srv.URL+"/redirect")
+		assert.Nil(t, err)
+	})
+	t.Run("not_image", func(t *testing.T) {
+		_, err := NewFetcher(opts).Fetch(context.Background(), srv.URL+"/page.html")
+		assert.Equal(t, ErrNotImage, err)
+	})
+	t.Run("too_large", func(t *testing.T) {
+		_, err := NewFetcher(opts).Fetch(context.Background(), srv.URL+"/huge.png")
+		assert.Equal(t, ErrTooLarge, err)
+	})
+	t.Run("type_not_allowed", func(t *testing.T) {
+		banned := errors.New("banned")
+		o := opts
+		o.AllowType = func(contentType string, size int64) error { return banned }
+		_, err := NewFetcher(o).Fetch(context.Background(), srv.URL+"/cat.png")
+		assert.Equal(t, banned, err)
+	})
+	t.Run("unsupported_scheme", func(t *testing.T) {
+		_, err := NewFetcher(opts).Fetch(context.Background(), "file:///etc/passwd")
+		assert.Equal(t, ErrUnsupportedScheme, err)
+	})
+	t.Run("data_uri", func(t *testing.T) {
+		uri := "data:image/png;base64," + base64.StdEncoding.EncodeToString(pngBytes(t))
+		file, err := NewFetcher(opts).Fetch(context.Background(), uri)
+		assert.Nil(t, err)
+		assert.Equal(t, "image/png", file.Type)
+		assert.Equal(t, "", file.Name)
+	})
+	t.Run("percent_encoded_data_uri", func(t *testing.T) {
+		uri := "data:image/png," + url.PathEscape(string(pngBytes(t)))
+		file, err := NewFetcher(opts).Fetch(context.Background(), uri)
+		assert.Nil(t, err)
+		assert.Equal(t, "image/png", file.Type)
+	})
+	t.Run("loopback_blocked", func(t *testing.T) {
+		o := opts
+		o.AllowPrivateNetworks = false
+		_, err := NewFetcher(o).Fetch(context.Background(), srv.URL+"/cat.png")
+		assert.ErrorIs(t, err, ErrBlockedAddress)
+	})
+}
+
+func TestIsBlockedIP(t *testing.T) {
+	for _, ip := range []string{"127.0.0.1", "10.1.2.3", "172.16.0.1", "192.168.1.1", "169.254.169.254", "100.64.0.1", "0.0.0.0", "::1", "fe80::1", "fc00::1"} {
+		assert.True(t, IsBlockedIP(net.ParseIP(ip)), ip)
+	}
+	for _, ip := range []string{"8.8.8.8", "142.250.74.14", "2a00:1450:4001:82b::200e"} {
+		assert.False(t, IsBlockedIP(net.ParseIP(ip)), ip)
+	}
+}
@@ -1,42 +1,116 @@
 package helpers
 
 import (
+	"bufio"
+	"bytes"
+	"context"
 	"crypto/aes"
 	"crypto/cipher"
-	"crypto/rand"
+	"crypto/sha256"
+	"encoding/hex"
+	"errors"
+	"hash"
 	"io"
+	"mime"
 	"mime/multipart"
-	"os"
-	"path/filepath"
+	"net/http"
+	"path"
+	"strconv"
+	"strings"
+
+	"github.com/lebleuciel/maani/pkg/blobstore"
+	"github.com/lebleuciel/maani/pkg/encryption"
 )
 
-func SaveEncryptedFile(fileContent []byte, destDir string, key []byte) (string, error) {
-	// Generate UUID for file name
-	newFileName, err := GenerateUUID()
-	if err != nil {
-		return "", err
-	}
+// SaveEncryptedFile encrypts content with data key dek into blob key of store
+func SaveEncryptedFile(ctx context.Context, store blobstore.BlobStore, key string, fileContent []byte, dek []byte) error {
+	return SaveEncryptedStream(ctx, store, key, bytes.NewReader(fileContent), dek)
+}
 
-	cipherText, err := EncryptFile(fileContent, key)
-	if err != nil {
-		return "", err
-	}
+// SaveEncryptedStream encrypts src segment by segment into blob key of store.
+// Blob only becomes visible once src is fully encrypted, so partial blobs are never stored.
+func SaveEncryptedStream(ctx context.Context, store blobstore.BlobStore, key string, src io.Reader, dek []byte) error {
+	return writeEncryptedBlob(ctx, store, key, src, dek)
+}
 
-	// Specify the directory where you want to save the file
-	err = os.MkdirAll(destDir, os.ModePerm)
+// ReencryptFile decrypts an existing blob with keys and encrypts it again with data key dek.
+// New ciphertext replaces the blob atomically, readers which already opened the blob keep reading the old one.
+func ReencryptFile(ctx context.Context, store blobstore.BlobStore, key string, keys encryption.KeySource, dek []byte) error {
+	return ReencryptBlob(ctx, store, key, key, keys, dek)
+}
+
+// ReencryptBlob decrypts blob srcKey with keys and encrypts it with data key dek into blob destKey
+func ReencryptBlob(ctx context.Context, store blobstore.BlobStore, srcKey string, destKey string, keys encryption.KeySource, dek []byte) error {
+	content, _, err := OpenDecryptedFile(ctx, store, srcKey, keys)
 	if err != nil {
-		return "", err
+		return err
 	}
+	defer content.Close()
 
-	// Specify the path for the encrypted file
-	destPath := filepath.Join(destDir, newFileName)
+	return writeEncryptedBlob(ctx, store, destKey, content, dek)
+}
 
-	err = os.WriteFile(destPath, cipherText, os.ModePerm)
-	if err != nil {
-		return "", err
+// BlobKey returns the content address of a blob by hex SHA-256 of its plaintext, sharded as ab/cd/abcd...
+func BlobKey(sha256 string) string {
+	return path.Join(sha256[:2], sha256[2:4], sha256)
+}
+
+// Checksum returns hex SHA-256 of content
+func Checksum(content []byte) string {
+	sum := sha256.Sum256(content)
+	return hex.EncodeToString(sum[:])
+}
+
+// NewVerifiedReader hashes content while it is read and fails with ErrChecksumMismatch instead of returning its last chunk
+// when it does not have hex SHA-256 checksum, so corrupted content is never fully delivered
+func NewVerifiedReader(content io.ReadCloser, checksum string) io.ReadCloser {
+	return &verifiedReader{src: bufio.NewReader(content), content: content, hash: sha256.New(), checksum: checksum}
+}
+
+type verifiedReader struct {
+	src      *bufio.Reader
+	content  io.Closer
+	hash     hash.Hash
+	checksum string
+}
+
+func (v *verifiedReader) Read(p []byte) (int, error) {
+	n, err := v.src.Read(p)
+	v.hash.Write(p[:n])
+	if err == nil {
+		// Looking ahead tells whether this is the last chunk, which is only returned once content is verified
+		if _, peekErr := v.src.Peek(1); peekErr == io.EOF {
+			err = io.EOF
+		}
+	}
+	if err == io.EOF && hex.EncodeToString(v.hash.Sum(nil)) != v.checksum {
+		return 0, ErrChecksumMismatch
 	}
+	return n, err
+}
+
+func (v *verifiedReader) Close() error {
+	return v.content.Close()
+}
 
-	return newFileName, nil
+// writeEncryptedBlob encrypts src while it is streamed into store under key
+func writeEncryptedBlob(ctx context.Context, store blobstore.BlobStore, key string, src io.Reader, dek []byte) error {
+	pr, pw := io.Pipe()
+	go func() {
+		w, err := encryption.NewWriter(pw, encryption.DataKeyId, dek)
+		if err == nil {
+			_, err = io.Copy(w, src)
+		}
+		if err == nil {
+			err = w.Close()
+		}
+		pw.CloseWithError(err)
+	}()
+
+	err := store.Put(ctx, key, pr)
+	// Unblocks encryption when store gave up before reading everything
+	pr.CloseWithError(err)
+	return err
 }
 
 func ReadFileContent(file *multipart.FileHeader) ([]byte, error) {
@@ -54,59 +128,194 @@ func ReadFileContent(file *multipart.FileHeader) ([]byte, error) {
 	return content, nil
 }
 
-func EncryptFile(plainText []byte, key []byte) ([]byte, error) {
-	// Creating block of algorithm
-	block, err := aes.NewCipher(key)
+// OpenDecryptedFile opens an encrypted blob for streaming decryption and returns its plaintext size.
+// Key is picked from keys by the id in blob header, plaintext is only ever held one segment at a time and never stored.
+func OpenDecryptedFile(ctx context.Context, store blobstore.BlobStore, key string, keys encryption.KeySource) (io.ReadCloser, int64, error) {
+	blob, info, err := store.Get(ctx, key)
 	if err != nil {
-		return nil, err
+		return nil, 0, err
 	}
 
-	// Creating GCM mode
-	gcm, err := cipher.NewGCM(block)
-	if err != nil {
-		return nil, err
+	src := bufio.NewReader(blob)
+	prefix, err := src.Peek(encryption.MagicSize)
+	if err != nil && err != io.EOF {
+		blob.Close()
+		return nil, 0, err
+	}
+	if !encryption.IsSegmented(prefix) {
+		// Blobs stored before segmented encryption are a single nonce prefixed GCM message of legacy key
+		content, err := readLegacyEncryptedFile(src, keys)
+		blob.Close()
+		if err != nil {
+			return nil, 0, err
+		}
+		return io.NopCloser(bytes.NewReader(content)), int64(len(content)), nil
 	}
 
-	// Generating random nonce
-	nonce := make([]byte, gcm.NonceSize())
-	if _, err := io.ReadFull(rand.Reader, nonce); err != nil {
-		return nil, err
+	r, err := encryption.NewReader(src, keys)
+	if err != nil {
+		blob.Close()
+		return nil, 0, err
 	}
 
-	// Decrypt file
-	cipherText := gcm.Seal(nonce, nonce, plainText, nil)
-	return cipherText, nil
+	return &decryptedFile{Reader: r, blob: blob}, r.PlaintextSize(info.Size), nil
+}
+
+type decryptedFile struct {
+	*encryption.Reader
+	blob io.Closer
 }
 
-func DecryptFile(filePath string, key []byte) error {
-	cipherText, err := os.ReadFile(filePath)
+func (d *decryptedFile) Close() error {
+	return d.blob.Close()
+}
+
+func readLegacyEncryptedFile(src io.Reader, keys encryption.KeySource) ([]byte, error) {
+	key, err := keys.Key("")
 	if err != nil {
-		return err
+		return nil, err
+	}
+	cipherText, err := io.ReadAll(src)
+	if err != nil {
+		return nil, err
 	}
 
 	// Creating block of algorithm
 	block, err := aes.NewCipher(key)
 	if err != nil {
-		return err
+		return nil, err
 	}
 
 	// Creating GCM mode
 	gcm, err := cipher.NewGCM(block)
 	if err != nil {
-		return err
+		return nil, err
+	}
+	if len(cipherText) < gcm.NonceSize() {
+		return nil, errors.New("encrypted file is too short")
 	}
 
 	// Deattached nonce and decrypt
 	nonce := cipherText[:gcm.NonceSize()]
 	cipherText = cipherText[gcm.NonceSize():]
-	plainText, err := gcm.Open(nil, nonce, cipherText, nil)
+	return gcm.Open(nil, nonce, cipherText, nil)
+}
+
+// MediaType returns lower case media type of a content type without its parameters,
+// unknown or malformed content types are treated as arbitrary binary data
+func MediaType(contentType string) string {
+	mediaType, _, err := mime.ParseMediaType(contentType)
 	if err != nil {
-		return err
+		return "application/octet-stream"
 	}
+	return mediaType
+}
 
-	err = os.WriteFile(filePath, plainText, os.ModePerm)
-	if err != nil {
-		return err
+// IsImageType reports whether a media type is an image format the image pipeline can decode
+func IsImageType(mediaType string) bool {
+	switch mediaType {
+	case "image/jpeg", "image/png", "image/gif", "image/webp":
+		return true
+	}
+	return false
+}
+
+// DetectMediaType returns media type of content, so files are never labeled with a format they are not stored in.
+// Images are recognized by their content, other files keep declared media type unless they claim to be an image.
+func DetectMediaType(declared string, content []byte) string {
+	sniffed := MediaType(http.DetectContentType(content))
+	if isAVIF(content) {
+		sniffed = "image/avif"
+	}
+	if strings.HasPrefix(sniffed, "image/") || strings.HasPrefix(declared, "image/") {
+		return sniffed
+	}
+	return declared
+}
+
+// isAVIF reports whether content starts with an ISO media file type box of AVIF brand, which net/http does not sniff
+func isAVIF(content []byte) bool {
+	if len(content) < 12 {
+		return false
+	}
+	brand := string(content[4:12])
+	return brand == "ftypavif" || brand == "ftypavis"
+}
+
+// NegotiateType picks the offer an Accept header prefers most, offers earlier in the list win ties.
+// Empty header accepts the first offer and empty result means none of offers is acceptable.
+func NegotiateType(accept string, offers []string) string {
+	if strings.TrimSpace(accept) == "" {
+		if len(offers) == 0 {
+			return ""
+		}
+		return offers[0]
+	}
+
+	best, bestQuality := "", 0.0
+	for _, offer := range offers {
+		quality := acceptQuality(accept, offer)
+		if quality > bestQuality {
+			best, bestQuality = offer, quality
+		}
+	}
+	return best
+}
+
+// acceptQuality returns quality value of the most specific media range of an Accept header matching offer
+func acceptQuality(accept string, offer string) float64 {
+	quality, specificity := 0.0, -1
+	for _, mediaRange := range strings.Split(accept, ",") {
+		mediaType, params, err := mime.ParseMediaType(strings.TrimSpace(mediaRange))
+		if err != nil {
+			continue
+		}
+		rangeSpecificity := 0
+		switch {
+		case mediaType == offer:
+			rangeSpecificity = 2
+		case strings.HasSuffix(mediaType, "/*") && strings.HasPrefix(offer, strings.TrimSuffix(mediaType, "*")):
+			rangeSpecificity = 1
+		case mediaType == "*/*":
+		default:
+			continue
+		}
+		if rangeSpecificity <= specificity {
+			continue
+		}
+		specificity, quality = rangeSpecificity, 1
+		if value, ok := params["q"]; ok {
+			quality, err = strconv.ParseFloat(value, 64)
+			if err != nil {
+				quality = 0
+			}
+		}
+	}
+	return quality
+}
+
+// ContentDisposition returns an attachment content disposition of file name, quoted or encoded as needed
+func ContentDisposition(fileName string) string {
+	return mime.FormatMediaType("attachment", map[string]string{"filename": fileName})
+}
+
+// ImageExtension returns the usual file extension of an image content type
+func ImageExtension(contentType string) string {
+	switch contentType {
+	case "image/jpeg":
+		return ".jpg"
+	case "image/png":
+		return ".png"
+	case "image/gif":
+		return ".gif"
+	case "image/webp":
+		return ".webp"
+	case "image/avif":
+		return ".avif"
+	case "image/bmp":
+		return ".bmp"
+	case "image/x-icon":
+		return ".ico"
 	}
-	return nil
+	return ""
 }
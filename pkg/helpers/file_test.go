@@ -0,0 +1,170 @@
+package helpers
+
+import (
+	"bytes"
+	"context"
+	"crypto/aes"
+	"crypto/cipher"
+	"io"
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/lebleuciel/maani/pkg/blobstore"
+	"github.com/lebleuciel/maani/pkg/encryption"
+	"github.com/stretchr/testify/assert"
+)
+
+var testKey = []byte("0123456789abcdef")
+
+func testKeyring(t *testing.T, activeId string) *encryption.Keyring {
+	keyring, err := encryption.NewKeyring(map[string]string{
+		"old": string(testKey),
+		"new": "fedcba9876543210",
+	}, activeId, string(testKey))
+	assert.NoError(t, err)
+	return keyring
+}
+
+func testStore(t *testing.T, dir string) blobstore.BlobStore {
+	store, err := blobstore.NewLocalStore(dir)
+	assert.NoError(t, err)
+	return store
+}
+
+func TestSaveEncryptedStream(t *testing.T) {
+	dir := t.TempDir()
+	plain := bytes.Repeat([]byte("streamed plaintext "), 10000)
+
+	dek, err := encryption.GenerateDataKey()
+	assert.NoError(t, err)
+	store := testStore(t, dir)
+	name := BlobKey(Checksum(plain))
+	assert.NoError(t, SaveEncryptedStream(context.Background(), store, name, bytes.NewReader(plain), dek))
+
+	// Only the final blob is left behind and it never contains plaintext
+	blobs, err := store.List(context.Background(), "")
+	assert.NoError(t, err)
+	assert.Len(t, blobs, 1)
+	blob, err := os.ReadFile(filepath.Join(dir, filepath.FromSlash(name)))
+	assert.NoError(t, err)
+	assert.False(t, bytes.Contains(blob, []byte("streamed plaintext")))
+
+	_, _, err = OpenDecryptedFile(context.Background(), store, name, testKeyring(t, "old"))
+	assert.ErrorIs(t, err, encryption.ErrUnknownKeyId)
+
+	content, size, err := OpenDecryptedFile(context.Background(), store, name, encryption.FileKeys{DataKey: dek, Provider: testKeyring(t, "old")})
+	assert.NoError(t, err)
+	defer content.Close()
+	assert.Equal(t, int64(len(plain)), size)
+	got, err := io.ReadAll(content)
+	assert.NoError(t, err)
+	assert.Equal(t, plain, got)
+}
+
+func TestOpenDecryptedFile_Legacy(t *testing.T) {
+	dir := t.TempDir()
+	block, err := aes.NewCipher(testKey)
+	assert.NoError(t, err)
+	gcm, err := cipher.NewGCM(block)
+	assert.NoError(t, err)
+	nonce := make([]byte, gcm.NonceSize())
+	blob := gcm.Seal(nonce, nonce, []byte("legacy content"), nil)
+	assert.NoError(t, os.WriteFile(filepath.Join(dir, "legacy"), blob, 0o600))
+
+	content, size, err := OpenDecryptedFile(context.Background(), testStore(t, dir), "legacy", testKeyring(t, "new"))
+	assert.NoError(t, err)
+	defer content.Close()
+	assert.Equal(t, int64(len("legacy content")), size)
+	got, err := io.ReadAll(content)
+	assert.NoError(t, err)
+	assert.Equal(t, "legacy content", string(got))
+}
+
+func TestReencryptFile(t *testing.T) {
+	dir := t.TempDir()
+	filePath := filepath.Join(dir, "blob")
+	plain := bytes.Repeat([]byte("rotated plaintext "), 10000)
+
+	// Blob encrypted directly with a keyring key, as stored before envelope encryption
+	var blob bytes.Buffer
+	w, err := encryption.NewWriter(&blob, "old", testKey)
+	assert.NoError(t, err)
+	_, err = w.Write(plain)
+	assert.NoError(t, err)
+	assert.NoError(t, w.Close())
+	assert.NoError(t, os.WriteFile(filePath, blob.Bytes(), 0o600))
+
+	dek, err := encryption.GenerateDataKey()
+	assert.NoError(t, err)
+	store := testStore(t, dir)
+	assert.NoError(t, ReencryptFile(context.Background(), store, "blob", testKeyring(t, "new"), dek))
+
+	// Keyring keys are no longer needed to read the file
+	newOnly, err := encryption.NewKeyring(map[string]string{"new": "fedcba9876543210"}, "new", "")
+	assert.NoError(t, err)
+	content, _, err := OpenDecryptedFile(context.Background(), store, "blob", encryption.FileKeys{DataKey: dek, Provider: newOnly})
+	assert.NoError(t, err)
+	defer content.Close()
+	got, err := io.ReadAll(content)
+	assert.NoError(t, err)
+	assert.Equal(t, plain, got)
+
+	entries, err := os.ReadDir(dir)
+	assert.NoError(t, err)
+	assert.Len(t, entries, 1)
+}
+
+func TestBlobKey(t *testing.T) {
+	checksum := Checksum([]byte("content"))
+	assert.Equal(t, "ed7002b439e9ac845f22357d822bac1444730fbdb6016d3ec9432297b9ec9f73", checksum)
+	assert.Equal(t, "ed/70/"+checksum, BlobKey(checksum))
+}
+
+func TestNewVerifiedReader(t *testing.T) {
+	checksum := Checksum([]byte("verified content"))
+
+	got, err := io.ReadAll(NewVerifiedReader(io.NopCloser(bytes.NewReader([]byte("verified content"))), checksum))
+	assert.NoError(t, err)
+	assert.Equal(t, "verified content", string(got))
+
+	_, err = io.ReadAll(NewVerifiedReader(io.NopCloser(bytes.NewReader([]byte("tampered content"))), checksum))
+	assert.ErrorIs(t, err, ErrChecksumMismatch)
+}
+
+func TestMediaType(t *testing.T) {
+	assert.Equal(t, "application/pdf", MediaType("application/PDF; version=1.4"))
+	assert.Equal(t, "text/plain", MediaType("text/plain; charset=utf-8"))
+	assert.Equal(t, "application/octet-stream", MediaType(""))
+	assert.Equal(t, "application/octet-stream", MediaType("not a type;;"))
+
+	assert.True(t, IsImageType("image/png"))
+	assert.False(t, IsImageType("image/svg+xml"))
+	assert.False(t, IsImageType("application/pdf"))
+}
+
+func TestContentDisposition(t *testing.T) {
+	assert.Equal(t, "attachment; filename=notes.txt", ContentDisposition("notes.txt"))
+	assert.Equal(t, `attachment; filename="report 2024.pdf"`, ContentDisposition("report 2024.pdf"))
+	assert.Equal(t, "attachment; filename*=utf-8''%D8%B9%DA%A9%D8%B3.png", ContentDisposition("عکس.png"))
+}
+
+func TestDetectMediaType(t *testing.T) {
+	png := []byte("\x89PNG\x0D\x0A\x1A\x0A rest of image")
+	avif := []byte("\x00\x00\x00\x1cftypavif\x00\x00\x00\x00")
+	assert.Equal(t, "image/png", DetectMediaType("image/jpeg", png))
+	assert.Equal(t, "image/png", DetectMediaType("application/octet-stream", png))
+	assert.Equal(t, "image/avif", DetectMediaType("image/avif", avif))
+	assert.Equal(t, "text/plain", DetectMediaType("image/png", []byte("not an image")))
+	assert.Equal(t, "text/csv", DetectMediaType("text/csv", []byte("a,b\n1,2\n")))
+}
+
+func TestNegotiateType(t *testing.T) {
+	offers := []string{"image/png", "image/webp", "image/jpeg"}
+	assert.Equal(t, "image/png", NegotiateType("", offers))
+	assert.Equal(t, "image/png", NegotiateType("*/*", offers))
+	assert.Equal(t, "image/webp", NegotiateType("image/avif,image/webp,*/*;q=0.8", offers))
+	assert.Equal(t, "image/jpeg", NegotiateType("image/jpeg, image/*;q=0.5", offers))
+	assert.Equal(t, "image/webp", NegotiateType("image/*, image/png;q=0", offers))
+	assert.Equal(t, "", NegotiateType("application/json", offers))
+}
@@ -0,0 +1,34 @@
+package helpers
+
+import (
+	"image"
+	"image/color"
+	"math/bits"
+
+	"github.com/nfnt/resize"
+)
+
+// DHash computes the 64 bit difference hash of an image.
+// The image is shrunk to 9x8 gray pixels and each bit tells whether a pixel is brighter than its right neighbour,
+// so resized or re-encoded copies of the same picture end up with hashes only a few bits apart.
+func DHash(img image.Image) uint64 {
+	small := resize.Resize(9, 8, img, resize.Bilinear)
+
+	var hash uint64
+	for y := 0; y < 8; y++ {
+		for x := 0; x < 8; x++ {
+			left := color.GrayModel.Convert(small.At(x, y)).(color.Gray).Y
+			right := color.GrayModel.Convert(small.At(x+1, y)).(color.Gray).Y
+			hash <<= 1
+			if left > right {
+				hash |= 1
+			}
+		}
+	}
+	return hash
+}
+
+// HammingDistance counts the bits which differ between two hashes
+func HammingDistance(a, b uint64) int {
+	return bits.OnesCount64(a ^ b)
+}
@@ -0,0 +1,40 @@
+package helpers
+
+import (
+	"image"
+	"image/color"
+	"testing"
+
+	"github.com/nfnt/resize"
+	"github.com/stretchr/testify/assert"
+)
+
+// gradient draws a horizontal gradient, reversed gradients produce opposite hashes
+func gradient(width, height int, reversed bool) image.Image {
+	img := image.NewGray(image.Rect(0, 0, width, height))
+	for y := 0; y < height; y++ {
+		for x := 0; x < width; x++ {
+			v := uint8(x * 250 / width)
+			if reversed {
+				v = 250 - v
+			}
+			img.SetGray(x, y, color.Gray{Y: v})
+		}
+	}
+	return img
+}
+
+func TestDHash(t *testing.T) {
+	original := gradient(300, 200, false)
+	resized := resize.Resize(120, 80, original, resize.Lanczos3)
+	other := gradient(300, 200, true)
+
+	assert.LessOrEqual(t, HammingDistance(DHash(original), DHash(resized)), 4)
+	assert.Greater(t, HammingDistance(DHash(original), DHash(other)), 32)
+}
+
+func TestHammingDistance(t *testing.T) {
+	assert.Equal(t, 0, HammingDistance(0xff, 0xff))
+	assert.Equal(t, 8, HammingDistance(0xff, 0x00))
+	assert.Equal(t, 64, HammingDistance(0, ^uint64(0)))
+}
@@ -0,0 +1,110 @@
+package helpers
+
+import (
+	"fmt"
+	"image"
+	"image/draw"
+	"image/gif"
+	"image/jpeg"
+	"image/png"
+	"io"
+	"math"
+
+	"github.com/HugoSmits86/nativewebp"
+	"github.com/lebleuciel/maani/pkg/settings"
+	"github.com/nfnt/resize"
+)
+
+// RenderImage returns a resized copy of img described by rendition, img itself is never modified
+func RenderImage(img image.Image, rendition settings.Rendition) image.Image {
+	if rendition.Mode == settings.RenditionCrop {
+		return cropImage(img, rendition.Width, rendition.Height)
+	}
+	return resize.Thumbnail(rendition.Width, rendition.Height, img, resize.Lanczos3)
+}
+
+// cropImage scales img to cover width x height keeping its aspect ratio and cuts out its center
+func cropImage(img image.Image, width uint, height uint) image.Image {
+	bounds := img.Bounds()
+	scale := math.Max(float64(width)/float64(bounds.Dx()), float64(height)/float64(bounds.Dy()))
+	scaled := resize.Resize(
+		uint(math.Ceil(float64(bounds.Dx())*scale)),
+		uint(math.Ceil(float64(bounds.Dy())*scale)),
+		img, resize.Lanczos3,
+	)
+
+	scaledBounds := scaled.Bounds()
+	offset := image.Pt(
+		scaledBounds.Min.X+(scaledBounds.Dx()-int(width))/2,
+		scaledBounds.Min.Y+(scaledBounds.Dy()-int(height))/2,
+	)
+	cropped := image.NewRGBA(image.Rect(0, 0, int(width), int(height)))
+	draw.Draw(cropped, cropped.Bounds(), scaled, offset, draw.Src)
+	return cropped
+}
+
+// OrientImage returns a copy of img rotated and flipped as exif orientation tells it to be displayed.
+// Orientation 1 and unknown orientations return img itself.
+func OrientImage(img image.Image, orientation int) image.Image {
+	if orientation < 2 || orientation > 8 {
+		return img
+	}
+	bounds := img.Bounds()
+	src := image.NewNRGBA(image.Rect(0, 0, bounds.Dx(), bounds.Dy()))
+	draw.Draw(src, src.Bounds(), img, bounds.Min, draw.Src)
+	w, h := bounds.Dx(), bounds.Dy()
+
+	// Orientations from 5 to 8 swap width and height
+	dst := image.NewNRGBA(image.Rect(0, 0, w, h))
+	if orientation >= 5 {
+		dst = image.NewNRGBA(image.Rect(0, 0, h, w))
+	}
+	for y := 0; y < dst.Rect.Dy(); y++ {
+		for x := 0; x < dst.Rect.Dx(); x++ {
+			var sx, sy int
+			switch orientation {
+			case 2:
+				sx, sy = w-1-x, y
+			case 3:
+				sx, sy = w-1-x, h-1-y
+			case 4:
+				sx, sy = x, h-1-y
+			case 5:
+				sx, sy = y, x
+			case 6:
+				sx, sy = y, h-1-x
+			case 7:
+				sx, sy = w-1-y, h-1-x
+			case 8:
+				sx, sy = w-1-y, x
+			}
+			copy(dst.Pix[dst.PixOffset(x, y):dst.PixOffset(x, y)+4], src.Pix[src.PixOffset(sx, sy):src.PixOffset(sx, sy)+4])
+		}
+	}
+	return dst
+}
+
+// RenditionId identifies output of a rendition, so renditions are regenerated once their settings change
+func RenditionId(rendition settings.Rendition) string {
+	return fmt.Sprintf("%s-%dx%d", rendition.Mode, rendition.Width, rendition.Height)
+}
+
+// EncodeImage writes img to w in format media type, quality is only used by JPEG and zero uses its default quality.
+// WebP images are encoded lossless and GIF images keep only a single frame.
+func EncodeImage(w io.Writer, img image.Image, format settings.ImageFormat) error {
+	switch format.Format {
+	case "image/jpeg":
+		quality := jpeg.DefaultQuality
+		if format.Quality > 0 {
+			quality = format.Quality
+		}
+		return jpeg.Encode(w, img, &jpeg.Options{Quality: quality})
+	case "image/png":
+		return png.Encode(w, img)
+	case "image/gif":
+		return gif.Encode(w, img, nil)
+	case "image/webp":
+		return nativewebp.Encode(w, img, nil)
+	}
+	return ErrUnsupportedImageFormat
+}
@@ -0,0 +1,87 @@
+package helpers
+
+import (
+	"bytes"
+	"image"
+	"image/color"
+	_ "image/gif"
+	_ "image/jpeg"
+	_ "image/png"
+	"io"
+	"testing"
+
+	"github.com/lebleuciel/maani/pkg/settings"
+	"github.com/stretchr/testify/assert"
+	_ "golang.org/x/image/webp"
+)
+
+func TestRenderImage(t *testing.T) {
+	img := image.NewRGBA(image.Rect(0, 0, 400, 200))
+
+	t.Run("fit keeps aspect ratio", func(t *testing.T) {
+		rendered := RenderImage(img, settings.Rendition{Width: 100, Height: 100, Mode: settings.RenditionFit})
+		assert.Equal(t, image.Rect(0, 0, 100, 50), rendered.Bounds())
+	})
+	t.Run("fit never enlarges", func(t *testing.T) {
+		rendered := RenderImage(img, settings.Rendition{Width: 1000, Height: 1000, Mode: settings.RenditionFit})
+		assert.Equal(t, image.Rect(0, 0, 400, 200), rendered.Bounds())
+	})
+	t.Run("crop fills exact size", func(t *testing.T) {
+		rendered := RenderImage(img, settings.Rendition{Width: 64, Height: 64, Mode: settings.RenditionCrop})
+		assert.Equal(t, image.Rect(0, 0, 64, 64), rendered.Bounds())
+	})
+	assert.Equal(t, image.Rect(0, 0, 400, 200), img.Bounds())
+}
+
+func TestRenditionId(t *testing.T) {
+	assert.Equal(t, "crop-64x32", RenditionId(settings.Rendition{Width: 64, Height: 32, Mode: settings.RenditionCrop}))
+}
+
+func TestEncodeImage(t *testing.T) {
+	img := image.NewRGBA(image.Rect(0, 0, 40, 20))
+	for _, format := range settings.ImageOutputFormats {
+		t.Run(format, func(t *testing.T) {
+			var buf bytes.Buffer
+			assert.Nil(t, EncodeImage(&buf, img, settings.ImageFormat{Format: format, Quality: 60}))
+			decoded, decodedFormat, err := image.Decode(&buf)
+			assert.Nil(t, err)
+			assert.Equal(t, "image/"+decodedFormat, format)
+			assert.Equal(t, img.Bounds(), decoded.Bounds())
+		})
+	}
+	assert.Equal(t, ErrUnsupportedImageFormat, EncodeImage(io.Discard, img, settings.ImageFormat{Format: "image/avif"}))
+}
+
+func TestOrientImage(t *testing.T) {
+	// 3x2 image with a distinct value of red in each pixel
+	img := image.NewNRGBA(image.Rect(0, 0, 3, 2))
+	for y := 0; y < 2; y++ {
+		for x := 0; x < 3; x++ {
+			img.SetNRGBA(x, y, color.NRGBA{R: uint8(y*3 + x), A: 255})
+		}
+	}
+	rows := func(img image.Image) [][]uint8 {
+		var result [][]uint8
+		for y := img.Bounds().Min.Y; y < img.Bounds().Max.Y; y++ {
+			var row []uint8
+			for x := img.Bounds().Min.X; x < img.Bounds().Max.X; x++ {
+				row = append(row, color.NRGBAModel.Convert(img.At(x, y)).(color.NRGBA).R)
+			}
+			result = append(result, row)
+		}
+		return result
+	}
+
+	for orientation, expected := range map[int][][]uint8{
+		1: {{0, 1, 2}, {3, 4, 5}},
+		2: {{2, 1, 0}, {5, 4, 3}},
+		3: {{5, 4, 3}, {2, 1, 0}},
+		4: {{3, 4, 5}, {0, 1, 2}},
+		5: {{0, 3}, {1, 4}, {2, 5}},
+		6: {{3, 0}, {4, 1}, {5, 2}},
+		7: {{5, 2}, {4, 1}, {3, 0}},
+		8: {{2, 5}, {1, 4}, {0, 3}},
+	} {
+		assert.Equal(t, expected, rows(OrientImage(img, orientation)), "orientation %d", orientation)
+	}
+}
@@ -5,6 +5,7 @@ import (
 	"crypto/rand"
 	"encoding/hex"
 	"strings"
+	"unicode"
 )
 
 func Encrypt(password string) string {
@@ -36,3 +37,11 @@ func SplitBySpaceComma(input []string) []string {
 	}
 	return result
 }
+
+// Slugify lowercases input and joins its letters and digits runs with dashes
+func Slugify(input string) string {
+	parts := strings.FieldsFunc(strings.ToLower(input), func(r rune) bool {
+		return !unicode.IsLetter(r) && !unicode.IsDigit(r)
+	})
+	return strings.Join(parts, "-")
+}
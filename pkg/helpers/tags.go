@@ -0,0 +1,50 @@
+package helpers
+
+import (
+	"strings"
+	"unicode"
+)
+
+const (
+	// MinTagLength and MaxTagLength bound length of tags in bytes, as tag schema does
+	MinTagLength = 2
+	MaxTagLength = 64
+)
+
+// NormalizeTag lowercases and trims tag, ErrInvalidTag is returned when it is too short, too long or has separators of tags
+func NormalizeTag(tag string) (string, error) {
+	tag = strings.ToLower(strings.TrimSpace(tag))
+	if len(tag) < MinTagLength || len(tag) > MaxTagLength || strings.IndexFunc(tag, isTagSeparator) >= 0 {
+		return "", ErrInvalidTag
+	}
+	return tag, nil
+}
+
+// NormalizeTags splits input by spaces and commas and normalizes each tag, dropping repeated tags while keeping their order
+func NormalizeTags(input []string) ([]string, error) {
+	var result []string
+	seen := make(map[string]struct{})
+	for _, s := range input {
+		for _, part := range strings.FieldsFunc(s, isTagSeparator) {
+			tag, err := NormalizeTag(part)
+			if err != nil {
+				return nil, err
+			}
+			if _, ok := seen[tag]; ok {
+				continue
+			}
+			seen[tag] = struct{}{}
+			result = append(result, tag)
+		}
+	}
+	return result, nil
+}
+
+// NormalizeTagPrefix lowercases and trims prefix of tags looked up by autocomplete, which may be shorter than a tag
+func NormalizeTagPrefix(prefix string) string {
+	return strings.ToLower(strings.TrimSpace(prefix))
+}
+
+func isTagSeparator(r rune) bool {
+	return r == ',' || unicode.IsSpace(r)
+}
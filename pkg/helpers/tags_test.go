@@ -0,0 +1,40 @@
+package helpers
+
+import (
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestNormalizeTags(t *testing.T) {
+	t.Run("split_and_normalize", func(t *testing.T) {
+		tags, err := NormalizeTags([]string{" Cats,pets  CATS", "Dogs\tbirds,"})
+		assert.Nil(t, err)
+		assert.Equal(t, []string{"cats", "pets", "dogs", "birds"}, tags)
+	})
+	t.Run("empty", func(t *testing.T) {
+		tags, err := NormalizeTags([]string{"", " , "})
+		assert.Nil(t, err)
+		assert.Empty(t, tags)
+	})
+	t.Run("too_short", func(t *testing.T) {
+		_, err := NormalizeTags([]string{"cats a"})
+		assert.Equal(t, ErrInvalidTag, err)
+	})
+	t.Run("too_long", func(t *testing.T) {
+		_, err := NormalizeTags([]string{strings.Repeat("a", MaxTagLength+1)})
+		assert.Equal(t, ErrInvalidTag, err)
+	})
+}
+
+func TestNormalizeTag(t *testing.T) {
+	tag, err := NormalizeTag("  Kittens ")
+	assert.Nil(t, err)
+	assert.Equal(t, "kittens", tag)
+
+	_, err = NormalizeTag("two words")
+	assert.Equal(t, ErrInvalidTag, err)
+	_, err = NormalizeTag("a,b")
+	assert.Equal(t, ErrInvalidTag, err)
+}
@@ -1 +1,8 @@
 package file
+
+import "github.com/pkg/errors"
+
+var ErrInvalidBatchSize = errors.New("batch size must be positive")
+var ErrUnknownRendition = errors.New("rendition is not defined")
+var ErrNoRenditions = errors.New("only images have renditions")
+var ErrNotAcceptable = errors.New("no rendition format is acceptable")
@@ -6,19 +6,24 @@ import (
 	"errors"
 	"fmt"
 	"image"
-	"image/jpeg"
+	_ "image/gif"
+	_ "image/png"
+	"io"
 	"log"
 	"mime/multipart"
 
-	"github.com/nfnt/resize"
-
 	"github.com/lib/pq"
 
 	"github.com/lebleuciel/maani/models"
+	"github.com/lebleuciel/maani/pkg/blobstore"
 	"github.com/lebleuciel/maani/pkg/database"
+	"github.com/lebleuciel/maani/pkg/encryption"
+	"github.com/lebleuciel/maani/pkg/exif"
+	"github.com/lebleuciel/maani/pkg/filequery"
 	"github.com/lebleuciel/maani/pkg/helpers"
 	"github.com/lebleuciel/maani/pkg/settings"
 	"go.uber.org/zap"
+	_ "golang.org/x/image/webp"
 )
 
 // logger is a global variable for logging using Zap.
@@ -35,13 +40,20 @@ func init() {
 }
 
 type FileRepository struct {
-	st settings.Settings
-	db database.Database
+	st    settings.Settings
+	db    database.Database
+	keys  encryption.KeyProvider
+	blobs blobstore.BlobStore
+}
+
+// Is file valid, contentType is media type of its content
+func (f *FileRepository) IsValidFile(file *multipart.FileHeader, contentType string) error {
+	return f.IsAllowedType(contentType, file.Size, file.Filename)
 }
 
-// Is file valid
-func (f *FileRepository) IsValidFile(file *multipart.FileHeader) error {
-	err := f.db.AddFileTypeIfNotExist(file.Header.Get("Content-Type"))
+// IsAllowedType checks file type is registered, not banned and size is in its allowed range
+func (f *FileRepository) IsAllowedType(contentType string, size int64, fileName string) error {
+	err := f.db.AddFileTypeIfNotExist(contentType)
 	if err != nil {
 		logger.Errorw("can't add file types into database", "error", err)
 		return errors.New("can't add file types into database")
@@ -54,53 +66,130 @@ func (f *FileRepository) IsValidFile(file *multipart.FileHeader) error {
 	}
 
 	for _, types := range filetypes {
-		if types.Name == file.Header.Get("Content-Type") {
+		if types.Name == contentType {
 			if types.IsBanned {
-				return fmt.Errorf("can't send file with %s type, for filename: %s", file.Header.Get("Content-Type"), file.Filename)
+				return fmt.Errorf("can't send file with %s type, for filename: %s", contentType, fileName)
 			}
-			if file.Size > int64(types.AllowedSize) {
-				return fmt.Errorf("file size is not allowed, you can send %s file with maximum %d byets, for filename: %s", types.Name, types.AllowedSize, file.Filename)
+			if size > int64(types.AllowedSize) {
+				return fmt.Errorf("file size is not allowed, you can send %s file with maximum %d byets, for filename: %s", types.Name, types.AllowedSize, fileName)
 			}
 			return nil
 		}
 	}
-	return fmt.Errorf("file type %s not found, for filename: %s", file.Header.Get("Content-Type"), file.Filename)
+	return fmt.Errorf("file type %s not found, for filename: %s", contentType, fileName)
 }
 
-// Save a file
-func (f *FileRepository) SaveEncryptedFile(file models.File) error {
-	image, _, err := image.Decode(bytes.NewReader(file.Content))
+// SaveEncryptedFile encrypts and stores original bytes of a file of any allowed type.
+// Images also get a perceptual hash and their eager renditions. When user already has a near-duplicate image,
+// nothing is stored and file is returned without content, with DuplicateOf set to uuid of the existing file.
+// Burn after read files are never deduplicated, they are always stored since they are removed once read.
+func (f *FileRepository) SaveEncryptedFile(file models.File) (models.File, error) {
+	var img image.Image
+	if helpers.IsImageType(file.TypeId) {
+		meta, err := f.readMetadata(&file)
+		if err != nil {
+			return models.File{}, err
+		}
+		img, err = decodeImage(file.Content, meta.Orientation)
+		if err != nil {
+			logger.Errorw("can't encode byte to image", "error", err)
+			return models.File{}, err
+		}
+		file.Width, file.Height = img.Bounds().Dx(), img.Bounds().Dy()
+		file.DominantColor = helpers.DominantColor(img)
+		file.CameraMake, file.CameraModel, file.TakenAt = meta.Make, meta.Model, meta.TakenAt
+
+		phash := helpers.DHash(img)
+		file.PHash = &phash
+		if f.st.BackendServer.DedupDistance >= 0 && !file.BurnAfterRead {
+			existing, err := f.db.FindSimilarFile(file.UserId, phash, f.st.BackendServer.DedupDistance)
+			if err != nil {
+				logger.Errorw("can't find similar files from database", "error", err)
+				return models.File{}, err
+			}
+			if existing != nil {
+				file.Content = nil
+				file.DuplicateOf = existing.UUID
+				return file, nil
+			}
+		}
+	}
+
+	currentSize, err := f.db.GetFilesSize()
 	if err != nil {
-		logger.Errorw("can't encode byte to image", "error", err)
-		return err
+		logger.Errorw("can't get files size from database", "error", err)
+		return models.File{}, err
+	}
+	if f.st.BackendServer.MaxFilesSizeByte < currentSize+file.Size {
+		return models.File{}, fmt.Errorf("reach maximum amount of disk usage")
 	}
 
-	newImage := resize.Resize(f.st.BackendServer.FileWidth, f.st.BackendServer.FileHeight, image, resize.Lanczos3)
-	buf := new(bytes.Buffer)
-	err = jpeg.Encode(buf, newImage, nil)
+	uid, err := helpers.GenerateUUID()
 	if err != nil {
-		logger.Errorw("can't encode image to byte", "error", err)
-		return err
+		return models.File{}, err
+	}
+	file.UUID = uid
+	file.SHA256 = helpers.Checksum(file.Content)
+	err = f.storeFile(&file)
+	if err != nil {
+		return models.File{}, err
+	}
+	if img != nil {
+		f.saveEagerRenditions(file, img)
 	}
+	file.Content = nil
+	return file, nil
+}
 
-	file.Content = buf.Bytes()
+// readMetadata reads exif metadata of an image file and strips its GPS location, from exif and XMP metadata,
+// unless it is kept by settings. Images with malformed metadata are refused then, since their GPS location can't be told apart.
+func (f *FileRepository) readMetadata(file *models.File) (exif.Metadata, error) {
+	meta, err := exif.Read(file.Content)
+	if err != nil && !errors.Is(err, exif.ErrNoExif) {
+		if !f.st.BackendServer.KeepGPS {
+			logger.Errorw("can't read exif metadata", "name", file.Name, "error", err)
+			return exif.Metadata{}, err
+		}
+		logger.Warnw("ignoring malformed exif metadata", "name", file.Name, "error", err)
+		return exif.Metadata{}, nil
+	}
 
-	currentSize, err := f.db.GetFilesSize()
+	if !f.st.BackendServer.KeepGPS && (meta.HasGPS || exif.HasXMPLocation(file.Content)) {
+		file.Content, err = exif.StripGPS(file.Content)
+		if err != nil {
+			logger.Errorw("can't strip gps location from image metadata", "name", file.Name, "error", err)
+			return exif.Metadata{}, err
+		}
+	}
+	return meta, nil
+}
+
+// decodeImage decodes image content as it is displayed, rotated and flipped by its exif orientation
+func decodeImage(content []byte, orientation int) (image.Image, error) {
+	img, _, err := image.Decode(bytes.NewReader(content))
 	if err != nil {
-		logger.Errorw("can't get files size from database", "error", err)
-		return err
+		return nil, err
 	}
-	if f.st.BackendServer.MaxFilesSizeByte < currentSize+file.Size {
-		return fmt.Errorf("reach maximum amount of disk usage")
+	return helpers.OrientImage(img, orientation), nil
+}
+
+// storeFile saves blob of file and then file into database, while holding lock of its checksum.
+// Otherwise two uploads of the same content could both write the blob, each with its own data key, and the file
+// whose blob was overwritten could not be decrypted anymore.
+func (f *FileRepository) storeFile(file *models.File) error {
+	unlock, err := f.db.LockChecksum(file.SHA256)
+	if err != nil {
+		logger.Errorw("can't lock blob of file from file repository", "error", err)
+		return err
 	}
+	defer unlock()
 
-	uid, err := helpers.SaveEncryptedFile(file.Content, f.st.BackendServer.FilePath, []byte(f.st.BackendServer.EncryptKey))
+	err = f.saveBlob(file)
 	if err != nil {
 		logger.Errorw("can't saved encrypted file from file repository", "error", err)
 		return err
 	}
-	file.UUID = uid
-	err = f.db.SaveFile(file)
+	err = f.db.SaveFile(*file)
 	if err != nil {
 		logger.Errorw("can't saved file into database from file repository", "error", err)
 		return err
@@ -108,7 +197,58 @@ func (f *FileRepository) SaveEncryptedFile(file models.File) error {
 	return nil
 }
 
-func (f *FileRepository) GetEncryptedFile(name []string, tags []string) (database.Transaction, models.File, error) {
+// saveBlob stores encrypted content of file at its content address and sets its data key, lock of its checksum must be held.
+// Files with the same content share a blob, so an already stored blob is reused with the data key it is encrypted with.
+func (f *FileRepository) saveBlob(file *models.File) error {
+	ctx := context.Background()
+	existing, err := f.db.GetFileByChecksum(file.SHA256)
+	if err != nil {
+		logger.Errorw("can't get file by checksum from database", "error", err)
+		return err
+	}
+
+	var dek []byte
+	if existing != nil {
+		_, err = f.blobs.Stat(ctx, helpers.BlobKey(file.SHA256))
+		if err == nil {
+			file.KeyId, file.WrappedKey = existing.KeyId, existing.WrappedKey
+			return nil
+		}
+		if !errors.Is(err, blobstore.ErrNotFound) {
+			return err
+		}
+		if existing.WrappedKey != nil {
+			// Missing blob is written again with data key of existing file, so both files can decrypt it
+			dek, err = f.dataKey(*existing)
+			if err != nil {
+				return err
+			}
+			file.KeyId, file.WrappedKey = existing.KeyId, existing.WrappedKey
+		}
+	}
+
+	if dek == nil {
+		dek, err = encryption.GenerateDataKey()
+		if err != nil {
+			return err
+		}
+		file.WrappedKey, file.KeyId, err = encryption.WrapDataKey(f.keys, dek)
+		if err != nil {
+			logger.Errorw("can't wrap data key from file repository", "error", err)
+			return err
+		}
+	}
+	return helpers.SaveEncryptedFile(ctx, f.blobs, helpers.BlobKey(file.SHA256), file.Content, dek)
+}
+
+// GetEncryptedFileByUUID returns a file in scope by its uuid with the serializable transaction it was read in
+func (f *FileRepository) GetEncryptedFileByUUID(scope database.Scope, uuid string) (database.Transaction, models.File, error) {
+	return f.getEncryptedFile(func(tx database.Transaction) (models.File, error) {
+		return tx.GetFileByUUID(scope, uuid)
+	})
+}
+
+func (f *FileRepository) getEncryptedFile(get func(database.Transaction) (models.File, error)) (database.Transaction, models.File, error) {
 	ctx := context.Background()
 	tx, err := f.db.NewSerializableTransaction(ctx)
 
@@ -127,24 +267,268 @@ func (f *FileRepository) GetEncryptedFile(name []string, tags []string) (databas
 		return nil, models.File{}, err
 	}
 
-	file, err := tx.GetFile(name, tags)
+	file, err := get(tx)
 	if err != nil {
 		return nil, models.File{}, err
 	}
 	return tx, file, nil
 }
 
-func (f *FileRepository) GetFileList() ([]models.File, error) {
-	files, err := f.db.GetFileList()
-	return files, err
+// OpenDecryptedFile streams plaintext of a stored file and returns its size.
+// Content of files with a checksum is verified while it is read, reading fails at its end on mismatch.
+func (f *FileRepository) OpenDecryptedFile(file models.File) (io.ReadCloser, int64, error) {
+	keys, err := f.fileKeys(file)
+	if err != nil {
+		return nil, 0, err
+	}
+	content, size, err := helpers.OpenDecryptedFile(context.Background(), f.blobs, blobKey(file), keys)
+	if err != nil {
+		return nil, 0, err
+	}
+	if file.SHA256 != "" {
+		content = helpers.NewVerifiedReader(content, file.SHA256)
+	}
+	return content, size, nil
+}
+
+// RotateFileKey wraps data key of a stored file with active key-encryption key.
+// Files stored before envelope encryption get a data key and are re-encrypted with it.
+func (f *FileRepository) RotateFileKey(file models.File) error {
+	if file.WrappedKey != nil {
+		wrapped, keyId, err := encryption.RewrapDataKey(f.keys, file.WrappedKey, file.KeyId)
+		if err != nil {
+			return err
+		}
+		return f.db.UpdateFileKey(file.UUID, keyId, wrapped)
+	}
+
+	dek, err := encryption.GenerateDataKey()
+	if err != nil {
+		return err
+	}
+	wrapped, keyId, err := encryption.WrapDataKey(f.keys, dek)
+	if err != nil {
+		return err
+	}
+	err = helpers.ReencryptFile(context.Background(), f.blobs, blobKey(file), f.keys, dek)
+	if err != nil {
+		return err
+	}
+	return f.db.UpdateFileKey(file.UUID, keyId, wrapped)
+}
+
+// fileKeys returns keys which decrypt content of file
+func (f *FileRepository) fileKeys(file models.File) (encryption.KeySource, error) {
+	if file.WrappedKey == nil {
+		return f.keys, nil
+	}
+	dek, err := f.dataKey(file)
+	if err != nil {
+		return nil, err
+	}
+	return encryption.FileKeys{DataKey: dek, Provider: f.keys}, nil
+}
+
+// dataKey unwraps data key of file, which must have one
+func (f *FileRepository) dataKey(file models.File) ([]byte, error) {
+	return encryption.UnwrapDataKey(f.keys, file.WrappedKey, file.KeyId)
+}
+
+// DeleteBlob removes stored blob and renditions of a deleted file unless other files share them, removing a missing blob is not an error
+func (f *FileRepository) DeleteBlob(file models.File) error {
+	if file.SHA256 != "" {
+		// Blob is only removed while no upload can start sharing it
+		unlock, err := f.db.LockChecksum(file.SHA256)
+		if err != nil {
+			return err
+		}
+		defer unlock()
+
+		sharing, err := f.db.CountFilesByChecksum(file.SHA256)
+		if err != nil {
+			return err
+		}
+		if sharing > 0 {
+			return nil
+		}
+	}
+	ctx := context.Background()
+	err := f.deleteRenditions(ctx, blobKey(file))
+	if err != nil {
+		return err
+	}
+	return f.blobs.Delete(ctx, blobKey(file))
+}
+
+// blobKey returns key of stored blob of a file, files stored before content addressing are kept by their uuid
+func blobKey(file models.File) string {
+	if file.SHA256 == "" {
+		return file.UUID
+	}
+	return helpers.BlobKey(file.SHA256)
+}
+
+// ActiveKeyId returns id of the key data keys of new files are wrapped with
+func (f *FileRepository) ActiveKeyId() string {
+	return f.keys.ActiveId()
+}
+
+// GetFileList returns metadata of a page of files in scope sorted and filtered by options
+func (f *FileRepository) GetFileList(scope database.Scope, options database.ListOptions) (models.FileList, error) {
+	files, nextCursor, err := f.db.GetFileList(scope, options)
+	if err != nil {
+		return models.FileList{}, err
+	}
+	result := make([]models.FileMetadata, 0, len(files))
+	for _, file := range files {
+		result = append(result, toFileMetadata(file))
+	}
+	return models.FileList{Files: result, NextCursor: nextCursor}, nil
+}
+
+// FindFiles returns metadata of all files in scope with any of names and any of tags
+func (f *FileRepository) FindFiles(scope database.Scope, names []string, tags []string) ([]models.FileMetadata, error) {
+	files, err := f.db.FindFiles(scope, names, tags)
+	if err != nil {
+		return nil, err
+	}
+	result := make([]models.FileMetadata, 0, len(files))
+	for _, file := range files {
+		result = append(result, toFileMetadata(file))
+	}
+	return result, nil
+}
+
+// QueryFiles returns a page of metadata of files in scope matching expr
+func (f *FileRepository) QueryFiles(scope database.Scope, expr filequery.Expr, limit int, offset int) (models.FilePage, error) {
+	files, total, err := f.db.QueryFiles(scope, expr, limit, offset)
+	if err != nil {
+		return models.FilePage{}, err
+	}
+	page := models.FilePage{
+		Files:  make([]models.FileMetadata, 0, len(files)),
+		Total:  total,
+		Limit:  limit,
+		Offset: offset,
+	}
+	for _, file := range files {
+		page.Files = append(page.Files, toFileMetadata(file))
+	}
+	return page, nil
+}
+
+// SearchFilesText returns a page of metadata of files in scope found by full-text search of text
+func (f *FileRepository) SearchFilesText(scope database.Scope, text string, limit int, offset int) (models.TextSearchPage, error) {
+	matches, total, err := f.db.SearchFilesText(scope, text, limit, offset)
+	if err != nil {
+		return models.TextSearchPage{}, err
+	}
+	page := models.TextSearchPage{
+		Matches: make([]models.TextMatchMetadata, 0, len(matches)),
+		Total:   total,
+		Limit:   limit,
+		Offset:  offset,
+	}
+	for _, match := range matches {
+		page.Matches = append(page.Matches, models.TextMatchMetadata{
+			File:      toFileMetadata(match.File),
+			Rank:      match.Rank,
+			Highlight: match.Highlight,
+		})
+	}
+	return page, nil
+}
+
+// GetFile returns a file in scope by uuid without its content
+func (f *FileRepository) GetFile(scope database.Scope, uuid string) (models.File, error) {
+	return f.db.GetFileByUUID(scope, uuid)
+}
+
+// TrashFile moves a file in scope by uuid to trash, its blob is kept until it is purged
+func (f *FileRepository) TrashFile(scope database.Scope, uuid string) error {
+	return f.db.TrashFile(scope, uuid)
+}
+
+// RestoreFile moves a file in scope by uuid out of trash
+func (f *FileRepository) RestoreFile(scope database.Scope, uuid string) error {
+	return f.db.RestoreFile(scope, uuid)
+}
+
+// GetTrashList returns metadata of trashed files in scope
+func (f *FileRepository) GetTrashList(scope database.Scope) ([]models.FileMetadata, error) {
+	files, err := f.db.GetTrashList(scope)
+	if err != nil {
+		return nil, err
+	}
+	result := make([]models.FileMetadata, 0, len(files))
+	for _, file := range files {
+		result = append(result, toFileMetadata(file))
+	}
+	return result, nil
+}
+
+// PurgeFile removes a file for good, its blob is kept while other files share it
+func (f *FileRepository) PurgeFile(file models.File) error {
+	err := f.db.DeleteFile(database.AllScope(), file.UUID)
+	if err != nil {
+		logger.Errorw("can't delete file from database", "uuid", file.UUID, "error", err)
+		return err
+	}
+	return f.DeleteBlob(file)
+}
+
+// GetFileMetadata returns metadata of a file in scope by uuid
+func (f *FileRepository) GetFileMetadata(scope database.Scope, uuid string) (models.FileMetadata, error) {
+	file, err := f.db.GetFileByUUID(scope, uuid)
+	if err != nil {
+		return models.FileMetadata{}, err
+	}
+	return toFileMetadata(file), nil
+}
+
+func toFileMetadata(file models.File) models.FileMetadata {
+	tags := file.Tags
+	if tags == nil {
+		tags = make([]string, 0)
+	}
+	return models.FileMetadata{
+		Id:            file.UUID,
+		Name:          file.Name,
+		Size:          file.Size,
+		Type:          file.TypeId,
+		UserId:        file.UserId,
+		Tags:          tags,
+		BurnAfterRead: file.BurnAfterRead,
+		SHA256:        file.SHA256,
+		Width:         file.Width,
+		Height:        file.Height,
+		DominantColor: file.DominantColor,
+		CameraMake:    file.CameraMake,
+		CameraModel:   file.CameraModel,
+		TakenAt:       file.TakenAt,
+		SourceURL:     file.SourceURL,
+		SearchQuery:   file.SearchQuery,
+		CreatedAt:     file.CreatedAt,
+		DeletedAt:     file.DeletedAt,
+	}
 }
 
 func NewFileRepository(st settings.Settings, db database.Database) (*FileRepository, error) {
 	if db == nil {
 		return nil, errors.New("db should not be nil")
 	}
+	keys, err := encryption.NewKeyProvider(st)
+	if err != nil {
+		return nil, err
+	}
+	blobs, err := blobstore.NewBlobStore(st)
+	if err != nil {
+		return nil, err
+	}
 	return &FileRepository{
-		st: st,
-		db: db,
+		st:    st,
+		db:    db,
+		keys:  keys,
+		blobs: blobs,
 	}, nil
 }
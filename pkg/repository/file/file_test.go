@@ -0,0 +1,146 @@
+package file
+
+import (
+	"bytes"
+	"fmt"
+	"image"
+	"image/png"
+	"io"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/golang/mock/gomock"
+	"github.com/lebleuciel/maani/models"
+	mock_database "github.com/lebleuciel/maani/pkg/database/mocks"
+	"github.com/lebleuciel/maani/pkg/settings"
+	"github.com/stretchr/testify/assert"
+)
+
+// expectChecksumLocks makes LockChecksum of db lock a mutex of each checksum, as the database does across store servers
+func expectChecksumLocks(db *mock_database.MockDatabase) {
+	var mu sync.Mutex
+	locks := make(map[string]*sync.Mutex)
+	db.EXPECT().LockChecksum(gomock.Any()).DoAndReturn(func(sha256 string) (func(), error) {
+		mu.Lock()
+		lock, ok := locks[sha256]
+		if !ok {
+			lock = &sync.Mutex{}
+			locks[sha256] = lock
+		}
+		mu.Unlock()
+		lock.Lock()
+		return lock.Unlock, nil
+	}).AnyTimes()
+}
+
+// TestFileRepository_SaveEncryptedFile_Concurrent tests concurrent uploads of the same content share one blob,
+// which every one of them can decrypt
+func TestFileRepository_SaveEncryptedFile_Concurrent(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	var st settings.Settings
+	st.BackendServer.FilePath = t.TempDir()
+	st.BackendServer.EncryptKey = "0123456789abcdef"
+	st.BackendServer.MaxFilesSizeByte = 1 << 20
+	db := mock_database.NewMockDatabase(ctrl)
+	expectChecksumLocks(db)
+	repo, err := NewFileRepository(st, db)
+	assert.Nil(t, err)
+
+	var mu sync.Mutex
+	var saved []models.File
+	db.EXPECT().GetFilesSize().Return(0, nil).AnyTimes()
+	db.EXPECT().GetFileByChecksum(gomock.Any()).DoAndReturn(func(sha256 string) (*models.File, error) {
+		// Uploads which don't wait for each other all get here before any of them is saved
+		time.Sleep(10 * time.Millisecond)
+		mu.Lock()
+		defer mu.Unlock()
+		for _, file := range saved {
+			if file.SHA256 == sha256 {
+				return &file, nil
+			}
+		}
+		return nil, nil
+	}).AnyTimes()
+	db.EXPECT().SaveFile(gomock.Any()).DoAndReturn(func(file models.File) error {
+		mu.Lock()
+		defer mu.Unlock()
+		saved = append(saved, file)
+		return nil
+	}).AnyTimes()
+
+	content := []byte("the same notes uploaded by everyone")
+	var wg sync.WaitGroup
+	for i := 0; i < 8; i++ {
+		wg.Add(1)
+		go func(i int) {
+			defer wg.Done()
+			_, err := repo.SaveEncryptedFile(models.File{Name: fmt.Sprintf("notes-%d.txt", i), Size: len(content), TypeId: "text/plain", UserId: 7, Content: content})
+			assert.Nil(t, err)
+		}(i)
+	}
+	wg.Wait()
+
+	assert.Len(t, saved, 8)
+	for _, file := range saved {
+		assert.Equal(t, saved[0].WrappedKey, file.WrappedKey)
+		reader, _, err := repo.OpenDecryptedFile(file)
+		if !assert.Nil(t, err) {
+			continue
+		}
+		stored, err := io.ReadAll(reader)
+		reader.Close()
+		assert.Nil(t, err, file.Name)
+		assert.Equal(t, content, stored)
+	}
+}
+
+func TestFileRepository_SaveEncryptedFile_Deduplicated(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	var st settings.Settings
+	st.BackendServer.FilePath = t.TempDir()
+	st.BackendServer.EncryptKey = "0123456789abcdef"
+	st.BackendServer.MaxFilesSizeByte = 1 << 20
+	st.BackendServer.DedupDistance = 4
+	db := mock_database.NewMockDatabase(ctrl)
+	expectChecksumLocks(db)
+	repo, err := NewFileRepository(st, db)
+	assert.Nil(t, err)
+
+	var buf bytes.Buffer
+	assert.Nil(t, png.Encode(&buf, image.NewGray(image.Rect(0, 0, 8, 8))))
+	content := buf.Bytes()
+	existing := &models.File{Name: "saved-cat.png", UUID: "abc", UserId: 7}
+
+	t.Run("duplicate", func(t *testing.T) {
+		db.EXPECT().FindSimilarFile(7, gomock.Any(), 4).Return(existing, nil)
+
+		stored, err := repo.SaveEncryptedFile(models.File{Name: "cat.png", Size: len(content), TypeId: "image/png", UserId: 7, Content: content})
+		assert.Nil(t, err)
+		// Skipped file is reported by its own name, along with the file it matched
+		assert.Equal(t, "cat.png", stored.Name)
+		assert.Equal(t, "abc", stored.DuplicateOf)
+		assert.Empty(t, stored.UUID)
+		assert.Nil(t, stored.Content)
+	})
+	t.Run("burn_after_read", func(t *testing.T) {
+		// Burn after read files are stored on their own, as linking them would remove or keep the other file
+		db.EXPECT().GetFilesSize().Return(0, nil)
+		db.EXPECT().GetFileByChecksum(gomock.Any()).Return(nil, nil)
+		var saved models.File
+		db.EXPECT().SaveFile(gomock.Any()).DoAndReturn(func(file models.File) error {
+			saved = file
+			return nil
+		})
+
+		stored, err := repo.SaveEncryptedFile(models.File{Name: "cat.png", Size: len(content), TypeId: "image/png", UserId: 7, Content: content, BurnAfterRead: true})
+		assert.Nil(t, err)
+		assert.Empty(t, stored.DuplicateOf)
+		assert.NotEmpty(t, stored.UUID)
+		assert.True(t, saved.BurnAfterRead)
+	})
+}
@@ -0,0 +1,140 @@
+package file
+
+import (
+	"bytes"
+	"encoding/binary"
+	"image"
+	"image/jpeg"
+	"image/png"
+	"io"
+	"testing"
+
+	"github.com/golang/mock/gomock"
+	"github.com/lebleuciel/maani/models"
+	mock_database "github.com/lebleuciel/maani/pkg/database/mocks"
+	"github.com/lebleuciel/maani/pkg/exif"
+	"github.com/lebleuciel/maani/pkg/settings"
+	"github.com/stretchr/testify/assert"
+)
+
+// testExif is big endian exif metadata with camera make Test, orientation 6 and a GPS latitude reference
+var testExif = []byte("MM\x00\x2a\x00\x00\x00\x08" +
+	"\x00\x03" +
+	"\x01\x0f\x00\x02\x00\x00\x00\x05\x00\x00\x00\x32" +
+	"\x01\x12\x00\x03\x00\x00\x00\x01\x00\x06\x00\x00" +
+	"\x88\x25\x00\x04\x00\x00\x00\x01\x00\x00\x00\x38" +
+	"\x00\x00\x00\x00" +
+	"Test\x00\x00" +
+	"\x00\x01" +
+	"\x00\x01\x00\x02\x00\x00\x00\x02N\x00\x00\x00" +
+	"\x00\x00\x00\x00")
+
+// testPhoto returns a 20x10 JPEG image with testExif metadata
+func testPhoto(t *testing.T) []byte {
+	var buf bytes.Buffer
+	assert.Nil(t, jpeg.Encode(&buf, image.NewGray(image.Rect(0, 0, 20, 10)), nil))
+	content := buf.Bytes()
+
+	segment := binary.BigEndian.AppendUint16([]byte{0xFF, 0xE1}, uint16(2+6+len(testExif)))
+	segment = append(append(segment, "Exif\x00\x00"...), testExif...)
+	return append(append(append([]byte{}, content[:2]...), segment...), content[2:]...)
+}
+
+func TestFileRepository_SaveEncryptedFile_Metadata(t *testing.T) {
+	for _, keepGPS := range []bool{false, true} {
+		ctrl := gomock.NewController(t)
+
+		var st settings.Settings
+		st.BackendServer.FilePath = t.TempDir()
+		st.BackendServer.EncryptKey = "0123456789abcdef"
+		st.BackendServer.MaxFilesSizeByte = 1 << 20
+		st.BackendServer.DedupDistance = -1
+		st.BackendServer.KeepGPS = keepGPS
+		st.BackendServer.Renditions = map[string]settings.Rendition{
+			"thumb": {Width: 100, Height: 100, Mode: settings.RenditionFit},
+		}
+		db := mock_database.NewMockDatabase(ctrl)
+		expectChecksumLocks(db)
+		repo, err := NewFileRepository(st, db)
+		assert.Nil(t, err)
+
+		photo := testPhoto(t)
+		var saved models.File
+		db.EXPECT().GetFilesSize().Return(0, nil)
+		db.EXPECT().GetFileByChecksum(gomock.Any()).Return(nil, nil)
+		db.EXPECT().SaveFile(gomock.Any()).DoAndReturn(func(file models.File) error {
+			saved = file
+			return nil
+		})
+		_, err = repo.SaveEncryptedFile(models.File{Name: "photo.jpg", Size: len(photo), TypeId: "image/jpeg", Content: photo})
+		assert.Nil(t, err)
+
+		// Dimensions are of the image as displayed
+		assert.Equal(t, 10, saved.Width)
+		assert.Equal(t, 20, saved.Height)
+		assert.Equal(t, "Test", saved.CameraMake)
+
+		content, _, err := repo.OpenDecryptedFile(saved)
+		assert.Nil(t, err)
+		stored, err := io.ReadAll(content)
+		assert.Nil(t, err)
+		content.Close()
+		assert.Len(t, stored, len(photo))
+		meta, err := exif.Read(stored)
+		assert.Nil(t, err)
+		assert.Equal(t, keepGPS, meta.HasGPS)
+		assert.Equal(t, 6, meta.Orientation)
+
+		rendition, _, err := repo.OpenRendition(saved, "thumb", "image/png")
+		assert.Nil(t, err)
+		thumb, err := png.Decode(rendition)
+		assert.Nil(t, err)
+		assert.Equal(t, image.Rect(0, 0, 10, 20), thumb.Bounds())
+		rendition.Close()
+
+		ctrl.Finish()
+	}
+}
+
+// TestFileRepository_SaveEncryptedFile_XMPLocation tests GPS location is stripped from XMP metadata of images without exif metadata
+func TestFileRepository_SaveEncryptedFile_XMPLocation(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	var st settings.Settings
+	st.BackendServer.FilePath = t.TempDir()
+	st.BackendServer.EncryptKey = "0123456789abcdef"
+	st.BackendServer.MaxFilesSizeByte = 1 << 20
+	st.BackendServer.DedupDistance = -1
+	db := mock_database.NewMockDatabase(ctrl)
+	expectChecksumLocks(db)
+	repo, err := NewFileRepository(st, db)
+	assert.Nil(t, err)
+
+	var buf bytes.Buffer
+	assert.Nil(t, jpeg.Encode(&buf, image.NewGray(image.Rect(0, 0, 20, 10)), nil))
+	xmp := []byte("http://ns.adobe.com/xap/1.0/\x00" +
+		`<x:xmpmeta xmlns:x="adobe:ns:meta/"><rdf:RDF xmlns:rdf="http://www.w3.org/1999/02/22-rdf-syntax-ns#">` +
+		`<rdf:Description xmlns:exif="http://ns.adobe.com/exif/1.0/" exif:GPSLatitude="35,41.2024N"/></rdf:RDF></x:xmpmeta>`)
+	segment := append(binary.BigEndian.AppendUint16([]byte{0xFF, 0xE1}, uint16(2+len(xmp))), xmp...)
+	photo := append(append(append([]byte{}, buf.Bytes()[:2]...), segment...), buf.Bytes()[2:]...)
+
+	var saved models.File
+	db.EXPECT().GetFilesSize().Return(0, nil)
+	db.EXPECT().GetFileByChecksum(gomock.Any()).Return(nil, nil)
+	db.EXPECT().SaveFile(gomock.Any()).DoAndReturn(func(file models.File) error {
+		saved = file
+		return nil
+	})
+	_, err = repo.SaveEncryptedFile(models.File{Name: "photo.jpg", Size: len(photo), TypeId: "image/jpeg", Content: photo})
+	assert.Nil(t, err)
+
+	content, _, err := repo.OpenDecryptedFile(saved)
+	assert.Nil(t, err)
+	stored, err := io.ReadAll(content)
+	assert.Nil(t, err)
+	content.Close()
+	assert.Len(t, stored, len(photo))
+	assert.False(t, bytes.Contains(stored, []byte("35,41.2024N")))
+	assert.False(t, exif.HasXMPLocation(stored))
+}
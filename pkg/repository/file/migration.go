@@ -0,0 +1,152 @@
+package file
+
+import (
+	"bufio"
+	"context"
+	"crypto/sha256"
+	"encoding/hex"
+	"io"
+
+	"github.com/lebleuciel/maani/models"
+	"github.com/lebleuciel/maani/pkg/encryption"
+	"github.com/lebleuciel/maani/pkg/helpers"
+)
+
+// sniffLen is how much of content is enough to tell its media type
+const sniffLen = 512
+
+// MigrateBlobs moves blobs of files stored before content addressing from their uuid to their content address
+// and records checksum of their content. Files stored before envelope encryption get a data key on the way,
+// and type of files is corrected to the format their content is stored in.
+// Migrated files are not touched again, so an interrupted migration is resumed by the next call.
+func (f *FileRepository) MigrateBlobs(ctx context.Context, batchSize int) (migrated int, failed int, err error) {
+	if batchSize <= 0 {
+		return 0, 0, ErrInvalidBatchSize
+	}
+
+	afterUUID := ""
+	for {
+		if err := ctx.Err(); err != nil {
+			return migrated, failed, err
+		}
+
+		files, err := f.db.GetFilesToMigrate(afterUUID, batchSize)
+		if err != nil {
+			logger.Errorw("can't get files to migrate from database", "error", err)
+			return migrated, failed, err
+		}
+		if len(files) == 0 {
+			break
+		}
+
+		for _, file := range files {
+			afterUUID = file.UUID
+			err := f.migrateBlob(ctx, file)
+			if err != nil {
+				logger.Errorw("can't migrate file blob", "uuid", file.UUID, "error", err)
+				failed++
+				continue
+			}
+			migrated++
+		}
+		logger.Infow("blob migration progress", "migrated", migrated, "failed", failed)
+	}
+	return migrated, failed, nil
+}
+
+// migrateBlob moves blob of a single file, old blob is removed only once database points to the new one
+func (f *FileRepository) migrateBlob(ctx context.Context, file models.File) error {
+	keys, err := f.fileKeys(file)
+	if err != nil {
+		return err
+	}
+	checksum, typeId, err := f.inspectBlob(ctx, file, keys)
+	if err != nil {
+		return err
+	}
+	destKey := helpers.BlobKey(checksum)
+
+	// Blob at content address is written and its data key is picked under lock of checksum, as uploads do
+	unlock, err := f.db.LockChecksum(checksum)
+	if err != nil {
+		return err
+	}
+	defer unlock()
+
+	existing, err := f.db.GetFileByChecksum(checksum)
+	if err != nil {
+		return err
+	}
+	switch {
+	case existing != nil:
+		// Content is already stored, file shares that blob and the data key it is encrypted with
+		file.KeyId, file.WrappedKey = existing.KeyId, existing.WrappedKey
+	case file.WrappedKey != nil:
+		// Ciphertext does not depend on where it is kept, so it is copied as is
+		err = f.copyBlob(ctx, file.UUID, destKey)
+		if err != nil {
+			return err
+		}
+	default:
+		dek, err := encryption.GenerateDataKey()
+		if err != nil {
+			return err
+		}
+		file.WrappedKey, file.KeyId, err = encryption.WrapDataKey(f.keys, dek)
+		if err != nil {
+			return err
+		}
+		err = helpers.ReencryptBlob(ctx, f.blobs, file.UUID, destKey, keys, dek)
+		if err != nil {
+			return err
+		}
+	}
+
+	if typeId != file.TypeId {
+		// Images were stored resized as JPEG while keeping type they were uploaded with
+		err = f.db.AddFileTypeIfNotExist(typeId)
+		if err != nil {
+			return err
+		}
+		err = f.db.UpdateFileType(file.UUID, typeId)
+		if err != nil {
+			return err
+		}
+	}
+	err = f.db.UpdateFileBlob(file.UUID, checksum, file.KeyId, file.WrappedKey)
+	if err != nil {
+		return err
+	}
+	return f.blobs.Delete(ctx, file.UUID)
+}
+
+// inspectBlob returns hex SHA-256 and media type of decrypted content of blob of file
+func (f *FileRepository) inspectBlob(ctx context.Context, file models.File, keys encryption.KeySource) (string, string, error) {
+	content, _, err := helpers.OpenDecryptedFile(ctx, f.blobs, file.UUID, keys)
+	if err != nil {
+		return "", "", err
+	}
+	defer content.Close()
+
+	src := bufio.NewReader(content)
+	head, err := src.Peek(sniffLen)
+	if err != nil && err != io.EOF {
+		return "", "", err
+	}
+	typeId := helpers.DetectMediaType(file.TypeId, head)
+
+	hash := sha256.New()
+	if _, err := io.Copy(hash, src); err != nil {
+		return "", "", err
+	}
+	return hex.EncodeToString(hash.Sum(nil)), typeId, nil
+}
+
+func (f *FileRepository) copyBlob(ctx context.Context, srcKey string, destKey string) error {
+	blob, _, err := f.blobs.Get(ctx, srcKey)
+	if err != nil {
+		return err
+	}
+	defer blob.Close()
+	return f.blobs.Put(ctx, destKey, blob)
+}
@@ -0,0 +1,107 @@
+package file
+
+import (
+	"bytes"
+	"context"
+	"io"
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/golang/mock/gomock"
+	"github.com/lebleuciel/maani/models"
+	"github.com/lebleuciel/maani/pkg/blobstore"
+	mock_database "github.com/lebleuciel/maani/pkg/database/mocks"
+	"github.com/lebleuciel/maani/pkg/encryption"
+	"github.com/lebleuciel/maani/pkg/helpers"
+	"github.com/lebleuciel/maani/pkg/settings"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestFileRepository_MigrateBlobs(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	var st settings.Settings
+	st.BackendServer.FilePath = t.TempDir()
+	st.BackendServer.EncryptKey = "0123456789abcdef"
+	keys, err := encryption.KeyringFromSettings(st)
+	assert.Nil(t, err)
+	store, err := blobstore.NewLocalStore(st.BackendServer.FilePath)
+	assert.Nil(t, err)
+	ctx := context.Background()
+
+	// Envelope encrypted flat blobs, two of them with the same content
+	envelopeFile := func(uuid string, content string) models.File {
+		dek, err := encryption.GenerateDataKey()
+		assert.Nil(t, err)
+		wrapped, keyId, err := encryption.WrapDataKey(keys, dek)
+		assert.Nil(t, err)
+		assert.Nil(t, helpers.SaveEncryptedFile(ctx, store, uuid, []byte(content), dek))
+		return models.File{UUID: uuid, KeyId: keyId, WrappedKey: wrapped}
+	}
+	first := envelopeFile("a-envelope", "shared content")
+	duplicate := envelopeFile("c-duplicate", "shared content")
+
+	// Flat blob stored before envelope encryption is encrypted directly with keyring key
+	var blob bytes.Buffer
+	w, err := encryption.NewWriter(&blob, encryption.DefaultKeyId, []byte(st.BackendServer.EncryptKey))
+	assert.Nil(t, err)
+	_, err = w.Write([]byte("direct content"))
+	assert.Nil(t, err)
+	assert.Nil(t, w.Close())
+	assert.Nil(t, store.Put(ctx, "b-direct", &blob))
+	// Content was labeled with type it was uploaded with instead of the format it is stored in
+	direct := models.File{UUID: "b-direct", KeyId: encryption.DefaultKeyId, TypeId: "image/png"}
+	missing := models.File{UUID: "d-missing", KeyId: first.KeyId, WrappedKey: first.WrappedKey}
+
+	db := mock_database.NewMockDatabase(ctrl)
+	expectChecksumLocks(db)
+	repo, err := NewFileRepository(st, db)
+	assert.Nil(t, err)
+
+	migrated := make(map[string]models.File)
+	db.EXPECT().GetFilesToMigrate("", 10).Return([]models.File{first, direct, duplicate, missing}, nil)
+	db.EXPECT().GetFilesToMigrate(missing.UUID, 10).Return(nil, nil)
+	db.EXPECT().GetFileByChecksum(gomock.Any()).DoAndReturn(func(sha256 string) (*models.File, error) {
+		for _, file := range migrated {
+			if file.SHA256 == sha256 {
+				return &file, nil
+			}
+		}
+		return nil, nil
+	}).Times(3)
+	db.EXPECT().AddFileTypeIfNotExist("text/plain").Return(nil)
+	db.EXPECT().UpdateFileType(direct.UUID, "text/plain").Return(nil)
+	db.EXPECT().UpdateFileBlob(gomock.Any(), gomock.Any(), gomock.Any(), gomock.Any()).DoAndReturn(func(uuid string, sha256 string, keyId string, wrappedKey []byte) error {
+		migrated[uuid] = models.File{UUID: uuid, SHA256: sha256, KeyId: keyId, WrappedKey: wrappedKey}
+		return nil
+	}).Times(3)
+
+	done, failed, err := repo.MigrateBlobs(ctx, 10)
+	assert.Nil(t, err)
+	assert.Equal(t, 3, done)
+	assert.Equal(t, 1, failed)
+
+	// Files with the same content share a blob and its data key
+	assert.Equal(t, migrated[first.UUID].SHA256, migrated[duplicate.UUID].SHA256)
+	assert.Equal(t, migrated[first.UUID].WrappedKey, migrated[duplicate.UUID].WrappedKey)
+	assert.NotNil(t, migrated[direct.UUID].WrappedKey)
+
+	blobs, err := store.List(ctx, "")
+	assert.Nil(t, err)
+	assert.Len(t, blobs, 2)
+	for _, file := range []models.File{first, direct, duplicate} {
+		_, err := os.Stat(filepath.Join(st.BackendServer.FilePath, file.UUID))
+		assert.True(t, os.IsNotExist(err))
+	}
+
+	for uuid, plain := range map[string]string{first.UUID: "shared content", duplicate.UUID: "shared content", direct.UUID: "direct content"} {
+		content, _, err := repo.OpenDecryptedFile(migrated[uuid])
+		assert.Nil(t, err)
+		got, err := io.ReadAll(content)
+		assert.Nil(t, err)
+		assert.Equal(t, plain, string(got))
+		content.Close()
+	}
+}
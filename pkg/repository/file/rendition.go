@@ -0,0 +1,187 @@
+package file
+
+import (
+	"bytes"
+	"context"
+	"errors"
+	"fmt"
+	"image"
+	"io"
+	"path"
+
+	"github.com/lebleuciel/maani/models"
+	"github.com/lebleuciel/maani/pkg/blobstore"
+	"github.com/lebleuciel/maani/pkg/exif"
+	"github.com/lebleuciel/maani/pkg/helpers"
+	"github.com/lebleuciel/maani/pkg/settings"
+)
+
+// renditionsPrefix keeps rendition blobs apart from original blobs
+const renditionsPrefix = "renditions"
+
+// HasRendition reports whether a rendition is defined in settings
+func (f *FileRepository) HasRendition(name string) bool {
+	_, ok := f.st.BackendServer.Renditions[name]
+	return ok
+}
+
+// RenditionFormat picks media type renditions of an image file are sent with from an Accept header.
+// Format configured for type of the original is preferred, then the original format and widely supported formats.
+func (f *FileRepository) RenditionFormat(file models.File, accept string) (string, error) {
+	if !helpers.IsImageType(file.TypeId) {
+		return "", ErrNoRenditions
+	}
+	offers := []string{f.imageFormat(file).Format}
+	for _, format := range []string{file.TypeId, "image/webp", "image/png", "image/jpeg"} {
+		if format != offers[0] {
+			offers = append(offers, format)
+		}
+	}
+	format := helpers.NegotiateType(accept, offers)
+	if format == "" {
+		return "", ErrNotAcceptable
+	}
+	return format, nil
+}
+
+// imageFormat returns how renditions of an image file are encoded by default, they keep format of the original unless configured
+func (f *FileRepository) imageFormat(file models.File) settings.ImageFormat {
+	format := f.st.BackendServer.ImageFormats[file.TypeId]
+	if format.Format == "" {
+		format.Format = file.TypeId
+	}
+	return format
+}
+
+// OpenRendition streams a rendition of an image file encoded with format media type and returns its size.
+// Renditions missing from store are generated from the original and stored for next requests.
+func (f *FileRepository) OpenRendition(file models.File, name string, format string) (io.ReadCloser, int64, error) {
+	rendition, ok := f.st.BackendServer.Renditions[name]
+	if !ok {
+		return nil, 0, ErrUnknownRendition
+	}
+	if !helpers.IsImageType(file.TypeId) {
+		return nil, 0, ErrNoRenditions
+	}
+	output := f.imageFormat(file)
+	if format != output.Format {
+		// Quality is configured for the preferred format only
+		output = settings.ImageFormat{Format: format, Quality: output.Quality}
+	}
+	ctx := context.Background()
+	key := renditionKey(file, rendition, output)
+
+	// Renditions are encrypted with data key of their file, files stored before envelope encryption have none to cache them with
+	if file.WrappedKey != nil {
+		keys, err := f.fileKeys(file)
+		if err != nil {
+			return nil, 0, err
+		}
+		content, size, err := helpers.OpenDecryptedFile(ctx, f.blobs, key, keys)
+		if err == nil {
+			return content, size, nil
+		}
+		if !errors.Is(err, blobstore.ErrNotFound) {
+			return nil, 0, err
+		}
+	}
+
+	original, _, err := f.OpenDecryptedFile(file)
+	if err != nil {
+		return nil, 0, err
+	}
+	defer original.Close()
+	content, err := io.ReadAll(original)
+	if err != nil {
+		return nil, 0, err
+	}
+	// Renditions have no metadata, so orientation of the original is applied to them
+	meta, err := exif.Read(content)
+	if err != nil && !errors.Is(err, exif.ErrNoExif) {
+		logger.Warnw("ignoring malformed exif metadata", "uuid", file.UUID, "error", err)
+	}
+	img, err := decodeImage(content, meta.Orientation)
+	if err != nil {
+		return nil, 0, err
+	}
+	rendered, err := encodeRendition(img, rendition, output)
+	if err != nil {
+		return nil, 0, err
+	}
+
+	if file.WrappedKey != nil {
+		err = f.saveRendition(ctx, file, key, rendered)
+		if err != nil {
+			// Rendition is generated again on next request
+			logger.Errorw("can't save rendition", "uuid", file.UUID, "rendition", name, "error", err)
+		}
+	}
+	return io.NopCloser(bytes.NewReader(rendered)), int64(len(rendered)), nil
+}
+
+// saveEagerRenditions generates renditions which are not left for their first request.
+// Failures are only logged, since missing renditions are generated again when requested.
+func (f *FileRepository) saveEagerRenditions(file models.File, img image.Image) {
+	ctx := context.Background()
+	output := f.imageFormat(file)
+	for name, rendition := range f.st.BackendServer.Renditions {
+		if !rendition.Eager {
+			continue
+		}
+		key := renditionKey(file, rendition, output)
+		_, err := f.blobs.Stat(ctx, key)
+		if err == nil {
+			// Files with the same content share their renditions
+			continue
+		}
+		rendered, err := encodeRendition(img, rendition, output)
+		if err == nil {
+			err = f.saveRendition(ctx, file, key, rendered)
+		}
+		if err != nil {
+			logger.Errorw("can't save rendition", "uuid", file.UUID, "rendition", name, "error", err)
+		}
+	}
+}
+
+func (f *FileRepository) saveRendition(ctx context.Context, file models.File, key string, rendered []byte) error {
+	dek, err := f.dataKey(file)
+	if err != nil {
+		return err
+	}
+	return helpers.SaveEncryptedFile(ctx, f.blobs, key, rendered, dek)
+}
+
+// deleteRenditions removes every rendition generated from blob key
+func (f *FileRepository) deleteRenditions(ctx context.Context, key string) error {
+	renditions, err := f.blobs.List(ctx, path.Join(renditionsPrefix, key)+"/")
+	if err != nil {
+		return err
+	}
+	for _, rendition := range renditions {
+		err = f.blobs.Delete(ctx, rendition.Key)
+		if err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+func encodeRendition(img image.Image, rendition settings.Rendition, output settings.ImageFormat) ([]byte, error) {
+	buf := new(bytes.Buffer)
+	err := helpers.EncodeImage(buf, helpers.RenderImage(img, rendition), output)
+	if err != nil {
+		return nil, err
+	}
+	return buf.Bytes(), nil
+}
+
+// renditionKey returns key of a rendition blob, renditions live next to each other under blob key of their original.
+// Each output format of a rendition is kept apart, so changing format or quality generates it again.
+func renditionKey(file models.File, rendition settings.Rendition, output settings.ImageFormat) string {
+	id := helpers.RenditionId(rendition)
+	if output.Quality > 0 && output.Format == "image/jpeg" {
+		id = fmt.Sprintf("%s-q%d", id, output.Quality)
+	}
+	return path.Join(renditionsPrefix, blobKey(file), id+helpers.ImageExtension(output.Format))
+}
@@ -0,0 +1,109 @@
+package file
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/lebleuciel/maani/models"
+)
+
+// RotateKey wraps data key of every file with the active key-encryption key and records progress in database.
+// Only files stored before envelope encryption are re-encrypted, others just get their data key rewrapped.
+// Interrupted rotations stay running and are resumed by the next call, files already rotated are not touched again.
+func (f *FileRepository) RotateKey(ctx context.Context, batchSize int) (models.KeyRotation, error) {
+	if batchSize <= 0 {
+		return models.KeyRotation{}, ErrInvalidBatchSize
+	}
+	keyId := f.keys.ActiveId()
+	remaining, err := f.db.CountFilesToRotate(keyId)
+	if err != nil {
+		logger.Errorw("can't count files to rotate from database", "error", err)
+		return models.KeyRotation{}, err
+	}
+
+	existing, err := f.db.GetRunningKeyRotation(keyId)
+	if err != nil {
+		logger.Errorw("can't get running key rotation from database", "error", err)
+		return models.KeyRotation{}, err
+	}
+	var rotation models.KeyRotation
+	if existing != nil {
+		// Failed files of previous run are retried, so they are counted as remaining again
+		rotation = *existing
+		rotation.Total = rotation.Done + remaining
+		rotation.Failed = 0
+		rotation.Error = ""
+	} else {
+		rotation, err = f.db.CreateKeyRotation(models.KeyRotation{KeyId: keyId, Total: remaining})
+		if err != nil {
+			logger.Errorw("can't create key rotation in database", "error", err)
+			return models.KeyRotation{}, err
+		}
+	}
+	logger.Infow("rotating file keys", "keyId", keyId, "rotation", rotation.Id, "remaining", remaining)
+
+	afterUUID := ""
+	for {
+		if err := ctx.Err(); err != nil {
+			updateErr := f.updateKeyRotation(rotation)
+			if updateErr != nil {
+				return rotation, updateErr
+			}
+			return rotation, err
+		}
+
+		files, err := f.db.GetFilesToRotate(keyId, afterUUID, batchSize)
+		if err != nil {
+			logger.Errorw("can't get files to rotate from database", "error", err)
+			return rotation, f.finishKeyRotation(&rotation, err)
+		}
+		if len(files) == 0 {
+			break
+		}
+
+		for _, file := range files {
+			afterUUID = file.UUID
+			err := f.RotateFileKey(file)
+			if err != nil {
+				logger.Errorw("can't rotate file key", "uuid", file.UUID, "error", err)
+				rotation.Failed++
+				continue
+			}
+			rotation.Done++
+		}
+		err = f.updateKeyRotation(rotation)
+		if err != nil {
+			return rotation, err
+		}
+		logger.Infow("key rotation progress", "rotation", rotation.Id, "done", rotation.Done, "failed", rotation.Failed, "total", rotation.Total)
+	}
+
+	if rotation.Failed > 0 {
+		return rotation, f.finishKeyRotation(&rotation, fmt.Errorf("key of %d files could not be rotated", rotation.Failed))
+	}
+	now := time.Now()
+	rotation.Status = models.KeyRotationCompleted
+	rotation.FinishedAt = &now
+	return rotation, f.updateKeyRotation(rotation)
+}
+
+func (f *FileRepository) finishKeyRotation(rotation *models.KeyRotation, failure error) error {
+	now := time.Now()
+	rotation.Status = models.KeyRotationFailed
+	rotation.Error = failure.Error()
+	rotation.FinishedAt = &now
+	err := f.updateKeyRotation(*rotation)
+	if err != nil {
+		return err
+	}
+	return failure
+}
+
+func (f *FileRepository) updateKeyRotation(rotation models.KeyRotation) error {
+	err := f.db.UpdateKeyRotation(rotation)
+	if err != nil {
+		logger.Errorw("can't update key rotation in database", "error", err)
+	}
+	return err
+}
@@ -0,0 +1,133 @@
+package file
+
+import (
+	"bytes"
+	"context"
+	"io"
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/golang/mock/gomock"
+	"github.com/lebleuciel/maani/models"
+	"github.com/lebleuciel/maani/pkg/blobstore"
+	mock_database "github.com/lebleuciel/maani/pkg/database/mocks"
+	"github.com/lebleuciel/maani/pkg/encryption"
+	"github.com/lebleuciel/maani/pkg/helpers"
+	"github.com/lebleuciel/maani/pkg/settings"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestFileRepository_RotateKey(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	var st settings.Settings
+	st.BackendServer.FilePath = t.TempDir()
+	st.BackendServer.EncryptKey = "0123456789abcdef"
+	oldKeys, err := encryption.KeyringFromSettings(st)
+	assert.Nil(t, err)
+
+	// Envelope encrypted file only needs its data key rewrapped
+	dek, err := encryption.GenerateDataKey()
+	assert.Nil(t, err)
+	wrapped, keyId, err := encryption.WrapDataKey(oldKeys, dek)
+	assert.Nil(t, err)
+	store, err := blobstore.NewLocalStore(st.BackendServer.FilePath)
+	assert.Nil(t, err)
+	envelope := "envelope"
+	assert.Nil(t, helpers.SaveEncryptedFile(context.Background(), store, envelope, []byte("envelope"), dek))
+	envelopeBlob, err := os.ReadFile(filepath.Join(st.BackendServer.FilePath, envelope))
+	assert.Nil(t, err)
+
+	// File stored before envelope encryption is encrypted directly with keyring key
+	var blob bytes.Buffer
+	w, err := encryption.NewWriter(&blob, encryption.DefaultKeyId, []byte(st.BackendServer.EncryptKey))
+	assert.Nil(t, err)
+	_, err = w.Write([]byte("direct"))
+	assert.Nil(t, err)
+	assert.Nil(t, w.Close())
+	assert.Nil(t, os.WriteFile(filepath.Join(st.BackendServer.FilePath, "direct"), blob.Bytes(), 0o600))
+
+	files := []models.File{
+		{UUID: "direct", KeyId: encryption.DefaultKeyId},
+		{UUID: envelope, KeyId: keyId, WrappedKey: wrapped},
+	}
+	missing := models.File{UUID: "zzzz-missing", KeyId: encryption.DefaultKeyId}
+
+	st.BackendServer.EncryptKeys = map[string]string{"next": "fedcba9876543210"}
+	st.BackendServer.ActiveKeyId = "next"
+	db := mock_database.NewMockDatabase(ctrl)
+	repo, err := NewFileRepository(st, db)
+	assert.Nil(t, err)
+
+	var saved models.KeyRotation
+	rotatedKeys := make(map[string][]byte)
+	db.EXPECT().CountFilesToRotate("next").Return(3, nil)
+	db.EXPECT().GetRunningKeyRotation("next").Return(nil, nil)
+	db.EXPECT().CreateKeyRotation(models.KeyRotation{KeyId: "next", Total: 3}).
+		Return(models.KeyRotation{Id: 1, KeyId: "next", Status: models.KeyRotationRunning, Total: 3}, nil)
+	db.EXPECT().GetFilesToRotate("next", "", 2).Return(files, nil)
+	db.EXPECT().GetFilesToRotate("next", envelope, 2).Return([]models.File{missing}, nil)
+	db.EXPECT().GetFilesToRotate("next", missing.UUID, 2).Return(nil, nil)
+	db.EXPECT().UpdateFileKey(gomock.Any(), "next", gomock.Any()).DoAndReturn(func(uuid string, keyId string, wrappedKey []byte) error {
+		rotatedKeys[uuid] = wrappedKey
+		return nil
+	}).Times(2)
+	db.EXPECT().UpdateKeyRotation(gomock.Any()).DoAndReturn(func(rotation models.KeyRotation) error {
+		saved = rotation
+		return nil
+	}).AnyTimes()
+
+	rotation, err := repo.RotateKey(context.Background(), 2)
+	assert.NotNil(t, err)
+	assert.Equal(t, rotation, saved)
+	assert.Equal(t, models.KeyRotationFailed, rotation.Status)
+	assert.Equal(t, 2, rotation.Done)
+	assert.Equal(t, 1, rotation.Failed)
+	assert.NotNil(t, rotation.FinishedAt)
+
+	// Rewrapping leaves blob untouched
+	rewrapped, err := os.ReadFile(filepath.Join(st.BackendServer.FilePath, envelope))
+	assert.Nil(t, err)
+	assert.Equal(t, envelopeBlob, rewrapped)
+
+	// Rotated files are readable with new key only
+	newOnly, err := encryption.NewKeyring(st.BackendServer.EncryptKeys, "next", "")
+	assert.Nil(t, err)
+	for uuid, plain := range map[string]string{envelope: "envelope", "direct": "direct"} {
+		dek, err := encryption.UnwrapDataKey(newOnly, rotatedKeys[uuid], "next")
+		assert.Nil(t, err)
+		content, _, err := helpers.OpenDecryptedFile(context.Background(), store, uuid, encryption.FileKeys{DataKey: dek, Provider: newOnly})
+		assert.Nil(t, err)
+		got, err := io.ReadAll(content)
+		assert.Nil(t, err)
+		assert.Equal(t, plain, string(got))
+		content.Close()
+	}
+}
+
+func TestFileRepository_RotateKey_Resume(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	var st settings.Settings
+	st.BackendServer.FilePath = t.TempDir()
+	st.BackendServer.EncryptKey = "0123456789abcdef"
+	db := mock_database.NewMockDatabase(ctrl)
+	repo, err := NewFileRepository(st, db)
+	assert.Nil(t, err)
+
+	running := models.KeyRotation{Id: 4, KeyId: encryption.DefaultKeyId, Status: models.KeyRotationRunning, Total: 10, Done: 7, Failed: 1}
+	db.EXPECT().CountFilesToRotate(encryption.DefaultKeyId).Return(0, nil)
+	db.EXPECT().GetRunningKeyRotation(encryption.DefaultKeyId).Return(&running, nil)
+	db.EXPECT().GetFilesToRotate(encryption.DefaultKeyId, "", 100).Return(nil, nil)
+	db.EXPECT().UpdateKeyRotation(gomock.Any()).Return(nil)
+
+	rotation, err := repo.RotateKey(context.Background(), 100)
+	assert.Nil(t, err)
+	assert.Equal(t, 4, rotation.Id)
+	assert.Equal(t, models.KeyRotationCompleted, rotation.Status)
+	assert.Equal(t, 7, rotation.Total)
+	assert.Equal(t, 0, rotation.Failed)
+}
@@ -37,9 +37,16 @@ func (r *UserRepository) CreateUser(spec models.UserCreationParameters) (models.
 	return user, nil
 }
 
-func (r *UserRepository) GetUserList() ([]models.User, error) {
-	users, err := r.db.GetUserList()
-	return users, err
+// GetUserList returns a page of users sorted and filtered by options
+func (r *UserRepository) GetUserList(options database.ListOptions) (models.UserList, error) {
+	users, nextCursor, err := r.db.GetUserList(options)
+	if err != nil {
+		return models.UserList{}, err
+	}
+	if users == nil {
+		users = []models.User{}
+	}
+	return models.UserList{Users: users, NextCursor: nextCursor}, nil
 }
 
 func (r *UserRepository) UpdateUserLastLogin(userId int) error {
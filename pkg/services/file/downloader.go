@@ -0,0 +1,83 @@
+package file
+
+import (
+	"context"
+	"sort"
+	"time"
+
+	"github.com/lebleuciel/maani/models"
+)
+
+// ImageJobResult is the outcome of ingesting a single search candidate
+type ImageJobResult struct {
+	Index     int
+	Candidate ImageSearchResult
+	File      models.File
+	Err       error
+}
+
+// imageHandler downloads and stores a single search candidate
+type imageHandler func(ctx context.Context, index int, candidate ImageSearchResult) (models.File, error)
+
+// ImageDownloader ingests search candidates with a bounded pool of workers
+type ImageDownloader struct {
+	concurrency int
+	timeout     time.Duration
+}
+
+func NewImageDownloader(concurrency int, timeout time.Duration) *ImageDownloader {
+	if concurrency <= 0 {
+		concurrency = 1
+	}
+	return &ImageDownloader{
+		concurrency: concurrency,
+		timeout:     timeout,
+	}
+}
+
+// Run handles candidates until max of them succeed or candidates run out.
+// A new candidate is only started while succeeded and in-flight jobs are below max,
+// so failures are replaced by later candidates and no more than max results are ever stored.
+// Both returned slices are ordered by candidate index.
+func (d *ImageDownloader) Run(ctx context.Context, candidates []ImageSearchResult, max int, handle imageHandler) (succeeded []ImageJobResult, failed []ImageJobResult) {
+	results := make(chan ImageJobResult)
+	next, inFlight := 0, 0
+
+	for {
+		for inFlight < d.concurrency && len(succeeded)+inFlight < max && next < len(candidates) && ctx.Err() == nil {
+			go d.work(ctx, next, candidates[next], handle, results)
+			next++
+			inFlight++
+		}
+		if inFlight == 0 {
+			break
+		}
+
+		result := <-results
+		inFlight--
+		if result.Err != nil {
+			failed = append(failed, result)
+		} else {
+			succeeded = append(succeeded, result)
+		}
+	}
+
+	sort.Slice(succeeded, func(i, j int) bool { return succeeded[i].Index < succeeded[j].Index })
+	sort.Slice(failed, func(i, j int) bool { return failed[i].Index < failed[j].Index })
+	return succeeded, failed
+}
+
+func (d *ImageDownloader) work(ctx context.Context, index int, candidate ImageSearchResult, handle imageHandler, results chan<- ImageJobResult) {
+	if d.timeout > 0 {
+		var cancel context.CancelFunc
+		ctx, cancel = context.WithTimeout(ctx, d.timeout)
+		defer cancel()
+	}
+	file, err := handle(ctx, index, candidate)
+	results <- ImageJobResult{
+		Index:     index,
+		Candidate: candidate,
+		File:      file,
+		Err:       err,
+	}
+}
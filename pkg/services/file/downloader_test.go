@@ -0,0 +1,87 @@
+package file
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"strings"
+	"sync/atomic"
+	"testing"
+	"time"
+
+	"github.com/lebleuciel/maani/models"
+	"github.com/stretchr/testify/assert"
+)
+
+func newCandidates(n int) []ImageSearchResult {
+	candidates := make([]ImageSearchResult, 0, n)
+	for i := 0; i < n; i++ {
+		candidates = append(candidates, ImageSearchResult{URL: fmt.Sprintf("https://images.foo/%d.jpg", i)})
+	}
+	return candidates
+}
+
+func TestImageDownloader_Run(t *testing.T) {
+	t.Run("exact_cap_with_failures", func(t *testing.T) {
+		downloader := NewImageDownloader(4, time.Second)
+		var handled int32
+		saved, failed := downloader.Run(context.Background(), newCandidates(20), 5, func(ctx context.Context, i int, c ImageSearchResult) (models.File, error) {
+			atomic.AddInt32(&handled, 1)
+			// every even candidate fails
+			var n int
+			fmt.Sscanf(c.URL, "https://images.foo/%d.jpg", &n)
+			if n%2 == 0 {
+				return models.File{}, errors.New("broken image")
+			}
+			return models.File{Name: c.URL}, nil
+		})
+		assert.Len(t, saved, 5)
+		assert.Equal(t, int(atomic.LoadInt32(&handled)), len(saved)+len(failed))
+		for i := 1; i < len(saved); i++ {
+			assert.Less(t, saved[i-1].Index, saved[i].Index)
+		}
+		for i := 1; i < len(failed); i++ {
+			assert.Less(t, failed[i-1].Index, failed[i].Index)
+		}
+	})
+	t.Run("not_enough_candidates", func(t *testing.T) {
+		downloader := NewImageDownloader(3, time.Second)
+		saved, failed := downloader.Run(context.Background(), newCandidates(4), 10, func(ctx context.Context, i int, c ImageSearchResult) (models.File, error) {
+			if strings.HasSuffix(c.URL, "1.jpg") {
+				return models.File{}, errors.New("broken image")
+			}
+			return models.File{Name: c.URL}, nil
+		})
+		assert.Len(t, saved, 3)
+		assert.Len(t, failed, 1)
+		assert.Equal(t, []int{0, 2, 3}, []int{saved[0].Index, saved[1].Index, saved[2].Index})
+	})
+	t.Run("bounded_concurrency", func(t *testing.T) {
+		downloader := NewImageDownloader(2, time.Second)
+		var running, peak int32
+		saved, _ := downloader.Run(context.Background(), newCandidates(10), 10, func(ctx context.Context, i int, c ImageSearchResult) (models.File, error) {
+			current := atomic.AddInt32(&running, 1)
+			for {
+				old := atomic.LoadInt32(&peak)
+				if current <= old || atomic.CompareAndSwapInt32(&peak, old, current) {
+					break
+				}
+			}
+			time.Sleep(5 * time.Millisecond)
+			atomic.AddInt32(&running, -1)
+			return models.File{Name: c.URL}, nil
+		})
+		assert.Len(t, saved, 10)
+		assert.LessOrEqual(t, atomic.LoadInt32(&peak), int32(2))
+	})
+	t.Run("download_timeout", func(t *testing.T) {
+		downloader := NewImageDownloader(2, 10*time.Millisecond)
+		saved, failed := downloader.Run(context.Background(), newCandidates(2), 2, func(ctx context.Context, i int, c ImageSearchResult) (models.File, error) {
+			<-ctx.Done()
+			return models.File{}, ctx.Err()
+		})
+		assert.Len(t, saved, 0)
+		assert.Len(t, failed, 2)
+		assert.ErrorIs(t, failed[0].Err, context.DeadlineExceeded)
+	})
+}
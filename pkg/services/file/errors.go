@@ -3,3 +3,5 @@ package file
 import "github.com/pkg/errors"
 
 var ErrNilFileRepo = errors.New("File repository can not be nil")
+var ErrUnknownSearchProvider = errors.New("Image search provider is not supported")
+var ErrInvalidUserId = errors.New("can not parse user id from header")
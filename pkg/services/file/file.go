@@ -1,23 +1,42 @@
 package file
 
 import (
+	"context"
+	"encoding/base64"
+	"encoding/hex"
 	"fmt"
+	"io"
 	"log"
 	"net/http"
-	"os"
+	"regexp"
 	"strconv"
+	"strings"
 
-	"github.com/antchfx/htmlquery"
 	"github.com/gin-gonic/gin"
 	"github.com/lebleuciel/maani/models"
+	"github.com/lebleuciel/maani/pkg/blobstore"
 	"github.com/lebleuciel/maani/pkg/database"
+	"github.com/lebleuciel/maani/pkg/filequery"
 	"github.com/lebleuciel/maani/pkg/helpers"
 	repository "github.com/lebleuciel/maani/pkg/repository/file"
 	"github.com/lebleuciel/maani/pkg/settings"
 	"github.com/lib/pq"
+	"github.com/pkg/errors"
 	"go.uber.org/zap"
 )
 
+const (
+	// searchPageLimit is number of files in a page of search results when no limit is asked for, maxSearchPageLimit is the most which can be asked for
+	searchPageLimit    = 20
+	maxSearchPageLimit = 100
+
+	// maxTextQueryLength is the longest text of full-text search in bytes
+	maxTextQueryLength = 256
+)
+
+// usableFileName matches plain file names with an extension, such as cat.jpg
+var usableFileName = regexp.MustCompile(`^[\w\-. ]{1,128}\.[A-Za-z0-9]{2,5}$`)
+
 // logger is a global variable for logging using Zap.
 var logger *zap.SugaredLogger
 
@@ -32,96 +51,160 @@ func init() {
 }
 
 type FileService struct {
-	st         settings.Settings
-	db         database.Database
-	repository *repository.FileRepository
+	st             settings.Settings
+	db             database.Database
+	repository     *repository.FileRepository
+	searchProvider ImageSearchProvider
+	downloader     *ImageDownloader
+	searchJobs     chan models.SearchJob
+	fetcher        *helpers.Fetcher
+	// runner is id of this store process among store processes claiming search jobs
+	runner string
 }
 
 func NewFileService(repo *repository.FileRepository, st settings.Settings, db database.Database) (*FileService, error) {
 	if repo == nil {
 		return nil, ErrNilFileRepo
 	}
+	searchProvider, err := NewImageSearchProvider(st)
+	if err != nil {
+		return nil, err
+	}
+	runner, err := helpers.GenerateUUID()
+	if err != nil {
+		return nil, err
+	}
 	return &FileService{
-		st:         st,
-		db:         db,
-		repository: repo,
+		runner:         runner,
+		st:             st,
+		db:             db,
+		repository:     repo,
+		searchProvider: searchProvider,
+		downloader:     NewImageDownloader(st.ImageSearch.Concurrency, st.ImageSearch.DownloadTimeout),
+		searchJobs:     make(chan models.SearchJob, st.ImageSearch.JobQueueSize),
+		fetcher: helpers.NewFetcher(helpers.FetcherOptions{
+			MaxBytes:             st.ImageSearch.MaxImageBytes,
+			ConnectTimeout:       st.ImageSearch.ConnectTimeout,
+			ReadTimeout:          st.ImageSearch.ReadTimeout,
+			MaxRedirects:         st.ImageSearch.MaxRedirects,
+			AllowPrivateNetworks: st.ImageSearch.AllowPrivateNetworks,
+			AllowType: func(contentType string, size int64) error {
+				return repo.IsAllowedType(contentType, size, "")
+			},
+		}),
 	}, nil
 }
 
+// SearchGoogle queues a search-and-save job and returns it without waiting for images to be saved
 func (f *FileService) SearchGoogle(c *gin.Context, isAdmin bool) {
 	searchQuery := c.Query("q")
-	maxImagesStr := c.Query("maxnum")
-	maxImages, err := strconv.Atoi(maxImagesStr)
-	if err != nil {
-		log.Println("Error converting maxImages to int:", err)
+	if searchQuery == "" {
+		c.JSON(http.StatusBadRequest, gin.H{"error": "Field q is empty"})
+		return
+	}
+	maxImages, err := strconv.Atoi(c.Query("maxnum"))
+	if err != nil || maxImages <= 0 {
+		c.JSON(http.StatusBadRequest, gin.H{"error": "Field maxnum should be a positive number"})
+		return
 	}
-	url := fmt.Sprintf("http://www.google.com/search?q=%s&tbm=isch", searchQuery)
 
-	resp, err := http.Get(url)
+	userId, err := strconv.Atoi(c.GetHeader(f.st.GatewayServer.UserIdHeaderKey))
 	if err != nil {
-		fmt.Println("Could not request to google: ", err)
-		c.JSON(http.StatusInternalServerError, gin.H{"error": "Could not request to google"})
+		c.JSON(http.StatusBadRequest, gin.H{"error": "can not parse user id from header"})
 		return
 	}
-	defer resp.Body.Close()
 
-	doc, err := htmlquery.Parse(resp.Body)
+	job, err := f.db.CreateSearchJob(models.SearchJob{
+		Query:      searchQuery,
+		MaxResults: maxImages,
+		UserId:     userId,
+	})
 	if err != nil {
-		c.JSON(http.StatusInternalServerError, gin.H{"error": "Could not parse google request"})
+		logger.Errorw("failed to create search job", "error", err)
+		c.JSON(http.StatusInternalServerError, gin.H{"error": "Could not create search job"})
 		return
 	}
+	f.enqueueSearchJob(job)
 
-	count := 0
-	var files_name string
+	c.JSON(http.StatusAccepted, job)
+}
 
-	userId, err := strconv.Atoi(c.GetHeader(f.st.GatewayServer.UserIdHeaderKey))
+// saveSearchResult downloads a search candidate of query and stores it for user with userId.
+// The thumbnail url is used when the higher resolution url could not be downloaded.
+func (f *FileService) saveSearchResult(ctx context.Context, query string, userId int, index int, candidate ImageSearchResult) (models.File, error) {
+	sourceURL := candidate.URL
+	fetched, err := f.fetcher.Fetch(ctx, sourceURL)
+	if err != nil && candidate.ThumbnailURL != "" {
+		logger.Infow("falling back to thumbnail of search result", "url", candidate.URL, "error", err)
+		sourceURL = candidate.ThumbnailURL
+		fetched, err = f.fetcher.Fetch(ctx, sourceURL)
+	}
 	if err != nil {
-		c.JSON(http.StatusBadRequest, gin.H{"error": "can not parse user id from header"})
-		return
+		return models.File{}, fmt.Errorf("could not download image: %w", err)
 	}
 
-	for _, imgNode := range htmlquery.Find(doc, "//img") {
-		fmt.Println("FILLDD")
-		if count >= maxImages {
-			break
-		}
+	file := models.File{
+		Name:        searchFileName(query, index, fetched),
+		Size:        int(fetched.Size),
+		TypeId:      fetched.Type,
+		UserId:      userId,
+		Content:     fetched.Content,
+		Tags:        make([]string, 0),
+		SourceURL:   sourceURLOf(sourceURL),
+		SearchQuery: query,
+	}
+	return f.repository.SaveEncryptedFile(file)
+}
 
-		imgURL := htmlquery.SelectAttr(imgNode, "src")
-		// content, name, size, type, err := helpers.DownloadImage()
-		content, name, size, filetype, err := helpers.DownloadImage(imgURL)
-		if err != nil {
-			log.Println("Error downloading image:", err)
-			continue
-		}
-		tags := make([]string, 0)
-		err = f.db.AddFileTypeIfNotExist(filetype)
-		if err != nil {
-			fmt.Println("can't add file types into database", "error", err)
-			continue
-		}
+// searchFileName keeps the remote file name when it looks like a real image name,
+// otherwise it is generated from the search query and position of the result
+func searchFileName(query string, index int, fetched helpers.FetchedFile) string {
+	if usableFileName.MatchString(fetched.Name) {
+		return fetched.Name
+	}
+	slug := []rune(helpers.Slugify(query))
+	if len(slug) > 64 {
+		slug = slug[:64]
+	}
+	name := strings.Trim(string(slug), "-")
+	if name == "" {
+		name = "image"
+	}
+	return fmt.Sprintf("%s-%d%s", name, index+1, helpers.ImageExtension(fetched.Type))
+}
 
-		f.repository.SaveEncryptedFile(models.File{
-			Name:    name,
-			Size:    int(size),
-			TypeId:  filetype,
-			UserId:  userId,
-			Content: content,
-			Tags:    tags,
-		})
-		files_name += name + ","
+// maxSourceURLLength is the longest source url kept in file metadata
+const maxSourceURLLength = 2048
 
-		count++
+// sourceURLOf returns url a search result was downloaded from as it is recorded in file metadata.
+// Data uris are the content itself and overly long urls can't be stored, so neither is recorded.
+func sourceURLOf(url string) string {
+	if strings.HasPrefix(url, "data:") || len(url) > maxSourceURLLength {
+		return ""
 	}
-
-	c.JSON(http.StatusOK, gin.H{"files name": files_name})
-	return
+	return url
 }
 
+// GetFile streams a file by id path parameter.
+// A rendition of images is streamed instead of the original when it is named by rendition query parameter.
 func (f *FileService) GetFile(c *gin.Context, isAdmin bool) {
-	tags := helpers.SplitBySpaceComma(c.PostFormArray("tags"))
-	name := helpers.SplitBySpaceComma(c.PostFormArray("name"))
+	scope, err := f.scope(c, isAdmin)
+	if err != nil {
+		c.JSON(http.StatusBadRequest, gin.H{"error": err.Error()})
+		return
+	}
+	f.serveFile(c, scope, c.Param("id"))
+}
 
-	tx, file, err := f.repository.GetEncryptedFile(name, tags)
+// serveFile streams file in scope with uuid, or its rendition named by rendition query parameter
+func (f *FileService) serveFile(c *gin.Context, scope database.Scope, uuid string) {
+	rendition := c.Query("rendition")
+	if rendition != "" && !f.repository.HasRendition(rendition) {
+		c.JSON(http.StatusBadRequest, gin.H{"error": "Unknown rendition"})
+		return
+	}
+
+	tx, file, err := f.repository.GetEncryptedFileByUUID(scope, uuid)
 	defer func() {
 		if err != nil && tx != nil {
 			if e, ok := err.(*pq.Error); !ok || e.Code != database.ErrSerializationFailure {
@@ -133,8 +216,8 @@ func (f *FileService) GetFile(c *gin.Context, isAdmin bool) {
 		}
 	}()
 	if err != nil {
-		if err.Error() == "file not found" {
-			c.JSON(http.StatusBadRequest, gin.H{"error": "File not found"})
+		if errors.Is(err, database.ErrFileNotFound) {
+			c.JSON(http.StatusNotFound, gin.H{"error": "File not found"})
 		} else {
 			logger.Errorw("failed to get encrypted file", "error", err)
 			c.JSON(http.StatusInternalServerError, gin.H{"error": "Failed to get encrypted file"})
@@ -142,39 +225,268 @@ func (f *FileService) GetFile(c *gin.Context, isAdmin bool) {
 		return
 	}
 
-	// Define the path of the file to be retrieved
-	filePath := fmt.Sprintf("%s/%s", f.st.BackendServer.FilePath, file.UUID)
-
-	err = helpers.DecryptFile(filePath, []byte(f.st.BackendServer.EncryptKey))
+	var content io.ReadCloser
+	var size int64
+	contentType := file.TypeId
+	if rendition != "" {
+		contentType, err = f.repository.RenditionFormat(file, c.GetHeader("Accept"))
+		if err == nil {
+			content, size, err = f.repository.OpenRendition(file, rendition, contentType)
+		}
+	} else {
+		content, size, err = f.repository.OpenDecryptedFile(file)
+	}
+	if errors.Is(err, repository.ErrNoRenditions) {
+		c.JSON(http.StatusBadRequest, gin.H{"error": "Only images have renditions"})
+		return
+	}
+	if errors.Is(err, repository.ErrNotAcceptable) {
+		c.JSON(http.StatusNotAcceptable, gin.H{"error": "No acceptable rendition format"})
+		return
+	}
 	if err != nil {
 		logger.Errorw("failed to decryptFile file", "error", err)
 		c.JSON(http.StatusInternalServerError, gin.H{"error": "Failed to decryptFile file"})
 
-		if os.IsNotExist(err) {
+		// File without a readable blob can't ever be served, reading it is not a conflict
+		if errors.Is(err, blobstore.ErrNotFound) || errors.Is(err, blobstore.ErrInvalidKey) {
 			err = nil
 			tx.Commit()
 		}
 		return
 	}
+	defer content.Close()
 
-	// Set the headers for the file transfer and return the file
-	c.Header("Content-Description", "File Transfer")
-	c.Header("Content-Transfer-Encoding", "binary")
-	c.Header("Content-Disposition", fmt.Sprintf("attachment; filename=%s", file.Name))
+	// Consume-once files are removed from database in the same transaction they are read, by anyone who can read them
+	if file.BurnAfterRead {
+		err = tx.DeleteFile(database.AllScope(), file.UUID)
+		if err != nil {
+			logger.Errorw("failed to delete burn after read file", "error", err)
+			c.JSON(http.StatusInternalServerError, gin.H{"error": "Failed to get encrypted file"})
+			return
+		}
+	}
+	err = tx.Commit()
+	if err != nil {
+		logger.Errorw("failed to commit get file transaction", "error", err)
+		c.JSON(http.StatusInternalServerError, gin.H{"error": "Failed to get encrypted file"})
+		return
+	}
+
+	// Set the headers for the file transfer and stream the decrypted file
+	headers := fileHeaders(file, rendition == "")
+	if rendition != "" {
+		// Format of renditions depends on Accept header
+		headers["Vary"] = "Accept"
+	}
+	c.DataFromReader(http.StatusOK, size, contentType, content, headers)
+	if streamErr := c.Errors.Last(); streamErr != nil {
+		// Headers are already sent, so a checksum mismatch can only cut the response short
+		logger.Errorw("failed to stream file", "uuid", file.UUID, "error", streamErr.Err)
+	}
+
+	if file.BurnAfterRead {
+		// Blob is removed only once streamed, since remote stores may read it lazily
+		removeErr := f.repository.DeleteBlob(file)
+		if removeErr != nil {
+			logger.Errorw("failed to delete file", "error", removeErr)
+		}
+	}
+}
+
+// HeadFile sends headers a download of a file by id path parameter would have, without reading its content
+func (f *FileService) HeadFile(c *gin.Context, isAdmin bool) {
+	scope, err := f.scope(c, isAdmin)
+	if err != nil {
+		c.Status(http.StatusBadRequest)
+		return
+	}
+
+	file, err := f.repository.GetFile(scope, c.Param("id"))
+	if errors.Is(err, database.ErrFileNotFound) {
+		c.Status(http.StatusNotFound)
+		return
+	}
+	if err != nil {
+		logger.Errorw("failed to get file", "error", err)
+		c.Status(http.StatusInternalServerError)
+		return
+	}
+
+	for key, value := range fileHeaders(file, true) {
+		c.Header(key, value)
+	}
 	c.Header("Content-Type", file.TypeId)
-	c.Header("Content-Length", fmt.Sprintf("%d", file.Size))
-	c.FileAttachment(filePath, file.Name)
+	c.Header("Content-Length", strconv.Itoa(file.Size))
+	c.Status(http.StatusOK)
+}
+
+// DeleteFile moves a file by id path parameter to trash, it is purged after retention period of trash
+func (f *FileService) DeleteFile(c *gin.Context, isAdmin bool) {
+	scope, err := f.scope(c, isAdmin)
+	if err != nil {
+		c.JSON(http.StatusBadRequest, gin.H{"error": err.Error()})
+		return
+	}
 
-	// Delete the file after sending it to the client
-	err = os.Remove(filePath)
+	err = f.repository.TrashFile(scope.ForWrite(), c.Param("id"))
+	if errors.Is(err, database.ErrFileNotFound) {
+		c.JSON(http.StatusNotFound, gin.H{"error": "File not found"})
+		return
+	}
 	if err != nil {
 		logger.Errorw("failed to delete file", "error", err)
+		c.JSON(http.StatusInternalServerError, gin.H{"error": "Failed to delete file"})
+		return
 	}
+	c.Status(http.StatusNoContent)
+}
 
-	c.JSON(http.StatusOK, gin.H{
-		"message": fmt.Sprintf("Get %s.%s successfully", file.Name, file.TypeId),
-	})
-	tx.Commit()
+// SearchFiles returns metadata of all files with any of names and any of tags query parameters.
+// Files are queried by q query parameter instead when it is set, which returns a page of matching files.
+func (f *FileService) SearchFiles(c *gin.Context, isAdmin bool) {
+	if q, ok := c.GetQuery("q"); ok {
+		f.queryFiles(c, isAdmin, q)
+		return
+	}
+
+	names := helpers.SplitBySpaceComma(c.QueryArray("name"))
+	tags, err := helpers.NormalizeTags(c.QueryArray("tags"))
+	if err != nil {
+		c.JSON(http.StatusBadRequest, gin.H{"error": err.Error()})
+		return
+	}
+	if len(names) == 0 && len(tags) == 0 {
+		c.JSON(http.StatusBadRequest, gin.H{"error": "Field name or tags is required"})
+		return
+	}
+
+	scope, err := f.scope(c, isAdmin)
+	if err != nil {
+		c.JSON(http.StatusBadRequest, gin.H{"error": err.Error()})
+		return
+	}
+
+	files, err := f.repository.FindFiles(scope, names, tags)
+	if err != nil {
+		logger.Errorw("failed to search files", "error", err)
+		c.JSON(http.StatusInternalServerError, gin.H{"error": "Failed to search files"})
+		return
+	}
+	c.JSON(http.StatusOK, files)
+}
+
+// queryFiles returns a page of metadata of files matching query q, by limit and offset query parameters
+func (f *FileService) queryFiles(c *gin.Context, isAdmin bool, q string) {
+	if len(q) > filequery.MaxLength {
+		c.JSON(http.StatusBadRequest, gin.H{"error": fmt.Sprintf("Field q should be at most %d characters long", filequery.MaxLength)})
+		return
+	}
+	expr, err := filequery.Parse(q)
+	if err != nil {
+		c.JSON(http.StatusBadRequest, gin.H{"error": err.Error()})
+		return
+	}
+	limit, offset, err := pageParams(c)
+	if err != nil {
+		c.JSON(http.StatusBadRequest, gin.H{"error": err.Error()})
+		return
+	}
+	scope, err := f.scope(c, isAdmin)
+	if err != nil {
+		c.JSON(http.StatusBadRequest, gin.H{"error": err.Error()})
+		return
+	}
+
+	page, err := f.repository.QueryFiles(scope, expr, limit, offset)
+	if err != nil {
+		logger.Errorw("failed to query files", "error", err)
+		c.JSON(http.StatusInternalServerError, gin.H{"error": "Failed to search files"})
+		return
+	}
+	c.JSON(http.StatusOK, page)
+}
+
+// SearchFilesText returns a page of files whose name, tags or search query have words of q query parameter, the best
+// matches first, with matching words highlighted
+func (f *FileService) SearchFilesText(c *gin.Context, isAdmin bool) {
+	q := strings.TrimSpace(c.Query("q"))
+	if q == "" {
+		c.JSON(http.StatusBadRequest, gin.H{"error": "Field q is required"})
+		return
+	}
+	if len(q) > maxTextQueryLength {
+		c.JSON(http.StatusBadRequest, gin.H{"error": fmt.Sprintf("Field q should be at most %d characters long", maxTextQueryLength)})
+		return
+	}
+	limit, offset, err := pageParams(c)
+	if err != nil {
+		c.JSON(http.StatusBadRequest, gin.H{"error": err.Error()})
+		return
+	}
+	scope, err := f.scope(c, isAdmin)
+	if err != nil {
+		c.JSON(http.StatusBadRequest, gin.H{"error": err.Error()})
+		return
+	}
+
+	page, err := f.repository.SearchFilesText(scope, q, limit, offset)
+	if err != nil {
+		logger.Errorw("failed to search text of files", "error", err)
+		c.JSON(http.StatusInternalServerError, gin.H{"error": "Failed to search files"})
+		return
+	}
+	c.JSON(http.StatusOK, page)
+}
+
+// pageParams returns limit and offset query parameters of a page of search results
+func pageParams(c *gin.Context) (int, int, error) {
+	limit := searchPageLimit
+	if value := c.Query("limit"); value != "" {
+		var err error
+		limit, err = strconv.Atoi(value)
+		if err != nil || limit <= 0 || limit > maxSearchPageLimit {
+			return 0, 0, errors.Errorf("Field limit should be a number from 1 to %d", maxSearchPageLimit)
+		}
+	}
+	offset := 0
+	if value := c.Query("offset"); value != "" {
+		var err error
+		offset, err = strconv.Atoi(value)
+		if err != nil || offset < 0 {
+			return 0, 0, errors.New("Field offset should not be negative")
+		}
+	}
+	return limit, offset, nil
+}
+
+// scope returns files user of request can access, which are files of every user for admins.
+// Requests of admin server and requests gateway marked as sent by an admin are admin requests.
+func (f *FileService) scope(c *gin.Context, isAdmin bool) (database.Scope, error) {
+	if isAdmin || c.GetHeader(f.st.GatewayServer.UserAccessHeaderKey) == models.AdminType {
+		return database.AllScope(), nil
+	}
+	userId, err := strconv.Atoi(c.GetHeader(f.st.GatewayServer.UserIdHeaderKey))
+	if err != nil {
+		return database.Scope{}, ErrInvalidUserId
+	}
+	return database.OwnerScope(userId), nil
+}
+
+// fileHeaders returns headers describing a file download, checksum headers are only valid for the original content
+func fileHeaders(file models.File, original bool) map[string]string {
+	headers := map[string]string{
+		"Content-Description":       "File Transfer",
+		"Content-Transfer-Encoding": "binary",
+		"Content-Disposition":       helpers.ContentDisposition(file.Name),
+	}
+	if file.SHA256 != "" && original {
+		headers["ETag"] = fmt.Sprintf("%q", file.SHA256)
+		if digest, err := hex.DecodeString(file.SHA256); err == nil {
+			headers["Digest"] = "sha-256=" + base64.StdEncoding.EncodeToString(digest)
+		}
+	}
+	return headers
 }
 
 func (f *FileService) SaveFiles(c *gin.Context, isAdmin bool) {
@@ -189,12 +501,27 @@ func (f *FileService) SaveFiles(c *gin.Context, isAdmin bool) {
 		return
 	}
 
+	// Type of files is told by their content, declared type is only trusted for files which are not images
+	files := make([]models.File, 0, len(form.File["files"]))
 	for _, file := range form.File["files"] {
-		err := f.repository.IsValidFile(file)
+		content, err := helpers.ReadFileContent(file)
+		if err != nil {
+			logger.Errorw("failed to read uploaded file", "error", err)
+			c.JSON(http.StatusInternalServerError, gin.H{"error": "Failed to read file"})
+			return
+		}
+		typeId := helpers.DetectMediaType(helpers.MediaType(file.Header.Get("Content-Type")), content)
+		err = f.repository.IsValidFile(file, typeId)
 		if err != nil {
 			c.JSON(http.StatusBadRequest, gin.H{"error": err.Error()})
 			return
 		}
+		files = append(files, models.File{
+			Name:    file.Filename,
+			Size:    int(file.Size),
+			TypeId:  typeId,
+			Content: content,
+		})
 	}
 
 	userId, err := strconv.Atoi(c.GetHeader(f.st.GatewayServer.UserIdHeaderKey))
@@ -203,25 +530,34 @@ func (f *FileService) SaveFiles(c *gin.Context, isAdmin bool) {
 		return
 	}
 
-	tags := helpers.SplitBySpaceComma(c.PostFormArray("tags"))
+	tags, err := helpers.NormalizeTags(c.PostFormArray("tags"))
+	if err != nil {
+		c.JSON(http.StatusBadRequest, gin.H{"error": err.Error()})
+		return
+	}
+	burnAfterRead := false
+	if value := c.PostForm("burn_after_read"); value != "" {
+		burnAfterRead, err = strconv.ParseBool(value)
+		if err != nil {
+			c.JSON(http.StatusBadRequest, gin.H{"error": "Field burn_after_read should be a boolean"})
+			return
+		}
+	}
 
 	var message []string
 	var error_message []string
-	for _, file := range form.File["files"] {
-		content, _ := helpers.ReadFileContent(file)
+	for _, file := range files {
+		file.UserId = userId
+		file.Tags = tags
+		file.BurnAfterRead = burnAfterRead
 
-		err := f.repository.SaveEncryptedFile(models.File{
-			Name:    file.Filename,
-			Size:    int(file.Size),
-			TypeId:  file.Header.Get("Content-Type"),
-			UserId:  userId,
-			Content: content,
-			Tags:    tags,
-		})
+		stored, err := f.repository.SaveEncryptedFile(file)
 		if err != nil {
 			error_message = append(error_message, fmt.Sprintf("Can not save file, error: %s", err.Error()))
+		} else if stored.DuplicateOf != "" {
+			message = append(message, fmt.Sprintf("File %s is a duplicate of already saved file %s", file.Name, stored.DuplicateOf))
 		} else {
-			message = append(message, fmt.Sprintf("File %s saved successfully", file.Filename))
+			message = append(message, fmt.Sprintf("File %s saved successfully", file.Name))
 		}
 	}
 
@@ -234,8 +570,53 @@ func (f *FileService) SaveFiles(c *gin.Context, isAdmin bool) {
 	})
 }
 
+// GetFileMeta returns metadata of a file by id path parameter
+func (f *FileService) GetFileMeta(c *gin.Context, isAdmin bool) {
+	scope, err := f.scope(c, isAdmin)
+	if err != nil {
+		c.JSON(http.StatusBadRequest, gin.H{"error": err.Error()})
+		return
+	}
+
+	file, err := f.repository.GetFileMetadata(scope, c.Param("id"))
+	if errors.Is(err, database.ErrFileNotFound) {
+		c.JSON(http.StatusNotFound, gin.H{"error": "File not found"})
+		return
+	}
+	if err != nil {
+		logger.Errorw("failed to get file metadata", "error", err)
+		c.JSON(http.StatusInternalServerError, gin.H{"error": "Failed to get file metadata"})
+		return
+	}
+	c.JSON(http.StatusOK, file)
+}
+
+// GetFileList returns metadata of a page of files, sorted and filtered by query parameters
 func (f *FileService) GetFileList(c *gin.Context, isAdmin bool) {
-	files, err := f.repository.GetFileList()
+	scope, err := f.scope(c, isAdmin)
+	if err != nil {
+		c.JSON(http.StatusBadRequest, gin.H{"error": err.Error()})
+		return
+	}
+
+	options, err := database.ParseListOptions(c.Request.URL.Query(), database.SortCreatedAt, database.SortSize, database.SortName)
+	if err != nil {
+		c.JSON(http.StatusBadRequest, gin.H{"error": err.Error()})
+		return
+	}
+	if options.Tag != "" {
+		options.Tag, err = helpers.NormalizeTag(options.Tag)
+		if err != nil {
+			c.JSON(http.StatusBadRequest, gin.H{"error": err.Error()})
+			return
+		}
+	}
+
+	files, err := f.repository.GetFileList(scope, options)
+	if errors.Is(err, database.ErrInvalidCursor) {
+		c.JSON(http.StatusBadRequest, gin.H{"error": err.Error()})
+		return
+	}
 	if err != nil {
 		logger.Errorw("failed to get file list", "error", err)
 		c.JSON(http.StatusInternalServerError, gin.H{"error": "can not get files list"})
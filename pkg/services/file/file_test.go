@@ -0,0 +1,27 @@
+package file
+
+import (
+	"testing"
+
+	"github.com/lebleuciel/maani/pkg/helpers"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestSearchFileName(t *testing.T) {
+	t.Run("remote_name", func(t *testing.T) {
+		name := searchFileName("black cats", 0, helpers.FetchedFile{Name: "kitten.jpg", Type: "image/jpeg"})
+		assert.Equal(t, "kitten.jpg", name)
+	})
+	t.Run("data_uri", func(t *testing.T) {
+		name := searchFileName("Black Cats!", 2, helpers.FetchedFile{Type: "image/png"})
+		assert.Equal(t, "black-cats-3.png", name)
+	})
+	t.Run("thumbnail_endpoint", func(t *testing.T) {
+		name := searchFileName("cats", 0, helpers.FetchedFile{Name: "images", Type: "image/jpeg"})
+		assert.Equal(t, "cats-1.jpg", name)
+	})
+	t.Run("empty_query", func(t *testing.T) {
+		name := searchFileName("??", 0, helpers.FetchedFile{Type: "image/gif"})
+		assert.Equal(t, "image-1.gif", name)
+	})
+}
@@ -0,0 +1,164 @@
+package file
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"net/url"
+	"strconv"
+	"strings"
+
+	"github.com/antchfx/htmlquery"
+)
+
+const GoogleSearchUrl = "http://www.google.com/search"
+
+// GoogleImageSearch scrapes image results from google image search page
+type GoogleImageSearch struct {
+	baseUrl string
+	client  *http.Client
+}
+
+func NewGoogleImageSearch(baseUrl string, client *http.Client) *GoogleImageSearch {
+	if baseUrl == "" {
+		baseUrl = GoogleSearchUrl
+	}
+	if client == nil {
+		client = http.DefaultClient
+	}
+	return &GoogleImageSearch{
+		baseUrl: baseUrl,
+		client:  client,
+	}
+}
+
+func (g *GoogleImageSearch) Name() string {
+	return GoogleProvider
+}
+
+func (g *GoogleImageSearch) Search(ctx context.Context, query string, maxResults int) ([]ImageSearchResult, error) {
+	base, err := url.Parse(g.baseUrl)
+	if err != nil {
+		return nil, fmt.Errorf("invalid google search url: %w", err)
+	}
+	params := base.Query()
+	params.Set("q", query)
+	params.Set("tbm", "isch")
+	base.RawQuery = params.Encode()
+	searchUrl := base.String()
+
+	req, err := http.NewRequestWithContext(ctx, http.MethodGet, searchUrl, nil)
+	if err != nil {
+		return nil, err
+	}
+	resp, err := g.client.Do(req)
+	if err != nil {
+		return nil, fmt.Errorf("could not request to google: %w", err)
+	}
+	defer resp.Body.Close()
+	if resp.StatusCode != http.StatusOK {
+		return nil, fmt.Errorf("google responded with status %d", resp.StatusCode)
+	}
+
+	doc, err := htmlquery.Parse(resp.Body)
+	if err != nil {
+		return nil, fmt.Errorf("could not parse google response: %w", err)
+	}
+
+	results := make([]ImageSearchResult, 0)
+	for _, imgNode := range htmlquery.Find(doc, "//img") {
+		if maxResults > 0 && len(results) >= maxResults {
+			break
+		}
+		imageUrl, thumbnailUrl := imageSources(
+			base,
+			htmlquery.SelectAttr(imgNode, "src"),
+			htmlquery.SelectAttr(imgNode, "data-src"),
+			htmlquery.SelectAttr(imgNode, "srcset"),
+		)
+		if imageUrl == "" {
+			continue
+		}
+		results = append(results, ImageSearchResult{
+			URL:          imageUrl,
+			ThumbnailURL: thumbnailUrl,
+			Title:        htmlquery.SelectAttr(imgNode, "alt"),
+			SourcePage:   searchUrl,
+			Provider:     g.Name(),
+		})
+	}
+	return results, nil
+}
+
+// imageSources picks the highest resolution url of an image element, preferring srcset over data-src over src.
+// The src url is returned as thumbnail when a better url is found, so it can be used as fallback.
+func imageSources(base *url.URL, src, dataSrc, srcset string) (string, string) {
+	thumbnail := resolveUrl(base, src)
+	best := ""
+	if candidate := bestSrcsetUrl(srcset); candidate != "" {
+		best = resolveUrl(base, candidate)
+	}
+	if best == "" && dataSrc != "" {
+		best = resolveUrl(base, dataSrc)
+	}
+	if best == "" || best == thumbnail {
+		return thumbnail, ""
+	}
+	return best, thumbnail
+}
+
+// resolveUrl resolves relative sources against the search page
+func resolveUrl(base *url.URL, rawUrl string) string {
+	rawUrl = strings.TrimSpace(rawUrl)
+	if rawUrl == "" {
+		return ""
+	}
+	ref, err := url.Parse(rawUrl)
+	if err != nil {
+		return ""
+	}
+	return base.ResolveReference(ref).String()
+}
+
+// bestSrcsetUrl returns the url with the largest width or density descriptor of a srcset attribute.
+// Urls are split on whitespace rather than commas, since data uris contain commas themselves.
+func bestSrcsetUrl(srcset string) string {
+	best, bestScore := "", -1.0
+	rest := srcset
+	for {
+		rest = strings.TrimLeft(rest, " \t\n\r\f,")
+		if rest == "" {
+			break
+		}
+		end := strings.IndexAny(rest, " \t\n\r\f")
+		if end < 0 {
+			end = len(rest)
+		}
+		candidate := rest[:end]
+		rest = rest[end:]
+
+		descriptor := ""
+		if strings.HasSuffix(candidate, ",") {
+			candidate = strings.TrimRight(candidate, ",")
+		} else {
+			comma := strings.IndexByte(rest, ',')
+			if comma < 0 {
+				comma = len(rest)
+			}
+			descriptor = strings.TrimSpace(rest[:comma])
+			rest = rest[comma:]
+		}
+
+		score := 1.0
+		if len(descriptor) > 1 {
+			value, err := strconv.ParseFloat(descriptor[:len(descriptor)-1], 64)
+			if err == nil {
+				score = value
+			}
+		}
+		if score > bestScore {
+			best, bestScore = candidate, score
+		}
+	}
+	return best
+}
@@ -0,0 +1,92 @@
+package file
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/lebleuciel/maani/pkg/settings"
+	"github.com/stretchr/testify/assert"
+)
+
+const googleResultPage = `<html><body>
+<img src="/images/branding/logo.png" alt="Google">
+<img src="https://images.foo/cat-1.jpg" alt="cat one">
+<img alt="without source">
+<img src="https://images.foo/cat-2.jpg" alt="cat two">
+<img src="data:image/gif;base64,R0lGODlhAQABAAAAACw=" data-src="https://images.foo/cat-3.jpg" alt="lazy cat">
+<img src="https://images.foo/cat-4-small.jpg" srcset="https://images.foo/cat-4-small.jpg 200w, https://images.foo/cat-4-large.jpg 1200w, https://images.foo/cat-4-medium.jpg 640w">
+</body></html>`
+
+// newGoogleStandIn starts a local server which serves a google like result page
+func newGoogleStandIn(t *testing.T, status int) *httptest.Server {
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		assert.Equal(t, "cats", r.URL.Query().Get("q"))
+		assert.Equal(t, "isch", r.URL.Query().Get("tbm"))
+		w.WriteHeader(status)
+		fmt.Fprint(w, googleResultPage)
+	}))
+	t.Cleanup(srv.Close)
+	return srv
+}
+
+func TestGoogleImageSearch_Search(t *testing.T) {
+	t.Run("all_results", func(t *testing.T) {
+		srv := newGoogleStandIn(t, http.StatusOK)
+		provider := NewGoogleImageSearch(srv.URL+"/search", srv.Client())
+
+		results, err := provider.Search(context.Background(), "cats", 0)
+		assert.Nil(t, err)
+		assert.Len(t, results, 5)
+		assert.Equal(t, srv.URL+"/images/branding/logo.png", results[0].URL)
+		assert.Equal(t, "https://images.foo/cat-1.jpg", results[1].URL)
+		assert.Equal(t, "cat one", results[1].Title)
+		assert.Equal(t, GoogleProvider, results[1].Provider)
+		assert.Equal(t, "https://images.foo/cat-2.jpg", results[2].URL)
+		assert.Equal(t, "", results[2].ThumbnailURL)
+		assert.Equal(t, "https://images.foo/cat-3.jpg", results[3].URL)
+		assert.Equal(t, "data:image/gif;base64,R0lGODlhAQABAAAAACw=", results[3].ThumbnailURL)
+		assert.Equal(t, "https://images.foo/cat-4-large.jpg", results[4].URL)
+		assert.Equal(t, "https://images.foo/cat-4-small.jpg", results[4].ThumbnailURL)
+	})
+	t.Run("max_results", func(t *testing.T) {
+		srv := newGoogleStandIn(t, http.StatusOK)
+		provider := NewGoogleImageSearch(srv.URL+"/search", srv.Client())
+
+		results, err := provider.Search(context.Background(), "cats", 2)
+		assert.Nil(t, err)
+		assert.Len(t, results, 2)
+	})
+	t.Run("bad_status", func(t *testing.T) {
+		srv := newGoogleStandIn(t, http.StatusTooManyRequests)
+		provider := NewGoogleImageSearch(srv.URL+"/search", srv.Client())
+
+		_, err := provider.Search(context.Background(), "cats", 0)
+		assert.NotNil(t, err)
+	})
+}
+
+func TestNewImageSearchProvider(t *testing.T) {
+	t.Run("default", func(t *testing.T) {
+		var st settings.Settings
+		provider, err := NewImageSearchProvider(st)
+		assert.Nil(t, err)
+		assert.Equal(t, GoogleProvider, provider.Name())
+	})
+	t.Run("unknown", func(t *testing.T) {
+		var st settings.Settings
+		st.ImageSearch.Provider = "altavista"
+		_, err := NewImageSearchProvider(st)
+		assert.Equal(t, ErrUnknownSearchProvider, err)
+	})
+}
+
+func TestBestSrcsetUrl(t *testing.T) {
+	assert.Equal(t, "", bestSrcsetUrl(""))
+	assert.Equal(t, "a.jpg", bestSrcsetUrl("a.jpg"))
+	assert.Equal(t, "b.jpg", bestSrcsetUrl("a.jpg 1x, b.jpg 2x"))
+	assert.Equal(t, "b.jpg", bestSrcsetUrl("a.jpg 320w,b.jpg 640w"))
+	assert.Equal(t, "data:image/png;base64,iVBO,RK", bestSrcsetUrl("data:image/png;base64,iVBO,RK 2x, a.jpg 1x"))
+}
@@ -0,0 +1,259 @@
+package file
+
+import (
+	"context"
+	"net/http"
+	"strconv"
+	"sync"
+	"time"
+
+	"github.com/gin-gonic/gin"
+	"github.com/lebleuciel/maani/models"
+	"github.com/lebleuciel/maani/pkg/database"
+	"github.com/pkg/errors"
+)
+
+// Runners report they are running a job every searchJobHeartbeat, a job whose runner hasn't reported for
+// searchJobStaleAfter can be claimed by another runner
+const (
+	searchJobHeartbeat  = 30 * time.Second
+	searchJobStaleAfter = 2 * time.Minute
+)
+
+// StartSearchJobs starts background runners for search jobs and requeues jobs which were left pending or running
+// by a previous store process. Every store process requeues them, runners only run the jobs they claim.
+func (f *FileService) StartSearchJobs(ctx context.Context) error {
+	workers := f.st.ImageSearch.JobWorkers
+	if workers <= 0 {
+		workers = 1
+	}
+	for i := 0; i < workers; i++ {
+		go f.runSearchJobs(ctx)
+	}
+
+	jobs, err := f.db.GetUnfinishedSearchJobs()
+	if err != nil {
+		return errors.Wrap(err, "Could not get unfinished search jobs")
+	}
+	for _, job := range jobs {
+		logger.Infow("resuming search job", "job_id", job.Id, "status", job.Status)
+		f.enqueueSearchJob(job)
+	}
+	return nil
+}
+
+// enqueueSearchJob hands a job to runners without blocking the caller when the queue is full
+func (f *FileService) enqueueSearchJob(job models.SearchJob) {
+	select {
+	case f.searchJobs <- job:
+	default:
+		go func() { f.searchJobs <- job }()
+	}
+}
+
+func (f *FileService) runSearchJobs(ctx context.Context) {
+	for {
+		select {
+		case <-ctx.Done():
+			return
+		case job := <-f.searchJobs:
+			f.runSearchJob(ctx, job)
+		}
+	}
+}
+
+// runSearchJob claims a job, then searches images for it and saves them while persisting the progress.
+// Jobs claimed by a runner of another store process are left to it.
+func (f *FileService) runSearchJob(ctx context.Context, job models.SearchJob) {
+	claimed, err := f.db.ClaimSearchJob(job.Id, f.runner, time.Now().Add(-searchJobStaleAfter))
+	if errors.Is(err, database.ErrSearchJobClaimed) {
+		logger.Infow("search job is run by another runner", "job_id", job.Id)
+		return
+	}
+	if err != nil {
+		logger.Errorw("could not claim search job", "job_id", job.Id, "error", err)
+		return
+	}
+	job = claimed
+	ctx, cancel := context.WithCancel(ctx)
+	defer cancel()
+	go f.heartbeatSearchJob(ctx, cancel, job.Id)
+
+	now := time.Now()
+	if job.StartedAt == nil {
+		job.StartedAt = &now
+	}
+
+	candidates, err := f.searchCandidates(ctx, &job)
+	if err != nil {
+		logger.Errorw("image search failed", "job_id", job.Id, "provider", f.searchProvider.Name(), "error", err)
+		f.finishSearchJob(job, err)
+		return
+	}
+	f.updateSearchJob(job)
+
+	// A resumed job only needs the images it has not saved yet, from results it has not processed yet
+	previousNames := job.FileNames
+	previousDuplicates := job.DuplicateFileNames
+	previousDuplicateOf := job.DuplicateOf
+	start := min(job.NextCandidate, len(candidates))
+	job.NextCandidate = start
+	processed := make(map[int]bool)
+	// Downloads only read this copy of job fields, since job is updated under mu while they run
+	query, userId := job.Query, job.UserId
+	var mu sync.Mutex
+	saved, failed := f.downloader.Run(ctx, candidates[start:], job.MaxResults-job.Saved-job.Deduplicated, func(ctx context.Context, index int, candidate ImageSearchResult) (models.File, error) {
+		index += start
+		file, err := f.saveSearchResult(ctx, query, userId, index, candidate)
+
+		mu.Lock()
+		defer mu.Unlock()
+		// Results finish out of order, so resuming skips the leading results which have all been processed
+		processed[index] = true
+		for processed[job.NextCandidate] {
+			delete(processed, job.NextCandidate)
+			job.NextCandidate++
+		}
+		if err != nil {
+			job.Failed++
+		} else if file.DuplicateOf != "" {
+			job.Deduplicated++
+			job.DuplicateFileNames = append(job.DuplicateFileNames, file.Name)
+			job.DuplicateOf = append(job.DuplicateOf, file.DuplicateOf)
+		} else {
+			job.Saved++
+			job.FileNames = append(job.FileNames, file.Name)
+		}
+		f.updateSearchJob(job)
+		return file, err
+	})
+	for _, result := range failed {
+		logger.Infow("could not save search result", "job_id", job.Id, "url", result.Candidate.URL, "error", result.Err)
+	}
+
+	// Report file names in candidate order rather than completion order
+	job.FileNames = append([]string{}, previousNames...)
+	job.DuplicateFileNames = append([]string{}, previousDuplicates...)
+	job.DuplicateOf = append([]string{}, previousDuplicateOf...)
+	for _, result := range saved {
+		if result.File.DuplicateOf != "" {
+			job.DuplicateFileNames = append(job.DuplicateFileNames, result.File.Name)
+			job.DuplicateOf = append(job.DuplicateOf, result.File.DuplicateOf)
+		} else {
+			job.FileNames = append(job.FileNames, result.File.Name)
+		}
+	}
+	f.finishSearchJob(job, nil)
+}
+
+// searchCandidates returns search results of a job. Results are searched once and kept with the job, a resumed job
+// goes on with the kept results, since NextCandidate points into them and another search may return other results.
+func (f *FileService) searchCandidates(ctx context.Context, job *models.SearchJob) ([]ImageSearchResult, error) {
+	if len(job.CandidateURLs) > 0 {
+		candidates := make([]ImageSearchResult, len(job.CandidateURLs))
+		for i, url := range job.CandidateURLs {
+			candidates[i].URL = url
+			if i < len(job.CandidateThumbnailURLs) {
+				candidates[i].ThumbnailURL = job.CandidateThumbnailURLs[i]
+			}
+		}
+		return candidates, nil
+	}
+
+	candidates, err := f.searchProvider.Search(ctx, job.Query, 0)
+	if err != nil {
+		return nil, err
+	}
+	job.CandidateURLs = make([]string, len(candidates))
+	job.CandidateThumbnailURLs = make([]string, len(candidates))
+	for i, candidate := range candidates {
+		job.CandidateURLs[i], job.CandidateThumbnailURLs[i] = candidate.URL, candidate.ThumbnailURL
+	}
+	return candidates, nil
+}
+
+// heartbeatSearchJob reports runner is running a job until ctx is done, and cancels the job once another runner claimed it
+func (f *FileService) heartbeatSearchJob(ctx context.Context, cancel context.CancelFunc, jobId int) {
+	ticker := time.NewTicker(searchJobHeartbeat)
+	defer ticker.Stop()
+	for {
+		select {
+		case <-ctx.Done():
+			return
+		case <-ticker.C:
+			err := f.db.HeartbeatSearchJob(jobId, f.runner)
+			if errors.Is(err, database.ErrSearchJobClaimed) {
+				logger.Warnw("search job was claimed by another runner", "job_id", jobId)
+				cancel()
+				return
+			}
+			if err != nil {
+				logger.Errorw("could not report search job is running", "job_id", jobId, "error", err)
+			}
+		}
+	}
+}
+
+func (f *FileService) finishSearchJob(job models.SearchJob, jobErr error) {
+	now := time.Now()
+	job.FinishedAt = &now
+	job.Status = models.SearchJobCompleted
+	if jobErr != nil {
+		job.Status = models.SearchJobFailed
+		job.Error = jobErr.Error()
+	}
+	f.updateSearchJob(job)
+}
+
+func (f *FileService) updateSearchJob(job models.SearchJob) {
+	err := f.db.UpdateSearchJob(job)
+	if err != nil {
+		logger.Errorw("could not update search job", "job_id", job.Id, "error", err)
+	}
+}
+
+func (f *FileService) GetSearchJob(c *gin.Context, isAdmin bool) {
+	scope, err := f.scope(c, isAdmin)
+	if err != nil {
+		c.JSON(http.StatusBadRequest, gin.H{"error": err.Error()})
+		return
+	}
+	jobId, err := strconv.Atoi(c.Param("id"))
+	if err != nil {
+		c.JSON(http.StatusBadRequest, gin.H{"error": "invalid search job id"})
+		return
+	}
+
+	job, err := f.db.GetSearchJob(jobId)
+	if err != nil {
+		if errors.Is(err, database.ErrSearchJobNotFound) {
+			c.JSON(http.StatusNotFound, gin.H{"error": "Search job not found"})
+			return
+		}
+		logger.Errorw("failed to get search job", "error", err)
+		c.JSON(http.StatusInternalServerError, gin.H{"error": "can not get search job"})
+		return
+	}
+	if !scope.Owns(job.UserId) {
+		c.JSON(http.StatusNotFound, gin.H{"error": "Search job not found"})
+		return
+	}
+	c.JSON(http.StatusOK, job)
+}
+
+// GetSearchJobList sends search jobs of current user, admins get jobs of every user
+func (f *FileService) GetSearchJobList(c *gin.Context, isAdmin bool) {
+	scope, err := f.scope(c, isAdmin)
+	if err != nil {
+		c.JSON(http.StatusBadRequest, gin.H{"error": err.Error()})
+		return
+	}
+
+	jobs, err := f.db.GetSearchJobList(scope)
+	if err != nil {
+		logger.Errorw("failed to get search job list", "error", err)
+		c.JSON(http.StatusInternalServerError, gin.H{"error": "can not get search jobs list"})
+		return
+	}
+	c.JSON(http.StatusOK, jobs)
+}
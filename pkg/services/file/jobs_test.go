@@ -0,0 +1,259 @@
+package file
+
+import (
+	"bytes"
+	"context"
+	"fmt"
+	"image"
+	"image/png"
+	"net/http"
+	"net/http/httptest"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/golang/mock/gomock"
+	"github.com/lebleuciel/maani/models"
+	"github.com/lebleuciel/maani/pkg/database"
+	mock_database "github.com/lebleuciel/maani/pkg/database/mocks"
+	repository "github.com/lebleuciel/maani/pkg/repository/file"
+	"github.com/lebleuciel/maani/pkg/settings"
+	"github.com/stretchr/testify/assert"
+)
+
+// newImageSearchStandIn starts a local server which serves a result page and its images,
+// image names listed in broken respond with not found
+func newImageSearchStandIn(t *testing.T, names []string, broken map[string]bool) *httptest.Server {
+	var img bytes.Buffer
+	assert.Nil(t, png.Encode(&img, image.NewRGBA(image.Rect(0, 0, 4, 4))))
+
+	mux := http.NewServeMux()
+	mux.HandleFunc("/search", func(w http.ResponseWriter, r *http.Request) {
+		fmt.Fprint(w, "<html><body>")
+		for _, name := range names {
+			fmt.Fprintf(w, `<img src="/images/%s">`, name)
+		}
+		fmt.Fprint(w, "</body></html>")
+	})
+	mux.HandleFunc("/images/", func(w http.ResponseWriter, r *http.Request) {
+		if broken[r.URL.Path[len("/images/"):]] {
+			http.NotFound(w, r)
+			return
+		}
+		w.Write(img.Bytes())
+	})
+	srv := httptest.NewServer(mux)
+	t.Cleanup(srv.Close)
+	return srv
+}
+
+// expectSearchJobClaim lets runners claim job, the claimed job is returned as the database would return it
+func expectSearchJobClaim(db *mock_database.MockDatabase, job models.SearchJob) {
+	db.EXPECT().ClaimSearchJob(job.Id, gomock.Any(), gomock.Any()).DoAndReturn(func(id int, runner string, staleBefore time.Time) (models.SearchJob, error) {
+		job.Status = models.SearchJobRunning
+		job.Runner = runner
+		return job, nil
+	})
+	db.EXPECT().HeartbeatSearchJob(job.Id, gomock.Any()).Return(nil).AnyTimes()
+}
+
+func TestFileService_runSearchJob(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	srv := newImageSearchStandIn(t, []string{"a.png", "b.png", "c.png", "d.png"}, map[string]bool{"b.png": true})
+
+	var st settings.Settings
+	st.BackendServer.FilePath = t.TempDir()
+	st.BackendServer.EncryptKey = "0123456789abcdef"
+	st.BackendServer.MaxFilesSizeByte = 1 << 20
+	st.ImageSearch.BaseUrl = srv.URL + "/search"
+	st.ImageSearch.Concurrency = 2
+	st.ImageSearch.AllowPrivateNetworks = true
+
+	db := mock_database.NewMockDatabase(ctrl)
+	db.EXPECT().LockChecksum(gomock.Any()).Return(func() {}, nil).AnyTimes()
+	repo, err := repository.NewFileRepository(st, db)
+	assert.Nil(t, err)
+	service, err := NewFileService(repo, st, db)
+	assert.Nil(t, err)
+
+	var last models.SearchJob
+	db.EXPECT().UpdateSearchJob(gomock.Any()).DoAndReturn(func(job models.SearchJob) error {
+		last = job
+		return nil
+	}).AnyTimes()
+	db.EXPECT().AddFileTypeIfNotExist(gomock.Any()).Return(nil).AnyTimes()
+	db.EXPECT().GetFileTypes().Return([]models.FileType{{Name: "image/png", AllowedSize: 1 << 20}}, nil).AnyTimes()
+	db.EXPECT().GetFilesSize().Return(0, nil).AnyTimes()
+	db.EXPECT().FindSimilarFile(7, gomock.Any(), 0).Return(nil, nil).Times(2)
+	db.EXPECT().GetFileByChecksum(gomock.Any()).Return(nil, nil).Times(2)
+	var saved []models.File
+	var mu sync.Mutex
+	db.EXPECT().SaveFile(gomock.Any()).DoAndReturn(func(file models.File) error {
+		mu.Lock()
+		defer mu.Unlock()
+		saved = append(saved, file)
+		return nil
+	}).Times(2)
+
+	job := models.SearchJob{Id: 1, UserId: 7, Query: "cats", MaxResults: 2}
+	expectSearchJobClaim(db, job)
+	service.runSearchJob(context.Background(), job)
+
+	// Files record where they came from
+	for _, file := range saved {
+		assert.Equal(t, srv.URL+"/images/"+file.Name, file.SourceURL)
+		assert.Equal(t, "cats", file.SearchQuery)
+		assert.Equal(t, 4, file.Width)
+	}
+	assert.Equal(t, models.SearchJobCompleted, last.Status)
+	assert.Equal(t, 2, last.Saved)
+	assert.NotNil(t, last.StartedAt)
+	assert.NotNil(t, last.FinishedAt)
+	assert.Equal(t, 1, last.Failed)
+	assert.Equal(t, []string{"a.png", "c.png"}, last.FileNames)
+	assert.Equal(t, 0, last.Deduplicated)
+	// Results are kept with the job, so resuming it goes on with the same results
+	assert.Equal(t, []string{srv.URL + "/images/a.png", srv.URL + "/images/b.png", srv.URL + "/images/c.png", srv.URL + "/images/d.png"}, last.CandidateURLs)
+	assert.Len(t, last.CandidateThumbnailURLs, 4)
+	assert.NotEmpty(t, last.Runner)
+}
+
+// TestFileService_runSearchJob_resumed tests a resumed job skips results it processed before it was interrupted,
+// and goes on with the results it kept rather than searching again
+func TestFileService_runSearchJob_resumed(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	// Searching again would return other results in another order
+	srv := newImageSearchStandIn(t, []string{"e.png", "d.png", "f.png"}, map[string]bool{"b.png": true})
+
+	var st settings.Settings
+	st.BackendServer.FilePath = t.TempDir()
+	st.BackendServer.EncryptKey = "0123456789abcdef"
+	st.BackendServer.MaxFilesSizeByte = 1 << 20
+	st.ImageSearch.BaseUrl = srv.URL + "/search"
+	st.ImageSearch.Concurrency = 2
+	st.ImageSearch.AllowPrivateNetworks = true
+
+	db := mock_database.NewMockDatabase(ctrl)
+	db.EXPECT().LockChecksum(gomock.Any()).Return(func() {}, nil).AnyTimes()
+	repo, err := repository.NewFileRepository(st, db)
+	assert.Nil(t, err)
+	service, err := NewFileService(repo, st, db)
+	assert.Nil(t, err)
+
+	var last models.SearchJob
+	db.EXPECT().UpdateSearchJob(gomock.Any()).DoAndReturn(func(job models.SearchJob) error {
+		last = job
+		return nil
+	}).AnyTimes()
+	db.EXPECT().AddFileTypeIfNotExist(gomock.Any()).Return(nil).AnyTimes()
+	db.EXPECT().GetFileTypes().Return([]models.FileType{{Name: "image/png", AllowedSize: 1 << 20}}, nil).AnyTimes()
+	db.EXPECT().GetFilesSize().Return(0, nil).AnyTimes()
+	db.EXPECT().FindSimilarFile(7, gomock.Any(), 0).Return(nil, nil).Times(2)
+	db.EXPECT().GetFileByChecksum(gomock.Any()).Return(nil, nil).Times(2)
+	var saved []string
+	var mu sync.Mutex
+	db.EXPECT().SaveFile(gomock.Any()).DoAndReturn(func(file models.File) error {
+		mu.Lock()
+		defer mu.Unlock()
+		saved = append(saved, file.Name)
+		return nil
+	}).Times(2)
+
+	// a.png was saved and b.png failed before the job was interrupted
+	var candidates []string
+	for _, name := range []string{"a.png", "b.png", "c.png", "d.png"} {
+		candidates = append(candidates, srv.URL+"/images/"+name)
+	}
+	job := models.SearchJob{
+		Id:                     1,
+		UserId:                 7,
+		Query:                  "cats",
+		MaxResults:             3,
+		Status:                 models.SearchJobRunning,
+		Saved:                  1,
+		Failed:                 1,
+		FileNames:              []string{"a.png"},
+		CandidateURLs:          candidates,
+		CandidateThumbnailURLs: make([]string, len(candidates)),
+		NextCandidate:          2,
+	}
+	expectSearchJobClaim(db, job)
+	service.runSearchJob(context.Background(), job)
+
+	assert.ElementsMatch(t, []string{"c.png", "d.png"}, saved)
+	assert.Equal(t, models.SearchJobCompleted, last.Status)
+	assert.Equal(t, 3, last.Saved)
+	assert.Equal(t, 1, last.Failed)
+	assert.Equal(t, []string{"a.png", "c.png", "d.png"}, last.FileNames)
+	assert.Equal(t, 4, last.NextCandidate)
+}
+
+func TestFileService_runSearchJob_deduplicated(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	srv := newImageSearchStandIn(t, []string{"a.png", "b.png"}, nil)
+
+	var st settings.Settings
+	st.BackendServer.FilePath = t.TempDir()
+	st.BackendServer.EncryptKey = "0123456789abcdef"
+	st.BackendServer.MaxFilesSizeByte = 1 << 20
+	st.BackendServer.DedupDistance = 4
+	st.ImageSearch.BaseUrl = srv.URL + "/search"
+	st.ImageSearch.AllowPrivateNetworks = true
+
+	db := mock_database.NewMockDatabase(ctrl)
+	repo, err := repository.NewFileRepository(st, db)
+	assert.Nil(t, err)
+	service, err := NewFileService(repo, st, db)
+	assert.Nil(t, err)
+
+	var last models.SearchJob
+	db.EXPECT().UpdateSearchJob(gomock.Any()).DoAndReturn(func(job models.SearchJob) error {
+		last = job
+		return nil
+	}).AnyTimes()
+	db.EXPECT().AddFileTypeIfNotExist(gomock.Any()).Return(nil).AnyTimes()
+	db.EXPECT().GetFileTypes().Return([]models.FileType{{Name: "image/png", AllowedSize: 1 << 20}}, nil).AnyTimes()
+	db.EXPECT().FindSimilarFile(7, gomock.Any(), 4).Return(&models.File{Name: "saved-cat.png", UUID: "abc", UserId: 7}, nil).Times(2)
+
+	job := models.SearchJob{Id: 1, UserId: 7, Query: "cats", MaxResults: 2}
+	expectSearchJobClaim(db, job)
+	service.runSearchJob(context.Background(), job)
+
+	assert.Equal(t, models.SearchJobCompleted, last.Status)
+	assert.Equal(t, 0, last.Saved)
+	assert.Equal(t, 2, last.Deduplicated)
+	assert.Equal(t, []string{"a.png", "b.png"}, last.DuplicateFileNames)
+	assert.Equal(t, []string{"abc", "abc"}, last.DuplicateOf)
+	assert.Empty(t, last.FileNames)
+}
+
+// TestFileService_runSearchJob_claimed tests a job claimed by a runner of another store process isn't run again
+func TestFileService_runSearchJob_claimed(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	srv := newImageSearchStandIn(t, []string{"a.png"}, nil)
+
+	var st settings.Settings
+	st.BackendServer.FilePath = t.TempDir()
+	st.BackendServer.EncryptKey = "0123456789abcdef"
+	st.ImageSearch.BaseUrl = srv.URL + "/search"
+	st.ImageSearch.AllowPrivateNetworks = true
+
+	db := mock_database.NewMockDatabase(ctrl)
+	repo, err := repository.NewFileRepository(st, db)
+	assert.Nil(t, err)
+	service, err := NewFileService(repo, st, db)
+	assert.Nil(t, err)
+
+	// Nothing else is expected, so searching, saving or updating the job fails the test
+	db.EXPECT().ClaimSearchJob(1, gomock.Any(), gomock.Any()).Return(models.SearchJob{}, database.ErrSearchJobClaimed)
+
+	service.runSearchJob(context.Background(), models.SearchJob{Id: 1, UserId: 7, Query: "cats", MaxResults: 1, Status: models.SearchJobRunning})
+}
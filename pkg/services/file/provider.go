@@ -0,0 +1,39 @@
+package file
+
+import (
+	"context"
+	"net/http"
+
+	"github.com/lebleuciel/maani/pkg/settings"
+)
+
+const (
+	GoogleProvider = "google"
+)
+
+// ImageSearchResult is a candidate image returned by an image search provider
+type ImageSearchResult struct {
+	URL string
+	// ThumbnailURL is a lower resolution fallback of URL, empty when there is none
+	ThumbnailURL string
+	Title        string
+	SourcePage   string
+	Provider     string
+}
+
+// ImageSearchProvider finds candidate images for a search query.
+// maxResults limits the number of returned candidates, zero or negative means no limit.
+type ImageSearchProvider interface {
+	Name() string
+	Search(ctx context.Context, query string, maxResults int) ([]ImageSearchResult, error)
+}
+
+// NewImageSearchProvider creates the image search provider selected in settings
+func NewImageSearchProvider(st settings.Settings) (ImageSearchProvider, error) {
+	client := &http.Client{Timeout: st.ImageSearch.Timeout}
+	switch st.ImageSearch.Provider {
+	case "", GoogleProvider:
+		return NewGoogleImageSearch(st.ImageSearch.BaseUrl, client), nil
+	}
+	return nil, ErrUnknownSearchProvider
+}
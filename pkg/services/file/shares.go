@@ -0,0 +1,210 @@
+package file
+
+import (
+	"net/http"
+	"strconv"
+	"time"
+
+	"github.com/gin-gonic/gin"
+	"github.com/lebleuciel/maani/models"
+	"github.com/lebleuciel/maani/pkg/database"
+	"github.com/lebleuciel/maani/pkg/sharelink"
+	"github.com/pkg/errors"
+)
+
+// ShareFile shares file by id path parameter with another user, only owner of file can share it
+func (f *FileService) ShareFile(c *gin.Context, isAdmin bool) {
+	file, ok := f.ownedFile(c, isAdmin)
+	if !ok {
+		return
+	}
+
+	params := models.ShareParameters{}
+	err := c.ShouldBindJSON(&params)
+	if err != nil {
+		c.JSON(http.StatusBadRequest, gin.H{"error": err.Error()})
+		return
+	}
+	if params.Permission == "" {
+		params.Permission = models.ShareRead
+	}
+	if params.Permission != models.ShareRead && params.Permission != models.ShareWrite {
+		c.JSON(http.StatusBadRequest, gin.H{"error": "Field permission should be read or write"})
+		return
+	}
+	if params.UserId <= 0 || params.UserId == file.UserId {
+		c.JSON(http.StatusBadRequest, gin.H{"error": "Field userId should be id of another user"})
+		return
+	}
+	if params.ExpiresAt != nil && !params.ExpiresAt.After(time.Now()) {
+		c.JSON(http.StatusBadRequest, gin.H{"error": "Field expiresAt should be in the future"})
+		return
+	}
+
+	share, err := f.db.CreateShare(models.Share{
+		FileId:     file.UUID,
+		UserId:     &params.UserId,
+		Permission: params.Permission,
+		ExpiresAt:  params.ExpiresAt,
+	})
+	if errors.Is(err, database.ErrUserNotFound) {
+		c.JSON(http.StatusBadRequest, gin.H{"error": "User not found"})
+		return
+	}
+	if err != nil {
+		logger.Errorw("failed to share file", "error", err)
+		c.JSON(http.StatusInternalServerError, gin.H{"error": "Could not share file"})
+		return
+	}
+	c.JSON(http.StatusCreated, share)
+}
+
+// CreateShareLink creates a public link to file by id path parameter, which can be downloaded without logging in until it expires
+func (f *FileService) CreateShareLink(c *gin.Context, isAdmin bool) {
+	file, ok := f.ownedFile(c, isAdmin)
+	if !ok {
+		return
+	}
+
+	params := models.ShareLinkParameters{}
+	err := c.ShouldBindJSON(&params)
+	if err != nil {
+		c.JSON(http.StatusBadRequest, gin.H{"error": err.Error()})
+		return
+	}
+	expiresIn := f.st.GatewayServer.ShareLinkTimeout
+	if params.ExpiresIn != "" {
+		expiresIn, err = time.ParseDuration(params.ExpiresIn)
+		if err != nil || expiresIn <= 0 || expiresIn > f.st.GatewayServer.ShareLinkMaxTimeout {
+			c.JSON(http.StatusBadRequest, gin.H{"error": "Field expiresIn should be a positive duration of at most " + f.st.GatewayServer.ShareLinkMaxTimeout.String()})
+			return
+		}
+	}
+	if params.MaxDownloads != nil && *params.MaxDownloads <= 0 {
+		c.JSON(http.StatusBadRequest, gin.H{"error": "Field maxDownloads should be a positive number"})
+		return
+	}
+
+	// Links are signed with expiry in seconds, so it is stored the same way
+	expiresAt := time.Now().Add(expiresIn).Truncate(time.Second)
+	share, err := f.db.CreateShare(models.Share{
+		FileId:       file.UUID,
+		Permission:   models.ShareRead,
+		ExpiresAt:    &expiresAt,
+		MaxDownloads: params.MaxDownloads,
+	})
+	if err != nil {
+		logger.Errorw("failed to create share link", "error", err)
+		c.JSON(http.StatusInternalServerError, gin.H{"error": "Could not create share link"})
+		return
+	}
+	share.Link = f.shareLink(share)
+	c.JSON(http.StatusCreated, share)
+}
+
+// GetShareList returns shares of file by id path parameter, with links of its public links
+func (f *FileService) GetShareList(c *gin.Context, isAdmin bool) {
+	file, ok := f.ownedFile(c, isAdmin)
+	if !ok {
+		return
+	}
+
+	shares, err := f.db.GetShareList(file.UUID)
+	if err != nil {
+		logger.Errorw("failed to get share list", "error", err)
+		c.JSON(http.StatusInternalServerError, gin.H{"error": "can not get shares list"})
+		return
+	}
+	for i := range shares {
+		if shares[i].UserId == nil {
+			shares[i].Link = f.shareLink(shares[i])
+		}
+	}
+	c.JSON(http.StatusOK, shares)
+}
+
+// DeleteShare revokes share by shareId path parameter of file by id path parameter
+func (f *FileService) DeleteShare(c *gin.Context, isAdmin bool) {
+	file, ok := f.ownedFile(c, isAdmin)
+	if !ok {
+		return
+	}
+	shareId, err := strconv.Atoi(c.Param("shareId"))
+	if err != nil {
+		c.JSON(http.StatusBadRequest, gin.H{"error": "invalid share id"})
+		return
+	}
+
+	err = f.db.DeleteShare(file.UUID, shareId)
+	if errors.Is(err, database.ErrShareNotFound) {
+		c.JSON(http.StatusNotFound, gin.H{"error": "Share not found"})
+		return
+	}
+	if err != nil {
+		logger.Errorw("failed to delete share", "error", err)
+		c.JSON(http.StatusInternalServerError, gin.H{"error": "Could not delete share"})
+		return
+	}
+	c.Status(http.StatusNoContent)
+}
+
+// GetSharedFile streams file of public link by token path parameter, each request counts as a download of link
+func (f *FileService) GetSharedFile(c *gin.Context, isAdmin bool) {
+	shareId, err := sharelink.Verify(f.st.GatewayServer.ShareLinkSecretKey, c.Param("token"), time.Now())
+	if errors.Is(err, sharelink.ErrExpiredLink) {
+		c.JSON(http.StatusGone, gin.H{"error": "Share link has expired"})
+		return
+	}
+	if err != nil {
+		c.JSON(http.StatusNotFound, gin.H{"error": "Share link not found"})
+		return
+	}
+
+	share, err := f.db.UseShareLink(shareId)
+	if errors.Is(err, database.ErrShareNotFound) {
+		c.JSON(http.StatusNotFound, gin.H{"error": "Share link not found"})
+		return
+	}
+	if errors.Is(err, database.ErrShareUsedUp) {
+		c.JSON(http.StatusGone, gin.H{"error": "Share link has expired or has no downloads left"})
+		return
+	}
+	if err != nil {
+		logger.Errorw("failed to use share link", "error", err)
+		c.JSON(http.StatusInternalServerError, gin.H{"error": "Could not get shared file"})
+		return
+	}
+	f.serveFile(c, database.AllScope(), share.FileId)
+}
+
+// ownedFile returns file by id path parameter when user of request owns it, and responds with an error otherwise
+func (f *FileService) ownedFile(c *gin.Context, isAdmin bool) (models.File, bool) {
+	scope, err := f.scope(c, isAdmin)
+	if err != nil {
+		c.JSON(http.StatusBadRequest, gin.H{"error": err.Error()})
+		return models.File{}, false
+	}
+	file, err := f.repository.GetFile(scope, c.Param("id"))
+	if errors.Is(err, database.ErrFileNotFound) {
+		c.JSON(http.StatusNotFound, gin.H{"error": "File not found"})
+		return models.File{}, false
+	}
+	if err != nil {
+		logger.Errorw("failed to get file", "error", err)
+		c.JSON(http.StatusInternalServerError, gin.H{"error": "Failed to get file"})
+		return models.File{}, false
+	}
+	if !scope.Owns(file.UserId) {
+		c.JSON(http.StatusForbidden, gin.H{"error": "Only owner of file can manage its shares"})
+		return models.File{}, false
+	}
+	return file, true
+}
+
+// shareLink returns path of public link of share, links are signed so they can't be forged or extended
+func (f *FileService) shareLink(share models.Share) string {
+	if share.ExpiresAt == nil {
+		return ""
+	}
+	return "/api/share/" + sharelink.Sign(f.st.GatewayServer.ShareLinkSecretKey, share.Id, *share.ExpiresAt)
+}
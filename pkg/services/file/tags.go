@@ -0,0 +1,146 @@
+package file
+
+import (
+	"net/http"
+	"strconv"
+
+	"github.com/gin-gonic/gin"
+	"github.com/lebleuciel/maani/models"
+	"github.com/lebleuciel/maani/pkg/database"
+	"github.com/lebleuciel/maani/pkg/helpers"
+	"github.com/pkg/errors"
+)
+
+const (
+	// tagListLimit is number of tags listed when no limit is asked for, maxTagListLimit is the most which can be asked for
+	tagListLimit    = 20
+	maxTagListLimit = 100
+)
+
+// GetTagList returns tags of files user can access with number of files they are set on, the most used first.
+// Tags can be filtered by prefix query parameter for autocomplete.
+func (f *FileService) GetTagList(c *gin.Context, isAdmin bool) {
+	scope, err := f.scope(c, isAdmin)
+	if err != nil {
+		c.JSON(http.StatusBadRequest, gin.H{"error": err.Error()})
+		return
+	}
+	limit := tagListLimit
+	if value := c.Query("limit"); value != "" {
+		limit, err = strconv.Atoi(value)
+		if err != nil || limit <= 0 || limit > maxTagListLimit {
+			c.JSON(http.StatusBadRequest, gin.H{"error": "Field limit should be a number from 1 to " + strconv.Itoa(maxTagListLimit)})
+			return
+		}
+	}
+
+	tags, err := f.db.GetTagList(scope, helpers.NormalizeTagPrefix(c.Query("prefix")), limit)
+	if err != nil {
+		logger.Errorw("failed to get tag list", "error", err)
+		c.JSON(http.StatusInternalServerError, gin.H{"error": "can not get tags list"})
+		return
+	}
+	c.JSON(http.StatusOK, tags)
+}
+
+// AddFileTags adds tags of body to file by id path parameter and returns its tags
+func (f *FileService) AddFileTags(c *gin.Context, isAdmin bool) {
+	params := models.FileTagsParameters{}
+	err := c.ShouldBindJSON(&params)
+	if err != nil {
+		c.JSON(http.StatusBadRequest, gin.H{"error": err.Error()})
+		return
+	}
+	f.changeFileTags(c, isAdmin, params.Tags, f.db.AddFileTags)
+}
+
+// RemoveFileTags removes tags query parameter from file by id path parameter and returns its tags
+func (f *FileService) RemoveFileTags(c *gin.Context, isAdmin bool) {
+	f.changeFileTags(c, isAdmin, c.QueryArray("tags"), f.db.RemoveFileTags)
+}
+
+// RenameTag renames tag by name path parameter on every file, it is only for admin user
+func (f *FileService) RenameTag(c *gin.Context, isAdmin bool) {
+	params := models.RenameTagParameters{}
+	err := c.ShouldBindJSON(&params)
+	if err != nil {
+		c.JSON(http.StatusBadRequest, gin.H{"error": err.Error()})
+		return
+	}
+	f.moveTag(c, params.Name, f.db.RenameTag)
+}
+
+// MergeTags replaces tag by name path parameter with tag into of body on every file, it is only for admin user
+func (f *FileService) MergeTags(c *gin.Context, isAdmin bool) {
+	params := models.MergeTagParameters{}
+	err := c.ShouldBindJSON(&params)
+	if err != nil {
+		c.JSON(http.StatusBadRequest, gin.H{"error": err.Error()})
+		return
+	}
+	f.moveTag(c, params.Into, f.db.MergeTags)
+}
+
+// changeFileTags normalizes tags and changes them on file by id path parameter with change, which user must be able to modify
+func (f *FileService) changeFileTags(c *gin.Context, isAdmin bool, input []string, change func(database.Scope, string, []string) ([]string, error)) {
+	tags, err := helpers.NormalizeTags(input)
+	if err != nil {
+		c.JSON(http.StatusBadRequest, gin.H{"error": err.Error()})
+		return
+	}
+	if len(tags) == 0 {
+		c.JSON(http.StatusBadRequest, gin.H{"error": "Field tags is required"})
+		return
+	}
+	scope, err := f.scope(c, isAdmin)
+	if err != nil {
+		c.JSON(http.StatusBadRequest, gin.H{"error": err.Error()})
+		return
+	}
+
+	fileTags, err := change(scope.ForWrite(), c.Param("id"), tags)
+	if errors.Is(err, database.ErrFileNotFound) {
+		c.JSON(http.StatusNotFound, gin.H{"error": "File not found"})
+		return
+	}
+	if err != nil {
+		logger.Errorw("failed to change tags of file", "error", err)
+		c.JSON(http.StatusInternalServerError, gin.H{"error": "Could not change tags of file"})
+		return
+	}
+	c.JSON(http.StatusOK, fileTags)
+}
+
+// moveTag normalizes tag by name path parameter and target tag, and moves files of the first to the other with move
+func (f *FileService) moveTag(c *gin.Context, target string, move func(string, string) error) {
+	name, err := helpers.NormalizeTag(c.Param("name"))
+	if err != nil {
+		c.JSON(http.StatusNotFound, gin.H{"error": "Tag not found"})
+		return
+	}
+	target, err = helpers.NormalizeTag(target)
+	if err != nil {
+		c.JSON(http.StatusBadRequest, gin.H{"error": err.Error()})
+		return
+	}
+	if name == target {
+		c.JSON(http.StatusBadRequest, gin.H{"error": "Tags should be different"})
+		return
+	}
+
+	err = move(name, target)
+	if errors.Is(err, database.ErrTagNotFound) {
+		c.JSON(http.StatusNotFound, gin.H{"error": "Tag not found"})
+		return
+	}
+	if errors.Is(err, database.ErrTagExists) {
+		c.JSON(http.StatusConflict, gin.H{"error": "Tag already exists, merge tags instead"})
+		return
+	}
+	if err != nil {
+		logger.Errorw("failed to move tag", "error", err)
+		c.JSON(http.StatusInternalServerError, gin.H{"error": "Could not change tag"})
+		return
+	}
+	c.Status(http.StatusNoContent)
+}
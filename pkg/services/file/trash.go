@@ -0,0 +1,99 @@
+package file
+
+import (
+	"context"
+	"net/http"
+	"time"
+
+	"github.com/gin-gonic/gin"
+	"github.com/lebleuciel/maani/pkg/database"
+	"github.com/pkg/errors"
+)
+
+// purgeBatchSize is number of trashed files read at once while purging
+const purgeBatchSize = 100
+
+// GetTrash returns metadata of trashed files of user, the most recently trashed first
+func (f *FileService) GetTrash(c *gin.Context, isAdmin bool) {
+	scope, err := f.scope(c, isAdmin)
+	if err != nil {
+		c.JSON(http.StatusBadRequest, gin.H{"error": err.Error()})
+		return
+	}
+
+	files, err := f.repository.GetTrashList(scope)
+	if err != nil {
+		logger.Errorw("failed to get trash list", "error", err)
+		c.JSON(http.StatusInternalServerError, gin.H{"error": "can not get trash list"})
+		return
+	}
+	c.JSON(http.StatusOK, files)
+}
+
+// RestoreFile moves a file by id path parameter out of trash
+func (f *FileService) RestoreFile(c *gin.Context, isAdmin bool) {
+	scope, err := f.scope(c, isAdmin)
+	if err != nil {
+		c.JSON(http.StatusBadRequest, gin.H{"error": err.Error()})
+		return
+	}
+
+	err = f.repository.RestoreFile(scope.ForWrite(), c.Param("id"))
+	if errors.Is(err, database.ErrFileNotFound) {
+		c.JSON(http.StatusNotFound, gin.H{"error": "File not found in trash"})
+		return
+	}
+	if err != nil {
+		logger.Errorw("failed to restore file", "error", err)
+		c.JSON(http.StatusInternalServerError, gin.H{"error": "Failed to restore file"})
+		return
+	}
+	c.Status(http.StatusNoContent)
+}
+
+// StartPurger purges files trashed for longer than retention period of trash in background, every purge interval
+func (f *FileService) StartPurger(ctx context.Context) {
+	go func() {
+		ticker := time.NewTicker(f.st.BackendServer.PurgeInterval)
+		defer ticker.Stop()
+		for {
+			f.purgeTrash()
+			select {
+			case <-ctx.Done():
+				return
+			case <-ticker.C:
+			}
+		}
+	}()
+}
+
+// purgeTrash removes trashed files and their blobs, files which can't be purged are retried on next purge
+func (f *FileService) purgeTrash() {
+	trashedBefore := time.Now().Add(-f.st.BackendServer.TrashRetention)
+	purged := 0
+	for {
+		files, err := f.db.GetFilesToPurge(trashedBefore, purgeBatchSize)
+		if err != nil {
+			logger.Errorw("could not get files to purge", "error", err)
+			return
+		}
+
+		failed := 0
+		for _, file := range files {
+			err = f.repository.PurgeFile(file)
+			if err != nil {
+				logger.Errorw("could not purge file", "uuid", file.UUID, "error", err)
+				failed++
+				continue
+			}
+			purged++
+		}
+		// A batch of files which all failed would be read again, so purging stops until next interval
+		if len(files) < purgeBatchSize || failed == len(files) {
+			break
+		}
+	}
+	if purged > 0 {
+		logger.Infow("purged trashed files", "count", purged)
+	}
+}
@@ -0,0 +1,64 @@
+package file
+
+import (
+	"context"
+	"os"
+	"path/filepath"
+	"testing"
+	"time"
+
+	"github.com/golang/mock/gomock"
+	"github.com/lebleuciel/maani/models"
+	"github.com/lebleuciel/maani/pkg/blobstore"
+	"github.com/lebleuciel/maani/pkg/database"
+	mock_database "github.com/lebleuciel/maani/pkg/database/mocks"
+	"github.com/lebleuciel/maani/pkg/helpers"
+	repository "github.com/lebleuciel/maani/pkg/repository/file"
+	"github.com/lebleuciel/maani/pkg/settings"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestFileService_purgeTrash(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	var st settings.Settings
+	st.BackendServer.FilePath = t.TempDir()
+	st.BackendServer.EncryptKey = "0123456789abcdef"
+	st.BackendServer.TrashRetention = 24 * time.Hour
+	db := mock_database.NewMockDatabase(ctrl)
+	db.EXPECT().LockChecksum(gomock.Any()).Return(func() {}, nil).AnyTimes()
+	repo, err := repository.NewFileRepository(st, db)
+	assert.Nil(t, err)
+	service, err := NewFileService(repo, st, db)
+	assert.Nil(t, err)
+
+	store, err := blobstore.NewLocalStore(st.BackendServer.FilePath)
+	assert.Nil(t, err)
+	blobPath := func(content string) string {
+		checksum := helpers.Checksum([]byte(content))
+		assert.Nil(t, helpers.SaveEncryptedFile(context.Background(), store, helpers.BlobKey(checksum), []byte(content), make([]byte, 32)))
+		return filepath.Join(st.BackendServer.FilePath, filepath.FromSlash(helpers.BlobKey(checksum)))
+	}
+	onlyPath := blobPath("only trashed")
+	sharedPath := blobPath("shared")
+	only := models.File{UUID: "only", SHA256: helpers.Checksum([]byte("only trashed"))}
+	shared := models.File{UUID: "shared", SHA256: helpers.Checksum([]byte("shared"))}
+
+	db.EXPECT().GetFilesToPurge(gomock.Any(), purgeBatchSize).DoAndReturn(func(trashedBefore time.Time, limit int) ([]models.File, error) {
+		assert.WithinDuration(t, time.Now().Add(-24*time.Hour), trashedBefore, time.Minute)
+		return []models.File{only, shared}, nil
+	})
+	db.EXPECT().DeleteFile(database.AllScope(), "only").Return(nil)
+	db.EXPECT().CountFilesByChecksum(only.SHA256).Return(0, nil)
+	db.EXPECT().DeleteFile(database.AllScope(), "shared").Return(nil)
+	db.EXPECT().CountFilesByChecksum(shared.SHA256).Return(1, nil)
+
+	service.purgeTrash()
+
+	// Blob of a purged file is kept while another file still has the same content
+	_, err = os.Stat(onlyPath)
+	assert.True(t, os.IsNotExist(err))
+	_, err = os.Stat(sharedPath)
+	assert.Nil(t, err)
+}
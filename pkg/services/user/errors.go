@@ -3,3 +3,5 @@ package user
 import "github.com/pkg/errors"
 
 var ErrNilUserRepo = errors.New("User repository can not be nil")
+var ErrUnsupportedUserFilter = errors.New("Users can only be filtered by type, after and before")
+var ErrInvalidAccessType = errors.New("Field type should be Admin or Customer")
@@ -5,6 +5,8 @@ import (
 	"net/http"
 
 	"github.com/gin-gonic/gin"
+	"github.com/lebleuciel/maani/models"
+	"github.com/lebleuciel/maani/pkg/database"
 	repository "github.com/lebleuciel/maani/pkg/repository/user"
 	"github.com/lebleuciel/maani/pkg/settings"
 	"go.uber.org/zap"
@@ -38,8 +40,18 @@ func NewUserService(repo *repository.UserRepository, st settings.Settings) (*Use
 	}, nil
 }
 
+// GetUserList returns a page of users, sorted and filtered by query parameters
 func (f *UserService) GetUserList(c *gin.Context, isAdmin bool) {
-	users, err := f.repository.GetUserList()
+	options, err := database.ParseListOptions(c.Request.URL.Query(), database.SortCreatedAt, database.SortName)
+	if err == nil {
+		err = checkUserListOptions(options)
+	}
+	if err != nil {
+		c.JSON(http.StatusBadRequest, gin.H{"error": err.Error()})
+		return
+	}
+
+	users, err := f.repository.GetUserList(options)
 	if err != nil {
 		logger.Errorw("failed to get user list", "error", err)
 		c.JSON(http.StatusInternalServerError, gin.H{"error": "can not get users list"})
@@ -47,3 +59,14 @@ func (f *UserService) GetUserList(c *gin.Context, isAdmin bool) {
 	}
 	c.JSON(http.StatusOK, users)
 }
+
+// checkUserListOptions refuses filters of files, users are only filtered by access type and creation time
+func checkUserListOptions(options database.ListOptions) error {
+	if options.OwnerId != 0 || options.Tag != "" {
+		return ErrUnsupportedUserFilter
+	}
+	if options.Type != "" && options.Type != models.AdminType && options.Type != models.CustomerType {
+		return ErrInvalidAccessType
+	}
+	return nil
+}
@@ -5,3 +5,8 @@ import "github.com/pkg/errors"
 var ErrSettingNameEmpty = errors.New("global.name field is required.")
 var ErrSettingDuplicatedServerPorts = errors.New("duplicated ports has been found: port number fields in setting.yml should have different values.")
 var ErrSettingInvalidEnvironment = errors.New("configs.environment field value is invalid.")
+var ErrSettingInvalidStoreTimeout = errors.New("retreival.storeTimeout and retreival.storeConnectTimeout should be positive.")
+var ErrSettingInvalidShareLinkTimeout = errors.New("retreival.shareLinkTimeout should be positive and at most retreival.shareLinkMaxTimeout.")
+var ErrSettingInvalidTrash = errors.New("store.trashRetention should not be negative and store.purgeInterval should be positive.")
+var ErrSettingInvalidRendition = errors.New("store.renditions should have width, height and mode of fit or crop.")
+var ErrSettingInvalidImageFormat = errors.New("store.imageFormats should have format of image/jpeg, image/png, image/gif or image/webp and quality from 0 to 100.")
@@ -2,6 +2,8 @@ package settings
 
 import (
 	"time"
+
+	"github.com/pkg/errors"
 )
 
 const (
@@ -10,6 +12,33 @@ const (
 	Test    string = "test"
 )
 
+const (
+	// RenditionFit scales image to fit in rendition size keeping its aspect ratio, images are never enlarged
+	RenditionFit string = "fit"
+	// RenditionCrop scales image to cover rendition size and crops its center to exactly that size
+	RenditionCrop string = "crop"
+)
+
+// Rendition describes a resized copy of stored images
+type Rendition struct {
+	Width  uint   `yaml:"width"`
+	Height uint   `yaml:"height"`
+	Mode   string `yaml:"mode"`
+	// Eager renditions are generated on upload, others on their first request
+	Eager bool `yaml:"eager"`
+}
+
+// ImageFormat describes how renditions of images of a type are encoded
+type ImageFormat struct {
+	// Format is media type renditions are encoded with, empty keeps format of the original
+	Format string `yaml:"format"`
+	// Quality of JPEG renditions from 1 to 100, zero uses default quality of encoder
+	Quality int `yaml:"quality"`
+}
+
+// ImageOutputFormats are media types renditions can be encoded with
+var ImageOutputFormats = []string{"image/jpeg", "image/png", "image/gif", "image/webp"}
+
 type Settings struct {
 	Global struct {
 		Name              string        `yaml:"name" env:"GLOBAL_NAME" env-default:"maani" env-description:"Instance Name"`
@@ -41,18 +70,59 @@ type Settings struct {
 	} `yaml:"database"`
 	GatewayServer struct {
 		StoreHost           string        `yaml:"storeHost" env:"STORE_HOST" env-default:"http://store" env-description:"Host for request to store servers"`
+		StoreTimeout        time.Duration `yaml:"storeTimeout" env:"STORE_TIMEOUT" env-default:"2m" env-description:"Timeout of store servers to start responding to forwarded requests, response bodies are streamed without a timeout"`
+		StoreConnectTimeout time.Duration `yaml:"storeConnectTimeout" env:"STORE_CONNECT_TIMEOUT" env-default:"5s" env-description:"Timeout of connecting to store servers"`
 		SecretKey           string        `env:"GATEWAY_API_SECRET_KEY" env-default:"gatewaySecret" env-description:"Secret key for gateway server api authentication"`
 		TokenTimeout        time.Duration `yaml:"tokenTimeout" env:"API_TOKEN_TIMEOUT" env-default:"1h" env-description:"Timeout of token for api authentication"`
 		RefreshTokenTimeout time.Duration `yaml:"refreshTokenTimeout" env:"API_REFRESH_TOKEN_TIMEOUT" env-default:"3h" env-description:"Timeout of refresh token for api authentication"`
 		UserIdHeaderKey     string        `yaml:"userIdHeaderKey" env:"USER_ID_HEADER_KEY" env-default:"X-MAANI-USER" env-description:"Header key to set user id and pass it throw reequest"`
+		UserAccessHeaderKey string        `yaml:"userAccessHeaderKey" env:"USER_ACCESS_HEADER_KEY" env-default:"X-MAANI-ACCESS" env-description:"Header key to set access type of user and pass it throw request"`
+		ShareLinkSecretKey  string        `env:"SHARE_LINK_SECRET_KEY" env-default:"shareLinkSecret" env-description:"Secret key public share links are signed with"`
+		ShareLinkTimeout    time.Duration `yaml:"shareLinkTimeout" env:"SHARE_LINK_TIMEOUT" env-default:"24h" env-description:"Default time public share links expire after"`
+		ShareLinkMaxTimeout time.Duration `yaml:"shareLinkMaxTimeout" env:"SHARE_LINK_MAX_TIMEOUT" env-default:"168h" env-description:"Maximum time public share links can expire after"`
 	} `yaml:"retreival"`
 	BackendServer struct {
-		EncryptKey       string `yaml:"encryptKey" env:"ENCRYPT_KEY" env-default:"files-secret-key"  env-description:"Key for encrypting file"`
-		FilePath         string `yaml:"filePath" env:"FILE_PATH" env-default:"/opt/files" env-description:"Path for new file to save"`
-		MaxFilesSizeByte int    `yaml:"maxFilesSizeByte" env:"MAX_FilES_SIZE_BYTE" env-default:"100000000" env-description:"Maximum limitation of files size in byte"`
-		FileWidth        uint   `yaml:"fileWidth" env:"FIlES_WIDTH" env-default:"1080" env-description:"downloaded files width"`
-		FileHeight       uint   `yaml:"fileHeight" env:"FIlES_HEIGHT" env-default:"1080" env-description:"downloaded files height"`
+		EncryptKey       string            `yaml:"encryptKey" env:"ENCRYPT_KEY" env-default:"files-secret-key"  env-description:"Key for encrypting file, available in keyring as default key"`
+		EncryptKeys      map[string]string `yaml:"encryptKeys" env:"ENCRYPT_KEYS" env-description:"Keyring of additional encryption keys by id, as id1:key1,id2:key2"`
+		ActiveKeyId      string            `yaml:"activeKeyId" env:"ACTIVE_KEY_ID" env-default:"default" env-description:"Id of key-encryption key data keys of new files are wrapped with"`
+		KeyProvider      string            `yaml:"keyProvider" env:"KEY_PROVIDER" env-default:"settings" env-description:"Source of key-encryption keys, supports: settings, file, env"`
+		KeyDir           string            `yaml:"keyDir" env:"KEY_DIR" env-default:"/run/secrets/maani" env-description:"Directory with one key file per key id for file key provider"`
+		KeyEnvPrefix     string            `yaml:"keyEnvPrefix" env:"KEY_ENV_PREFIX" env-default:"MAANI_KEK_" env-description:"Prefix of environment variables holding keys by id for env key provider"`
+		FilePath         string            `yaml:"filePath" env:"FILE_PATH" env-default:"/opt/files" env-description:"Path for new file to save"`
+		MaxFilesSizeByte int               `yaml:"maxFilesSizeByte" env:"MAX_FilES_SIZE_BYTE" env-default:"100000000" env-description:"Maximum limitation of files size in byte"`
+		DedupDistance    int               `yaml:"dedupDistance" env:"DEDUP_DISTANCE" env-default:"4" env-description:"Maximum perceptual hash distance of images treated as duplicates, negative disables deduplication"`
+		KeepGPS          bool              `yaml:"keepGps" env:"KEEP_GPS" env-default:"false" env-description:"Keep GPS location in exif metadata of stored images, it is stripped otherwise"`
+		TrashRetention   time.Duration     `yaml:"trashRetention" env:"TRASH_RETENTION" env-default:"720h" env-description:"Time trashed files are kept for before they are purged"`
+		PurgeInterval    time.Duration     `yaml:"purgeInterval" env:"PURGE_INTERVAL" env-default:"1h" env-description:"Interval of purging files trashed for longer than retention period"`
+		// Renditions are resized copies of stored images by name, originals are never modified
+		Renditions map[string]Rendition `yaml:"renditions"`
+		// ImageFormats overrides format of renditions by media type of their original
+		ImageFormats map[string]ImageFormat `yaml:"imageFormats"`
 	} `yaml:"store"`
+	BlobStore struct {
+		Driver    string `yaml:"driver" env:"BLOB_STORE_DRIVER" env-default:"local" env-description:"Storage of file blobs, supports: local, s3"`
+		Endpoint  string `yaml:"endpoint" env:"BLOB_STORE_ENDPOINT" env-description:"Host and port of s3 compatible storage, such as minio:9000"`
+		Region    string `yaml:"region" env:"BLOB_STORE_REGION" env-default:"us-east-1" env-description:"Region of s3 bucket"`
+		Bucket    string `yaml:"bucket" env:"BLOB_STORE_BUCKET" env-default:"maani" env-description:"Bucket keeping file blobs in s3 compatible storage"`
+		Prefix    string `yaml:"prefix" env:"BLOB_STORE_PREFIX" env-description:"Prefix of object keys in bucket, such as files/"`
+		AccessKey string `yaml:"accessKey" env:"BLOB_STORE_ACCESS_KEY" env-description:"Access key of s3 compatible storage"`
+		SecretKey string `yaml:"secretKey" env:"BLOB_STORE_SECRET_KEY" env-description:"Secret key of s3 compatible storage"`
+		UseSSL    bool   `yaml:"useSSL" env:"BLOB_STORE_USE_SSL" env-default:"true" env-description:"Connect to s3 compatible storage over https"`
+	} `yaml:"blobStore"`
+	ImageSearch struct {
+		Provider             string        `yaml:"provider" env:"IMAGE_SEARCH_PROVIDER" env-default:"google" env-description:"Image search provider used for search endpoint, supports: google"`
+		BaseUrl              string        `yaml:"baseUrl" env:"IMAGE_SEARCH_BASE_URL" env-default:"http://www.google.com/search" env-description:"Base url of image search provider"`
+		Timeout              time.Duration `yaml:"timeout" env:"IMAGE_SEARCH_TIMEOUT" env-default:"30s" env-description:"Timeout of requests to image search provider"`
+		Concurrency          int           `yaml:"concurrency" env:"IMAGE_SEARCH_CONCURRENCY" env-default:"8" env-description:"Number of images downloaded concurrently for each search"`
+		DownloadTimeout      time.Duration `yaml:"downloadTimeout" env:"IMAGE_SEARCH_DOWNLOAD_TIMEOUT" env-default:"15s" env-description:"Timeout of downloading each image of search results"`
+		MaxImageBytes        int64         `yaml:"maxImageBytes" env:"IMAGE_SEARCH_MAX_IMAGE_BYTES" env-default:"10000000" env-description:"Maximum size of each downloaded image in byte"`
+		ConnectTimeout       time.Duration `yaml:"connectTimeout" env:"IMAGE_SEARCH_CONNECT_TIMEOUT" env-default:"5s" env-description:"Timeout of connecting to image hosts"`
+		ReadTimeout          time.Duration `yaml:"readTimeout" env:"IMAGE_SEARCH_READ_TIMEOUT" env-default:"10s" env-description:"Timeout of reading images from image hosts"`
+		MaxRedirects         int           `yaml:"maxRedirects" env:"IMAGE_SEARCH_MAX_REDIRECTS" env-default:"3" env-description:"Maximum redirects followed while downloading an image"`
+		AllowPrivateNetworks bool          `yaml:"allowPrivateNetworks" env:"IMAGE_SEARCH_ALLOW_PRIVATE_NETWORKS" env-default:"false" env-description:"Allow downloading images from private, loopback and link-local addresses"`
+		JobWorkers           int           `yaml:"jobWorkers" env:"IMAGE_SEARCH_JOB_WORKERS" env-default:"2" env-description:"Number of search jobs processed concurrently by store"`
+		JobQueueSize         int           `yaml:"jobQueueSize" env:"IMAGE_SEARCH_JOB_QUEUE_SIZE" env-default:"64" env-description:"Number of search jobs buffered before runners pick them"`
+	} `yaml:"search"`
 }
 
 func (settings Settings) IsValid() (bool, error) {
@@ -81,5 +151,37 @@ func (settings Settings) IsValid() (bool, error) {
 	if settings.Global.Environment != Debug && settings.Global.Environment != Release && settings.Global.Environment != Test {
 		return false, ErrSettingInvalidEnvironment
 	}
+
+	if settings.GatewayServer.StoreTimeout <= 0 || settings.GatewayServer.StoreConnectTimeout <= 0 {
+		return false, ErrSettingInvalidStoreTimeout
+	}
+
+	if settings.GatewayServer.ShareLinkTimeout <= 0 || settings.GatewayServer.ShareLinkTimeout > settings.GatewayServer.ShareLinkMaxTimeout {
+		return false, ErrSettingInvalidShareLinkTimeout
+	}
+
+	if settings.BackendServer.TrashRetention < 0 || settings.BackendServer.PurgeInterval <= 0 {
+		return false, ErrSettingInvalidTrash
+	}
+
+	for name, rendition := range settings.BackendServer.Renditions {
+		if name == "" || rendition.Width == 0 || rendition.Height == 0 || (rendition.Mode != RenditionFit && rendition.Mode != RenditionCrop) {
+			return false, errors.Wrapf(ErrSettingInvalidRendition, "rendition %q", name)
+		}
+	}
+	for mediaType, format := range settings.BackendServer.ImageFormats {
+		if format.Quality < 0 || format.Quality > 100 || (format.Format != "" && !isImageOutputFormat(format.Format)) {
+			return false, errors.Wrapf(ErrSettingInvalidImageFormat, "image format of %q", mediaType)
+		}
+	}
 	return true, nil
 }
+
+func isImageOutputFormat(format string) bool {
+	for _, outputFormat := range ImageOutputFormats {
+		if format == outputFormat {
+			return true
+		}
+	}
+	return false
+}
@@ -0,0 +1,6 @@
+package sharelink
+
+import "github.com/pkg/errors"
+
+var ErrInvalidLink = errors.New("share link is not valid")
+var ErrExpiredLink = errors.New("share link has expired")
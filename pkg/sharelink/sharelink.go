@@ -0,0 +1,53 @@
+package sharelink
+
+import (
+	"crypto/hmac"
+	"crypto/sha256"
+	"encoding/base64"
+	"fmt"
+	"strconv"
+	"strings"
+	"time"
+)
+
+// Sign returns token of public share link with shareId which expires at expiresAt.
+// Token carries share id and expiry with their signature, so it can be verified without reading the share.
+func Sign(secret string, shareId int, expiresAt time.Time) string {
+	payload := fmt.Sprintf("%d.%d", shareId, expiresAt.Unix())
+	return payload + "." + signature(secret, payload)
+}
+
+// Verify checks signature and expiry of token and returns id of its share
+func Verify(secret string, token string, now time.Time) (int, error) {
+	separator := strings.LastIndexByte(token, '.')
+	if separator < 0 {
+		return 0, ErrInvalidLink
+	}
+	payload := token[:separator]
+	if !hmac.Equal([]byte(token[separator+1:]), []byte(signature(secret, payload))) {
+		return 0, ErrInvalidLink
+	}
+
+	id, expires, found := strings.Cut(payload, ".")
+	if !found {
+		return 0, ErrInvalidLink
+	}
+	shareId, err := strconv.Atoi(id)
+	if err != nil {
+		return 0, ErrInvalidLink
+	}
+	expiresAt, err := strconv.ParseInt(expires, 10, 64)
+	if err != nil {
+		return 0, ErrInvalidLink
+	}
+	if !now.Before(time.Unix(expiresAt, 0)) {
+		return 0, ErrExpiredLink
+	}
+	return shareId, nil
+}
+
+func signature(secret string, payload string) string {
+	mac := hmac.New(sha256.New, []byte(secret))
+	mac.Write([]byte(payload))
+	return base64.RawURLEncoding.EncodeToString(mac.Sum(nil))
+}
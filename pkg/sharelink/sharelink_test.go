@@ -0,0 +1,43 @@
+package sharelink
+
+import (
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestVerify(t *testing.T) {
+	now := time.Date(2024, 3, 4, 5, 6, 7, 0, time.UTC)
+	token := Sign("secret", 42, now.Add(time.Hour))
+
+	t.Run("valid", func(t *testing.T) {
+		id, err := Verify("secret", token, now)
+		assert.Nil(t, err)
+		assert.Equal(t, 42, id)
+	})
+	t.Run("expired", func(t *testing.T) {
+		_, err := Verify("secret", token, now.Add(time.Hour))
+		assert.Equal(t, ErrExpiredLink, err)
+	})
+	t.Run("other_secret", func(t *testing.T) {
+		_, err := Verify("other", token, now)
+		assert.Equal(t, ErrInvalidLink, err)
+	})
+	t.Run("tampered", func(t *testing.T) {
+		_, err := Verify("secret", strings.Replace(token, "42.", "43.", 1), now)
+		assert.Equal(t, ErrInvalidLink, err)
+
+		parts := strings.Split(token, ".")
+		extended := Sign("secret", 42, now.Add(48*time.Hour))
+		_, err = Verify("secret", parts[0]+"."+strings.Split(extended, ".")[1]+"."+parts[2], now)
+		assert.Equal(t, ErrInvalidLink, err)
+	})
+	t.Run("malformed", func(t *testing.T) {
+		for _, token := range []string{"", "42", "42.abc", "x.1.abc"} {
+			_, err := Verify("secret", token, now)
+			assert.Equal(t, ErrInvalidLink, err, token)
+		}
+	})
+}